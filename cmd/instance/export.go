@@ -0,0 +1,62 @@
+package instance
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/cmd/key"
+	"github.com/zitadel/zitadel/internal/database/dialect"
+)
+
+func exportCmd() *cobra.Command {
+	var (
+		instanceID string
+		output     string
+	)
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "exports all events, unique constraints and assets of an instance into an archive",
+		Long: `exports all events, unique constraints and assets of an instance into an archive
+The archive is self-contained and can be imported into another ZITADEL cluster using zitadel instance import.
+Only assets stored in the database are exported.
+
+Personal data are decrypted and secrets stay encrypted with the encryption keys of this cluster,
+so the archive must be handled as confidential and the target needs the same encryption keys (see zitadel mirror system).
+The masterkey is only required if personal data encryption is enabled.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			config := MustNewConfig(viper.GetViper())
+			start := time.Now()
+
+			var w io.Writer = os.Stdout
+			if output != "-" {
+				file, err := os.Create(output)
+				logging.OnError(err).Fatal("unable to create archive")
+				defer file.Close()
+				w = file
+			}
+
+			summary, err := newTransfer(cmd, config, dialect.DBPurposeQuery).Export(cmd.Context(), instanceID, w)
+			logging.WithFields("instance", instanceID).OnError(err).Fatal("export failed")
+
+			logging.WithFields(
+				"instance", instanceID,
+				"events", summary.Events,
+				"uniqueConstraints", summary.UniqueConstraints,
+				"assets", summary.Assets,
+				"took", time.Since(start),
+			).Info("instance exported")
+		},
+	}
+
+	key.AddMasterKeyFlag(cmd)
+	cmd.Flags().StringVar(&instanceID, "instance", "", "id of the instance to export")
+	cmd.Flags().StringVarP(&output, "output", "o", "-", "path of the archive, - writes to stdout")
+	logging.OnError(cmd.MarkFlagRequired("instance")).Fatal("unable to mark flag required")
+
+	return cmd
+}
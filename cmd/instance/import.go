@@ -0,0 +1,64 @@
+package instance
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/cmd/key"
+	"github.com/zitadel/zitadel/internal/database/dialect"
+	"github.com/zitadel/zitadel/internal/transfer"
+)
+
+func importCmd() *cobra.Command {
+	var (
+		input     string
+		remapping transfer.Remapping
+	)
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "imports an instance from an archive created by zitadel instance export",
+		Long: `imports an instance from an archive created by zitadel instance export
+The instance must not exist yet. All data are imported in a single transaction.
+
+To clone an instance into the same cluster, a new instance id and new domains must be provided, e.g.:
+zitadel instance import --input prod.zitadel --instance-id 123 --domain prod.example.com=staging.example.com
+
+Domains are mapped in all values of the instance including subdomains and urls, e.g. redirect uris.
+The masterkey is only required if personal data encryption is enabled.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			config := MustNewConfig(viper.GetViper())
+			start := time.Now()
+
+			var r io.Reader = os.Stdin
+			if input != "-" {
+				file, err := os.Open(input)
+				logging.OnError(err).Fatal("unable to open archive")
+				defer file.Close()
+				r = file
+			}
+
+			instanceID, summary, err := newTransfer(cmd, config, dialect.DBPurposeEventPusher).Import(cmd.Context(), r, &remapping)
+			logging.OnError(err).Fatal("import failed")
+
+			logging.WithFields(
+				"instance", instanceID,
+				"events", summary.Events,
+				"uniqueConstraints", summary.UniqueConstraints,
+				"assets", summary.Assets,
+				"took", time.Since(start),
+			).Info("instance imported")
+		},
+	}
+
+	key.AddMasterKeyFlag(cmd)
+	cmd.Flags().StringVarP(&input, "input", "i", "-", "path of the archive, - reads from stdin")
+	cmd.Flags().StringVar(&remapping.InstanceID, "instance-id", "", "id of the imported instance, the id of the exported instance is used if not set")
+	cmd.Flags().StringToStringVar(&remapping.Domains, "domain", nil, "maps a domain of the exported instance to a new domain, e.g. prod.example.com=staging.example.com")
+
+	return cmd
+}
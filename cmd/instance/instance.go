@@ -0,0 +1,51 @@
+package instance
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/cmd/key"
+	crypto_db "github.com/zitadel/zitadel/internal/crypto/database"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/dialect"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/transfer"
+)
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instance",
+		Short: "exports and imports instances",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return errors.New("no additional command provided")
+		},
+	}
+
+	cmd.AddCommand(
+		exportCmd(),
+		importCmd(),
+	)
+
+	return cmd
+}
+
+// newTransfer connects to the database.
+// The master key is only required if personal data are encrypted.
+func newTransfer(cmd *cobra.Command, config *Config, purpose dialect.DBPurpose) *transfer.Transfer {
+	client, err := database.Connect(config.Database, false, purpose)
+	logging.OnError(err).Fatal("unable to connect to database")
+
+	if !config.Eventstore.PersonalData.Enabled {
+		return transfer.New(client, nil)
+	}
+
+	masterKey, err := key.MasterKey(cmd)
+	logging.OnError(err).Fatal("unable to read master key")
+
+	keyStorage, err := crypto_db.NewKeyStorage(client, masterKey)
+	logging.OnError(err).Fatal("cannot start key storage")
+
+	return transfer.New(client, eventstore.NewPersonalDataCipher(keyStorage, config.Eventstore.PersonalData))
+}
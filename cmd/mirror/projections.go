@@ -84,6 +84,7 @@ type ProjectionsConfig struct {
 	InternalAuthZ   internal_authz.Config
 	SystemDefaults  systemdefaults.SystemDefaults
 	Telemetry       *handlers.TelemetryPusherConfig
+	Notifications   *handlers.NotificationWorkerConfig
 	Login           login.Config
 	OIDC            oidc.Config
 	WebAuthNName    string
@@ -119,6 +120,7 @@ func projections(
 	esPusherDBClient, err := database.Connect(config.Destination, false, dialect.DBPurposeEventPusher)
 	logging.OnError(err).Fatal("unable to connect eventstore push client")
 	config.Eventstore.Pusher = new_es.NewEventstore(esPusherDBClient)
+	config.Eventstore.PersonalDataKeys = keyStorage
 	es := eventstore.NewEventstore(config.Eventstore)
 	esV4 := es_v4.NewEventstoreFromOne(es_v4_pg.New(client, &es_v4_pg.Config{
 		MaxRetries: config.Eventstore.MaxRetries,
@@ -197,7 +199,11 @@ func projections(
 		config.Projections.Customizations["notifications"],
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["notifications_back_channel_logout"],
+		config.Projections.Customizations["notifications_saml_logout"],
+		config.Projections.Customizations["notification_worker"],
 		*config.Telemetry,
+		*config.Notifications,
 		config.ExternalDomain,
 		config.ExternalPort,
 		config.ExternalSecure,
@@ -209,6 +215,7 @@ func projections(
 		keys.User,
 		keys.SMTP,
 		keys.SMS,
+		keys.OIDC,
 	)
 
 	config.Auth.Spooler.Client = client
@@ -0,0 +1,87 @@
+package projections
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/cmd/encryption"
+	"github.com/zitadel/zitadel/cmd/key"
+	crypto_db "github.com/zitadel/zitadel/internal/crypto/database"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/dialect"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	old_es "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
+	new_es "github.com/zitadel/zitadel/internal/eventstore/v3"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+var instanceIDs []string
+
+func rebuildCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild [projection]",
+		Short: "rebuilds a projection without downtime",
+		Long: `rebuilds a projection without downtime
+The events are projected into shadow tables starting at position zero.
+Afterwards the shadow tables replace the tables of the projection in a single transaction,
+so ZITADEL keeps serving the current state of the projection during the rebuild.
+
+The name of the projection is the name returned by the ListViews endpoint of the system API, e.g. projections.users14`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			config := MustNewConfig(viper.GetViper())
+
+			masterKey, err := key.MasterKey(cmd)
+			logging.OnError(err).Fatal("unable to read master key")
+
+			rebuild(cmd.Context(), config, masterKey, args[0])
+		},
+	}
+
+	key.AddMasterKeyFlag(cmd)
+	cmd.Flags().StringSliceVar(&instanceIDs, "instance", nil, "id or comma separated ids of the instance(s) to rebuild, all instances are rebuilt if not set")
+
+	return cmd
+}
+
+func rebuild(ctx context.Context, config *Config, masterKey, projectionName string) {
+	start := time.Now()
+
+	client, err := database.Connect(config.Database, false, dialect.DBPurposeProjectionSpooler)
+	logging.OnError(err).Fatal("unable to connect to database")
+
+	keyStorage, err := crypto_db.NewKeyStorage(client, masterKey)
+	logging.OnError(err).Fatal("cannot start key storage")
+
+	keys, err := encryption.EnsureEncryptionKeys(ctx, config.EncryptionKeys, keyStorage)
+	logging.OnError(err).Fatal("unable to read encryption keys")
+
+	config.Eventstore.Querier = old_es.NewCRDB(client)
+	esPusherDBClient, err := database.Connect(config.Database, false, dialect.DBPurposeEventPusher)
+	logging.OnError(err).Fatal("unable to connect eventstore push client")
+	config.Eventstore.Pusher = new_es.NewEventstore(esPusherDBClient)
+	config.Eventstore.PersonalDataKeys = keyStorage
+	es := eventstore.NewEventstore(config.Eventstore)
+
+	err = projection.Create(ctx, client, es, config.Projections, keys.OIDC, keys.SAML, config.SystemAPIUsers)
+	logging.OnError(err).Fatal("unable to create projections")
+
+	err = projection.Rebuild(ctx, projectionName, instanceIDs, func(progress *handler.RebuildProgress) {
+		logging.WithFields(
+			"projection", projectionName,
+			"instance", progress.InstanceID,
+			"instanceNumber", progress.Instance,
+			"instances", progress.Instances,
+			"position", progress.Position,
+			"targetPosition", progress.TargetPosition,
+		).Info("rebuilding projection")
+	})
+	logging.WithFields("projection", projectionName).OnError(err).Fatal("rebuild failed")
+
+	logging.WithFields("projection", projectionName, "took", time.Since(start)).Info("projection rebuilt")
+}
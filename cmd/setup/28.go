@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 28.sql
+	createSnapshotsTable string
+)
+
+type EventstoreSnapshots struct {
+	dbClient *database.DB
+}
+
+func (mig *EventstoreSnapshots) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, createSnapshotsTable)
+	return err
+}
+
+func (mig *EventstoreSnapshots) String() string {
+	return "28_eventstore_snapshots"
+}
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS eventstore.snapshots (
+    instance_id TEXT NOT NULL
+    , aggregate_type TEXT NOT NULL
+    , aggregate_id TEXT NOT NULL
+    , write_model_type TEXT NOT NULL
+    , version INT2 NOT NULL
+    , "sequence" INT8 NOT NULL
+    , "position" DECIMAL NOT NULL
+    , "owner" TEXT NOT NULL
+    , change_date TIMESTAMPTZ NOT NULL
+    -- the write model is encrypted with the personal data key of the aggregate
+    , payload BYTEA NOT NULL
+    , created_at TIMESTAMPTZ NOT NULL
+
+    , PRIMARY KEY (instance_id, aggregate_type, aggregate_id, write_model_type)
+);
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 29.sql
+	createEventsArchiveTable string
+)
+
+type EventstoreArchive struct {
+	dbClient *database.DB
+}
+
+func (mig *EventstoreArchive) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, createEventsArchiveTable)
+	return err
+}
+
+func (mig *EventstoreArchive) String() string {
+	return "29_eventstore_archive"
+}
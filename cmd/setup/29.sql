@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS eventstore.events2_archive (
+    instance_id TEXT NOT NULL
+    , aggregate_type TEXT NOT NULL
+    , aggregate_id TEXT NOT NULL
+
+    , event_type TEXT NOT NULL
+    , "sequence" BIGINT NOT NULL
+    , revision SMALLINT NOT NULL
+    , created_at TIMESTAMPTZ NOT NULL
+    , payload JSONB
+    , creator TEXT NOT NULL
+    , "owner" TEXT NOT NULL
+
+    , "position" DECIMAL NOT NULL
+    , in_tx_order INTEGER NOT NULL
+
+    , PRIMARY KEY (instance_id, aggregate_type, aggregate_id, "sequence")
+);
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 30.sql
+	addLDAPSync string
+)
+
+type IDPTemplate6LDAPSync struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplate6LDAPSync) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addLDAPSync)
+	return err
+}
+
+func (mig *IDPTemplate6LDAPSync) String() string {
+	return "30_idp_templates6_add_ldap_sync"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 31.sql
+	addClaimMappings string
+)
+
+type IDPTemplate6ClaimMappings struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplate6ClaimMappings) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addClaimMappings)
+	return err
+}
+
+func (mig *IDPTemplate6ClaimMappings) String() string {
+	return "31_idp_templates6_add_claim_mappings"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 32.sql
+	addUserMapping string
+)
+
+type IDPTemplate6UserMapping struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplate6UserMapping) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addUserMapping)
+	return err
+}
+
+func (mig *IDPTemplate6UserMapping) String() string {
+	return "32_idp_templates6_add_user_mapping"
+}
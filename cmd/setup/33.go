@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 33.sql
+	addDPoPBoundAccessTokensToMachines string
+)
+
+type User12MachineDPoPBoundAccessTokens struct {
+	dbClient *database.DB
+}
+
+func (mig *User12MachineDPoPBoundAccessTokens) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addDPoPBoundAccessTokensToMachines)
+	return err
+}
+
+func (mig *User12MachineDPoPBoundAccessTokens) String() string {
+	return "33_user12_machines_add_dpop_bound_access_tokens"
+}
@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.users12_machines ADD COLUMN IF NOT EXISTS dpop_bound_access_tokens BOOLEAN DEFAULT FALSE;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 34.sql
+	removePushedAuthRequestUsedConstraints string
+)
+
+type RemovePushedAuthRequestUsedConstraints struct {
+	dbClient *database.DB
+}
+
+func (mig *RemovePushedAuthRequestUsedConstraints) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, removePushedAuthRequestUsedConstraints)
+	return err
+}
+
+func (mig *RemovePushedAuthRequestUsedConstraints) String() string {
+	return "34_remove_pushed_auth_request_used_constraints"
+}
@@ -0,0 +1 @@
+DELETE FROM eventstore.unique_constraints WHERE unique_type = 'pushed_auth_request_used';
@@ -48,6 +48,7 @@ type Config struct {
 	Login           login.Config
 	WebAuthNName    string
 	Telemetry       *handlers.TelemetryPusherConfig
+	Notifications   *handlers.NotificationWorkerConfig
 	SystemAPIUsers  map[string]*internal_authz.SystemAPIUser
 }
 
@@ -87,30 +88,37 @@ func MustNewConfig(v *viper.Viper) *Config {
 }
 
 type Steps struct {
-	s1ProjectionTable                      *ProjectionTable
-	s2AssetsTable                          *AssetTable
-	FirstInstance                          *FirstInstance
-	s5LastFailed                           *LastFailed
-	s6OwnerRemoveColumns                   *OwnerRemoveColumns
-	s7LogstoreTables                       *LogstoreTables
-	s8AuthTokens                           *AuthTokenIndexes
-	CorrectCreationDate                    *CorrectCreationDate
-	s12AddOTPColumns                       *AddOTPColumns
-	s13FixQuotaProjection                  *FixQuotaConstraints
-	s14NewEventsTable                      *NewEventsTable
-	s15CurrentStates                       *CurrentProjectionState
-	s16UniqueConstraintsLower              *UniqueConstraintToLower
-	s17AddOffsetToUniqueConstraints        *AddOffsetToCurrentStates
-	s18AddLowerFieldsToLoginNames          *AddLowerFieldsToLoginNames
-	s19AddCurrentStatesIndex               *AddCurrentSequencesIndex
-	s20AddByUserSessionIndex               *AddByUserIndexToSession
-	s21AddBlockFieldToLimits               *AddBlockFieldToLimits
-	s22ActiveInstancesIndex                *ActiveInstanceEvents
-	s23CorrectGlobalUniqueConstraints      *CorrectGlobalUniqueConstraints
-	s24AddActorToAuthTokens                *AddActorToAuthTokens
-	s25User11AddLowerFieldsToVerifiedEmail *User11AddLowerFieldsToVerifiedEmail
-	s26AuthUsers3                          *AuthUsers3
-	s27IDPTemplate6SAMLNameIDFormat        *IDPTemplate6SAMLNameIDFormat
+	s1ProjectionTable                         *ProjectionTable
+	s2AssetsTable                             *AssetTable
+	FirstInstance                             *FirstInstance
+	s5LastFailed                              *LastFailed
+	s6OwnerRemoveColumns                      *OwnerRemoveColumns
+	s7LogstoreTables                          *LogstoreTables
+	s8AuthTokens                              *AuthTokenIndexes
+	CorrectCreationDate                       *CorrectCreationDate
+	s12AddOTPColumns                          *AddOTPColumns
+	s13FixQuotaProjection                     *FixQuotaConstraints
+	s14NewEventsTable                         *NewEventsTable
+	s15CurrentStates                          *CurrentProjectionState
+	s16UniqueConstraintsLower                 *UniqueConstraintToLower
+	s17AddOffsetToUniqueConstraints           *AddOffsetToCurrentStates
+	s18AddLowerFieldsToLoginNames             *AddLowerFieldsToLoginNames
+	s19AddCurrentStatesIndex                  *AddCurrentSequencesIndex
+	s20AddByUserSessionIndex                  *AddByUserIndexToSession
+	s21AddBlockFieldToLimits                  *AddBlockFieldToLimits
+	s22ActiveInstancesIndex                   *ActiveInstanceEvents
+	s23CorrectGlobalUniqueConstraints         *CorrectGlobalUniqueConstraints
+	s24AddActorToAuthTokens                   *AddActorToAuthTokens
+	s25User11AddLowerFieldsToVerifiedEmail    *User11AddLowerFieldsToVerifiedEmail
+	s26AuthUsers3                             *AuthUsers3
+	s27IDPTemplate6SAMLNameIDFormat           *IDPTemplate6SAMLNameIDFormat
+	s28EventstoreSnapshots                    *EventstoreSnapshots
+	s29EventstoreArchive                      *EventstoreArchive
+	s30IDPTemplate6LDAPSync                   *IDPTemplate6LDAPSync
+	s31IDPTemplate6ClaimMappings              *IDPTemplate6ClaimMappings
+	s32IDPTemplate6UserMapping                *IDPTemplate6UserMapping
+	s33User12MachineDPoPBoundAccessTokens     *User12MachineDPoPBoundAccessTokens
+	s34RemovePushedAuthRequestUsedConstraints *RemovePushedAuthRequestUsedConstraints
 }
 
 func MustNewSteps(v *viper.Viper) *Steps {
@@ -109,6 +109,10 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 
 	config.Eventstore.Querier = old_es.NewCRDB(queryDBClient)
 	config.Eventstore.Pusher = new_es.NewEventstore(esPusherDBClient)
+	if config.Eventstore.PersonalData.Enabled {
+		config.Eventstore.PersonalDataKeys, err = cryptoDB.NewKeyStorage(queryDBClient, masterKey)
+		logging.OnError(err).Fatal("unable to start key storage")
+	}
 	eventstoreClient := eventstore.NewEventstore(config.Eventstore)
 	logging.OnError(err).Fatal("unable to start eventstore")
 	eventstoreV4 := es_v4.NewEventstoreFromOne(es_v4_pg.New(queryDBClient, &es_v4_pg.Config{
@@ -153,6 +157,13 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	steps.s25User11AddLowerFieldsToVerifiedEmail = &User11AddLowerFieldsToVerifiedEmail{dbClient: esPusherDBClient}
 	steps.s26AuthUsers3 = &AuthUsers3{dbClient: esPusherDBClient}
 	steps.s27IDPTemplate6SAMLNameIDFormat = &IDPTemplate6SAMLNameIDFormat{dbClient: esPusherDBClient}
+	steps.s28EventstoreSnapshots = &EventstoreSnapshots{dbClient: esPusherDBClient}
+	steps.s29EventstoreArchive = &EventstoreArchive{dbClient: esPusherDBClient}
+	steps.s30IDPTemplate6LDAPSync = &IDPTemplate6LDAPSync{dbClient: esPusherDBClient}
+	steps.s31IDPTemplate6ClaimMappings = &IDPTemplate6ClaimMappings{dbClient: esPusherDBClient}
+	steps.s32IDPTemplate6UserMapping = &IDPTemplate6UserMapping{dbClient: esPusherDBClient}
+	steps.s33User12MachineDPoPBoundAccessTokens = &User12MachineDPoPBoundAccessTokens{dbClient: esPusherDBClient}
+	steps.s34RemovePushedAuthRequestUsedConstraints = &RemovePushedAuthRequestUsedConstraints{dbClient: esPusherDBClient}
 
 	err = projection.Create(ctx, projectionDBClient, eventstoreClient, config.Projections, nil, nil, nil)
 	logging.OnError(err).Fatal("unable to start projections")
@@ -191,6 +202,8 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s23CorrectGlobalUniqueConstraints,
 		steps.s24AddActorToAuthTokens,
 		steps.s26AuthUsers3,
+		steps.s28EventstoreSnapshots,
+		steps.s29EventstoreArchive,
 	} {
 		mustExecuteMigration(ctx, eventstoreClient, step, "migration failed")
 	}
@@ -205,6 +218,11 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s21AddBlockFieldToLimits,
 		steps.s25User11AddLowerFieldsToVerifiedEmail,
 		steps.s27IDPTemplate6SAMLNameIDFormat,
+		steps.s30IDPTemplate6LDAPSync,
+		steps.s31IDPTemplate6ClaimMappings,
+		steps.s32IDPTemplate6UserMapping,
+		steps.s33User12MachineDPoPBoundAccessTokens,
+		steps.s34RemovePushedAuthRequestUsedConstraints,
 	} {
 		mustExecuteMigration(ctx, eventstoreClient, step, "migration failed")
 	}
@@ -371,7 +389,11 @@ func initProjections(
 		config.Projections.Customizations["notifications"],
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["notifications_back_channel_logout"],
+		config.Projections.Customizations["notifications_saml_logout"],
+		config.Projections.Customizations["notification_worker"],
 		*config.Telemetry,
+		*config.Notifications,
 		config.ExternalDomain,
 		config.ExternalPort,
 		config.ExternalSecure,
@@ -383,6 +405,7 @@ func initProjections(
 		keys.User,
 		keys.SMTP,
 		keys.SMS,
+		keys.OIDC,
 	)
 	for _, p := range notify_handler.Projections() {
 		err := migration.Migrate(ctx, eventstoreClient, p)
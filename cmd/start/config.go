@@ -15,6 +15,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
 	"github.com/zitadel/zitadel/internal/api/oidc"
 	"github.com/zitadel/zitadel/internal/api/saml"
+	"github.com/zitadel/zitadel/internal/api/scim"
 	"github.com/zitadel/zitadel/internal/api/ui/console"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	auth_es "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing"
@@ -25,9 +26,13 @@ import (
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/retention"
+	"github.com/zitadel/zitadel/internal/exporter"
 	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/idp/ldapsync"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
+	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	static_config "github.com/zitadel/zitadel/internal/static/config"
 	metrics "github.com/zitadel/zitadel/internal/telemetry/metrics/config"
@@ -45,6 +50,7 @@ type Config struct {
 	HTTP1HostHeader   string
 	WebAuthNName      string
 	Database          database.Config
+	ReadReplica       *database.ReplicaConfig
 	Tracing           tracing.Config
 	Metrics           metrics.Config
 	Projections       projection.Config
@@ -53,6 +59,7 @@ type Config struct {
 	UserAgentCookie   *middleware.UserAgentCookieConfig
 	OIDC              oidc.Config
 	SAML              saml.Config
+	SCIM              scim.Config
 	Login             login.Config
 	Console           console.Config
 	AssetStorage      static_config.AssetStorageConfig
@@ -61,6 +68,7 @@ type Config struct {
 	EncryptionKeys    *encryption.EncryptionKeyConfig
 	DefaultInstance   command.InstanceSetup
 	AuditLogRetention time.Duration
+	EventWatch        query.EventWatchConfig
 	SystemAPIUsers    map[string]*internal_authz.SystemAPIUser
 	CustomerPortal    string
 	Machine           *id.Config
@@ -69,6 +77,10 @@ type Config struct {
 	LogStore          *logstore.Configs
 	Quotas            *QuotasConfig
 	Telemetry         *handlers.TelemetryPusherConfig
+	Notifications     *handlers.NotificationWorkerConfig
+	Exporter          *exporter.Config
+	Retention         *retention.Config
+	LDAPSync          *ldapsync.Config
 }
 
 type QuotasConfig struct {
@@ -34,6 +34,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api"
 	"github.com/zitadel/zitadel/internal/api/assets"
 	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/events"
 	action_v3_alpha "github.com/zitadel/zitadel/internal/api/grpc/action/v3alpha"
 	"github.com/zitadel/zitadel/internal/api/grpc/admin"
 	"github.com/zitadel/zitadel/internal/api/grpc/auth"
@@ -46,12 +47,14 @@ import (
 	"github.com/zitadel/zitadel/internal/api/grpc/system"
 	user_schema_v3_alpha "github.com/zitadel/zitadel/internal/api/grpc/user/schema/v3alpha"
 	user_v2 "github.com/zitadel/zitadel/internal/api/grpc/user/v2"
+	user_v3_alpha "github.com/zitadel/zitadel/internal/api/grpc/user/v3alpha"
 	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
 	"github.com/zitadel/zitadel/internal/api/idp"
 	"github.com/zitadel/zitadel/internal/api/oidc"
 	"github.com/zitadel/zitadel/internal/api/robots_txt"
 	"github.com/zitadel/zitadel/internal/api/saml"
+	"github.com/zitadel/zitadel/internal/api/scim"
 	"github.com/zitadel/zitadel/internal/api/ui/console"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	auth_es "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing"
@@ -66,9 +69,12 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_es "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
+	"github.com/zitadel/zitadel/internal/eventstore/retention"
 	new_es "github.com/zitadel/zitadel/internal/eventstore/v3"
+	"github.com/zitadel/zitadel/internal/exporter"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/idp/ldapsync"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/access"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/execution"
@@ -77,7 +83,9 @@ import (
 	"github.com/zitadel/zitadel/internal/net"
 	"github.com/zitadel/zitadel/internal/notification"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/transfer"
 	es_v4 "github.com/zitadel/zitadel/internal/v2/eventstore"
 	es_v4_pg "github.com/zitadel/zitadel/internal/v2/eventstore/postgres"
 	"github.com/zitadel/zitadel/internal/webauthn"
@@ -152,8 +160,11 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		return err
 	}
 
-	config.Eventstore.Pusher = new_es.NewEventstore(esPusherDBClient)
+	eventstorePusher := new_es.NewEventstore(esPusherDBClient)
+	config.Eventstore.Pusher = eventstorePusher
 	config.Eventstore.Querier = old_es.NewCRDB(queryDBClient)
+	config.Eventstore.SnapshotStore = eventstorePusher
+	config.Eventstore.PersonalDataKeys = keyStorage
 	eventstoreClient := eventstore.NewEventstore(config.Eventstore)
 	eventstoreV4 := es_v4.NewEventstoreFromOne(es_v4_pg.New(queryDBClient, &es_v4_pg.Config{
 		MaxRetries: config.Eventstore.MaxRetries,
@@ -161,11 +172,23 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 
 	sessionTokenVerifier := internal_authz.SessionTokenVerifier(keys.OIDC)
 
+	queriesDBClient := queryDBClient
+	if config.ReadReplica.Enabled {
+		replicaDBClient, err := database.Connect(config.ReadReplica.Database, false, dialect.DBPurposeQuery)
+		if err != nil {
+			return fmt.Errorf("cannot start read replica client: %w", err)
+		}
+		queriesDBClient, err = queryDBClient.WithReplica(replicaDBClient, config.ReadReplica)
+		if err != nil {
+			return fmt.Errorf("cannot start read replica client: %w", err)
+		}
+	}
+
 	queries, err := query.StartQueries(
 		ctx,
 		eventstoreClient,
 		eventstoreV4.Querier,
-		queryDBClient,
+		queriesDBClient,
 		projectionDBClient,
 		config.Projections,
 		config.SystemDefaults,
@@ -252,7 +275,11 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		config.Projections.Customizations["notifications"],
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["notifications_back_channel_logout"],
+		config.Projections.Customizations["notifications_saml_logout"],
+		config.Projections.Customizations["notification_worker"],
 		*config.Telemetry,
+		*config.Notifications,
 		config.ExternalDomain,
 		config.ExternalPort,
 		config.ExternalSecure,
@@ -264,9 +291,37 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		keys.User,
 		keys.SMTP,
 		keys.SMS,
+		keys.OIDC,
 	)
 	notification.Start(ctx)
 
+	if config.Exporter.Enabled {
+		eventExporter, err := exporter.NewExporter(
+			ctx,
+			*config.Exporter,
+			projection.ApplyCustomConfig(config.Projections.Customizations["event_exporter"]),
+			eventstoreClient.EventTypes(),
+		)
+		if err != nil {
+			return fmt.Errorf("cannot start event exporter: %w", err)
+		}
+		if err = eventExporter.Start(ctx); err != nil {
+			return fmt.Errorf("cannot start event exporter: %w", err)
+		}
+	}
+
+	if config.Retention.Enabled {
+		eventRetention, err := retention.New(esPusherDBClient, config.Retention)
+		if err != nil {
+			return fmt.Errorf("cannot start eventstore retention: %w", err)
+		}
+		eventRetention.Start(ctx)
+	}
+
+	if config.LDAPSync.Enabled {
+		ldapsync.New(queries, commands, queryDBClient, config.LDAPSync).Start(ctx)
+	}
+
 	router := mux.NewRouter()
 	tlsConfig, err := config.TLS.Config()
 	if err != nil {
@@ -340,6 +395,8 @@ func startAPIs(
 	oidcPrefixes := []string{"/.well-known/openid-configuration", "/oidc/v1", "/oauth/v2"}
 	// always set the origin in the context if available in the http headers, no matter for what protocol
 	router.Use(middleware.WithOrigin(config.ExternalSecure))
+	// reads after a push must reflect the pushed events, even if they are executed on a read replica
+	router.Use(middleware.ConsistencyHandler)
 	systemTokenVerifier, err := internal_authz.StartSystemTokenVerifierFromConfig(http_util.BuildHTTP(config.ExternalDomain, config.ExternalPort, config.ExternalSecure), config.SystemAPIUsers)
 	if err != nil {
 		return nil, err
@@ -386,10 +443,10 @@ func startAPIs(
 		return nil, fmt.Errorf("error starting admin repo: %w", err)
 	}
 
-	if err := apis.RegisterServer(ctx, system.CreateServer(commands, queries, config.Database.DatabaseName(), config.DefaultInstance, config.ExternalDomain), tlsConfig); err != nil {
+	if err := apis.RegisterServer(ctx, system.CreateServer(commands, queries, config.Database.DatabaseName(), config.DefaultInstance, config.ExternalDomain, transfer.New(dbClient, eventstore.PersonalData())), tlsConfig); err != nil {
 		return nil, err
 	}
-	if err := apis.RegisterServer(ctx, admin.CreateServer(config.Database.DatabaseName(), commands, queries, config.SystemDefaults, config.ExternalSecure, keys.User, config.AuditLogRetention), tlsConfig); err != nil {
+	if err := apis.RegisterServer(ctx, admin.CreateServer(config.Database.DatabaseName(), commands, queries, config.SystemDefaults, config.ExternalSecure, keys.User, config.AuditLogRetention, config.EventWatch), tlsConfig); err != nil {
 		return nil, err
 	}
 	if err := apis.RegisterServer(ctx, management.CreateServer(commands, queries, config.SystemDefaults, keys.User, config.ExternalSecure), tlsConfig); err != nil {
@@ -420,6 +477,9 @@ func startAPIs(
 	if err := apis.RegisterService(ctx, user_schema_v3_alpha.CreateServer(commands, queries)); err != nil {
 		return nil, err
 	}
+	if err := apis.RegisterService(ctx, user_v3_alpha.CreateServer(commands, queries)); err != nil {
+		return nil, err
+	}
 	instanceInterceptor := middleware.InstanceInterceptor(queries, config.HTTP1HostHeader, config.ExternalDomain, login.IgnoreInstanceEndpoints...)
 	assetsCache := middleware.AssetsCacheInterceptor(config.AssetStorage.Cache.MaxAge, config.AssetStorage.Cache.SharedMaxAge)
 	apis.RegisterHandlerOnPrefix(assets.HandlerPrefix, assets.NewHandler(commands, verifier, config.InternalAuthZ, id.SonyFlakeGenerator(), store, queries, middleware.CallDurationHandler, instanceInterceptor.Handler, assetsCache.Handler, limitingAccessInterceptor.Handle))
@@ -456,6 +516,8 @@ func startAPIs(
 		return nil, fmt.Errorf("unable to start saml provider: %w", err)
 	}
 	apis.RegisterHandlerOnPrefix(saml.HandlerPrefix, samlProvider.HttpHandler())
+	apis.RegisterHandlerOnPrefix(scim.HandlerPrefix, scim.NewHandler(config.SCIM, commands, queries, verifier, config.InternalAuthZ, keys.User, config.ExternalSecure, middleware.CallDurationHandler, instanceInterceptor.Handler, limitingAccessInterceptor.Handle))
+	apis.RegisterHandlerOnPrefix(events.HandlerPrefix, events.NewHandler(queries, config.EventWatch, verifier, config.InternalAuthZ, middleware.CallDurationHandler, instanceInterceptor.Handler, limitingAccessInterceptor.Handle))
 
 	c, err := console.Start(config.Console, config.ExternalSecure, oidcServer.IssuerFromRequest, middleware.CallDurationHandler, instanceInterceptor.Handler, limitingAccessInterceptor, config.CustomerPortal)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/zitadel/cmd/key"
 	"github.com/zitadel/zitadel/cmd/setup"
 	"github.com/zitadel/zitadel/cmd/tls"
+	"github.com/zitadel/zitadel/internal/database/embedded"
 )
 
 func NewStartFromInit(server chan<- *Server) *cobra.Command {
@@ -21,11 +22,14 @@ Second the initial events are created.
 Last ZITADEL starts.
 
 Requirements:
-- cockroachdb`,
+- cockroachdb, postgres or the flag --embedded`,
 		Run: func(cmd *cobra.Command, args []string) {
 			err := tls.ModeFromFlag(cmd)
 			logging.OnError(err).Fatal("invalid tlsMode")
 
+			err = embeddedFromFlag(cmd)
+			logging.OnError(err).Fatal("invalid embedded flag")
+
 			masterKey, err := key.MasterKey(cmd)
 			logging.OnError(err).Panic("No master key provided")
 
@@ -47,6 +51,19 @@ Requirements:
 
 	startFlags(cmd)
 	setup.Flags(cmd)
+	cmd.Flags().Bool("embedded", false, "runs a PostgreSQL server downloaded on the first start, configure it in the section Database.Embedded")
 
 	return cmd
 }
+
+// embeddedFromFlag selects the embedded database if the flag is set
+func embeddedFromFlag(cmd *cobra.Command) error {
+	enabled, err := cmd.Flags().GetBool("embedded")
+	if err != nil || !enabled {
+		return err
+	}
+	if !viper.IsSet("Database.Embedded.DataPath") {
+		viper.Set("Database.Embedded.DataPath", embedded.DefaultDataPath)
+	}
+	return nil
+}
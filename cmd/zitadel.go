@@ -14,11 +14,14 @@ import (
 	"github.com/zitadel/zitadel/cmd/admin"
 	"github.com/zitadel/zitadel/cmd/build"
 	"github.com/zitadel/zitadel/cmd/initialise"
+	"github.com/zitadel/zitadel/cmd/instance"
 	"github.com/zitadel/zitadel/cmd/key"
 	"github.com/zitadel/zitadel/cmd/mirror"
+	"github.com/zitadel/zitadel/cmd/projections"
 	"github.com/zitadel/zitadel/cmd/ready"
 	"github.com/zitadel/zitadel/cmd/setup"
 	"github.com/zitadel/zitadel/cmd/start"
+	"github.com/zitadel/zitadel/internal/database/embedded"
 )
 
 var (
@@ -36,6 +39,10 @@ func New(out io.Writer, in io.Reader, args []string, server chan<- *start.Server
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return errors.New("no additional command provided")
 		},
+		// the embedded database is started by the first connection of a command
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			embedded.Stop()
+		},
 		Version: build.Version(),
 	}
 
@@ -57,6 +64,8 @@ func New(out io.Writer, in io.Reader, args []string, server chan<- *start.Server
 		start.NewStartFromInit(server),
 		start.NewStartFromSetup(server),
 		mirror.New(),
+		projections.New(),
+		instance.New(),
 		key.New(),
 		ready.New(),
 	)
@@ -22,6 +22,8 @@ const (
 	dataKey               key = 2
 	allPermissionsKey     key = 3
 	instanceKey           key = 4
+	dpopRequestKey        key = 5
+	dpopThumbprintKey     key = 6
 )
 
 type CtxData struct {
@@ -99,10 +101,16 @@ func (s SystemTokenVerifierFunc) VerifySystemToken(ctx context.Context, token st
 func VerifyTokenAndCreateCtxData(ctx context.Context, token, orgID, orgDomain string, t APITokenVerifier) (_ CtxData, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
-	tokenWOBearer, err := extractBearerToken(token)
+	tokenWOBearer, isDPoP, err := extractAccessToken(token)
 	if err != nil {
 		return CtxData{}, err
 	}
+	if isDPoP {
+		ctx, err = verifyDPoPRequest(ctx, tokenWOBearer)
+		if err != nil {
+			return CtxData{}, err
+		}
+	}
 	userID, clientID, agentID, prefLang, resourceOwner, err := t.VerifyAccessToken(ctx, tokenWOBearer)
 	var sysMemberships Memberships
 	if err != nil && !zerrors.IsUnauthenticated(err) {
@@ -179,6 +187,16 @@ func checkOrigin(ctx context.Context, origins []string) error {
 	return zerrors.ThrowPermissionDenied(nil, "AUTH-DZG21", "Errors.OriginNotAllowed")
 }
 
+// extractAccessToken returns the access token of the authorization header
+// and whether it was presented using the DPoP scheme.
+func extractAccessToken(token string) (part string, isDPoP bool, err error) {
+	if part, ok := strings.CutPrefix(token, DPoPPrefix); ok {
+		return part, true, nil
+	}
+	part, err = extractBearerToken(token)
+	return part, false, err
+}
+
 func extractBearerToken(token string) (part string, err error) {
 	parts := strings.Split(token, BearerPrefix)
 	if len(parts) != 2 {
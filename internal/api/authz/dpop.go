@@ -0,0 +1,206 @@
+package authz
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	DPoPPrefix    = "DPoP "
+	DPoPTokenType = "DPoP"
+
+	dpopProofType      = "dpop+jwt"
+	dpopProofLifetime  = 5 * time.Minute
+	dpopProofClockSkew = 30 * time.Second
+)
+
+// usedDPoPProofs remembers the proofs, which were already presented,
+// so a captured proof cannot be replayed within its lifetime (RFC 9449, section 11.1).
+// The proofs are remembered in the memory of the process.
+var usedDPoPProofs = &dpopProofCache{used: make(map[string]time.Time)}
+
+var dpopSignatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.EdDSA,
+}
+
+// DPoPSigningAlgorithms returns the JWS algorithms supported for DPoP proofs (RFC 9449, section 5.1)
+func DPoPSigningAlgorithms() []string {
+	algorithms := make([]string, len(dpopSignatureAlgorithms))
+	for i, algorithm := range dpopSignatureAlgorithms {
+		algorithms[i] = string(algorithm)
+	}
+	return algorithms
+}
+
+// DPoPRequest is the HTTP request a DPoP proof (RFC 9449) was sent with
+type DPoPRequest struct {
+	Proof  string
+	Method string
+	URL    string
+}
+
+// WithDPoPRequest stores the DPoP proof and the HTTP request it was sent with,
+// so it can be verified together with a DPoP bound access token.
+func WithDPoPRequest(ctx context.Context, proof, method, url string) context.Context {
+	if proof == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, dpopRequestKey, &DPoPRequest{Proof: proof, Method: method, URL: url})
+}
+
+// WithDPoPRequestFromHTTP stores the DPoP proof of the HTTP request.
+// The URL is composed of the origin and the unmodified request URI,
+// so handlers registered on a stripped prefix are verified against the URL the client called.
+func WithDPoPRequestFromHTTP(ctx context.Context, r *http.Request) context.Context {
+	return WithDPoPRequest(ctx, r.Header.Get(http_util.DPoP), r.Method, http_util.ComposedOrigin(ctx)+r.RequestURI)
+}
+
+func dpopRequestFromCtx(ctx context.Context) *DPoPRequest {
+	request, _ := ctx.Value(dpopRequestKey).(*DPoPRequest)
+	return request
+}
+
+// DPoPThumbprintFromCtx returns the JWK thumbprint of the verified DPoP proof,
+// which was presented with the access token.
+// An empty string is returned if the access token was presented as bearer token.
+func DPoPThumbprintFromCtx(ctx context.Context) string {
+	thumbprint, _ := ctx.Value(dpopThumbprintKey).(string)
+	return thumbprint
+}
+
+// verifyDPoPRequest verifies the DPoP proof of the request for the presented access token
+// and stores the thumbprint of its key in the context.
+func verifyDPoPRequest(ctx context.Context, accessToken string) (context.Context, error) {
+	request := dpopRequestFromCtx(ctx)
+	if request == nil {
+		return nil, zerrors.ThrowUnauthenticated(nil, "AUTH-Ahng7", "Errors.Token.Invalid")
+	}
+	thumbprint, err := VerifyDPoPProof(request.Proof, request.Method, request.URL, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, dpopThumbprintKey, thumbprint), nil
+}
+
+type dpopProofClaims struct {
+	JWTID           string    `json:"jti"`
+	Method          string    `json:"htm"`
+	URL             string    `json:"htu"`
+	IssuedAt        oidc.Time `json:"iat"`
+	AccessTokenHash string    `json:"ath,omitempty"`
+}
+
+// VerifyDPoPProof verifies the DPoP proof JWT as defined in RFC 9449, section 4.3,
+// for the method and url of the HTTP request it was sent with.
+// If an access token is provided, the proof must contain its hash (ath).
+// It returns the base64url encoded SHA-256 JWK thumbprint (jkt) of the key used to sign the proof.
+func VerifyDPoPProof(proof, method, requestURL, accessToken string) (jkt string, err error) {
+	jws, err := jose.ParseSigned(proof, dpopSignatureAlgorithms)
+	if err != nil {
+		return "", zerrors.ThrowUnauthenticated(err, "AUTH-Eeth4", "Errors.Token.Invalid")
+	}
+	if len(jws.Signatures) != 1 {
+		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-iu2Ai", "Errors.Token.Invalid")
+	}
+	header := jws.Signatures[0].Protected
+	if typ, _ := header.ExtraHeaders[jose.HeaderType].(string); typ != dpopProofType {
+		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-ooR4u", "Errors.Token.Invalid")
+	}
+	if header.JSONWebKey == nil || !header.JSONWebKey.IsPublic() || !header.JSONWebKey.Valid() {
+		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-Phoh5", "Errors.Token.Invalid")
+	}
+	payload, err := jws.Verify(header.JSONWebKey)
+	if err != nil {
+		return "", zerrors.ThrowUnauthenticated(err, "AUTH-Zoo9a", "Errors.Token.Invalid")
+	}
+	claims := new(dpopProofClaims)
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return "", zerrors.ThrowUnauthenticated(err, "AUTH-aeT8o", "Errors.Token.Invalid")
+	}
+	if claims.JWTID == "" || claims.Method != method || !equalHTTPURI(claims.URL, requestURL) {
+		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-Yie3e", "Errors.Token.Invalid")
+	}
+	issuedAt := claims.IssuedAt.AsTime()
+	now := time.Now()
+	if issuedAt.Before(now.Add(-dpopProofLifetime)) || issuedAt.After(now.Add(dpopProofClockSkew)) {
+		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-ieL7o", "Errors.Token.Invalid")
+	}
+	if accessToken != "" && claims.AccessTokenHash != DPoPAccessTokenHash(accessToken) {
+		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-Ju5ae", "Errors.Token.Invalid")
+	}
+	thumbprint, err := header.JSONWebKey.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", zerrors.ThrowUnauthenticated(err, "AUTH-ahM3i", "Errors.Token.Invalid")
+	}
+	jkt = base64.RawURLEncoding.EncodeToString(thumbprint)
+	if !usedDPoPProofs.use(jkt+":"+claims.JWTID, issuedAt.Add(dpopProofLifetime), now) {
+		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-Oos4e", "Errors.Token.Invalid")
+	}
+	return jkt, nil
+}
+
+// dpopProofCache stores the jti of every accepted proof until its iat is outside the accepted window.
+// Expired entries are removed at most once per proof lifetime, so the cache is bounded by the proofs of that window.
+type dpopProofCache struct {
+	mu        sync.Mutex
+	used      map[string]time.Time
+	nextPrune time.Time
+}
+
+// use marks the proof as used and returns false if it was already used before.
+func (c *dpopProofCache) use(key string, expiration, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now.After(c.nextPrune) {
+		for usedKey, usedExpiration := range c.used {
+			if now.After(usedExpiration) {
+				delete(c.used, usedKey)
+			}
+		}
+		c.nextPrune = now.Add(dpopProofLifetime)
+	}
+	if usedExpiration, ok := c.used[key]; ok && !now.After(usedExpiration) {
+		return false
+	}
+	c.used[key] = expiration
+	return true
+}
+
+// DPoPAccessTokenHash returns the base64url encoded SHA-256 hash of the access token (ath)
+func DPoPAccessTokenHash(accessToken string) string {
+	hash := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// equalHTTPURI compares the htu claim with the request URL
+// ignoring any query and fragment as well as the case of the scheme and host (RFC 9449, section 4.3)
+func equalHTTPURI(htu, requestURL string) bool {
+	claimed, err := url.Parse(htu)
+	if err != nil {
+		return false
+	}
+	requested, err := url.Parse(requestURL)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(claimed.Scheme, requested.Scheme) &&
+		strings.EqualFold(claimed.Host, requested.Host) &&
+		claimed.EscapedPath() == requested.EscapedPath()
+}
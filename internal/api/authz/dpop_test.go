@@ -0,0 +1,231 @@
+package authz
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	dpopTestURL         = "https://issuer.zitadel.ch/oauth/v2/token"
+	dpopTestAccessToken = "accessToken"
+)
+
+func TestVerifyDPoPProof(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKey := &jose.JSONWebKey{Key: &key.PublicKey}
+	thumbprint, err := publicKey.Thumbprint(crypto.SHA256)
+	require.NoError(t, err)
+	jkt := base64.RawURLEncoding.EncodeToString(thumbprint)
+
+	type args struct {
+		typ         string
+		claims      map[string]any
+		method      string
+		requestURL  string
+		accessToken string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "wrong type",
+			args: args{
+				typ:        "JWT",
+				claims:     dpopTestClaims("POST", dpopTestURL, time.Now(), ""),
+				method:     "POST",
+				requestURL: dpopTestURL,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong method",
+			args: args{
+				typ:        dpopProofType,
+				claims:     dpopTestClaims("GET", dpopTestURL, time.Now(), ""),
+				method:     "POST",
+				requestURL: dpopTestURL,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong url",
+			args: args{
+				typ:        dpopProofType,
+				claims:     dpopTestClaims("POST", "https://other.zitadel.ch/oauth/v2/token", time.Now(), ""),
+				method:     "POST",
+				requestURL: dpopTestURL,
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			args: args{
+				typ:        dpopProofType,
+				claims:     dpopTestClaims("POST", dpopTestURL, time.Now().Add(-time.Hour), ""),
+				method:     "POST",
+				requestURL: dpopTestURL,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing access token hash",
+			args: args{
+				typ:         dpopProofType,
+				claims:      dpopTestClaims("POST", dpopTestURL, time.Now(), ""),
+				method:      "POST",
+				requestURL:  dpopTestURL,
+				accessToken: dpopTestAccessToken,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			args: args{
+				typ:        dpopProofType,
+				claims:     dpopTestClaims("POST", dpopTestURL, time.Now(), ""),
+				method:     "POST",
+				requestURL: dpopTestURL,
+			},
+			want: jkt,
+		},
+		{
+			name: "valid, ignoring query and case of host",
+			args: args{
+				typ:        dpopProofType,
+				claims:     dpopTestClaims("POST", "https://ISSUER.zitadel.ch/oauth/v2/token", time.Now(), ""),
+				method:     "POST",
+				requestURL: dpopTestURL + "?foo=bar",
+			},
+			want: jkt,
+		},
+		{
+			name: "valid with access token",
+			args: args{
+				typ:         dpopProofType,
+				claims:      dpopTestClaims("GET", dpopTestURL, time.Now(), DPoPAccessTokenHash(dpopTestAccessToken)),
+				method:      "GET",
+				requestURL:  dpopTestURL,
+				accessToken: dpopTestAccessToken,
+			},
+			want: jkt,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.claims["jti"] = t.Name()
+			proof := signDPoPTestProof(t, key, tt.args.typ, tt.args.claims)
+			got, err := VerifyDPoPProof(proof, tt.args.method, tt.args.requestURL, tt.args.accessToken)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVerifyDPoPProof_replay(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	proof := signDPoPTestProof(t, key, dpopProofType, dpopTestClaims("POST", dpopTestURL, time.Now(), ""))
+
+	_, err = VerifyDPoPProof(proof, "POST", dpopTestURL, "")
+	require.NoError(t, err)
+	_, err = VerifyDPoPProof(proof, "POST", dpopTestURL, "")
+	assert.Error(t, err)
+}
+
+func Test_dpopProofCache_use(t *testing.T) {
+	now := time.Now()
+	cache := &dpopProofCache{used: make(map[string]time.Time)}
+
+	assert.True(t, cache.use("jkt:id", now.Add(time.Minute), now))
+	assert.False(t, cache.use("jkt:id", now.Add(time.Minute), now.Add(time.Second)))
+	assert.True(t, cache.use("jkt:other", now.Add(time.Minute), now.Add(time.Second)))
+
+	later := now.Add(dpopProofLifetime + time.Second)
+	assert.True(t, cache.use("jkt:id", later.Add(time.Minute), later))
+	assert.Len(t, cache.used, 1)
+}
+
+func Test_extractAccessToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      string
+		wantPart   string
+		wantIsDPoP bool
+		wantErr    bool
+	}{
+		{
+			name:    "basic",
+			token:   "Basic sds",
+			wantErr: true,
+		},
+		{
+			name:     "bearer",
+			token:    "Bearer AUTH",
+			wantPart: "AUTH",
+		},
+		{
+			name:       "dpop",
+			token:      "DPoP AUTH",
+			wantPart:   "AUTH",
+			wantIsDPoP: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			part, isDPoP, err := extractAccessToken(tt.token)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPart, part)
+			assert.Equal(t, tt.wantIsDPoP, isDPoP)
+		})
+	}
+}
+
+func dpopTestClaims(method, url string, issuedAt time.Time, accessTokenHash string) map[string]any {
+	claims := map[string]any{
+		"jti": "id",
+		"htm": method,
+		"htu": url,
+		"iat": issuedAt.Unix(),
+	}
+	if accessTokenHash != "" {
+		claims["ath"] = accessTokenHash
+	}
+	return claims
+}
+
+func signDPoPTestProof(t *testing.T, key *ecdsa.PrivateKey, typ string, claims map[string]any) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: key},
+		(&jose.SignerOptions{EmbedJWK: true}).WithType(jose.ContentType(typ)),
+	)
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	proof, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return proof
+}
@@ -0,0 +1,227 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HandlerPrefix = "/events"
+
+	watchPath = "/watch"
+
+	contentTypeEventStream = "text/event-stream"
+	headerLastEventID      = "Last-Event-ID"
+
+	paramCursor        = "cursor"
+	paramEventType     = "event_type"
+	paramAggregateType = "aggregate_type"
+	paramAggregateID   = "aggregate_id"
+	paramResourceOwner = "resource_owner"
+
+	permissionEventsRead = "events.read"
+)
+
+// Queries are the queries used to read the events
+type Queries interface {
+	WatchEvents(ctx context.Context, config query.EventWatchConfig, query *eventstore.SearchQueryBuilder, cursor query.EventCursor, send func(event *query.Event, cursor query.EventCursor) error) error
+}
+
+type handler struct {
+	query      Queries
+	config     query.EventWatchConfig
+	verifier   authz.APITokenVerifier
+	authConfig authz.Config
+}
+
+// event is the data of a single server-sent event
+type event struct {
+	Aggregate    aggregate       `json:"aggregate"`
+	Editor       editor          `json:"editor"`
+	Sequence     uint64          `json:"sequence"`
+	Position     float64         `json:"position"`
+	CreationDate time.Time       `json:"creationDate"`
+	Type         string          `json:"type"`
+	Payload      json.RawMessage `json:"payload,omitempty"`
+}
+
+type aggregate struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	ResourceOwner string `json:"resourceOwner"`
+}
+
+type editor struct {
+	UserID      string `json:"userId,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	Service     string `json:"service,omitempty"`
+}
+
+// NewHandler returns the server-sent events (SSE) fallback of the admin API WatchEvents stream.
+// It accepts the same filters as query parameters and resumes from the cursor
+// of the Last-Event-ID header, which browsers send on reconnect, or the cursor parameter.
+func NewHandler(
+	queries Queries,
+	config query.EventWatchConfig,
+	verifier authz.APITokenVerifier,
+	authConfig authz.Config,
+	interceptors ...mux.MiddlewareFunc,
+) http.Handler {
+	h := &handler{
+		query:      queries,
+		config:     config,
+		verifier:   verifier,
+		authConfig: authConfig,
+	}
+
+	router := mux.NewRouter()
+	router.Use(interceptors...)
+	router.HandleFunc(watchPath, h.watch).Methods(http.MethodGet)
+	return router
+}
+
+func (h *handler) watch(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	ctx, err := h.authorize(r)
+	if err != nil {
+		writeError(w, err, http.StatusUnauthorized)
+		return
+	}
+	cursor, err := cursorFromRequest(r)
+	if err != nil {
+		writeError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentTypeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	err = h.query.WatchEvents(ctx, h.config, watchQuery(ctx, r), cursor, func(e *query.Event, cursor query.EventCursor) error {
+		if err := writeEvent(w, e, cursor); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+	// the stream always ends with an error, the client closing the connection is the regular case
+	if err != nil && ctx.Err() == nil {
+		logging.WithError(err).Warn("events: watch stopped")
+		_, err = fmt.Fprintf(w, "event: error\ndata: %s\n\n", strings.ReplaceAll(err.Error(), "\n", " "))
+		logging.OnError(err).Debug("events: unable to write error")
+		flusher.Flush()
+	}
+}
+
+// authorize verifies the token of the request and checks the permission to read the events of the instance.
+func (h *handler) authorize(r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	token := http_util.GetAuthorization(r)
+	if token == "" {
+		return nil, zerrors.ThrowUnauthenticated(nil, "EVENT-Shoh7", "auth header missing")
+	}
+	ctx = authz.WithDPoPRequestFromHTTP(ctx, r)
+	ctxSetter, err := authz.CheckUserAuthorization(ctx, nil, token, http_util.GetOrgID(r), "", h.verifier, h.authConfig, authz.Option{Permission: permissionEventsRead}, r.Method+":"+HandlerPrefix+watchPath)
+	if err != nil {
+		return nil, err
+	}
+	return ctxSetter(r.Context()), nil
+}
+
+// writeError writes the status code of the error, e.g. 403 if the permission is denied,
+// defaultCode is used for errors without a status code
+func writeError(w http.ResponseWriter, err error, defaultCode int) {
+	code, ok := http_util.ZitadelErrorToHTTPStatusCode(err)
+	if !ok {
+		code = defaultCode
+	}
+	http.Error(w, err.Error(), code)
+}
+
+func watchQuery(ctx context.Context, r *http.Request) *eventstore.SearchQueryBuilder {
+	params := r.URL.Query()
+	builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(authz.GetInstance(ctx).InstanceID()).
+		ResourceOwner(params.Get(paramResourceOwner))
+	query.AddEventTypesAndAggregatesQuery(builder, params[paramEventType], params.Get(paramAggregateID), params[paramAggregateType])
+	return builder
+}
+
+// cursorFromRequest parses the cursor of the Last-Event-ID header or the cursor parameter.
+// The cursor is formatted as `<position>:<offset>`, which is the id of every sent event.
+func cursorFromRequest(r *http.Request) (query.EventCursor, error) {
+	value := r.Header.Get(headerLastEventID)
+	if value == "" {
+		value = r.URL.Query().Get(paramCursor)
+	}
+	if value == "" {
+		return query.EventCursor{}, nil
+	}
+	position, offset, ok := strings.Cut(value, ":")
+	if !ok {
+		return query.EventCursor{}, zerrors.ThrowInvalidArgument(nil, "EVENT-ieX3u", "invalid cursor")
+	}
+	p, err := strconv.ParseFloat(position, 64)
+	if err != nil {
+		return query.EventCursor{}, zerrors.ThrowInvalidArgument(err, "EVENT-Ohg0a", "invalid cursor")
+	}
+	o, err := strconv.ParseUint(offset, 10, 32)
+	if err != nil {
+		return query.EventCursor{}, zerrors.ThrowInvalidArgument(err, "EVENT-ua3Ph", "invalid cursor")
+	}
+	return query.EventCursor{Position: p, Offset: uint32(o)}, nil
+}
+
+func formatCursor(cursor query.EventCursor) string {
+	return strconv.FormatFloat(cursor.Position, 'f', -1, 64) + ":" + strconv.FormatUint(uint64(cursor.Offset), 10)
+}
+
+func writeEvent(w http.ResponseWriter, e *query.Event, cursor query.EventCursor) error {
+	data := &event{
+		Aggregate: aggregate{
+			ID:            e.Aggregate.ID,
+			Type:          string(e.Aggregate.Type),
+			ResourceOwner: e.Aggregate.ResourceOwner,
+		},
+		Sequence:     e.Sequence,
+		Position:     e.Position,
+		CreationDate: e.CreationDate,
+		Type:         e.Type,
+	}
+	if e.Editor != nil {
+		data.Editor = editor{
+			UserID:      e.Editor.ID,
+			DisplayName: e.Editor.DisplayName,
+			Service:     e.Editor.Service,
+		}
+	}
+	if len(e.Payload) > 0 && json.Valid(e.Payload) {
+		data.Payload = e.Payload
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", formatCursor(cursor), e.Type, payload)
+	return err
+}
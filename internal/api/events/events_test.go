@@ -0,0 +1,133 @@
+package events
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_cursorFromRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		lastEventID string
+		target      string
+		want        query.EventCursor
+		wantErr     bool
+	}{
+		{
+			name:   "no cursor",
+			target: "/watch",
+			want:   query.EventCursor{},
+		},
+		{
+			name:   "cursor param",
+			target: "/watch?cursor=1712345678.123456:2",
+			want:   query.EventCursor{Position: 1712345678.123456, Offset: 2},
+		},
+		{
+			name:        "last event id overwrites param",
+			lastEventID: "1712345679.5:1",
+			target:      "/watch?cursor=1712345678.123456:2",
+			want:        query.EventCursor{Position: 1712345679.5, Offset: 1},
+		},
+		{
+			name:    "missing offset",
+			target:  "/watch?cursor=1712345678.123456",
+			wantErr: true,
+		},
+		{
+			name:    "invalid position",
+			target:  "/watch?cursor=position:1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.lastEventID != "" {
+				r.Header.Set(headerLastEventID, tt.lastEventID)
+			}
+			got, err := cursorFromRequest(r)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_writeEvent(t *testing.T) {
+	cursor := query.EventCursor{Position: 1712345678.123456, Offset: 1}
+	w := httptest.NewRecorder()
+	err := writeEvent(w, &query.Event{
+		Editor: &query.EventEditor{
+			ID:          "editor",
+			DisplayName: "Editor",
+		},
+		Aggregate: &eventstore.Aggregate{
+			ID:            "user1",
+			Type:          "user",
+			ResourceOwner: "org1",
+		},
+		Sequence:     3,
+		Position:     1712345678.123456,
+		CreationDate: time.Date(2024, 4, 5, 19, 34, 38, 0, time.UTC),
+		Type:         "user.human.added",
+		Payload:      []byte(`{"userName":"user"}`),
+	}, cursor)
+	require.NoError(t, err)
+	assert.Equal(t, "id: 1712345678.123456:1\n"+
+		"event: user.human.added\n"+
+		`data: {"aggregate":{"id":"user1","type":"user","resourceOwner":"org1"},"editor":{"userId":"editor","displayName":"Editor"},"sequence":3,"position":1712345678.123456,"creationDate":"2024-04-05T19:34:38Z","type":"user.human.added","payload":{"userName":"user"}}`+"\n\n",
+		w.Body.String(),
+	)
+
+	// the id of the event must be accepted as cursor on reconnect
+	r := httptest.NewRequest(http.MethodGet, "/watch", nil)
+	r.Header.Set(headerLastEventID, "1712345678.123456:1")
+	got, err := cursorFromRequest(r)
+	require.NoError(t, err)
+	assert.Equal(t, cursor, got)
+}
+
+func Test_writeError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "unauthenticated",
+			err:        zerrors.ThrowUnauthenticated(nil, "EVENT-Test1", "auth header missing"),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "permission denied",
+			err:        zerrors.ThrowPermissionDenied(nil, "EVENT-Test2", "No matching permissions found"),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "unknown error",
+			err:        io.EOF,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			writeError(w, tt.err, http.StatusUnauthorized)
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
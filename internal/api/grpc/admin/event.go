@@ -2,11 +2,12 @@ package admin
 
 import (
 	"context"
-	"slices"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	event_grpc "github.com/zitadel/zitadel/internal/api/grpc/event"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
@@ -27,6 +28,19 @@ func (s *Server) ListEvents(ctx context.Context, in *admin_pb.ListEventsRequest)
 	return admin_pb.EventsToPb(ctx, events)
 }
 
+func (s *Server) WatchEvents(in *admin_pb.WatchEventsRequest, stream admin_pb.AdminService_WatchEventsServer) error {
+	ctx := stream.Context()
+	return s.query.WatchEvents(ctx, s.eventWatch, watchEventsRequestToFilter(ctx, in), event_grpc.EventCursorToQuery(in.GetCursor()),
+		func(event *query.Event, cursor query.EventCursor) error {
+			resp, err := admin_pb.WatchEventToPb(event, cursor)
+			if err != nil {
+				return err
+			}
+			return stream.Send(resp)
+		},
+	)
+}
+
 func (s *Server) ListEventTypes(ctx context.Context, in *admin_pb.ListEventTypesRequest) (*admin_pb.ListEventTypesResponse, error) {
 	eventTypes := s.query.SearchEventTypes(ctx)
 	return admin_pb.EventTypesToPb(eventTypes), nil
@@ -57,25 +71,6 @@ func eventRequestToFilter(ctx context.Context, req *admin_pb.ListEventsRequest)
 			untilTime = timeUntilPb.AsTime()
 		}
 	}
-	eventTypes := make([]eventstore.EventType, len(req.EventTypes))
-	for i, eventType := range req.EventTypes {
-		eventTypes[i] = eventstore.EventType(eventType)
-	}
-
-	aggregateIDs := make([]string, 0, 1)
-	if req.AggregateId != "" {
-		aggregateIDs = append(aggregateIDs, req.AggregateId)
-	}
-
-	aggregateTypes := make([]eventstore.AggregateType, len(req.AggregateTypes))
-	for i, aggregateType := range req.AggregateTypes {
-		aggregateTypes[i] = eventstore.AggregateType(aggregateType)
-	}
-	if len(aggregateTypes) == 0 {
-		aggregateTypes = aggregateTypesFromEventTypes(eventTypes)
-	}
-	aggregateTypes = slices.Compact(aggregateTypes)
-
 	limit := uint64(req.Limit)
 	if limit == 0 || limit > maxLimit {
 		limit = maxLimit
@@ -91,14 +86,7 @@ func eventRequestToFilter(ctx context.Context, req *admin_pb.ListEventsRequest)
 		SequenceGreater(req.Sequence).
 		CreationDateAfter(sinceTime).
 		CreationDateBefore(untilTime)
-
-	if len(aggregateIDs) > 0 || len(aggregateTypes) > 0 || len(eventTypes) > 0 {
-		builder.AddQuery().
-			AggregateIDs(aggregateIDs...).
-			AggregateTypes(aggregateTypes...).
-			EventTypes(eventTypes...).
-			Builder()
-	}
+	query.AddEventTypesAndAggregatesQuery(builder, req.EventTypes, req.AggregateId, req.AggregateTypes)
 
 	if req.GetAsc() {
 		builder.OrderAsc()
@@ -109,12 +97,10 @@ func eventRequestToFilter(ctx context.Context, req *admin_pb.ListEventsRequest)
 	return builder, nil
 }
 
-func aggregateTypesFromEventTypes(eventTypes []eventstore.EventType) []eventstore.AggregateType {
-	aggregateTypes := make([]eventstore.AggregateType, 0, len(eventTypes))
-
-	for _, eventType := range eventTypes {
-		aggregateTypes = append(aggregateTypes, eventstore.AggregateTypeFromEventType(eventType))
-	}
-
-	return aggregateTypes
+func watchEventsRequestToFilter(ctx context.Context, req *admin_pb.WatchEventsRequest) *eventstore.SearchQueryBuilder {
+	builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(authz.GetInstance(ctx).InstanceID()).
+		ResourceOwner(req.ResourceOwner)
+	query.AddEventTypesAndAggregatesQuery(builder, req.EventTypes, req.AggregateId, req.AggregateTypes)
+	return builder
 }
@@ -514,11 +514,13 @@ func (s *Server) getPasswordComplexityPolicy(ctx context.Context, orgID string)
 	}
 	if !queriedPasswordComplexity.IsDefault {
 		return &management_pb.AddCustomPasswordComplexityPolicyRequest{
-			MinLength:    queriedPasswordComplexity.MinLength,
-			HasUppercase: queriedPasswordComplexity.HasUppercase,
-			HasLowercase: queriedPasswordComplexity.HasLowercase,
-			HasNumber:    queriedPasswordComplexity.HasNumber,
-			HasSymbol:    queriedPasswordComplexity.HasSymbol,
+			MinLength:     queriedPasswordComplexity.MinLength,
+			HasUppercase:  queriedPasswordComplexity.HasUppercase,
+			HasLowercase:  queriedPasswordComplexity.HasLowercase,
+			HasNumber:     queriedPasswordComplexity.HasNumber,
+			HasSymbol:     queriedPasswordComplexity.HasSymbol,
+			HistoryCount:  queriedPasswordComplexity.HistoryCount,
+			CheckBreached: queriedPasswordComplexity.CheckBreached,
 		}, nil
 	}
 	return nil, nil
@@ -799,23 +801,27 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 				oidcApps = append(oidcApps, &v1_pb.DataOIDCApplication{
 					AppId: app.ID,
 					App: &management_pb.AddOIDCAppRequest{
-						ProjectId:                app.ProjectID,
-						Name:                     app.Name,
-						RedirectUris:             app.OIDCConfig.RedirectURIs,
-						ResponseTypes:            responseTypes,
-						GrantTypes:               grantTypes,
-						AppType:                  app_pb.OIDCAppType(app.OIDCConfig.AppType),
-						AuthMethodType:           app_pb.OIDCAuthMethodType(app.OIDCConfig.AuthMethodType),
-						PostLogoutRedirectUris:   app.OIDCConfig.PostLogoutRedirectURIs,
-						Version:                  app_pb.OIDCVersion(app.OIDCConfig.Version),
-						DevMode:                  app.OIDCConfig.IsDevMode,
-						AccessTokenType:          app_pb.OIDCTokenType(app.OIDCConfig.AccessTokenType),
-						AccessTokenRoleAssertion: app.OIDCConfig.AssertAccessTokenRole,
-						IdTokenRoleAssertion:     app.OIDCConfig.AssertIDTokenRole,
-						IdTokenUserinfoAssertion: app.OIDCConfig.AssertIDTokenUserinfo,
-						ClockSkew:                durationpb.New(app.OIDCConfig.ClockSkew),
-						AdditionalOrigins:        app.OIDCConfig.AdditionalOrigins,
-						SkipNativeAppSuccessPage: app.OIDCConfig.SkipNativeAppSuccessPage,
+						ProjectId:                        app.ProjectID,
+						Name:                             app.Name,
+						RedirectUris:                     app.OIDCConfig.RedirectURIs,
+						ResponseTypes:                    responseTypes,
+						GrantTypes:                       grantTypes,
+						AppType:                          app_pb.OIDCAppType(app.OIDCConfig.AppType),
+						AuthMethodType:                   app_pb.OIDCAuthMethodType(app.OIDCConfig.AuthMethodType),
+						PostLogoutRedirectUris:           app.OIDCConfig.PostLogoutRedirectURIs,
+						Version:                          app_pb.OIDCVersion(app.OIDCConfig.Version),
+						DevMode:                          app.OIDCConfig.IsDevMode,
+						AccessTokenType:                  app_pb.OIDCTokenType(app.OIDCConfig.AccessTokenType),
+						AccessTokenRoleAssertion:         app.OIDCConfig.AssertAccessTokenRole,
+						IdTokenRoleAssertion:             app.OIDCConfig.AssertIDTokenRole,
+						IdTokenUserinfoAssertion:         app.OIDCConfig.AssertIDTokenUserinfo,
+						ClockSkew:                        durationpb.New(app.OIDCConfig.ClockSkew),
+						AdditionalOrigins:                app.OIDCConfig.AdditionalOrigins,
+						SkipNativeAppSuccessPage:         app.OIDCConfig.SkipNativeAppSuccessPage,
+						BackChannelLogoutUri:             app.OIDCConfig.BackChannelLogoutURI,
+						BackChannelLogoutSessionRequired: app.OIDCConfig.BackChannelLogoutSessionRequired,
+						DpopBoundAccessTokens:            app.OIDCConfig.DPoPBoundAccessTokens,
+						RequirePushedAuthRequests:        app.OIDCConfig.RequirePushedAuthRequests,
 					},
 				})
 			}
@@ -216,6 +216,8 @@ func addGenericOAuthProviderToCommand(req *admin_pb.AddGenericOAuthProviderReque
 		Scopes:                req.Scopes,
 		IDAttribute:           req.IdAttribute,
 		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
+		ClaimMappings:         idp_grpc.ClaimMappingsToCommand(req.ClaimMappings),
+		UserMapping:           idp_grpc.UserMappingToCommand(req.UserMapping),
 	}
 }
 
@@ -230,6 +232,8 @@ func updateGenericOAuthProviderToCommand(req *admin_pb.UpdateGenericOAuthProvide
 		Scopes:                req.Scopes,
 		IDAttribute:           req.IdAttribute,
 		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
+		ClaimMappings:         idp_grpc.ClaimMappingsToCommand(req.ClaimMappings),
+		UserMapping:           idp_grpc.UserMappingToCommand(req.UserMapping),
 	}
 }
 
@@ -242,6 +246,8 @@ func addGenericOIDCProviderToCommand(req *admin_pb.AddGenericOIDCProviderRequest
 		Scopes:           req.Scopes,
 		IsIDTokenMapping: req.IsIdTokenMapping,
 		IDPOptions:       idp_grpc.OptionsToCommand(req.ProviderOptions),
+		ClaimMappings:    idp_grpc.ClaimMappingsToCommand(req.ClaimMappings),
+		UserMapping:      idp_grpc.UserMappingToCommand(req.UserMapping),
 	}
 }
 
@@ -254,6 +260,8 @@ func updateGenericOIDCProviderToCommand(req *admin_pb.UpdateGenericOIDCProviderR
 		Scopes:           req.Scopes,
 		IsIDTokenMapping: req.IsIdTokenMapping,
 		IDPOptions:       idp_grpc.OptionsToCommand(req.ProviderOptions),
+		ClaimMappings:    idp_grpc.ClaimMappingsToCommand(req.ClaimMappings),
+		UserMapping:      idp_grpc.UserMappingToCommand(req.UserMapping),
 	}
 }
 
@@ -369,6 +377,66 @@ func updateGitLabProviderToCommand(req *admin_pb.UpdateGitLabProviderRequest) co
 	}
 }
 
+func addBitbucketProviderToCommand(req *admin_pb.AddBitbucketProviderRequest) command.BitbucketProvider {
+	return command.BitbucketProvider{
+		Name:         req.Name,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateBitbucketProviderToCommand(req *admin_pb.UpdateBitbucketProviderRequest) command.BitbucketProvider {
+	return command.BitbucketProvider{
+		Name:         req.Name,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func addDiscordProviderToCommand(req *admin_pb.AddDiscordProviderRequest) command.DiscordProvider {
+	return command.DiscordProvider{
+		Name:         req.Name,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateDiscordProviderToCommand(req *admin_pb.UpdateDiscordProviderRequest) command.DiscordProvider {
+	return command.DiscordProvider{
+		Name:         req.Name,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func addSlackProviderToCommand(req *admin_pb.AddSlackProviderRequest) command.SlackProvider {
+	return command.SlackProvider{
+		Name:         req.Name,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateSlackProviderToCommand(req *admin_pb.UpdateSlackProviderRequest) command.SlackProvider {
+	return command.SlackProvider{
+		Name:         req.Name,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
 func addGitLabSelfHostedProviderToCommand(req *admin_pb.AddGitLabSelfHostedProviderRequest) command.GitLabSelfHostedProvider {
 	return command.GitLabSelfHostedProvider{
 		Name:         req.Name,
@@ -391,6 +459,54 @@ func updateGitLabSelfHostedProviderToCommand(req *admin_pb.UpdateGitLabSelfHoste
 	}
 }
 
+func addKeycloakProviderToCommand(req *admin_pb.AddKeycloakProviderRequest) command.KeycloakProvider {
+	return command.KeycloakProvider{
+		Name:         req.Name,
+		BaseURL:      req.BaseUrl,
+		Realm:        req.Realm,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateKeycloakProviderToCommand(req *admin_pb.UpdateKeycloakProviderRequest) command.KeycloakProvider {
+	return command.KeycloakProvider{
+		Name:         req.Name,
+		BaseURL:      req.BaseUrl,
+		Realm:        req.Realm,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func addOktaProviderToCommand(req *admin_pb.AddOktaProviderRequest) command.OktaProvider {
+	return command.OktaProvider{
+		Name:                  req.Name,
+		Issuer:                req.Issuer,
+		AuthorizationServerID: req.AuthorizationServerId,
+		ClientID:              req.ClientId,
+		ClientSecret:          req.ClientSecret,
+		Scopes:                req.Scopes,
+		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateOktaProviderToCommand(req *admin_pb.UpdateOktaProviderRequest) command.OktaProvider {
+	return command.OktaProvider{
+		Name:                  req.Name,
+		Issuer:                req.Issuer,
+		AuthorizationServerID: req.AuthorizationServerId,
+		ClientID:              req.ClientId,
+		ClientSecret:          req.ClientSecret,
+		Scopes:                req.Scopes,
+		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
 func addGoogleProviderToCommand(req *admin_pb.AddGoogleProviderRequest) command.GoogleProvider {
 	return command.GoogleProvider{
 		Name:         req.Name,
@@ -424,6 +540,7 @@ func addLDAPProviderToCommand(req *admin_pb.AddLDAPProviderRequest) command.LDAP
 		UserFilters:       req.UserFilters,
 		Timeout:           req.Timeout.AsDuration(),
 		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
+		Sync:              idp_grpc.LDAPSyncToCommand(req.Sync),
 		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -441,6 +558,7 @@ func updateLDAPProviderToCommand(req *admin_pb.UpdateLDAPProviderRequest) comman
 		UserFilters:       req.UserFilters,
 		Timeout:           req.Timeout.AsDuration(),
 		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
+		Sync:              idp_grpc.LDAPSyncToCommand(req.Sync),
 		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -483,6 +601,7 @@ func addSAMLProviderToCommand(req *admin_pb.AddSAMLProviderRequest) command.SAML
 		NameIDFormat:                  nameIDFormat,
 		TransientMappingAttributeName: req.GetTransientMappingAttributeName(),
 		IDPOptions:                    idp_grpc.OptionsToCommand(req.ProviderOptions),
+		ClaimMappings:                 idp_grpc.ClaimMappingsToCommand(req.ClaimMappings),
 	}
 }
 
@@ -500,6 +619,7 @@ func updateSAMLProviderToCommand(req *admin_pb.UpdateSAMLProviderRequest) comman
 		NameIDFormat:                  nameIDFormat,
 		TransientMappingAttributeName: req.GetTransientMappingAttributeName(),
 		IDPOptions:                    idp_grpc.OptionsToCommand(req.ProviderOptions),
+		ClaimMappings:                 idp_grpc.ClaimMappingsToCommand(req.ClaimMappings),
 	}
 }
 
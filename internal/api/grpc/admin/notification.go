@@ -0,0 +1,33 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListUserNotifications(ctx context.Context, req *admin_pb.ListUserNotificationsRequest) (*admin_pb.ListUserNotificationsResponse, error) {
+	queries, err := listUserNotificationsToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchNotificationRequests(ctx, true, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListUserNotificationsResponse{
+		Result:  notificationRequestsToPb(resp.NotificationRequests),
+		Details: object.ToListDetails(resp.Count, resp.Sequence, resp.LastRun),
+	}, nil
+}
+
+func (s *Server) ResendNotification(ctx context.Context, req *admin_pb.ResendNotificationRequest) (*admin_pb.ResendNotificationResponse, error) {
+	details, err := s.command.ResendNotification(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResendNotificationResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,129 @@
+package admin
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	notification_pb "github.com/zitadel/zitadel/pkg/grpc/notification"
+)
+
+func listUserNotificationsToModel(req *admin_pb.ListUserNotificationsRequest) (*query.NotificationRequestSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := notificationQueriesToModel(req.GetQueries())
+	if err != nil {
+		return nil, err
+	}
+	userIDQuery, err := query.NewNotificationRequestUserIDSearchQuery(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &query.NotificationRequestSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.NotificationRequestColumnCreationDate,
+		},
+		Queries: append(queries, userIDQuery),
+	}, nil
+}
+
+func notificationQueriesToModel(queries []*notification_pb.NotificationQuery) (q []query.SearchQuery, err error) {
+	q = make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = notificationQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func notificationQueryToModel(notificationQuery *notification_pb.NotificationQuery) (query.SearchQuery, error) {
+	switch q := notificationQuery.Query.(type) {
+	case *notification_pb.NotificationQuery_StateQuery:
+		return query.NewNotificationRequestStateSearchQuery(notificationStateToDomain(q.StateQuery.GetState()))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-Ouy5a", "List.Query.Invalid")
+	}
+}
+
+func notificationRequestsToPb(requests []*query.NotificationRequest) []*notification_pb.Notification {
+	resp := make([]*notification_pb.Notification, len(requests))
+	for i, request := range requests {
+		resp[i] = notificationRequestToPb(request)
+	}
+	return resp
+}
+
+func notificationRequestToPb(request *query.NotificationRequest) *notification_pb.Notification {
+	notification := &notification_pb.Notification{
+		Details: object.ToViewDetailsPb(
+			request.Sequence,
+			request.CreationDate,
+			request.ChangeDate,
+			request.ResourceOwner,
+		),
+		Id:               request.ID,
+		UserId:           request.UserID,
+		State:            notificationStateToPb(request.State),
+		Type:             notificationTypeToPb(request.NotificationType),
+		MessageType:      request.MessageType,
+		TriggerEventType: string(request.TriggerEventType),
+		Attempts:         uint32(request.Attempts),
+		LastError:        request.LastError,
+	}
+	if !request.NextAttempt.IsZero() {
+		notification.NextAttempt = timestamppb.New(request.NextAttempt)
+	}
+	return notification
+}
+
+func notificationStateToPb(state domain.NotificationState) notification_pb.NotificationState {
+	switch state {
+	case domain.NotificationStateQueued:
+		return notification_pb.NotificationState_NOTIFICATION_STATE_QUEUED
+	case domain.NotificationStateSent:
+		return notification_pb.NotificationState_NOTIFICATION_STATE_SENT
+	case domain.NotificationStateFailed:
+		return notification_pb.NotificationState_NOTIFICATION_STATE_FAILED
+	case domain.NotificationStateDeadLetter:
+		return notification_pb.NotificationState_NOTIFICATION_STATE_DEAD_LETTER
+	case domain.NotificationStateUnspecified:
+		return notification_pb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED
+	default:
+		return notification_pb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED
+	}
+}
+
+func notificationStateToDomain(state notification_pb.NotificationState) domain.NotificationState {
+	switch state {
+	case notification_pb.NotificationState_NOTIFICATION_STATE_QUEUED:
+		return domain.NotificationStateQueued
+	case notification_pb.NotificationState_NOTIFICATION_STATE_SENT:
+		return domain.NotificationStateSent
+	case notification_pb.NotificationState_NOTIFICATION_STATE_FAILED:
+		return domain.NotificationStateFailed
+	case notification_pb.NotificationState_NOTIFICATION_STATE_DEAD_LETTER:
+		return domain.NotificationStateDeadLetter
+	case notification_pb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED:
+		return domain.NotificationStateUnspecified
+	default:
+		return domain.NotificationStateUnspecified
+	}
+}
+
+func notificationTypeToPb(notificationType domain.NotificationType) notification_pb.NotificationType {
+	switch notificationType {
+	case domain.NotificationTypeEmail:
+		return notification_pb.NotificationType_NOTIFICATION_TYPE_EMAIL
+	case domain.NotificationTypeSms:
+		return notification_pb.NotificationType_NOTIFICATION_TYPE_SMS
+	default:
+		return notification_pb.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+	}
+}
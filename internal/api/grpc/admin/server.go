@@ -31,6 +31,7 @@ type Server struct {
 	assetsAPIDomain   func(context.Context) string
 	userCodeAlg       crypto.EncryptionAlgorithm
 	auditLogRetention time.Duration
+	eventWatch        query.EventWatchConfig
 }
 
 type Config struct {
@@ -45,6 +46,7 @@ func CreateServer(
 	externalSecure bool,
 	userCodeAlg crypto.EncryptionAlgorithm,
 	auditLogRetention time.Duration,
+	eventWatch query.EventWatchConfig,
 ) *Server {
 	return &Server{
 		database:          database,
@@ -53,6 +55,7 @@ func CreateServer(
 		assetsAPIDomain:   assets.AssetAPI(externalSecure),
 		userCodeAlg:       userCodeAlg,
 		auditLogRetention: auditLogRetention,
+		eventWatch:        eventWatch,
 	}
 }
 
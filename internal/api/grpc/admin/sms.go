@@ -65,6 +65,79 @@ func (s *Server) UpdateSMSProviderTwilioToken(ctx context.Context, req *admin_pb
 	}, nil
 }
 
+func (s *Server) AddSMSProviderHTTP(ctx context.Context, req *admin_pb.AddSMSProviderHTTPRequest) (*admin_pb.AddSMSProviderHTTPResponse, error) {
+	id, result, err := s.command.AddSMSConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), AddSMSConfigHTTPToConfig(req), req.GetPriority())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderHTTPResponse{
+		Details: object.DomainToAddDetailsPb(result),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderHTTP(ctx context.Context, req *admin_pb.UpdateSMSProviderHTTPRequest) (*admin_pb.UpdateSMSProviderHTTPResponse, error) {
+	result, err := s.command.ChangeSMSConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), req.GetId(), UpdateSMSConfigHTTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderHTTPResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderVonage(ctx context.Context, req *admin_pb.AddSMSProviderVonageRequest) (*admin_pb.AddSMSProviderVonageResponse, error) {
+	id, result, err := s.command.AddSMSConfigVonage(ctx, authz.GetInstance(ctx).InstanceID(), AddSMSConfigVonageToConfig(req), req.GetPriority())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderVonageResponse{
+		Details: object.DomainToAddDetailsPb(result),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderVonage(ctx context.Context, req *admin_pb.UpdateSMSProviderVonageRequest) (*admin_pb.UpdateSMSProviderVonageResponse, error) {
+	result, err := s.command.ChangeSMSConfigVonage(ctx, authz.GetInstance(ctx).InstanceID(), req.GetId(), UpdateSMSConfigVonageToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderVonageResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderMessageBird(ctx context.Context, req *admin_pb.AddSMSProviderMessageBirdRequest) (*admin_pb.AddSMSProviderMessageBirdResponse, error) {
+	id, result, err := s.command.AddSMSConfigMessageBird(ctx, authz.GetInstance(ctx).InstanceID(), AddSMSConfigMessageBirdToConfig(req), req.GetPriority())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderMessageBirdResponse{
+		Details: object.DomainToAddDetailsPb(result),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderMessageBird(ctx context.Context, req *admin_pb.UpdateSMSProviderMessageBirdRequest) (*admin_pb.UpdateSMSProviderMessageBirdResponse, error) {
+	result, err := s.command.ChangeSMSConfigMessageBird(ctx, authz.GetInstance(ctx).InstanceID(), req.GetId(), UpdateSMSConfigMessageBirdToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderMessageBirdResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderPriority(ctx context.Context, req *admin_pb.UpdateSMSProviderPriorityRequest) (*admin_pb.UpdateSMSProviderPriorityResponse, error) {
+	result, err := s.command.ChangeSMSConfigPriority(ctx, authz.GetInstance(ctx).InstanceID(), req.GetId(), req.GetPriority())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderPriorityResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
 func (s *Server) ActivateSMSProvider(ctx context.Context, req *admin_pb.ActivateSMSProviderRequest) (*admin_pb.ActivateSMSProviderResponse, error) {
 	result, err := s.command.ActivateSMSConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
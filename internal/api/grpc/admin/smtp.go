@@ -85,7 +85,7 @@ func (s *Server) UpdateSMTPConfigPassword(ctx context.Context, req *admin_pb.Upd
 }
 
 func (s *Server) ListSMTPConfigs(ctx context.Context, req *admin_pb.ListSMTPConfigsRequest) (*admin_pb.ListSMTPConfigsResponse, error) {
-	queries, err := listSMTPConfigsToModel(req)
+	queries, err := listSMTPConfigsToModel(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -128,3 +128,19 @@ func (s *Server) DeactivateSMTPConfig(ctx context.Context, req *admin_pb.Deactiv
 		Details: object.DomainToAddDetailsPb(result),
 	}, nil
 }
+
+func (s *Server) TestSMTPConfigById(ctx context.Context, req *admin_pb.TestSMTPConfigByIdRequest) (*admin_pb.TestSMTPConfigByIdResponse, error) {
+	err := s.command.TestSMTPConfigByID(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.ReceiverAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.TestSMTPConfigByIdResponse{}, nil
+}
+
+func (s *Server) TestSMTPConfig(ctx context.Context, req *admin_pb.TestSMTPConfigRequest) (*admin_pb.TestSMTPConfigResponse, error) {
+	err := s.command.TestSMTPConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.ReceiverAddress, TestSMTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.TestSMTPConfigResponse{}, nil
+}
@@ -1,20 +1,29 @@
 package admin
 
 import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/query"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
 )
 
-func listSMTPConfigsToModel(req *admin_pb.ListSMTPConfigsRequest) (*query.SMTPConfigsSearchQueries, error) {
+func listSMTPConfigsToModel(ctx context.Context, req *admin_pb.ListSMTPConfigsRequest) (*query.SMTPConfigsSearchQueries, error) {
 	offset, limit, asc := object.ListQueryToModel(req.Query)
+	// configs owned by organizations are managed through the management API
+	resourceOwnerQuery, err := query.NewSMTPConfigResourceOwnerSearchQuery(authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
 	return &query.SMTPConfigsSearchQueries{
 		SearchRequest: query.SearchRequest{
 			Offset: offset,
 			Limit:  limit,
 			Asc:    asc,
 		},
+		Queries: []query.SearchQuery{resourceOwnerQuery},
 	}, nil
 }
 
@@ -43,6 +43,7 @@ func EventToPb(event *query.Event) (response *eventpb.Event, err error) {
 			ResourceOwner: event.Aggregate.ResourceOwner,
 		},
 		Sequence:     event.Sequence,
+		Position:     event.Position,
 		CreationDate: timestamppb.New(event.CreationDate),
 		Payload:      payload,
 		Type:         EventTypeToPb(event.Type),
@@ -62,3 +63,17 @@ func AggregateTypeToPb(typ string) *eventpb.AggregateType {
 		Localized: message.NewLocalizedAggregateType(typ),
 	}
 }
+
+func EventCursorToPb(cursor query.EventCursor) *eventpb.EventCursor {
+	return &eventpb.EventCursor{
+		Position: cursor.Position,
+		Offset:   cursor.Offset,
+	}
+}
+
+func EventCursorToQuery(cursor *eventpb.EventCursor) query.EventCursor {
+	return query.EventCursor{
+		Position: cursor.GetPosition(),
+		Offset:   cursor.GetOffset(),
+	}
+}
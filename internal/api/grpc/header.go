@@ -9,6 +9,13 @@ import (
 	"github.com/zitadel/zitadel/internal/api/http"
 )
 
+const (
+	// GatewayHTTPMethod and GatewayHTTPPath are added by the gateway
+	// and contain the method and path of the HTTP request the call was translated from
+	GatewayHTTPMethod = "http-method"
+	GatewayHTTPPath   = "http-path"
+)
+
 func GetHeader(ctx context.Context, headername string) string {
 	return metautils.ExtractIncoming(ctx).Get(headername)
 }
@@ -20,3 +27,9 @@ func GetGatewayHeader(ctx context.Context, headername string) string {
 func GetAuthorizationHeader(ctx context.Context) string {
 	return GetHeader(ctx, http.Authorization)
 }
+
+// GetGatewayRequest returns the method and path of the HTTP request the gateway translated into the current call.
+// Both are empty if the call was not made through the gateway.
+func GetGatewayRequest(ctx context.Context) (method, path string) {
+	return GetGatewayHeader(ctx, GatewayHTTPMethod), GetGatewayHeader(ctx, GatewayHTTPPath)
+}
@@ -7,6 +7,7 @@ import (
 
 	obj_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/domain"
+	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/repository/idp"
@@ -313,6 +314,69 @@ func LDAPAttributesToCommand(attributes *idp_pb.LDAPAttributes) idp.LDAPAttribut
 	}
 }
 
+func LDAPSyncToCommand(sync *idp_pb.LDAPSync) *idp.LDAPSync {
+	if sync == nil {
+		return nil
+	}
+	mappings := make([]idp.LDAPGroupMapping, len(sync.GroupMappings))
+	for i, mapping := range sync.GroupMappings {
+		mappings[i] = idp.LDAPGroupMapping{
+			Group:          mapping.Group,
+			ProjectID:      mapping.ProjectId,
+			ProjectGrantID: mapping.ProjectGrantId,
+			Roles:          mapping.Roles,
+		}
+	}
+	return &idp.LDAPSync{
+		Enabled:           sync.Enabled,
+		DryRun:            sync.DryRun,
+		Filter:            sync.Filter,
+		OrgID:             sync.OrgId,
+		DeactivateMissing: sync.DeactivateMissing,
+		GroupAttribute:    sync.GroupAttribute,
+		GroupMappings:     mappings,
+	}
+}
+
+func ClaimMappingsToCommand(mappings []*idp_pb.ClaimMapping) idp.ClaimMappings {
+	if len(mappings) == 0 {
+		return nil
+	}
+	claimMappings := make(idp.ClaimMappings, len(mappings))
+	for i, mapping := range mappings {
+		claimMappings[i] = idp.ClaimMapping{
+			Claim:          mapping.Claim,
+			MetadataKey:    mapping.MetadataKey,
+			Value:          mapping.Value,
+			ProjectID:      mapping.ProjectId,
+			ProjectGrantID: mapping.ProjectGrantId,
+			Roles:          mapping.Roles,
+		}
+	}
+	return claimMappings
+}
+
+func UserMappingToCommand(mapping *idp_pb.UserMapping) *providers.UserMapping {
+	if mapping == nil {
+		return nil
+	}
+	return &providers.UserMapping{
+		ID:                mapping.Id,
+		FirstName:         mapping.FirstName,
+		LastName:          mapping.LastName,
+		DisplayName:       mapping.DisplayName,
+		NickName:          mapping.NickName,
+		PreferredUsername: mapping.PreferredUsername,
+		Email:             mapping.Email,
+		EmailVerified:     mapping.EmailVerified,
+		Phone:             mapping.Phone,
+		PhoneVerified:     mapping.PhoneVerified,
+		PreferredLanguage: mapping.PreferredLanguage,
+		AvatarURL:         mapping.AvatarUrl,
+		Profile:           mapping.Profile,
+	}
+}
+
 func AzureADTenantToCommand(tenant *idp_pb.AzureADTenant) string {
 	if tenant == nil {
 		return string(azuread.CommonTenant)
@@ -414,6 +478,16 @@ func providerTypeToPb(idpType domain.IDPType) idp_pb.ProviderType {
 		return idp_pb.ProviderType_PROVIDER_TYPE_APPLE
 	case domain.IDPTypeSAML:
 		return idp_pb.ProviderType_PROVIDER_TYPE_SAML
+	case domain.IDPTypeOkta:
+		return idp_pb.ProviderType_PROVIDER_TYPE_OKTA
+	case domain.IDPTypeKeycloak:
+		return idp_pb.ProviderType_PROVIDER_TYPE_KEYCLOAK
+	case domain.IDPTypeBitbucket:
+		return idp_pb.ProviderType_PROVIDER_TYPE_BITBUCKET
+	case domain.IDPTypeDiscord:
+		return idp_pb.ProviderType_PROVIDER_TYPE_DISCORD
+	case domain.IDPTypeSlack:
+		return idp_pb.ProviderType_PROVIDER_TYPE_SLACK
 	case domain.IDPTypeUnspecified:
 		return idp_pb.ProviderType_PROVIDER_TYPE_UNSPECIFIED
 	default:
@@ -432,11 +506,11 @@ func configToPb(config *query.IDPTemplate) *idp_pb.ProviderConfig {
 		},
 	}
 	if config.OAuthIDPTemplate != nil {
-		oauthConfigToPb(providerConfig, config.OAuthIDPTemplate)
+		oauthConfigToPb(providerConfig, config.OAuthIDPTemplate, config.ClaimMappings)
 		return providerConfig
 	}
 	if config.OIDCIDPTemplate != nil {
-		oidcConfigToPb(providerConfig, config.OIDCIDPTemplate)
+		oidcConfigToPb(providerConfig, config.OIDCIDPTemplate, config.ClaimMappings)
 		return providerConfig
 	}
 	if config.JWTIDPTemplate != nil {
@@ -476,7 +550,27 @@ func configToPb(config *query.IDPTemplate) *idp_pb.ProviderConfig {
 		return providerConfig
 	}
 	if config.SAMLIDPTemplate != nil {
-		samlConfigToPb(providerConfig, config.SAMLIDPTemplate)
+		samlConfigToPb(providerConfig, config.SAMLIDPTemplate, config.ClaimMappings)
+		return providerConfig
+	}
+	if config.OktaIDPTemplate != nil {
+		oktaConfigToPb(providerConfig, config.OktaIDPTemplate)
+		return providerConfig
+	}
+	if config.KeycloakIDPTemplate != nil {
+		keycloakConfigToPb(providerConfig, config.KeycloakIDPTemplate)
+		return providerConfig
+	}
+	if config.BitbucketIDPTemplate != nil {
+		bitbucketConfigToPb(providerConfig, config.BitbucketIDPTemplate)
+		return providerConfig
+	}
+	if config.DiscordIDPTemplate != nil {
+		discordConfigToPb(providerConfig, config.DiscordIDPTemplate)
+		return providerConfig
+	}
+	if config.SlackIDPTemplate != nil {
+		slackConfigToPb(providerConfig, config.SlackIDPTemplate)
 		return providerConfig
 	}
 	return providerConfig
@@ -495,7 +589,7 @@ func autoLinkingOptionToPb(linking domain.AutoLinkingOption) idp_pb.AutoLinkingO
 	}
 }
 
-func oauthConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OAuthIDPTemplate) {
+func oauthConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OAuthIDPTemplate, claimMappings idp.ClaimMappings) {
 	providerConfig.Config = &idp_pb.ProviderConfig_Oauth{
 		Oauth: &idp_pb.OAuthConfig{
 			ClientId:              template.ClientID,
@@ -504,17 +598,21 @@ func oauthConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OAut
 			UserEndpoint:          template.UserEndpoint,
 			Scopes:                template.Scopes,
 			IdAttribute:           template.IDAttribute,
+			ClaimMappings:         claimMappingsToPb(claimMappings),
+			UserMapping:           userMappingToPb(template.UserMapping),
 		},
 	}
 }
 
-func oidcConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OIDCIDPTemplate) {
+func oidcConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OIDCIDPTemplate, claimMappings idp.ClaimMappings) {
 	providerConfig.Config = &idp_pb.ProviderConfig_Oidc{
 		Oidc: &idp_pb.GenericOIDCConfig{
 			ClientId:         template.ClientID,
 			Issuer:           template.Issuer,
 			Scopes:           template.Scopes,
 			IsIdTokenMapping: template.IsIDTokenMapping,
+			ClaimMappings:    claimMappingsToPb(claimMappings),
+			UserMapping:      userMappingToPb(template.UserMapping),
 		},
 	}
 }
@@ -586,6 +684,33 @@ func gitlabConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.Git
 	}
 }
 
+func bitbucketConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.BitbucketIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Bitbucket{
+		Bitbucket: &idp_pb.BitbucketConfig{
+			ClientId: template.ClientID,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
+func discordConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.DiscordIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Discord{
+		Discord: &idp_pb.DiscordConfig{
+			ClientId: template.ClientID,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
+func slackConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.SlackIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Slack{
+		Slack: &idp_pb.SlackConfig{
+			ClientId: template.ClientID,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
 func gitlabSelfHostedConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.GitLabSelfHostedIDPTemplate) {
 	providerConfig.Config = &idp_pb.ProviderConfig_GitlabSelfHosted{
 		GitlabSelfHosted: &idp_pb.GitLabSelfHostedConfig{
@@ -596,6 +721,28 @@ func gitlabSelfHostedConfigToPb(providerConfig *idp_pb.ProviderConfig, template
 	}
 }
 
+func keycloakConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.KeycloakIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Keycloak{
+		Keycloak: &idp_pb.KeycloakConfig{
+			ClientId: template.ClientID,
+			BaseUrl:  template.BaseURL,
+			Realm:    template.Realm,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
+func oktaConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OktaIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Okta{
+		Okta: &idp_pb.OktaConfig{
+			ClientId:              template.ClientID,
+			Issuer:                template.Issuer,
+			AuthorizationServerId: template.AuthorizationServerID,
+			Scopes:                template.Scopes,
+		},
+	}
+}
+
 func googleConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.GoogleIDPTemplate) {
 	providerConfig.Config = &idp_pb.ProviderConfig_Google{
 		Google: &idp_pb.GoogleConfig{
@@ -621,6 +768,8 @@ func ldapConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.LDAPI
 			UserFilters:       template.UserFilters,
 			Timeout:           timeout,
 			Attributes:        ldapAttributesToPb(template.LDAPAttributes),
+			Sync:              ldapSyncToPb(template.Sync),
+			SyncReport:        ldapSyncReportToPb(template.SyncReport),
 		},
 	}
 }
@@ -643,6 +792,89 @@ func ldapAttributesToPb(attributes idp.LDAPAttributes) *idp_pb.LDAPAttributes {
 	}
 }
 
+func ldapSyncToPb(sync *idp.LDAPSync) *idp_pb.LDAPSync {
+	if sync == nil {
+		return nil
+	}
+	mappings := make([]*idp_pb.LDAPGroupMapping, len(sync.GroupMappings))
+	for i, mapping := range sync.GroupMappings {
+		mappings[i] = &idp_pb.LDAPGroupMapping{
+			Group:          mapping.Group,
+			ProjectId:      mapping.ProjectID,
+			ProjectGrantId: mapping.ProjectGrantID,
+			Roles:          mapping.Roles,
+		}
+	}
+	return &idp_pb.LDAPSync{
+		Enabled:           sync.Enabled,
+		DryRun:            sync.DryRun,
+		Filter:            sync.Filter,
+		OrgId:             sync.OrgID,
+		DeactivateMissing: sync.DeactivateMissing,
+		GroupAttribute:    sync.GroupAttribute,
+		GroupMappings:     mappings,
+	}
+}
+
+func claimMappingsToPb(mappings idp.ClaimMappings) []*idp_pb.ClaimMapping {
+	claimMappings := make([]*idp_pb.ClaimMapping, len(mappings))
+	for i, mapping := range mappings {
+		claimMappings[i] = &idp_pb.ClaimMapping{
+			Claim:          mapping.Claim,
+			MetadataKey:    mapping.MetadataKey,
+			Value:          mapping.Value,
+			ProjectId:      mapping.ProjectID,
+			ProjectGrantId: mapping.ProjectGrantID,
+			Roles:          mapping.Roles,
+		}
+	}
+	return claimMappings
+}
+
+func userMappingToPb(mapping *providers.UserMapping) *idp_pb.UserMapping {
+	if mapping.IsZero() {
+		return nil
+	}
+	return &idp_pb.UserMapping{
+		Id:                mapping.ID,
+		FirstName:         mapping.FirstName,
+		LastName:          mapping.LastName,
+		DisplayName:       mapping.DisplayName,
+		NickName:          mapping.NickName,
+		PreferredUsername: mapping.PreferredUsername,
+		Email:             mapping.Email,
+		EmailVerified:     mapping.EmailVerified,
+		Phone:             mapping.Phone,
+		PhoneVerified:     mapping.PhoneVerified,
+		PreferredLanguage: mapping.PreferredLanguage,
+		AvatarUrl:         mapping.AvatarURL,
+		Profile:           mapping.Profile,
+	}
+}
+
+func ldapSyncReportToPb(report *idp.LDAPSyncReport) *idp_pb.LDAPSyncReport {
+	if report == nil {
+		return nil
+	}
+	failed := make([]*idp_pb.LDAPSyncFailure, len(report.Failed))
+	for i, failure := range report.Failed {
+		failed[i] = &idp_pb.LDAPSyncFailure{
+			ExternalUserId: failure.ExternalUserID,
+			Error:          failure.Error,
+		}
+	}
+	return &idp_pb.LDAPSyncReport{
+		DryRun:      report.DryRun,
+		Created:     report.Created,
+		Updated:     report.Updated,
+		Deactivated: report.Deactivated,
+		Reactivated: report.Reactivated,
+		Skipped:     report.Skipped,
+		Grants:      report.Grants,
+		Failed:      failed,
+	}
+}
+
 func appleConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.AppleIDPTemplate) {
 	providerConfig.Config = &idp_pb.ProviderConfig_Apple{
 		Apple: &idp_pb.AppleConfig{
@@ -654,7 +886,7 @@ func appleConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.Appl
 	}
 }
 
-func samlConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.SAMLIDPTemplate) {
+func samlConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.SAMLIDPTemplate, claimMappings idp.ClaimMappings) {
 	nameIDFormat := idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT
 	if template.NameIDFormat.Valid {
 		nameIDFormat = nameIDToPb(template.NameIDFormat.V)
@@ -666,6 +898,7 @@ func samlConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.SAMLI
 			WithSignedRequest:             template.WithSignedRequest,
 			NameIdFormat:                  nameIDFormat,
 			TransientMappingAttributeName: gu.Ptr(template.TransientMappingAttributeName),
+			ClaimMappings:                 claimMappingsToPb(claimMappings),
 		},
 	}
 }
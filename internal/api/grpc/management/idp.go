@@ -334,6 +334,69 @@ func (s *Server) UpdateGitLabProvider(ctx context.Context, req *mgmt_pb.UpdateGi
 	}, nil
 }
 
+func (s *Server) AddBitbucketProvider(ctx context.Context, req *mgmt_pb.AddBitbucketProviderRequest) (*mgmt_pb.AddBitbucketProviderResponse, error) {
+	id, details, err := s.command.AddOrgBitbucketProvider(ctx, authz.GetCtxData(ctx).OrgID, addBitbucketProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddBitbucketProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateBitbucketProvider(ctx context.Context, req *mgmt_pb.UpdateBitbucketProviderRequest) (*mgmt_pb.UpdateBitbucketProviderResponse, error) {
+	details, err := s.command.UpdateOrgBitbucketProvider(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateBitbucketProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateBitbucketProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddDiscordProvider(ctx context.Context, req *mgmt_pb.AddDiscordProviderRequest) (*mgmt_pb.AddDiscordProviderResponse, error) {
+	id, details, err := s.command.AddOrgDiscordProvider(ctx, authz.GetCtxData(ctx).OrgID, addDiscordProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddDiscordProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateDiscordProvider(ctx context.Context, req *mgmt_pb.UpdateDiscordProviderRequest) (*mgmt_pb.UpdateDiscordProviderResponse, error) {
+	details, err := s.command.UpdateOrgDiscordProvider(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateDiscordProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateDiscordProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddSlackProvider(ctx context.Context, req *mgmt_pb.AddSlackProviderRequest) (*mgmt_pb.AddSlackProviderResponse, error) {
+	id, details, err := s.command.AddOrgSlackProvider(ctx, authz.GetCtxData(ctx).OrgID, addSlackProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddSlackProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateSlackProvider(ctx context.Context, req *mgmt_pb.UpdateSlackProviderRequest) (*mgmt_pb.UpdateSlackProviderResponse, error) {
+	details, err := s.command.UpdateOrgSlackProvider(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateSlackProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateSlackProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) AddGitLabSelfHostedProvider(ctx context.Context, req *mgmt_pb.AddGitLabSelfHostedProviderRequest) (*mgmt_pb.AddGitLabSelfHostedProviderResponse, error) {
 	id, details, err := s.command.AddOrgGitLabSelfHostedProvider(ctx, authz.GetCtxData(ctx).OrgID, addGitLabSelfHostedProviderToCommand(req))
 	if err != nil {
@@ -355,6 +418,48 @@ func (s *Server) UpdateGitLabSelfHostedProvider(ctx context.Context, req *mgmt_p
 	}, nil
 }
 
+func (s *Server) AddKeycloakProvider(ctx context.Context, req *mgmt_pb.AddKeycloakProviderRequest) (*mgmt_pb.AddKeycloakProviderResponse, error) {
+	id, details, err := s.command.AddOrgKeycloakProvider(ctx, authz.GetCtxData(ctx).OrgID, addKeycloakProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddKeycloakProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateKeycloakProvider(ctx context.Context, req *mgmt_pb.UpdateKeycloakProviderRequest) (*mgmt_pb.UpdateKeycloakProviderResponse, error) {
+	details, err := s.command.UpdateOrgKeycloakProvider(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateKeycloakProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateKeycloakProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddOktaProvider(ctx context.Context, req *mgmt_pb.AddOktaProviderRequest) (*mgmt_pb.AddOktaProviderResponse, error) {
+	id, details, err := s.command.AddOrgOktaProvider(ctx, authz.GetCtxData(ctx).OrgID, addOktaProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOktaProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateOktaProvider(ctx context.Context, req *mgmt_pb.UpdateOktaProviderRequest) (*mgmt_pb.UpdateOktaProviderResponse, error) {
+	details, err := s.command.UpdateOrgOktaProvider(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateOktaProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOktaProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) AddGoogleProvider(ctx context.Context, req *mgmt_pb.AddGoogleProviderRequest) (*mgmt_pb.AddGoogleProviderResponse, error) {
 	id, details, err := s.command.AddOrgGoogleProvider(ctx, authz.GetCtxData(ctx).OrgID, addGoogleProviderToCommand(req))
 	if err != nil {
@@ -7,20 +7,24 @@ import (
 
 func AddPasswordComplexityPolicyToDomain(req *mgmt_pb.AddCustomPasswordComplexityPolicyRequest) *domain.PasswordComplexityPolicy {
 	return &domain.PasswordComplexityPolicy{
-		MinLength:    req.MinLength,
-		HasLowercase: req.HasLowercase,
-		HasUppercase: req.HasUppercase,
-		HasNumber:    req.HasNumber,
-		HasSymbol:    req.HasSymbol,
+		MinLength:     req.MinLength,
+		HasLowercase:  req.HasLowercase,
+		HasUppercase:  req.HasUppercase,
+		HasNumber:     req.HasNumber,
+		HasSymbol:     req.HasSymbol,
+		HistoryCount:  req.HistoryCount,
+		CheckBreached: req.CheckBreached,
 	}
 }
 
 func UpdatePasswordComplexityPolicyToDomain(req *mgmt_pb.UpdateCustomPasswordComplexityPolicyRequest) *domain.PasswordComplexityPolicy {
 	return &domain.PasswordComplexityPolicy{
-		MinLength:    req.MinLength,
-		HasLowercase: req.HasLowercase,
-		HasUppercase: req.HasUppercase,
-		HasNumber:    req.HasNumber,
-		HasSymbol:    req.HasSymbol,
+		MinLength:     req.MinLength,
+		HasLowercase:  req.HasLowercase,
+		HasUppercase:  req.HasUppercase,
+		HasNumber:     req.HasNumber,
+		HasSymbol:     req.HasSymbol,
+		HistoryCount:  req.HistoryCount,
+		CheckBreached: req.CheckBreached,
 	}
 }
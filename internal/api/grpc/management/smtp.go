@@ -0,0 +1,131 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListSMTPConfigs(ctx context.Context, req *mgmt_pb.ListSMTPConfigsRequest) (*mgmt_pb.ListSMTPConfigsResponse, error) {
+	queries, err := listSMTPConfigsToModel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchSMTPConfigs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListSMTPConfigsResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  smtpConfigsToPb(result.Configs),
+	}, nil
+}
+
+func (s *Server) GetSMTPConfigById(ctx context.Context, req *mgmt_pb.GetSMTPConfigByIdRequest) (*mgmt_pb.GetSMTPConfigByIdResponse, error) {
+	smtp, err := s.query.SMTPConfigByID(ctx, authz.GetInstance(ctx).InstanceID(), authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetSMTPConfigByIdResponse{
+		SmtpConfig: smtpConfigToPb(smtp),
+	}, nil
+}
+
+func (s *Server) AddSMTPConfig(ctx context.Context, req *mgmt_pb.AddSMTPConfigRequest) (*mgmt_pb.AddSMTPConfigResponse, error) {
+	id, details, err := s.command.AddOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, addSMTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddSMTPConfigResponse{
+		Details: object.AddToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+		Id: id,
+	}, nil
+}
+
+func (s *Server) UpdateSMTPConfig(ctx context.Context, req *mgmt_pb.UpdateSMTPConfigRequest) (*mgmt_pb.UpdateSMTPConfigResponse, error) {
+	details, err := s.command.ChangeOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateSMTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateSMTPConfigResponse{
+		Details: object.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateSMTPConfigPassword(ctx context.Context, req *mgmt_pb.UpdateSMTPConfigPasswordRequest) (*mgmt_pb.UpdateSMTPConfigPasswordResponse, error) {
+	details, err := s.command.ChangeOrgSMTPConfigPassword(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateSMTPConfigPasswordResponse{
+		Details: object.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ActivateSMTPConfig(ctx context.Context, req *mgmt_pb.ActivateSMTPConfigRequest) (*mgmt_pb.ActivateSMTPConfigResponse, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	// Get the ID of the currently active configuration of the organization if any
+	currentActiveID := ""
+	smtp, err := s.query.OrgSMTPConfigActive(ctx, orgID)
+	if err == nil {
+		currentActiveID = smtp.ID
+	}
+
+	details, err := s.command.ActivateOrgSMTPConfig(ctx, orgID, req.Id, currentActiveID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ActivateSMTPConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) DeactivateSMTPConfig(ctx context.Context, req *mgmt_pb.DeactivateSMTPConfigRequest) (*mgmt_pb.DeactivateSMTPConfigResponse, error) {
+	details, err := s.command.DeactivateOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DeactivateSMTPConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveSMTPConfig(ctx context.Context, req *mgmt_pb.RemoveSMTPConfigRequest) (*mgmt_pb.RemoveSMTPConfigResponse, error) {
+	details, err := s.command.RemoveOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveSMTPConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) TestSMTPConfigById(ctx context.Context, req *mgmt_pb.TestSMTPConfigByIdRequest) (*mgmt_pb.TestSMTPConfigByIdResponse, error) {
+	err := s.command.TestOrgSMTPConfigByID(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.ReceiverAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.TestSMTPConfigByIdResponse{}, nil
+}
+
+func (s *Server) TestSMTPConfig(ctx context.Context, req *mgmt_pb.TestSMTPConfigRequest) (*mgmt_pb.TestSMTPConfigResponse, error) {
+	err := s.command.TestOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.ReceiverAddress, testSMTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.TestSMTPConfigResponse{}, nil
+}
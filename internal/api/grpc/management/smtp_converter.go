@@ -0,0 +1,94 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+)
+
+func listSMTPConfigsToModel(ctx context.Context, req *mgmt_pb.ListSMTPConfigsRequest) (*query.SMTPConfigsSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	resourceOwnerQuery, err := query.NewSMTPConfigResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.SMTPConfigsSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{resourceOwnerQuery},
+	}, nil
+}
+
+func addSMTPToConfig(req *mgmt_pb.AddSMTPConfigRequest) *smtp.Config {
+	return &smtp.Config{
+		Description:    req.Description,
+		Tls:            req.Tls,
+		From:           req.SenderAddress,
+		FromName:       req.SenderName,
+		ReplyToAddress: req.ReplyToAddress,
+		SMTP: smtp.SMTP{
+			Host:     req.Host,
+			User:     req.User,
+			Password: req.Password,
+		},
+	}
+}
+
+func updateSMTPToConfig(req *mgmt_pb.UpdateSMTPConfigRequest) *smtp.Config {
+	return &smtp.Config{
+		Description:    req.Description,
+		Tls:            req.Tls,
+		From:           req.SenderAddress,
+		FromName:       req.SenderName,
+		ReplyToAddress: req.ReplyToAddress,
+		SMTP: smtp.SMTP{
+			Host:     req.Host,
+			User:     req.User,
+			Password: req.Password,
+		},
+	}
+}
+
+func testSMTPToConfig(req *mgmt_pb.TestSMTPConfigRequest) *smtp.Config {
+	return &smtp.Config{
+		Tls:      req.Tls,
+		From:     req.SenderAddress,
+		FromName: req.SenderName,
+		SMTP: smtp.SMTP{
+			Host:     req.Host,
+			User:     req.User,
+			Password: req.Password,
+		},
+	}
+}
+
+func smtpConfigToPb(config *query.SMTPConfig) *settings_pb.SMTPConfig {
+	return &settings_pb.SMTPConfig{
+		Details:        object.ToViewDetailsPb(config.Sequence, config.CreationDate, config.ChangeDate, config.ResourceOwner),
+		Id:             config.ID,
+		Description:    config.Description,
+		Tls:            config.TLS,
+		Host:           config.Host,
+		User:           config.User,
+		State:          settings_pb.SMTPConfigState(config.State),
+		SenderAddress:  config.SenderAddress,
+		SenderName:     config.SenderName,
+		ReplyToAddress: config.ReplyToAddress,
+	}
+}
+
+func smtpConfigsToPb(configs []*query.SMTPConfig) []*settings_pb.SMTPConfig {
+	c := make([]*settings_pb.SMTPConfig, len(configs))
+	for i, config := range configs {
+		c[i] = smtpConfigToPb(config)
+	}
+	return c
+}
@@ -137,10 +137,11 @@ func AddMachineUserRequestToCommand(req *mgmt_pb.AddMachineUserRequest, resource
 		ObjectRoot: models.ObjectRoot{
 			ResourceOwner: resourceowner,
 		},
-		Username:        req.UserName,
-		Name:            req.Name,
-		Description:     req.Description,
-		AccessTokenType: user_grpc.AccessTokenTypeToDomain(req.AccessTokenType),
+		Username:              req.UserName,
+		Name:                  req.Name,
+		Description:           req.Description,
+		AccessTokenType:       user_grpc.AccessTokenTypeToDomain(req.AccessTokenType),
+		DPoPBoundAccessTokens: req.DpopBoundAccessTokens,
 	}
 }
 
@@ -194,9 +195,10 @@ func UpdateMachineRequestToCommand(req *mgmt_pb.UpdateMachineRequest, orgID stri
 			AggregateID:   req.UserId,
 			ResourceOwner: orgID,
 		},
-		Name:            req.Name,
-		Description:     req.Description,
-		AccessTokenType: user_grpc.AccessTokenTypeToDomain(req.AccessTokenType),
+		Name:                  req.Name,
+		Description:           req.Description,
+		AccessTokenType:       user_grpc.AccessTokenTypeToDomain(req.AccessTokenType),
+		DPoPBoundAccessTokens: req.DpopBoundAccessTokens,
 	}
 }
 
@@ -14,6 +14,7 @@ func DomainToChangeDetailsPb(objectDetail *domain.ObjectDetails) *object_pb.Obje
 	details := &object_pb.ObjectDetails{
 		Sequence:      objectDetail.Sequence,
 		ResourceOwner: objectDetail.ResourceOwner,
+		Position:      objectDetail.Position,
 	}
 	if !objectDetail.EventDate.IsZero() {
 		details.ChangeDate = timestamppb.New(objectDetail.EventDate)
@@ -25,6 +26,7 @@ func DomainToAddDetailsPb(objectDetail *domain.ObjectDetails) *object_pb.ObjectD
 	details := &object_pb.ObjectDetails{
 		Sequence:      objectDetail.Sequence,
 		ResourceOwner: objectDetail.ResourceOwner,
+		Position:      objectDetail.Position,
 	}
 	if !objectDetail.EventDate.IsZero() {
 		details.CreationDate = timestamppb.New(objectDetail.EventDate)
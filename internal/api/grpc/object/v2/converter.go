@@ -15,6 +15,7 @@ func DomainToDetailsPb(objectDetail *domain.ObjectDetails) *object.Details {
 	details := &object.Details{
 		Sequence:      objectDetail.Sequence,
 		ResourceOwner: objectDetail.ResourceOwner,
+		Position:      objectDetail.Position,
 	}
 	if !objectDetail.EventDate.IsZero() {
 		details.ChangeDate = timestamppb.New(objectDetail.EventDate)
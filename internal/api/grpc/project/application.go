@@ -42,25 +42,29 @@ func AppConfigToPb(app *query.App) app_pb.AppConfig {
 func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 	return &app_pb.App_OidcConfig{
 		OidcConfig: &app_pb.OIDCConfig{
-			RedirectUris:             app.RedirectURIs,
-			ResponseTypes:            OIDCResponseTypesFromModel(app.ResponseTypes),
-			GrantTypes:               OIDCGrantTypesFromModel(app.GrantTypes),
-			AppType:                  OIDCApplicationTypeToPb(app.AppType),
-			ClientId:                 app.ClientID,
-			AuthMethodType:           OIDCAuthMethodTypeToPb(app.AuthMethodType),
-			PostLogoutRedirectUris:   app.PostLogoutRedirectURIs,
-			Version:                  OIDCVersionToPb(domain.OIDCVersion(app.Version)),
-			NoneCompliant:            len(app.ComplianceProblems) != 0,
-			ComplianceProblems:       ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
-			DevMode:                  app.IsDevMode,
-			AccessTokenType:          oidcTokenTypeToPb(app.AccessTokenType),
-			AccessTokenRoleAssertion: app.AssertAccessTokenRole,
-			IdTokenRoleAssertion:     app.AssertIDTokenRole,
-			IdTokenUserinfoAssertion: app.AssertIDTokenUserinfo,
-			ClockSkew:                durationpb.New(app.ClockSkew),
-			AdditionalOrigins:        app.AdditionalOrigins,
-			AllowedOrigins:           app.AllowedOrigins,
-			SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
+			RedirectUris:                     app.RedirectURIs,
+			ResponseTypes:                    OIDCResponseTypesFromModel(app.ResponseTypes),
+			GrantTypes:                       OIDCGrantTypesFromModel(app.GrantTypes),
+			AppType:                          OIDCApplicationTypeToPb(app.AppType),
+			ClientId:                         app.ClientID,
+			AuthMethodType:                   OIDCAuthMethodTypeToPb(app.AuthMethodType),
+			PostLogoutRedirectUris:           app.PostLogoutRedirectURIs,
+			Version:                          OIDCVersionToPb(domain.OIDCVersion(app.Version)),
+			NoneCompliant:                    len(app.ComplianceProblems) != 0,
+			ComplianceProblems:               ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
+			DevMode:                          app.IsDevMode,
+			AccessTokenType:                  oidcTokenTypeToPb(app.AccessTokenType),
+			AccessTokenRoleAssertion:         app.AssertAccessTokenRole,
+			IdTokenRoleAssertion:             app.AssertIDTokenRole,
+			IdTokenUserinfoAssertion:         app.AssertIDTokenUserinfo,
+			ClockSkew:                        durationpb.New(app.ClockSkew),
+			AdditionalOrigins:                app.AdditionalOrigins,
+			AllowedOrigins:                   app.AllowedOrigins,
+			SkipNativeAppSuccessPage:         app.SkipNativeAppSuccessPage,
+			BackChannelLogoutUri:             app.BackChannelLogoutURI,
+			BackChannelLogoutSessionRequired: app.BackChannelLogoutSessionRequired,
+			DpopBoundAccessTokens:            app.DPoPBoundAccessTokens,
+			RequirePushedAuthRequests:        app.RequirePushedAuthRequests,
 		},
 	}
 }
@@ -68,7 +72,8 @@ func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 func AppSAMLConfigToPb(app *query.SAMLApp) app_pb.AppConfig {
 	return &app_pb.App_SamlConfig{
 		SamlConfig: &app_pb.SAMLConfig{
-			Metadata: &app_pb.SAMLConfig_MetadataXml{MetadataXml: app.Metadata},
+			Metadata:            &app_pb.SAMLConfig_MetadataXml{MetadataXml: app.Metadata},
+			AllowUnsignedLogout: app.AllowUnsignedLogout,
 		},
 	}
 }
@@ -14,12 +14,15 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
+	grpc_util "github.com/zitadel/zitadel/internal/api/grpc"
 	client_middleware "github.com/zitadel/zitadel/internal/api/grpc/client/middleware"
 	"github.com/zitadel/zitadel/internal/api/grpc/server/middleware"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
 	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
 	"github.com/zitadel/zitadel/internal/query"
 )
@@ -31,6 +34,7 @@ const (
 var (
 	customHeaders = []string{
 		"x-zitadel-",
+		http_util.DPoP,
 	}
 	jsonMarshaler = &runtime.JSONPb{
 		UnmarshalOptions: protojson.UnmarshalOptions{
@@ -63,6 +67,7 @@ var (
 		runtime.WithOutgoingHeaderMatcher(runtime.DefaultHeaderMatcher),
 		runtime.WithForwardResponseOption(responseForwarder),
 		runtime.WithRoutingErrorHandler(httpErrorHandler),
+		runtime.WithMetadata(requestMetadata),
 	}
 
 	headerMatcher = runtime.HeaderMatcherFunc(
@@ -76,6 +81,15 @@ var (
 		},
 	)
 
+	// requestMetadata passes the method and path of the HTTP request to the gRPC server,
+	// which are needed to verify DPoP proofs
+	requestMetadata = func(_ context.Context, r *http.Request) metadata.MD {
+		return metadata.Pairs(
+			runtime.MetadataPrefix+grpc_util.GatewayHTTPMethod, r.Method,
+			runtime.MetadataPrefix+grpc_util.GatewayHTTPPath, r.URL.Path,
+		)
+	}
+
 	responseForwarder = func(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
 		t, ok := resp.(CustomHTTPResponse)
 		if ok {
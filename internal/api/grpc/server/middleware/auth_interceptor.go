@@ -33,6 +33,7 @@ func authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		return nil, status.Error(codes.Unauthenticated, "auth header missing")
 	}
 
+	authCtx = withDPoPRequest(authCtx, info.FullMethod)
 	orgID, orgDomain := orgIDAndDomainFromRequest(authCtx, req)
 	ctxSetter, err := authz.CheckUserAuthorization(authCtx, req, authToken, orgID, orgDomain, verifier, authConfig, authOpt, info.FullMethod)
 	if err != nil {
@@ -42,6 +43,17 @@ func authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 	return handler(ctxSetter(ctx), req)
 }
 
+// withDPoPRequest stores the DPoP proof of the call for the token verification.
+// Calls through the gateway are verified against the original HTTP request,
+// native gRPC calls against the path of the gRPC method, which is always called using POST.
+func withDPoPRequest(ctx context.Context, fullMethod string) context.Context {
+	method, path := grpc_util.GetGatewayRequest(ctx)
+	if method == "" {
+		method, path = "POST", fullMethod
+	}
+	return authz.WithDPoPRequest(ctx, grpc_util.GetHeader(ctx, http.DPoP), method, http.ComposedOrigin(ctx)+path)
+}
+
 func orgIDAndDomainFromRequest(ctx context.Context, req interface{}) (id, domain string) {
 	orgID := grpc_util.GetHeader(ctx, http.ZitadelOrgID)
 	oz, ok := req.(OrganizationFromRequest)
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryToStreamInterceptor runs a unary interceptor when a server stream is established.
+// The request message is not received at this point,
+// so the interceptor is called with a nil request.
+// The context passed on by the interceptor is used as context of the stream.
+func UnaryToStreamInterceptor(interceptor grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		_, err := interceptor(
+			stream.Context(),
+			nil,
+			&grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod},
+			func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return nil, handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+			},
+		)
+		return err
+	}
+}
+
+// ValidationStreamHandler validates each message received on a stream.
+func ValidationStreamHandler() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatedServerStream{ServerStream: stream})
+	}
+}
+
+// TranslationStreamHandler translates the localized fields of each message sent on a stream.
+// Errors are translated by [TranslationHandler] wrapped in [UnaryToStreamInterceptor].
+func TranslationStreamHandler() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &translatedServerStream{ServerStream: stream})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+type validatedServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	validate, ok := m.(validator)
+	if !ok {
+		return nil
+	}
+	if err := validate.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+type translatedServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *translatedServerStream) SendMsg(m interface{}) error {
+	if loc, ok := m.(localizers); ok && m != nil {
+		if translator, err := getTranslator(s.Context()); err == nil {
+			translateFields(s.Context(), loc, translator)
+		}
+	}
+	return s.ServerStream.SendMsg(m)
+}
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ctxKey struct{}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	msg interface{}
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockServerStream) RecvMsg(m interface{}) error {
+	*(m.(*mockValidatedReq)) = *(s.msg.(*mockValidatedReq))
+	return nil
+}
+
+type mockValidatedReq struct {
+	err error
+}
+
+func (r *mockValidatedReq) Validate() error {
+	return r.err
+}
+
+func TestUnaryToStreamInterceptor(t *testing.T) {
+	tests := []struct {
+		name        string
+		interceptor grpc.UnaryServerInterceptor
+		wantValue   interface{}
+		wantErr     error
+	}{
+		{
+			name: "context passed to stream",
+			interceptor: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				assert.Nil(t, req)
+				assert.Equal(t, "/service/method", info.FullMethod)
+				return handler(context.WithValue(ctx, ctxKey{}, "value"), req)
+			},
+			wantValue: "value",
+		},
+		{
+			name: "interceptor error",
+			interceptor: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				return nil, errors.ErrUnsupported
+			},
+			wantErr: errors.ErrUnsupported,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotValue interface{}
+			err := UnaryToStreamInterceptor(tt.interceptor)(
+				nil,
+				&mockServerStream{ctx: context.Background()},
+				&grpc.StreamServerInfo{FullMethod: "/service/method", IsServerStream: true},
+				func(_ interface{}, stream grpc.ServerStream) error {
+					gotValue = stream.Context().Value(ctxKey{})
+					return nil
+				},
+			)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantValue, gotValue)
+		})
+	}
+}
+
+func TestValidationStreamHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      *mockValidatedReq
+		wantCode codes.Code
+	}{
+		{
+			name:     "valid",
+			msg:      &mockValidatedReq{},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "invalid",
+			msg:      &mockValidatedReq{err: errors.New("invalid")},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidationStreamHandler()(
+				nil,
+				&mockServerStream{ctx: context.Background(), msg: tt.msg},
+				&grpc.StreamServerInfo{FullMethod: "/service/method", IsServerStream: true},
+				func(_ interface{}, stream grpc.ServerStream) error {
+					return stream.RecvMsg(new(mockValidatedReq))
+				},
+			)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
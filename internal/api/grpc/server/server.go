@@ -64,6 +64,19 @@ func CreateServer(
 				middleware.ActivityInterceptor(),
 			),
 		),
+		grpc.StreamInterceptor(
+			grpc_middleware.ChainStreamServer(
+				middleware.UnaryToStreamInterceptor(middleware.CallDurationHandler()),
+				middleware.UnaryToStreamInterceptor(middleware.InstanceInterceptor(queries, hostHeaderName, externalDomain, system_pb.SystemService_ServiceDesc.ServiceName, healthpb.Health_ServiceDesc.ServiceName)),
+				middleware.UnaryToStreamInterceptor(middleware.ErrorHandler()),
+				middleware.UnaryToStreamInterceptor(middleware.LimitsInterceptor(system_pb.SystemService_ServiceDesc.ServiceName)),
+				middleware.UnaryToStreamInterceptor(middleware.AuthorizationInterceptor(verifier, authConfig)),
+				middleware.UnaryToStreamInterceptor(middleware.TranslationHandler()),
+				middleware.TranslationStreamHandler(),
+				middleware.ValidationStreamHandler(),
+				middleware.UnaryToStreamInterceptor(middleware.ServiceHandler()),
+			),
+		),
 	}
 	if tlsConfig != nil {
 		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
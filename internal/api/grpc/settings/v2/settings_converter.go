@@ -99,6 +99,8 @@ func passwordSettingsToPb(current *query.PasswordComplexityPolicy) *settings.Pas
 		RequiresNumber:    current.HasNumber,
 		RequiresSymbol:    current.HasSymbol,
 		ResourceOwnerType: isDefaultToResourceOwnerTypePb(current.IsDefault),
+		HistoryCount:      current.HistoryCount,
+		CheckBreached:     current.CheckBreached,
 	}
 }
 
@@ -215,12 +215,14 @@ func Test_multiFactorTypeToPb(t *testing.T) {
 
 func Test_passwordSettingsToPb(t *testing.T) {
 	arg := &query.PasswordComplexityPolicy{
-		MinLength:    12,
-		HasUppercase: true,
-		HasLowercase: true,
-		HasNumber:    true,
-		HasSymbol:    true,
-		IsDefault:    true,
+		MinLength:     12,
+		HasUppercase:  true,
+		HasLowercase:  true,
+		HasNumber:     true,
+		HasSymbol:     true,
+		HistoryCount:  5,
+		CheckBreached: true,
+		IsDefault:     true,
 	}
 	want := &settings.PasswordComplexitySettings{
 		MinLength:         12,
@@ -229,6 +231,8 @@ func Test_passwordSettingsToPb(t *testing.T) {
 		RequiresNumber:    true,
 		RequiresSymbol:    true,
 		ResourceOwnerType: settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+		HistoryCount:      5,
+		CheckBreached:     true,
 	}
 
 	got := passwordSettingsToPb(arg)
@@ -0,0 +1,88 @@
+package system
+
+import (
+	"bufio"
+
+	"github.com/zitadel/zitadel/internal/transfer"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
+)
+
+// exportChunkSize is the size of the archive chunks sent to the client
+const exportChunkSize = 1 << 20
+
+func (s *Server) ExportInstance(req *system_pb.ExportInstanceRequest, stream system_pb.SystemService_ExportInstanceServer) error {
+	w := bufio.NewWriterSize(&exportStream{stream: stream}, exportChunkSize)
+	if _, err := s.transfer.Export(stream.Context(), req.InstanceId, w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func (s *Server) ImportInstance(stream system_pb.SystemService_ImportInstanceServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	options := req.GetOptions()
+	if options == nil {
+		return zerrors.ThrowInvalidArgument(nil, "SYSTEM-Xoo3e", "Errors.Transfer.OptionsMissing")
+	}
+	instanceID, summary, err := s.transfer.Import(stream.Context(), &importStream{stream: stream}, ImportInstanceOptionsToRemapping(options))
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(ImportSummaryToPb(instanceID, summary))
+}
+
+// exportStream sends the written archive as chunks
+type exportStream struct {
+	stream system_pb.SystemService_ExportInstanceServer
+}
+
+func (s *exportStream) Write(p []byte) (int, error) {
+	// the message is marshalled during send, so the buffer can be reused afterwards
+	if err := s.stream.Send(&system_pb.ExportInstanceResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// importStream reads the archive from the received chunks
+type importStream struct {
+	stream system_pb.SystemService_ImportInstanceServer
+	chunk  []byte
+}
+
+func (s *importStream) Read(p []byte) (int, error) {
+	for len(s.chunk) == 0 {
+		// io.EOF is returned as soon as the client closed the stream
+		req, err := s.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req.GetOptions() != nil {
+			return 0, zerrors.ThrowInvalidArgument(nil, "SYSTEM-ohT2a", "Errors.Transfer.Archive.Invalid")
+		}
+		s.chunk = req.GetChunk()
+	}
+	n := copy(p, s.chunk)
+	s.chunk = s.chunk[n:]
+	return n, nil
+}
+
+func ImportInstanceOptionsToRemapping(options *system_pb.ImportInstanceOptions) *transfer.Remapping {
+	return &transfer.Remapping{
+		InstanceID: options.InstanceId,
+		Domains:    options.Domains,
+	}
+}
+
+func ImportSummaryToPb(instanceID string, summary *transfer.Summary) *system_pb.ImportInstanceResponse {
+	return &system_pb.ImportInstanceResponse{
+		InstanceId:        instanceID,
+		Events:            summary.Events,
+		UniqueConstraints: summary.UniqueConstraints,
+		Assets:            summary.Assets,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/grpc/server"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/transfer"
 	"github.com/zitadel/zitadel/pkg/grpc/system"
 )
 
@@ -24,6 +25,7 @@ type Server struct {
 	query           *query.Queries
 	defaultInstance command.InstanceSetup
 	externalDomain  string
+	transfer        *transfer.Transfer
 }
 
 type Config struct {
@@ -36,6 +38,7 @@ func CreateServer(
 	database string,
 	defaultInstance command.InstanceSetup,
 	externalDomain string,
+	transfer *transfer.Transfer,
 ) *Server {
 	return &Server{
 		command:         command,
@@ -43,6 +46,7 @@ func CreateServer(
 		database:        database,
 		defaultInstance: defaultInstance,
 		externalDomain:  externalDomain,
+		transfer:        transfer,
 	}
 }
 
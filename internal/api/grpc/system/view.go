@@ -3,6 +3,9 @@ package system
 import (
 	"context"
 
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query"
 	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
 )
@@ -22,3 +25,11 @@ func (s *Server) ClearView(ctx context.Context, req *system_pb.ClearViewRequest)
 	}
 	return &system_pb.ClearViewResponse{}, nil
 }
+
+func (s *Server) RebuildView(req *system_pb.RebuildViewRequest, stream system_pb.SystemService_RebuildViewServer) error {
+	return s.query.RebuildProjection(stream.Context(), req.ViewName, req.InstanceIds, func(progress *handler.RebuildProgress) {
+		// the rebuild is canceled by the context if the client is gone
+		err := stream.Send(RebuildProgressToPb(progress))
+		logging.WithFields("view", req.ViewName).OnError(err).Debug("unable to send rebuild progress")
+	})
+}
@@ -3,6 +3,7 @@ package system
 import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query"
 	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
 )
@@ -23,3 +24,13 @@ func CurrentSequenceToPb(database string, currentSequence *query.CurrentState) *
 		LastSuccessfulSpoolerRun: timestamppb.New(currentSequence.LastRun),
 	}
 }
+
+func RebuildProgressToPb(progress *handler.RebuildProgress) *system_pb.RebuildViewResponse {
+	return &system_pb.RebuildViewResponse{
+		InstanceId:     progress.InstanceID,
+		Instance:       uint32(progress.Instance),
+		Instances:      uint32(progress.Instances),
+		Position:       progress.Position,
+		TargetPosition: progress.TargetPosition,
+	}
+}
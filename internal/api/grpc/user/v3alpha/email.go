@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v3alpha"
+)
+
+func (s *Server) SetContactEmail(ctx context.Context, req *user.SetContactEmailRequest) (_ *user.SetContactEmailResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	schemaUserEmail := &command.ChangeSchemaUserEmail{
+		ID:    req.GetUserId(),
+		Email: setEmailToCommand(req.GetEmail()),
+	}
+	if err := s.command.ChangeSchemaUserEmail(ctx, schemaUserEmail); err != nil {
+		return nil, err
+	}
+	return &user.SetContactEmailResponse{
+		Details:          object.DomainToDetailsPb(schemaUserEmail.Details),
+		VerificationCode: returnCodeToPb(schemaUserEmail.ReturnCode),
+	}, nil
+}
+
+func (s *Server) VerifyContactEmail(ctx context.Context, req *user.VerifyContactEmailRequest) (_ *user.VerifyContactEmailResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.VerifySchemaUserEmail(ctx, "", req.GetUserId(), req.GetVerificationCode())
+	if err != nil {
+		return nil, err
+	}
+	return &user.VerifyContactEmailResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ResendContactEmailCode(ctx context.Context, req *user.ResendContactEmailCodeRequest) (_ *user.ResendContactEmailCodeResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	resend := &command.ResendSchemaUserEmailCode{
+		ID: req.GetUserId(),
+	}
+	switch v := req.GetVerification().(type) {
+	case *user.ResendContactEmailCodeRequest_SendCode:
+		resend.URLTemplate = v.SendCode.GetUrlTemplate()
+	case *user.ResendContactEmailCodeRequest_ReturnCode:
+		resend.ReturnCode = true
+	}
+	if err := s.command.ResendSchemaUserEmailCode(ctx, resend); err != nil {
+		return nil, err
+	}
+	return &user.ResendContactEmailCodeResponse{
+		Details:          object.DomainToDetailsPb(resend.Details),
+		VerificationCode: returnCodeToPb(resend.PlainReturnCode),
+	}, nil
+}
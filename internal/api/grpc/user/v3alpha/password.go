@@ -0,0 +1,71 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v3alpha"
+)
+
+func (s *Server) SetPassword(ctx context.Context, req *user.SetPasswordRequest) (_ *user.SetPasswordResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	password := setPasswordToCommand(req.GetNewPassword())
+	if password != nil {
+		switch v := req.GetVerification().(type) {
+		case *user.SetPasswordRequest_CurrentPassword:
+			password.OldPassword = v.CurrentPassword
+		case *user.SetPasswordRequest_VerificationCode:
+			password.PasswordCode = v.VerificationCode
+		}
+	}
+	schemaUserPassword := &command.SetSchemaUserPassword{
+		UserID:   req.GetUserId(),
+		Password: password,
+	}
+	if err := s.command.SetSchemaUserPassword(ctx, schemaUserPassword); err != nil {
+		return nil, err
+	}
+	return &user.SetPasswordResponse{
+		Details: object.DomainToDetailsPb(schemaUserPassword.Details),
+	}, nil
+}
+
+func (s *Server) RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetRequest) (_ *user.RequestPasswordResetResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	reset := &command.RequestSchemaUserPasswordReset{
+		UserID: req.GetUserId(),
+	}
+	switch v := req.GetMedium().(type) {
+	case *user.RequestPasswordResetRequest_SendEmail:
+		reset.NotificationType = domain.NotificationTypeEmail
+		reset.URLTemplate = v.SendEmail.GetUrlTemplate()
+	case *user.RequestPasswordResetRequest_SendSms:
+		reset.NotificationType = domain.NotificationTypeSms
+	case *user.RequestPasswordResetRequest_ReturnCode:
+		reset.ReturnCode = true
+	}
+	if err := s.command.RequestSchemaUserPasswordReset(ctx, reset); err != nil {
+		return nil, err
+	}
+	return &user.RequestPasswordResetResponse{
+		Details:          object.DomainToDetailsPb(reset.Details),
+		VerificationCode: returnCodeToPb(reset.PlainReturnCode),
+	}, nil
+}
+
+func setPasswordToCommand(password *user.SetPassword) *command.Password {
+	if password == nil {
+		return nil
+	}
+	return &command.Password{
+		Password:            password.GetPassword(),
+		EncodedPasswordHash: password.GetHash(),
+		ChangeRequired:      password.GetChangeRequired(),
+	}
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v3alpha"
+)
+
+func (s *Server) SetContactPhone(ctx context.Context, req *user.SetContactPhoneRequest) (_ *user.SetContactPhoneResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	schemaUserPhone := &command.ChangeSchemaUserPhone{
+		ID:    req.GetUserId(),
+		Phone: setPhoneToCommand(req.GetPhone()),
+	}
+	if err := s.command.ChangeSchemaUserPhone(ctx, schemaUserPhone); err != nil {
+		return nil, err
+	}
+	return &user.SetContactPhoneResponse{
+		Details:   object.DomainToDetailsPb(schemaUserPhone.Details),
+		EmailCode: returnCodeToPb(schemaUserPhone.ReturnCode),
+	}, nil
+}
+
+func (s *Server) VerifyContactPhone(ctx context.Context, req *user.VerifyContactPhoneRequest) (_ *user.VerifyContactPhoneResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.VerifySchemaUserPhone(ctx, "", req.GetUserId(), req.GetVerificationCode())
+	if err != nil {
+		return nil, err
+	}
+	return &user.VerifyContactPhoneResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ResendContactPhoneCode(ctx context.Context, req *user.ResendContactPhoneCodeRequest) (_ *user.ResendContactPhoneCodeResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	resend := &command.ResendSchemaUserPhoneCode{
+		ID: req.GetUserId(),
+	}
+	if _, ok := req.GetVerification().(*user.ResendContactPhoneCodeRequest_ReturnCode); ok {
+		resend.ReturnCode = true
+	}
+	if err := s.command.ResendSchemaUserPhoneCode(ctx, resend); err != nil {
+		return nil, err
+	}
+	return &user.ResendContactPhoneCodeResponse{
+		Details:          object.DomainToDetailsPb(resend.Details),
+		VerificationCode: returnCodeToPb(resend.PlainReturnCode),
+	}, nil
+}
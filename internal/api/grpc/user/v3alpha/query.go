@@ -0,0 +1,275 @@
+package user
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v3alpha"
+)
+
+func (s *Server) GetUserByID(ctx context.Context, req *user.GetUserByIDRequest) (_ *user.GetUserByIDResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	res, err := s.query.GetSchemaUserByID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	u, err := schemaUserToPb(res)
+	if err != nil {
+		return nil, err
+	}
+	return &user.GetUserByIDResponse{
+		User: u,
+	}, nil
+}
+
+func (s *Server) ListUsers(ctx context.Context, req *user.ListUsersRequest) (_ *user.ListUsersResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	queries, err := listUsersRequestToQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchSchemaUsers(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	users, err := schemaUsersToPb(res.Users)
+	if err != nil {
+		return nil, err
+	}
+	return &user.ListUsersResponse{
+		Details:       object.ToListDetails(res.SearchResponse),
+		SortingColumn: req.GetSortingColumn(),
+		Result:        users,
+	}, nil
+}
+
+func schemaUsersToPb(users []*query.SchemaUser) (_ []*user.User, err error) {
+	result := make([]*user.User, len(users))
+	for i, u := range users {
+		result[i], err = schemaUserToPb(u)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func schemaUserToPb(u *query.SchemaUser) (*user.User, error) {
+	var data *structpb.Struct
+	if len(u.Data) > 0 {
+		data = new(structpb.Struct)
+		if err := data.UnmarshalJSON(u.Data); err != nil {
+			return nil, err
+		}
+	}
+	return &user.User{
+		UserId:  u.ID,
+		Details: object.DomainToDetailsPb(&u.ObjectDetails),
+		Contact: contactToPb(u),
+		State:   userStateToPb(u.State),
+		Schema: &user.Schema{
+			Id:       u.SchemaID,
+			Type:     u.SchemaType,
+			Revision: u.SchemaRevision,
+		},
+		Data: data,
+	}, nil
+}
+
+func contactToPb(u *query.SchemaUser) *user.Contact {
+	contact := new(user.Contact)
+	if u.Email != "" {
+		contact.Email = &user.Email{
+			Address:    u.Email,
+			IsVerified: u.IsEmailVerified,
+		}
+	}
+	if u.Phone != "" {
+		contact.Phone = &user.Phone{
+			Number:     u.Phone,
+			IsVerified: u.IsPhoneVerified,
+		}
+	}
+	return contact
+}
+
+func userStateToPb(state domain.UserState) user.State {
+	switch state {
+	case domain.UserStateActive:
+		return user.State_USER_STATE_ACTIVE
+	case domain.UserStateInactive:
+		return user.State_USER_STATE_INACTIVE
+	case domain.UserStateDeleted:
+		return user.State_USER_STATE_DELETED
+	case domain.UserStateLocked:
+		return user.State_USER_STATE_LOCKED
+	case domain.UserStateUnspecified,
+		domain.UserStateInitial,
+		domain.UserStateSuspend:
+		return user.State_USER_STATE_UNSPECIFIED
+	default:
+		return user.State_USER_STATE_UNSPECIFIED
+	}
+}
+
+func userStateToDomain(state user.State) domain.UserState {
+	switch state {
+	case user.State_USER_STATE_ACTIVE:
+		return domain.UserStateActive
+	case user.State_USER_STATE_INACTIVE:
+		return domain.UserStateInactive
+	case user.State_USER_STATE_DELETED:
+		return domain.UserStateDeleted
+	case user.State_USER_STATE_LOCKED:
+		return domain.UserStateLocked
+	case user.State_USER_STATE_UNSPECIFIED:
+		return domain.UserStateUnspecified
+	default:
+		return domain.UserStateUnspecified
+	}
+}
+
+func listUsersRequestToQuery(req *user.ListUsersRequest) (*query.SchemaUserSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToQuery(req.GetQuery())
+	queries, err := userQueriesToQuery(req.GetQueries(), 0) // start at level 0
+	if err != nil {
+		return nil, err
+	}
+	return &query.SchemaUserSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: userFieldNameToSortingColumn(req.GetSortingColumn()),
+		},
+		Queries: queries,
+	}, nil
+}
+
+func userFieldNameToSortingColumn(field user.FieldName) query.Column {
+	switch field {
+	case user.FieldName_FIELD_NAME_CREATION_DATE:
+		return query.SchemaUserCreationDateCol
+	case user.FieldName_FIELD_NAME_CHANGE_DATE:
+		return query.SchemaUserChangeDateCol
+	case user.FieldName_FIELD_NAME_EMAIL:
+		return query.SchemaUserEmailCol
+	case user.FieldName_FIELD_NAME_PHONE:
+		return query.SchemaUserPhoneCol
+	case user.FieldName_FIELD_NAME_STATE:
+		return query.SchemaUserStateCol
+	case user.FieldName_FIELD_NAME_SCHEMA_ID:
+		return query.SchemaUserSchemaIDCol
+	case user.FieldName_FIELD_NAME_SCHEMA_TYPE:
+		return query.UserSchemaTypeCol
+	case user.FieldName_FIELD_NAME_ID,
+		user.FieldName_FIELD_NAME_UNSPECIFIED:
+		return query.SchemaUserIDCol
+	default:
+		return query.SchemaUserIDCol
+	}
+}
+
+func userQueriesToQuery(queries []*user.SearchQuery, level uint8) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = userQueryToQuery(query, level)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func userQueryToQuery(query *user.SearchQuery, level uint8) (query.SearchQuery, error) {
+	if level > 20 {
+		// can't go deeper than 20 levels of nesting.
+		return nil, zerrors.ThrowInvalidArgument(nil, "USERv3-Lq6HtXZ0hY", "Errors.Query.TooManyNestingLevels")
+	}
+	switch q := query.GetQuery().(type) {
+	case *user.SearchQuery_UserIdQuery:
+		return userIDQueryToQuery(q.UserIdQuery)
+	case *user.SearchQuery_OrganizationIdQuery:
+		return organizationIDQueryToQuery(q.OrganizationIdQuery)
+	case *user.SearchQuery_EmailQuery:
+		return emailQueryToQuery(q.EmailQuery)
+	case *user.SearchQuery_PhoneQuery:
+		return phoneQueryToQuery(q.PhoneQuery)
+	case *user.SearchQuery_StateQuery:
+		return stateQueryToQuery(q.StateQuery)
+	case *user.SearchQuery_Schema_IDQuery:
+		return schemaIDQueryToQuery(q.Schema_IDQuery)
+	case *user.SearchQuery_SchemaTypeQuery:
+		return schemaTypeQueryToQuery(q.SchemaTypeQuery)
+	case *user.SearchQuery_OrQuery:
+		return orQueryToQuery(q.OrQuery, level)
+	case *user.SearchQuery_AndQuery:
+		return andQueryToQuery(q.AndQuery, level)
+	case *user.SearchQuery_NotQuery:
+		return notQueryToQuery(q.NotQuery, level)
+	default:
+		// usernames are not projected yet, so they can't be searched
+		return nil, zerrors.ThrowInvalidArgument(nil, "USERv3-b7OVvxXAyc", "List.Query.Invalid")
+	}
+}
+
+func userIDQueryToQuery(q *user.UserIDQuery) (query.SearchQuery, error) {
+	return query.NewSchemaUserIDSearchQuery(q.GetId(), object.TextMethodToQuery(q.GetMethod()))
+}
+
+func organizationIDQueryToQuery(q *user.OrganizationIDQuery) (query.SearchQuery, error) {
+	return query.NewSchemaUserResourceOwnerSearchQuery(q.GetId(), object.TextMethodToQuery(q.GetMethod()))
+}
+
+func emailQueryToQuery(q *user.EmailQuery) (query.SearchQuery, error) {
+	return query.NewSchemaUserEmailSearchQuery(q.GetAddress(), object.TextMethodToQuery(q.GetMethod()))
+}
+
+func phoneQueryToQuery(q *user.PhoneQuery) (query.SearchQuery, error) {
+	return query.NewSchemaUserPhoneSearchQuery(q.GetNumber(), object.TextMethodToQuery(q.GetMethod()))
+}
+
+func stateQueryToQuery(q *user.StateQuery) (query.SearchQuery, error) {
+	return query.NewSchemaUserStateSearchQuery(userStateToDomain(q.GetState()))
+}
+
+func schemaIDQueryToQuery(q *user.SchemaIDQuery) (query.SearchQuery, error) {
+	return query.NewSchemaUserSchemaIDSearchQuery(q.GetId(), query.TextEquals)
+}
+
+func schemaTypeQueryToQuery(q *user.SchemaTypeQuery) (query.SearchQuery, error) {
+	return query.NewSchemaUserSchemaTypeSearchQuery(q.GetType(), object.TextMethodToQuery(q.GetMethod()))
+}
+
+func orQueryToQuery(q *user.OrQuery, level uint8) (query.SearchQuery, error) {
+	mappedQueries, err := userQueriesToQuery(q.GetQueries(), level+1)
+	if err != nil {
+		return nil, err
+	}
+	return query.NewSchemaUserOrSearchQuery(mappedQueries)
+}
+
+func andQueryToQuery(q *user.AndQuery, level uint8) (query.SearchQuery, error) {
+	mappedQueries, err := userQueriesToQuery(q.GetQueries(), level+1)
+	if err != nil {
+		return nil, err
+	}
+	return query.NewSchemaUserAndSearchQuery(mappedQueries)
+}
+
+func notQueryToQuery(q *user.NotQuery, level uint8) (query.SearchQuery, error) {
+	mappedQuery, err := userQueryToQuery(q.GetQuery(), level+1)
+	if err != nil {
+		return nil, err
+	}
+	return query.NewSchemaUserNotSearchQuery(mappedQuery)
+}
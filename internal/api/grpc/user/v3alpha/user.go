@@ -0,0 +1,221 @@
+package user
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	object_pb "github.com/zitadel/zitadel/pkg/grpc/object/v2beta"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v3alpha"
+)
+
+func (s *Server) CreateUser(ctx context.Context, req *user.CreateUserRequest) (_ *user.CreateUserResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	schemaUser, err := s.createUserRequestToCreateSchemaUser(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.command.CreateSchemaUser(ctx, schemaUser); err != nil {
+		return nil, err
+	}
+	return &user.CreateUserResponse{
+		UserId:    schemaUser.ID,
+		Details:   object.DomainToDetailsPb(schemaUser.Details),
+		EmailCode: returnCodeToPb(schemaUser.ReturnCodeEmail),
+		PhoneCode: returnCodeToPb(schemaUser.ReturnCodePhone),
+	}, nil
+}
+
+func (s *Server) createUserRequestToCreateSchemaUser(ctx context.Context, req *user.CreateUserRequest) (*command.CreateSchemaUser, error) {
+	resourceOwner, err := s.organizationToResourceOwner(ctx, req.GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	data, err := dataToJSON(req.GetData())
+	if err != nil {
+		return nil, err
+	}
+	return &command.CreateSchemaUser{
+		ResourceOwner: resourceOwner,
+		SchemaID:      req.GetSchemaId(),
+		ID:            req.GetUserId(),
+		Data:          data,
+		Email:         setEmailToCommand(req.GetContact().GetEmail()),
+		Phone:         setPhoneToCommand(req.GetContact().GetPhone()),
+		Usernames:     setUsernamesToCommand(req.GetAuthenticators().GetUsernames()),
+		Password:      setPasswordToCommand(req.GetAuthenticators().GetPassword()),
+	}, nil
+}
+
+// organizationToResourceOwner returns the id of the organization,
+// which is either passed directly or resolved by its primary domain.
+// If no organization is passed, the organization of the caller is used.
+func (s *Server) organizationToResourceOwner(ctx context.Context, org *object_pb.Organization) (string, error) {
+	switch o := org.GetOrg().(type) {
+	case *object_pb.Organization_OrgId:
+		return o.OrgId, nil
+	case *object_pb.Organization_OrgDomain:
+		resourceOwner, err := s.query.OrgByPrimaryDomain(ctx, o.OrgDomain)
+		if err != nil {
+			return "", err
+		}
+		return resourceOwner.ID, nil
+	default:
+		return authz.GetCtxData(ctx).OrgID, nil
+	}
+}
+
+func (s *Server) UpdateUser(ctx context.Context, req *user.UpdateUserRequest) (_ *user.UpdateUserResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	schemaUser, err := updateUserRequestToChangeSchemaUser(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.command.ChangeSchemaUser(ctx, schemaUser); err != nil {
+		return nil, err
+	}
+	return &user.UpdateUserResponse{
+		Details:   object.DomainToDetailsPb(schemaUser.Details),
+		EmailCode: returnCodeToPb(schemaUser.ReturnCodeEmail),
+		PhoneCode: returnCodeToPb(schemaUser.ReturnCodePhone),
+	}, nil
+}
+
+func updateUserRequestToChangeSchemaUser(req *user.UpdateUserRequest) (*command.ChangeSchemaUser, error) {
+	var data []byte
+	if req.Data != nil {
+		var err error
+		data, err = dataToJSON(req.GetData())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &command.ChangeSchemaUser{
+		ID:       req.GetUserId(),
+		SchemaID: req.SchemaId,
+		Data:     data,
+		Email:    setEmailToCommand(req.GetContact().GetEmail()),
+		Phone:    setPhoneToCommand(req.GetContact().GetPhone()),
+	}, nil
+}
+
+func (s *Server) DeactivateUser(ctx context.Context, req *user.DeactivateUserRequest) (_ *user.DeactivateUserResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.DeactivateSchemaUser(ctx, "", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.DeactivateUserResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ReactivateUser(ctx context.Context, req *user.ReactivateUserRequest) (_ *user.ReactivateUserResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.ReactivateSchemaUser(ctx, "", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.ReactivateUserResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) LockUser(ctx context.Context, req *user.LockUserRequest) (_ *user.LockUserResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.LockSchemaUser(ctx, "", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.LockUserResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UnlockUser(ctx context.Context, req *user.UnlockUserRequest) (_ *user.UnlockUserResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.UnlockSchemaUser(ctx, "", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.UnlockUserResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) DeleteUser(ctx context.Context, req *user.DeleteUserRequest) (_ *user.DeleteUserResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.DeleteSchemaUser(ctx, "", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.DeleteUserResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func dataToJSON(data *structpb.Struct) ([]byte, error) {
+	if data == nil {
+		return nil, nil
+	}
+	return data.MarshalJSON()
+}
+
+func returnCodeToPb(code string) *string {
+	if code == "" {
+		return nil
+	}
+	return &code
+}
+
+func setEmailToCommand(email *user.SetEmail) *command.Email {
+	if email == nil {
+		return nil
+	}
+	cmd := &command.Email{
+		Address: domain.EmailAddress(email.GetAddress()),
+	}
+	switch v := email.GetVerification().(type) {
+	case *user.SetEmail_SendCode:
+		cmd.URLTemplate = v.SendCode.GetUrlTemplate()
+	case *user.SetEmail_ReturnCode:
+		cmd.ReturnCode = true
+	case *user.SetEmail_IsVerified:
+		cmd.Verified = v.IsVerified
+	}
+	return cmd
+}
+
+func setPhoneToCommand(phone *user.SetPhone) *command.Phone {
+	if phone == nil {
+		return nil
+	}
+	cmd := &command.Phone{
+		Number: domain.PhoneNumber(phone.GetNumber()),
+	}
+	switch v := phone.GetVerification().(type) {
+	case *user.SetPhone_ReturnCode:
+		cmd.ReturnCode = true
+	case *user.SetPhone_IsVerified:
+		cmd.Verified = v.IsVerified
+	}
+	return cmd
+}
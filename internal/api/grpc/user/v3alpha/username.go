@@ -0,0 +1,60 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v3alpha"
+)
+
+func (s *Server) AddUsername(ctx context.Context, req *user.AddUsernameRequest) (_ *user.AddUsernameResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	username := &command.AddSchemaUserUsername{
+		UserID:   req.GetUserId(),
+		Username: setUsernameToCommand(req.GetUsername()),
+	}
+	if err := s.command.AddSchemaUserUsername(ctx, username); err != nil {
+		return nil, err
+	}
+	return &user.AddUsernameResponse{
+		Details:    object.DomainToDetailsPb(username.Details),
+		UsernameId: username.ID,
+	}, nil
+}
+
+func (s *Server) RemoveUsername(ctx context.Context, req *user.RemoveUsernameRequest) (_ *user.RemoveUsernameResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.RemoveSchemaUserUsername(ctx, "", req.GetUserId(), req.GetUsernameId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.RemoveUsernameResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func setUsernameToCommand(username *user.SetUsername) *command.Username {
+	if username == nil {
+		return nil
+	}
+	return &command.Username{
+		Username:      username.GetUsername(),
+		IsOrgSpecific: username.GetIsOrganizationSpecific(),
+	}
+}
+
+func setUsernamesToCommand(usernames []*user.SetUsername) []*command.Username {
+	if len(usernames) == 0 {
+		return nil
+	}
+	cmds := make([]*command.Username, len(usernames))
+	for i, username := range usernames {
+		cmds[i] = setUsernameToCommand(username)
+	}
+	return cmds
+}
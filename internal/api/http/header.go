@@ -14,6 +14,7 @@ const (
 	CacheControl    = "cache-control"
 	ContentType     = "content-type"
 	ContentLength   = "content-length"
+	DPoP            = "dpop"
 	Expires         = "expires"
 	Location        = "location"
 	Origin          = "origin"
@@ -38,6 +39,9 @@ const (
 	PermissionsPolicy       = "permissions-policy"
 
 	ZitadelOrgID = "x-zitadel-orgid"
+	// ZitadelMinPosition is the position of the details returned by a previous request,
+	// queries of the request reflect at least the events up to the position
+	ZitadelMinPosition = "x-zitadel-min-position"
 )
 
 type key int
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+// ConsistencyHandler requires the position of the [http_utils.ZitadelMinPosition] header for all reads of the request,
+// so the queried projections are triggered until they reached it.
+// Requests without the header read the projections as they are.
+func ConsistencyHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := database.WithConsistency(r.Context())
+		if position, err := strconv.ParseFloat(r.Header.Get(http_utils.ZitadelMinPosition), 64); err == nil {
+			database.RequirePosition(ctx, position)
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+func Test_ConsistencyHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		header       string
+		wantPosition float64
+	}{
+		{
+			name:         "no header",
+			wantPosition: 0,
+		},
+		{
+			name:         "min position",
+			header:       "1713868843.254893",
+			wantPosition: 1713868843.254893,
+		},
+		{
+			name:         "invalid position",
+			header:       "invalid",
+			wantPosition: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPosition float64
+			testHandler := func(w http.ResponseWriter, r *http.Request) {
+				gotPosition, _ = database.RequiredPosition(r.Context())
+			}
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("x-zitadel-min-position", tt.header)
+			}
+
+			ConsistencyHandler(http.HandlerFunc(testHandler)).ServeHTTP(httptest.NewRecorder(), req)
+			assert.Equal(t, tt.wantPosition, gotPosition)
+		})
+	}
+}
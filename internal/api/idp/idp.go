@@ -22,14 +22,19 @@ import (
 	"github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/idp/providers/apple"
 	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
+	"github.com/zitadel/zitadel/internal/idp/providers/bitbucket"
+	"github.com/zitadel/zitadel/internal/idp/providers/discord"
 	"github.com/zitadel/zitadel/internal/idp/providers/github"
 	"github.com/zitadel/zitadel/internal/idp/providers/gitlab"
 	"github.com/zitadel/zitadel/internal/idp/providers/google"
 	"github.com/zitadel/zitadel/internal/idp/providers/jwt"
+	"github.com/zitadel/zitadel/internal/idp/providers/keycloak"
 	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	openid "github.com/zitadel/zitadel/internal/idp/providers/oidc"
+	"github.com/zitadel/zitadel/internal/idp/providers/okta"
 	saml2 "github.com/zitadel/zitadel/internal/idp/providers/saml"
+	"github.com/zitadel/zitadel/internal/idp/providers/slack"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -256,6 +261,7 @@ func (h *Handler) handleACS(w http.ResponseWriter, r *http.Request) {
 
 	userID, err := h.checkExternalUser(ctx, intent.IDPID, idpUser.GetID())
 	logging.WithFields("intent", intent.AggregateID).OnError(err).Error("could not check if idp user already exists")
+	h.applyClaimMappings(ctx, intent, idpUser, userID)
 
 	token, err := h.commands.SucceedSAMLIDPIntent(ctx, intent, idpUser, userID, session.Assertion)
 	if err != nil {
@@ -312,6 +318,7 @@ func (h *Handler) handleCallback(w http.ResponseWriter, r *http.Request) {
 		userID, err = h.tryMigrateExternalUser(ctx, intent.IDPID, idpUser, idpSession)
 		logging.WithFields("intent", intent.AggregateID).OnError(err).Error("migration check failed")
 	}
+	h.applyClaimMappings(ctx, intent, idpUser, userID)
 
 	token, err := h.commands.SucceedIDPIntent(ctx, intent, idpUser, idpSession, userID)
 	if err != nil {
@@ -321,6 +328,16 @@ func (h *Handler) handleCallback(w http.ResponseWriter, r *http.Request) {
 	redirectToSuccessURL(w, r, intent, token, userID)
 }
 
+// applyClaimMappings updates the metadata and grants of an already linked user according to the claim mappings of the provider.
+// Failures are only logged to not prevent the user from signing in.
+func (h *Handler) applyClaimMappings(ctx context.Context, intent *command.IDPIntentWriteModel, idpUser idp.User, userID string) {
+	if userID == "" {
+		return
+	}
+	err := h.commands.ApplyProviderClaimMappings(ctx, intent.IDPID, userID, idpUser)
+	logging.WithFields("intent", intent.AggregateID, "user", userID).OnError(err).Error("could not apply claim mappings")
+}
+
 func (h *Handler) tryMigrateExternalUser(ctx context.Context, idpID string, idpUser idp.User, idpSession idp.Session) (userID string, err error) {
 	migration, ok := idpSession.(idp.SessionSupportsMigration)
 	if !ok {
@@ -397,6 +414,16 @@ func (h *Handler) fetchIDPUserFromCode(ctx context.Context, identityProvider idp
 		session = &openid.Session{Provider: provider.Provider, Code: code}
 	case *apple.Provider:
 		session = &apple.Session{Session: &openid.Session{Provider: provider.Provider, Code: code}, UserFormValue: appleUser}
+	case *okta.Provider:
+		session = &openid.Session{Provider: provider.Provider, Code: code}
+	case *keycloak.Provider:
+		session = &openid.Session{Provider: provider.Provider, Code: code}
+	case *slack.Provider:
+		session = &openid.Session{Provider: provider.Provider, Code: code}
+	case *bitbucket.Provider:
+		session = &bitbucket.Session{Session: &oauth.Session{Provider: provider.Provider, Code: code}}
+	case *discord.Provider:
+		session = &oauth.Session{Provider: provider.Provider, Code: code}
 	case *jwt.Provider, *ldap.Provider, *saml2.Provider:
 		return nil, nil, zerrors.ThrowInvalidArgument(nil, "IDP-52jmn", "Errors.ExternalIDP.IDPTypeNotImplemented")
 	default:
@@ -35,6 +35,7 @@ type accessToken struct {
 	tokenExpiration   time.Time
 	isPAT             bool
 	actor             *domain.TokenActor
+	dpopJKT           string
 }
 
 var ErrInvalidTokenFormat = errors.New("invalid token format")
@@ -111,6 +112,7 @@ func accessTokenV2(tokenID, subject string, token *query.OIDCSessionAccessTokenR
 		tokenCreation:     token.AccessTokenCreation,
 		tokenExpiration:   token.AccessTokenExpiration,
 		actor:             token.Actor,
+		dpopJKT:           token.DPoPJKT,
 	}
 }
 
@@ -151,6 +151,35 @@ func (o *OPStorage) AuthRequestByID(ctx context.Context, id string) (_ op.AuthRe
 	return AuthRequestFromBusiness(resp)
 }
 
+// resolvePushedAuthRequest replaces the parameters of the authorization request
+// with the ones of the pushed authorization request referenced by the request_uri (RFC 9126, section 4).
+// The pushed request can only be used once and by the client which pushed it.
+// It returns false if the authorization request does not reference a pushed request.
+func (s *Server) resolvePushedAuthRequest(ctx context.Context, r *op.Request[oidc.AuthRequest]) (_ bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	requestURI := r.Form.Get("request_uri")
+	if requestURI == "" {
+		return false, nil
+	}
+	id, err := s.pushedAuthRequestID(requestURI)
+	if err != nil {
+		return false, err
+	}
+	pushed, err := s.command.UsePushedAuthRequest(ctx, id, r.Data.ClientID)
+	if err != nil {
+		return false, oidc.ErrInvalidRequest().WithParent(err).WithDescription("invalid or expired request_uri")
+	}
+	authRequest, err := decodeAuthRequest(pushed.Parameters)
+	if err != nil {
+		return false, err
+	}
+	r.Data = authRequest
+	r.Form = pushed.Parameters
+	return true, nil
+}
+
 func (o *OPStorage) AuthRequestByCode(ctx context.Context, code string) (_ op.AuthRequest, err error) {
 	panic(o.panicErr("AuthRequestByCode"))
 }
@@ -467,6 +496,7 @@ func (s *Server) CreateTokenCallbackURL(ctx context.Context, req op.AuthRequest)
 		req.GetID(),
 		implicitFlowComplianceChecker(),
 		slices.Contains(client.GrantTypes(), oidc.GrantTypeRefreshToken),
+		"",
 	)
 	if err != nil {
 		return "", err
@@ -558,6 +588,7 @@ func (s *Server) authResponseToken(authReq *AuthRequest, authorizer op.Authorize
 		domain.TokenReasonAuthRequest,
 		nil,
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
+		"",
 	)
 	if err != nil {
 		op.AuthRequestError(w, r, authReq, err, authorizer)
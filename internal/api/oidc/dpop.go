@@ -0,0 +1,122 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+)
+
+// errorTypeInvalidDPoPProof is returned if the DPoP proof of a request is missing or invalid (RFC 9449, section 5)
+const errorTypeInvalidDPoPProof = "invalid_dpop_proof"
+
+// tokenRequestDPoPThumbprint verifies the DPoP proof of a token request
+// and returns the JWK thumbprint (jkt) the issued tokens will be bound to.
+// If the client requires DPoP bound access tokens, the proof is mandatory.
+// Otherwise, tokens are only bound if the client sent a proof.
+func tokenRequestDPoPThumbprint[T any](ctx context.Context, r *op.Request[T], required bool) (string, error) {
+	proofs := r.Header.Values(http_utils.DPoP)
+	if len(proofs) == 0 {
+		if required {
+			return "", invalidDPoPProofError(nil, "DPoP proof required")
+		}
+		return "", nil
+	}
+	if len(proofs) > 1 {
+		return "", invalidDPoPProofError(nil, "multiple DPoP proofs")
+	}
+	jkt, err := authz.VerifyDPoPProof(proofs[0], r.Method, op.IssuerFromContext(ctx)+r.URL.Path, "")
+	if err != nil {
+		return "", invalidDPoPProofError(err, "invalid DPoP proof")
+	}
+	return jkt, nil
+}
+
+// tokenType returns the token_type of the access tokens of the session
+func tokenType(dpopJKT string) string {
+	if dpopJKT != "" {
+		return authz.DPoPTokenType
+	}
+	return oidc.BearerToken
+}
+
+// dpopConfirmation adds the confirmation (cnf) claim with the JWK thumbprint to the claims of a DPoP bound token (RFC 9449, section 6).
+// The claims are copied, so the map of the caller is not modified.
+func dpopConfirmation(claims map[string]any, dpopJKT string) map[string]any {
+	if dpopJKT == "" {
+		return claims
+	}
+	confirmed := make(map[string]any, len(claims)+1)
+	for k, v := range claims {
+		confirmed[k] = v
+	}
+	confirmed["cnf"] = map[string]string{"jkt": dpopJKT}
+	return confirmed
+}
+
+func invalidDPoPProofError(parent error, description string) error {
+	return op.NewStatusError(
+		(&oidc.Error{ErrorType: errorTypeInvalidDPoPProof}).WithParent(parent).WithDescription(description),
+		http.StatusBadRequest,
+	)
+}
+
+type dpopSchemeKey struct{}
+
+// userInfoDPoPSchemeHandler accepts access tokens presented with the DPoP authorization scheme
+// at the userinfo endpoint (RFC 9449, section 7.1).
+// The op package only reads access tokens of the Bearer scheme,
+// so the scheme is replaced and remembered in the context for verifyUserInfoDPoPProof.
+func userInfoDPoPSchemeHandler(userInfoPath string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != userInfoPath {
+				next.ServeHTTP(w, r)
+				return
+			}
+			token, ok := strings.CutPrefix(r.Header.Get(http_utils.Authorization), authz.DPoPPrefix)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			r = r.WithContext(context.WithValue(r.Context(), dpopSchemeKey{}, true))
+			r.Header.Set(http_utils.Authorization, oidc.PrefixBearer+token)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isDPoPScheme(ctx context.Context) bool {
+	dpop, _ := ctx.Value(dpopSchemeKey{}).(bool)
+	return dpop
+}
+
+// verifyUserInfoDPoPProof requires a valid DPoP proof for the presented access token,
+// if the token is bound to a key (RFC 9449, section 7).
+// Bound tokens must be presented with the DPoP scheme and unbound tokens with the Bearer scheme.
+func verifyUserInfoDPoPProof(ctx context.Context, r *op.Request[oidc.UserInfoRequest], token *accessToken) error {
+	dpopScheme := isDPoPScheme(ctx)
+	if token.dpopJKT == "" {
+		if dpopScheme {
+			return op.NewStatusError(oidc.ErrAccessDenied().WithDescription("access token is not DPoP bound"), http.StatusUnauthorized)
+		}
+		return nil
+	}
+	if !dpopScheme {
+		return op.NewStatusError(oidc.ErrAccessDenied().WithDescription("DPoP bound access token must use the DPoP authorization scheme"), http.StatusUnauthorized)
+	}
+	proofs := r.Header.Values(http_utils.DPoP)
+	if len(proofs) != 1 {
+		return op.NewStatusError(oidc.ErrAccessDenied().WithDescription("DPoP proof required"), http.StatusUnauthorized)
+	}
+	jkt, err := authz.VerifyDPoPProof(proofs[0], r.Method, op.IssuerFromContext(ctx)+r.URL.Path, r.Data.AccessToken)
+	if err != nil || jkt != token.dpopJKT {
+		return op.NewStatusError((&oidc.Error{ErrorType: errorTypeInvalidDPoPProof}).WithParent(err).WithDescription("invalid DPoP proof"), http.StatusUnauthorized)
+	}
+	return nil
+}
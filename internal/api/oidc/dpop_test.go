@@ -0,0 +1,150 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+)
+
+const (
+	dpopTestIssuer       = "https://issuer.zitadel.ch"
+	dpopTestUserInfoPath = "/oidc/v1/userinfo"
+	dpopTestAccessToken  = "accessToken"
+)
+
+// dpopTestServer verifies the DPoP proof of the userinfo request for a fixed access token
+type dpopTestServer struct {
+	op.UnimplementedServer
+	token *accessToken
+}
+
+func (s *dpopTestServer) UserInfo(ctx context.Context, r *op.Request[oidc.UserInfoRequest]) (*op.Response, error) {
+	if r.Data.AccessToken != dpopTestAccessToken {
+		return nil, op.NewStatusError(oidc.ErrAccessDenied().WithDescription("access token invalid"), http.StatusUnauthorized)
+	}
+	if err := verifyUserInfoDPoPProof(ctx, r, s.token); err != nil {
+		return nil, err
+	}
+	return op.NewResponse(&oidc.UserInfo{Subject: s.token.userID}), nil
+}
+
+func TestServer_UserInfo_dpopScheme(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	thumbprint, err := (&jose.JSONWebKey{Key: &key.PublicKey}).Thumbprint(crypto.SHA256)
+	require.NoError(t, err)
+	jkt := base64.RawURLEncoding.EncodeToString(thumbprint)
+
+	tests := []struct {
+		name          string
+		dpopJKT       string
+		authorization string
+		proof         bool
+		wantStatus    int
+	}{
+		{
+			name:          "bearer token, bearer scheme",
+			authorization: oidc.PrefixBearer + dpopTestAccessToken,
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:          "bearer token, dpop scheme",
+			authorization: authz.DPoPPrefix + dpopTestAccessToken,
+			proof:         true,
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "dpop token, bearer scheme",
+			dpopJKT:       jkt,
+			authorization: oidc.PrefixBearer + dpopTestAccessToken,
+			proof:         true,
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "dpop token, dpop scheme without proof",
+			dpopJKT:       jkt,
+			authorization: authz.DPoPPrefix + dpopTestAccessToken,
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "dpop token, other key",
+			dpopJKT:       "otherKey",
+			authorization: authz.DPoPPrefix + dpopTestAccessToken,
+			proof:         true,
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "dpop token, dpop scheme",
+			dpopJKT:       jkt,
+			authorization: authz.DPoPPrefix + dpopTestAccessToken,
+			proof:         true,
+			wantStatus:    http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := op.RegisterServer(
+				&dpopTestServer{token: &accessToken{userID: "userID", dpopJKT: tt.dpopJKT}},
+				op.Endpoints{Userinfo: op.NewEndpoint(dpopTestUserInfoPath)},
+				op.WithHTTPMiddleware(
+					func(next http.Handler) http.Handler {
+						return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							next.ServeHTTP(w, r.WithContext(op.ContextWithIssuer(r.Context(), dpopTestIssuer)))
+						})
+					},
+					userInfoDPoPSchemeHandler(dpopTestUserInfoPath),
+				),
+			)
+			req := httptest.NewRequest(http.MethodGet, dpopTestIssuer+dpopTestUserInfoPath, nil)
+			req.Header.Set("Authorization", tt.authorization)
+			if tt.proof {
+				req.Header.Set("DPoP", dpopTestProof(t, key, http.MethodGet, dpopTestIssuer+dpopTestUserInfoPath, dpopTestAccessToken))
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func dpopTestProof(t *testing.T, key *ecdsa.PrivateKey, method, url, accessToken string) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: key},
+		&jose.SignerOptions{
+			EmbedJWK: true,
+			ExtraHeaders: map[jose.HeaderKey]any{
+				jose.HeaderType: "dpop+jwt",
+			},
+		},
+	)
+	require.NoError(t, err)
+	payload, err := json.Marshal(map[string]any{
+		"jti": base64.RawURLEncoding.EncodeToString([]byte(t.Name())),
+		"htm": method,
+		"htu": url,
+		"iat": time.Now().Unix(),
+		"ath": authz.DPoPAccessTokenHash(accessToken),
+	})
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	proof, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return proof
+}
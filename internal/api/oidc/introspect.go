@@ -119,7 +119,7 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		Active:                          true,
 		Scope:                           token.scope,
 		ClientID:                        token.clientID,
-		TokenType:                       oidc.BearerToken,
+		TokenType:                       tokenType(token.dpopJKT),
 		Expiration:                      oidc.FromTime(token.tokenExpiration),
 		IssuedAt:                        oidc.FromTime(token.tokenCreation),
 		AuthTime:                        oidc.FromTime(token.authTime),
@@ -131,6 +131,7 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		Actor:                           actorDomainToClaims(token.actor),
 	}
 	introspectionResp.SetUserInfo(userInfo)
+	introspectionResp.Claims = dpopConfirmation(introspectionResp.Claims, token.dpopJKT)
 	return op.NewResponse(introspectionResp), nil
 }
 
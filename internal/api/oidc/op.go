@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 
@@ -43,6 +44,7 @@ type Config struct {
 	DefaultLoginURLV2                 string
 	DefaultLogoutURLV2                string
 	PublicKeyCacheMaxAge              time.Duration
+	PushedAuthRequestLifetime         time.Duration
 }
 
 type EndpointConfig struct {
@@ -54,6 +56,7 @@ type EndpointConfig struct {
 	EndSession    *Endpoint
 	Keys          *Endpoint
 	DeviceAuth    *Endpoint
+	PushedAuth    *Endpoint
 }
 
 type Endpoint struct {
@@ -137,6 +140,8 @@ func NewServer(
 		defaultLogoutURLV2:         config.DefaultLogoutURLV2,
 		defaultAccessTokenLifetime: config.DefaultAccessTokenLifetime,
 		defaultIdTokenLifetime:     config.DefaultIdTokenLifetime,
+		pushedAuthRequestEndpoint:  pushedAuthRequestEndpoint(config.CustomEndpoints),
+		pushedAuthRequestLifetime:  config.PushedAuthRequestLifetime,
 		fallbackLogger:             fallbackLogger,
 		hasher:                     hasher,
 		signingKeyAlgorithm:        config.SigningKeyAlgorithm,
@@ -157,7 +162,12 @@ func NewServer(
 			http_utils.CopyHeadersToContext,
 			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(config.CustomEndpoints)),
 			middleware.ActivityHandler,
-		))
+			userInfoDPoPSchemeHandler(server.Endpoints().Userinfo.Relative()),
+		),
+		op.WithSetRouter(func(r chi.Router) {
+			r.HandleFunc(server.pushedAuthRequestEndpoint.Relative(), server.pushedAuthRequestHandler)
+		}),
+	)
 
 	return server, nil
 }
@@ -0,0 +1,176 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+	"github.com/zitadel/schema"
+
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// requestURIPrefix is the prefix of the request_uri returned by the pushed authorization request endpoint (RFC 9126, section 2.2)
+const requestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+
+// clientAuthenticationParameters are only used to authenticate the client
+// and are not stored as parameters of a pushed authorization request.
+var clientAuthenticationParameters = []string{
+	"client_secret",
+	"client_assertion",
+	"client_assertion_type",
+}
+
+var authRequestDecoder = func() *schema.Decoder {
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	return decoder
+}()
+
+type pushedAuthRequestResponse struct {
+	RequestURI string `json:"request_uri"`
+	ExpiresIn  int64  `json:"expires_in"`
+}
+
+// discoveryConfiguration adds the metadata of the pushed authorization request endpoint (RFC 9126, section 5)
+// and the supported DPoP algorithms (RFC 9449, section 5.1) to the discovery document.
+type discoveryConfiguration struct {
+	*oidc.DiscoveryConfiguration
+	PushedAuthorizationRequestEndpoint string   `json:"pushed_authorization_request_endpoint,omitempty"`
+	DPoPSigningAlgValuesSupported      []string `json:"dpop_signing_alg_values_supported,omitempty"`
+}
+
+func (s *Server) pushedAuthRequestHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.pushAuthRequest(r.Context(), r)
+	if err != nil {
+		op.WriteError(w, r, err, s.getLogger(r.Context()))
+		return
+	}
+	httphelper.MarshalJSONWithStatus(w, resp, http.StatusCreated)
+}
+
+// pushAuthRequest authenticates the client and stores the parameters of the authorization request.
+// The returned request_uri can be used once by the client at the authorization endpoint (RFC 9126, section 2).
+func (s *Server) pushAuthRequest(ctx context.Context, r *http.Request) (_ *pushedAuthRequestResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = oidcError(err)
+		span.EndWithError(err)
+	}()
+
+	if r.Method != http.MethodPost {
+		return nil, op.NewStatusError(oidc.ErrInvalidRequest().WithDescription("method must be POST"), http.StatusMethodNotAllowed)
+	}
+	if err = r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithParent(err).WithDescription("error parsing form")
+	}
+	credentials, err := pushedAuthRequestClientCredentials(r)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Data:   credentials,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := make(url.Values, len(r.PostForm))
+	for key, values := range r.PostForm {
+		parameters[key] = values
+	}
+	for _, key := range clientAuthenticationParameters {
+		parameters.Del(key)
+	}
+	if parameters.Has("request_uri") {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request_uri is not allowed in a pushed authorization request")
+	}
+	if clientID := parameters.Get("client_id"); clientID != "" && clientID != client.GetID() {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id does not match the authenticated client")
+	}
+	parameters.Set("client_id", client.GetID())
+	authRequest, err := decodeAuthRequest(parameters)
+	if err != nil {
+		return nil, err
+	}
+	if err = op.ValidateAuthReqRedirectURI(client, authRequest.RedirectURI, authRequest.ResponseType); err != nil {
+		return nil, err
+	}
+
+	pushed, err := s.command.AddPushedAuthRequest(ctx, client.GetID(), parameters, time.Now().Add(s.pushedAuthRequestLifetime))
+	if err != nil {
+		return nil, err
+	}
+	requestURI, err := s.opCrypto.Encrypt(pushed.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &pushedAuthRequestResponse{
+		RequestURI: requestURIPrefix + requestURI,
+		ExpiresIn:  int64(s.pushedAuthRequestLifetime / time.Second),
+	}, nil
+}
+
+// pushedAuthRequestClientCredentials reads the client credentials from the form,
+// where basic auth takes precedence over the form.
+func pushedAuthRequestClientCredentials(r *http.Request) (_ *op.ClientCredentials, err error) {
+	credentials := &op.ClientCredentials{
+		ClientID:            r.PostForm.Get("client_id"),
+		ClientSecret:        r.PostForm.Get("client_secret"),
+		ClientAssertion:     r.PostForm.Get("client_assertion"),
+		ClientAssertionType: r.PostForm.Get("client_assertion_type"),
+	}
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		return credentials, nil
+	}
+	credentials.ClientID, err = url.QueryUnescape(clientID)
+	if err != nil {
+		return nil, oidc.ErrInvalidClient().WithParent(err).WithDescription("invalid basic auth header")
+	}
+	credentials.ClientSecret, err = url.QueryUnescape(clientSecret)
+	if err != nil {
+		return nil, oidc.ErrInvalidClient().WithParent(err).WithDescription("invalid basic auth header")
+	}
+	return credentials, nil
+}
+
+// pushedAuthRequestID returns the ID of the pushed authorization request referenced by the request_uri
+func (s *Server) pushedAuthRequestID(requestURI string) (string, error) {
+	encrypted, ok := strings.CutPrefix(requestURI, requestURIPrefix)
+	if !ok {
+		return "", oidc.ErrInvalidRequest().WithDescription("invalid request_uri")
+	}
+	id, err := s.opCrypto.Decrypt(encrypted)
+	if err != nil {
+		return "", oidc.ErrInvalidRequest().WithParent(err).WithDescription("invalid request_uri")
+	}
+	return id, nil
+}
+
+func decodeAuthRequest(parameters url.Values) (*oidc.AuthRequest, error) {
+	authRequest := new(oidc.AuthRequest)
+	if err := authRequestDecoder.Decode(authRequest, parameters); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithParent(err).WithDescription("error decoding authorization request")
+	}
+	return authRequest, nil
+}
+
+// requirePushedAuthRequests returns true if the client may only start authorization requests
+// with a request_uri obtained from the pushed authorization request endpoint.
+func requirePushedAuthRequests(client op.Client) bool {
+	c, ok := client.(*Client)
+	if !ok {
+		return false
+	}
+	return c.client.RequirePushedAuthRequests ||
+		c.client.Settings != nil && c.client.Settings.RequirePushedAuthRequests
+}
@@ -0,0 +1,104 @@
+package oidc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_pushedAuthRequestClientCredentials(t *testing.T) {
+	tests := []struct {
+		name      string
+		form      url.Values
+		basicAuth []string
+		want      *op.ClientCredentials
+		wantErr   bool
+	}{
+		{
+			name: "form",
+			form: url.Values{
+				"client_id":             {"clientID"},
+				"client_assertion":      {"assertion"},
+				"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+			},
+			want: &op.ClientCredentials{
+				ClientID:            "clientID",
+				ClientAssertion:     "assertion",
+				ClientAssertionType: "urn:ietf:params:oauth:client-assertion-type:jwt-bearer",
+			},
+		},
+		{
+			name: "basic auth overwrites form",
+			form: url.Values{
+				"client_id":     {"formClientID"},
+				"client_secret": {"formSecret"},
+			},
+			basicAuth: []string{"client%40ID", "secret"},
+			want: &op.ClientCredentials{
+				ClientID:     "client@ID",
+				ClientSecret: "secret",
+			},
+		},
+		{
+			name:      "invalid basic auth",
+			basicAuth: []string{"client%ZZ", "secret"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/oauth/v2/par", strings.NewReader(tt.form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tt.basicAuth != nil {
+				r.SetBasicAuth(tt.basicAuth[0], tt.basicAuth[1])
+			}
+			require.NoError(t, r.ParseForm())
+			got, err := pushedAuthRequestClientCredentials(r)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_requirePushedAuthRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		client op.Client
+		want   bool
+	}{
+		{
+			name:   "not required",
+			client: &Client{client: &query.OIDCClient{}},
+			want:   false,
+		},
+		{
+			name:   "required by client",
+			client: &Client{client: &query.OIDCClient{RequirePushedAuthRequests: true}},
+			want:   true,
+		},
+		{
+			name: "required by instance",
+			client: &Client{client: &query.OIDCClient{
+				Settings: &query.OIDCSettings{RequirePushedAuthRequests: true},
+			}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, requirePushedAuthRequests(tt.client))
+		})
+	}
+}
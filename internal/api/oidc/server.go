@@ -10,6 +10,7 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/auth/repository"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
@@ -34,6 +35,9 @@ type Server struct {
 	defaultAccessTokenLifetime time.Duration
 	defaultIdTokenLifetime     time.Duration
 
+	pushedAuthRequestEndpoint *op.Endpoint
+	pushedAuthRequestLifetime time.Duration
+
 	fallbackLogger      *slog.Logger
 	hasher              *crypto.Hasher
 	signingKeyAlgorithm string
@@ -86,6 +90,13 @@ func endpoints(endpointConfig *EndpointConfig) op.Endpoints {
 	return endpoints
 }
 
+func pushedAuthRequestEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.PushedAuth == nil {
+		return op.NewEndpoint("/oauth/v2/par")
+	}
+	return op.NewEndpointWithURL(endpointConfig.PushedAuth.Path, endpointConfig.PushedAuth.URL)
+}
+
 func (s *Server) getLogger(ctx context.Context) *slog.Logger {
 	if logger, ok := logging.FromContext(ctx); ok {
 		return logger
@@ -125,7 +136,11 @@ func (s *Server) Discovery(ctx context.Context, r *op.Request[struct{}]) (_ *op.
 	if len(allowedLanguages) == 0 {
 		allowedLanguages = i18n.SupportedLanguages()
 	}
-	return op.NewResponse(s.createDiscoveryConfig(ctx, allowedLanguages)), nil
+	return op.NewResponse(&discoveryConfiguration{
+		DiscoveryConfiguration:             s.createDiscoveryConfig(ctx, allowedLanguages),
+		PushedAuthorizationRequestEndpoint: s.pushedAuthRequestEndpoint.Absolute(op.IssuerFromContext(ctx)),
+		DPoPSigningAlgValuesSupported:      authz.DPoPSigningAlgorithms(),
+	}), nil
 }
 
 func (s *Server) Keys(ctx context.Context, r *op.Request[struct{}]) (_ *op.Response, err error) {
@@ -139,7 +154,18 @@ func (s *Server) VerifyAuthRequest(ctx context.Context, r *op.Request[oidc.AuthR
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.VerifyAuthRequest(ctx, r)
+	pushed, err := s.resolvePushedAuthRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	clientRequest, err := s.LegacyServer.VerifyAuthRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if !pushed && requirePushedAuthRequests(clientRequest.Client) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("pushed authorization request required")
+	}
+	return clientRequest, nil
 }
 
 func (s *Server) Authorize(ctx context.Context, r *op.ClientRequest[oidc.AuthRequest]) (_ *op.Redirect, err error) {
@@ -34,7 +34,7 @@ func (s *Server) accessTokenResponseFromSession(ctx context.Context, client op.C
 	getSigner := s.getSignerOnce()
 
 	resp := &oidc.AccessTokenResponse{
-		TokenType:    oidc.BearerToken,
+		TokenType:    tokenType(session.DPoPJKT),
 		RefreshToken: session.RefreshToken,
 		ExpiresIn:    timeToOIDCExpiresIn(session.Expiration),
 		State:        state,
@@ -171,7 +171,7 @@ func (s *Server) createJWT(ctx context.Context, client op.Client, session *comma
 		client.ClockSkew(),
 	)
 	claims.Actor = actorDomainToClaims(session.Actor)
-	claims.Claims = userInfo.Claims
+	claims.Claims = dpopConfirmation(userInfo.Claims, session.DPoPJKT)
 
 	return crypto.Sign(claims, signer)
 }
@@ -26,12 +26,17 @@ func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.Refr
 		return nil, zerrors.ThrowInternal(nil, "OIDC-ga0EP", "Error.Internal")
 	}
 
-	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, refreshTokenComplianceChecker())
+	dpopJKT, err := tokenRequestDPoPThumbprint(ctx, r.Request, client.client.DPoPBoundAccessTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, refreshTokenComplianceChecker(dpopJKT))
 	if err == nil {
 		return response(s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion))
 	} else if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-JOI23", "Errors.OIDCSession.RefreshTokenInvalid")) {
 		// We try again for v1 tokens when we encountered specific parsing error
-		return s.refreshTokenV1(ctx, client, r)
+		return s.refreshTokenV1(ctx, client, r, dpopJKT)
 	}
 	return nil, err
 }
@@ -41,7 +46,7 @@ func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.Refr
 // This "upgrades" existing v1 sessions to v2 session without requiring users to re-login.
 //
 // This function can be removed when we retire the v1 token repo.
-func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.ClientRequest[oidc.RefreshTokenRequest]) (_ *op.Response, err error) {
+func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.ClientRequest[oidc.RefreshTokenRequest], dpopJKT string) (_ *op.Response, err error) {
 	refreshToken, err := s.repo.RefreshTokenByToken(ctx, r.Data.RefreshToken)
 	if err != nil {
 		return nil, err
@@ -67,6 +72,7 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 		domain.TokenReasonRefresh,
 		refreshToken.Actor,
 		true,
+		dpopJKT,
 	)
 	if err != nil {
 		return nil, err
@@ -81,9 +87,13 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 	return response(s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion))
 }
 
-// refreshTokenComplianceChecker validates that the requested scope is a subset of the original auth request scope.
-func refreshTokenComplianceChecker() command.RefreshTokenComplianceChecker {
+// refreshTokenComplianceChecker validates that the requested scope is a subset of the original auth request scope
+// and that the DPoP proof was signed by the key the session is bound to.
+func refreshTokenComplianceChecker(dpopJKT string) command.RefreshTokenComplianceChecker {
 	return func(_ context.Context, model *command.OIDCSessionWriteModel, requestedScope []string) ([]string, error) {
+		if model.DPoPJKT != "" && model.DPoPJKT != dpopJKT {
+			return nil, invalidDPoPProofError(nil, "DPoP proof does not match the key the refresh token is bound to")
+		}
 		return validateRefreshTokenScopes(model.Scope, requestedScope)
 	}
 }
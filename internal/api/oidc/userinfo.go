@@ -44,6 +44,9 @@ func (s *Server) UserInfo(ctx context.Context, r *op.Request[oidc.UserInfoReques
 	if err != nil {
 		return nil, op.NewStatusError(oidc.ErrAccessDenied().WithDescription("access token invalid").WithParent(err), http.StatusUnauthorized)
 	}
+	if err = verifyUserInfoDPoPProof(ctx, r, token); err != nil {
+		return nil, err
+	}
 
 	var (
 		projectID string
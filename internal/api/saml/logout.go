@@ -0,0 +1,308 @@
+package saml
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/key"
+	"github.com/zitadel/saml/pkg/provider/serviceprovider"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/api/saml/slo"
+	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// logoutInterceptor handles the single logout endpoint instead of the saml library,
+// which only answers logout requests without terminating any session.
+//
+// The logout is propagated over the front-channel:
+// every request to the endpoint either records a logout request of a service provider,
+// a logout response to a previously sent logout request or starts an identity provider initiated logout.
+// Afterwards the user agent is redirected to the next service provider of the user agent,
+// which is still logged in and provides a single logout service with HTTP-Redirect or HTTP-POST binding.
+// Once all of them are logged out, the logout response is sent to the requesting service provider
+// or the user agent is redirected to the logout page of the login UI.
+//
+// Service providers with a single logout service with SOAP binding are logged out over the back-channel
+// by the notification handler as soon as the users of the user agent are signed out.
+type logoutInterceptor struct {
+	storage       *Storage
+	endpoint      string
+	logoutDoneURL string
+}
+
+func newLogoutInterceptor(storage *Storage, config *provider.Config) *logoutInterceptor {
+	endpoint := provider.NewEndpoint(provider.DefaultSingleLogOutEndpoint)
+	if config != nil && config.IDPConfig != nil && config.IDPConfig.Endpoints != nil && config.IDPConfig.Endpoints.SingleLogOut != nil {
+		endpoint = *config.IDPConfig.Endpoints.SingleLogOut
+	}
+	return &logoutInterceptor{
+		storage:       storage,
+		endpoint:      endpoint.Relative(),
+		logoutDoneURL: login.HandlerPrefix + login.EndpointLogoutDone,
+	}
+}
+
+func (l *logoutInterceptor) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != l.endpoint {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if err := l.handle(w, r); err != nil {
+			logging.WithError(err).Warn("saml single logout failed")
+			statusCode, ok := http_utils.ZitadelErrorToHTTPStatusCode(err)
+			if !ok {
+				statusCode = http.StatusBadRequest
+			}
+			http.Error(w, err.Error(), statusCode)
+		}
+	})
+}
+
+func (l *logoutInterceptor) handle(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
+	if !ok {
+		return zerrors.ThrowPreconditionFailed(nil, "SAML-kOp94", "no user agent id")
+	}
+	request, err := slo.ParseRequest(r, slo.ParamRequest)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "SAML-z0GRA", "invalid logout request")
+	}
+	if request != nil {
+		responded, err := l.logoutRequested(ctx, w, r, userAgentID, request)
+		if err != nil || responded {
+			return err
+		}
+		return l.propagate(ctx, w, r, userAgentID)
+	}
+	response, err := slo.ParseRequest(r, slo.ParamResponse)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "SAML-jpwQt", "invalid logout response")
+	}
+	if response != nil {
+		if err = l.logoutResponded(ctx, response); err != nil {
+			return err
+		}
+		return l.propagate(ctx, w, r, userAgentID)
+	}
+	if err = l.signOut(ctx, userAgentID, ""); err != nil {
+		return err
+	}
+	return l.propagate(ctx, w, r, userAgentID)
+}
+
+// logoutRequested verifies the logout request of a service provider and signs out the users of the user agent.
+// The logout response will be sent after all other service providers are logged out.
+// If the user agent has no session at the service provider, the response is sent directly and responded is true.
+func (l *logoutInterceptor) logoutRequested(ctx context.Context, w http.ResponseWriter, r *http.Request, userAgentID string, message *slo.Message) (responded bool, err error) {
+	request, err := message.LogoutRequest()
+	if err != nil {
+		return false, zerrors.ThrowInvalidArgument(err, "SAML-ecXBp", "invalid logout request")
+	}
+	if request.Issuer == nil || request.NameID == nil {
+		return false, zerrors.ThrowInvalidArgument(nil, "SAML-4YeAB", "logout request is missing issuer or name id")
+	}
+	if request.NotOnOrAfter != "" {
+		notOnOrAfter, err := time.Parse(time.RFC3339, request.NotOnOrAfter)
+		if err != nil || !time.Now().Before(notOnOrAfter) {
+			return false, zerrors.ThrowInvalidArgument(err, "SAML-S8iQB", "logout request expired")
+		}
+	}
+	sp, config, err := l.storage.serviceProviderByEntityID(ctx, request.Issuer.Text)
+	if err != nil {
+		return false, err
+	}
+	if err = verifySignature(sp, config, message); err != nil {
+		return false, err
+	}
+	service := slo.ServiceByBinding(slo.Services(sp.Metadata), message.Binding, provider.RedirectBinding, provider.PostBinding)
+	if service == nil {
+		return false, zerrors.ThrowPreconditionFailed(nil, "SAML-WkL0h", "service provider has no single logout service")
+	}
+	sessions, err := l.storage.query.ActiveSAMLSessionsByUserAgent(ctx, userAgentID, "")
+	if err != nil {
+		return false, err
+	}
+	for _, session := range sessions {
+		if session.EntityID != request.Issuer.Text || session.NameID != request.NameID.Text {
+			continue
+		}
+		err = l.storage.command.RequestSAMLSessionLogout(ctx, session.ID, session.ResourceOwner, request.Id, message.RelayState, service.Binding, slo.ResponseLocation(service))
+		if err != nil {
+			return false, err
+		}
+		return false, l.signOut(ctx, userAgentID, session.UserID)
+	}
+	if err = l.signOut(ctx, userAgentID, ""); err != nil {
+		return false, err
+	}
+	certAndKey, err := l.storage.GetResponseSigningKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	response := slo.NewLogoutResponse(l.storage.issuer(ctx), slo.ResponseLocation(service), request.Id, provider.StatusCodeSuccess, "")
+	return true, send(w, r, service.Binding, slo.ResponseLocation(service), slo.ParamResponse, response, message.RelayState, certAndKey)
+}
+
+// logoutResponded terminates the SAML session, to which the logout request was sent.
+func (l *logoutInterceptor) logoutResponded(ctx context.Context, message *slo.Message) error {
+	response, err := message.LogoutResponse()
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "SAML-gZEKK", "invalid logout response")
+	}
+	session, err := l.storage.query.SAMLSessionByLogoutRequestID(ctx, response.InResponseTo)
+	if err != nil {
+		return err
+	}
+	if response.Issuer != nil && response.Issuer.Text != session.EntityID {
+		return zerrors.ThrowInvalidArgument(nil, "SAML-96KIZ", "logout response issuer does not match")
+	}
+	sp, config, err := l.storage.serviceProviderByEntityID(ctx, session.EntityID)
+	if err != nil && !zerrors.IsNotFound(err) && !zerrors.IsPreconditionFailed(err) {
+		return err
+	}
+	// the app might have been removed in the meantime, in which case the session can be terminated anyway
+	if err == nil {
+		if err = verifySignature(sp, config, message); err != nil {
+			return err
+		}
+	}
+	if response.Status.StatusCode.Value != provider.StatusCodeSuccess {
+		logging.WithFields("app", session.AppID, "status", response.Status.StatusCode.Value, "message", response.Status.StatusMessage).
+			Warn("service provider did not confirm logout")
+	}
+	return l.storage.command.TerminateSAMLSession(ctx, session.ID, session.ResourceOwner)
+}
+
+// signOut terminates the sessions of all users of the user agent.
+func (l *logoutInterceptor) signOut(ctx context.Context, userAgentID, userID string) error {
+	userIDs, err := l.storage.repo.UserSessionUserIDsByAgentID(ctx, userAgentID)
+	if err != nil || len(userIDs) == 0 {
+		return err
+	}
+	return l.storage.command.HumansSignOut(authz.SetCtxData(ctx, authz.CtxData{UserID: userID}), userAgentID, userIDs)
+}
+
+// propagate sends a logout request to the next service provider of the user agent,
+// which needs to be logged out over the front-channel.
+// If there is none left, the logout is completed.
+func (l *logoutInterceptor) propagate(ctx context.Context, w http.ResponseWriter, r *http.Request, userAgentID string) error {
+	sessions, err := l.storage.query.SAMLSessionsByUserAgent(ctx, userAgentID)
+	if err != nil {
+		return err
+	}
+	var initiator *query.SAMLSession
+	for _, session := range sessions {
+		switch session.State {
+		case domain.SAMLSessionStateLogoutRequested:
+			initiator = session
+		case domain.SAMLSessionStateActive:
+			sent, err := l.sendLogoutRequest(ctx, w, r, session)
+			if err != nil || sent {
+				return err
+			}
+		case domain.SAMLSessionStateUnspecified,
+			domain.SAMLSessionStateLogoutPending,
+			domain.SAMLSessionStateTerminated:
+		}
+	}
+	if initiator != nil {
+		return l.sendLogoutResponse(ctx, w, r, initiator)
+	}
+	http.Redirect(w, r, l.logoutDoneURL, http.StatusFound)
+	return nil
+}
+
+// sendLogoutRequest sends the logout request to the service provider of the session over the front-channel.
+// It returns false if the service provider does not need to be logged out over the front-channel.
+func (l *logoutInterceptor) sendLogoutRequest(ctx context.Context, w http.ResponseWriter, r *http.Request, session *query.SAMLSession) (sent bool, err error) {
+	sp, err := l.storage.GetEntityByID(ctx, session.EntityID)
+	if zerrors.IsNotFound(err) || zerrors.IsPreconditionFailed(err) {
+		return false, l.storage.command.TerminateSAMLSession(ctx, session.ID, session.ResourceOwner)
+	}
+	if err != nil {
+		return false, err
+	}
+	services := slo.Services(sp.Metadata)
+	if slo.ServiceByBinding(services, provider.SOAPBinding) != nil {
+		return false, nil
+	}
+	service := slo.ServiceByBinding(services, provider.RedirectBinding, provider.PostBinding)
+	if service == nil {
+		return false, l.storage.command.TerminateSAMLSession(ctx, session.ID, session.ResourceOwner)
+	}
+	certAndKey, err := l.storage.GetResponseSigningKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	request := slo.NewLogoutRequest(session.Issuer, service.Location, session.NameID)
+	if err = l.storage.command.SAMLSessionLogoutSent(ctx, session.ID, session.ResourceOwner, request.Id, service.Binding); err != nil {
+		return false, err
+	}
+	return true, send(w, r, service.Binding, service.Location, slo.ParamRequest, request, "", certAndKey)
+}
+
+// sendLogoutResponse terminates the session of the service provider, which requested the logout
+// and sends the logout response to it.
+func (l *logoutInterceptor) sendLogoutResponse(ctx context.Context, w http.ResponseWriter, r *http.Request, session *query.SAMLSession) error {
+	if err := l.storage.command.TerminateSAMLSession(ctx, session.ID, session.ResourceOwner); err != nil {
+		return err
+	}
+	certAndKey, err := l.storage.GetResponseSigningKey(ctx)
+	if err != nil {
+		return err
+	}
+	response := slo.NewLogoutResponse(session.Issuer, session.LogoutResponseURL, session.LogoutRequestID, provider.StatusCodeSuccess, "")
+	return send(w, r, session.LogoutBinding, session.LogoutResponseURL, slo.ParamResponse, response, session.LogoutRelayState, certAndKey)
+}
+
+// send sends the logout message to the service provider using the binding.
+// Messages sent over HTTP-POST are signed with an enveloped signature, the ones over HTTP-Redirect by the query parameters.
+func send(w http.ResponseWriter, r *http.Request, binding, location, param string, message any, relayState string, certAndKey *key.CertificateAndKey) (err error) {
+	if binding == provider.RedirectBinding {
+		redirectURL, err := slo.RedirectURL(location, param, message, relayState, certAndKey)
+		if err != nil {
+			return err
+		}
+		http.Redirect(w, r, redirectURL, http.StatusFound)
+		return nil
+	}
+	switch m := message.(type) {
+	case *slo.LogoutRequest:
+		err = slo.SignRequest(m, certAndKey)
+	case *samlp.LogoutResponseType:
+		err = slo.SignResponse(m, certAndKey)
+	}
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	return slo.WritePostForm(w, location, param, message, relayState)
+}
+
+// verifySignature verifies the signature of the message with the signing certificates of the service provider.
+// Unsigned messages are rejected, unless the app explicitly allows unsigned logout messages.
+// Signed messages are always verified.
+func verifySignature(sp *serviceprovider.ServiceProvider, config *query.SAMLApp, message *slo.Message) error {
+	if !message.Signed() {
+		if config.AllowUnsignedLogout {
+			return nil
+		}
+		return zerrors.ThrowPermissionDenied(nil, "SAML-Ee2ai", "logout message is not signed")
+	}
+	if err := message.VerifySignature(sp.Metadata); err != nil {
+		return zerrors.ThrowPermissionDenied(err, "SAML-D9Yjv", "invalid signature")
+	}
+	return nil
+}
@@ -44,6 +44,7 @@ func NewProvider(
 	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
 
 	provStorage, err := newStorage(
+		conf.ProviderConfig,
 		command,
 		query,
 		repo,
@@ -66,6 +67,7 @@ func NewProvider(
 			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(conf.ProviderConfig)),
 			http_utils.CopyHeadersToContext,
 			middleware.ActivityHandler,
+			newLogoutInterceptor(provStorage, conf.ProviderConfig).Handler,
 		),
 		provider.WithCustomTimeFormat("2006-01-02T15:04:05.999Z"),
 	}
@@ -82,6 +84,7 @@ func NewProvider(
 }
 
 func newStorage(
+	config *provider.Config,
 	command *command.Commands,
 	query *query.Queries,
 	repo repository.Repository,
@@ -90,15 +93,20 @@ func newStorage(
 	es *eventstore.Eventstore,
 	db *database.DB,
 ) (*Storage, error) {
+	metadataEndpoint := provider.NewEndpoint(provider.DefaultMetadataEndpoint)
+	if config != nil && config.Metadata != nil {
+		metadataEndpoint = *config.Metadata
+	}
 	return &Storage{
-		encAlg:          encAlg,
-		certEncAlg:      certEncAlg,
-		locker:          crdb.NewLocker(db.DB, locksTable, signingKey),
-		eventstore:      es,
-		repo:            repo,
-		command:         command,
-		query:           query,
-		defaultLoginURL: fmt.Sprintf("%s%s?%s=", login.HandlerPrefix, login.EndpointLogin, login.QueryAuthRequestID),
+		encAlg:           encAlg,
+		certEncAlg:       certEncAlg,
+		locker:           crdb.NewLocker(db.DB, locksTable, signingKey),
+		eventstore:       es,
+		repo:             repo,
+		command:          command,
+		query:            query,
+		defaultLoginURL:  fmt.Sprintf("%s%s?%s=", login.HandlerPrefix, login.EndpointLogin, login.QueryAuthRequestID),
+		metadataEndpoint: metadataEndpoint,
 	}, nil
 }
 
@@ -0,0 +1,416 @@
+// Package slo implements the parts of the SAML 2.0 single logout profile,
+// which are not provided by the saml library:
+// creating and signing logout requests and responses sent by ZITADEL as identity provider
+// and decoding and verifying the ones received from the service providers.
+package slo
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/key"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	saml_xml "github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+	"github.com/zitadel/saml/pkg/provider/xml/xml_dsig"
+)
+
+const (
+	// SignatureAlgorithm is used to sign the logout messages
+	SignatureAlgorithm = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	// SOAPAction is the value of the SOAPAction header required by the SAML SOAP binding
+	SOAPAction = "http://www.oasis-open.org/committees/security"
+
+	ParamRequest    = "SAMLRequest"
+	ParamResponse   = "SAMLResponse"
+	ParamRelayState = "RelayState"
+	paramSigAlg     = "SigAlg"
+	paramSignature  = "Signature"
+
+	TimeFormat = "2006-01-02T15:04:05.999Z"
+
+	issuerFormat    = "urn:oasis:names:tc:SAML:2.0:nameid-format:entity"
+	nameIDFormat    = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	requestLifetime = 5 * time.Minute
+)
+
+// LogoutRequest is the logout request sent to the service providers.
+// In contrast to samlp.LogoutRequestType the elements are marshalled in the order required by the schema.
+type LogoutRequest struct {
+	XMLName      xml.Name                `xml:"urn:oasis:names:tc:SAML:2.0:protocol LogoutRequest"`
+	Id           string                  `xml:"ID,attr"`
+	Version      string                  `xml:"Version,attr"`
+	IssueInstant string                  `xml:"IssueInstant,attr"`
+	Destination  string                  `xml:"Destination,attr,omitempty"`
+	NotOnOrAfter string                  `xml:"NotOnOrAfter,attr,omitempty"`
+	Issuer       *saml.NameIDType        `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	Signature    *xml_dsig.SignatureType `xml:"Signature"`
+	NameID       *saml.NameIDType        `xml:"urn:oasis:names:tc:SAML:2.0:assertion NameID"`
+}
+
+// NewLogoutRequest creates an unsigned logout request for the user identified by the nameID.
+func NewLogoutRequest(issuer, destination, nameID string) *LogoutRequest {
+	now := time.Now().UTC()
+	return &LogoutRequest{
+		Id:           provider.NewID(),
+		Version:      "2.0",
+		IssueInstant: now.Format(TimeFormat),
+		Destination:  destination,
+		NotOnOrAfter: now.Add(requestLifetime).Format(TimeFormat),
+		Issuer: &saml.NameIDType{
+			Format: issuerFormat,
+			Text:   issuer,
+		},
+		NameID: &saml.NameIDType{
+			Format: nameIDFormat,
+			Text:   nameID,
+		},
+	}
+}
+
+// NewLogoutResponse creates an unsigned logout response to the logout request with the id inResponseTo.
+func NewLogoutResponse(issuer, destination, inResponseTo, status, message string) *samlp.LogoutResponseType {
+	return &samlp.LogoutResponseType{
+		Id:           provider.NewID(),
+		InResponseTo: inResponseTo,
+		Version:      "2.0",
+		IssueInstant: time.Now().UTC().Format(TimeFormat),
+		Destination:  destination,
+		Issuer: &saml.NameIDType{
+			Format: issuerFormat,
+			Text:   issuer,
+		},
+		Status: samlp.StatusType{
+			StatusCode: samlp.StatusCodeType{
+				Value: status,
+			},
+			StatusMessage: message,
+		},
+	}
+}
+
+// SignRequest adds an enveloped signature to the logout request,
+// as required for the HTTP-POST and SOAP binding.
+func SignRequest(request *LogoutRequest, certAndKey *key.CertificateAndKey) (err error) {
+	request.Signature, err = sign(certAndKey, request)
+	return err
+}
+
+// SignResponse adds an enveloped signature to the logout response,
+// as required for the HTTP-POST binding.
+func SignResponse(response *samlp.LogoutResponseType, certAndKey *key.CertificateAndKey) (err error) {
+	response.Signature, err = sign(certAndKey, response)
+	return err
+}
+
+func sign(certAndKey *key.CertificateAndKey, element any) (*xml_dsig.SignatureType, error) {
+	signer, err := signature.GetSigner(certAndKey.Certificate, certAndKey.Key, SignatureAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	return signature.Create(signer, element)
+}
+
+type logoutRequestEnvelope struct {
+	XMLName xml.Name `xml:"http://schemas.xmlsoap.org/soap/envelope/ Envelope"`
+	Body    logoutRequestBody
+}
+
+type logoutRequestBody struct {
+	XMLName       xml.Name `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
+	LogoutRequest *LogoutRequest
+}
+
+// SOAPEnvelope marshals the (signed) logout request into a SOAP envelope for the SOAP binding.
+func SOAPEnvelope(request *LogoutRequest) ([]byte, error) {
+	return saml_xml.Marshal(&logoutRequestEnvelope{
+		Body: logoutRequestBody{
+			LogoutRequest: request,
+		},
+	})
+}
+
+// RedirectURL returns the url for sending the message over the HTTP-Redirect binding.
+// The message is deflated and the query is signed as defined in the SAML 2.0 bindings, section 3.4.4.1.
+// The param must be either ParamRequest or ParamResponse.
+func RedirectURL(location, param string, message any, relayState string, certAndKey *key.CertificateAndKey) (string, error) {
+	data, err := saml_xml.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+	deflated, err := deflate(data)
+	if err != nil {
+		return "", err
+	}
+	query := param + "=" + url.QueryEscape(base64.StdEncoding.EncodeToString(deflated))
+	if relayState != "" {
+		query += "&" + ParamRelayState + "=" + url.QueryEscape(relayState)
+	}
+	query += "&" + paramSigAlg + "=" + url.QueryEscape(SignatureAlgorithm)
+
+	signingContext, _, err := signature.GetSigningContextAndSigner(certAndKey.Certificate, certAndKey.Key, SignatureAlgorithm)
+	if err != nil {
+		return "", err
+	}
+	sig, err := signature.CreateRedirect(signingContext, query)
+	if err != nil {
+		return "", err
+	}
+	query += "&" + paramSignature + "=" + url.QueryEscape(base64.StdEncoding.EncodeToString(sig))
+
+	separator := "?"
+	if strings.Contains(location, "?") {
+		separator = "&"
+	}
+	return location + separator + query, nil
+}
+
+// deflate compresses the data with DEFLATE (RFC 1951) as required by the HTTP-Redirect binding.
+func deflate(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(data); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var postForm = template.Must(template.New("post").Parse(`<!DOCTYPE html>
+<html>
+<body onload="document.getElementById('samlpost').submit()">
+<noscript>
+<p>
+<strong>Note:</strong> Since your browser does not support JavaScript,
+you must press the Continue button once to proceed.
+</p>
+</noscript>
+<form action="{{ .Location }}" method="post" id="samlpost">
+<div>
+<input type="hidden" name="{{ .Param }}" value="{{ .Message }}"/>
+{{- if .RelayState }}
+<input type="hidden" name="RelayState" value="{{ .RelayState }}"/>
+{{- end }}
+</div>
+<noscript>
+<div>
+<input type="submit" value="Continue"/>
+</div>
+</noscript>
+</form>
+</body>
+</html>`))
+
+// WritePostForm writes a self-submitting form for sending the (signed) message over the HTTP-POST binding.
+// The param must be either ParamRequest or ParamResponse.
+func WritePostForm(w io.Writer, location, param string, message any, relayState string) error {
+	data, err := saml_xml.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return postForm.Execute(w, struct {
+		Location   string
+		Param      string
+		Message    string
+		RelayState string
+	}{
+		Location:   location,
+		Param:      param,
+		Message:    base64.StdEncoding.EncodeToString(data),
+		RelayState: relayState,
+	})
+}
+
+// Services returns the single logout services of the service provider metadata.
+func Services(metadata *md.EntityDescriptorType) []md.EndpointType {
+	if metadata == nil || metadata.SPSSODescriptor == nil {
+		return nil
+	}
+	return metadata.SPSSODescriptor.SingleLogoutService
+}
+
+// ServiceByBinding returns the first single logout service supporting one of the bindings.
+// The bindings are checked in the provided order of preference.
+func ServiceByBinding(services []md.EndpointType, bindings ...string) *md.EndpointType {
+	for _, binding := range bindings {
+		for i := range services {
+			if services[i].Binding == binding {
+				return &services[i]
+			}
+		}
+	}
+	return nil
+}
+
+// ResponseLocation returns the location, where the logout response has to be sent to.
+func ResponseLocation(service *md.EndpointType) string {
+	if service.ResponseLocation != "" {
+		return service.ResponseLocation
+	}
+	return service.Location
+}
+
+// Message is a logout request or response received from a service provider.
+type Message struct {
+	// Raw is the decoded xml of the message
+	Raw        []byte
+	RelayState string
+	Binding    string
+
+	// rawQuery is needed to verify the signature of the HTTP-Redirect binding,
+	// which is created over the url encoded parameters as received
+	rawQuery string
+	param    string
+}
+
+// ParseRequest reads the logout request or response (depending on the param) from the http request.
+// A GET request is handled as HTTP-Redirect binding, which requires the message to be deflated,
+// all others as HTTP-POST binding.
+// It returns nil if the request does not contain the param.
+func ParseRequest(r *http.Request, param string) (*Message, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+	value := r.Form.Get(param)
+	if value == "" {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to base64 decode: %w", err)
+	}
+	message := &Message{
+		Raw:        data,
+		RelayState: r.Form.Get(ParamRelayState),
+		Binding:    provider.PostBinding,
+		param:      param,
+	}
+	if r.Method == http.MethodGet {
+		message.Binding = provider.RedirectBinding
+		message.rawQuery = r.URL.RawQuery
+		message.Raw, err = io.ReadAll(flate.NewReader(bytes.NewReader(data)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to inflate: %w", err)
+		}
+	}
+	return message, nil
+}
+
+// LogoutRequest unmarshals the message as logout request.
+func (m *Message) LogoutRequest() (*samlp.LogoutRequestType, error) {
+	request := new(samlp.LogoutRequestType)
+	if err := xml.Unmarshal(m.Raw, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// LogoutResponse unmarshals the message as logout response.
+func (m *Message) LogoutResponse() (*samlp.LogoutResponseType, error) {
+	response := new(samlp.LogoutResponseType)
+	if err := xml.Unmarshal(m.Raw, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// VerifySignature verifies the signature of the message with the signing certificates of the service provider.
+// Messages received over HTTP-Redirect are verified by the query signature, all others by the enveloped signature.
+func (m *Message) VerifySignature(metadata *md.EntityDescriptorType) error {
+	certs, err := SigningCertificates(metadata)
+	if err != nil {
+		return err
+	}
+	if len(certs) == 0 {
+		return fmt.Errorf("no signing certificate found in metadata")
+	}
+	if m.Binding == provider.RedirectBinding {
+		return m.verifyRedirectSignature(certs)
+	}
+	doc := etree.NewDocument()
+	if err = doc.ReadFromBytes(m.Raw); err != nil {
+		return err
+	}
+	if doc.Root() == nil {
+		return fmt.Errorf("error while parsing message")
+	}
+	return signature.ValidatePost(certs, doc.Root())
+}
+
+// Signed returns whether the message contains a signature,
+// either as query parameter (HTTP-Redirect) or as enveloped signature (all others).
+// It does not verify the signature.
+func (m *Message) Signed() bool {
+	if m.Binding == provider.RedirectBinding {
+		values, err := url.ParseQuery(m.rawQuery)
+		return err == nil && values.Get(paramSignature) != ""
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(m.Raw); err != nil || doc.Root() == nil {
+		return false
+	}
+	for _, child := range doc.Root().ChildElements() {
+		if child.Tag == "Signature" {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Message) verifyRedirectSignature(certs []*x509.Certificate) error {
+	values := make(map[string]string, 4)
+	for _, part := range strings.Split(m.rawQuery, "&") {
+		name, value, _ := strings.Cut(part, "=")
+		values[name] = value
+	}
+	sigAlg, err := url.QueryUnescape(values[paramSigAlg])
+	if err != nil {
+		return err
+	}
+	sigValue, err := url.QueryUnescape(values[paramSignature])
+	if err != nil {
+		return err
+	}
+	sig, err := base64.StdEncoding.DecodeString(sigValue)
+	if err != nil {
+		return err
+	}
+	signed := m.param + "=" + values[m.param]
+	if relayState, ok := values[ParamRelayState]; ok {
+		signed += "&" + ParamRelayState + "=" + relayState
+	}
+	signed += "&" + paramSigAlg + "=" + values[paramSigAlg]
+	for _, cert := range certs {
+		if err = signature.ValidateRedirect(sigAlg, []byte(signed), sig, cert.PublicKey); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// SigningCertificates returns the certificates of the service provider used for signing.
+func SigningCertificates(metadata *md.EntityDescriptorType) ([]*x509.Certificate, error) {
+	if metadata == nil || metadata.SPSSODescriptor == nil {
+		return nil, nil
+	}
+	return signature.ParseCertificates(saml_xml.GetCertsFromKeyDescriptors(metadata.SPSSODescriptor.KeyDescriptor))
+}
@@ -0,0 +1,155 @@
+package slo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"html"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/key"
+	saml_xml "github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/xml_dsig"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+)
+
+func newCertificateAndKey(t *testing.T) (*key.CertificateAndKey, *md.EntityDescriptorType) {
+	privateKey, _, certPem, err := crypto.GenerateCACertificate(2048, &crypto.CertificateInformations{
+		SerialNumber: big.NewInt(1),
+		CommonName:   "ZITADEL",
+		NotAfter:     time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	cert, err := crypto.BytesToCertificate(certPem)
+	require.NoError(t, err)
+	metadata := &md.EntityDescriptorType{
+		SPSSODescriptor: &md.SPSSODescriptorType{
+			KeyDescriptor: []md.KeyDescriptorType{{
+				Use: md.KeyTypesSigning,
+				KeyInfo: xml_dsig.KeyInfoType{
+					X509Data: []xml_dsig.X509DataType{{
+						X509Certificate: base64.StdEncoding.EncodeToString(cert),
+					}},
+				},
+			}},
+		},
+	}
+	return &key.CertificateAndKey{Certificate: cert, Key: privateKey}, metadata
+}
+
+func TestRedirectURL(t *testing.T) {
+	certAndKey, metadata := newCertificateAndKey(t)
+	request := NewLogoutRequest("https://zitadel.example.com/saml/v2/metadata", "https://sp.example.com/slo", "user@example.com")
+
+	redirectURL, err := RedirectURL("https://sp.example.com/slo?tenant=1", ParamRequest, request, "state", certAndKey)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(redirectURL, "https://sp.example.com/slo?tenant=1&SAMLRequest="))
+
+	message, err := ParseRequest(httptest.NewRequest(http.MethodGet, redirectURL, nil), ParamRequest)
+	require.NoError(t, err)
+	assert.Equal(t, provider.RedirectBinding, message.Binding)
+	assert.Equal(t, "state", message.RelayState)
+	assert.True(t, message.Signed())
+	require.NoError(t, message.VerifySignature(metadata))
+
+	got, err := message.LogoutRequest()
+	require.NoError(t, err)
+	assert.Equal(t, request.Id, got.Id)
+	assert.Equal(t, "https://zitadel.example.com/saml/v2/metadata", got.Issuer.Text)
+	assert.Equal(t, "user@example.com", got.NameID.Text)
+
+	_, otherMetadata := newCertificateAndKey(t)
+	assert.Error(t, message.VerifySignature(otherMetadata))
+
+	tampered := regexp.MustCompile(`RelayState=state`).ReplaceAllString(redirectURL, "RelayState=other")
+	message, err = ParseRequest(httptest.NewRequest(http.MethodGet, tampered, nil), ParamRequest)
+	require.NoError(t, err)
+	assert.Error(t, message.VerifySignature(metadata))
+
+	unsigned := regexp.MustCompile(`&Signature=[^&]+`).ReplaceAllString(redirectURL, "")
+	message, err = ParseRequest(httptest.NewRequest(http.MethodGet, unsigned, nil), ParamRequest)
+	require.NoError(t, err)
+	assert.False(t, message.Signed())
+	assert.Error(t, message.VerifySignature(metadata))
+}
+
+func TestWritePostForm(t *testing.T) {
+	certAndKey, metadata := newCertificateAndKey(t)
+	response := NewLogoutResponse("https://zitadel.example.com/saml/v2/metadata", "https://sp.example.com/slo", "requestID", provider.StatusCodeSuccess, "")
+	require.NoError(t, SignResponse(response, certAndKey))
+
+	form := new(bytes.Buffer)
+	require.NoError(t, WritePostForm(form, "https://sp.example.com/slo", ParamResponse, response, "state"))
+	value := regexp.MustCompile(`name="SAMLResponse" value="([^"]+)"`).FindStringSubmatch(form.String())
+	require.Len(t, value, 2)
+	assert.Contains(t, form.String(), `name="RelayState" value="state"`)
+
+	body := url.Values{ParamResponse: {html.UnescapeString(value[1])}, ParamRelayState: {"state"}}
+	r := httptest.NewRequest(http.MethodPost, "/SLO", strings.NewReader(body.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	message, err := ParseRequest(r, ParamResponse)
+	require.NoError(t, err)
+	assert.Equal(t, provider.PostBinding, message.Binding)
+	assert.True(t, message.Signed())
+	require.NoError(t, message.VerifySignature(metadata))
+
+	got, err := message.LogoutResponse()
+	require.NoError(t, err)
+	assert.Equal(t, "requestID", got.InResponseTo)
+	assert.Equal(t, provider.StatusCodeSuccess, got.Status.StatusCode.Value)
+
+	unsigned := NewLogoutResponse("https://zitadel.example.com/saml/v2/metadata", "https://sp.example.com/slo", "requestID", provider.StatusCodeSuccess, "")
+	form.Reset()
+	require.NoError(t, WritePostForm(form, "https://sp.example.com/slo", ParamResponse, unsigned, ""))
+	value = regexp.MustCompile(`name="SAMLResponse" value="([^"]+)"`).FindStringSubmatch(form.String())
+	require.Len(t, value, 2)
+	r = httptest.NewRequest(http.MethodPost, "/SLO", strings.NewReader(url.Values{ParamResponse: {html.UnescapeString(value[1])}}.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	message, err = ParseRequest(r, ParamResponse)
+	require.NoError(t, err)
+	assert.False(t, message.Signed())
+}
+
+func TestSOAPEnvelope(t *testing.T) {
+	certAndKey, _ := newCertificateAndKey(t)
+	request := NewLogoutRequest("https://zitadel.example.com/saml/v2/metadata", "https://sp.example.com/soap", "user@example.com")
+	require.NoError(t, SignRequest(request, certAndKey))
+
+	envelope, err := SOAPEnvelope(request)
+	require.NoError(t, err)
+	assert.Regexp(t, `^<\?xml.*\?>\s*<Envelope xmlns="http://schemas.xmlsoap.org/soap/envelope/"><Body[^>]*><LogoutRequest xmlns="urn:oasis:names:tc:SAML:2.0:protocol"`, string(envelope))
+	assert.Less(t, strings.Index(string(envelope), "<Issuer"), strings.Index(string(envelope), "<NameID"))
+}
+
+func TestServiceByBinding(t *testing.T) {
+	metadata, err := saml_xml.ParseMetadataXmlIntoStruct([]byte(`<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.example.com/metadata">
+  <SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/slo/post"/>
+    <SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://sp.example.com/slo/redirect" ResponseLocation="https://sp.example.com/slo/response"/>
+  </SPSSODescriptor>
+</EntityDescriptor>`))
+	require.NoError(t, err)
+	services := Services(metadata)
+
+	assert.Nil(t, ServiceByBinding(services, provider.SOAPBinding))
+
+	service := ServiceByBinding(services, provider.RedirectBinding, provider.PostBinding)
+	require.NotNil(t, service)
+	assert.Equal(t, "https://sp.example.com/slo/redirect", service.Location)
+	assert.Equal(t, "https://sp.example.com/slo/response", ResponseLocation(service))
+
+	service = ServiceByBinding(services, provider.SOAPBinding, provider.PostBinding)
+	require.NotNil(t, service)
+	assert.Equal(t, "https://sp.example.com/slo/post", ResponseLocation(service))
+}
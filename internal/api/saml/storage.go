@@ -52,23 +52,35 @@ type Storage struct {
 	query      *query.Queries
 
 	defaultLoginURL string
+	// metadataEndpoint is needed to compute the entity ID of ZITADEL, which is used as issuer of the logout requests
+	metadataEndpoint provider.Endpoint
 }
 
 func (p *Storage) GetEntityByID(ctx context.Context, entityID string) (*serviceprovider.ServiceProvider, error) {
+	sp, _, err := p.serviceProviderByEntityID(ctx, entityID)
+	return sp, err
+}
+
+// serviceProviderByEntityID returns the service provider together with the SAML config of the active app.
+func (p *Storage) serviceProviderByEntityID(ctx context.Context, entityID string) (*serviceprovider.ServiceProvider, *query.SAMLApp, error) {
 	app, err := p.query.AppBySAMLEntityID(ctx, entityID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if app.State != domain.AppStateActive {
-		return nil, zerrors.ThrowPreconditionFailed(nil, "SAML-sdaGg", "app is not active")
+		return nil, nil, zerrors.ThrowPreconditionFailed(nil, "SAML-sdaGg", "app is not active")
 	}
-	return serviceprovider.NewServiceProvider(
+	sp, err := serviceprovider.NewServiceProvider(
 		app.ID,
 		&serviceprovider.Config{
 			Metadata: app.SAMLConfig.Metadata,
 		},
 		p.defaultLoginURL,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sp, app.SAMLConfig, nil
 }
 
 func (p *Storage) GetEntityIDByAppID(ctx context.Context, appID string) (string, error) {
@@ -150,11 +162,39 @@ func (p *Storage) SetUserinfoWithUserID(ctx context.Context, applicationID strin
 
 	setUserinfo(user, userinfo, attributes, customAttributes)
 
+	if err = p.addSAMLSession(ctx, applicationID, user); err != nil {
+		return err
+	}
+
 	// trigger activity log for authentication for user
 	activity.Trigger(ctx, user.ResourceOwner, user.ID, activity.SAMLResponse, p.eventstore.FilterToQueryReducer)
 	return nil
 }
 
+// addSAMLSession tracks that the app receives a SAML response for the user on the current user agent,
+// so the service provider can be logged out, when the user signs out.
+// The SAML login is based on the user sessions of the user agent, which are not linked to a (v2) session,
+// so the SAML session has no session id.
+func (p *Storage) addSAMLSession(ctx context.Context, applicationID string, user *query.User) error {
+	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
+	if !ok {
+		return zerrors.ThrowPreconditionFailed(nil, "SAML-m4MuG", "no user agent id")
+	}
+	entityID, err := p.GetEntityIDByAppID(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+	_, err = p.command.AddSAMLSession(ctx, user.ID, user.ResourceOwner, "", applicationID, entityID, p.issuer(ctx), user.PreferredLoginName,
+		&domain.UserAgent{FingerprintID: &userAgentID},
+	)
+	return err
+}
+
+// issuer returns the entity ID of ZITADEL as identity provider of the requested instance
+func (p *Storage) issuer(ctx context.Context) string {
+	return p.metadataEndpoint.Absolute(provider.IssuerFromContext(ctx))
+}
+
 func (p *Storage) SetUserinfoWithLoginName(ctx context.Context, userinfo models.AttributeSetter, loginName string, attributes []int) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -0,0 +1,143 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+)
+
+const bulkIDPrefix = "bulkId:"
+
+// bulkIDReference matches a complete reference to a resource created in the same bulk request
+var bulkIDReference = regexp.MustCompile(bulkIDPrefix + `[^"/\s]+`)
+
+// bulkRequest is the body of a bulk request as defined in RFC 7644, section 3.7
+type bulkRequest struct {
+	Schemas      []string         `json:"schemas"`
+	FailOnErrors int              `json:"failOnErrors"`
+	Operations   []*bulkOperation `json:"Operations"`
+}
+
+type bulkOperation struct {
+	Method  string          `json:"method"`
+	BulkID  string          `json:"bulkId,omitempty"`
+	Version string          `json:"version,omitempty"`
+	Path    string          `json:"path"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+type bulkResponse struct {
+	Schemas    []string                 `json:"schemas"`
+	Operations []*bulkOperationResponse `json:"Operations"`
+}
+
+type bulkOperationResponse struct {
+	Method   string     `json:"method"`
+	BulkID   string     `json:"bulkId,omitempty"`
+	Version  string     `json:"version,omitempty"`
+	Location string     `json:"location,omitempty"`
+	Status   string     `json:"status"`
+	Response *scimError `json:"response,omitempty"`
+}
+
+// handleBulk executes the operations of a bulk request in the given order.
+// Each operation is authorized on its own, references to resources created
+// in the same request (`bulkId:<id>`) are resolved before the operation is executed.
+func (h *handler) handleBulk(w http.ResponseWriter, r *http.Request) {
+	bulk := new(bulkRequest)
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, h.config.MaxPayloadSize)).Decode(bulk); err != nil {
+		writeError(w, errInvalidSyntax("invalid bulk request"))
+		return
+	}
+	if len(bulk.Operations) > h.config.MaxBulkOperations {
+		writeError(w, errTooMany("the bulk request exceeds the maximum of "+strconv.Itoa(h.config.MaxBulkOperations)+" operations"))
+		return
+	}
+	base := newRequest(r, nil)
+	// the DPoP proof is bound to the bulk request and therefore valid for all its operations
+	ctx := authz.WithDPoPRequestFromHTTP(r.Context(), r)
+	createdIDs := make(map[string]string)
+	resp := &bulkResponse{
+		Schemas:    []string{schemaBulkResponse},
+		Operations: make([]*bulkOperationResponse, 0, len(bulk.Operations)),
+	}
+	errCount := 0
+	for _, operation := range bulk.Operations {
+		opResp := &bulkOperationResponse{
+			Method: operation.Method,
+			BulkID: operation.BulkID,
+		}
+		resp.Operations = append(resp.Operations, opResp)
+
+		status, res, err := h.executeBulkOperation(ctx, base, operation, createdIDs)
+		if err != nil {
+			scimErr := toSCIMError(err)
+			opResp.Status = scimErr.Status
+			opResp.Response = scimErr
+			errCount++
+			if bulk.FailOnErrors > 0 && errCount >= bulk.FailOnErrors {
+				break
+			}
+			continue
+		}
+		opResp.Status = strconv.Itoa(status)
+		if res == nil {
+			continue
+		}
+		m := res.resourceMeta()
+		opResp.Location = m.Location
+		opResp.Version = m.Version
+		if operation.BulkID != "" {
+			createdIDs[operation.BulkID] = m.Location[strings.LastIndex(m.Location, "/")+1:]
+		}
+	}
+	writeResponse(w, http.StatusOK, resp)
+}
+
+func (h *handler) executeBulkOperation(ctx context.Context, base *request, operation *bulkOperation, createdIDs map[string]string) (int, resource, error) {
+	path, err := resolveBulkIDs(operation.Path, createdIDs)
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := resolveBulkIDs(string(operation.Data), createdIDs)
+	if err != nil {
+		return 0, nil, err
+	}
+	return h.execute(ctx, &request{
+		orgID:   base.orgID,
+		token:   base.token,
+		method:  strings.ToUpper(operation.Method),
+		path:    path,
+		ifMatch: operation.Version,
+		body:    []byte(body),
+	})
+}
+
+// resolveBulkIDs replaces the references to resources created earlier in the same bulk request.
+// Every reference is matched as a whole, so `bulkId:1` never replaces a part of `bulkId:10`.
+// A reference to a resource, which was not created (yet), results in an error.
+func resolveBulkIDs(s string, createdIDs map[string]string) (string, error) {
+	if !strings.Contains(s, bulkIDPrefix) {
+		return s, nil
+	}
+	var unresolved string
+	resolved := bulkIDReference.ReplaceAllStringFunc(s, func(reference string) string {
+		id, ok := createdIDs[strings.TrimPrefix(reference, bulkIDPrefix)]
+		if !ok {
+			if unresolved == "" {
+				unresolved = reference
+			}
+			return reference
+		}
+		return id
+	})
+	if unresolved != "" {
+		return "", errInvalidValue("the reference " + unresolved + " could not be resolved")
+	}
+	return resolved, nil
+}
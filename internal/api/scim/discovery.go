@@ -0,0 +1,122 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// The discovery endpoints as defined in RFC 7644, section 4
+
+type serviceProviderConfig struct {
+	Schemas               []string                `json:"schemas"`
+	Patch                 supported               `json:"patch"`
+	Bulk                  bulkSupport             `json:"bulk"`
+	Filter                filterSupport           `json:"filter"`
+	ChangePassword        supported               `json:"changePassword"`
+	Sort                  supported               `json:"sort"`
+	ETag                  supported               `json:"etag"`
+	AuthenticationSchemes []*authenticationScheme `json:"authenticationSchemes"`
+}
+
+type supported struct {
+	Supported bool `json:"supported"`
+}
+
+type bulkSupport struct {
+	Supported      bool  `json:"supported"`
+	MaxOperations  int   `json:"maxOperations"`
+	MaxPayloadSize int64 `json:"maxPayloadSize"`
+}
+
+type filterSupport struct {
+	Supported  bool   `json:"supported"`
+	MaxResults uint64 `json:"maxResults"`
+}
+
+type authenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary"`
+}
+
+type resourceType struct {
+	Schemas          []string          `json:"schemas"`
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	Endpoint         string            `json:"endpoint"`
+	Schema           string            `json:"schema"`
+	SchemaExtensions []schemaExtension `json:"schemaExtensions,omitempty"`
+	Meta             *meta             `json:"meta"`
+}
+
+type schemaExtension struct {
+	Schema   string `json:"schema"`
+	Required bool   `json:"required"`
+}
+
+type schemaDefinition struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (h *handler) handleServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, http.StatusOK, &serviceProviderConfig{
+		Schemas: []string{schemaServiceProviderConfig},
+		Patch:   supported{Supported: true},
+		Bulk: bulkSupport{
+			Supported:      true,
+			MaxOperations:  h.config.MaxBulkOperations,
+			MaxPayloadSize: h.config.MaxPayloadSize,
+		},
+		Filter: filterSupport{
+			Supported:  true,
+			MaxResults: h.config.MaxResults,
+		},
+		ChangePassword: supported{Supported: true},
+		Sort:           supported{Supported: true},
+		ETag:           supported{Supported: true},
+		AuthenticationSchemes: []*authenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "Authentication using an access token or personal access token of a user with the required permissions on the organization",
+			Primary:     true,
+		}},
+	})
+}
+
+func (h *handler) handleResourceTypes(w http.ResponseWriter, r *http.Request) {
+	orgID := mux.Vars(r)[varOrgID]
+	resources := []any{
+		h.resourceType(r.Context(), orgID, resourceTypeUser, pathUsers, schemaUser),
+		h.resourceType(r.Context(), orgID, resourceTypeGroup, pathGroups, schemaGroup, schemaExtension{Schema: schemaZitadelGroup, Required: true}),
+	}
+	writeResponse(w, http.StatusOK, newListResponse(uint64(len(resources)), 1, resources))
+}
+
+func (h *handler) resourceType(ctx context.Context, orgID, name, endpoint, schema string, extensions ...schemaExtension) *resourceType {
+	return &resourceType{
+		Schemas:          []string{schemaResourceType},
+		ID:               name,
+		Name:             name,
+		Endpoint:         endpoint,
+		Schema:           schema,
+		SchemaExtensions: extensions,
+		Meta: &meta{
+			ResourceType: "ResourceType",
+			Location:     h.location(ctx, orgID, pathResourceTypes, name),
+		},
+	}
+}
+
+func (h *handler) handleSchemas(w http.ResponseWriter, r *http.Request) {
+	resources := []any{
+		&schemaDefinition{ID: schemaUser, Name: resourceTypeUser, Description: "ZITADEL human user"},
+		&schemaDefinition{ID: schemaGroup, Name: resourceTypeGroup, Description: "Role of a project owned by the organization"},
+		&schemaDefinition{ID: schemaZitadelGroup, Name: "ZITADELGroup", Description: "The project and role key of the group"},
+	}
+	writeResponse(w, http.StatusOK, newListResponse(uint64(len(resources)), 1, resources))
+}
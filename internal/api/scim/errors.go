@@ -0,0 +1,123 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/zitadel/logging"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// scimType values as defined in RFC 7644, section 3.12
+const (
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeTooMany       = "tooMany"
+	scimTypeUniqueness    = "uniqueness"
+	scimTypeMutability    = "mutability"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeNoTarget      = "noTarget"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeInvalidVers   = "invalidVers"
+)
+
+// scimError is the error response defined in RFC 7644, section 3.12
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+	Status   string   `json:"status"`
+
+	status int
+}
+
+func (e *scimError) Error() string {
+	return e.Detail
+}
+
+func newSCIMError(status int, scimType, detail string) *scimError {
+	return &scimError{
+		Schemas:  []string{schemaError},
+		ScimType: scimType,
+		Detail:   detail,
+		Status:   strconv.Itoa(status),
+		status:   status,
+	}
+}
+
+func errInvalidFilter(detail string) *scimError {
+	return newSCIMError(http.StatusBadRequest, scimTypeInvalidFilter, detail)
+}
+
+func errInvalidSyntax(detail string) *scimError {
+	return newSCIMError(http.StatusBadRequest, scimTypeInvalidSyntax, detail)
+}
+
+func errInvalidPath(detail string) *scimError {
+	return newSCIMError(http.StatusBadRequest, scimTypeInvalidPath, detail)
+}
+
+func errInvalidValue(detail string) *scimError {
+	return newSCIMError(http.StatusBadRequest, scimTypeInvalidValue, detail)
+}
+
+func errNoTarget(detail string) *scimError {
+	return newSCIMError(http.StatusBadRequest, scimTypeNoTarget, detail)
+}
+
+func errMutability(detail string) *scimError {
+	return newSCIMError(http.StatusBadRequest, scimTypeMutability, detail)
+}
+
+func errTooMany(detail string) *scimError {
+	return newSCIMError(http.StatusRequestEntityTooLarge, scimTypeTooMany, detail)
+}
+
+func errNotFound(detail string) *scimError {
+	return newSCIMError(http.StatusNotFound, "", detail)
+}
+
+func errVersionMismatch() *scimError {
+	return newSCIMError(http.StatusPreconditionFailed, scimTypeInvalidVers, "the resource version does not match the If-Match header")
+}
+
+// toSCIMError maps any error returned by the commands or queries to a [scimError].
+func toSCIMError(err error) *scimError {
+	scimErr := new(scimError)
+	if errors.As(err, &scimErr) {
+		return scimErr
+	}
+	status, _ := http_util.ZitadelErrorToHTTPStatusCode(err)
+	detail := err.Error()
+	zitadelErr := new(zerrors.ZitadelError)
+	if errors.As(err, &zitadelErr) {
+		detail = zitadelErr.GetMessage()
+	}
+	scimType := ""
+	switch {
+	case zerrors.IsErrorAlreadyExists(err):
+		scimType = scimTypeUniqueness
+	case zerrors.IsErrorInvalidArgument(err):
+		scimType = scimTypeInvalidValue
+	}
+	return newSCIMError(status, scimType, detail)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	scimErr := toSCIMError(err)
+	writeResponse(w, scimErr.status, scimErr)
+}
+
+func writeResponse(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", contentTypeSCIM)
+	w.WriteHeader(status)
+	if body == nil {
+		return
+	}
+	err := json.NewEncoder(w).Encode(body)
+	logging.OnError(err).Error("scim: unable to write response")
+}
@@ -0,0 +1,329 @@
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// filter represents a parsed SCIM filter expression as defined in RFC 7644, section 3.4.2.2
+type filter interface {
+	isFilter()
+}
+
+// attributeFilter compares an attribute to a value, e.g. `userName eq "bjensen"`
+type attributeFilter struct {
+	Path     string
+	Operator string
+	// Value is either a string, a bool, a float64 or nil
+	Value any
+}
+
+// logicalFilter combines two filters with `and` or `or`
+type logicalFilter struct {
+	Operator string
+	Left     filter
+	Right    filter
+}
+
+// notFilter negates the wrapped filter
+type notFilter struct {
+	Filter filter
+}
+
+// valuePathFilter filters multi valued attributes, e.g. `emails[type eq "work"]`
+type valuePathFilter struct {
+	Path   string
+	Filter filter
+}
+
+func (*attributeFilter) isFilter() {}
+func (*logicalFilter) isFilter()   {}
+func (*notFilter) isFilter()       {}
+func (*valuePathFilter) isFilter() {}
+
+const (
+	operatorEqual      = "eq"
+	operatorNotEqual   = "ne"
+	operatorContains   = "co"
+	operatorStartsWith = "sw"
+	operatorEndsWith   = "ew"
+	operatorPresent    = "pr"
+	operatorGreater    = "gt"
+	operatorGreaterEq  = "ge"
+	operatorLess       = "lt"
+	operatorLessEq     = "le"
+	operatorAnd        = "and"
+	operatorOr         = "or"
+	operatorNot        = "not"
+)
+
+var comparisonOperators = map[string]bool{
+	operatorEqual:      true,
+	operatorNotEqual:   true,
+	operatorContains:   true,
+	operatorStartsWith: true,
+	operatorEndsWith:   true,
+	operatorGreater:    true,
+	operatorGreaterEq:  true,
+	operatorLess:       true,
+	operatorLessEq:     true,
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenOpenParen
+	tokenCloseParen
+	tokenOpenBracket
+	tokenCloseBracket
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+func tokenize(input string) ([]token, error) {
+	tokens := make([]token, 0, 8)
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenOpenParen})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenCloseParen})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{kind: tokenOpenBracket})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{kind: tokenCloseBracket})
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(input); end++ {
+				if input[end] == '\\' {
+					end++
+					continue
+				}
+				if input[end] == '"' {
+					break
+				}
+			}
+			if end >= len(input) {
+				return nil, errInvalidFilter("unterminated string in filter")
+			}
+			var value string
+			if err := json.Unmarshal([]byte(input[i:end+1]), &value); err != nil {
+				return nil, errInvalidFilter("invalid string in filter")
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value})
+			i = end + 1
+		default:
+			end := i
+			for ; end < len(input); end++ {
+				r := rune(input[end])
+				if unicode.IsSpace(r) || strings.ContainsRune("()[]\"", r) {
+					break
+				}
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: input[i:end]})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []token
+	pos    int
+}
+
+// parseFilter parses a SCIM filter expression.
+// Precedence from lowest to highest is `or`, `and`, `not` and grouping.
+func parseFilter(input string) (filter, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, nil
+	}
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, errInvalidFilter("unexpected token in filter")
+	}
+	return f, nil
+}
+
+func (p *filterParser) peek() *token {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+func (p *filterParser) next() *token {
+	t := p.peek()
+	if t != nil {
+		p.pos++
+	}
+	return t
+}
+
+func (p *filterParser) peekWord(word string) bool {
+	t := p.peek()
+	return t != nil && t.kind == tokenWord && strings.EqualFold(t.value, word)
+}
+
+func (p *filterParser) parseOr() (filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekWord(operatorOr) {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{Operator: operatorOr, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekWord(operatorAnd) {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{Operator: operatorAnd, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filter, error) {
+	if p.peekWord(operatorNot) {
+		p.next()
+		t := p.peek()
+		if t == nil || t.kind != tokenOpenParen {
+			return nil, errInvalidFilter("not must be followed by a parenthesised filter")
+		}
+		f, err := p.parseGroup()
+		if err != nil {
+			return nil, err
+		}
+		return &notFilter{Filter: f}, nil
+	}
+	t := p.peek()
+	if t == nil {
+		return nil, errInvalidFilter("unexpected end of filter")
+	}
+	if t.kind == tokenOpenParen {
+		return p.parseGroup()
+	}
+	return p.parseAttribute()
+}
+
+func (p *filterParser) parseGroup() (filter, error) {
+	p.next()
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.next(); t == nil || t.kind != tokenCloseParen {
+		return nil, errInvalidFilter("missing closing parenthesis in filter")
+	}
+	return f, nil
+}
+
+func (p *filterParser) parseAttribute() (filter, error) {
+	t := p.next()
+	if t.kind != tokenWord {
+		return nil, errInvalidFilter("expected attribute path in filter")
+	}
+	path := t.value
+	if next := p.peek(); next != nil && next.kind == tokenOpenBracket {
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t == nil || t.kind != tokenCloseBracket {
+			return nil, errInvalidFilter("missing closing bracket in filter")
+		}
+		return &valuePathFilter{Path: path, Filter: inner}, nil
+	}
+	op := p.next()
+	if op == nil || op.kind != tokenWord {
+		return nil, errInvalidFilter("expected operator after " + path)
+	}
+	operator := strings.ToLower(op.value)
+	if operator == operatorPresent {
+		return &attributeFilter{Path: path, Operator: operator}, nil
+	}
+	if !comparisonOperators[operator] {
+		return nil, errInvalidFilter("unknown operator " + op.value)
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	return &attributeFilter{Path: path, Operator: operator, Value: value}, nil
+}
+
+func (p *filterParser) parseValue() (any, error) {
+	t := p.next()
+	if t == nil {
+		return nil, errInvalidFilter("expected comparison value in filter")
+	}
+	if t.kind == tokenString {
+		return t.value, nil
+	}
+	if t.kind != tokenWord {
+		return nil, errInvalidFilter("expected comparison value in filter")
+	}
+	switch strings.ToLower(t.value) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	number, err := strconv.ParseFloat(t.value, 64)
+	if err != nil {
+		return nil, errInvalidFilter("invalid comparison value " + t.value)
+	}
+	return number, nil
+}
+
+// normalizeAttributePath removes the schema urn of the given resource schema
+// and lower cases the path, as attribute names are case-insensitive.
+func normalizeAttributePath(path, schema string) string {
+	return strings.ToLower(trimSchema(path, schema))
+}
+
+func trimSchema(path, schema string) string {
+	if len(path) > len(schema) && strings.EqualFold(path[:len(schema)], schema) {
+		return strings.TrimPrefix(path[len(schema):], ":")
+	}
+	return path
+}
@@ -0,0 +1,159 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    filter
+		wantErr bool
+	}{
+		{
+			name:  "equal",
+			input: `userName eq "bjensen"`,
+			want:  &attributeFilter{Path: "userName", Operator: operatorEqual, Value: "bjensen"},
+		},
+		{
+			name:  "operator case insensitive",
+			input: `userName EQ "bjensen"`,
+			want:  &attributeFilter{Path: "userName", Operator: operatorEqual, Value: "bjensen"},
+		},
+		{
+			name:  "escaped string",
+			input: `displayName eq "Babs \"Barbara\" Jensen"`,
+			want:  &attributeFilter{Path: "displayName", Operator: operatorEqual, Value: `Babs "Barbara" Jensen`},
+		},
+		{
+			name:  "present",
+			input: `title pr`,
+			want:  &attributeFilter{Path: "title", Operator: operatorPresent},
+		},
+		{
+			name:  "boolean",
+			input: `active eq false`,
+			want:  &attributeFilter{Path: "active", Operator: operatorEqual, Value: false},
+		},
+		{
+			name:  "schema prefixed path",
+			input: `urn:ietf:params:scim:schemas:core:2.0:User:name.familyName co "O'Malley"`,
+			want:  &attributeFilter{Path: "urn:ietf:params:scim:schemas:core:2.0:User:name.familyName", Operator: operatorContains, Value: "O'Malley"},
+		},
+		{
+			name:  "and binds stronger than or",
+			input: `title pr or userType eq "Employee" and active eq true`,
+			want: &logicalFilter{
+				Operator: operatorOr,
+				Left:     &attributeFilter{Path: "title", Operator: operatorPresent},
+				Right: &logicalFilter{
+					Operator: operatorAnd,
+					Left:     &attributeFilter{Path: "userType", Operator: operatorEqual, Value: "Employee"},
+					Right:    &attributeFilter{Path: "active", Operator: operatorEqual, Value: true},
+				},
+			},
+		},
+		{
+			name:  "grouping and not",
+			input: `userType eq "Employee" and not (emails co "example.com")`,
+			want: &logicalFilter{
+				Operator: operatorAnd,
+				Left:     &attributeFilter{Path: "userType", Operator: operatorEqual, Value: "Employee"},
+				Right: &notFilter{
+					Filter: &attributeFilter{Path: "emails", Operator: operatorContains, Value: "example.com"},
+				},
+			},
+		},
+		{
+			name:  "value path",
+			input: `emails[type eq "work" and value co "@example.com"]`,
+			want: &valuePathFilter{
+				Path: "emails",
+				Filter: &logicalFilter{
+					Operator: operatorAnd,
+					Left:     &attributeFilter{Path: "type", Operator: operatorEqual, Value: "work"},
+					Right:    &attributeFilter{Path: "value", Operator: operatorContains, Value: "@example.com"},
+				},
+			},
+		},
+		{
+			name:    "missing value",
+			input:   `userName eq`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown operator",
+			input:   `userName is "bjensen"`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated string",
+			input:   `userName eq "bjensen`,
+			wantErr: true,
+		},
+		{
+			name:    "missing closing parenthesis",
+			input:   `(userName eq "bjensen"`,
+			wantErr: true,
+		},
+		{
+			name:    "trailing tokens",
+			input:   `userName eq "bjensen" "x"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFilter(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_normalizeAttributePath(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		schema string
+		want   string
+	}{
+		{
+			name:   "attribute",
+			path:   "userName",
+			schema: schemaUser,
+			want:   "username",
+		},
+		{
+			name:   "sub attribute",
+			path:   "name.givenName",
+			schema: schemaUser,
+			want:   "name.givenname",
+		},
+		{
+			name:   "schema prefix",
+			path:   schemaUser + ":name.familyName",
+			schema: schemaUser,
+			want:   "name.familyname",
+		},
+		{
+			name:   "other schema",
+			path:   schemaGroup + ":displayName",
+			schema: schemaUser,
+			want:   "urn:ietf:params:scim:schemas:core:2.0:group:displayname",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeAttributePath(tt.path, tt.schema))
+		})
+	}
+}
@@ -0,0 +1,445 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Groups are mapped to the roles of the projects owned by the organization.
+// The members of a group are the users granted the role on the project.
+
+func (h *handler) listGroups(ctx context.Context, req *request, search *searchRequest) (_ *listResponse, err error) {
+	ctx, err = h.authorize(ctx, req, domain.PermissionProjectRoleRead)
+	if err != nil {
+		return nil, err
+	}
+	searchReq, err := search.toSearchRequest(h.config.MaxResults, groupSortColumns, schemaGroup)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewProjectRoleResourceOwnerSearchQuery(req.orgID)
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{ownerQuery}
+	f, err := parseFilter(search.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if f != nil {
+		filterQuery, err := groupFilterToQuery(f)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, filterQuery)
+	}
+	roles, err := h.query.SearchProjectRoles(ctx, true, &query.ProjectRoleSearchQueries{
+		SearchRequest: searchReq,
+		Queries:       queries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if search.onlyCount() {
+		return newListResponse(roles.Count, search.StartIndex, []any{}), nil
+	}
+	withMembers := !search.excludes("members")
+	resources := make([]any, len(roles.ProjectRoles))
+	for i, role := range roles.ProjectRoles {
+		// the grants are required for the version even if the members are not returned
+		grants, err := h.roleGrants(ctx, req.orgID, role.ProjectID, role.Key)
+		if err != nil {
+			return nil, err
+		}
+		group := h.roleToResource(ctx, role, grants)
+		if !withMembers {
+			group.Members = nil
+		}
+		resources[i] = group
+	}
+	return newListResponse(roles.Count, search.StartIndex, resources), nil
+}
+
+func (h *handler) getGroup(ctx context.Context, req *request, id string) (_ *groupResource, err error) {
+	ctx, err = h.authorize(ctx, req, domain.PermissionProjectRoleRead)
+	if err != nil {
+		return nil, err
+	}
+	return h.groupByID(ctx, req.orgID, id)
+}
+
+func (h *handler) groupByID(ctx context.Context, orgID, id string) (*groupResource, error) {
+	projectID, key, ok := splitGroupID(id)
+	if !ok {
+		return nil, errNotFound("group " + id + " not found")
+	}
+	role, err := h.orgRole(ctx, orgID, projectID, key)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := h.roleGrants(ctx, orgID, projectID, key)
+	if err != nil {
+		return nil, err
+	}
+	return h.roleToResource(ctx, role, grants), nil
+}
+
+func (h *handler) orgRole(ctx context.Context, orgID, projectID, key string) (*query.ProjectRole, error) {
+	ownerQuery, err := query.NewProjectRoleResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	projectQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	keyQuery, err := query.NewProjectRoleKeySearchQuery(query.TextEquals, key)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := h.query.SearchProjectRoles(ctx, true, &query.ProjectRoleSearchQueries{
+		Queries: []query.SearchQuery{ownerQuery, projectQuery, keyQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(roles.ProjectRoles) != 1 {
+		return nil, zerrors.ThrowNotFound(nil, "SCIM-P3m8f", "Errors.Project.Role.NotExisting")
+	}
+	return roles.ProjectRoles[0], nil
+}
+
+// roleGrants returns the user grants of the organization containing the role
+func (h *handler) roleGrants(ctx context.Context, orgID, projectID, key string) ([]*query.UserGrant, error) {
+	queries, err := userGrantQueries(orgID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	roleQuery, err := query.NewUserGrantRoleQuery(key)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := h.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: append(queries, roleQuery),
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	return grants.UserGrants, nil
+}
+
+func userGrantQueries(orgID, projectID string) ([]query.SearchQuery, error) {
+	ownerQuery, err := query.NewUserGrantResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return []query.SearchQuery{ownerQuery, projectQuery}, nil
+}
+
+func (h *handler) createGroup(ctx context.Context, req *request) (_ *groupResource, err error) {
+	roleCtx, err := h.authorize(ctx, req, domain.PermissionProjectRoleWrite)
+	if err != nil {
+		return nil, err
+	}
+	group := new(groupResource)
+	if err := json.Unmarshal(req.body, group); err != nil {
+		return nil, errInvalidSyntax("invalid group")
+	}
+	if group.Zitadel == nil || group.Zitadel.ProjectID == "" {
+		return nil, errInvalidValue("the project of the group is required in " + schemaZitadelGroup)
+	}
+	key := group.Zitadel.Key
+	if key == "" {
+		key = group.DisplayName
+	}
+	_, err = h.command.AddProjectRole(roleCtx, &domain.ProjectRole{
+		ObjectRoot:  models.ObjectRoot{AggregateID: group.Zitadel.ProjectID},
+		Key:         key,
+		DisplayName: group.DisplayName,
+		Group:       group.Zitadel.Group,
+	}, req.orgID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.updateMembers(ctx, req, group.Zitadel.ProjectID, key, nil, group.Members); err != nil {
+		return nil, err
+	}
+	return h.groupByID(roleCtx, req.orgID, groupID(group.Zitadel.ProjectID, key))
+}
+
+func (h *handler) replaceGroup(ctx context.Context, req *request, id string) (_ *groupResource, err error) {
+	roleCtx, err := h.authorize(ctx, req, domain.PermissionProjectRoleWrite)
+	if err != nil {
+		return nil, err
+	}
+	current, err := h.groupByID(roleCtx, req.orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !matchesVersion(req.ifMatch, current.Meta.Version) {
+		return nil, errVersionMismatch()
+	}
+	desired := new(groupResource)
+	if err := json.Unmarshal(req.body, desired); err != nil {
+		return nil, errInvalidSyntax("invalid group")
+	}
+	return h.updateGroup(ctx, roleCtx, req, current, desired)
+}
+
+func (h *handler) patchGroup(ctx context.Context, req *request, id string) (_ *groupResource, err error) {
+	roleCtx, err := h.authorize(ctx, req, domain.PermissionProjectRoleWrite)
+	if err != nil {
+		return nil, err
+	}
+	current, err := h.groupByID(roleCtx, req.orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !matchesVersion(req.ifMatch, current.Meta.Version) {
+		return nil, errVersionMismatch()
+	}
+	patch := new(patchRequest)
+	if err := json.Unmarshal(req.body, patch); err != nil {
+		return nil, errInvalidSyntax("invalid patch request")
+	}
+	desired := &groupResource{
+		DisplayName: current.DisplayName,
+		Members:     slices.Clone(current.Members),
+	}
+	if err := applyGroupPatch(desired, patch.Operations); err != nil {
+		return nil, err
+	}
+	return h.updateGroup(ctx, roleCtx, req, current, desired)
+}
+
+// updateGroup changes the role from the current to the desired state.
+// Changes of the role and of the memberships require different permissions,
+// therefore the request is authorized separately for the user grants.
+func (h *handler) updateGroup(ctx, roleCtx context.Context, req *request, current, desired *groupResource) (*groupResource, error) {
+	if desired.DisplayName != current.DisplayName {
+		_, err := h.command.ChangeProjectRole(roleCtx, &domain.ProjectRole{
+			ObjectRoot:  models.ObjectRoot{AggregateID: current.Zitadel.ProjectID},
+			Key:         current.Zitadel.Key,
+			DisplayName: desired.DisplayName,
+			Group:       current.Zitadel.Group,
+		}, req.orgID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := h.updateMembers(ctx, req, current.Zitadel.ProjectID, current.Zitadel.Key, current.Members, desired.Members); err != nil {
+		return nil, err
+	}
+	return h.groupByID(roleCtx, req.orgID, current.ID)
+}
+
+func (h *handler) updateMembers(ctx context.Context, req *request, projectID, key string, current, desired []*memberReference) (err error) {
+	added, removed := diffMembers(current, desired)
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	ctx, err = h.authorize(ctx, req, domain.PermissionUserGrantWrite)
+	if err != nil {
+		return err
+	}
+	for _, userID := range added {
+		if err := h.addMember(ctx, req.orgID, projectID, key, userID); err != nil {
+			return err
+		}
+	}
+	for _, userID := range removed {
+		if err := h.removeMember(ctx, req.orgID, projectID, key, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func diffMembers(current, desired []*memberReference) (added, removed []string) {
+	currentIDs := make(map[string]bool, len(current))
+	for _, member := range current {
+		currentIDs[member.Value] = true
+	}
+	desiredIDs := make(map[string]bool, len(desired))
+	for _, member := range desired {
+		if desiredIDs[member.Value] {
+			continue
+		}
+		desiredIDs[member.Value] = true
+		if !currentIDs[member.Value] {
+			added = append(added, member.Value)
+		}
+	}
+	for _, member := range current {
+		if !desiredIDs[member.Value] {
+			removed = append(removed, member.Value)
+		}
+	}
+	return added, removed
+}
+
+// addMember adds the role to the user grant of the project or creates the user grant if there is none
+func (h *handler) addMember(ctx context.Context, orgID, projectID, key, userID string) error {
+	grant, err := h.userProjectGrant(ctx, orgID, projectID, userID)
+	if err != nil {
+		return err
+	}
+	if grant == nil {
+		_, err = h.command.AddUserGrant(ctx, &domain.UserGrant{
+			UserID:    userID,
+			ProjectID: projectID,
+			RoleKeys:  []string{key},
+		}, orgID)
+		return err
+	}
+	if slices.Contains(grant.Roles, key) {
+		return nil
+	}
+	_, err = h.command.ChangeUserGrant(ctx, &domain.UserGrant{
+		ObjectRoot: models.ObjectRoot{AggregateID: grant.ID, ResourceOwner: orgID},
+		UserID:     userID,
+		ProjectID:  projectID,
+		RoleKeys:   append(slices.Clone(grant.Roles), key),
+	}, orgID)
+	return err
+}
+
+// removeMember removes the role from the user grant and the user grant itself if it was the last role
+func (h *handler) removeMember(ctx context.Context, orgID, projectID, key, userID string) (err error) {
+	grant, err := h.userProjectGrant(ctx, orgID, projectID, userID)
+	if err != nil || grant == nil {
+		return err
+	}
+	roles := slices.DeleteFunc(slices.Clone(grant.Roles), func(role string) bool { return role == key })
+	if len(roles) == len(grant.Roles) {
+		return nil
+	}
+	if len(roles) == 0 {
+		_, err = h.command.RemoveUserGrant(ctx, grant.ID, orgID)
+		return err
+	}
+	_, err = h.command.ChangeUserGrant(ctx, &domain.UserGrant{
+		ObjectRoot: models.ObjectRoot{AggregateID: grant.ID, ResourceOwner: orgID},
+		UserID:     userID,
+		ProjectID:  projectID,
+		RoleKeys:   roles,
+	}, orgID)
+	return err
+}
+
+// userProjectGrant returns the user grant of the organization's own project (not of a project grant)
+func (h *handler) userProjectGrant(ctx context.Context, orgID, projectID, userID string) (*query.UserGrant, error) {
+	queries, err := userGrantQueries(orgID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	userQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := h.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: append(queries, userQuery),
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, grant := range grants.UserGrants {
+		if grant.GrantID == "" {
+			return grant, nil
+		}
+	}
+	return nil, nil
+}
+
+func (h *handler) deleteGroup(ctx context.Context, req *request, id string) (err error) {
+	ctx, err = h.authorize(ctx, req, domain.PermissionProjectRoleDelete)
+	if err != nil {
+		return err
+	}
+	projectID, key, ok := splitGroupID(id)
+	if !ok {
+		return errNotFound("group " + id + " not found")
+	}
+	role, err := h.orgRole(ctx, req.orgID, projectID, key)
+	if err != nil {
+		return err
+	}
+	members, err := h.roleGrants(ctx, req.orgID, projectID, key)
+	if err != nil {
+		return err
+	}
+	if !matchesVersion(req.ifMatch, groupETag(role.Sequence, members)) {
+		return errVersionMismatch()
+	}
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return err
+	}
+	roleQuery, err := query.NewUserGrantRoleQuery(key)
+	if err != nil {
+		return err
+	}
+	userGrants, err := h.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{projectQuery, roleQuery},
+	}, false)
+	if err != nil {
+		return err
+	}
+	projectGrants, err := h.query.SearchProjectGrantsByProjectIDAndRoleKey(ctx, projectID, key)
+	if err != nil {
+		return err
+	}
+	projectGrantIDs := make([]string, len(projectGrants.ProjectGrants))
+	for i, grant := range projectGrants.ProjectGrants {
+		projectGrantIDs[i] = grant.GrantID
+	}
+	_, err = h.command.RemoveProjectRole(ctx, projectID, key, req.orgID, projectGrantIDs, userGrantsToIDs(userGrants.UserGrants)...)
+	return err
+}
+
+func (h *handler) roleToResource(ctx context.Context, role *query.ProjectRole, grants []*query.UserGrant) *groupResource {
+	id := groupID(role.ProjectID, role.Key)
+	group := &groupResource{
+		Schemas:     []string{schemaGroup, schemaZitadelGroup},
+		ID:          id,
+		DisplayName: role.DisplayName,
+		Zitadel: &groupExtension{
+			ProjectID: role.ProjectID,
+			Key:       role.Key,
+			Group:     role.Group,
+		},
+		Meta: &meta{
+			ResourceType: resourceTypeGroup,
+			Created:      &role.CreationDate,
+			LastModified: &role.ChangeDate,
+			Version:      groupETag(role.Sequence, grants),
+			Location:     h.location(ctx, role.ResourceOwner, pathGroups, id),
+		},
+	}
+	if len(grants) > 0 {
+		group.Members = make([]*memberReference, len(grants))
+	}
+	for i, grant := range grants {
+		group.Members[i] = &memberReference{
+			Value:   grant.UserID,
+			Ref:     h.location(ctx, grant.UserResourceOwner, pathUsers, grant.UserID),
+			Display: grant.DisplayName,
+			Type:    resourceTypeUser,
+		}
+	}
+	return group
+}
+
+func (g *groupResource) resourceMeta() *meta {
+	return g.Meta
+}
@@ -0,0 +1,553 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	testOrgID     = "123"
+	testProjectID = "project1"
+	testRole      = "ORG_OWNER"
+)
+
+type authzRepoMock struct{}
+
+func (*authzRepoMock) VerifyAccessToken(context.Context, string, string, string) (string, string, string, string, string, error) {
+	return "", "", "", "", "", nil
+}
+
+func (*authzRepoMock) SearchMyMemberships(_ context.Context, orgID string, _ bool) ([]*authz.Membership, error) {
+	return authz.Memberships{{
+		MemberType:  authz.MemberTypeOrganization,
+		AggregateID: orgID,
+		Roles:       []string{testRole},
+	}}, nil
+}
+
+func (*authzRepoMock) ProjectIDAndOriginsByClientID(context.Context, string) (string, []string, error) {
+	return "", nil, nil
+}
+
+func (*authzRepoMock) ExistsOrg(_ context.Context, orgID, _ string) (string, error) {
+	return orgID, nil
+}
+
+func (*authzRepoMock) VerifierClientID(context.Context, string) (string, string, error) {
+	return "", "", nil
+}
+
+// store is an in-memory implementation of the commands and queries used by the handler
+type store struct {
+	sequence      uint64
+	users         map[string]*query.User
+	metadata      map[string]map[string][]byte
+	roles         map[string]*query.ProjectRole
+	grants        map[string]*query.UserGrant
+	metadataReads int
+}
+
+func newStore() *store {
+	return &store{
+		users:    make(map[string]*query.User),
+		metadata: make(map[string]map[string][]byte),
+		roles:    make(map[string]*query.ProjectRole),
+		grants:   make(map[string]*query.UserGrant),
+	}
+}
+
+func (s *store) nextSequence() uint64 {
+	s.sequence++
+	return s.sequence
+}
+
+func (s *store) AddUserHuman(_ context.Context, resourceOwner string, human *command.AddHuman, _ bool, _ crypto.EncryptionAlgorithm) error {
+	for _, user := range s.users {
+		if user.Username == human.Username {
+			return zerrors.ThrowAlreadyExists(nil, "TEST-3m9fs", "Errors.User.AlreadyExists")
+		}
+	}
+	human.ID = "user" + strconv.Itoa(len(s.users)+1)
+	s.users[human.ID] = &query.User{
+		ID:            human.ID,
+		ResourceOwner: resourceOwner,
+		Sequence:      s.nextSequence(),
+		State:         domain.UserStateActive,
+		Type:          domain.UserTypeHuman,
+		Username:      human.Username,
+		Human: &query.Human{
+			FirstName: human.FirstName,
+			LastName:  human.LastName,
+			Email:     human.Email.Address,
+		},
+	}
+	if human.Inactive {
+		s.users[human.ID].State = domain.UserStateInactive
+	}
+	for _, entry := range human.Metadata {
+		s.setMetadata(human.ID, entry.Key, entry.Value)
+	}
+	return nil
+}
+
+func (s *store) ChangeUserHuman(_ context.Context, human *command.ChangeHuman, _ crypto.EncryptionAlgorithm) error {
+	user := s.users[human.ID]
+	if human.Username != nil {
+		user.Username = *human.Username
+	}
+	if human.Profile != nil && human.Profile.FirstName != nil {
+		user.Human.FirstName = *human.Profile.FirstName
+	}
+	user.Sequence = s.nextSequence()
+	return nil
+}
+
+func (s *store) RemoveHumanPhone(context.Context, string, string) (*domain.ObjectDetails, error) {
+	return nil, nil
+}
+
+func (s *store) DeactivateUserV2(_ context.Context, userID string) (*domain.ObjectDetails, error) {
+	s.users[userID].State = domain.UserStateInactive
+	s.users[userID].Sequence = s.nextSequence()
+	return nil, nil
+}
+
+func (s *store) ReactivateUserV2(_ context.Context, userID string) (*domain.ObjectDetails, error) {
+	s.users[userID].State = domain.UserStateActive
+	s.users[userID].Sequence = s.nextSequence()
+	return nil, nil
+}
+
+func (s *store) RemoveUserV2(_ context.Context, userID string, _ []*command.CascadingMembership, _ ...string) (*domain.ObjectDetails, error) {
+	delete(s.users, userID)
+	return nil, nil
+}
+
+func (s *store) SetUserMetadata(_ context.Context, metadata *domain.Metadata, userID, _ string) (*domain.Metadata, error) {
+	s.setMetadata(userID, metadata.Key, metadata.Value)
+	return metadata, nil
+}
+
+func (s *store) setMetadata(userID, key string, value []byte) {
+	if s.metadata[key] == nil {
+		s.metadata[key] = make(map[string][]byte)
+	}
+	s.metadata[key][userID] = value
+}
+
+func (s *store) RemoveUserMetadata(_ context.Context, key, userID, _ string) (*domain.ObjectDetails, error) {
+	delete(s.metadata[key], userID)
+	return nil, nil
+}
+
+func (s *store) AddProjectRole(_ context.Context, role *domain.ProjectRole, resourceOwner string) (*domain.ProjectRole, error) {
+	s.roles[groupID(role.AggregateID, role.Key)] = &query.ProjectRole{
+		ProjectID:     role.AggregateID,
+		ResourceOwner: resourceOwner,
+		Sequence:      s.nextSequence(),
+		Key:           role.Key,
+		DisplayName:   role.DisplayName,
+		Group:         role.Group,
+	}
+	return role, nil
+}
+
+func (s *store) ChangeProjectRole(_ context.Context, role *domain.ProjectRole, _ string) (*domain.ProjectRole, error) {
+	current := s.roles[groupID(role.AggregateID, role.Key)]
+	current.DisplayName = role.DisplayName
+	current.Sequence = s.nextSequence()
+	return role, nil
+}
+
+func (s *store) RemoveProjectRole(_ context.Context, projectID, key, _ string, _ []string, _ ...string) (*domain.ObjectDetails, error) {
+	delete(s.roles, groupID(projectID, key))
+	return nil, nil
+}
+
+func (s *store) AddUserGrant(_ context.Context, grant *domain.UserGrant, resourceOwner string) (*domain.UserGrant, error) {
+	id := "grant" + strconv.Itoa(len(s.grants)+1)
+	s.grants[id] = &query.UserGrant{
+		ID:                id,
+		Sequence:          s.nextSequence(),
+		Roles:             grant.RoleKeys,
+		UserID:            grant.UserID,
+		UserResourceOwner: resourceOwner,
+		ResourceOwner:     resourceOwner,
+		ProjectID:         grant.ProjectID,
+	}
+	return grant, nil
+}
+
+func (s *store) ChangeUserGrant(_ context.Context, grant *domain.UserGrant, _ string) (*domain.UserGrant, error) {
+	current := s.grants[grant.AggregateID]
+	current.Roles = grant.RoleKeys
+	current.Sequence = s.nextSequence()
+	return grant, nil
+}
+
+func (s *store) RemoveUserGrant(_ context.Context, grantID, _ string) (*domain.ObjectDetails, error) {
+	delete(s.grants, grantID)
+	return nil, nil
+}
+
+func (s *store) GetUserByID(_ context.Context, _ bool, userID string) (*query.User, error) {
+	user, ok := s.users[userID]
+	if !ok {
+		return nil, zerrors.ThrowNotFound(nil, "TEST-Ld9s2", "Errors.User.NotFound")
+	}
+	return user, nil
+}
+
+// SearchUsers ignores the filters of the search request, all users of the organization are returned
+func (s *store) SearchUsers(_ context.Context, queries *query.UserSearchQueries) (*query.Users, error) {
+	users := new(query.Users)
+	for _, id := range sortedKeys(s.users) {
+		user := s.users[id]
+		if matchesQueries(queries.Queries[:1], mustQuery(query.NewUserResourceOwnerSearchQuery(user.ResourceOwner, query.TextEquals))) {
+			users.Users = append(users.Users, user)
+		}
+	}
+	users.Count = uint64(len(users.Users))
+	return users, nil
+}
+
+func (s *store) UserMetadataValuesByKey(_ context.Context, key string, userIDs ...string) (map[string][]byte, error) {
+	s.metadataReads++
+	values := make(map[string][]byte)
+	for _, userID := range userIDs {
+		if value, ok := s.metadata[key][userID]; ok {
+			values[userID] = value
+		}
+	}
+	return values, nil
+}
+
+func (s *store) Memberships(context.Context, *query.MembershipSearchQuery, bool) (*query.Memberships, error) {
+	return new(query.Memberships), nil
+}
+
+func (s *store) SearchProjectRoles(_ context.Context, _ bool, queries *query.ProjectRoleSearchQueries) (*query.ProjectRoles, error) {
+	roles := new(query.ProjectRoles)
+	for _, id := range sortedKeys(s.roles) {
+		role := s.roles[id]
+		if matchesQueries(queries.Queries,
+			mustQuery(query.NewProjectRoleResourceOwnerSearchQuery(role.ResourceOwner)),
+			mustQuery(query.NewProjectRoleProjectIDSearchQuery(role.ProjectID)),
+			mustQuery(query.NewProjectRoleKeySearchQuery(query.TextEquals, role.Key)),
+		) {
+			roles.ProjectRoles = append(roles.ProjectRoles, role)
+		}
+	}
+	roles.Count = uint64(len(roles.ProjectRoles))
+	return roles, nil
+}
+
+func (s *store) SearchProjectGrantsByProjectIDAndRoleKey(context.Context, string, string) (*query.ProjectGrants, error) {
+	return new(query.ProjectGrants), nil
+}
+
+func (s *store) UserGrants(_ context.Context, queries *query.UserGrantsQueries, _ bool) (*query.UserGrants, error) {
+	grants := new(query.UserGrants)
+	for _, id := range sortedKeys(s.grants) {
+		grant := s.grants[id]
+		grantQueries := []query.SearchQuery{
+			mustQuery(query.NewUserGrantResourceOwnerSearchQuery(grant.ResourceOwner)),
+			mustQuery(query.NewUserGrantProjectIDSearchQuery(grant.ProjectID)),
+			mustQuery(query.NewUserGrantUserIDSearchQuery(grant.UserID)),
+		}
+		for _, role := range grant.Roles {
+			grantQueries = append(grantQueries, mustQuery(query.NewUserGrantRoleQuery(role)))
+		}
+		if matchesQueries(queries.Queries, grantQueries...) {
+			grants.UserGrants = append(grants.UserGrants, grant)
+		}
+	}
+	grants.Count = uint64(len(grants.UserGrants))
+	return grants, nil
+}
+
+// matchesQueries returns true if each of the queries equals one of the queries matching the object
+func matchesQueries(queries []query.SearchQuery, matching ...query.SearchQuery) bool {
+	for _, q := range queries {
+		if !slices.ContainsFunc(matching, func(m query.SearchQuery) bool { return reflect.DeepEqual(q, m) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustQuery(q query.SearchQuery, err error) query.SearchQuery {
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func newTestHandler(s *store) http.Handler {
+	verifier := authz.StartAPITokenVerifier(&authzRepoMock{},
+		authz.AccessTokenVerifierFunc(func(context.Context, string) (string, string, string, string, string, error) {
+			return "provisioner", "", "", "", testOrgID, nil
+		}),
+		authz.SystemTokenVerifierFunc(func(context.Context, string, string) (authz.Memberships, string, error) {
+			return nil, "", zerrors.ThrowUnauthenticated(nil, "TEST-s8Fd2", "unauthenticated")
+		}),
+	)
+	authConfig := authz.Config{
+		RolePermissionMappings: []authz.RoleMapping{{
+			Role: testRole,
+			Permissions: []string{
+				domain.PermissionUserRead,
+				domain.PermissionUserWrite,
+				domain.PermissionUserDelete,
+				domain.PermissionProjectRoleRead,
+				domain.PermissionProjectRoleWrite,
+				domain.PermissionProjectRoleDelete,
+				domain.PermissionUserGrantWrite,
+			},
+		}},
+	}
+	config := Config{
+		MaxResults:        100,
+		MaxBulkOperations: 10,
+		MaxPayloadSize:    1 << 20,
+	}
+	instance := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(authz.WithRequestedDomain(authz.WithInstanceID(r.Context(), "instance1"), "scim.example.com")))
+		})
+	}
+	return NewHandler(config, s, s, verifier, authConfig, nil, true, instance)
+}
+
+func serve(t *testing.T, handler http.Handler, method, path, ifMatch, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, "/"+testOrgID+path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("Content-Type", contentTypeSCIM)
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+	return resp
+}
+
+func decode[T any](t *testing.T, resp *httptest.ResponseRecorder) *T {
+	t.Helper()
+	v := new(T)
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), v))
+	return v
+}
+
+func TestHandler_users(t *testing.T) {
+	s := newStore()
+	handler := newTestHandler(s)
+
+	resp := serve(t, handler, http.MethodPost, pathUsers, "",
+		`{"schemas":["`+schemaUser+`"],"userName":"bjensen","externalId":"ext1","name":{"givenName":"Barbara","familyName":"Jensen"},"emails":[{"value":"bjensen@example.com","primary":true}]}`)
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+	created := decode[userResource](t, resp)
+	assert.Equal(t, "ext1", created.ExternalID)
+	assert.Equal(t, "Barbara Jensen", created.Name.Formatted)
+	assert.Equal(t, "https://scim.example.com"+HandlerPrefix+"/"+testOrgID+pathUsers+"/"+created.ID, resp.Header().Get("Location"))
+	assert.Equal(t, created.Meta.Version, resp.Header().Get("ETag"))
+
+	resp = serve(t, handler, http.MethodPost, pathUsers, "",
+		`{"schemas":["`+schemaUser+`"],"userName":"jdoe","externalId":"ext2","active":false}`)
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+	assert.False(t, *decode[userResource](t, resp).Active)
+
+	t.Run("list loads the external ids at once", func(t *testing.T) {
+		s.metadataReads = 0
+		resp := serve(t, handler, http.MethodGet, pathUsers, "", "")
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		list := decode[struct {
+			TotalResults uint64          `json:"totalResults"`
+			Resources    []*userResource `json:"Resources"`
+		}](t, resp)
+		assert.EqualValues(t, 2, list.TotalResults)
+		require.Len(t, list.Resources, 2)
+		assert.Equal(t, "ext1", list.Resources[0].ExternalID)
+		assert.Equal(t, "ext2", list.Resources[1].ExternalID)
+		assert.Equal(t, 1, s.metadataReads)
+	})
+	t.Run("patch", func(t *testing.T) {
+		resp := serve(t, handler, http.MethodPatch, pathUsers+"/"+created.ID, created.Meta.Version,
+			`{"schemas":["`+schemaPatchOp+`"],"Operations":[{"op":"replace","path":"active","value":false},{"op":"remove","path":"externalId"}]}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		patched := decode[userResource](t, resp)
+		assert.False(t, *patched.Active)
+		assert.Empty(t, patched.ExternalID)
+		assert.NotEqual(t, created.Meta.Version, patched.Meta.Version)
+	})
+	t.Run("delete with outdated version", func(t *testing.T) {
+		resp := serve(t, handler, http.MethodDelete, pathUsers+"/"+created.ID, created.Meta.Version, "")
+		assert.Equal(t, http.StatusPreconditionFailed, resp.Code, resp.Body.String())
+	})
+	t.Run("delete", func(t *testing.T) {
+		resp := serve(t, handler, http.MethodDelete, pathUsers+"/"+created.ID, "", "")
+		assert.Equal(t, http.StatusNoContent, resp.Code, resp.Body.String())
+		resp = serve(t, handler, http.MethodGet, pathUsers+"/"+created.ID, "", "")
+		assert.Equal(t, http.StatusNotFound, resp.Code, resp.Body.String())
+	})
+	t.Run("user of other organization", func(t *testing.T) {
+		s.users["other"] = &query.User{ID: "other", ResourceOwner: "456", Human: new(query.Human)}
+		resp := serve(t, handler, http.MethodGet, pathUsers+"/other", "", "")
+		assert.Equal(t, http.StatusNotFound, resp.Code, resp.Body.String())
+	})
+}
+
+func TestHandler_groups(t *testing.T) {
+	s := newStore()
+	handler := newTestHandler(s)
+	require.NoError(t, s.AddUserHuman(context.Background(), testOrgID, &command.AddHuman{Username: "bjensen"}, false, nil))
+	require.NoError(t, s.AddUserHuman(context.Background(), testOrgID, &command.AddHuman{Username: "jdoe"}, false, nil))
+
+	resp := serve(t, handler, http.MethodPost, pathGroups, "",
+		`{"schemas":["`+schemaGroup+`","`+schemaZitadelGroup+`"],"displayName":"Admins","members":[{"value":"user1"}],"`+schemaZitadelGroup+`":{"projectId":"`+testProjectID+`","key":"admin"}}`)
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+	created := decode[groupResource](t, resp)
+	assert.Equal(t, groupID(testProjectID, "admin"), created.ID)
+	require.Len(t, created.Members, 1)
+	assert.Equal(t, "user1", created.Members[0].Value)
+
+	t.Run("version changes with the members", func(t *testing.T) {
+		roleSequence := s.roles[created.ID].Sequence
+		resp := serve(t, handler, http.MethodPatch, pathGroups+"/"+created.ID, created.Meta.Version,
+			`{"schemas":["`+schemaPatchOp+`"],"Operations":[{"op":"add","path":"members","value":[{"value":"user2"}]}]}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		patched := decode[groupResource](t, resp)
+		assert.Len(t, patched.Members, 2)
+		assert.Equal(t, roleSequence, s.roles[created.ID].Sequence)
+		assert.NotEqual(t, created.Meta.Version, patched.Meta.Version)
+
+		resp = serve(t, handler, http.MethodDelete, pathGroups+"/"+created.ID, created.Meta.Version, "")
+		assert.Equal(t, http.StatusPreconditionFailed, resp.Code, resp.Body.String())
+	})
+	t.Run("list without members", func(t *testing.T) {
+		resp := serve(t, handler, http.MethodGet, pathGroups+"?excludedAttributes=members", "", "")
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		list := decode[struct {
+			Resources []*groupResource `json:"Resources"`
+		}](t, resp)
+		require.Len(t, list.Resources, 1)
+		assert.Empty(t, list.Resources[0].Members)
+
+		resp = serve(t, handler, http.MethodGet, pathGroups+"/"+created.ID, "", "")
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, decode[groupResource](t, resp).Meta.Version, list.Resources[0].Meta.Version)
+	})
+	t.Run("remove member", func(t *testing.T) {
+		resp := serve(t, handler, http.MethodPatch, pathGroups+"/"+created.ID, "",
+			`{"schemas":["`+schemaPatchOp+`"],"Operations":[{"op":"remove","path":"members[value eq \"user1\"]"}]}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		patched := decode[groupResource](t, resp)
+		require.Len(t, patched.Members, 1)
+		assert.Equal(t, "user2", patched.Members[0].Value)
+	})
+	t.Run("missing project", func(t *testing.T) {
+		resp := serve(t, handler, http.MethodPost, pathGroups, "", `{"schemas":["`+schemaGroup+`"],"displayName":"Users"}`)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+		assert.Equal(t, scimTypeInvalidValue, decode[scimError](t, resp).ScimType)
+	})
+}
+
+func TestHandler_bulk(t *testing.T) {
+	s := newStore()
+	handler := newTestHandler(s)
+
+	resp := serve(t, handler, http.MethodPost, pathBulk, "", `{"schemas":["`+schemaBulkRequest+`"],"Operations":[
+		{"method":"POST","path":"/Users","bulkId":"1","data":{"schemas":["`+schemaUser+`"],"userName":"bjensen"}},
+		{"method":"POST","path":"/Users","bulkId":"10","data":{"schemas":["`+schemaUser+`"],"userName":"jdoe"}},
+		{"method":"POST","path":"/Groups","bulkId":"group","data":{"schemas":["`+schemaGroup+`"],"displayName":"Admins","members":[{"value":"bulkId:10"},{"value":"bulkId:1"}],"`+schemaZitadelGroup+`":{"projectId":"`+testProjectID+`","key":"admin"}}},
+		{"method":"PATCH","path":"/Users/bulkId:1","data":{"schemas":["`+schemaPatchOp+`"],"Operations":[{"op":"replace","path":"userName","value":"babs"}]}},
+		{"method":"DELETE","path":"/Users/bulkId:2"}
+	]}`)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	bulk := decode[bulkResponse](t, resp)
+	require.Len(t, bulk.Operations, 5)
+	for _, operation := range bulk.Operations[:4] {
+		assert.Nil(t, operation.Response, operation.BulkID)
+	}
+	assert.Equal(t, "201", bulk.Operations[0].Status)
+	assert.Equal(t, "201", bulk.Operations[2].Status)
+	assert.Equal(t, "200", bulk.Operations[3].Status)
+	assert.Equal(t, "400", bulk.Operations[4].Status)
+	assert.Equal(t, scimTypeInvalidValue, bulk.Operations[4].Response.ScimType)
+
+	assert.Equal(t, "babs", s.users["user1"].Username)
+	members := make([]string, 0, 2)
+	for _, grant := range s.grants {
+		members = append(members, grant.UserID)
+	}
+	assert.ElementsMatch(t, []string{"user1", "user2"}, members)
+	assert.Len(t, s.users, 2)
+}
+
+func Test_resolveBulkIDs(t *testing.T) {
+	createdIDs := map[string]string{"1": "user1", "10": "user10"}
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no references",
+			s:    `{"userName":"bjensen"}`,
+			want: `{"userName":"bjensen"}`,
+		},
+		{
+			name: "path",
+			s:    "/Users/bulkId:10",
+			want: "/Users/user10",
+		},
+		{
+			name: "prefix of other reference",
+			s:    `[{"value":"bulkId:1"},{"value":"bulkId:10"}]`,
+			want: `[{"value":"user1"},{"value":"user10"}]`,
+		},
+		{
+			name:    "unknown reference",
+			s:       `[{"value":"bulkId:1"},{"value":"bulkId:100"}]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveBulkIDs(tt.s, createdIDs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package scim
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	patchOpAdd     = "add"
+	patchOpRemove  = "remove"
+	patchOpReplace = "replace"
+)
+
+// patchRequest is the body of a PATCH request as defined in RFC 7644, section 3.5.2
+type patchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// patchPath is a parsed attribute path, e.g. `emails[type eq "work"].value`
+type patchPath struct {
+	Attribute    string
+	Filter       filter
+	SubAttribute string
+}
+
+func parsePatchPath(path, schema string) (*patchPath, error) {
+	path = trimSchema(path, schema)
+	if path == "" {
+		return nil, errInvalidPath("empty path")
+	}
+	p := new(patchPath)
+	// attributes of extension schemas contain dots in the urn
+	if strings.HasPrefix(path, "urn:") {
+		p.Attribute = strings.ToLower(path)
+		return p, nil
+	}
+	if open := strings.IndexByte(path, '['); open >= 0 {
+		end := strings.LastIndexByte(path, ']')
+		if end < open {
+			return nil, errInvalidPath("missing closing bracket in path " + path)
+		}
+		f, err := parseFilter(path[open+1 : end])
+		if err != nil {
+			return nil, errInvalidPath("invalid filter in path " + path)
+		}
+		p.Attribute = strings.ToLower(path[:open])
+		p.Filter = f
+		p.SubAttribute = strings.ToLower(strings.TrimPrefix(path[end+1:], "."))
+		return p, nil
+	}
+	attribute, subAttribute, _ := strings.Cut(path, ".")
+	p.Attribute, p.SubAttribute = strings.ToLower(attribute), strings.ToLower(subAttribute)
+	return p, nil
+}
+
+func (op *patchOperation) operation() (string, error) {
+	operation := strings.ToLower(op.Op)
+	switch operation {
+	case patchOpAdd, patchOpReplace:
+		if len(op.Value) == 0 {
+			return "", errInvalidValue("value is required for " + op.Op)
+		}
+		return operation, nil
+	case patchOpRemove:
+		if op.Path == "" {
+			return "", errNoTarget("path is required for remove")
+		}
+		return operation, nil
+	}
+	return "", errInvalidSyntax("unknown patch operation " + op.Op)
+}
+
+// applyPatch applies the operations using the attribute setter of the resource.
+// Operations without a path set each attribute of the value object.
+func applyPatch(operations []*patchOperation, schema string, apply func(op string, path *patchPath, value json.RawMessage) error) error {
+	for _, operation := range operations {
+		op, err := operation.operation()
+		if err != nil {
+			return err
+		}
+		if operation.Path != "" {
+			path, err := parsePatchPath(operation.Path, schema)
+			if err != nil {
+				return err
+			}
+			if err = apply(op, path, operation.Value); err != nil {
+				return err
+			}
+			continue
+		}
+		attributes := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(operation.Value, &attributes); err != nil {
+			return errInvalidValue("value must be an object if no path is provided")
+		}
+		for attribute, value := range attributes {
+			path, err := parsePatchPath(attribute, schema)
+			if err != nil {
+				return err
+			}
+			if err = apply(op, path, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func applyUserPatch(u *userResource, operations []*patchOperation) error {
+	return applyPatch(operations, schemaUser, u.applyPatch)
+}
+
+func (u *userResource) applyPatch(op string, path *patchPath, value json.RawMessage) (err error) {
+	if path.Attribute == "name" && path.SubAttribute == "" {
+		return u.patchName(op, value)
+	}
+	switch path.Attribute {
+	case "username":
+		if op == patchOpRemove {
+			return errMutability("userName is required")
+		}
+		return unmarshalString(value, &u.UserName)
+	case "displayname":
+		return patchString(op, value, &u.DisplayName)
+	case "nickname":
+		return patchString(op, value, &u.NickName)
+	case "preferredlanguage":
+		return patchString(op, value, &u.PreferredLanguage)
+	case "externalid":
+		return patchString(op, value, &u.ExternalID)
+	case "password":
+		return patchString(op, value, &u.Password)
+	case "active":
+		if op == patchOpRemove {
+			return errMutability("active can not be removed")
+		}
+		active, err := unmarshalBool(value)
+		if err != nil {
+			return err
+		}
+		u.Active = &active
+		return nil
+	case "name":
+		if u.Name == nil {
+			u.Name = new(userName)
+		}
+		switch path.SubAttribute {
+		case "givenname":
+			return patchString(op, value, &u.Name.GivenName)
+		case "familyname":
+			return patchString(op, value, &u.Name.FamilyName)
+		case "formatted":
+			return patchString(op, value, &u.Name.Formatted)
+		}
+	case "emails":
+		u.Emails, err = patchMultiValued(op, path, value, u.Emails)
+		return err
+	case "phonenumbers":
+		u.PhoneNumbers, err = patchMultiValued(op, path, value, u.PhoneNumbers)
+		return err
+	case "groups":
+		return errMutability("groups are read only, use the Group resource to change memberships")
+	case "id", "meta":
+		return errMutability(path.Attribute + " is read only")
+	}
+	// attributes of extension schemas (e.g. the enterprise user) are not stored
+	if strings.HasPrefix(path.Attribute, "urn:") {
+		return nil
+	}
+	return errInvalidPath("unknown attribute " + path.Attribute)
+}
+
+func (u *userResource) patchName(op string, value json.RawMessage) error {
+	if op == patchOpRemove {
+		u.Name = nil
+		return nil
+	}
+	name := new(userName)
+	if err := json.Unmarshal(value, name); err != nil {
+		return errInvalidValue("invalid name")
+	}
+	if op == patchOpReplace || u.Name == nil {
+		u.Name = name
+		return nil
+	}
+	if name.GivenName != "" {
+		u.Name.GivenName = name.GivenName
+	}
+	if name.FamilyName != "" {
+		u.Name.FamilyName = name.FamilyName
+	}
+	if name.Formatted != "" {
+		u.Name.Formatted = name.Formatted
+	}
+	return nil
+}
+
+// patchMultiValued changes emails or phone numbers.
+// As only a single value is stored, filters and sub attributes always target the primary value.
+func patchMultiValued(op string, path *patchPath, value json.RawMessage, values []*multiValued) ([]*multiValued, error) {
+	if op == patchOpRemove {
+		return nil, nil
+	}
+	if path.Filter != nil || path.SubAttribute != "" {
+		if path.SubAttribute != "" && path.SubAttribute != "value" {
+			// type, primary and display are not stored
+			return values, nil
+		}
+		var v string
+		if err := unmarshalString(value, &v); err != nil {
+			return nil, err
+		}
+		return []*multiValued{{Value: v, Primary: true}}, nil
+	}
+	newValues := make([]*multiValued, 0, 1)
+	if err := json.Unmarshal(value, &newValues); err != nil {
+		return nil, errInvalidValue("invalid value for " + path.Attribute)
+	}
+	if op == patchOpAdd && primaryValue(newValues) == "" {
+		return values, nil
+	}
+	return newValues, nil
+}
+
+func applyGroupPatch(g *groupResource, operations []*patchOperation) error {
+	return applyPatch(operations, schemaGroup, g.applyPatch)
+}
+
+func (g *groupResource) applyPatch(op string, path *patchPath, value json.RawMessage) error {
+	switch path.Attribute {
+	case "displayname":
+		if op == patchOpRemove {
+			return errMutability("displayName is required")
+		}
+		return unmarshalString(value, &g.DisplayName)
+	case "members":
+		return g.patchMembers(op, path, value)
+	case "id", "meta":
+		return errMutability(path.Attribute + " is read only")
+	}
+	if strings.HasPrefix(path.Attribute, strings.ToLower(schemaZitadelGroup)) {
+		return errMutability("the project of a group can not be changed")
+	}
+	return errInvalidPath("unknown attribute " + path.Attribute)
+}
+
+func (g *groupResource) patchMembers(op string, path *patchPath, value json.RawMessage) error {
+	var members []*memberReference
+	if len(value) > 0 {
+		if err := json.Unmarshal(value, &members); err != nil {
+			return errInvalidValue("members must be a list of member references")
+		}
+	}
+	switch op {
+	case patchOpReplace:
+		g.Members = members
+	case patchOpAdd:
+		for _, member := range members {
+			if !g.hasMember(member.Value) {
+				g.Members = append(g.Members, member)
+			}
+		}
+	case patchOpRemove:
+		g.Members = removeMembers(g.Members, func(member *memberReference) bool {
+			if path.Filter != nil {
+				return matchesMember(path.Filter, member)
+			}
+			if len(members) == 0 {
+				return true
+			}
+			for _, m := range members {
+				if m.Value == member.Value {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	return nil
+}
+
+func (g *groupResource) hasMember(id string) bool {
+	for _, member := range g.Members {
+		if member.Value == id {
+			return true
+		}
+	}
+	return false
+}
+
+func removeMembers(members []*memberReference, remove func(*memberReference) bool) []*memberReference {
+	kept := make([]*memberReference, 0, len(members))
+	for _, member := range members {
+		if !remove(member) {
+			kept = append(kept, member)
+		}
+	}
+	return kept
+}
+
+// matchesMember evaluates filters like `value eq "123"` of a members path
+func matchesMember(f filter, member *memberReference) bool {
+	switch f := f.(type) {
+	case *attributeFilter:
+		if !strings.EqualFold(f.Path, "value") {
+			return false
+		}
+		value, _ := f.Value.(string)
+		switch f.Operator {
+		case operatorEqual:
+			return member.Value == value
+		case operatorNotEqual:
+			return member.Value != value
+		}
+	case *logicalFilter:
+		if f.Operator == operatorOr {
+			return matchesMember(f.Left, member) || matchesMember(f.Right, member)
+		}
+		return matchesMember(f.Left, member) && matchesMember(f.Right, member)
+	case *notFilter:
+		return !matchesMember(f.Filter, member)
+	}
+	return false
+}
+
+func patchString(op string, value json.RawMessage, target *string) error {
+	if op == patchOpRemove {
+		*target = ""
+		return nil
+	}
+	return unmarshalString(value, target)
+}
+
+func unmarshalString(value json.RawMessage, target *string) error {
+	if err := json.Unmarshal(value, target); err != nil {
+		return errInvalidValue("expected a string value")
+	}
+	return nil
+}
+
+// unmarshalBool also accepts booleans sent as strings, which some clients do (e.g. "False")
+func unmarshalBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		switch strings.ToLower(s) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+	return false, errInvalidValue("expected a boolean value")
+}
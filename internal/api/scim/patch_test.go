@@ -0,0 +1,199 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_applyUserPatch(t *testing.T) {
+	active := true
+	inactive := false
+	newUser := func() *userResource {
+		return &userResource{
+			UserName:    "bjensen",
+			Name:        &userName{GivenName: "Barbara", FamilyName: "Jensen"},
+			DisplayName: "Babs",
+			Active:      &active,
+			Emails:      []*multiValued{{Value: "bjensen@example.com", Primary: true}},
+		}
+	}
+	tests := []struct {
+		name       string
+		operations string
+		want       func(u *userResource)
+		wantErr    bool
+	}{
+		{
+			name:       "replace attribute",
+			operations: `[{"op":"replace","path":"displayName","value":"Barbara"}]`,
+			want: func(u *userResource) {
+				u.DisplayName = "Barbara"
+			},
+		},
+		{
+			name:       "operation case insensitive",
+			operations: `[{"op":"Replace","path":"active","value":"False"}]`,
+			want: func(u *userResource) {
+				u.Active = &inactive
+			},
+		},
+		{
+			name:       "sub attribute with schema",
+			operations: `[{"op":"replace","path":"urn:ietf:params:scim:schemas:core:2.0:User:name.familyName","value":"Doe"}]`,
+			want: func(u *userResource) {
+				u.Name.FamilyName = "Doe"
+			},
+		},
+		{
+			name:       "without path",
+			operations: `[{"op":"replace","value":{"nickName":"Babs","name.givenName":"Babs"}}]`,
+			want: func(u *userResource) {
+				u.NickName = "Babs"
+				u.Name.GivenName = "Babs"
+			},
+		},
+		{
+			name:       "value filter",
+			operations: `[{"op":"replace","path":"emails[type eq \"work\"].value","value":"babs@example.com"}]`,
+			want: func(u *userResource) {
+				u.Emails = []*multiValued{{Value: "babs@example.com", Primary: true}}
+			},
+		},
+		{
+			name:       "remove",
+			operations: `[{"op":"remove","path":"displayName"}]`,
+			want: func(u *userResource) {
+				u.DisplayName = ""
+			},
+		},
+		{
+			name:       "extension attributes are ignored",
+			operations: `[{"op":"add","path":"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber","value":"701984"}]`,
+			want:       func(u *userResource) {},
+		},
+		{
+			name:       "remove without path",
+			operations: `[{"op":"remove"}]`,
+			wantErr:    true,
+		},
+		{
+			name:       "remove required attribute",
+			operations: `[{"op":"remove","path":"userName"}]`,
+			wantErr:    true,
+		},
+		{
+			name:       "read only attribute",
+			operations: `[{"op":"replace","path":"id","value":"123"}]`,
+			wantErr:    true,
+		},
+		{
+			name:       "unknown attribute",
+			operations: `[{"op":"replace","path":"unknown","value":"123"}]`,
+			wantErr:    true,
+		},
+		{
+			name:       "unknown operation",
+			operations: `[{"op":"move","path":"displayName","value":"Babs"}]`,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var operations []*patchOperation
+			require.NoError(t, json.Unmarshal([]byte(tt.operations), &operations))
+			got := newUser()
+			err := applyUserPatch(got, operations)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			want := newUser()
+			tt.want(want)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func Test_applyGroupPatch(t *testing.T) {
+	newGroup := func() *groupResource {
+		return &groupResource{
+			DisplayName: "Admins",
+			Members:     []*memberReference{{Value: "1"}, {Value: "2"}},
+		}
+	}
+	tests := []struct {
+		name       string
+		operations string
+		want       []*memberReference
+		wantErr    bool
+	}{
+		{
+			name:       "add members",
+			operations: `[{"op":"add","path":"members","value":[{"value":"2"},{"value":"3"}]}]`,
+			want:       []*memberReference{{Value: "1"}, {Value: "2"}, {Value: "3"}},
+		},
+		{
+			name:       "replace members",
+			operations: `[{"op":"replace","path":"members","value":[{"value":"3"}]}]`,
+			want:       []*memberReference{{Value: "3"}},
+		},
+		{
+			name:       "remove member by filter",
+			operations: `[{"op":"remove","path":"members[value eq \"1\"]"}]`,
+			want:       []*memberReference{{Value: "2"}},
+		},
+		{
+			name:       "remove member by value",
+			operations: `[{"op":"remove","path":"members","value":[{"value":"2"}]}]`,
+			want:       []*memberReference{{Value: "1"}},
+		},
+		{
+			name:       "remove all members",
+			operations: `[{"op":"remove","path":"members"}]`,
+			want:       []*memberReference{},
+		},
+		{
+			name:       "change project",
+			operations: `[{"op":"replace","path":"urn:ietf:params:scim:schemas:extension:zitadel:2.0:Group:projectId","value":"123"}]`,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var operations []*patchOperation
+			require.NoError(t, json.Unmarshal([]byte(tt.operations), &operations))
+			got := newGroup()
+			err := applyGroupPatch(got, operations)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Members)
+		})
+	}
+}
+
+func Test_matchesVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		version string
+		want    bool
+	}{
+		{"empty", "", etag(1), true},
+		{"wildcard", "*", etag(1), true},
+		{"match", `W/"1"`, etag(1), true},
+		{"list", `W/"2", W/"1"`, etag(1), true},
+		{"mismatch", `W/"2"`, etag(1), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesVersion(tt.ifMatch, tt.version))
+		})
+	}
+}
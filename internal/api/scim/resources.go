@@ -0,0 +1,172 @@
+package scim
+
+import (
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaZitadelGroup          = "urn:ietf:params:scim:schemas:extension:zitadel:2.0:Group"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaSearchRequest         = "urn:ietf:params:scim:api:messages:2.0:SearchRequest"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaBulkRequest           = "urn:ietf:params:scim:api:messages:2.0:BulkRequest"
+	schemaBulkResponse          = "urn:ietf:params:scim:api:messages:2.0:BulkResponse"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+
+	resourceTypeUser  = "User"
+	resourceTypeGroup = "Group"
+)
+
+type meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Version      string     `json:"version,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+type userResource struct {
+	Schemas           []string           `json:"schemas"`
+	ID                string             `json:"id,omitempty"`
+	ExternalID        string             `json:"externalId,omitempty"`
+	UserName          string             `json:"userName"`
+	Name              *userName          `json:"name,omitempty"`
+	DisplayName       string             `json:"displayName,omitempty"`
+	NickName          string             `json:"nickName,omitempty"`
+	PreferredLanguage string             `json:"preferredLanguage,omitempty"`
+	Active            *bool              `json:"active,omitempty"`
+	Emails            []*multiValued     `json:"emails,omitempty"`
+	PhoneNumbers      []*multiValued     `json:"phoneNumbers,omitempty"`
+	Password          string             `json:"password,omitempty"`
+	Groups            []*memberReference `json:"groups,omitempty"`
+	Meta              *meta              `json:"meta,omitempty"`
+}
+
+type userName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+type multiValued struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// primaryValue returns the value flagged as primary or the first value of the list.
+// ZITADEL only stores a single email and phone number per user.
+func primaryValue(values []*multiValued) string {
+	for _, value := range values {
+		if value.Primary {
+			return value.Value
+		}
+	}
+	if len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
+
+func (u *userResource) isActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+type groupResource struct {
+	Schemas     []string           `json:"schemas"`
+	ID          string             `json:"id,omitempty"`
+	DisplayName string             `json:"displayName"`
+	Members     []*memberReference `json:"members,omitempty"`
+	Zitadel     *groupExtension    `json:"urn:ietf:params:scim:schemas:extension:zitadel:2.0:Group,omitempty"`
+	Meta        *meta              `json:"meta,omitempty"`
+}
+
+// groupExtension carries the ZITADEL specific attributes of a group.
+// Groups are represented as project roles, so a project is required on creation.
+type groupExtension struct {
+	ProjectID string `json:"projectId,omitempty"`
+	Key       string `json:"key,omitempty"`
+	Group     string `json:"group,omitempty"`
+}
+
+type memberReference struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+// groupID combines the project id and the role key to the id of the group.
+// Project ids never contain a colon, so the first colon separates the two parts.
+func groupID(projectID, roleKey string) string {
+	return projectID + ":" + roleKey
+}
+
+func splitGroupID(id string) (projectID, roleKey string, ok bool) {
+	projectID, roleKey, ok = strings.Cut(id, ":")
+	return projectID, roleKey, ok && projectID != "" && roleKey != ""
+}
+
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults uint64   `json:"totalResults"`
+	StartIndex   uint64   `json:"startIndex"`
+	ItemsPerPage uint64   `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+func newListResponse(total, startIndex uint64, resources []any) *listResponse {
+	return &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: uint64(len(resources)),
+		Resources:    resources,
+	}
+}
+
+// etag returns the weak entity tag of a resource based on the sequence of its aggregate.
+func etag(sequence uint64) string {
+	return `W/"` + strconv.FormatUint(sequence, 10) + `"`
+}
+
+// groupETag returns the weak entity tag of a group based on the sequence of the role
+// and the sequences of the user grants of its members,
+// so adding or removing a member results in a new version as well.
+func groupETag(roleSequence uint64, grants []*query.UserGrant) string {
+	grants = slices.Clone(grants)
+	slices.SortFunc(grants, func(a, b *query.UserGrant) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	hash := fnv.New64a()
+	hash.Write([]byte(strconv.FormatUint(roleSequence, 10)))
+	for _, grant := range grants {
+		hash.Write([]byte("|" + grant.ID + ":" + strconv.FormatUint(grant.Sequence, 10)))
+	}
+	return `W/"` + strconv.FormatUint(hash.Sum64(), 36) + `"`
+}
+
+// matchesVersion checks the If-Match header against the current version of the resource.
+// An empty header or a wildcard always match.
+func matchesVersion(ifMatch, version string) bool {
+	if ifMatch == "" || ifMatch == "*" {
+		return true
+	}
+	for _, tag := range strings.Split(ifMatch, ",") {
+		if strings.TrimSpace(tag) == version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,250 @@
+package scim
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	HandlerPrefix = "/scim/v2"
+
+	contentTypeSCIM = "application/scim+json"
+
+	varOrgID = "orgID"
+
+	pathUsers                 = "/Users"
+	pathGroups                = "/Groups"
+	pathBulk                  = "/Bulk"
+	pathSearch                = "/.search"
+	pathServiceProviderConfig = "/ServiceProviderConfig"
+	pathResourceTypes         = "/ResourceTypes"
+	pathSchemas               = "/Schemas"
+
+	orgPrefix = "/{" + varOrgID + ":[0-9]+}"
+)
+
+type Config struct {
+	// MaxResults limits the number of resources returned in a list response
+	MaxResults uint64
+	// MaxBulkOperations limits the number of operations in a single bulk request
+	MaxBulkOperations int
+	// MaxPayloadSize limits the size of request bodies in bytes
+	MaxPayloadSize int64
+	// EmailVerified marks emails and phone numbers set by the provisioning client as verified
+	EmailVerified bool
+}
+
+// Commands are the commands used to provision the users and groups
+type Commands interface {
+	AddUserHuman(ctx context.Context, resourceOwner string, human *command.AddHuman, allowInitMail bool, alg crypto.EncryptionAlgorithm) error
+	ChangeUserHuman(ctx context.Context, human *command.ChangeHuman, alg crypto.EncryptionAlgorithm) error
+	RemoveHumanPhone(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	DeactivateUserV2(ctx context.Context, userID string) (*domain.ObjectDetails, error)
+	ReactivateUserV2(ctx context.Context, userID string) (*domain.ObjectDetails, error)
+	RemoveUserV2(ctx context.Context, userID string, cascadingUserMemberships []*command.CascadingMembership, cascadingGrantIDs ...string) (*domain.ObjectDetails, error)
+	SetUserMetadata(ctx context.Context, metadata *domain.Metadata, userID, resourceOwner string) (*domain.Metadata, error)
+	RemoveUserMetadata(ctx context.Context, metadataKey, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	AddProjectRole(ctx context.Context, projectRole *domain.ProjectRole, resourceOwner string) (*domain.ProjectRole, error)
+	ChangeProjectRole(ctx context.Context, projectRole *domain.ProjectRole, resourceOwner string) (*domain.ProjectRole, error)
+	RemoveProjectRole(ctx context.Context, projectID, key, resourceOwner string, cascadingProjectGrantIds []string, cascadeUserGrantIDs ...string) (*domain.ObjectDetails, error)
+	AddUserGrant(ctx context.Context, usergrant *domain.UserGrant, resourceOwner string) (*domain.UserGrant, error)
+	ChangeUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string) (*domain.UserGrant, error)
+	RemoveUserGrant(ctx context.Context, grantID, resourceOwner string) (*domain.ObjectDetails, error)
+}
+
+// Queries are the queries used to read the users and groups
+type Queries interface {
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string) (*query.User, error)
+	SearchUsers(ctx context.Context, queries *query.UserSearchQueries) (*query.Users, error)
+	UserMetadataValuesByKey(ctx context.Context, key string, userIDs ...string) (map[string][]byte, error)
+	Memberships(ctx context.Context, queries *query.MembershipSearchQuery, shouldTrigger bool) (*query.Memberships, error)
+	SearchProjectRoles(ctx context.Context, shouldTriggerBulk bool, queries *query.ProjectRoleSearchQueries) (*query.ProjectRoles, error)
+	SearchProjectGrantsByProjectIDAndRoleKey(ctx context.Context, projectID, roleKey string) (*query.ProjectGrants, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool) (*query.UserGrants, error)
+}
+
+type handler struct {
+	config         Config
+	command        Commands
+	query          Queries
+	verifier       authz.APITokenVerifier
+	authConfig     authz.Config
+	userCodeAlg    crypto.EncryptionAlgorithm
+	externalSecure bool
+}
+
+// request is a single SCIM operation, either sent as http request or as part of a bulk request
+type request struct {
+	orgID   string
+	token   string
+	method  string
+	path    string
+	ifMatch string
+	body    []byte
+}
+
+func NewHandler(
+	config Config,
+	commands Commands,
+	queries Queries,
+	verifier authz.APITokenVerifier,
+	authConfig authz.Config,
+	userCodeAlg crypto.EncryptionAlgorithm,
+	externalSecure bool,
+	interceptors ...mux.MiddlewareFunc,
+) http.Handler {
+	h := &handler{
+		config:         config,
+		command:        commands,
+		query:          queries,
+		verifier:       verifier,
+		authConfig:     authConfig,
+		userCodeAlg:    userCodeAlg,
+		externalSecure: externalSecure,
+	}
+
+	router := mux.NewRouter()
+	router.Use(interceptors...)
+	org := router.PathPrefix(orgPrefix).Subrouter()
+	org.HandleFunc(pathServiceProviderConfig, h.handleServiceProviderConfig).Methods(http.MethodGet)
+	org.HandleFunc(pathResourceTypes, h.handleResourceTypes).Methods(http.MethodGet)
+	org.HandleFunc(pathSchemas, h.handleSchemas).Methods(http.MethodGet)
+	org.HandleFunc(pathUsers, h.handleList(h.listUsers)).Methods(http.MethodGet)
+	org.HandleFunc(pathUsers+pathSearch, h.handleList(h.listUsers)).Methods(http.MethodPost)
+	org.HandleFunc(pathGroups, h.handleList(h.listGroups)).Methods(http.MethodGet)
+	org.HandleFunc(pathGroups+pathSearch, h.handleList(h.listGroups)).Methods(http.MethodPost)
+	org.HandleFunc(pathBulk, h.handleBulk).Methods(http.MethodPost)
+	org.PathPrefix("/").HandlerFunc(h.handleResource)
+	return router
+}
+
+func (h *handler) handleList(list func(ctx context.Context, req *request, search *searchRequest) (*listResponse, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, h.config.MaxPayloadSize)
+		search, err := parseSearchRequest(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		resp, err := list(authz.WithDPoPRequestFromHTTP(r.Context(), r), newRequest(r, nil), search)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeResponse(w, http.StatusOK, resp)
+	}
+}
+
+func (h *handler) handleResource(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.config.MaxPayloadSize))
+	if err != nil {
+		writeError(w, errTooMany("the request exceeds the maximum payload size"))
+		return
+	}
+	status, resource, err := h.execute(authz.WithDPoPRequestFromHTTP(r.Context(), r), newRequest(r, body))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if resource != nil {
+		m := resource.resourceMeta()
+		w.Header().Set("ETag", m.Version)
+		if status == http.StatusCreated {
+			w.Header().Set("Location", m.Location)
+		}
+	}
+	writeResponse(w, status, resource)
+}
+
+func newRequest(r *http.Request, body []byte) *request {
+	orgID := mux.Vars(r)[varOrgID]
+	return &request{
+		orgID:   orgID,
+		token:   http_util.GetAuthorization(r),
+		method:  r.Method,
+		path:    strings.TrimPrefix(r.URL.Path, "/"+orgID),
+		ifMatch: r.Header.Get("If-Match"),
+		body:    body,
+	}
+}
+
+type resource interface {
+	resourceMeta() *meta
+}
+
+// execute routes a single operation on the Users or Groups endpoint
+func (h *handler) execute(ctx context.Context, req *request) (status int, _ resource, err error) {
+	resourceType, id, _ := strings.Cut(strings.TrimPrefix(req.path, "/"), "/")
+	switch "/" + resourceType {
+	case pathUsers:
+		return h.executeUser(ctx, req, id)
+	case pathGroups:
+		return h.executeGroup(ctx, req, id)
+	}
+	return 0, nil, errNotFound("unknown resource " + req.path)
+}
+
+func (h *handler) executeUser(ctx context.Context, req *request, id string) (int, resource, error) {
+	switch {
+	case req.method == http.MethodPost && id == "":
+		user, err := h.createUser(ctx, req)
+		return http.StatusCreated, user, err
+	case req.method == http.MethodGet && id != "":
+		user, err := h.getUser(ctx, req, id)
+		return http.StatusOK, user, err
+	case req.method == http.MethodPut && id != "":
+		user, err := h.replaceUser(ctx, req, id)
+		return http.StatusOK, user, err
+	case req.method == http.MethodPatch && id != "":
+		user, err := h.patchUser(ctx, req, id)
+		return http.StatusOK, user, err
+	case req.method == http.MethodDelete && id != "":
+		return http.StatusNoContent, nil, h.deleteUser(ctx, req, id)
+	}
+	return 0, nil, newSCIMError(http.StatusMethodNotAllowed, "", req.method+" is not supported on "+req.path)
+}
+
+func (h *handler) executeGroup(ctx context.Context, req *request, id string) (int, resource, error) {
+	switch {
+	case req.method == http.MethodPost && id == "":
+		group, err := h.createGroup(ctx, req)
+		return http.StatusCreated, group, err
+	case req.method == http.MethodGet && id != "":
+		group, err := h.getGroup(ctx, req, id)
+		return http.StatusOK, group, err
+	case req.method == http.MethodPut && id != "":
+		group, err := h.replaceGroup(ctx, req, id)
+		return http.StatusOK, group, err
+	case req.method == http.MethodPatch && id != "":
+		group, err := h.patchGroup(ctx, req, id)
+		return http.StatusOK, group, err
+	case req.method == http.MethodDelete && id != "":
+		return http.StatusNoContent, nil, h.deleteGroup(ctx, req, id)
+	}
+	return 0, nil, newSCIMError(http.StatusMethodNotAllowed, "", req.method+" is not supported on "+req.path)
+}
+
+// authorize verifies the token of the request and checks the permission on the organization.
+// The returned context contains the requested permissions, which are required by some commands.
+func (h *handler) authorize(ctx context.Context, req *request, permission string) (context.Context, error) {
+	ctxSetter, err := authz.CheckUserAuthorization(ctx, nil, req.token, req.orgID, "", h.verifier, h.authConfig, authz.Option{Permission: permission}, req.method+":"+HandlerPrefix+req.path)
+	if err != nil {
+		return nil, err
+	}
+	return ctxSetter(ctx), nil
+}
+
+func (h *handler) location(ctx context.Context, orgID, resourcePath, id string) string {
+	return http_util.BuildOrigin(authz.GetInstance(ctx).RequestedHost(), h.externalSecure) + HandlerPrefix + "/" + orgID + resourcePath + "/" + id
+}
@@ -0,0 +1,301 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// searchRequest contains the list parameters of RFC 7644, section 3.4.2
+// either sent as query parameters or as body of a POST to `/.search`
+type searchRequest struct {
+	Schemas    []string `json:"schemas"`
+	Filter     string   `json:"filter"`
+	SortBy     string   `json:"sortBy"`
+	SortOrder  string   `json:"sortOrder"`
+	StartIndex uint64   `json:"startIndex"`
+	Count      *uint64  `json:"count"`
+
+	ExcludedAttributes []string `json:"excludedAttributes"`
+}
+
+func parseSearchRequest(r *http.Request) (*searchRequest, error) {
+	req := new(searchRequest)
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			return nil, errInvalidSyntax("invalid search request")
+		}
+	} else {
+		params := r.URL.Query()
+		req.Filter = params.Get("filter")
+		req.SortBy = params.Get("sortBy")
+		req.SortOrder = params.Get("sortOrder")
+		if excluded := params.Get("excludedAttributes"); excluded != "" {
+			req.ExcludedAttributes = strings.Split(excluded, ",")
+		}
+		if startIndex := params.Get("startIndex"); startIndex != "" {
+			index, err := strconv.ParseUint(startIndex, 10, 64)
+			if err != nil {
+				return nil, errInvalidValue("invalid startIndex")
+			}
+			req.StartIndex = index
+		}
+		if count := params.Get("count"); count != "" {
+			c, err := strconv.ParseUint(count, 10, 64)
+			if err != nil {
+				return nil, errInvalidValue("invalid count")
+			}
+			req.Count = &c
+		}
+	}
+	// startIndex is 1-based, values below 1 are interpreted as 1
+	if req.StartIndex < 1 {
+		req.StartIndex = 1
+	}
+	return req, nil
+}
+
+// toSearchRequest maps the pagination and sorting to the query package.
+// A count of 0 only returns the total results, which still requires a limit for the query.
+func (r *searchRequest) toSearchRequest(maxResults uint64, sortColumns map[string]query.Column, schema string) (query.SearchRequest, error) {
+	limit := maxResults
+	if r.Count != nil && *r.Count < limit {
+		limit = *r.Count
+	}
+	if limit == 0 {
+		limit = 1
+	}
+	req := query.SearchRequest{
+		Offset: r.StartIndex - 1,
+		Limit:  limit,
+		Asc:    !strings.EqualFold(r.SortOrder, "descending"),
+	}
+	if r.SortBy == "" {
+		return req, nil
+	}
+	column, ok := sortColumns[normalizeAttributePath(r.SortBy, schema)]
+	if !ok {
+		return req, errInvalidValue("sorting by " + r.SortBy + " is not supported")
+	}
+	req.SortingColumn = column
+	return req, nil
+}
+
+func (r *searchRequest) onlyCount() bool {
+	return r.Count != nil && *r.Count == 0
+}
+
+func (r *searchRequest) excludes(attribute string) bool {
+	for _, excluded := range r.ExcludedAttributes {
+		if strings.EqualFold(strings.TrimSpace(excluded), attribute) {
+			return true
+		}
+	}
+	return false
+}
+
+var userSortColumns = map[string]query.Column{
+	"id":                query.UserIDCol,
+	"username":          query.UserUsernameCol,
+	"displayname":       query.HumanDisplayNameCol,
+	"nickname":          query.HumanNickNameCol,
+	"name.givenname":    query.HumanFirstNameCol,
+	"name.familyname":   query.HumanLastNameCol,
+	"emails":            query.HumanEmailCol,
+	"emails.value":      query.HumanEmailCol,
+	"meta.created":      query.UserCreationDateCol,
+	"meta.lastmodified": query.UserChangeDateCol,
+}
+
+var groupSortColumns = map[string]query.Column{
+	"displayname":       query.ProjectRoleColumnDisplayName,
+	"meta.created":      query.ProjectRoleColumnCreationDate,
+	"meta.lastmodified": query.ProjectRoleColumnChangeDate,
+}
+
+type textQueryFunc func(value string, comparison query.TextComparison) (query.SearchQuery, error)
+
+var userTextAttributes = map[string]textQueryFunc{
+	"username":           query.NewUserUsernameSearchQuery,
+	"displayname":        query.NewUserDisplayNameSearchQuery,
+	"nickname":           query.NewUserNickNameSearchQuery,
+	"name.givenname":     query.NewUserFirstNameSearchQuery,
+	"name.familyname":    query.NewUserLastNameSearchQuery,
+	"emails":             query.NewUserEmailSearchQuery,
+	"emails.value":       query.NewUserEmailSearchQuery,
+	"phonenumbers":       query.NewUserPhoneSearchQuery,
+	"phonenumbers.value": query.NewUserPhoneSearchQuery,
+}
+
+var userPresentColumns = map[string]query.Column{
+	"displayname":        query.HumanDisplayNameCol,
+	"nickname":           query.HumanNickNameCol,
+	"emails":             query.HumanEmailCol,
+	"emails.value":       query.HumanEmailCol,
+	"phonenumbers":       query.HumanPhoneCol,
+	"phonenumbers.value": query.HumanPhoneCol,
+}
+
+// userFilterToQuery converts a parsed filter of the User resource to a [query.SearchQuery].
+func userFilterToQuery(f filter) (query.SearchQuery, error) {
+	return filterToQuery(f, schemaUser, userAttributeToQuery)
+}
+
+func userAttributeToQuery(f *attributeFilter, path string) (query.SearchQuery, error) {
+	if f.Operator == operatorPresent {
+		column, ok := userPresentColumns[path]
+		if !ok {
+			return nil, errInvalidFilter("presence of " + f.Path + " can not be filtered")
+		}
+		return query.NewNotNullQuery(column)
+	}
+	switch path {
+	case "id":
+		value, err := stringValue(f)
+		if err != nil {
+			return nil, err
+		}
+		if f.Operator == operatorEqual {
+			return query.NewUserInUserIdsSearchQuery([]string{value})
+		}
+		if f.Operator == operatorNotEqual {
+			q, err := query.NewUserInUserIdsSearchQuery([]string{value})
+			if err != nil {
+				return nil, err
+			}
+			return query.NewNotQuery(q)
+		}
+		return nil, errInvalidFilter("operator " + f.Operator + " is not supported for " + f.Path)
+	case "active":
+		active, ok := f.Value.(bool)
+		if !ok || (f.Operator != operatorEqual && f.Operator != operatorNotEqual) {
+			return nil, errInvalidFilter("active can only be compared to a boolean using eq or ne")
+		}
+		q, err := query.NewUserStateSearchQuery(int32(domain.UserStateInactive))
+		if err != nil {
+			return nil, err
+		}
+		if active == (f.Operator == operatorEqual) {
+			return query.NewNotQuery(q)
+		}
+		return q, nil
+	}
+	queryFunc, ok := userTextAttributes[path]
+	if !ok {
+		return nil, errInvalidFilter("filtering by " + f.Path + " is not supported")
+	}
+	return textAttributeToQuery(f, queryFunc)
+}
+
+// groupFilterToQuery converts a parsed filter of the Group resource to a [query.SearchQuery].
+func groupFilterToQuery(f filter) (query.SearchQuery, error) {
+	return filterToQuery(f, schemaGroup, groupAttributeToQuery)
+}
+
+func groupAttributeToQuery(f *attributeFilter, path string) (query.SearchQuery, error) {
+	switch path {
+	case "displayname":
+		return textAttributeToQuery(f, func(value string, comparison query.TextComparison) (query.SearchQuery, error) {
+			return query.NewProjectRoleDisplayNameSearchQuery(comparison, value)
+		})
+	case "id":
+		value, err := stringValue(f)
+		if err != nil {
+			return nil, err
+		}
+		if f.Operator != operatorEqual {
+			return nil, errInvalidFilter("operator " + f.Operator + " is not supported for " + f.Path)
+		}
+		projectID, key, ok := splitGroupID(value)
+		if !ok {
+			return nil, errInvalidFilter("invalid group id")
+		}
+		projectQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
+		if err != nil {
+			return nil, err
+		}
+		keyQuery, err := query.NewProjectRoleKeySearchQuery(query.TextEquals, key)
+		if err != nil {
+			return nil, err
+		}
+		return query.NewAndQuery(projectQuery, keyQuery)
+	}
+	return nil, errInvalidFilter("filtering by " + f.Path + " is not supported")
+}
+
+func filterToQuery(f filter, schema string, attributeToQuery func(*attributeFilter, string) (query.SearchQuery, error)) (query.SearchQuery, error) {
+	switch f := f.(type) {
+	case *attributeFilter:
+		return attributeToQuery(f, normalizeAttributePath(f.Path, schema))
+	case *notFilter:
+		q, err := filterToQuery(f.Filter, schema, attributeToQuery)
+		if err != nil {
+			return nil, err
+		}
+		return query.NewNotQuery(q)
+	case *logicalFilter:
+		left, err := filterToQuery(f.Left, schema, attributeToQuery)
+		if err != nil {
+			return nil, err
+		}
+		right, err := filterToQuery(f.Right, schema, attributeToQuery)
+		if err != nil {
+			return nil, err
+		}
+		if f.Operator == operatorOr {
+			return query.NewOrQuery(left, right)
+		}
+		return query.NewAndQuery(left, right)
+	case *valuePathFilter:
+		// only the value sub attribute of the multi valued attributes is stored,
+		// so `emails[value eq "x"]` is equivalent to `emails.value eq "x"`
+		inner, ok := f.Filter.(*attributeFilter)
+		if !ok || !strings.EqualFold(inner.Path, "value") {
+			return nil, errInvalidFilter("only the value sub attribute can be filtered in " + f.Path)
+		}
+		return filterToQuery(&attributeFilter{
+			Path:     f.Path + ".value",
+			Operator: inner.Operator,
+			Value:    inner.Value,
+		}, schema, attributeToQuery)
+	}
+	return nil, errInvalidFilter("unsupported filter")
+}
+
+func stringValue(f *attributeFilter) (string, error) {
+	value, ok := f.Value.(string)
+	if !ok {
+		return "", errInvalidFilter(f.Path + " must be compared to a string")
+	}
+	return value, nil
+}
+
+// textAttributeToQuery maps the SCIM operators to the text comparisons.
+// String attributes of SCIM are case-insensitive by default.
+func textAttributeToQuery(f *attributeFilter, queryFunc textQueryFunc) (query.SearchQuery, error) {
+	value, err := stringValue(f)
+	if err != nil {
+		return nil, err
+	}
+	switch f.Operator {
+	case operatorEqual:
+		return queryFunc(value, query.TextEqualsIgnoreCase)
+	case operatorNotEqual:
+		q, err := queryFunc(value, query.TextEqualsIgnoreCase)
+		if err != nil {
+			return nil, err
+		}
+		return query.NewNotQuery(q)
+	case operatorContains:
+		return queryFunc(value, query.TextContainsIgnoreCase)
+	case operatorStartsWith:
+		return queryFunc(value, query.TextStartsWithIgnoreCase)
+	case operatorEndsWith:
+		return queryFunc(value, query.TextEndsWithIgnoreCase)
+	}
+	return nil, errInvalidFilter("operator " + f.Operator + " is not supported for " + f.Path)
+}
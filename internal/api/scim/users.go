@@ -0,0 +1,457 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// metadataKeyExternalID is the user metadata key the SCIM externalId is stored in
+const metadataKeyExternalID = "urn:zitadel:scim:externalId"
+
+func (h *handler) listUsers(ctx context.Context, req *request, search *searchRequest) (_ *listResponse, err error) {
+	ctx, err = h.authorize(ctx, req, domain.PermissionUserRead)
+	if err != nil {
+		return nil, err
+	}
+	searchReq, err := search.toSearchRequest(h.config.MaxResults, userSortColumns, schemaUser)
+	if err != nil {
+		return nil, err
+	}
+	queries, err := userOrgQueries(req.orgID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := parseFilter(search.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if f != nil {
+		filterQuery, err := userFilterToQuery(f)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, filterQuery)
+	}
+	users, err := h.query.SearchUsers(ctx, &query.UserSearchQueries{
+		SearchRequest: searchReq,
+		Queries:       queries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if search.onlyCount() {
+		return newListResponse(users.Count, search.StartIndex, []any{}), nil
+	}
+	userIDs := make([]string, len(users.Users))
+	for i, user := range users.Users {
+		userIDs[i] = user.ID
+	}
+	externalIDs, err := h.query.UserMetadataValuesByKey(ctx, metadataKeyExternalID, userIDs...)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]any, len(users.Users))
+	for i, user := range users.Users {
+		resources[i] = h.userToResource(ctx, user, string(externalIDs[user.ID]))
+	}
+	return newListResponse(users.Count, search.StartIndex, resources), nil
+}
+
+// userOrgQueries restricts the search to human users of the organization
+func userOrgQueries(orgID string) ([]query.SearchQuery, error) {
+	ownerQuery, err := query.NewUserResourceOwnerSearchQuery(orgID, query.TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	typeQuery, err := query.NewUserTypeSearchQuery(int32(domain.UserTypeHuman))
+	if err != nil {
+		return nil, err
+	}
+	return []query.SearchQuery{ownerQuery, typeQuery}, nil
+}
+
+func (h *handler) getUser(ctx context.Context, req *request, id string) (_ *userResource, err error) {
+	ctx, err = h.authorize(ctx, req, domain.PermissionUserRead)
+	if err != nil {
+		return nil, err
+	}
+	return h.userByID(ctx, req.orgID, id)
+}
+
+func (h *handler) userByID(ctx context.Context, orgID, id string) (*userResource, error) {
+	user, err := h.orgUser(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	externalID, err := h.externalID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return h.userToResource(ctx, user, externalID), nil
+}
+
+// orgUser returns the human user if it belongs to the organization of the request
+func (h *handler) orgUser(ctx context.Context, orgID, id string) (*query.User, error) {
+	user, err := h.query.GetUserByID(ctx, true, id)
+	if err != nil {
+		return nil, err
+	}
+	if user.ResourceOwner != orgID || user.Human == nil {
+		return nil, zerrors.ThrowNotFound(nil, "SCIM-Hk3ad", "Errors.User.NotFound")
+	}
+	return user, nil
+}
+
+func (h *handler) externalID(ctx context.Context, userID string) (string, error) {
+	externalIDs, err := h.query.UserMetadataValuesByKey(ctx, metadataKeyExternalID, userID)
+	if err != nil {
+		return "", err
+	}
+	return string(externalIDs[userID]), nil
+}
+
+func (h *handler) createUser(ctx context.Context, req *request) (_ *userResource, err error) {
+	ctx, err = h.authorize(ctx, req, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	user := new(userResource)
+	if err := json.Unmarshal(req.body, user); err != nil {
+		return nil, errInvalidSyntax("invalid user")
+	}
+	human := h.resourceToAddHuman(user)
+	human.Inactive = !user.isActive()
+	if err := h.command.AddUserHuman(ctx, req.orgID, human, true, h.userCodeAlg); err != nil {
+		return nil, err
+	}
+	return h.userByID(ctx, req.orgID, human.ID)
+}
+
+func (h *handler) resourceToAddHuman(user *userResource) *command.AddHuman {
+	human := &command.AddHuman{
+		Username:    user.UserName,
+		NickName:    user.NickName,
+		DisplayName: user.DisplayName,
+		Email: command.Email{
+			Address:  domain.EmailAddress(primaryValue(user.Emails)),
+			Verified: h.config.EmailVerified,
+		},
+		Phone: command.Phone{
+			Number:   domain.PhoneNumber(primaryValue(user.PhoneNumbers)),
+			Verified: h.config.EmailVerified,
+		},
+		Password: user.Password,
+	}
+	if user.Name != nil {
+		human.FirstName = user.Name.GivenName
+		human.LastName = user.Name.FamilyName
+	}
+	if user.PreferredLanguage != "" {
+		human.PreferredLanguage = language.Make(user.PreferredLanguage)
+	}
+	if user.ExternalID != "" {
+		human.Metadata = []*command.AddMetadataEntry{{
+			Key:   metadataKeyExternalID,
+			Value: []byte(user.ExternalID),
+		}}
+	}
+	return human
+}
+
+func (h *handler) replaceUser(ctx context.Context, req *request, id string) (_ *userResource, err error) {
+	ctx, err = h.authorize(ctx, req, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	current, err := h.userByID(ctx, req.orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !matchesVersion(req.ifMatch, current.Meta.Version) {
+		return nil, errVersionMismatch()
+	}
+	desired := new(userResource)
+	if err := json.Unmarshal(req.body, desired); err != nil {
+		return nil, errInvalidSyntax("invalid user")
+	}
+	return h.updateUser(ctx, req.orgID, current, desired)
+}
+
+func (h *handler) patchUser(ctx context.Context, req *request, id string) (_ *userResource, err error) {
+	ctx, err = h.authorize(ctx, req, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	current, err := h.userByID(ctx, req.orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !matchesVersion(req.ifMatch, current.Meta.Version) {
+		return nil, errVersionMismatch()
+	}
+	patch := new(patchRequest)
+	if err := json.Unmarshal(req.body, patch); err != nil {
+		return nil, errInvalidSyntax("invalid patch request")
+	}
+	desired, err := copyUser(current)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyUserPatch(desired, patch.Operations); err != nil {
+		return nil, err
+	}
+	return h.updateUser(ctx, req.orgID, current, desired)
+}
+
+func copyUser(user *userResource) (*userResource, error) {
+	data, err := json.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+	userCopy := new(userResource)
+	return userCopy, json.Unmarshal(data, userCopy)
+}
+
+// updateUser changes the user from the current to the desired state
+func (h *handler) updateUser(ctx context.Context, orgID string, current, desired *userResource) (*userResource, error) {
+	change := &command.ChangeHuman{
+		ID:      current.ID,
+		Profile: userProfileChanges(current, desired),
+	}
+	if desired.UserName != current.UserName {
+		change.Username = &desired.UserName
+	}
+	if email := primaryValue(desired.Emails); email != primaryValue(current.Emails) {
+		change.Email = &command.Email{
+			Address:  domain.EmailAddress(email),
+			Verified: h.config.EmailVerified,
+		}
+	}
+	phone := primaryValue(desired.PhoneNumbers)
+	if phone != "" && phone != primaryValue(current.PhoneNumbers) {
+		change.Phone = &command.Phone{
+			Number:   domain.PhoneNumber(phone),
+			Verified: h.config.EmailVerified,
+		}
+	}
+	if desired.Password != "" {
+		change.Password = &command.Password{Password: desired.Password}
+	}
+	if change.Changed() {
+		if err := h.command.ChangeUserHuman(ctx, change, h.userCodeAlg); err != nil {
+			return nil, err
+		}
+	}
+	if phone == "" && primaryValue(current.PhoneNumbers) != "" {
+		if _, err := h.command.RemoveHumanPhone(ctx, current.ID, orgID); err != nil {
+			return nil, err
+		}
+	}
+	if err := h.updateUserState(ctx, current, desired); err != nil {
+		return nil, err
+	}
+	if err := h.updateExternalID(ctx, orgID, current, desired); err != nil {
+		return nil, err
+	}
+	return h.userByID(ctx, orgID, current.ID)
+}
+
+func userProfileChanges(current, desired *userResource) *command.Profile {
+	currentName, desiredName := current.Name, desired.Name
+	if currentName == nil {
+		currentName = new(userName)
+	}
+	if desiredName == nil {
+		desiredName = new(userName)
+	}
+	profile := new(command.Profile)
+	changed := false
+	if desiredName.GivenName != currentName.GivenName {
+		profile.FirstName = &desiredName.GivenName
+		changed = true
+	}
+	if desiredName.FamilyName != currentName.FamilyName {
+		profile.LastName = &desiredName.FamilyName
+		changed = true
+	}
+	if desired.NickName != current.NickName {
+		profile.NickName = &desired.NickName
+		changed = true
+	}
+	if desired.DisplayName != current.DisplayName {
+		profile.DisplayName = &desired.DisplayName
+		changed = true
+	}
+	if desired.PreferredLanguage != current.PreferredLanguage {
+		lang := language.Make(desired.PreferredLanguage)
+		profile.PreferredLanguage = &lang
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return profile
+}
+
+func (h *handler) updateUserState(ctx context.Context, current, desired *userResource) (err error) {
+	if current.isActive() == desired.isActive() {
+		return nil
+	}
+	if desired.isActive() {
+		_, err = h.command.ReactivateUserV2(ctx, current.ID)
+		return err
+	}
+	_, err = h.command.DeactivateUserV2(ctx, current.ID)
+	return err
+}
+
+func (h *handler) updateExternalID(ctx context.Context, orgID string, current, desired *userResource) (err error) {
+	if current.ExternalID == desired.ExternalID {
+		return nil
+	}
+	if desired.ExternalID == "" {
+		_, err = h.command.RemoveUserMetadata(ctx, metadataKeyExternalID, current.ID, orgID)
+		return err
+	}
+	_, err = h.command.SetUserMetadata(ctx, &domain.Metadata{Key: metadataKeyExternalID, Value: []byte(desired.ExternalID)}, current.ID, orgID)
+	return err
+}
+
+func (h *handler) deleteUser(ctx context.Context, req *request, id string) (err error) {
+	ctx, err = h.authorize(ctx, req, domain.PermissionUserDelete)
+	if err != nil {
+		return err
+	}
+	user, err := h.orgUser(ctx, req.orgID, id)
+	if err != nil {
+		return err
+	}
+	if !matchesVersion(req.ifMatch, etag(user.Sequence)) {
+		return errVersionMismatch()
+	}
+	memberships, grants, err := h.removeUserDependencies(ctx, id)
+	if err != nil {
+		return err
+	}
+	_, err = h.command.RemoveUserV2(ctx, id, memberships, grants...)
+	return err
+}
+
+func (h *handler) removeUserDependencies(ctx context.Context, userID string) ([]*command.CascadingMembership, []string, error) {
+	userGrantUserQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	grants, err := h.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{userGrantUserQuery},
+	}, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	membershipsUserQuery, err := query.NewMembershipUserIDQuery(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	memberships, err := h.query.Memberships(ctx, &query.MembershipSearchQuery{
+		Queries: []query.SearchQuery{membershipsUserQuery},
+	}, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cascadingMemberships(memberships.Memberships), userGrantsToIDs(grants.UserGrants), nil
+}
+
+func cascadingMemberships(memberships []*query.Membership) []*command.CascadingMembership {
+	cascades := make([]*command.CascadingMembership, len(memberships))
+	for i, membership := range memberships {
+		cascades[i] = &command.CascadingMembership{
+			UserID:        membership.UserID,
+			ResourceOwner: membership.ResourceOwner,
+		}
+		if membership.IAM != nil {
+			cascades[i].IAM = &command.CascadingIAMMembership{IAMID: membership.IAM.IAMID}
+		}
+		if membership.Org != nil {
+			cascades[i].Org = &command.CascadingOrgMembership{OrgID: membership.Org.OrgID}
+		}
+		if membership.Project != nil {
+			cascades[i].Project = &command.CascadingProjectMembership{ProjectID: membership.Project.ProjectID}
+		}
+		if membership.ProjectGrant != nil {
+			cascades[i].ProjectGrant = &command.CascadingProjectGrantMembership{
+				ProjectID: membership.ProjectGrant.ProjectID,
+				GrantID:   membership.ProjectGrant.GrantID,
+			}
+		}
+	}
+	return cascades
+}
+
+func userGrantsToIDs(userGrants []*query.UserGrant) []string {
+	ids := make([]string, len(userGrants))
+	for i, grant := range userGrants {
+		ids[i] = grant.ID
+	}
+	return ids
+}
+
+func (h *handler) userToResource(ctx context.Context, user *query.User, externalID string) *userResource {
+	active := user.State != domain.UserStateInactive
+	resource := &userResource{
+		Schemas:    []string{schemaUser},
+		ID:         user.ID,
+		ExternalID: externalID,
+		UserName:   user.Username,
+		Active:     &active,
+		Meta: &meta{
+			ResourceType: resourceTypeUser,
+			Created:      &user.CreationDate,
+			LastModified: &user.ChangeDate,
+			Version:      etag(user.Sequence),
+			Location:     h.location(ctx, user.ResourceOwner, pathUsers, user.ID),
+		},
+	}
+	if user.Human == nil {
+		return resource
+	}
+	resource.Name = &userName{
+		GivenName:  user.Human.FirstName,
+		FamilyName: user.Human.LastName,
+		Formatted:  formattedName(user.Human.FirstName, user.Human.LastName),
+	}
+	resource.DisplayName = user.Human.DisplayName
+	resource.NickName = user.Human.NickName
+	if !user.Human.PreferredLanguage.IsRoot() {
+		resource.PreferredLanguage = user.Human.PreferredLanguage.String()
+	}
+	if user.Human.Email != "" {
+		resource.Emails = []*multiValued{{Value: string(user.Human.Email), Primary: true}}
+	}
+	if user.Human.Phone != "" {
+		resource.PhoneNumbers = []*multiValued{{Value: string(user.Human.Phone), Primary: true}}
+	}
+	return resource
+}
+
+// formattedName joins the non-empty parts of the name
+func formattedName(parts ...string) string {
+	names := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" {
+			names = append(names, part)
+		}
+	}
+	return strings.Join(names, " ")
+}
+
+func (u *userResource) resourceMeta() *meta {
+	return u.Meta
+}
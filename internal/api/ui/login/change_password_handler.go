@@ -59,6 +59,7 @@ func (l *Login) renderChangePassword(w http.ResponseWriter, r *http.Request, aut
 		if policy.HasNumber {
 			data.HasNumber = NumberRegex
 		}
+		data.CheckBreached = policy.CheckBreached
 	}
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplChangePassword], data, nil)
 }
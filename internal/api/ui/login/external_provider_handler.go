@@ -21,15 +21,20 @@ import (
 	"github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/idp/providers/apple"
 	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
+	"github.com/zitadel/zitadel/internal/idp/providers/bitbucket"
+	"github.com/zitadel/zitadel/internal/idp/providers/discord"
 	"github.com/zitadel/zitadel/internal/idp/providers/github"
 	"github.com/zitadel/zitadel/internal/idp/providers/gitlab"
 	"github.com/zitadel/zitadel/internal/idp/providers/google"
 	"github.com/zitadel/zitadel/internal/idp/providers/jwt"
+	"github.com/zitadel/zitadel/internal/idp/providers/keycloak"
 	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	openid "github.com/zitadel/zitadel/internal/idp/providers/oidc"
+	"github.com/zitadel/zitadel/internal/idp/providers/okta"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml/requesttracker"
+	"github.com/zitadel/zitadel/internal/idp/providers/slack"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -176,6 +181,16 @@ func (l *Login) handleIDP(w http.ResponseWriter, r *http.Request, authReq *domai
 		provider, err = l.ldapProvider(r.Context(), identityProvider)
 	case domain.IDPTypeSAML:
 		provider, err = l.samlProvider(r.Context(), identityProvider)
+	case domain.IDPTypeOkta:
+		provider, err = l.oktaProvider(r.Context(), identityProvider)
+	case domain.IDPTypeKeycloak:
+		provider, err = l.keycloakProvider(r.Context(), identityProvider)
+	case domain.IDPTypeBitbucket:
+		provider, err = l.bitbucketProvider(r.Context(), identityProvider)
+	case domain.IDPTypeDiscord:
+		provider, err = l.discordProvider(r.Context(), identityProvider)
+	case domain.IDPTypeSlack:
+		provider, err = l.slackProvider(r.Context(), identityProvider)
 	case domain.IDPTypeUnspecified:
 		fallthrough
 	default:
@@ -324,6 +339,41 @@ func (l *Login) handleExternalLoginCallback(w http.ResponseWriter, r *http.Reque
 			l.externalAuthFailed(w, r, authReq, nil, nil, err)
 			return
 		}
+	case domain.IDPTypeOkta:
+		provider, err = l.oktaProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = &openid.Session{Provider: provider.(*okta.Provider).Provider, Code: data.Code}
+	case domain.IDPTypeKeycloak:
+		provider, err = l.keycloakProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = &openid.Session{Provider: provider.(*keycloak.Provider).Provider, Code: data.Code}
+	case domain.IDPTypeBitbucket:
+		provider, err = l.bitbucketProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = &bitbucket.Session{Session: &oauth.Session{Provider: provider.(*bitbucket.Provider).Provider, Code: data.Code}}
+	case domain.IDPTypeDiscord:
+		provider, err = l.discordProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = &oauth.Session{Provider: provider.(*discord.Provider).Provider, Code: data.Code}
+	case domain.IDPTypeSlack:
+		provider, err = l.slackProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = &openid.Session{Provider: provider.(*slack.Provider).Provider, Code: data.Code}
 	case domain.IDPTypeJWT,
 		domain.IDPTypeLDAP,
 		domain.IDPTypeUnspecified:
@@ -449,6 +499,9 @@ func (l *Login) handleExternalUserAuthenticated(
 			return
 		}
 	}
+	// failing claim mappings (e.g. of a removed project) must not prevent the user from signing in
+	err = l.command.ApplyIDPClaimMappings(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, provider.ClaimMappings, user)
+	logging.WithFields("authReq", authReq.ID, "user", authReq.UserID).OnError(err).Error("unable to apply claim mappings")
 	callback(w, r, authReq)
 }
 
@@ -971,11 +1024,14 @@ func (l *Login) oidcProvider(ctx context.Context, identityProvider *query.IDPTem
 	if err != nil {
 		return nil, err
 	}
-	opts := make([]openid.ProviderOpts, 1, 2)
+	opts := make([]openid.ProviderOpts, 1, 3)
 	opts[0] = openid.WithSelectAccount()
 	if identityProvider.OIDCIDPTemplate.IsIDTokenMapping {
 		opts = append(opts, openid.WithIDTokenMapping())
 	}
+	if !identityProvider.OIDCIDPTemplate.UserMapping.IsZero() {
+		opts = append(opts, openid.WithUserMapping(identityProvider.OIDCIDPTemplate.UserMapping))
+	}
 	return openid.New(identityProvider.Name,
 		identityProvider.OIDCIDPTemplate.Issuer,
 		identityProvider.OIDCIDPTemplate.ClientID,
@@ -1020,6 +1076,7 @@ func (l *Login) oauthProvider(ctx context.Context, identityProvider *query.IDPTe
 		func() idp.User {
 			return oauth.NewUserMapper(identityProvider.OAuthIDPTemplate.IDAttribute)
 		},
+		oauth.WithUserMapping(identityProvider.OAuthIDPTemplate.UserMapping),
 	)
 }
 
@@ -1152,6 +1209,77 @@ func (l *Login) gitlabSelfHostedProvider(ctx context.Context, identityProvider *
 	)
 }
 
+func (l *Login) oktaProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*okta.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.OktaIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return okta.New(
+		identityProvider.Name,
+		identityProvider.OktaIDPTemplate.Issuer,
+		identityProvider.OktaIDPTemplate.AuthorizationServerID,
+		identityProvider.OktaIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.OktaIDPTemplate.Scopes,
+	)
+}
+
+func (l *Login) keycloakProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*keycloak.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.KeycloakIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return keycloak.New(
+		identityProvider.Name,
+		identityProvider.KeycloakIDPTemplate.BaseURL,
+		identityProvider.KeycloakIDPTemplate.Realm,
+		identityProvider.KeycloakIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.KeycloakIDPTemplate.Scopes,
+	)
+}
+
+func (l *Login) bitbucketProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*bitbucket.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.BitbucketIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return bitbucket.New(
+		identityProvider.BitbucketIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.BitbucketIDPTemplate.Scopes,
+	)
+}
+
+func (l *Login) discordProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*discord.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.DiscordIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return discord.New(
+		identityProvider.DiscordIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.DiscordIDPTemplate.Scopes,
+	)
+}
+
+func (l *Login) slackProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*slack.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.SlackIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return slack.New(
+		identityProvider.SlackIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.SlackIDPTemplate.Scopes,
+	)
+}
+
 func (l *Login) appleProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*apple.Provider, error) {
 	privateKey, err := crypto.Decrypt(identityProvider.AppleIDPTemplate.PrivateKey, l.idpConfigAlg)
 	if err != nil {
@@ -1204,6 +1332,8 @@ func tokens(session idp.Session) *oidc.Tokens[*oidc.IDTokenClaims] {
 		return s.Tokens()
 	case *apple.Session:
 		return s.Tokens
+	case *bitbucket.Session:
+		return s.Tokens
 	}
 	return nil
 }
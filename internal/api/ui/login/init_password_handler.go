@@ -29,13 +29,14 @@ type initPasswordFormData struct {
 type initPasswordData struct {
 	baseData
 	profileData
-	Code         string
-	UserID       string
-	MinLength    uint64
-	HasUppercase string
-	HasLowercase string
-	HasNumber    string
-	HasSymbol    string
+	Code          string
+	UserID        string
+	MinLength     uint64
+	HasUppercase  string
+	HasLowercase  string
+	HasNumber     string
+	HasSymbol     string
+	CheckBreached bool
 }
 
 func InitPasswordLink(origin, userID, code, orgID, authRequestID string) string {
@@ -138,6 +139,7 @@ func (l *Login) renderInitPassword(w http.ResponseWriter, r *http.Request, authR
 		if policy.HasNumber {
 			data.HasNumber = NumberRegex
 		}
+		data.CheckBreached = policy.CheckBreached
 	}
 	if authReq == nil {
 		user, err := l.query.GetUserByID(r.Context(), false, userID)
@@ -33,15 +33,16 @@ type initUserFormData struct {
 type initUserData struct {
 	baseData
 	profileData
-	Code         string
-	LoginName    string
-	UserID       string
-	PasswordSet  bool
-	MinLength    uint64
-	HasUppercase string
-	HasLowercase string
-	HasNumber    string
-	HasSymbol    string
+	Code          string
+	LoginName     string
+	UserID        string
+	PasswordSet   bool
+	MinLength     uint64
+	HasUppercase  string
+	HasLowercase  string
+	HasNumber     string
+	HasSymbol     string
+	CheckBreached bool
 }
 
 func InitUserLink(origin, userID, loginName, code, orgID string, passwordSet bool, authRequestID string) string {
@@ -155,6 +156,7 @@ func (l *Login) renderInitUser(w http.ResponseWriter, r *http.Request, authReq *
 		if policy.HasNumber {
 			data.HasNumber = NumberRegex
 		}
+		data.CheckBreached = policy.CheckBreached
 	}
 	if authReq == nil {
 		user, err := l.query.GetUserByID(r.Context(), false, userID)
@@ -35,6 +35,7 @@ type registerData struct {
 	HasLowercase       string
 	HasNumber          string
 	HasSymbol          string
+	CheckBreached      bool
 	ShowUsername       bool
 	ShowUsernameSuffix bool
 	OrgRegister        bool
@@ -155,6 +156,7 @@ func (l *Login) renderRegister(w http.ResponseWriter, r *http.Request, authReque
 		if pwPolicy.HasNumber {
 			data.HasNumber = NumberRegex
 		}
+		data.CheckBreached = pwPolicy.CheckBreached
 	}
 
 	orgIAMPolicy, err := l.getOrgDomainPolicy(r, resourceOwner)
@@ -33,6 +33,7 @@ type registerOrgData struct {
 	HasLowercase              string
 	HasNumber                 string
 	HasSymbol                 string
+	CheckBreached             bool
 	UserLoginMustBeDomain     bool
 	IamDomain                 string
 }
@@ -124,6 +125,7 @@ func (l *Login) renderRegisterOrg(w http.ResponseWriter, r *http.Request, authRe
 		if pwPolicy.HasNumber {
 			data.HasNumber = NumberRegex
 		}
+		data.CheckBreached = pwPolicy.CheckBreached
 	}
 	orgPolicy, _ := l.getDefaultDomainPolicy(r)
 	if orgPolicy != nil {
@@ -678,11 +678,12 @@ type profileData struct {
 type passwordData struct {
 	baseData
 	profileData
-	MinLength    uint64
-	HasUppercase string
-	HasLowercase string
-	HasNumber    string
-	HasSymbol    string
+	MinLength     uint64
+	HasUppercase  string
+	HasLowercase  string
+	HasNumber     string
+	HasSymbol     string
+	CheckBreached bool
 }
 
 type userSelectionData struct {
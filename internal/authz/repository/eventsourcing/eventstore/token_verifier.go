@@ -99,6 +99,10 @@ func (repo *TokenVerifierRepo) VerifyAccessToken(ctx context.Context, tokenStrin
 	if strings.HasPrefix(tokenID, command.IDPrefixV2) {
 		return repo.verifyAccessTokenV2(ctx, tokenID, verifierClientID, projectID)
 	}
+	// only v2 tokens can be bound to a DPoP key
+	if authz.DPoPThumbprintFromCtx(ctx) != "" {
+		return "", "", "", "", "", zerrors.ThrowUnauthenticated(nil, "APP-ahR4i", "Errors.Token.Invalid")
+	}
 	if sessionID, ok := strings.CutPrefix(tokenID, authz.SessionTokenPrefix); ok {
 		userID, clientID, resourceOwner, err = repo.verifySessionToken(ctx, sessionID, tokenString)
 		return
@@ -142,6 +146,11 @@ func (repo *TokenVerifierRepo) verifyAccessTokenV2(ctx context.Context, token, v
 	if activeToken.Actor != nil {
 		return "", "", "", "", "", zerrors.ThrowPermissionDenied(nil, "APP-Shi0J", "Errors.TokenExchange.Token.NotForAPI")
 	}
+	// DPoP bound tokens must be presented with a proof of the bound key
+	// and unbound tokens must not be presented using the DPoP scheme
+	if activeToken.DPoPJKT != authz.DPoPThumbprintFromCtx(ctx) {
+		return "", "", "", "", "", zerrors.ThrowUnauthenticated(nil, "APP-Eiz4u", "Errors.Token.Invalid")
+	}
 	if err = verifyAudience(activeToken.Audience, verifierClientID, projectID); err != nil {
 		return "", "", "", "", "", err
 	}
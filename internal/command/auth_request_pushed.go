@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// PushedAuthRequest is an authorization request pushed by a client to the pushed authorization request endpoint (RFC 9126)
+type PushedAuthRequest struct {
+	ID         string
+	ClientID   string
+	Parameters url.Values
+	Expiration time.Time
+}
+
+// AddPushedAuthRequest stores the parameters of an authorization request of an authenticated client.
+// The returned ID is used to create the request_uri, which can be used once until the expiration.
+func (c *Commands) AddPushedAuthRequest(ctx context.Context, clientID string, parameters url.Values, expiration time.Time) (_ *PushedAuthRequest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if clientID == "" || len(parameters) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ooS5i", "Errors.Invalid.Argument")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewPushedAuthRequestWriteModel(ctx, id)
+	err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedEvent(
+		ctx,
+		writeModel.aggregate,
+		clientID,
+		parameters,
+		expiration,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return pushedAuthRequestWriteModelToPushedAuthRequest(writeModel), nil
+}
+
+// UsePushedAuthRequest returns the parameters of the pushed authorization request
+// and marks it as used, so the request_uri cannot be used again.
+// The request must have been pushed by the same client.
+func (c *Commands) UsePushedAuthRequest(ctx context.Context, id, clientID string) (_ *PushedAuthRequest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewPushedAuthRequestWriteModel(ctx, id)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	// don't disclose the existence of requests of other clients
+	if writeModel.ClientID == "" || writeModel.ClientID != clientID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Iej5u", "Errors.AuthRequest.NotExisting")
+	}
+	if writeModel.Used {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ahN1e", "Errors.AuthRequest.AlreadyHandled")
+	}
+	if time.Now().After(writeModel.Expiration) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohng0", "Errors.AuthRequest.Expired")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedUsedEvent(ctx, writeModel.aggregate, writeModel.ProcessedSequence)); err != nil {
+		return nil, err
+	}
+	return pushedAuthRequestWriteModelToPushedAuthRequest(writeModel), nil
+}
+
+func pushedAuthRequestWriteModelToPushedAuthRequest(writeModel *PushedAuthRequestWriteModel) *PushedAuthRequest {
+	return &PushedAuthRequest{
+		ID:         writeModel.AggregateID,
+		ClientID:   writeModel.ClientID,
+		Parameters: writeModel.Parameters,
+		Expiration: writeModel.Expiration,
+	}
+}
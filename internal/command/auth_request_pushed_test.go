@@ -0,0 +1,316 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddPushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	expiration := time.Now().Add(time.Minute).UTC()
+	parameters := url.Values{
+		"response_type": {"code"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"scope":         {"openid"},
+	}
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx        context.Context
+		clientID   string
+		parameters url.Values
+		expiration time.Time
+	}
+	type res struct {
+		want    *PushedAuthRequest
+		wantErr error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing client, invalid argument error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:        mockCtx,
+				parameters: parameters,
+				expiration: expiration,
+			},
+			res{
+				wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ooS5i", "Errors.Invalid.Argument"),
+			},
+		},
+		{
+			"missing parameters, invalid argument error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:        mockCtx,
+				clientID:   "clientID",
+				expiration: expiration,
+			},
+			res{
+				wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ooS5i", "Errors.Invalid.Argument"),
+			},
+		},
+		{
+			"added",
+			fields{
+				eventstore: expectEventstore(
+					expectPush(
+						authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+							"clientID",
+							parameters,
+							expiration,
+						),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "id"),
+			},
+			args{
+				ctx:        mockCtx,
+				clientID:   "clientID",
+				parameters: parameters,
+				expiration: expiration,
+			},
+			res{
+				want: &PushedAuthRequest{
+					ID:         "id",
+					ClientID:   "clientID",
+					Parameters: parameters,
+					Expiration: expiration,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddPushedAuthRequest(tt.args.ctx, tt.args.clientID, tt.args.parameters, tt.args.expiration)
+			require.ErrorIs(t, err, tt.res.wantErr)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommands_UsePushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	expiration := time.Now().Add(time.Minute).UTC()
+	parameters := url.Values{
+		"response_type": {"code"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"scope":         {"openid"},
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		id       string
+		clientID string
+	}
+	type res struct {
+		want    *PushedAuthRequest
+		wantErr error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not existing, not found error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			res{
+				wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Iej5u", "Errors.AuthRequest.NotExisting"),
+			},
+		},
+		{
+			"other client, not found error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+								"clientID",
+								parameters,
+								expiration,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "otherClientID",
+			},
+			res{
+				wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Iej5u", "Errors.AuthRequest.NotExisting"),
+			},
+		},
+		{
+			"already used, precondition failed error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+								"clientID",
+								parameters,
+								expiration,
+							),
+						),
+						eventFromEventPusher(
+							authrequest.NewPushedUsedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate, 1),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			res{
+				wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-ahN1e", "Errors.AuthRequest.AlreadyHandled"),
+			},
+		},
+		{
+			"expired, precondition failed error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+								"clientID",
+								parameters,
+								time.Now().Add(-time.Minute),
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			res{
+				wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohng0", "Errors.AuthRequest.Expired"),
+			},
+		},
+		{
+			"used",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						pushedAuthRequestEventWithSequence(
+							authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+								"clientID",
+								parameters,
+								expiration,
+							),
+							1,
+						),
+					),
+					expectPush(
+						authrequest.NewPushedUsedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate, 1),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			res{
+				want: &PushedAuthRequest{
+					ID:         "id",
+					ClientID:   "clientID",
+					Parameters: parameters,
+					Expiration: expiration,
+				},
+			},
+		},
+		{
+			"used concurrently",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						pushedAuthRequestEventWithSequence(
+							authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+								"clientID",
+								parameters,
+								expiration,
+							),
+							1,
+						),
+					),
+					expectPushFailed(
+						zerrors.ThrowPreconditionFailed(nil, "V3-Ohs4u", "Errors.AuthRequest.AlreadyHandled"),
+						authrequest.NewPushedUsedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate, 1),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			res{
+				wantErr: zerrors.ThrowPreconditionFailed(nil, "V3-Ohs4u", "Errors.AuthRequest.AlreadyHandled"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.UsePushedAuthRequest(tt.args.ctx, tt.args.id, tt.args.clientID)
+			require.ErrorIs(t, err, tt.res.wantErr)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func pushedAuthRequestEventWithSequence(event eventstore.Command, sequence uint64) *repository.Event {
+	e := eventFromEventPusher(event)
+	e.Seq = sequence
+	return e
+}
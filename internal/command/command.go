@@ -20,6 +20,7 @@ import (
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	sd "github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/crypto/breach"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
@@ -52,6 +53,7 @@ type Commands struct {
 	smsEncryption                   crypto.EncryptionAlgorithm
 	userEncryption                  crypto.EncryptionAlgorithm
 	userPasswordHasher              *crypto.Hasher
+	passwordBreachChecker           breach.Checker
 	secretHasher                    *crypto.Hasher
 	machineKeySize                  int
 	applicationKeySize              int
@@ -119,6 +121,10 @@ func StartCommands(
 	if err != nil {
 		return nil, fmt.Errorf("password hasher: %w", err)
 	}
+	passwordBreachChecker, err := defaults.PasswordBreachCheck.NewChecker(httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("password breach checker: %w", err)
+	}
 	repo = &Commands{
 		eventstore:                      es,
 		static:                          staticStore,
@@ -137,6 +143,7 @@ func StartCommands(
 		smsEncryption:                   smsEncryption,
 		userEncryption:                  userEncryption,
 		userPasswordHasher:              userPasswordHasher,
+		passwordBreachChecker:           passwordBreachChecker,
 		secretHasher:                    secretHasher,
 		machineKeySize:                  int(defaults.SecretGenerators.MachineKeySize),
 		applicationKeySize:              int(defaults.SecretGenerators.ApplicationKeySize),
@@ -2,10 +2,12 @@ package command
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
+	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -19,6 +21,8 @@ type GenericOAuthProvider struct {
 	UserEndpoint          string
 	Scopes                []string
 	IDAttribute           string
+	ClaimMappings         idp.ClaimMappings
+	UserMapping           *providers.UserMapping
 	IDPOptions            idp.Options
 }
 
@@ -29,6 +33,8 @@ type GenericOIDCProvider struct {
 	ClientSecret     string
 	Scopes           []string
 	IsIDTokenMapping bool
+	ClaimMappings    idp.ClaimMappings
+	UserMapping      *providers.UserMapping
 	IDPOptions       idp.Options
 }
 
@@ -78,6 +84,30 @@ type GitLabProvider struct {
 	IDPOptions   idp.Options
 }
 
+type BitbucketProvider struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
+type DiscordProvider struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
+type SlackProvider struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
 type GitLabSelfHostedProvider struct {
 	Name         string
 	Issuer       string
@@ -87,6 +117,26 @@ type GitLabSelfHostedProvider struct {
 	IDPOptions   idp.Options
 }
 
+type KeycloakProvider struct {
+	Name         string
+	BaseURL      string
+	Realm        string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
+type OktaProvider struct {
+	Name                  string
+	Issuer                string
+	AuthorizationServerID string
+	ClientID              string
+	ClientSecret          string
+	Scopes                []string
+	IDPOptions            idp.Options
+}
+
 type GoogleProvider struct {
 	Name         string
 	ClientID     string
@@ -107,9 +157,56 @@ type LDAPProvider struct {
 	UserFilters       []string
 	Timeout           time.Duration
 	LDAPAttributes    idp.LDAPAttributes
+	Sync              *idp.LDAPSync
 	IDPOptions        idp.Options
 }
 
+// validateLDAPSync checks that a group attribute is set for the group mappings
+// and that each mapping grants at least one role of a project
+func validateLDAPSync(sync *idp.LDAPSync) error {
+	if sync == nil {
+		return nil
+	}
+	sync.GroupAttribute = strings.TrimSpace(sync.GroupAttribute)
+	if len(sync.GroupMappings) > 0 && sync.GroupAttribute == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-aeV4o", "Errors.IDP.LDAPSync.GroupAttributeMissing")
+	}
+	for i, mapping := range sync.GroupMappings {
+		sync.GroupMappings[i].Group = strings.TrimSpace(mapping.Group)
+		if sync.GroupMappings[i].Group == "" || mapping.ProjectID == "" || len(mapping.Roles) == 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Eeph3", "Errors.IDP.LDAPSync.InvalidGroupMapping")
+		}
+	}
+	return nil
+}
+
+// validateClaimMappings checks that each mapping of a claim either sets a metadata key
+// or grants at least one role of a project
+func validateClaimMappings(mappings idp.ClaimMappings) error {
+	for i, mapping := range mappings {
+		mappings[i].Claim = strings.TrimSpace(mapping.Claim)
+		mappings[i].MetadataKey = strings.TrimSpace(mapping.MetadataKey)
+		if mappings[i].Claim == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ieg4u", "Errors.IDP.ClaimMapping.Invalid")
+		}
+		if mapping.ProjectID == "" && len(mapping.Roles) > 0 || mapping.ProjectID != "" && len(mapping.Roles) == 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-aeL3o", "Errors.IDP.ClaimMapping.Invalid")
+		}
+		if mappings[i].MetadataKey == "" && mapping.ProjectID == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Shoo7", "Errors.IDP.ClaimMapping.Invalid")
+		}
+	}
+	return nil
+}
+
+// validateUserMapping checks that the user fields are mapped by valid JSONPath expressions
+func validateUserMapping(mapping *providers.UserMapping) error {
+	if err := mapping.Validate(); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Gai4e", "Errors.IDP.UserMapping.Invalid")
+	}
+	return nil
+}
+
 type SAMLProvider struct {
 	Name                          string
 	Metadata                      []byte
@@ -118,6 +215,7 @@ type SAMLProvider struct {
 	WithSignedRequest             bool
 	NameIDFormat                  *domain.SAMLNameIDFormat
 	TransientMappingAttributeName string
+	ClaimMappings                 idp.ClaimMappings
 	IDPOptions                    idp.Options
 }
 
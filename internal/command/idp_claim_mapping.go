@@ -0,0 +1,161 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	providers "github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ApplyIDPClaimMappings updates the metadata and the project role grants of the user
+// according to the claim mappings of the identity provider and the claims returned on login.
+// Users of providers which don't return their raw claims are left unchanged.
+func (c *Commands) ApplyIDPClaimMappings(ctx context.Context, userID, resourceOwner string, mappings idp.ClaimMappings, idpUser providers.User) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(mappings) == 0 {
+		return nil
+	}
+	claimsUser, ok := idpUser.(providers.ClaimsUser)
+	if !ok {
+		return nil
+	}
+	claims := make(map[string][]string, len(mappings))
+	for _, mapping := range mappings {
+		if _, ok := claims[mapping.Claim]; !ok {
+			claims[mapping.Claim] = claimsUser.GetClaim(mapping.Claim)
+		}
+	}
+	if err = c.applyClaimMetadata(ctx, userID, resourceOwner, mappings, claims); err != nil {
+		return err
+	}
+	roleMappings := claimRoleMappings(mappings, claims)
+	if len(roleMappings) == 0 {
+		return nil
+	}
+	grants, err := c.userGrantsOfUser(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return c.pushUserGrantChanges(ctx, userID, resourceOwner, userGrantChanges(grants, userID, roleMappings))
+}
+
+// ApplyProviderClaimMappings applies the claim mappings of the identity provider to the (linked) user,
+// see [Commands.ApplyIDPClaimMappings]
+func (c *Commands) ApplyProviderClaimMappings(ctx context.Context, idpID, userID string, idpUser providers.User) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := IDPProviderWriteModel(ctx, c.eventstore.Filter, idpID)
+	if err != nil {
+		return err
+	}
+	mappings := writeModel.ClaimMappings()
+	if len(mappings) == 0 {
+		return nil
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, "")
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Oop5e", "Errors.User.NotFound")
+	}
+	return c.ApplyIDPClaimMappings(ctx, userID, existingUser.ResourceOwner, mappings, idpUser)
+}
+
+// applyClaimMetadata sets the metadata of the mapped claims, a single value is stored as is
+// and multiple values as json array. The metadata is removed if the claim is missing.
+func (c *Commands) applyClaimMetadata(ctx context.Context, userID, resourceOwner string, mappings idp.ClaimMappings, claims map[string][]string) error {
+	metadata, err := c.getUserMetadataListModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	userAgg := UserAggregateFromWriteModel(&metadata.WriteModel)
+	cmds := make([]eventstore.Command, 0, len(mappings))
+	for _, mapping := range mappings {
+		if mapping.MetadataKey == "" {
+			continue
+		}
+		existing, exists := metadata.metadataList[mapping.MetadataKey]
+		value, err := claimMetadataValue(claims[mapping.Claim])
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			if exists {
+				cmds = append(cmds, user.NewMetadataRemovedEvent(ctx, userAgg, mapping.MetadataKey))
+			}
+			continue
+		}
+		if !exists || !bytes.Equal(existing, value) {
+			cmds = append(cmds, user.NewMetadataSetEvent(ctx, userAgg, mapping.MetadataKey, value))
+		}
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, cmds...)
+	return err
+}
+
+func claimMetadataValue(values []string) ([]byte, error) {
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+		return []byte(values[0]), nil
+	default:
+		return json.Marshal(values)
+	}
+}
+
+// claimRoleMappings returns the mappings granting roles,
+// which are granted if the claim contains the value or has any value if none is defined
+func claimRoleMappings(mappings idp.ClaimMappings, claims map[string][]string) []*roleMapping {
+	roleMappings := make([]*roleMapping, 0, len(mappings))
+	for _, mapping := range mappings {
+		if mapping.ProjectID == "" {
+			continue
+		}
+		values := claims[mapping.Claim]
+		roleMappings = append(roleMappings, &roleMapping{
+			projectID:      mapping.ProjectID,
+			projectGrantID: mapping.ProjectGrantID,
+			roles:          mapping.Roles,
+			granted:        mapping.Value == "" && len(values) > 0 || mapping.Value != "" && slices.Contains(values, mapping.Value),
+		})
+	}
+	return roleMappings
+}
+
+// userGrantsOfUser reduces the grants of the user in the organization,
+// the grants are searched by their added events first to not reduce all grants of the organization
+func (c *Commands) userGrantsOfUser(ctx context.Context, userID, orgID string) (*OrgUserGrantsWriteModel, error) {
+	grants := NewOrgUserGrantsWriteModel(orgID)
+	events, err := c.eventstore.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(orgID).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		EventTypes(usergrant.UserGrantAddedType).
+		EventData(map[string]interface{}{"userId": userID}).
+		Builder(),
+	)
+	if err != nil || len(events) == 0 {
+		return grants, err
+	}
+	grants.grantIDs = make([]string, len(events))
+	for i, event := range events {
+		grants.grantIDs[i] = event.Aggregate().ID
+	}
+	return grants, c.eventstore.FilterToQueryReducer(ctx, grants)
+}
@@ -0,0 +1,202 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	providers "github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/idp/providers/saml"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+func TestCommands_ApplyIDPClaimMappings(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		mappings idp.ClaimMappings
+		idpUser  providers.User
+	}
+	userAgg := user.NewAggregate("user1", "org1")
+	projectAgg := project.NewAggregate("project1", "org1")
+	grantAgg := usergrant.NewAggregate("grant1", "org1")
+	mappings := idp.ClaimMappings{
+		{
+			Claim:       "department",
+			MetadataKey: "department",
+		},
+		{
+			Claim:     "groups",
+			Value:     "admins",
+			ProjectID: "project1",
+			Roles:     []string{"admin"},
+		},
+	}
+	samlUser := func(attributes map[string][]string) *saml.UserMapper {
+		u := saml.NewUser()
+		u.SetID("ext1")
+		u.Attributes = attributes
+		return u
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+	}{
+		{
+			name: "no mappings",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				idpUser: samlUser(map[string][]string{"department": {"sales"}}),
+			},
+		},
+		{
+			name: "user without claims",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				mappings: mappings,
+				idpUser:  &ldap.User{ID: "ext1"},
+			},
+		},
+		{
+			name: "set metadata and add grant",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						user.NewMetadataSetEvent(context.Background(), &userAgg.Aggregate, "department", []byte("sales")),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&userAgg.Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"",
+								"firstname lastname",
+								language.English,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &projectAgg.Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &projectAgg.Aggregate, "admin", "admin", ""),
+						),
+					),
+					expectPush(
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&grantAgg.Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"admin"},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "grant1"),
+			},
+			args: args{
+				mappings: mappings,
+				idpUser: samlUser(map[string][]string{
+					"department": {"sales"},
+					"groups":     {"users", "admins"},
+				}),
+			},
+		},
+		{
+			name: "unchanged",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewMetadataSetEvent(context.Background(), &userAgg.Aggregate, "department", []byte(`["sales","support"]`)),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(), &grantAgg.Aggregate, "user1", "project1", "", []string{"admin"}),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(), &grantAgg.Aggregate, "user1", "project1", "", []string{"admin"}),
+						),
+					),
+				),
+			},
+			args: args{
+				mappings: mappings,
+				idpUser: samlUser(map[string][]string{
+					"department": {"sales", "support"},
+					"groups":     {"admins"},
+				}),
+			},
+		},
+		{
+			name: "remove metadata and grant",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewMetadataSetEvent(context.Background(), &userAgg.Aggregate, "department", []byte("sales")),
+						),
+					),
+					expectPush(
+						user.NewMetadataRemovedEvent(context.Background(), &userAgg.Aggregate, "department"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(), &grantAgg.Aggregate, "user1", "project1", "", []string{"admin"}),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(), &grantAgg.Aggregate, "user1", "project1", "", []string{"admin"}),
+						),
+					),
+					expectPush(
+						usergrant.NewUserGrantRemovedEvent(context.Background(), &grantAgg.Aggregate, "user1", "project1", ""),
+					),
+				),
+			},
+			args: args{
+				mappings: mappings,
+				idpUser: samlUser(map[string][]string{
+					"groups": {"users"},
+				}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			err := c.ApplyIDPClaimMappings(context.Background(), "user1", "org1", tt.args.mappings, tt.args.idpUser)
+			require.NoError(t, err)
+		})
+	}
+}
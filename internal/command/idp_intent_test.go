@@ -182,6 +182,8 @@ func TestCommands_CreateIntent(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -235,6 +237,8 @@ func TestCommands_CreateIntent(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -288,6 +292,8 @@ func TestCommands_CreateIntent(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -402,6 +408,8 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
+								nil,
 								rep_idp.Options{},
 							)),
 						eventFromEventPusherWithInstanceID(
@@ -446,6 +454,8 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -467,6 +477,8 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -504,6 +516,8 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								},
 								[]string{"openid", "profile", "User.Read"},
 								false,
+								nil,
+								nil,
 								rep_idp.Options{},
 							)),
 						eventFromEventPusherWithInstanceID(
@@ -540,6 +554,8 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								},
 								[]string{"openid", "profile", "User.Read"},
 								false,
+								nil,
+								nil,
 								rep_idp.Options{},
 							)),
 						eventFromEventPusherWithInstanceID(
@@ -634,6 +650,7 @@ func TestCommands_AuthFromProvider_SAML(t *testing.T) {
 								false,
 								gu.Ptr(domain.SAMLNameIDFormatUnspecified),
 								"",
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -654,6 +671,7 @@ func TestCommands_AuthFromProvider_SAML(t *testing.T) {
 								false,
 								gu.Ptr(domain.SAMLNameIDFormatUnspecified),
 								"",
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -0,0 +1,383 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// LDAPSyncUserID is the editor of the events pushed by the scheduled synchronization,
+// only users deactivated by it are reactivated
+const LDAPSyncUserID = "LDAP-SYNC"
+
+// LDAPSyncReport lists the changes of a synchronization of an LDAP identity provider.
+// The users are referenced by their id in the directory.
+type LDAPSyncReport struct {
+	IDPID  string
+	DryRun bool
+
+	Created     []string
+	Updated     []string
+	Deactivated []string
+	Reactivated []string
+	// Skipped lists the users of the directory which were removed or unlinked in ZITADEL, they aren't recreated
+	Skipped []string
+	// Grants lists the users whose authorizations were added, changed or removed
+	Grants []string
+	Failed []*LDAPSyncFailure
+}
+
+type LDAPSyncFailure struct {
+	ExternalUserID string
+	Err            error
+}
+
+func (r *LDAPSyncReport) toEvent() *idp.LDAPSyncReport {
+	failed := make([]idp.LDAPSyncUserFailure, len(r.Failed))
+	for i, failure := range r.Failed {
+		failed[i] = idp.LDAPSyncUserFailure{
+			ExternalUserID: failure.ExternalUserID,
+			Error:          failure.Err.Error(),
+		}
+	}
+	return &idp.LDAPSyncReport{
+		DryRun:      r.DryRun,
+		Created:     r.Created,
+		Updated:     r.Updated,
+		Deactivated: r.Deactivated,
+		Reactivated: r.Reactivated,
+		Skipped:     r.Skipped,
+		Grants:      r.Grants,
+		Failed:      failed,
+	}
+}
+
+// SyncLDAPProvider synchronizes the users of the directory of an LDAP identity provider.
+// Users which aren't linked to the provider are created and linked, linked users are updated
+// and their authorizations are set according to the group mappings.
+// If dryRun is set or the synchronization is configured as dry run, the changes are only reported.
+// The report is stored on the identity provider if it differs from the one of the last synchronization.
+func (c *Commands) SyncLDAPProvider(ctx context.Context, idpID string, dryRun bool) (_ *LDAPSyncReport, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := IDPProviderWriteModel(ctx, c.eventstore.Filter, idpID)
+	if err != nil {
+		return nil, err
+	}
+	ldapWriteModel, ok := writeModel.LDAP()
+	if !ok {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooR3e", "Errors.IDPConfig.NotExisting")
+	}
+	sync := ldapWriteModel.Sync
+	if sync == nil || !sync.Enabled {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Iew2a", "Errors.IDP.LDAPSync.Disabled")
+	}
+	provider, err := writeModel.ToProvider("", c.idpConfigEncryption)
+	if err != nil {
+		return nil, err
+	}
+	ldapProvider, ok := provider.(*ldap.Provider)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "COMMAND-Ahb8u", "Errors.IDPConfig.NotExisting")
+	}
+	users, err := ldapProvider.SearchUsers(ctx, sync.Filter, sync.GroupAttribute)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-Ohj3i", "Errors.IDP.LDAPSync.SearchFailed")
+	}
+
+	orgID := writeModel.ResourceOwner
+	if writeModel.Instance {
+		orgID = sync.OrgID
+		if orgID == "" {
+			orgID = authz.GetInstance(ctx).DefaultOrganisationID()
+		}
+	}
+	report, err := c.syncLDAPUsers(ctx, idpID, orgID, sync, users, dryRun || sync.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return report, c.pushLDAPSyncReport(ctx, writeModel.Instance, ldapWriteModel, report)
+}
+
+func (c *Commands) pushLDAPSyncReport(ctx context.Context, instanceIDP bool, writeModel *LDAPIDPWriteModel, report *LDAPSyncReport) error {
+	stored := report.toEvent()
+	if writeModel.SyncReport.Equal(stored) {
+		return nil
+	}
+	var event eventstore.Command
+	if instanceIDP {
+		event = instance.NewLDAPIDPSyncedEvent(ctx, &instance.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID, stored)
+	} else {
+		event = org.NewLDAPIDPSyncedEvent(ctx, &org.NewAggregate(writeModel.AggregateID).Aggregate, writeModel.ID, stored)
+	}
+	_, err := c.eventstore.Push(ctx, event)
+	return err
+}
+
+func (c *Commands) syncLDAPUsers(ctx context.Context, idpID, orgID string, sync *idp.LDAPSync, users []*ldap.SyncUser, dryRun bool) (*LDAPSyncReport, error) {
+	links := NewIDPUserLinksWriteModel(idpID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, links); err != nil {
+		return nil, err
+	}
+	var grants *OrgUserGrantsWriteModel
+	if len(sync.GroupMappings) > 0 {
+		grants = NewOrgUserGrantsWriteModel(orgID)
+		if err := c.eventstore.FilterToQueryReducer(ctx, grants); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &LDAPSyncReport{
+		IDPID:  idpID,
+		DryRun: dryRun,
+	}
+	found := make(map[string]struct{}, len(users))
+	for _, ldapUser := range users {
+		found[ldapUser.ID] = struct{}{}
+		if _, ok := links.Unlinked[ldapUser.ID]; ok {
+			report.Skipped = append(report.Skipped, ldapUser.ID)
+			continue
+		}
+		err := c.syncLDAPUser(ctx, report, idpID, orgID, sync, grants, links.UserIDs[ldapUser.ID], ldapUser)
+		if err != nil {
+			report.Failed = append(report.Failed, &LDAPSyncFailure{ExternalUserID: ldapUser.ID, Err: err})
+		}
+	}
+	if !sync.DeactivateMissing {
+		return report, nil
+	}
+
+	missing := make([]string, 0, len(links.UserIDs))
+	for externalUserID := range links.UserIDs {
+		if _, ok := found[externalUserID]; !ok {
+			missing = append(missing, externalUserID)
+		}
+	}
+	slices.Sort(missing)
+	for _, externalUserID := range missing {
+		if err := c.deactivateLDAPUser(ctx, report, externalUserID, links.UserIDs[externalUserID]); err != nil {
+			report.Failed = append(report.Failed, &LDAPSyncFailure{ExternalUserID: externalUserID, Err: err})
+		}
+	}
+	return report, nil
+}
+
+func (c *Commands) syncLDAPUser(ctx context.Context, report *LDAPSyncReport, idpID, orgID string, sync *idp.LDAPSync, grants *OrgUserGrantsWriteModel, userID string, ldapUser *ldap.SyncUser) error {
+	if userID == "" {
+		return c.createLDAPUser(ctx, report, idpID, orgID, sync, grants, ldapUser)
+	}
+	existing, err := c.userHumanWriteModel(ctx, userID, true, true, true, false, false, false)
+	if err != nil {
+		return err
+	}
+	// removed users keep their link and aren't recreated
+	if !isUserStateExists(existing.UserState) {
+		report.Skipped = append(report.Skipped, ldapUser.ID)
+		return nil
+	}
+
+	cmds, changed, err := c.ldapUserChanges(ctx, existing, ldapUser.User, report.DryRun)
+	if err != nil {
+		return err
+	}
+	if changed {
+		report.Updated = append(report.Updated, ldapUser.ID)
+	}
+	if sync.DeactivateMissing && existing.UserState == domain.UserStateInactive {
+		reactivate, err := c.isDeactivatedByLDAPSync(ctx, existing)
+		if err != nil {
+			return err
+		}
+		if reactivate {
+			cmds = append(cmds, user.NewUserReactivatedEvent(ctx, &existing.Aggregate().Aggregate))
+			report.Reactivated = append(report.Reactivated, ldapUser.ID)
+		}
+	}
+	var grantChanges []*userGrantChange
+	// grants are only managed in the organization of the synchronization
+	if grants != nil && existing.ResourceOwner == orgID {
+		grantChanges = ldapUserGrantChanges(grants, userID, sync.GroupMappings, ldapUser.Groups)
+		if len(grantChanges) > 0 {
+			report.Grants = append(report.Grants, ldapUser.ID)
+		}
+	}
+	if report.DryRun {
+		return nil
+	}
+	if len(cmds) > 0 {
+		if _, err = c.eventstore.Push(ctx, cmds...); err != nil {
+			return err
+		}
+	}
+	return c.pushUserGrantChanges(ctx, userID, orgID, grantChanges)
+}
+
+// isDeactivatedByLDAPSync returns if the last deactivation of the user was done by the synchronization,
+// users deactivated otherwise aren't reactivated
+func (c *Commands) isDeactivatedByLDAPSync(ctx context.Context, existing *UserV2WriteModel) (bool, error) {
+	deactivation := newUserDeactivationWriteModel(existing.AggregateID, existing.ResourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, deactivation); err != nil {
+		return false, err
+	}
+	return deactivation.DeactivatedBy == LDAPSyncUserID, nil
+}
+
+func (c *Commands) createLDAPUser(ctx context.Context, report *LDAPSyncReport, idpID, orgID string, sync *idp.LDAPSync, grants *OrgUserGrantsWriteModel, ldapUser *ldap.SyncUser) error {
+	human := &AddHuman{
+		Username:          ldapUsername(ldapUser.User),
+		FirstName:         ldapUser.FirstName,
+		LastName:          ldapUser.LastName,
+		NickName:          ldapUser.NickName,
+		DisplayName:       ldapUser.DisplayName,
+		PreferredLanguage: ldapUser.PreferredLanguage,
+		Email: Email{
+			Address:  ldapUser.Email,
+			Verified: ldapUser.EmailVerified,
+		},
+		Phone: Phone{
+			Number:   ldapUser.Phone,
+			Verified: ldapUser.PhoneVerified,
+		},
+		ExternalIDP: true,
+		Links: []*AddLink{
+			{
+				IDPID:         idpID,
+				DisplayName:   ldapUser.GetPreferredUsername(),
+				IDPExternalID: ldapUser.ID,
+			},
+		},
+	}
+	var grantChanges []*userGrantChange
+	if grants != nil {
+		grantChanges = ldapUserGrantChanges(grants, "", sync.GroupMappings, ldapUser.Groups)
+	}
+	if report.DryRun {
+		if err := human.Validate(c.userPasswordHasher); err != nil {
+			return err
+		}
+		report.Created = append(report.Created, ldapUser.ID)
+		if len(grantChanges) > 0 {
+			report.Grants = append(report.Grants, ldapUser.ID)
+		}
+		return nil
+	}
+
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.AddHumanCommand(human, orgID, c.userPasswordHasher, c.userEncryption, false))
+	if err != nil {
+		return err
+	}
+	if _, err = c.eventstore.Push(ctx, cmds...); err != nil {
+		return err
+	}
+	report.Created = append(report.Created, ldapUser.ID)
+	if len(grantChanges) == 0 {
+		return nil
+	}
+	report.Grants = append(report.Grants, ldapUser.ID)
+	return c.pushUserGrantChanges(ctx, human.ID, orgID, grantChanges)
+}
+
+// ldapUsername uses the preferred username of the directory with a fallback to the email and the id
+func ldapUsername(ldapUser *ldap.User) string {
+	if username := ldapUser.GetPreferredUsername(); username != "" {
+		return username
+	}
+	if ldapUser.Email != "" {
+		return string(ldapUser.Email)
+	}
+	return ldapUser.ID
+}
+
+// ldapUserChanges returns the events to update the profile, email and phone of the user with the values of the directory,
+// empty values of the directory don't overwrite the user.
+// For a dry run no verification codes are generated, the email and phone changes are only reported by the returned bool.
+func (c *Commands) ldapUserChanges(ctx context.Context, existing *UserV2WriteModel, ldapUser *ldap.User, dryRun bool) (cmds []eventstore.Command, changed bool, err error) {
+	cmds, err = changeUserProfile(ctx, cmds, existing, ldapUserProfile(ldapUser))
+	if err != nil {
+		return nil, false, err
+	}
+	if dryRun {
+		emailChanged := ldapUser.Email != "" && (ldapUser.Email != existing.Email || ldapUser.EmailVerified && !existing.IsEmailVerified)
+		phoneChanged := ldapUser.Phone != "" && (ldapUser.Phone != existing.Phone || ldapUser.PhoneVerified && !existing.IsPhoneVerified)
+		return cmds, len(cmds) > 0 || emailChanged || phoneChanged, nil
+	}
+	if ldapUser.Email != "" {
+		cmds, _, err = c.changeUserEmail(ctx, cmds, existing, &Email{Address: ldapUser.Email, Verified: ldapUser.EmailVerified}, c.userEncryption)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	if ldapUser.Phone != "" {
+		cmds, _, err = c.changeUserPhone(ctx, cmds, existing, &Phone{Number: ldapUser.Phone, Verified: ldapUser.PhoneVerified}, c.userEncryption)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	return cmds, len(cmds) > 0, nil
+}
+
+func ldapUserProfile(ldapUser *ldap.User) *Profile {
+	profile := new(Profile)
+	if ldapUser.FirstName != "" {
+		profile.FirstName = &ldapUser.FirstName
+	}
+	if ldapUser.LastName != "" {
+		profile.LastName = &ldapUser.LastName
+	}
+	if ldapUser.NickName != "" {
+		profile.NickName = &ldapUser.NickName
+	}
+	if ldapUser.DisplayName != "" {
+		profile.DisplayName = &ldapUser.DisplayName
+	}
+	if !ldapUser.PreferredLanguage.IsRoot() {
+		profile.PreferredLanguage = &ldapUser.PreferredLanguage
+	}
+	return profile
+}
+
+func (c *Commands) deactivateLDAPUser(ctx context.Context, report *LDAPSyncReport, externalUserID, userID string) error {
+	existing := NewUserStateWriteModel(userID, "")
+	if err := c.eventstore.FilterToQueryReducer(ctx, existing); err != nil {
+		return err
+	}
+	// initial and locked users are left untouched
+	if existing.UserState != domain.UserStateActive {
+		return nil
+	}
+	report.Deactivated = append(report.Deactivated, externalUserID)
+	if report.DryRun {
+		return nil
+	}
+	_, err := c.eventstore.Push(ctx, user.NewUserDeactivatedEvent(ctx, &existing.Aggregate().Aggregate))
+	return err
+}
+
+// ldapUserGrantChanges computes the grants of the user on the mapped projects:
+// roles of groups the user is member of are added, mapped roles of other groups are removed
+// and roles which aren't mapped at all are kept.
+func ldapUserGrantChanges(grants *OrgUserGrantsWriteModel, userID string, mappings []idp.LDAPGroupMapping, groups []string) []*userGrantChange {
+	roleMappings := make([]*roleMapping, len(mappings))
+	for i, mapping := range mappings {
+		roleMappings[i] = &roleMapping{
+			projectID:      mapping.ProjectID,
+			projectGrantID: mapping.ProjectGrantID,
+			roles:          mapping.Roles,
+			granted:        slices.ContainsFunc(groups, func(group string) bool { return strings.EqualFold(group, mapping.Group) }),
+		}
+	}
+	return userGrantChanges(grants, userID, roleMappings)
+}
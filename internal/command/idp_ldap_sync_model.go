@@ -0,0 +1,197 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+// IDPUserLinksWriteModel collects the users linked to an identity provider
+type IDPUserLinksWriteModel struct {
+	eventstore.WriteModel
+
+	IDPID string
+	// UserIDs maps the external user id of the identity provider to the id of the linked user
+	UserIDs map[string]string
+	// Unlinked contains the external user ids whose link was removed
+	Unlinked map[string]struct{}
+}
+
+func NewIDPUserLinksWriteModel(idpID string) *IDPUserLinksWriteModel {
+	return &IDPUserLinksWriteModel{
+		IDPID:    idpID,
+		UserIDs:  make(map[string]string),
+		Unlinked: make(map[string]struct{}),
+	}
+}
+
+func (wm *IDPUserLinksWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.UserIDPLinkAddedEvent:
+			wm.UserIDs[e.ExternalUserID] = e.Aggregate().ID
+			delete(wm.Unlinked, e.ExternalUserID)
+		case *user.UserIDPLinkRemovedEvent:
+			delete(wm.UserIDs, e.ExternalUserID)
+			wm.Unlinked[e.ExternalUserID] = struct{}{}
+		case *user.UserIDPLinkCascadeRemovedEvent:
+			delete(wm.UserIDs, e.ExternalUserID)
+			wm.Unlinked[e.ExternalUserID] = struct{}{}
+		case *user.UserIDPExternalIDMigratedEvent:
+			delete(wm.UserIDs, e.PreviousID)
+			wm.UserIDs[e.NewID] = e.Aggregate().ID
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IDPUserLinksWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		EventTypes(
+			user.UserIDPLinkAddedType,
+			user.UserIDPLinkRemovedType,
+			user.UserIDPLinkCascadeRemovedType,
+			user.UserIDPExternalIDMigratedType,
+		).
+		EventData(map[string]interface{}{"idpConfigId": wm.IDPID}).
+		Builder()
+}
+
+// userDeactivationWriteModel keeps the editor of the last deactivation of a user
+type userDeactivationWriteModel struct {
+	eventstore.WriteModel
+
+	DeactivatedBy string
+}
+
+func newUserDeactivationWriteModel(userID, resourceOwner string) *userDeactivationWriteModel {
+	return &userDeactivationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *userDeactivationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch event.(type) {
+		case *user.UserDeactivatedEvent:
+			wm.DeactivatedBy = event.Creator()
+		case *user.UserReactivatedEvent:
+			wm.DeactivatedBy = ""
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *userDeactivationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.UserDeactivatedType,
+			user.UserReactivatedType,
+		).
+		Builder()
+}
+
+// OrgUserGrantsWriteModel collects all user grants of an organization,
+// or only the grants with the given ids if set
+type OrgUserGrantsWriteModel struct {
+	eventstore.WriteModel
+
+	grantIDs []string
+	grants   map[string]*OrgUserGrant
+}
+
+type OrgUserGrant struct {
+	ID             string
+	UserID         string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+	State          domain.UserGrantState
+}
+
+func NewOrgUserGrantsWriteModel(orgID string) *OrgUserGrantsWriteModel {
+	return &OrgUserGrantsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: orgID,
+		},
+		grants: make(map[string]*OrgUserGrant),
+	}
+}
+
+func (wm *OrgUserGrantsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *usergrant.UserGrantAddedEvent:
+			wm.grants[e.Aggregate().ID] = &OrgUserGrant{
+				ID:             e.Aggregate().ID,
+				UserID:         e.UserID,
+				ProjectID:      e.ProjectID,
+				ProjectGrantID: e.ProjectGrantID,
+				RoleKeys:       e.RoleKeys,
+				State:          domain.UserGrantStateActive,
+			}
+		case *usergrant.UserGrantChangedEvent:
+			if grant, ok := wm.grants[e.Aggregate().ID]; ok {
+				grant.RoleKeys = e.RoleKeys
+			}
+		case *usergrant.UserGrantCascadeChangedEvent:
+			if grant, ok := wm.grants[e.Aggregate().ID]; ok {
+				grant.RoleKeys = e.RoleKeys
+			}
+		case *usergrant.UserGrantDeactivatedEvent:
+			if grant, ok := wm.grants[e.Aggregate().ID]; ok {
+				grant.State = domain.UserGrantStateInactive
+			}
+		case *usergrant.UserGrantReactivatedEvent:
+			if grant, ok := wm.grants[e.Aggregate().ID]; ok {
+				grant.State = domain.UserGrantStateActive
+			}
+		case *usergrant.UserGrantRemovedEvent:
+			delete(wm.grants, e.Aggregate().ID)
+		case *usergrant.UserGrantCascadeRemovedEvent:
+			delete(wm.grants, e.Aggregate().ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgUserGrantsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType)
+	if len(wm.grantIDs) > 0 {
+		query = query.AggregateIDs(wm.grantIDs...)
+	}
+	return query.
+		EventTypes(
+			usergrant.UserGrantAddedType,
+			usergrant.UserGrantChangedType,
+			usergrant.UserGrantCascadeChangedType,
+			usergrant.UserGrantDeactivatedType,
+			usergrant.UserGrantReactivatedType,
+			usergrant.UserGrantRemovedType,
+			usergrant.UserGrantCascadeRemovedType,
+		).
+		Builder()
+}
+
+// Grant returns the existing grant of the user on the project (grant)
+func (wm *OrgUserGrantsWriteModel) Grant(userID, projectID, projectGrantID string) *OrgUserGrant {
+	for _, grant := range wm.grants {
+		if grant.UserID == userID && grant.ProjectID == projectID && grant.ProjectGrantID == projectGrantID {
+			return grant
+		}
+	}
+	return nil
+}
@@ -0,0 +1,563 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+func TestCommands_syncLDAPUsers(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx    context.Context
+		sync   *idp.LDAPSync
+		users  []*ldap.SyncUser
+		dryRun bool
+	}
+	syncCtx := authz.SetCtxData(context.Background(), authz.CtxData{UserID: LDAPSyncUserID})
+	adminCtx := authz.SetCtxData(context.Background(), authz.CtxData{UserID: "admin"})
+	userAgg := user.NewAggregate("user1", "org1")
+	user2Agg := user.NewAggregate("user2", "org1")
+	projectAgg := project.NewAggregate("project1", "org1")
+	adminMapping := []idp.LDAPGroupMapping{
+		{
+			Group:     "cn=admins,dc=example,dc=com",
+			ProjectID: "project1",
+			Roles:     []string{"admin"},
+		},
+	}
+	ldapUser := func(groups ...string) *ldap.SyncUser {
+		return &ldap.SyncUser{
+			User: &ldap.User{
+				ID:                "ext1",
+				FirstName:         "firstname",
+				LastName:          "lastname",
+				PreferredUsername: "username",
+				Email:             "email@test.ch",
+				EmailVerified:     true,
+				PreferredLanguage: language.English,
+			},
+			DN:     "uid=ext1,dc=example,dc=com",
+			Groups: groups,
+		}
+	}
+	humanAddedEvent := func(agg *user.Aggregate) eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(),
+				&agg.Aggregate,
+				"username",
+				"firstname",
+				"lastname",
+				"",
+				"firstname lastname",
+				language.English,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   *LDAPSyncReport
+	}{
+		{
+			name: "dry run, create",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sync: &idp.LDAPSync{
+					Enabled:        true,
+					GroupAttribute: "memberOf",
+					GroupMappings:  adminMapping,
+				},
+				users:  []*ldap.SyncUser{ldapUser("CN=admins,DC=example,DC=com")},
+				dryRun: true,
+			},
+			want: &LDAPSyncReport{
+				IDPID:   "idp1",
+				DryRun:  true,
+				Created: []string{"ext1"},
+				Grants:  []string{"ext1"},
+			},
+		},
+		{
+			name: "create with grant",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&userAgg.Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLDAPIDPAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"idp1",
+								"ldap",
+								[]string{"server"},
+								false,
+								"baseDN",
+								"dn",
+								&crypto.CryptoValue{},
+								"user",
+								[]string{"object"},
+								[]string{"filter"},
+								time.Second,
+								idp.LDAPAttributes{},
+								nil,
+								idp.Options{},
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanAddedEvent(context.Background(),
+							&userAgg.Aggregate,
+							"username",
+							"firstname",
+							"lastname",
+							"",
+							"firstname lastname",
+							language.English,
+							domain.GenderUnspecified,
+							"email@test.ch",
+							true,
+						),
+						user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate),
+						user.NewUserIDPLinkAddedEvent(context.Background(), &userAgg.Aggregate, "idp1", "username", "ext1"),
+					),
+					expectFilter(
+						humanAddedEvent(userAgg),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(), &projectAgg.Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &projectAgg.Aggregate, "admin", "admin", ""),
+						),
+					),
+					expectPush(
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&usergrant.NewAggregate("grant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"admin"},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "user1", "grant1"),
+			},
+			args: args{
+				ctx: context.Background(),
+				sync: &idp.LDAPSync{
+					Enabled:        true,
+					GroupAttribute: "memberOf",
+					GroupMappings:  adminMapping,
+				},
+				users: []*ldap.SyncUser{ldapUser("cn=admins,dc=example,dc=com")},
+			},
+			want: &LDAPSyncReport{
+				IDPID:   "idp1",
+				Created: []string{"ext1"},
+				Grants:  []string{"ext1"},
+			},
+		},
+		{
+			name: "update, remove grant and deactivate missing",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewUserIDPLinkAddedEvent(context.Background(), &userAgg.Aggregate, "idp1", "username", "ext1"),
+						),
+						eventFromEventPusher(
+							user.NewUserIDPLinkAddedEvent(context.Background(), &user2Agg.Aggregate, "idp1", "username2", "ext2"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("grant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"admin"},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&userAgg.Aggregate,
+								"username",
+								"old",
+								"lastname",
+								"",
+								"firstname lastname",
+								language.English,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := user.NewHumanProfileChangedEvent(context.Background(),
+								&userAgg.Aggregate,
+								[]user.ProfileChanges{user.ChangeFirstName("firstname")},
+							)
+							return event
+						}(),
+					),
+					expectPush(
+						usergrant.NewUserGrantRemovedEvent(context.Background(),
+							&usergrant.NewAggregate("grant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+						),
+					),
+					expectFilter(
+						humanAddedEvent(user2Agg),
+					),
+					expectPush(
+						user.NewUserDeactivatedEvent(context.Background(), &user2Agg.Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sync: &idp.LDAPSync{
+					Enabled:           true,
+					DeactivateMissing: true,
+					GroupAttribute:    "memberOf",
+					GroupMappings:     adminMapping,
+				},
+				users: []*ldap.SyncUser{ldapUser()},
+			},
+			want: &LDAPSyncReport{
+				IDPID:       "idp1",
+				Updated:     []string{"ext1"},
+				Deactivated: []string{"ext2"},
+				Grants:      []string{"ext1"},
+			},
+		},
+		{
+			name: "dry run, reactivate",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewUserIDPLinkAddedEvent(context.Background(), &userAgg.Aggregate, "idp1", "username", "ext1"),
+						),
+					),
+					expectFilter(
+						humanAddedEvent(userAgg),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate),
+						),
+						eventFromEventPusher(
+							user.NewUserDeactivatedEvent(syncCtx, &userAgg.Aggregate),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewUserDeactivatedEvent(syncCtx, &userAgg.Aggregate),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: syncCtx,
+				sync: &idp.LDAPSync{
+					Enabled:           true,
+					DeactivateMissing: true,
+				},
+				users:  []*ldap.SyncUser{ldapUser()},
+				dryRun: true,
+			},
+			want: &LDAPSyncReport{
+				IDPID:       "idp1",
+				DryRun:      true,
+				Reactivated: []string{"ext1"},
+			},
+		},
+		{
+			name: "manually deactivated, not reactivated",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewUserIDPLinkAddedEvent(context.Background(), &userAgg.Aggregate, "idp1", "username", "ext1"),
+						),
+					),
+					expectFilter(
+						humanAddedEvent(userAgg),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate),
+						),
+						eventFromEventPusher(
+							user.NewUserDeactivatedEvent(syncCtx, &userAgg.Aggregate),
+						),
+						eventFromEventPusher(
+							user.NewUserReactivatedEvent(adminCtx, &userAgg.Aggregate),
+						),
+						eventFromEventPusher(
+							user.NewUserDeactivatedEvent(adminCtx, &userAgg.Aggregate),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewUserDeactivatedEvent(syncCtx, &userAgg.Aggregate),
+						),
+						eventFromEventPusher(
+							user.NewUserReactivatedEvent(adminCtx, &userAgg.Aggregate),
+						),
+						eventFromEventPusher(
+							user.NewUserDeactivatedEvent(adminCtx, &userAgg.Aggregate),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: syncCtx,
+				sync: &idp.LDAPSync{
+					Enabled:           true,
+					DeactivateMissing: true,
+				},
+				users: []*ldap.SyncUser{ldapUser()},
+			},
+			want: &LDAPSyncReport{
+				IDPID: "idp1",
+			},
+		},
+		{
+			name: "removed and unlinked users not recreated",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewUserIDPLinkAddedEvent(context.Background(), &userAgg.Aggregate, "idp1", "username", "ext1"),
+						),
+						eventFromEventPusher(
+							user.NewUserIDPLinkAddedEvent(context.Background(), &user2Agg.Aggregate, "idp1", "username2", "ext2"),
+						),
+						eventFromEventPusher(
+							user.NewUserIDPLinkRemovedEvent(context.Background(), &user2Agg.Aggregate, "idp1", "ext2"),
+						),
+					),
+					expectFilter(
+						humanAddedEvent(userAgg),
+						eventFromEventPusher(
+							user.NewUserRemovedEvent(context.Background(), &userAgg.Aggregate, "username", nil, true),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: syncCtx,
+				sync: &idp.LDAPSync{
+					Enabled: true,
+				},
+				users: []*ldap.SyncUser{
+					ldapUser(),
+					{User: &ldap.User{ID: "ext2", PreferredUsername: "username2"}},
+				},
+			},
+			want: &LDAPSyncReport{
+				IDPID:   "idp1",
+				Skipped: []string{"ext1", "ext2"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.syncLDAPUsers(tt.args.ctx, "idp1", "org1", tt.args.sync, tt.args.users, tt.args.dryRun)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_pushLDAPSyncReport(t *testing.T) {
+	failure := &LDAPSyncFailure{ExternalUserID: "ext2", Err: errors.New("failed")}
+	tests := []struct {
+		name        string
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		instanceIDP bool
+		stored      *idp.LDAPSyncReport
+		report      *LDAPSyncReport
+	}{
+		{
+			name:       "unchanged",
+			eventstore: expectEventstore(),
+			stored: &idp.LDAPSyncReport{
+				DryRun:  true,
+				Created: []string{"ext1"},
+				Failed:  []idp.LDAPSyncUserFailure{{ExternalUserID: "ext2", Error: "failed"}},
+			},
+			report: &LDAPSyncReport{
+				IDPID:   "idp1",
+				DryRun:  true,
+				Created: []string{"ext1"},
+				Failed:  []*LDAPSyncFailure{failure},
+			},
+		},
+		{
+			name: "changed, org",
+			eventstore: expectEventstore(
+				expectPush(
+					org.NewLDAPIDPSyncedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idp1",
+						&idp.LDAPSyncReport{
+							DryRun:  true,
+							Created: []string{"ext1"},
+							Failed:  []idp.LDAPSyncUserFailure{{ExternalUserID: "ext2", Error: "failed"}},
+						},
+					),
+				),
+			),
+			stored: &idp.LDAPSyncReport{
+				DryRun:  true,
+				Created: []string{"ext1"},
+			},
+			report: &LDAPSyncReport{
+				IDPID:   "idp1",
+				DryRun:  true,
+				Created: []string{"ext1"},
+				Failed:  []*LDAPSyncFailure{failure},
+			},
+		},
+		{
+			name: "first, instance",
+			eventstore: expectEventstore(
+				expectPush(
+					instance.NewLDAPIDPSyncedEvent(context.Background(), &instance.NewAggregate("org1").Aggregate, "idp1",
+						&idp.LDAPSyncReport{
+							Failed: []idp.LDAPSyncUserFailure{},
+						},
+					),
+				),
+			),
+			instanceIDP: true,
+			report: &LDAPSyncReport{
+				IDPID: "idp1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			writeModel := &LDAPIDPWriteModel{
+				WriteModel: eventstore.WriteModel{AggregateID: "org1"},
+				ID:         "idp1",
+				SyncReport: tt.stored,
+			}
+			err := c.pushLDAPSyncReport(context.Background(), tt.instanceIDP, writeModel, tt.report)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_ldapUserGrantChanges(t *testing.T) {
+	grants := NewOrgUserGrantsWriteModel("org1")
+	grants.grants["grant1"] = &OrgUserGrant{
+		ID:        "grant1",
+		UserID:    "user1",
+		ProjectID: "project1",
+		RoleKeys:  []string{"manual", "admin"},
+		State:     domain.UserGrantStateActive,
+	}
+	mappings := []idp.LDAPGroupMapping{
+		{Group: "cn=admins", ProjectID: "project1", Roles: []string{"admin"}},
+		{Group: "cn=users", ProjectID: "project1", Roles: []string{"user"}},
+		{Group: "cn=users", ProjectID: "project2", Roles: []string{"user"}},
+	}
+	tests := []struct {
+		name   string
+		userID string
+		groups []string
+		want   []*userGrantChange
+	}{
+		{
+			name:   "no groups, new user",
+			userID: "",
+			groups: nil,
+			want:   []*userGrantChange{},
+		},
+		{
+			name:   "unchanged",
+			userID: "user1",
+			groups: []string{"cn=admins"},
+			want:   []*userGrantChange{},
+		},
+		{
+			name:   "mapped roles replaced, manual roles kept",
+			userID: "user1",
+			groups: []string{"cn=users"},
+			want: []*userGrantChange{
+				{
+					existing:  grants.grants["grant1"],
+					projectID: "project1",
+					roles:     []string{"manual", "user"},
+				},
+				{
+					projectID: "project2",
+					roles:     []string{"user"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ldapUserGrantChanges(grants, tt.userID, mappings, tt.groups))
+		})
+	}
+}
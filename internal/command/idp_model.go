@@ -16,15 +16,20 @@ import (
 	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/idp/providers/apple"
 	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
+	"github.com/zitadel/zitadel/internal/idp/providers/bitbucket"
+	"github.com/zitadel/zitadel/internal/idp/providers/discord"
 	"github.com/zitadel/zitadel/internal/idp/providers/github"
 	"github.com/zitadel/zitadel/internal/idp/providers/gitlab"
 	"github.com/zitadel/zitadel/internal/idp/providers/google"
 	"github.com/zitadel/zitadel/internal/idp/providers/jwt"
+	"github.com/zitadel/zitadel/internal/idp/providers/keycloak"
 	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+	"github.com/zitadel/zitadel/internal/idp/providers/okta"
 	saml2 "github.com/zitadel/zitadel/internal/idp/providers/saml"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml/requesttracker"
+	"github.com/zitadel/zitadel/internal/idp/providers/slack"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/repository/idpconfig"
 	"github.com/zitadel/zitadel/internal/repository/instance"
@@ -44,6 +49,8 @@ type OAuthIDPWriteModel struct {
 	UserEndpoint          string
 	Scopes                []string
 	IDAttribute           string
+	ClaimMappings         idp.ClaimMappings
+	UserMapping           *providers.UserMapping
 	idp.Options
 
 	State domain.IDPState
@@ -72,6 +79,8 @@ func (wm *OAuthIDPWriteModel) reduceAddedEvent(e *idp.OAuthIDPAddedEvent) {
 	wm.UserEndpoint = e.UserEndpoint
 	wm.Scopes = e.Scopes
 	wm.IDAttribute = e.IDAttribute
+	wm.ClaimMappings = e.ClaimMappings
+	wm.UserMapping = e.UserMapping
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
 }
@@ -101,6 +110,12 @@ func (wm *OAuthIDPWriteModel) reduceChangedEvent(e *idp.OAuthIDPChangedEvent) {
 	if e.IDAttribute != nil {
 		wm.IDAttribute = *e.IDAttribute
 	}
+	if e.ClaimMappings != nil {
+		wm.ClaimMappings = *e.ClaimMappings
+	}
+	if e.UserMapping != nil {
+		wm.UserMapping = e.UserMapping
+	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
@@ -114,6 +129,8 @@ func (wm *OAuthIDPWriteModel) NewChanges(
 	userEndpoint,
 	idAttribute string,
 	scopes []string,
+	claimMappings idp.ClaimMappings,
+	userMapping *providers.UserMapping,
 	options idp.Options,
 ) ([]idp.OAuthIDPChanges, error) {
 	changes := make([]idp.OAuthIDPChanges, 0)
@@ -147,6 +164,12 @@ func (wm *OAuthIDPWriteModel) NewChanges(
 	if wm.IDAttribute != idAttribute {
 		changes = append(changes, idp.ChangeOAuthIDAttribute(idAttribute))
 	}
+	if !wm.ClaimMappings.Equal(claimMappings) {
+		changes = append(changes, idp.ChangeOAuthClaimMappings(claimMappings))
+	}
+	if !wm.UserMapping.Equal(userMapping) {
+		changes = append(changes, idp.ChangeOAuthUserMapping(userMapping))
+	}
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
 		changes = append(changes, idp.ChangeOAuthOptions(opts))
@@ -169,10 +192,13 @@ func (wm *OAuthIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encry
 		RedirectURL: callbackURL,
 		Scopes:      wm.Scopes,
 	}
-	opts := make([]oauth.ProviderOpts, 0, 4)
+	opts := make([]oauth.ProviderOpts, 0, 5)
 	if wm.IsCreationAllowed {
 		opts = append(opts, oauth.WithCreationAllowed())
 	}
+	if !wm.UserMapping.IsZero() {
+		opts = append(opts, oauth.WithUserMapping(wm.UserMapping))
+	}
 	if wm.IsLinkingAllowed {
 		opts = append(opts, oauth.WithLinkingAllowed())
 	}
@@ -207,6 +233,8 @@ type OIDCIDPWriteModel struct {
 	ClientSecret     *crypto.CryptoValue
 	Scopes           []string
 	IsIDTokenMapping bool
+	ClaimMappings    idp.ClaimMappings
+	UserMapping      *providers.UserMapping
 	idp.Options
 
 	State domain.IDPState
@@ -247,6 +275,8 @@ func (wm *OIDCIDPWriteModel) reduceAddedEvent(e *idp.OIDCIDPAddedEvent) {
 	wm.ClientSecret = e.ClientSecret
 	wm.Scopes = e.Scopes
 	wm.IsIDTokenMapping = e.IsIDTokenMapping
+	wm.ClaimMappings = e.ClaimMappings
+	wm.UserMapping = e.UserMapping
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
 }
@@ -270,6 +300,12 @@ func (wm *OIDCIDPWriteModel) reduceChangedEvent(e *idp.OIDCIDPChangedEvent) {
 	if e.IsIDTokenMapping != nil {
 		wm.IsIDTokenMapping = *e.IsIDTokenMapping
 	}
+	if e.ClaimMappings != nil {
+		wm.ClaimMappings = *e.ClaimMappings
+	}
+	if e.UserMapping != nil {
+		wm.UserMapping = e.UserMapping
+	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
@@ -281,6 +317,8 @@ func (wm *OIDCIDPWriteModel) NewChanges(
 	secretCrypto crypto.EncryptionAlgorithm,
 	scopes []string,
 	idTokenMapping bool,
+	claimMappings idp.ClaimMappings,
+	userMapping *providers.UserMapping,
 	options idp.Options,
 ) ([]idp.OIDCIDPChanges, error) {
 	changes := make([]idp.OIDCIDPChanges, 0)
@@ -308,6 +346,12 @@ func (wm *OIDCIDPWriteModel) NewChanges(
 	if wm.IsIDTokenMapping != idTokenMapping {
 		changes = append(changes, idp.ChangeOIDCIsIDTokenMapping(idTokenMapping))
 	}
+	if !wm.ClaimMappings.Equal(claimMappings) {
+		changes = append(changes, idp.ChangeOIDCClaimMappings(claimMappings))
+	}
+	if !wm.UserMapping.Equal(userMapping) {
+		changes = append(changes, idp.ChangeOIDCUserMapping(userMapping))
+	}
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
 		changes = append(changes, idp.ChangeOIDCOptions(opts))
@@ -364,11 +408,14 @@ func (wm *OIDCIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encryp
 	if err != nil {
 		return nil, err
 	}
-	opts := make([]oidc.ProviderOpts, 1, 6)
+	opts := make([]oidc.ProviderOpts, 1, 7)
 	opts[0] = oidc.WithSelectAccount()
 	if wm.IsIDTokenMapping {
 		opts = append(opts, oidc.WithIDTokenMapping())
 	}
+	if !wm.UserMapping.IsZero() {
+		opts = append(opts, oidc.WithUserMapping(wm.UserMapping))
+	}
 	if wm.IsCreationAllowed {
 		opts = append(opts, oidc.WithCreationAllowed())
 	}
@@ -626,23 +673,661 @@ func (wm *AzureADIDPWriteModel) reduceChangedEvent(e *idp.AzureADIDPChangedEvent
 	if e.Tenant != nil {
 		wm.Tenant = *e.Tenant
 	}
-	if e.IsEmailVerified != nil {
-		wm.IsEmailVerified = *e.IsEmailVerified
+	if e.IsEmailVerified != nil {
+		wm.IsEmailVerified = *e.IsEmailVerified
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *AzureADIDPWriteModel) NewChanges(
+	name string,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	tenant string,
+	isEmailVerified bool,
+	options idp.Options,
+) ([]idp.AzureADIDPChanges, error) {
+	changes := make([]idp.AzureADIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeAzureADClientSecret(clientSecret))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeAzureADName(name))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeAzureADClientID(clientID))
+	}
+	if wm.Tenant != tenant {
+		changes = append(changes, idp.ChangeAzureADTenant(tenant))
+	}
+	if wm.IsEmailVerified != isEmailVerified {
+		changes = append(changes, idp.ChangeAzureADIsEmailVerified(isEmailVerified))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeAzureADScopes(scopes))
+	}
+
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeAzureADOptions(opts))
+	}
+	return changes, nil
+}
+func (wm *AzureADIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	opts := make([]azuread.ProviderOptions, 0, 3)
+	if wm.IsEmailVerified {
+		opts = append(opts, azuread.WithEmailVerified())
+	}
+	if wm.Tenant != "" {
+		opts = append(opts, azuread.WithTenant(azuread.TenantType(wm.Tenant)))
+	}
+	oauthOpts := make([]oauth.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		oauthOpts = append(oauthOpts, oauth.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		oauthOpts = append(oauthOpts, oauth.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		oauthOpts = append(oauthOpts, oauth.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		oauthOpts = append(oauthOpts, oauth.WithAutoUpdate())
+	}
+	if len(oauthOpts) > 0 {
+		opts = append(opts, azuread.WithOAuthOptions(oauthOpts...))
+	}
+	return azuread.New(
+		wm.Name,
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		opts...,
+	)
+}
+
+func (wm *AzureADIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type GitHubIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *GitHubIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.GitHubIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.GitHubIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GitHubIDPWriteModel) reduceAddedEvent(e *idp.GitHubIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *GitHubIDPWriteModel) reduceChangedEvent(e *idp.GitHubIDPChangedEvent) {
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *GitHubIDPWriteModel) NewChanges(
+	name,
+	clientID,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.GitHubIDPChanges, error) {
+	changes := make([]idp.GitHubIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeGitHubClientSecret(clientSecret))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeGitHubName(name))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeGitHubClientID(clientID))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeGitHubScopes(scopes))
+	}
+
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeGitHubOptions(opts))
+	}
+	return changes, nil
+}
+func (wm *GitHubIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	oauthOpts := make([]oauth.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		oauthOpts = append(oauthOpts, oauth.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		oauthOpts = append(oauthOpts, oauth.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		oauthOpts = append(oauthOpts, oauth.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		oauthOpts = append(oauthOpts, oauth.WithAutoUpdate())
+	}
+	return github.New(
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		oauthOpts...,
+	)
+}
+
+func (wm *GitHubIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type GitHubEnterpriseIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID                    string
+	Name                  string
+	ClientID              string
+	ClientSecret          *crypto.CryptoValue
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	UserEndpoint          string
+	Scopes                []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *GitHubEnterpriseIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.GitHubEnterpriseIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.GitHubEnterpriseIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GitHubEnterpriseIDPWriteModel) reduceAddedEvent(e *idp.GitHubEnterpriseIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.AuthorizationEndpoint = e.AuthorizationEndpoint
+	wm.TokenEndpoint = e.TokenEndpoint
+	wm.UserEndpoint = e.UserEndpoint
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *GitHubEnterpriseIDPWriteModel) reduceChangedEvent(e *idp.GitHubEnterpriseIDPChangedEvent) {
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.AuthorizationEndpoint != nil {
+		wm.AuthorizationEndpoint = *e.AuthorizationEndpoint
+	}
+	if e.TokenEndpoint != nil {
+		wm.TokenEndpoint = *e.TokenEndpoint
+	}
+	if e.UserEndpoint != nil {
+		wm.UserEndpoint = *e.UserEndpoint
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *GitHubEnterpriseIDPWriteModel) NewChanges(
+	name,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	authorizationEndpoint,
+	tokenEndpoint,
+	userEndpoint string,
+	scopes []string,
+	options idp.Options,
+) ([]idp.GitHubEnterpriseIDPChanges, error) {
+	changes := make([]idp.GitHubEnterpriseIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeGitHubEnterpriseClientSecret(clientSecret))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeGitHubEnterpriseClientID(clientID))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeGitHubEnterpriseName(name))
+	}
+	if wm.AuthorizationEndpoint != authorizationEndpoint {
+		changes = append(changes, idp.ChangeGitHubEnterpriseAuthorizationEndpoint(authorizationEndpoint))
+	}
+	if wm.TokenEndpoint != tokenEndpoint {
+		changes = append(changes, idp.ChangeGitHubEnterpriseTokenEndpoint(tokenEndpoint))
+	}
+	if wm.UserEndpoint != userEndpoint {
+		changes = append(changes, idp.ChangeGitHubEnterpriseUserEndpoint(userEndpoint))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeGitHubEnterpriseScopes(scopes))
+	}
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeGitHubEnterpriseOptions(opts))
+	}
+	return changes, nil
+}
+
+func (wm *GitHubEnterpriseIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	oauthOpts := make([]oauth.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		oauthOpts = append(oauthOpts, oauth.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		oauthOpts = append(oauthOpts, oauth.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		oauthOpts = append(oauthOpts, oauth.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		oauthOpts = append(oauthOpts, oauth.WithAutoUpdate())
+	}
+	return github.NewCustomURL(
+		wm.Name,
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.AuthorizationEndpoint,
+		wm.TokenEndpoint,
+		wm.UserEndpoint,
+		wm.Scopes,
+		oauthOpts...,
+	)
+}
+
+func (wm *GitHubEnterpriseIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type GitLabIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *GitLabIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.GitLabIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+			wm.reduceAddedEvent(e)
+			wm.reduceAddedEvent(e)
+			wm.reduceAddedEvent(e)
+		case *idp.GitLabIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GitLabIDPWriteModel) reduceAddedEvent(e *idp.GitLabIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *GitLabIDPWriteModel) reduceChangedEvent(e *idp.GitLabIDPChangedEvent) {
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *GitLabIDPWriteModel) NewChanges(
+	name,
+	clientID,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.GitLabIDPChanges, error) {
+	changes := make([]idp.GitLabIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeGitLabClientSecret(clientSecret))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeGitLabName(name))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeGitLabClientID(clientID))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeGitLabScopes(scopes))
+	}
+
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeGitLabOptions(opts))
+	}
+	return changes, nil
+}
+
+func (wm *GitLabIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	opts := make([]oidc.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		opts = append(opts, oidc.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		opts = append(opts, oidc.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		opts = append(opts, oidc.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		opts = append(opts, oidc.WithAutoUpdate())
+	}
+	return gitlab.New(
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		opts...,
+	)
+}
+
+func (wm *GitLabIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type BitbucketIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *BitbucketIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.BitbucketIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.BitbucketIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *BitbucketIDPWriteModel) reduceAddedEvent(e *idp.BitbucketIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *BitbucketIDPWriteModel) reduceChangedEvent(e *idp.BitbucketIDPChangedEvent) {
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *BitbucketIDPWriteModel) NewChanges(
+	name,
+	clientID,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.BitbucketIDPChanges, error) {
+	changes := make([]idp.BitbucketIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeBitbucketClientSecret(clientSecret))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeBitbucketName(name))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeBitbucketClientID(clientID))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeBitbucketScopes(scopes))
+	}
+
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeBitbucketOptions(opts))
+	}
+	return changes, nil
+}
+
+func (wm *BitbucketIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	opts := make([]oauth.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		opts = append(opts, oauth.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		opts = append(opts, oauth.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		opts = append(opts, oauth.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		opts = append(opts, oauth.WithAutoUpdate())
+	}
+	return bitbucket.New(
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		opts...,
+	)
+}
+
+func (wm *BitbucketIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type DiscordIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *DiscordIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.DiscordIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.DiscordIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *DiscordIDPWriteModel) reduceAddedEvent(e *idp.DiscordIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *DiscordIDPWriteModel) reduceChangedEvent(e *idp.DiscordIDPChangedEvent) {
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
 	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
-func (wm *AzureADIDPWriteModel) NewChanges(
-	name string,
-	clientID string,
+func (wm *DiscordIDPWriteModel) NewChanges(
+	name,
+	clientID,
 	clientSecretString string,
 	secretCrypto crypto.EncryptionAlgorithm,
 	scopes []string,
-	tenant string,
-	isEmailVerified bool,
 	options idp.Options,
-) ([]idp.AzureADIDPChanges, error) {
-	changes := make([]idp.AzureADIDPChanges, 0)
+) ([]idp.DiscordIDPChanges, error) {
+	changes := make([]idp.DiscordIDPChanges, 0)
 	var clientSecret *crypto.CryptoValue
 	var err error
 	if clientSecretString != "" {
@@ -650,60 +1335,44 @@ func (wm *AzureADIDPWriteModel) NewChanges(
 		if err != nil {
 			return nil, err
 		}
-		changes = append(changes, idp.ChangeAzureADClientSecret(clientSecret))
+		changes = append(changes, idp.ChangeDiscordClientSecret(clientSecret))
 	}
 	if wm.Name != name {
-		changes = append(changes, idp.ChangeAzureADName(name))
+		changes = append(changes, idp.ChangeDiscordName(name))
 	}
 	if wm.ClientID != clientID {
-		changes = append(changes, idp.ChangeAzureADClientID(clientID))
-	}
-	if wm.Tenant != tenant {
-		changes = append(changes, idp.ChangeAzureADTenant(tenant))
-	}
-	if wm.IsEmailVerified != isEmailVerified {
-		changes = append(changes, idp.ChangeAzureADIsEmailVerified(isEmailVerified))
+		changes = append(changes, idp.ChangeDiscordClientID(clientID))
 	}
 	if !reflect.DeepEqual(wm.Scopes, scopes) {
-		changes = append(changes, idp.ChangeAzureADScopes(scopes))
+		changes = append(changes, idp.ChangeDiscordScopes(scopes))
 	}
 
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
-		changes = append(changes, idp.ChangeAzureADOptions(opts))
+		changes = append(changes, idp.ChangeDiscordOptions(opts))
 	}
 	return changes, nil
 }
-func (wm *AzureADIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+
+func (wm *DiscordIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
 	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
 	if err != nil {
 		return nil, err
 	}
-	opts := make([]azuread.ProviderOptions, 0, 3)
-	if wm.IsEmailVerified {
-		opts = append(opts, azuread.WithEmailVerified())
-	}
-	if wm.Tenant != "" {
-		opts = append(opts, azuread.WithTenant(azuread.TenantType(wm.Tenant)))
-	}
-	oauthOpts := make([]oauth.ProviderOpts, 0, 4)
+	opts := make([]oauth.ProviderOpts, 0, 4)
 	if wm.IsCreationAllowed {
-		oauthOpts = append(oauthOpts, oauth.WithCreationAllowed())
+		opts = append(opts, oauth.WithCreationAllowed())
 	}
 	if wm.IsLinkingAllowed {
-		oauthOpts = append(oauthOpts, oauth.WithLinkingAllowed())
+		opts = append(opts, oauth.WithLinkingAllowed())
 	}
 	if wm.IsAutoCreation {
-		oauthOpts = append(oauthOpts, oauth.WithAutoCreation())
+		opts = append(opts, oauth.WithAutoCreation())
 	}
 	if wm.IsAutoUpdate {
-		oauthOpts = append(oauthOpts, oauth.WithAutoUpdate())
-	}
-	if len(oauthOpts) > 0 {
-		opts = append(opts, azuread.WithOAuthOptions(oauthOpts...))
+		opts = append(opts, oauth.WithAutoUpdate())
 	}
-	return azuread.New(
-		wm.Name,
+	return discord.New(
 		wm.ClientID,
 		secret,
 		callbackURL,
@@ -712,11 +1381,11 @@ func (wm *AzureADIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Enc
 	)
 }
 
-func (wm *AzureADIDPWriteModel) GetProviderOptions() idp.Options {
+func (wm *DiscordIDPWriteModel) GetProviderOptions() idp.Options {
 	return wm.Options
 }
 
-type GitHubIDPWriteModel struct {
+type SlackIDPWriteModel struct {
 	eventstore.WriteModel
 
 	ID           string
@@ -729,12 +1398,12 @@ type GitHubIDPWriteModel struct {
 	State domain.IDPState
 }
 
-func (wm *GitHubIDPWriteModel) Reduce() error {
+func (wm *SlackIDPWriteModel) Reduce() error {
 	for _, event := range wm.Events {
 		switch e := event.(type) {
-		case *idp.GitHubIDPAddedEvent:
+		case *idp.SlackIDPAddedEvent:
 			wm.reduceAddedEvent(e)
-		case *idp.GitHubIDPChangedEvent:
+		case *idp.SlackIDPChangedEvent:
 			wm.reduceChangedEvent(e)
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
@@ -743,7 +1412,7 @@ func (wm *GitHubIDPWriteModel) Reduce() error {
 	return wm.WriteModel.Reduce()
 }
 
-func (wm *GitHubIDPWriteModel) reduceAddedEvent(e *idp.GitHubIDPAddedEvent) {
+func (wm *SlackIDPWriteModel) reduceAddedEvent(e *idp.SlackIDPAddedEvent) {
 	wm.Name = e.Name
 	wm.ClientID = e.ClientID
 	wm.ClientSecret = e.ClientSecret
@@ -752,7 +1421,7 @@ func (wm *GitHubIDPWriteModel) reduceAddedEvent(e *idp.GitHubIDPAddedEvent) {
 	wm.State = domain.IDPStateActive
 }
 
-func (wm *GitHubIDPWriteModel) reduceChangedEvent(e *idp.GitHubIDPChangedEvent) {
+func (wm *SlackIDPWriteModel) reduceChangedEvent(e *idp.SlackIDPChangedEvent) {
 	if e.Name != nil {
 		wm.Name = *e.Name
 	}
@@ -768,15 +1437,15 @@ func (wm *GitHubIDPWriteModel) reduceChangedEvent(e *idp.GitHubIDPChangedEvent)
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
-func (wm *GitHubIDPWriteModel) NewChanges(
+func (wm *SlackIDPWriteModel) NewChanges(
 	name,
 	clientID,
 	clientSecretString string,
 	secretCrypto crypto.EncryptionAlgorithm,
 	scopes []string,
 	options idp.Options,
-) ([]idp.GitHubIDPChanges, error) {
-	changes := make([]idp.GitHubIDPChanges, 0)
+) ([]idp.SlackIDPChanges, error) {
+	changes := make([]idp.SlackIDPChanges, 0)
 	var clientSecret *crypto.CryptoValue
 	var err error
 	if clientSecretString != "" {
@@ -784,77 +1453,78 @@ func (wm *GitHubIDPWriteModel) NewChanges(
 		if err != nil {
 			return nil, err
 		}
-		changes = append(changes, idp.ChangeGitHubClientSecret(clientSecret))
+		changes = append(changes, idp.ChangeSlackClientSecret(clientSecret))
 	}
 	if wm.Name != name {
-		changes = append(changes, idp.ChangeGitHubName(name))
+		changes = append(changes, idp.ChangeSlackName(name))
 	}
 	if wm.ClientID != clientID {
-		changes = append(changes, idp.ChangeGitHubClientID(clientID))
+		changes = append(changes, idp.ChangeSlackClientID(clientID))
 	}
 	if !reflect.DeepEqual(wm.Scopes, scopes) {
-		changes = append(changes, idp.ChangeGitHubScopes(scopes))
+		changes = append(changes, idp.ChangeSlackScopes(scopes))
 	}
 
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
-		changes = append(changes, idp.ChangeGitHubOptions(opts))
+		changes = append(changes, idp.ChangeSlackOptions(opts))
 	}
 	return changes, nil
 }
-func (wm *GitHubIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+
+func (wm *SlackIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
 	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
 	if err != nil {
 		return nil, err
 	}
-	oauthOpts := make([]oauth.ProviderOpts, 0, 4)
+	opts := make([]oidc.ProviderOpts, 0, 4)
 	if wm.IsCreationAllowed {
-		oauthOpts = append(oauthOpts, oauth.WithCreationAllowed())
+		opts = append(opts, oidc.WithCreationAllowed())
 	}
 	if wm.IsLinkingAllowed {
-		oauthOpts = append(oauthOpts, oauth.WithLinkingAllowed())
+		opts = append(opts, oidc.WithLinkingAllowed())
 	}
 	if wm.IsAutoCreation {
-		oauthOpts = append(oauthOpts, oauth.WithAutoCreation())
+		opts = append(opts, oidc.WithAutoCreation())
 	}
 	if wm.IsAutoUpdate {
-		oauthOpts = append(oauthOpts, oauth.WithAutoUpdate())
+		opts = append(opts, oidc.WithAutoUpdate())
 	}
-	return github.New(
+	return slack.New(
 		wm.ClientID,
 		secret,
 		callbackURL,
 		wm.Scopes,
-		oauthOpts...,
+		opts...,
 	)
 }
 
-func (wm *GitHubIDPWriteModel) GetProviderOptions() idp.Options {
+func (wm *SlackIDPWriteModel) GetProviderOptions() idp.Options {
 	return wm.Options
 }
 
-type GitHubEnterpriseIDPWriteModel struct {
+type GitLabSelfHostedIDPWriteModel struct {
 	eventstore.WriteModel
 
-	ID                    string
-	Name                  string
-	ClientID              string
-	ClientSecret          *crypto.CryptoValue
-	AuthorizationEndpoint string
-	TokenEndpoint         string
-	UserEndpoint          string
-	Scopes                []string
+	ID           string
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
 	idp.Options
 
 	State domain.IDPState
 }
 
-func (wm *GitHubEnterpriseIDPWriteModel) Reduce() error {
+func (wm *GitLabSelfHostedIDPWriteModel) Reduce() error {
 	for _, event := range wm.Events {
 		switch e := event.(type) {
-		case *idp.GitHubEnterpriseIDPAddedEvent:
+		case *idp.GitLabSelfHostedIDPAddedEvent:
 			wm.reduceAddedEvent(e)
-		case *idp.GitHubEnterpriseIDPChangedEvent:
+			wm.reduceAddedEvent(e)
+			wm.reduceAddedEvent(e)
+		case *idp.GitLabSelfHostedIDPChangedEvent:
 			wm.reduceChangedEvent(e)
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
@@ -863,19 +1533,17 @@ func (wm *GitHubEnterpriseIDPWriteModel) Reduce() error {
 	return wm.WriteModel.Reduce()
 }
 
-func (wm *GitHubEnterpriseIDPWriteModel) reduceAddedEvent(e *idp.GitHubEnterpriseIDPAddedEvent) {
+func (wm *GitLabSelfHostedIDPWriteModel) reduceAddedEvent(e *idp.GitLabSelfHostedIDPAddedEvent) {
 	wm.Name = e.Name
+	wm.Issuer = e.Issuer
 	wm.ClientID = e.ClientID
 	wm.ClientSecret = e.ClientSecret
-	wm.AuthorizationEndpoint = e.AuthorizationEndpoint
-	wm.TokenEndpoint = e.TokenEndpoint
-	wm.UserEndpoint = e.UserEndpoint
 	wm.Scopes = e.Scopes
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
 }
 
-func (wm *GitHubEnterpriseIDPWriteModel) reduceChangedEvent(e *idp.GitHubEnterpriseIDPChangedEvent) {
+func (wm *GitLabSelfHostedIDPWriteModel) reduceChangedEvent(e *idp.GitLabSelfHostedIDPChangedEvent) {
 	if e.ClientID != nil {
 		wm.ClientID = *e.ClientID
 	}
@@ -885,14 +1553,8 @@ func (wm *GitHubEnterpriseIDPWriteModel) reduceChangedEvent(e *idp.GitHubEnterpr
 	if e.Name != nil {
 		wm.Name = *e.Name
 	}
-	if e.AuthorizationEndpoint != nil {
-		wm.AuthorizationEndpoint = *e.AuthorizationEndpoint
-	}
-	if e.TokenEndpoint != nil {
-		wm.TokenEndpoint = *e.TokenEndpoint
-	}
-	if e.UserEndpoint != nil {
-		wm.UserEndpoint = *e.UserEndpoint
+	if e.Issuer != nil {
+		wm.Issuer = *e.Issuer
 	}
 	if e.Scopes != nil {
 		wm.Scopes = e.Scopes
@@ -900,18 +1562,16 @@ func (wm *GitHubEnterpriseIDPWriteModel) reduceChangedEvent(e *idp.GitHubEnterpr
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
-func (wm *GitHubEnterpriseIDPWriteModel) NewChanges(
-	name,
+func (wm *GitLabSelfHostedIDPWriteModel) NewChanges(
+	name string,
+	issuer string,
 	clientID string,
 	clientSecretString string,
 	secretCrypto crypto.EncryptionAlgorithm,
-	authorizationEndpoint,
-	tokenEndpoint,
-	userEndpoint string,
 	scopes []string,
 	options idp.Options,
-) ([]idp.GitHubEnterpriseIDPChanges, error) {
-	changes := make([]idp.GitHubEnterpriseIDPChanges, 0)
+) ([]idp.GitLabSelfHostedIDPChanges, error) {
+	changes := make([]idp.GitLabSelfHostedIDPChanges, 0)
 	var clientSecret *crypto.CryptoValue
 	var err error
 	if clientSecretString != "" {
@@ -919,73 +1579,67 @@ func (wm *GitHubEnterpriseIDPWriteModel) NewChanges(
 		if err != nil {
 			return nil, err
 		}
-		changes = append(changes, idp.ChangeGitHubEnterpriseClientSecret(clientSecret))
+		changes = append(changes, idp.ChangeGitLabSelfHostedClientSecret(clientSecret))
 	}
 	if wm.ClientID != clientID {
-		changes = append(changes, idp.ChangeGitHubEnterpriseClientID(clientID))
+		changes = append(changes, idp.ChangeGitLabSelfHostedClientID(clientID))
 	}
 	if wm.Name != name {
-		changes = append(changes, idp.ChangeGitHubEnterpriseName(name))
-	}
-	if wm.AuthorizationEndpoint != authorizationEndpoint {
-		changes = append(changes, idp.ChangeGitHubEnterpriseAuthorizationEndpoint(authorizationEndpoint))
-	}
-	if wm.TokenEndpoint != tokenEndpoint {
-		changes = append(changes, idp.ChangeGitHubEnterpriseTokenEndpoint(tokenEndpoint))
+		changes = append(changes, idp.ChangeGitLabSelfHostedName(name))
 	}
-	if wm.UserEndpoint != userEndpoint {
-		changes = append(changes, idp.ChangeGitHubEnterpriseUserEndpoint(userEndpoint))
+	if wm.Issuer != issuer {
+		changes = append(changes, idp.ChangeGitLabSelfHostedIssuer(issuer))
 	}
 	if !reflect.DeepEqual(wm.Scopes, scopes) {
-		changes = append(changes, idp.ChangeGitHubEnterpriseScopes(scopes))
+		changes = append(changes, idp.ChangeGitLabSelfHostedScopes(scopes))
 	}
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
-		changes = append(changes, idp.ChangeGitHubEnterpriseOptions(opts))
+		changes = append(changes, idp.ChangeGitLabSelfHostedOptions(opts))
 	}
 	return changes, nil
 }
 
-func (wm *GitHubEnterpriseIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+func (wm *GitLabSelfHostedIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
 	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
 	if err != nil {
 		return nil, err
 	}
-	oauthOpts := make([]oauth.ProviderOpts, 0, 4)
+	opts := make([]oidc.ProviderOpts, 0, 4)
 	if wm.IsCreationAllowed {
-		oauthOpts = append(oauthOpts, oauth.WithCreationAllowed())
+		opts = append(opts, oidc.WithCreationAllowed())
 	}
 	if wm.IsLinkingAllowed {
-		oauthOpts = append(oauthOpts, oauth.WithLinkingAllowed())
+		opts = append(opts, oidc.WithLinkingAllowed())
 	}
 	if wm.IsAutoCreation {
-		oauthOpts = append(oauthOpts, oauth.WithAutoCreation())
+		opts = append(opts, oidc.WithAutoCreation())
 	}
 	if wm.IsAutoUpdate {
-		oauthOpts = append(oauthOpts, oauth.WithAutoUpdate())
+		opts = append(opts, oidc.WithAutoUpdate())
 	}
-	return github.NewCustomURL(
+	return gitlab.NewCustomIssuer(
 		wm.Name,
+		wm.Issuer,
 		wm.ClientID,
 		secret,
 		callbackURL,
-		wm.AuthorizationEndpoint,
-		wm.TokenEndpoint,
-		wm.UserEndpoint,
 		wm.Scopes,
-		oauthOpts...,
+		opts...,
 	)
 }
 
-func (wm *GitHubEnterpriseIDPWriteModel) GetProviderOptions() idp.Options {
+func (wm *GitLabSelfHostedIDPWriteModel) GetProviderOptions() idp.Options {
 	return wm.Options
 }
 
-type GitLabIDPWriteModel struct {
+type KeycloakIDPWriteModel struct {
 	eventstore.WriteModel
 
 	ID           string
 	Name         string
+	BaseURL      string
+	Realm        string
 	ClientID     string
 	ClientSecret *crypto.CryptoValue
 	Scopes       []string
@@ -994,12 +1648,12 @@ type GitLabIDPWriteModel struct {
 	State domain.IDPState
 }
 
-func (wm *GitLabIDPWriteModel) Reduce() error {
+func (wm *KeycloakIDPWriteModel) Reduce() error {
 	for _, event := range wm.Events {
 		switch e := event.(type) {
-		case *idp.GitLabIDPAddedEvent:
+		case *idp.KeycloakIDPAddedEvent:
 			wm.reduceAddedEvent(e)
-		case *idp.GitLabIDPChangedEvent:
+		case *idp.KeycloakIDPChangedEvent:
 			wm.reduceChangedEvent(e)
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
@@ -1008,8 +1662,10 @@ func (wm *GitLabIDPWriteModel) Reduce() error {
 	return wm.WriteModel.Reduce()
 }
 
-func (wm *GitLabIDPWriteModel) reduceAddedEvent(e *idp.GitLabIDPAddedEvent) {
+func (wm *KeycloakIDPWriteModel) reduceAddedEvent(e *idp.KeycloakIDPAddedEvent) {
 	wm.Name = e.Name
+	wm.BaseURL = e.BaseURL
+	wm.Realm = e.Realm
 	wm.ClientID = e.ClientID
 	wm.ClientSecret = e.ClientSecret
 	wm.Scopes = e.Scopes
@@ -1017,31 +1673,39 @@ func (wm *GitLabIDPWriteModel) reduceAddedEvent(e *idp.GitLabIDPAddedEvent) {
 	wm.State = domain.IDPStateActive
 }
 
-func (wm *GitLabIDPWriteModel) reduceChangedEvent(e *idp.GitLabIDPChangedEvent) {
-	if e.Name != nil {
-		wm.Name = *e.Name
-	}
+func (wm *KeycloakIDPWriteModel) reduceChangedEvent(e *idp.KeycloakIDPChangedEvent) {
 	if e.ClientID != nil {
 		wm.ClientID = *e.ClientID
 	}
 	if e.ClientSecret != nil {
 		wm.ClientSecret = e.ClientSecret
 	}
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.BaseURL != nil {
+		wm.BaseURL = *e.BaseURL
+	}
+	if e.Realm != nil {
+		wm.Realm = *e.Realm
+	}
 	if e.Scopes != nil {
 		wm.Scopes = e.Scopes
 	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
-func (wm *GitLabIDPWriteModel) NewChanges(
-	name,
-	clientID,
+func (wm *KeycloakIDPWriteModel) NewChanges(
+	name string,
+	baseURL string,
+	realm string,
+	clientID string,
 	clientSecretString string,
 	secretCrypto crypto.EncryptionAlgorithm,
 	scopes []string,
 	options idp.Options,
-) ([]idp.GitLabIDPChanges, error) {
-	changes := make([]idp.GitLabIDPChanges, 0)
+) ([]idp.KeycloakIDPChanges, error) {
+	changes := make([]idp.KeycloakIDPChanges, 0)
 	var clientSecret *crypto.CryptoValue
 	var err error
 	if clientSecretString != "" {
@@ -1049,26 +1713,31 @@ func (wm *GitLabIDPWriteModel) NewChanges(
 		if err != nil {
 			return nil, err
 		}
-		changes = append(changes, idp.ChangeGitLabClientSecret(clientSecret))
+		changes = append(changes, idp.ChangeKeycloakClientSecret(clientSecret))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeKeycloakClientID(clientID))
 	}
 	if wm.Name != name {
-		changes = append(changes, idp.ChangeGitLabName(name))
+		changes = append(changes, idp.ChangeKeycloakName(name))
 	}
-	if wm.ClientID != clientID {
-		changes = append(changes, idp.ChangeGitLabClientID(clientID))
+	if wm.BaseURL != baseURL {
+		changes = append(changes, idp.ChangeKeycloakBaseURL(baseURL))
+	}
+	if wm.Realm != realm {
+		changes = append(changes, idp.ChangeKeycloakRealm(realm))
 	}
 	if !reflect.DeepEqual(wm.Scopes, scopes) {
-		changes = append(changes, idp.ChangeGitLabScopes(scopes))
+		changes = append(changes, idp.ChangeKeycloakScopes(scopes))
 	}
-
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
-		changes = append(changes, idp.ChangeGitLabOptions(opts))
+		changes = append(changes, idp.ChangeKeycloakOptions(opts))
 	}
 	return changes, nil
 }
 
-func (wm *GitLabIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+func (wm *KeycloakIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
 	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
 	if err != nil {
 		return nil, err
@@ -1086,7 +1755,10 @@ func (wm *GitLabIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encr
 	if wm.IsAutoUpdate {
 		opts = append(opts, oidc.WithAutoUpdate())
 	}
-	return gitlab.New(
+	return keycloak.New(
+		wm.Name,
+		wm.BaseURL,
+		wm.Realm,
 		wm.ClientID,
 		secret,
 		callbackURL,
@@ -1095,30 +1767,31 @@ func (wm *GitLabIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encr
 	)
 }
 
-func (wm *GitLabIDPWriteModel) GetProviderOptions() idp.Options {
+func (wm *KeycloakIDPWriteModel) GetProviderOptions() idp.Options {
 	return wm.Options
 }
 
-type GitLabSelfHostedIDPWriteModel struct {
+type OktaIDPWriteModel struct {
 	eventstore.WriteModel
 
-	ID           string
-	Name         string
-	Issuer       string
-	ClientID     string
-	ClientSecret *crypto.CryptoValue
-	Scopes       []string
+	ID                    string
+	Name                  string
+	Issuer                string
+	AuthorizationServerID string
+	ClientID              string
+	ClientSecret          *crypto.CryptoValue
+	Scopes                []string
 	idp.Options
 
 	State domain.IDPState
 }
 
-func (wm *GitLabSelfHostedIDPWriteModel) Reduce() error {
+func (wm *OktaIDPWriteModel) Reduce() error {
 	for _, event := range wm.Events {
 		switch e := event.(type) {
-		case *idp.GitLabSelfHostedIDPAddedEvent:
+		case *idp.OktaIDPAddedEvent:
 			wm.reduceAddedEvent(e)
-		case *idp.GitLabSelfHostedIDPChangedEvent:
+		case *idp.OktaIDPChangedEvent:
 			wm.reduceChangedEvent(e)
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
@@ -1127,9 +1800,10 @@ func (wm *GitLabSelfHostedIDPWriteModel) Reduce() error {
 	return wm.WriteModel.Reduce()
 }
 
-func (wm *GitLabSelfHostedIDPWriteModel) reduceAddedEvent(e *idp.GitLabSelfHostedIDPAddedEvent) {
+func (wm *OktaIDPWriteModel) reduceAddedEvent(e *idp.OktaIDPAddedEvent) {
 	wm.Name = e.Name
 	wm.Issuer = e.Issuer
+	wm.AuthorizationServerID = e.AuthorizationServerID
 	wm.ClientID = e.ClientID
 	wm.ClientSecret = e.ClientSecret
 	wm.Scopes = e.Scopes
@@ -1137,7 +1811,7 @@ func (wm *GitLabSelfHostedIDPWriteModel) reduceAddedEvent(e *idp.GitLabSelfHoste
 	wm.State = domain.IDPStateActive
 }
 
-func (wm *GitLabSelfHostedIDPWriteModel) reduceChangedEvent(e *idp.GitLabSelfHostedIDPChangedEvent) {
+func (wm *OktaIDPWriteModel) reduceChangedEvent(e *idp.OktaIDPChangedEvent) {
 	if e.ClientID != nil {
 		wm.ClientID = *e.ClientID
 	}
@@ -1150,22 +1824,26 @@ func (wm *GitLabSelfHostedIDPWriteModel) reduceChangedEvent(e *idp.GitLabSelfHos
 	if e.Issuer != nil {
 		wm.Issuer = *e.Issuer
 	}
+	if e.AuthorizationServerID != nil {
+		wm.AuthorizationServerID = *e.AuthorizationServerID
+	}
 	if e.Scopes != nil {
 		wm.Scopes = e.Scopes
 	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
-func (wm *GitLabSelfHostedIDPWriteModel) NewChanges(
+func (wm *OktaIDPWriteModel) NewChanges(
 	name string,
 	issuer string,
+	authorizationServerID string,
 	clientID string,
 	clientSecretString string,
 	secretCrypto crypto.EncryptionAlgorithm,
 	scopes []string,
 	options idp.Options,
-) ([]idp.GitLabSelfHostedIDPChanges, error) {
-	changes := make([]idp.GitLabSelfHostedIDPChanges, 0)
+) ([]idp.OktaIDPChanges, error) {
+	changes := make([]idp.OktaIDPChanges, 0)
 	var clientSecret *crypto.CryptoValue
 	var err error
 	if clientSecretString != "" {
@@ -1173,28 +1851,31 @@ func (wm *GitLabSelfHostedIDPWriteModel) NewChanges(
 		if err != nil {
 			return nil, err
 		}
-		changes = append(changes, idp.ChangeGitLabSelfHostedClientSecret(clientSecret))
+		changes = append(changes, idp.ChangeOktaClientSecret(clientSecret))
 	}
 	if wm.ClientID != clientID {
-		changes = append(changes, idp.ChangeGitLabSelfHostedClientID(clientID))
+		changes = append(changes, idp.ChangeOktaClientID(clientID))
 	}
 	if wm.Name != name {
-		changes = append(changes, idp.ChangeGitLabSelfHostedName(name))
+		changes = append(changes, idp.ChangeOktaName(name))
 	}
 	if wm.Issuer != issuer {
-		changes = append(changes, idp.ChangeGitLabSelfHostedIssuer(issuer))
+		changes = append(changes, idp.ChangeOktaIssuer(issuer))
+	}
+	if wm.AuthorizationServerID != authorizationServerID {
+		changes = append(changes, idp.ChangeOktaAuthorizationServerID(authorizationServerID))
 	}
 	if !reflect.DeepEqual(wm.Scopes, scopes) {
-		changes = append(changes, idp.ChangeGitLabSelfHostedScopes(scopes))
+		changes = append(changes, idp.ChangeOktaScopes(scopes))
 	}
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
-		changes = append(changes, idp.ChangeGitLabSelfHostedOptions(opts))
+		changes = append(changes, idp.ChangeOktaOptions(opts))
 	}
 	return changes, nil
 }
 
-func (wm *GitLabSelfHostedIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+func (wm *OktaIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
 	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
 	if err != nil {
 		return nil, err
@@ -1212,9 +1893,10 @@ func (wm *GitLabSelfHostedIDPWriteModel) ToProvider(callbackURL string, idpAlg c
 	if wm.IsAutoUpdate {
 		opts = append(opts, oidc.WithAutoUpdate())
 	}
-	return gitlab.NewCustomIssuer(
+	return okta.New(
 		wm.Name,
 		wm.Issuer,
+		wm.AuthorizationServerID,
 		wm.ClientID,
 		secret,
 		callbackURL,
@@ -1223,7 +1905,7 @@ func (wm *GitLabSelfHostedIDPWriteModel) ToProvider(callbackURL string, idpAlg c
 	)
 }
 
-func (wm *GitLabSelfHostedIDPWriteModel) GetProviderOptions() idp.Options {
+func (wm *OktaIDPWriteModel) GetProviderOptions() idp.Options {
 	return wm.Options
 }
 
@@ -1366,6 +2048,9 @@ type LDAPIDPWriteModel struct {
 	UserObjectClasses []string
 	UserFilters       []string
 	Timeout           time.Duration
+	Sync              *idp.LDAPSync
+	// SyncReport is the report of the last synchronization
+	SyncReport *idp.LDAPSyncReport
 	idp.LDAPAttributes
 	idp.Options
 
@@ -1385,6 +2070,11 @@ func (wm *LDAPIDPWriteModel) Reduce() error {
 				continue
 			}
 			wm.reduceChangedEvent(e)
+		case *idp.LDAPIDPSyncedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.SyncReport = e.Report
 		case *idp.RemovedEvent:
 			if wm.ID != e.ID {
 				continue
@@ -1406,6 +2096,7 @@ func (wm *LDAPIDPWriteModel) reduceAddedEvent(e *idp.LDAPIDPAddedEvent) {
 	wm.UserObjectClasses = e.UserObjectClasses
 	wm.UserFilters = e.UserFilters
 	wm.Timeout = e.Timeout
+	wm.Sync = e.Sync
 	wm.LDAPAttributes = e.LDAPAttributes
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
@@ -1445,6 +2136,9 @@ func (wm *LDAPIDPWriteModel) reduceChangedEvent(e *idp.LDAPIDPChangedEvent) {
 	if e.Timeout != nil {
 		wm.Timeout = *e.Timeout
 	}
+	if e.Sync != nil {
+		wm.Sync = e.Sync
+	}
 	wm.LDAPAttributes.ReduceChanges(e.LDAPAttributeChanges)
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
@@ -1462,6 +2156,7 @@ func (wm *LDAPIDPWriteModel) NewChanges(
 	timeout time.Duration,
 	secretCrypto crypto.EncryptionAlgorithm,
 	attributes idp.LDAPAttributes,
+	sync *idp.LDAPSync,
 	options idp.Options,
 ) ([]idp.LDAPIDPChanges, error) {
 	changes := make([]idp.LDAPIDPChanges, 0)
@@ -1505,6 +2200,9 @@ func (wm *LDAPIDPWriteModel) NewChanges(
 	if !attrs.IsZero() {
 		changes = append(changes, idp.ChangeLDAPAttributes(attrs))
 	}
+	if !wm.Sync.Equal(sync) {
+		changes = append(changes, idp.ChangeLDAPSync(sync))
+	}
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
 		changes = append(changes, idp.ChangeLDAPOptions(opts))
@@ -1735,6 +2433,7 @@ type SAMLIDPWriteModel struct {
 	WithSignedRequest             bool
 	NameIDFormat                  *domain.SAMLNameIDFormat
 	TransientMappingAttributeName string
+	ClaimMappings                 idp.ClaimMappings
 	idp.Options
 
 	State domain.IDPState
@@ -1763,6 +2462,7 @@ func (wm *SAMLIDPWriteModel) reduceAddedEvent(e *idp.SAMLIDPAddedEvent) {
 	wm.WithSignedRequest = e.WithSignedRequest
 	wm.NameIDFormat = e.NameIDFormat
 	wm.TransientMappingAttributeName = e.TransientMappingAttributeName
+	wm.ClaimMappings = e.ClaimMappings
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
 }
@@ -1792,6 +2492,9 @@ func (wm *SAMLIDPWriteModel) reduceChangedEvent(e *idp.SAMLIDPChangedEvent) {
 	if e.TransientMappingAttributeName != nil {
 		wm.TransientMappingAttributeName = *e.TransientMappingAttributeName
 	}
+	if e.ClaimMappings != nil {
+		wm.ClaimMappings = *e.ClaimMappings
+	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
@@ -1805,6 +2508,7 @@ func (wm *SAMLIDPWriteModel) NewChanges(
 	withSignedRequest bool,
 	nameIDFormat *domain.SAMLNameIDFormat,
 	transientMappingAttributeName string,
+	claimMappings idp.ClaimMappings,
 	options idp.Options,
 ) ([]idp.SAMLIDPChanges, error) {
 	changes := make([]idp.SAMLIDPChanges, 0)
@@ -1836,6 +2540,9 @@ func (wm *SAMLIDPWriteModel) NewChanges(
 	if wm.TransientMappingAttributeName != transientMappingAttributeName {
 		changes = append(changes, idp.ChangeSAMLTransientMappingAttributeName(transientMappingAttributeName))
 	}
+	if !wm.ClaimMappings.Equal(claimMappings) {
+		changes = append(changes, idp.ChangeSAMLClaimMappings(claimMappings))
+	}
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
 		changes = append(changes, idp.ChangeSAMLOptions(opts))
@@ -1918,8 +2625,18 @@ func (wm *IDPRemoveWriteModel) Reduce() error {
 			wm.reduceAdded(e.ID)
 		case *idp.GitLabIDPAddedEvent:
 			wm.reduceAdded(e.ID)
+		case *idp.BitbucketIDPAddedEvent:
+			wm.reduceAdded(e.ID)
+		case *idp.DiscordIDPAddedEvent:
+			wm.reduceAdded(e.ID)
+		case *idp.SlackIDPAddedEvent:
+			wm.reduceAdded(e.ID)
 		case *idp.GitLabSelfHostedIDPAddedEvent:
 			wm.reduceAdded(e.ID)
+		case *idp.KeycloakIDPAddedEvent:
+			wm.reduceAdded(e.ID)
+		case *idp.OktaIDPAddedEvent:
+			wm.reduceAdded(e.ID)
 		case *idp.GoogleIDPAddedEvent:
 			wm.reduceAdded(e.ID)
 		case *idp.LDAPIDPAddedEvent:
@@ -1998,10 +2715,30 @@ func (wm *IDPTypeWriteModel) Reduce() error {
 			wm.reduceAdded(e.ID, domain.IDPTypeGitLab, e.Aggregate())
 		case *org.GitLabIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeGitLab, e.Aggregate())
+		case *instance.BitbucketIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeBitbucket, e.Aggregate())
+		case *org.BitbucketIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeBitbucket, e.Aggregate())
+		case *instance.DiscordIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeDiscord, e.Aggregate())
+		case *org.DiscordIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeDiscord, e.Aggregate())
+		case *instance.SlackIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeSlack, e.Aggregate())
+		case *org.SlackIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeSlack, e.Aggregate())
 		case *instance.GitLabSelfHostedIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeGitLabSelfHosted, e.Aggregate())
 		case *org.GitLabSelfHostedIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeGitLabSelfHosted, e.Aggregate())
+		case *instance.KeycloakIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeKeycloak, e.Aggregate())
+		case *org.KeycloakIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeKeycloak, e.Aggregate())
+		case *instance.OktaIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeOkta, e.Aggregate())
+		case *org.OktaIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeOkta, e.Aggregate())
 		case *instance.GoogleIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeGoogle, e.Aggregate())
 		case *org.GoogleIDPAddedEvent:
@@ -2090,7 +2827,12 @@ func (wm *IDPTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.GitHubIDPAddedEventType,
 			instance.GitHubEnterpriseIDPAddedEventType,
 			instance.GitLabIDPAddedEventType,
+			instance.BitbucketIDPAddedEventType,
+			instance.DiscordIDPAddedEventType,
+			instance.SlackIDPAddedEventType,
 			instance.GitLabSelfHostedIDPAddedEventType,
+			instance.KeycloakIDPAddedEventType,
+			instance.OktaIDPAddedEventType,
 			instance.GoogleIDPAddedEventType,
 			instance.LDAPIDPAddedEventType,
 			instance.AppleIDPAddedEventType,
@@ -2110,7 +2852,12 @@ func (wm *IDPTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.GitHubIDPAddedEventType,
 			org.GitHubEnterpriseIDPAddedEventType,
 			org.GitLabIDPAddedEventType,
+			org.BitbucketIDPAddedEventType,
+			org.DiscordIDPAddedEventType,
+			org.SlackIDPAddedEventType,
 			org.GitLabSelfHostedIDPAddedEventType,
+			org.KeycloakIDPAddedEventType,
+			org.OktaIDPAddedEventType,
 			org.GoogleIDPAddedEventType,
 			org.LDAPIDPAddedEventType,
 			org.AppleIDPAddedEventType,
@@ -2185,8 +2932,18 @@ func NewAllIDPWriteModel(resourceOwner string, instanceBool bool, id string, idp
 			writeModel.model = NewGitHubEnterpriseInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitLab:
 			writeModel.model = NewGitLabInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeBitbucket:
+			writeModel.model = NewBitbucketInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeDiscord:
+			writeModel.model = NewDiscordInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeSlack:
+			writeModel.model = NewSlackInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitLabSelfHosted:
 			writeModel.model = NewGitLabSelfHostedInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeKeycloak:
+			writeModel.model = NewKeycloakInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeOkta:
+			writeModel.model = NewOktaInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGoogle:
 			writeModel.model = NewGoogleInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeApple:
@@ -2216,8 +2973,18 @@ func NewAllIDPWriteModel(resourceOwner string, instanceBool bool, id string, idp
 			writeModel.model = NewGitHubEnterpriseOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitLab:
 			writeModel.model = NewGitLabOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeBitbucket:
+			writeModel.model = NewBitbucketOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeDiscord:
+			writeModel.model = NewDiscordOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeSlack:
+			writeModel.model = NewSlackOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitLabSelfHosted:
 			writeModel.model = NewGitLabSelfHostedOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeKeycloak:
+			writeModel.model = NewKeycloakOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeOkta:
+			writeModel.model = NewOktaOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGoogle:
 			writeModel.model = NewGoogleOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeApple:
@@ -2262,6 +3029,44 @@ func (wm *AllIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encrypt
 	return wm.model.ToProvider(callbackURL, idpAlg)
 }
 
+// LDAP returns the write model if it's an existing LDAP identity provider
+func (wm *AllIDPWriteModel) LDAP() (*LDAPIDPWriteModel, bool) {
+	var model *LDAPIDPWriteModel
+	switch m := wm.model.(type) {
+	case *InstanceLDAPIDPWriteModel:
+		model = &m.LDAPIDPWriteModel
+	case *OrgLDAPIDPWriteModel:
+		model = &m.LDAPIDPWriteModel
+	default:
+		return nil, false
+	}
+	if !model.State.Exists() {
+		return nil, false
+	}
+	return model, true
+}
+
+// ClaimMappings returns the claim mappings of the identity provider, only OAuth, OIDC and SAML providers can have any
+func (wm *AllIDPWriteModel) ClaimMappings() idp.ClaimMappings {
+	switch m := wm.model.(type) {
+	case *InstanceOAuthIDPWriteModel:
+		return m.ClaimMappings
+	case *OrgOAuthIDPWriteModel:
+		return m.ClaimMappings
+	case *InstanceOIDCIDPWriteModel:
+		return m.ClaimMappings
+	case *OrgOIDCIDPWriteModel:
+		return m.ClaimMappings
+	}
+	switch m := wm.samlModel.(type) {
+	case *InstanceSAMLIDPWriteModel:
+		return m.ClaimMappings
+	case *OrgSAMLIDPWriteModel:
+		return m.ClaimMappings
+	}
+	return nil
+}
+
 func (wm *AllIDPWriteModel) GetProviderOptions() idp.Options {
 	if wm.model != nil {
 		return wm.model.GetProviderOptions()
@@ -0,0 +1,142 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+// roleMapping grants the roles of a project (grant) if granted is set,
+// otherwise the roles are removed from an existing grant
+type roleMapping struct {
+	projectID      string
+	projectGrantID string
+	roles          []string
+	granted        bool
+}
+
+type userGrantChange struct {
+	existing       *OrgUserGrant
+	projectID      string
+	projectGrantID string
+	roles          []string
+}
+
+// userGrantChanges computes the grants of the user on the mapped projects:
+// roles of granted mappings are added, roles of the other mappings are removed
+// and roles which aren't mapped at all are kept.
+func userGrantChanges(grants *OrgUserGrantsWriteModel, userID string, mappings []*roleMapping) []*userGrantChange {
+	type project struct {
+		projectID      string
+		projectGrantID string
+	}
+	projects := make([]project, 0, len(mappings))
+	mapped := make(map[project][]string, len(mappings))
+	desired := make(map[project][]string, len(mappings))
+	for _, mapping := range mappings {
+		key := project{projectID: mapping.projectID, projectGrantID: mapping.projectGrantID}
+		if _, ok := mapped[key]; !ok {
+			projects = append(projects, key)
+		}
+		mapped[key] = append(mapped[key], mapping.roles...)
+		if mapping.granted {
+			desired[key] = append(desired[key], mapping.roles...)
+		}
+	}
+
+	changes := make([]*userGrantChange, 0, len(projects))
+	for _, key := range projects {
+		var existing *OrgUserGrant
+		if userID != "" {
+			existing = grants.Grant(userID, key.projectID, key.projectGrantID)
+		}
+		var current []string
+		if existing != nil {
+			current = existing.RoleKeys
+		}
+		roles := make([]string, 0, len(current)+len(desired[key]))
+		for _, role := range current {
+			if !slices.Contains(mapped[key], role) {
+				roles = append(roles, role)
+			}
+		}
+		for _, role := range desired[key] {
+			if !slices.Contains(roles, role) {
+				roles = append(roles, role)
+			}
+		}
+		if len(roles) == 0 && existing == nil {
+			continue
+		}
+		if existing != nil && len(roles) > 0 && sameRoles(current, roles) {
+			continue
+		}
+		changes = append(changes, &userGrantChange{
+			existing:       existing,
+			projectID:      key.projectID,
+			projectGrantID: key.projectGrantID,
+			roles:          roles,
+		})
+	}
+	return changes
+}
+
+func sameRoles(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+func (c *Commands) pushUserGrantChanges(ctx context.Context, userID, orgID string, changes []*userGrantChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	cmds := make([]eventstore.Command, 0, len(changes))
+	for _, change := range changes {
+		if change.existing != nil && len(change.roles) == 0 {
+			cmds = append(cmds, usergrant.NewUserGrantRemovedEvent(
+				ctx,
+				&usergrant.NewAggregate(change.existing.ID, orgID).Aggregate,
+				userID,
+				change.projectID,
+				change.projectGrantID,
+			))
+			continue
+		}
+		err := c.checkUserGrantPreCondition(ctx, &domain.UserGrant{
+			UserID:         userID,
+			ProjectID:      change.projectID,
+			ProjectGrantID: change.projectGrantID,
+			RoleKeys:       change.roles,
+		}, orgID)
+		if err != nil {
+			return err
+		}
+		if change.existing != nil {
+			cmds = append(cmds, usergrant.NewUserGrantChangedEvent(
+				ctx,
+				&usergrant.NewAggregate(change.existing.ID, orgID).Aggregate,
+				change.roles,
+			))
+			continue
+		}
+		grantID, err := c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+		cmds = append(cmds, usergrant.NewUserGrantAddedEvent(
+			ctx,
+			&usergrant.NewAggregate(grantID, orgID).Aggregate,
+			userID,
+			change.projectID,
+			change.projectGrantID,
+			change.roles,
+		))
+	}
+	_, err := c.eventstore.Push(ctx, cmds...)
+	return err
+}
@@ -43,11 +43,13 @@ type InstanceSetup struct {
 	Org                      InstanceOrgSetup
 	SecretGenerators         *SecretGenerators
 	PasswordComplexityPolicy struct {
-		MinLength    uint64
-		HasLowercase bool
-		HasUppercase bool
-		HasNumber    bool
-		HasSymbol    bool
+		MinLength     uint64
+		HasLowercase  bool
+		HasUppercase  bool
+		HasNumber     bool
+		HasSymbol     bool
+		HistoryCount  uint64
+		CheckBreached bool
 	}
 	PasswordAgePolicy struct {
 		ExpireWarnDays uint64
@@ -123,6 +125,7 @@ type OIDCSettings struct {
 	IdTokenLifetime            time.Duration
 	RefreshTokenIdleExpiration time.Duration
 	RefreshTokenExpiration     time.Duration
+	RequirePushedAuthRequests  bool
 }
 
 type SetQuotas struct {
@@ -297,6 +300,8 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 			setup.PasswordComplexityPolicy.HasUppercase,
 			setup.PasswordComplexityPolicy.HasNumber,
 			setup.PasswordComplexityPolicy.HasSymbol,
+			setup.PasswordComplexityPolicy.HistoryCount,
+			setup.PasswordComplexityPolicy.CheckBreached,
 		),
 		prepareAddDefaultPasswordAgePolicy(
 			instanceAgg,
@@ -403,6 +408,7 @@ func setupOIDCSettings(commands *Commands, validations *[]preparation.Validation
 			oidcSettings.IdTokenLifetime,
 			oidcSettings.RefreshTokenIdleExpiration,
 			oidcSettings.RefreshTokenExpiration,
+			oidcSettings.RequirePushedAuthRequests,
 		),
 	)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 )
@@ -68,6 +69,8 @@ func (wm *InstanceOAuthIDPWriteModel) NewChangedEvent(
 	userEndpoint,
 	idAttribute string,
 	scopes []string,
+	claimMappings idp.ClaimMappings,
+	userMapping *providers.UserMapping,
 	options idp.Options,
 ) (*instance.OAuthIDPChangedEvent, error) {
 
@@ -81,6 +84,8 @@ func (wm *InstanceOAuthIDPWriteModel) NewChangedEvent(
 		userEndpoint,
 		idAttribute,
 		scopes,
+		claimMappings,
+		userMapping,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -175,6 +180,8 @@ func (wm *InstanceOIDCIDPWriteModel) NewChangedEvent(
 	secretCrypto crypto.EncryptionAlgorithm,
 	scopes []string,
 	idTokenMapping bool,
+	claimMappings idp.ClaimMappings,
+	userMapping *providers.UserMapping,
 	options idp.Options,
 ) (*instance.OIDCIDPChangedEvent, error) {
 
@@ -186,6 +193,8 @@ func (wm *InstanceOIDCIDPWriteModel) NewChangedEvent(
 		secretCrypto,
 		scopes,
 		idTokenMapping,
+		claimMappings,
+		userMapping,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -576,6 +585,201 @@ func (wm *InstanceGitLabIDPWriteModel) NewChangedEvent(
 	return instance.NewGitLabIDPChangedEvent(ctx, aggregate, id, changes)
 }
 
+type InstanceBitbucketIDPWriteModel struct {
+	BitbucketIDPWriteModel
+}
+
+func NewBitbucketInstanceIDPWriteModel(instanceID, id string) *InstanceBitbucketIDPWriteModel {
+	return &InstanceBitbucketIDPWriteModel{
+		BitbucketIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceBitbucketIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.BitbucketIDPAddedEvent:
+			wm.BitbucketIDPWriteModel.AppendEvents(&e.BitbucketIDPAddedEvent)
+		case *instance.BitbucketIDPChangedEvent:
+			wm.BitbucketIDPWriteModel.AppendEvents(&e.BitbucketIDPChangedEvent)
+		case *instance.IDPRemovedEvent:
+			wm.BitbucketIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.BitbucketIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceBitbucketIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.BitbucketIDPAddedEventType,
+			instance.BitbucketIDPChangedEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *InstanceBitbucketIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*instance.BitbucketIDPChangedEvent, error) {
+
+	changes, err := wm.BitbucketIDPWriteModel.NewChanges(name, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return instance.NewBitbucketIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
+type InstanceDiscordIDPWriteModel struct {
+	DiscordIDPWriteModel
+}
+
+func NewDiscordInstanceIDPWriteModel(instanceID, id string) *InstanceDiscordIDPWriteModel {
+	return &InstanceDiscordIDPWriteModel{
+		DiscordIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceDiscordIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.DiscordIDPAddedEvent:
+			wm.DiscordIDPWriteModel.AppendEvents(&e.DiscordIDPAddedEvent)
+		case *instance.DiscordIDPChangedEvent:
+			wm.DiscordIDPWriteModel.AppendEvents(&e.DiscordIDPChangedEvent)
+		case *instance.IDPRemovedEvent:
+			wm.DiscordIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.DiscordIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceDiscordIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.DiscordIDPAddedEventType,
+			instance.DiscordIDPChangedEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *InstanceDiscordIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*instance.DiscordIDPChangedEvent, error) {
+
+	changes, err := wm.DiscordIDPWriteModel.NewChanges(name, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return instance.NewDiscordIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
+type InstanceSlackIDPWriteModel struct {
+	SlackIDPWriteModel
+}
+
+func NewSlackInstanceIDPWriteModel(instanceID, id string) *InstanceSlackIDPWriteModel {
+	return &InstanceSlackIDPWriteModel{
+		SlackIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceSlackIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.SlackIDPAddedEvent:
+			wm.SlackIDPWriteModel.AppendEvents(&e.SlackIDPAddedEvent)
+		case *instance.SlackIDPChangedEvent:
+			wm.SlackIDPWriteModel.AppendEvents(&e.SlackIDPChangedEvent)
+		case *instance.IDPRemovedEvent:
+			wm.SlackIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.SlackIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceSlackIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.SlackIDPAddedEventType,
+			instance.SlackIDPChangedEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *InstanceSlackIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*instance.SlackIDPChangedEvent, error) {
+
+	changes, err := wm.SlackIDPWriteModel.NewChanges(name, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return instance.NewSlackIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
 type InstanceGitLabSelfHostedIDPWriteModel struct {
 	GitLabSelfHostedIDPWriteModel
 }
@@ -642,6 +846,140 @@ func (wm *InstanceGitLabSelfHostedIDPWriteModel) NewChangedEvent(
 	return instance.NewGitLabSelfHostedIDPChangedEvent(ctx, aggregate, id, changes)
 }
 
+type InstanceKeycloakIDPWriteModel struct {
+	KeycloakIDPWriteModel
+}
+
+func NewKeycloakInstanceIDPWriteModel(instanceID, id string) *InstanceKeycloakIDPWriteModel {
+	return &InstanceKeycloakIDPWriteModel{
+		KeycloakIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceKeycloakIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.KeycloakIDPAddedEvent:
+			wm.KeycloakIDPWriteModel.AppendEvents(&e.KeycloakIDPAddedEvent)
+		case *instance.KeycloakIDPChangedEvent:
+			wm.KeycloakIDPWriteModel.AppendEvents(&e.KeycloakIDPChangedEvent)
+		case *instance.IDPRemovedEvent:
+			wm.KeycloakIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.KeycloakIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceKeycloakIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.KeycloakIDPAddedEventType,
+			instance.KeycloakIDPChangedEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *InstanceKeycloakIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	baseURL,
+	realm,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*instance.KeycloakIDPChangedEvent, error) {
+
+	changes, err := wm.KeycloakIDPWriteModel.NewChanges(name, baseURL, realm, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return instance.NewKeycloakIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
+type InstanceOktaIDPWriteModel struct {
+	OktaIDPWriteModel
+}
+
+func NewOktaInstanceIDPWriteModel(instanceID, id string) *InstanceOktaIDPWriteModel {
+	return &InstanceOktaIDPWriteModel{
+		OktaIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceOktaIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.OktaIDPAddedEvent:
+			wm.OktaIDPWriteModel.AppendEvents(&e.OktaIDPAddedEvent)
+		case *instance.OktaIDPChangedEvent:
+			wm.OktaIDPWriteModel.AppendEvents(&e.OktaIDPChangedEvent)
+		case *instance.IDPRemovedEvent:
+			wm.OktaIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.OktaIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceOktaIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.OktaIDPAddedEventType,
+			instance.OktaIDPChangedEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *InstanceOktaIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	issuer,
+	authorizationServerID,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*instance.OktaIDPChangedEvent, error) {
+
+	changes, err := wm.OktaIDPWriteModel.NewChanges(name, issuer, authorizationServerID, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return instance.NewOktaIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
 type InstanceGoogleIDPWriteModel struct {
 	GoogleIDPWriteModel
 }
@@ -731,6 +1069,8 @@ func (wm *InstanceLDAPIDPWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.LDAPIDPWriteModel.AppendEvents(&e.LDAPIDPAddedEvent)
 		case *instance.LDAPIDPChangedEvent:
 			wm.LDAPIDPWriteModel.AppendEvents(&e.LDAPIDPChangedEvent)
+		case *instance.LDAPIDPSyncedEvent:
+			wm.LDAPIDPWriteModel.AppendEvents(&e.LDAPIDPSyncedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.LDAPIDPWriteModel.AppendEvents(&e.RemovedEvent)
 		default:
@@ -748,6 +1088,7 @@ func (wm *InstanceLDAPIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			instance.LDAPIDPAddedEventType,
 			instance.LDAPIDPChangedEventType,
+			instance.LDAPIDPSyncedEventType,
 			instance.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -770,6 +1111,7 @@ func (wm *InstanceLDAPIDPWriteModel) NewChangedEvent(
 	timeout time.Duration,
 	secretCrypto crypto.EncryptionAlgorithm,
 	attributes idp.LDAPAttributes,
+	sync *idp.LDAPSync,
 	options idp.Options,
 ) (*instance.LDAPIDPChangedEvent, error) {
 
@@ -786,6 +1128,7 @@ func (wm *InstanceLDAPIDPWriteModel) NewChangedEvent(
 		timeout,
 		secretCrypto,
 		attributes,
+		sync,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -918,6 +1261,7 @@ func (wm *InstanceSAMLIDPWriteModel) NewChangedEvent(
 	withSignedRequest bool,
 	nameIDFormat *domain.SAMLNameIDFormat,
 	transientMappingAttributeName string,
+	claimMappings idp.ClaimMappings,
 	options idp.Options,
 ) (*instance.SAMLIDPChangedEvent, error) {
 	changes, err := wm.SAMLIDPWriteModel.NewChanges(
@@ -930,6 +1274,7 @@ func (wm *InstanceSAMLIDPWriteModel) NewChangedEvent(
 		withSignedRequest,
 		nameIDFormat,
 		transientMappingAttributeName,
+		claimMappings,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -971,8 +1316,18 @@ func (wm *InstanceIDPRemoveWriteModel) AppendEvents(events ...eventstore.Event)
 			wm.IDPRemoveWriteModel.AppendEvents(&e.GitHubEnterpriseIDPAddedEvent)
 		case *instance.GitLabIDPAddedEvent:
 			wm.IDPRemoveWriteModel.AppendEvents(&e.GitLabIDPAddedEvent)
+		case *instance.BitbucketIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.BitbucketIDPAddedEvent)
+		case *instance.DiscordIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.DiscordIDPAddedEvent)
+		case *instance.SlackIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.SlackIDPAddedEvent)
 		case *instance.GitLabSelfHostedIDPAddedEvent:
 			wm.IDPRemoveWriteModel.AppendEvents(&e.GitLabSelfHostedIDPAddedEvent)
+		case *instance.KeycloakIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.KeycloakIDPAddedEvent)
+		case *instance.OktaIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.OktaIDPAddedEvent)
 		case *instance.GoogleIDPAddedEvent:
 			wm.IDPRemoveWriteModel.AppendEvents(&e.GoogleIDPAddedEvent)
 		case *instance.SAMLIDPAddedEvent:
@@ -1007,7 +1362,12 @@ func (wm *InstanceIDPRemoveWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.GitHubIDPAddedEventType,
 			instance.GitHubEnterpriseIDPAddedEventType,
 			instance.GitLabIDPAddedEventType,
+			instance.BitbucketIDPAddedEventType,
+			instance.DiscordIDPAddedEventType,
+			instance.SlackIDPAddedEventType,
 			instance.GitLabSelfHostedIDPAddedEventType,
+			instance.KeycloakIDPAddedEventType,
+			instance.OktaIDPAddedEventType,
 			instance.GoogleIDPAddedEventType,
 			instance.LDAPIDPAddedEventType,
 			instance.AppleIDPAddedEventType,
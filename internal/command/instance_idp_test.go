@@ -17,6 +17,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -203,6 +204,8 @@ func TestCommandSide_AddInstanceGenericOAuthIDP(t *testing.T) {
 							"user",
 							"idAttribute",
 							nil,
+							nil,
+							nil,
 							idp.Options{},
 						),
 					),
@@ -248,6 +251,8 @@ func TestCommandSide_AddInstanceGenericOAuthIDP(t *testing.T) {
 							"user",
 							"idAttribute",
 							[]string{"user"},
+							nil,
+							nil,
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -502,6 +507,8 @@ func TestCommandSide_UpdateInstanceGenericOAuthIDP(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -544,6 +551,8 @@ func TestCommandSide_UpdateInstanceGenericOAuthIDP(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -719,6 +728,30 @@ func TestCommandSide_AddInstanceGenericOIDCIDP(t *testing.T) {
 				},
 			},
 		},
+		{
+			"invalid user mapping",
+			fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: GenericOIDCProvider{
+					Name:         "name",
+					Issuer:       "issuer",
+					ClientID:     "clientID",
+					ClientSecret: "clientSecret",
+					UserMapping: &providers.UserMapping{
+						Email: "profile.email",
+					},
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gai4e", ""))
+				},
+			},
+		},
 		{
 			name: "ok",
 			fields: fields{
@@ -738,6 +771,8 @@ func TestCommandSide_AddInstanceGenericOIDCIDP(t *testing.T) {
 							},
 							nil,
 							false,
+							nil,
+							nil,
 							idp.Options{},
 						),
 					),
@@ -778,6 +813,11 @@ func TestCommandSide_AddInstanceGenericOIDCIDP(t *testing.T) {
 							},
 							[]string{openid.ScopeOpenID},
 							true,
+							nil,
+							&providers.UserMapping{
+								ID:    "$.attributes.uid",
+								Email: "$.emails[0].value",
+							},
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -799,6 +839,10 @@ func TestCommandSide_AddInstanceGenericOIDCIDP(t *testing.T) {
 					ClientSecret:     "clientSecret",
 					Scopes:           []string{openid.ScopeOpenID},
 					IsIDTokenMapping: true,
+					UserMapping: &providers.UserMapping{
+						ID:    "$.attributes.uid",
+						Email: "$.emails[0].value",
+					},
 					IDPOptions: idp.Options{
 						IsCreationAllowed: true,
 						IsLinkingAllowed:  true,
@@ -962,6 +1006,8 @@ func TestCommandSide_UpdateInstanceGenericOIDCIDP(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -999,6 +1045,8 @@ func TestCommandSide_UpdateInstanceGenericOIDCIDP(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -1186,6 +1234,8 @@ func TestCommandSide_MigrateInstanceGenericOIDCToAzureADProvider(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -1244,6 +1294,8 @@ func TestCommandSide_MigrateInstanceGenericOIDCToAzureADProvider(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -1408,6 +1460,8 @@ func TestCommandSide_MigrateInstanceOIDCToGoogleIDP(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -1460,6 +1514,8 @@ func TestCommandSide_MigrateInstanceOIDCToGoogleIDP(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -3634,6 +3690,318 @@ func TestCommandSide_UpdateInstanceGitLabSelfHostedIDP(t *testing.T) {
 	}
 }
 
+func TestCommandSide_AddInstanceKeycloakIDP(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		provider KeycloakProvider
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		res        res
+	}{
+		{
+			"invalid realm",
+			expectEventstore(),
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: KeycloakProvider{
+					Name:    "name",
+					BaseURL: "https://keycloak.example.com",
+					Realm:   " ",
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "INST-aPqiY", ""))
+				},
+			},
+		},
+		{
+			name: "ok with realm and scopes",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					instance.NewKeycloakIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+						"id1",
+						"name",
+						"https://keycloak.example.com",
+						"realm",
+						"clientID",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("clientSecret"),
+						},
+						[]string{"openid", "profile", "email"},
+						idp.Options{},
+					),
+				),
+			),
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: KeycloakProvider{
+					Name:         "name",
+					BaseURL:      "https://keycloak.example.com",
+					Realm:        " realm ",
+					ClientID:     "clientID",
+					ClientSecret: "clientSecret",
+					Scopes:       []string{"openid", "profile", "email"},
+				},
+			},
+			res: res{
+				id:   "id1",
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:          tt.eventstore(t),
+				idGenerator:         id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+				idpConfigEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			id, got, err := c.AddInstanceKeycloakProvider(tt.args.ctx, tt.args.provider)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_UpdateInstanceKeycloakIDP_realmAndScopes(t *testing.T) {
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(
+					instance.NewKeycloakIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+						"id1",
+						"name",
+						"https://keycloak.example.com",
+						"realm",
+						"clientID",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("clientSecret"),
+						},
+						[]string{"openid"},
+						idp.Options{},
+					)),
+			),
+			expectPush(
+				func() eventstore.Command {
+					event, _ := instance.NewKeycloakIDPChangedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+						"id1",
+						[]idp.KeycloakIDPChanges{
+							idp.ChangeKeycloakRealm("newRealm"),
+							idp.ChangeKeycloakScopes([]string{"openid", "profile"}),
+						},
+					)
+					return event
+				}(),
+			),
+		)(t),
+	}
+	got, err := c.UpdateInstanceKeycloakProvider(authz.WithInstanceID(context.Background(), "instance1"), "id1", KeycloakProvider{
+		Name:     "name",
+		BaseURL:  "https://keycloak.example.com",
+		Realm:    "newRealm",
+		ClientID: "clientID",
+		Scopes:   []string{"openid", "profile"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.ObjectDetails{ResourceOwner: "instance1"}, got)
+}
+
+func TestCommandSide_AddInstanceOktaIDP(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		provider OktaProvider
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		res        res
+	}{
+		{
+			"invalid issuer",
+			expectEventstore(),
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: OktaProvider{
+					Name:                  "name",
+					AuthorizationServerID: "default",
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "INST-YxF1g", ""))
+				},
+			},
+		},
+		{
+			name: "ok org authorization server",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					instance.NewOktaIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+						"id1",
+						"name",
+						"https://example.okta.com",
+						"",
+						"clientID",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("clientSecret"),
+						},
+						nil,
+						idp.Options{},
+					),
+				),
+			),
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: OktaProvider{
+					Name:         "name",
+					Issuer:       "https://example.okta.com",
+					ClientID:     "clientID",
+					ClientSecret: "clientSecret",
+				},
+			},
+			res: res{
+				id:   "id1",
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+		{
+			name: "ok custom authorization server",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					instance.NewOktaIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+						"id1",
+						"name",
+						"https://example.okta.com",
+						"aus1",
+						"clientID",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("clientSecret"),
+						},
+						nil,
+						idp.Options{},
+					),
+				),
+			),
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: OktaProvider{
+					Name:                  "name",
+					Issuer:                "https://example.okta.com",
+					AuthorizationServerID: " aus1 ",
+					ClientID:              "clientID",
+					ClientSecret:          "clientSecret",
+				},
+			},
+			res: res{
+				id:   "id1",
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:          tt.eventstore(t),
+				idGenerator:         id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+				idpConfigEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			id, got, err := c.AddInstanceOktaProvider(tt.args.ctx, tt.args.provider)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_UpdateInstanceOktaIDP_authorizationServer(t *testing.T) {
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(
+					instance.NewOktaIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+						"id1",
+						"name",
+						"https://example.okta.com",
+						"",
+						"clientID",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("clientSecret"),
+						},
+						nil,
+						idp.Options{},
+					)),
+			),
+			expectPush(
+				func() eventstore.Command {
+					event, _ := instance.NewOktaIDPChangedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+						"id1",
+						[]idp.OktaIDPChanges{
+							idp.ChangeOktaAuthorizationServerID("aus1"),
+						},
+					)
+					return event
+				}(),
+			),
+		)(t),
+	}
+	got, err := c.UpdateInstanceOktaProvider(authz.WithInstanceID(context.Background(), "instance1"), "id1", OktaProvider{
+		Name:                  "name",
+		Issuer:                "https://example.okta.com",
+		AuthorizationServerID: "aus1",
+		ClientID:              "clientID",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.ObjectDetails{ResourceOwner: "instance1"}, got)
+}
+
 func TestCommandSide_AddInstanceGoogleIDP(t *testing.T) {
 	type fields struct {
 		eventstore   func(*testing.T) *eventstore.Eventstore
@@ -4194,6 +4562,7 @@ func TestCommandSide_AddInstanceLDAPIDP(t *testing.T) {
 							[]string{"filter"},
 							time.Second*30,
 							idp.LDAPAttributes{},
+							nil,
 							idp.Options{},
 						),
 					),
@@ -4259,6 +4628,7 @@ func TestCommandSide_AddInstanceLDAPIDP(t *testing.T) {
 								AvatarURLAttribute:         "avatarURL",
 								ProfileAttribute:           "profile",
 							},
+							nil,
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -4560,6 +4930,7 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								idp.LDAPAttributes{},
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -4608,6 +4979,7 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								idp.LDAPAttributes{},
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -5248,6 +5620,7 @@ func TestCommandSide_AddInstanceSAMLIDP(t *testing.T) {
 							false,
 							nil,
 							"",
+							nil,
 							idp.Options{},
 						),
 					),
@@ -5289,6 +5662,7 @@ func TestCommandSide_AddInstanceSAMLIDP(t *testing.T) {
 							true,
 							gu.Ptr(domain.SAMLNameIDFormatTransient),
 							"customAttribute",
+							nil,
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -5457,6 +5831,7 @@ func TestCommandSide_UpdateInstanceGenericSAMLIDP(t *testing.T) {
 								false,
 								nil,
 								"",
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -5495,6 +5870,7 @@ func TestCommandSide_UpdateInstanceGenericSAMLIDP(t *testing.T) {
 								false,
 								gu.Ptr(domain.SAMLNameIDFormatUnspecified),
 								"",
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -5637,6 +6013,7 @@ func TestCommandSide_RegenerateInstanceSAMLProviderCertificate(t *testing.T) {
 								false,
 								gu.Ptr(domain.SAMLNameIDFormatUnspecified),
 								"",
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -17,6 +17,7 @@ type InstanceOIDCSettingsWriteModel struct {
 	IdTokenLifetime            time.Duration
 	RefreshTokenIdleExpiration time.Duration
 	RefreshTokenExpiration     time.Duration
+	RequirePushedAuthRequests  bool
 	State                      domain.OIDCSettingsState
 }
 
@@ -37,6 +38,7 @@ func (wm *InstanceOIDCSettingsWriteModel) Reduce() error {
 			wm.IdTokenLifetime = e.IdTokenLifetime
 			wm.RefreshTokenIdleExpiration = e.RefreshTokenIdleExpiration
 			wm.RefreshTokenExpiration = e.RefreshTokenExpiration
+			wm.RequirePushedAuthRequests = e.RequirePushedAuthRequests
 			wm.State = domain.OIDCSettingsStateActive
 		case *instance.OIDCSettingsChangedEvent:
 			if e.AccessTokenLifetime != nil {
@@ -51,6 +53,9 @@ func (wm *InstanceOIDCSettingsWriteModel) Reduce() error {
 			if e.RefreshTokenExpiration != nil {
 				wm.RefreshTokenExpiration = *e.RefreshTokenExpiration
 			}
+			if e.RequirePushedAuthRequests != nil {
+				wm.RequirePushedAuthRequests = *e.RequirePushedAuthRequests
+			}
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -75,8 +80,9 @@ func (wm *InstanceOIDCSettingsWriteModel) NewChangedEvent(
 	idTokenLifetime,
 	refreshTokenIdleExpiration,
 	refreshTokenExpiration time.Duration,
+	requirePushedAuthRequests bool,
 ) (*instance.OIDCSettingsChangedEvent, bool, error) {
-	changes := make([]instance.OIDCSettingsChanges, 0, 4)
+	changes := make([]instance.OIDCSettingsChanges, 0, 5)
 	var err error
 
 	if wm.AccessTokenLifetime != accessTokenLifetime {
@@ -91,6 +97,9 @@ func (wm *InstanceOIDCSettingsWriteModel) NewChangedEvent(
 	if wm.RefreshTokenExpiration != refreshTokenExpiration {
 		changes = append(changes, instance.ChangeOIDCSettingsRefreshTokenExpiration(refreshTokenExpiration))
 	}
+	if wm.RequirePushedAuthRequests != requirePushedAuthRequests {
+		changes = append(changes, instance.ChangeOIDCSettingsRequirePushedAuthRequests(requirePushedAuthRequests))
+	}
 	if len(changes) == 0 {
 		return nil, false, nil
 	}
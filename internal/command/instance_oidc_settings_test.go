@@ -45,6 +45,7 @@ func TestCommandSide_AddOIDCConfig(t *testing.T) {
 								time.Hour*1,
 								time.Hour*1,
 								time.Hour*1,
+								false,
 							),
 						),
 					),
@@ -77,6 +78,7 @@ func TestCommandSide_AddOIDCConfig(t *testing.T) {
 							time.Hour*1,
 							time.Hour*1,
 							time.Hour*1,
+							false,
 						),
 					),
 				),
@@ -329,6 +331,7 @@ func TestCommandSide_ChangeOIDCConfig(t *testing.T) {
 								time.Hour*1,
 								time.Hour*1,
 								time.Hour*1,
+								false,
 							),
 						),
 					),
@@ -361,6 +364,7 @@ func TestCommandSide_ChangeOIDCConfig(t *testing.T) {
 								time.Hour*1,
 								time.Hour*1,
 								time.Hour*1,
+								false,
 							),
 						),
 					),
@@ -389,6 +393,53 @@ func TestCommandSide_ChangeOIDCConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "oidc settings change pushed auth requests required, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewOIDCSettingsAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								time.Hour*1,
+								time.Hour*1,
+								time.Hour*1,
+								time.Hour*1,
+								false,
+							),
+						),
+					),
+					expectPush(
+						func() *instance.OIDCSettingsChangedEvent {
+							event, _ := instance.NewOIDCSettingsChangeEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								[]instance.OIDCSettingsChanges{
+									instance.ChangeOIDCSettingsRequirePushedAuthRequests(true),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				oidcConfig: &domain.OIDCSettings{
+					AccessTokenLifetime:        1 * time.Hour,
+					IdTokenLifetime:            1 * time.Hour,
+					RefreshTokenIdleExpiration: 1 * time.Hour,
+					RefreshTokenExpiration:     1 * time.Hour,
+					RequirePushedAuthRequests:  true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
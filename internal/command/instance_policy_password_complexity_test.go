@@ -20,12 +20,14 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 		eventstore *eventstore.Eventstore
 	}
 	type args struct {
-		ctx          context.Context
-		minLength    uint64
-		hasLowercase bool
-		hasUppercase bool
-		hasNumber    bool
-		hasSymbol    bool
+		ctx           context.Context
+		minLength     uint64
+		hasLowercase  bool
+		hasUppercase  bool
+		hasNumber     bool
+		hasSymbol     bool
+		historyCount  uint64
+		checkBreached bool
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -67,6 +69,8 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
 							),
 						),
 					),
@@ -95,17 +99,21 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							8,
 							true, true, true, true,
+							5,
+							true,
 						),
 					),
 				),
 			},
 			args: args{
-				ctx:          authz.WithInstanceID(context.Background(), "INSTANCE"),
-				minLength:    8,
-				hasUppercase: true,
-				hasLowercase: true,
-				hasNumber:    true,
-				hasSymbol:    true,
+				ctx:           authz.WithInstanceID(context.Background(), "INSTANCE"),
+				minLength:     8,
+				hasUppercase:  true,
+				hasLowercase:  true,
+				hasNumber:     true,
+				hasSymbol:     true,
+				historyCount:  5,
+				checkBreached: true,
 			},
 			res: res{
 				want: &domain.ObjectDetails{
@@ -119,7 +127,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultPasswordComplexityPolicy(tt.args.ctx, tt.args.minLength, tt.args.hasLowercase, tt.args.hasUppercase, tt.args.hasNumber, tt.args.hasSymbol)
+			got, err := r.AddDefaultPasswordComplexityPolicy(tt.args.ctx, tt.args.minLength, tt.args.hasLowercase, tt.args.hasUppercase, tt.args.hasNumber, tt.args.hasSymbol, tt.args.historyCount, tt.args.checkBreached)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -205,6 +213,8 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
 							),
 						),
 					),
@@ -235,6 +245,8 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
 							),
 						),
 					),
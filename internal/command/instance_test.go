@@ -125,6 +125,10 @@ func oidcAppEvents(ctx context.Context, orgID, projectID, id, name, clientID str
 			0,
 			nil,
 			false,
+			"",
+			false,
+			false,
+			false,
 		),
 	}
 }
@@ -201,7 +205,7 @@ func instancePoliciesFilters(instanceID string) []expect {
 func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore.Command {
 	instanceAgg := instance.NewAggregate(instanceID)
 	return []eventstore.Command{
-		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true),
+		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true, 0, false),
 		instance.NewPasswordAgePolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0),
 		instance.NewDomainPolicyAddedEvent(ctx, &instanceAgg.Aggregate, false, false, false),
 		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour),
@@ -219,12 +223,14 @@ func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore
 func instanceSetupPoliciesConfig() *InstanceSetup {
 	return &InstanceSetup{
 		PasswordComplexityPolicy: struct {
-			MinLength    uint64
-			HasLowercase bool
-			HasUppercase bool
-			HasNumber    bool
-			HasSymbol    bool
-		}{8, true, true, true, true},
+			MinLength     uint64
+			HasLowercase  bool
+			HasUppercase  bool
+			HasNumber     bool
+			HasSymbol     bool
+			HistoryCount  uint64
+			CheckBreached bool
+		}{8, true, true, true, true, 0, false},
 		PasswordAgePolicy: struct {
 			ExpireWarnDays uint64
 			MaxAgeDays     uint64
@@ -430,6 +436,10 @@ func generatedDomainFilters(instanceID, orgID, projectID, appID, generatedDomain
 				0,
 				nil,
 				false,
+				"",
+				false,
+				false,
+				false,
 			),
 		),
 		expectFilter(
@@ -467,6 +477,8 @@ func humanFilters(orgID string) []expect {
 				false,
 				false,
 				false,
+				0,
+				false,
 			),
 		),
 	}
@@ -617,6 +629,7 @@ func addMachineEvent(ctx context.Context, orgID, userID string) *user.MachineAdd
 		"Admin",
 		false,
 		domain.OIDCTokenTypeBearer,
+		false,
 	)
 }
 
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"database/sql"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -285,3 +286,17 @@ func mockPasswordHasher(x string) *crypto.Hasher {
 		Prefixes: []string{"$plain$"},
 	}
 }
+
+// mockBreachChecker reports the passwords in breached as breached
+// or returns err if set.
+type mockBreachChecker struct {
+	breached []string
+	err      error
+}
+
+func (m *mockBreachChecker) Breached(_ context.Context, password string) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	return slices.Contains(m.breached, password), nil
+}
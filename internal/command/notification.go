@@ -0,0 +1,125 @@
+package command
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// NotificationRequest describes a notification to a user triggered by an event.
+type NotificationRequest struct {
+	UserID           string
+	ResourceOwner    string
+	NotificationType domain.NotificationType
+	MessageType      string
+	TriggerEvent     eventstore.Event
+}
+
+// ID of the notification is derived from the triggering event,
+// so that a notification is only requested once per event.
+func (r *NotificationRequest) ID() string {
+	return r.TriggerEvent.Aggregate().ID + "-" + strconv.FormatUint(r.TriggerEvent.Sequence(), 10)
+}
+
+// RequestNotification records the notification if it was not requested yet and returns its current state.
+// Only notifications in the queued state must be delivered by the caller,
+// failed notifications are retried by the notification worker.
+func (c *Commands) RequestNotification(ctx context.Context, request *NotificationRequest) (domain.NotificationState, error) {
+	if request.UserID == "" || request.TriggerEvent == nil {
+		return domain.NotificationStateUnspecified, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ehi3i", "Errors.IDMissing")
+	}
+	wm, err := c.getNotificationWriteModel(ctx, request.ID(), request.ResourceOwner)
+	if err != nil {
+		return domain.NotificationStateUnspecified, err
+	}
+	if wm.State.Exists() {
+		return wm.State, nil
+	}
+	_, err = c.eventstore.Push(ctx, notification.NewRequestedEvent(ctx,
+		NotificationAggregateFromWriteModel(&wm.WriteModel),
+		request.UserID,
+		request.NotificationType,
+		request.MessageType,
+		request.TriggerEvent.Aggregate().Type,
+		request.TriggerEvent.Aggregate().ID,
+		request.TriggerEvent.Type(),
+		request.TriggerEvent.Sequence(),
+	))
+	if err != nil {
+		return domain.NotificationStateUnspecified, err
+	}
+	return domain.NotificationStateQueued, nil
+}
+
+// NotificationSent marks the notification as successfully delivered.
+func (c *Commands) NotificationSent(ctx context.Context, id, resourceOwner string) error {
+	wm, err := c.getExistingNotificationWriteModel(ctx, id, resourceOwner)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, notification.NewSentEvent(ctx, NotificationAggregateFromWriteModel(&wm.WriteModel)))
+	return err
+}
+
+// NotificationFailed records a failed delivery attempt, which will be retried after retryAt.
+func (c *Commands) NotificationFailed(ctx context.Context, id, resourceOwner string, sendErr error, retryAt time.Time) error {
+	wm, err := c.getExistingNotificationWriteModel(ctx, id, resourceOwner)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, notification.NewFailedEvent(ctx, NotificationAggregateFromWriteModel(&wm.WriteModel), sendErr, retryAt))
+	return err
+}
+
+// NotificationDeadLettered stops any further delivery attempts of the notification.
+func (c *Commands) NotificationDeadLettered(ctx context.Context, id, resourceOwner string, reason error) error {
+	wm, err := c.getExistingNotificationWriteModel(ctx, id, resourceOwner)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, notification.NewDeadLetteredEvent(ctx, NotificationAggregateFromWriteModel(&wm.WriteModel), reason))
+	return err
+}
+
+// ResendNotification resets the attempts of a failed or dead lettered notification,
+// so it will be delivered again by the notification worker.
+func (c *Commands) ResendNotification(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	wm, err := c.getExistingNotificationWriteModel(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Resendable() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Iex2u", "Errors.Notification.NotResendable")
+	}
+	if err = c.pushAppendAndReduce(ctx, wm, notification.NewRetryRequestedEvent(ctx, NotificationAggregateFromWriteModel(&wm.WriteModel))); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+func (c *Commands) getExistingNotificationWriteModel(ctx context.Context, id, resourceOwner string) (*NotificationWriteModel, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ooD4o", "Errors.IDMissing")
+	}
+	wm, err := c.getNotificationWriteModel(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-ahG3e", "Errors.Notification.NotFound")
+	}
+	return wm, nil
+}
+
+func (c *Commands) getNotificationWriteModel(ctx context.Context, id, resourceOwner string) (*NotificationWriteModel, error) {
+	wm := NewNotificationWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+type NotificationWriteModel struct {
+	eventstore.WriteModel
+
+	UserID               string
+	NotificationType     domain.NotificationType
+	MessageType          string
+	TriggerAggregateType eventstore.AggregateType
+	TriggerAggregateID   string
+	TriggerEventType     eventstore.EventType
+	TriggerSequence      uint64
+	State                domain.NotificationState
+	Attempts             uint8
+	RetryAt              time.Time
+}
+
+func NewNotificationWriteModel(id, resourceOwner string) *NotificationWriteModel {
+	return &NotificationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *NotificationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *notification.RequestedEvent:
+			wm.UserID = e.UserID
+			wm.NotificationType = e.NotificationType
+			wm.MessageType = e.MessageType
+			wm.TriggerAggregateType = e.TriggerAggregateType
+			wm.TriggerAggregateID = e.TriggerAggregateID
+			wm.TriggerEventType = e.TriggerEventType
+			wm.TriggerSequence = e.TriggerSequence
+			wm.State = domain.NotificationStateQueued
+		case *notification.SentEvent:
+			wm.Attempts++
+			wm.State = domain.NotificationStateSent
+		case *notification.FailedEvent:
+			wm.Attempts++
+			wm.RetryAt = e.RetryAt
+			wm.State = domain.NotificationStateFailed
+		case *notification.RetryRequestedEvent:
+			wm.Attempts = 0
+			wm.RetryAt = e.CreatedAt()
+			wm.State = domain.NotificationStateFailed
+		case *notification.DeadLetteredEvent:
+			wm.RetryAt = time.Time{}
+			wm.State = domain.NotificationStateDeadLetter
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *NotificationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(notification.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			notification.RequestedType,
+			notification.SentType,
+			notification.FailedType,
+			notification.RetryRequestedType,
+			notification.DeadLetteredType,
+		).
+		Builder()
+}
+
+func NotificationAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return &notification.NewAggregate(wm.AggregateID, wm.ResourceOwner).Aggregate
+}
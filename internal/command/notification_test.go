@@ -0,0 +1,336 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func testNotificationTriggerEvent() eventstore.Event {
+	return eventstore.BaseEventFromRepo(&repository.Event{
+		AggregateID:   "user1",
+		AggregateType: user.AggregateType,
+		ResourceOwner: sql.NullString{String: "org1", Valid: true},
+		Seq:           15,
+		Typ:           user.HumanInitialCodeAddedType,
+	})
+}
+
+func testNotificationRequestedEvent() *notification.RequestedEvent {
+	return notification.NewRequestedEvent(
+		context.Background(),
+		&notification.NewAggregate("user1-15", "org1").Aggregate,
+		"user1",
+		domain.NotificationTypeEmail,
+		domain.InitCodeMessageType,
+		user.AggregateType,
+		"user1",
+		user.HumanInitialCodeAddedType,
+		15,
+	)
+}
+
+func TestCommands_RequestNotification(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		request *NotificationRequest
+	}
+	type res struct {
+		state domain.NotificationState
+		err   func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing user, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				request: &NotificationRequest{
+					ResourceOwner: "org1",
+					TriggerEvent:  testNotificationTriggerEvent(),
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "new notification, queued",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						testNotificationRequestedEvent(),
+					),
+				),
+			},
+			args: args{
+				request: &NotificationRequest{
+					UserID:           "user1",
+					ResourceOwner:    "org1",
+					NotificationType: domain.NotificationTypeEmail,
+					MessageType:      domain.InitCodeMessageType,
+					TriggerEvent:     testNotificationTriggerEvent(),
+				},
+			},
+			res: res{
+				state: domain.NotificationStateQueued,
+			},
+		},
+		{
+			name: "already requested, current state",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							testNotificationRequestedEvent(),
+						),
+						eventFromEventPusher(
+							notification.NewFailedEvent(context.Background(),
+								&notification.NewAggregate("user1-15", "org1").Aggregate,
+								errors.New("unavailable"),
+								time.Now(),
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				request: &NotificationRequest{
+					UserID:           "user1",
+					ResourceOwner:    "org1",
+					NotificationType: domain.NotificationTypeEmail,
+					MessageType:      domain.InitCodeMessageType,
+					TriggerEvent:     testNotificationTriggerEvent(),
+				},
+			},
+			res: res{
+				state: domain.NotificationStateFailed,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.RequestNotification(context.Background(), tt.args.request)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.res.state, got)
+		})
+	}
+}
+
+func TestCommands_NotificationFailed(t *testing.T) {
+	retryAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		id  string
+		err error
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				err: errors.New("unavailable"),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "notification not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				id:  "user1-15",
+				err: errors.New("unavailable"),
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "failed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							testNotificationRequestedEvent(),
+						),
+					),
+					expectPush(
+						notification.NewFailedEvent(context.Background(),
+							&notification.NewAggregate("user1-15", "org1").Aggregate,
+							errors.New("unavailable"),
+							retryAt,
+						),
+					),
+				),
+			},
+			args: args{
+				id:  "user1-15",
+				err: errors.New("unavailable"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.NotificationFailed(context.Background(), tt.args.id, "org1", tt.args.err, retryAt)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_ResendNotification(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		id string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "notification not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				id: "user1-15",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "notification sent, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							testNotificationRequestedEvent(),
+						),
+						eventFromEventPusher(
+							notification.NewSentEvent(context.Background(),
+								&notification.NewAggregate("user1-15", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				id: "user1-15",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "notification dead lettered, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							testNotificationRequestedEvent(),
+						),
+						eventFromEventPusher(
+							notification.NewDeadLetteredEvent(context.Background(),
+								&notification.NewAggregate("user1-15", "org1").Aggregate,
+								errors.New("maximum attempts reached"),
+							),
+						),
+					),
+					expectPush(
+						notification.NewRetryRequestedEvent(context.Background(),
+							&notification.NewAggregate("user1-15", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			args: args{
+				id: "user1-15",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.ResendNotification(context.Background(), tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -47,6 +47,7 @@ type OIDCSession struct {
 	Reason            domain.TokenReason
 	Actor             *domain.TokenActor
 	RefreshToken      string
+	DPoPJKT           string
 }
 
 type AuthRequestComplianceChecker func(context.Context, *AuthRequestWriteModel) error
@@ -54,7 +55,8 @@ type AuthRequestComplianceChecker func(context.Context, *AuthRequestWriteModel)
 // CreateOIDCSessionFromAuthRequest creates a new OIDC Session, creates an access token and refresh token.
 // It returns the access token id, expiration and the refresh token.
 // If the underlying [AuthRequest] is a OIDC Auth Code Flow, it will set the code as exchanged.
-func (c *Commands) CreateOIDCSessionFromAuthRequest(ctx context.Context, authReqId string, complianceCheck AuthRequestComplianceChecker, needRefreshToken bool) (session *OIDCSession, state string, err error) {
+// If a DPoP JWK thumbprint (dpopJKT) is provided, the tokens of the session will be bound to the corresponding key.
+func (c *Commands) CreateOIDCSessionFromAuthRequest(ctx context.Context, authReqId string, complianceCheck AuthRequestComplianceChecker, needRefreshToken bool, dpopJKT string) (session *OIDCSession, state string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -105,6 +107,7 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(ctx context.Context, authReq
 		authReqModel.Nonce,
 		sessionModel.PreferredLanguage,
 		sessionModel.UserAgent,
+		dpopJKT,
 	)
 
 	if authReqModel.ResponseType != domain.OIDCResponseTypeIDToken {
@@ -136,6 +139,7 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 	reason domain.TokenReason,
 	actor *domain.TokenActor,
 	needRefreshToken bool,
+	dpopJKT string,
 ) (session *OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -151,7 +155,7 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 		cmd.UserImpersonated(ctx, userID, resourceOwner, clientID, actor)
 	}
 
-	cmd.AddSession(ctx, userID, resourceOwner, "", clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent)
+	cmd.AddSession(ctx, userID, resourceOwner, "", clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent, dpopJKT)
 	if err = cmd.AddAccessToken(ctx, scope, userID, resourceOwner, reason, actor); err != nil {
 		return nil, err
 	}
@@ -264,6 +268,35 @@ func (c *Commands) RevokeOIDCSessionToken(ctx context.Context, token, clientID s
 	return c.pushAppendAndReduce(ctx, writeModel, oidcsession.NewAccessTokenRevokedEvent(ctx, writeModel.aggregate))
 }
 
+// BackChannelLogoutSent marks the OIDCSession as notified about the logout
+// by the back-channel logout token sent to the client
+func (c *Commands) BackChannelLogoutSent(ctx context.Context, oidcSessionID, resourceOwner string) (err error) {
+	writeModel := NewOIDCSessionWriteModel(oidcSessionID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return err
+	}
+	if writeModel.State == domain.OIDCSessionStateUnspecified {
+		return zerrors.ThrowNotFound(nil, "OIDCS-Ohs4u", "Errors.OIDCSession.Token.Invalid")
+	}
+	return c.pushAppendAndReduce(ctx, writeModel, oidcsession.NewBackChannelLogoutSentEvent(ctx, writeModel.aggregate, writeModel.ClientID))
+}
+
+// BackChannelLogoutFailed marks the OIDCSession as not notified about the logout,
+// because the back-channel logout token could not be delivered to the client
+// after all retries of the notification handler.
+func (c *Commands) BackChannelLogoutFailed(ctx context.Context, oidcSessionID, resourceOwner string, sendErr error) (err error) {
+	writeModel := NewOIDCSessionWriteModel(oidcSessionID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return err
+	}
+	if writeModel.State == domain.OIDCSessionStateUnspecified {
+		return zerrors.ThrowNotFound(nil, "OIDCS-1QSrF", "Errors.OIDCSession.Token.Invalid")
+	}
+	return c.pushAppendAndReduce(ctx, writeModel, oidcsession.NewBackChannelLogoutFailedEvent(ctx, writeModel.aggregate, writeModel.ClientID, sendErr))
+}
+
 func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, resourceOwner string, pending ...eventstore.Command) (*OIDCSessionEvents, error) {
 	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime, err := c.tokenTokenLifetimes(ctx)
 	if err != nil {
@@ -367,6 +400,7 @@ func (c *OIDCSessionEvents) AddSession(
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	dpopJKT string,
 ) {
 	c.events = append(c.events, oidcsession.NewAddedEvent(
 		ctx,
@@ -382,6 +416,7 @@ func (c *OIDCSessionEvents) AddSession(
 		nonce,
 		preferredLanguage,
 		userAgent,
+		dpopJKT,
 	))
 }
 
@@ -473,6 +508,7 @@ func (c *OIDCSessionEvents) PushEvents(ctx context.Context) (*OIDCSession, error
 		Reason:            c.oidcSessionWriteModel.AccessTokenReason,
 		Actor:             c.oidcSessionWriteModel.AccessTokenActor,
 		RefreshToken:      c.refreshToken,
+		DPoPJKT:           c.oidcSessionWriteModel.DPoPJKT,
 	}
 	if c.accessTokenID != "" {
 		// prefix the returned id with the oidcSessionID so that we can retrieve it later on
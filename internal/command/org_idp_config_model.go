@@ -88,6 +88,16 @@ func (wm *OrgIDPConfigWriteModel) Reduce() error {
 	return wm.IDPConfigWriteModel.Reduce()
 }
 
+// SnapshotType implements [eventstore.Snapshotter]
+func (wm *OrgIDPConfigWriteModel) SnapshotType() string {
+	return "org_idp_config:" + wm.ConfigID
+}
+
+// SnapshotVersion implements [eventstore.Snapshotter]
+func (wm *OrgIDPConfigWriteModel) SnapshotVersion() uint16 {
+	return 1
+}
+
 func (wm *OrgIDPConfigWriteModel) AppendAndReduce(events ...eventstore.Event) error {
 	wm.AppendEvents(events...)
 	return wm.Reduce()
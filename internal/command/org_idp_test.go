@@ -210,6 +210,8 @@ func TestCommandSide_AddOrgGenericOAuthIDP(t *testing.T) {
 							"user",
 							"idAttribute",
 							nil,
+							nil,
+							nil,
 							idp.Options{},
 						),
 					),
@@ -256,6 +258,8 @@ func TestCommandSide_AddOrgGenericOAuthIDP(t *testing.T) {
 							"user",
 							"idAttribute",
 							[]string{"user"},
+							nil,
+							nil,
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -520,6 +524,8 @@ func TestCommandSide_UpdateOrgGenericOAuthIDP(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -563,6 +569,8 @@ func TestCommandSide_UpdateOrgGenericOAuthIDP(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -763,6 +771,8 @@ func TestCommandSide_AddOrgGenericOIDCIDP(t *testing.T) {
 							},
 							nil,
 							false,
+							nil,
+							nil,
 							idp.Options{},
 						),
 					),
@@ -804,6 +814,8 @@ func TestCommandSide_AddOrgGenericOIDCIDP(t *testing.T) {
 							},
 							[]string{openid.ScopeOpenID},
 							true,
+							nil,
+							nil,
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -995,6 +1007,8 @@ func TestCommandSide_UpdateOrgGenericOIDCIDP(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -1033,6 +1047,8 @@ func TestCommandSide_UpdateOrgGenericOIDCIDP(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -1225,6 +1241,8 @@ func TestCommandSide_MigrateOrgGenericOIDCToAzureADProvider(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -1284,6 +1302,8 @@ func TestCommandSide_MigrateOrgGenericOIDCToAzureADProvider(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -1452,6 +1472,8 @@ func TestCommandSide_MigrateOrgOIDCToGoogleIDP(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -1505,6 +1527,8 @@ func TestCommandSide_MigrateOrgOIDCToGoogleIDP(t *testing.T) {
 								},
 								nil,
 								false,
+								nil,
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -3749,6 +3773,318 @@ func TestCommandSide_UpdateOrgGitLabSelfHostedIDP(t *testing.T) {
 	}
 }
 
+func TestCommandSide_AddOrgKeycloakIDP(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		provider KeycloakProvider
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		res        res
+	}{
+		{
+			"invalid realm",
+			expectEventstore(),
+			args{
+				ctx: context.Background(),
+				provider: KeycloakProvider{
+					Name:    "name",
+					BaseURL: "https://keycloak.example.com",
+					Realm:   " ",
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "ORG-5Fx3r", ""))
+				},
+			},
+		},
+		{
+			name: "ok with realm and scopes",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					org.NewKeycloakIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+						"id1",
+						"name",
+						"https://keycloak.example.com",
+						"realm",
+						"clientID",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("clientSecret"),
+						},
+						[]string{"openid", "profile", "email"},
+						idp.Options{},
+					),
+				),
+			),
+			args: args{
+				ctx: context.Background(),
+				provider: KeycloakProvider{
+					Name:         "name",
+					BaseURL:      "https://keycloak.example.com",
+					Realm:        " realm ",
+					ClientID:     "clientID",
+					ClientSecret: "clientSecret",
+					Scopes:       []string{"openid", "profile", "email"},
+				},
+			},
+			res: res{
+				id:   "id1",
+				want: &domain.ObjectDetails{ResourceOwner: "org1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:          tt.eventstore(t),
+				idGenerator:         id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+				idpConfigEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			id, got, err := c.AddOrgKeycloakProvider(tt.args.ctx, "org1", tt.args.provider)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_UpdateOrgKeycloakIDP_realmAndScopes(t *testing.T) {
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(
+					org.NewKeycloakIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+						"id1",
+						"name",
+						"https://keycloak.example.com",
+						"realm",
+						"clientID",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("clientSecret"),
+						},
+						[]string{"openid"},
+						idp.Options{},
+					)),
+			),
+			expectPush(
+				func() eventstore.Command {
+					event, _ := org.NewKeycloakIDPChangedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+						"id1",
+						[]idp.KeycloakIDPChanges{
+							idp.ChangeKeycloakRealm("newRealm"),
+							idp.ChangeKeycloakScopes([]string{"openid", "profile"}),
+						},
+					)
+					return event
+				}(),
+			),
+		)(t),
+	}
+	got, err := c.UpdateOrgKeycloakProvider(context.Background(), "org1", "id1", KeycloakProvider{
+		Name:     "name",
+		BaseURL:  "https://keycloak.example.com",
+		Realm:    "newRealm",
+		ClientID: "clientID",
+		Scopes:   []string{"openid", "profile"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.ObjectDetails{ResourceOwner: "org1"}, got)
+}
+
+func TestCommandSide_AddOrgOktaIDP(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		provider OktaProvider
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		res        res
+	}{
+		{
+			"invalid issuer",
+			expectEventstore(),
+			args{
+				ctx: context.Background(),
+				provider: OktaProvider{
+					Name:                  "name",
+					AuthorizationServerID: "default",
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "ORG-z6ZP5", ""))
+				},
+			},
+		},
+		{
+			name: "ok org authorization server",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					org.NewOktaIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+						"id1",
+						"name",
+						"https://example.okta.com",
+						"",
+						"clientID",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("clientSecret"),
+						},
+						nil,
+						idp.Options{},
+					),
+				),
+			),
+			args: args{
+				ctx: context.Background(),
+				provider: OktaProvider{
+					Name:         "name",
+					Issuer:       "https://example.okta.com",
+					ClientID:     "clientID",
+					ClientSecret: "clientSecret",
+				},
+			},
+			res: res{
+				id:   "id1",
+				want: &domain.ObjectDetails{ResourceOwner: "org1"},
+			},
+		},
+		{
+			name: "ok custom authorization server",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					org.NewOktaIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+						"id1",
+						"name",
+						"https://example.okta.com",
+						"aus1",
+						"clientID",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("clientSecret"),
+						},
+						nil,
+						idp.Options{},
+					),
+				),
+			),
+			args: args{
+				ctx: context.Background(),
+				provider: OktaProvider{
+					Name:                  "name",
+					Issuer:                "https://example.okta.com",
+					AuthorizationServerID: " aus1 ",
+					ClientID:              "clientID",
+					ClientSecret:          "clientSecret",
+				},
+			},
+			res: res{
+				id:   "id1",
+				want: &domain.ObjectDetails{ResourceOwner: "org1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:          tt.eventstore(t),
+				idGenerator:         id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+				idpConfigEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			id, got, err := c.AddOrgOktaProvider(tt.args.ctx, "org1", tt.args.provider)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_UpdateOrgOktaIDP_authorizationServer(t *testing.T) {
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(
+					org.NewOktaIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+						"id1",
+						"name",
+						"https://example.okta.com",
+						"",
+						"clientID",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("clientSecret"),
+						},
+						nil,
+						idp.Options{},
+					)),
+			),
+			expectPush(
+				func() eventstore.Command {
+					event, _ := org.NewOktaIDPChangedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+						"id1",
+						[]idp.OktaIDPChanges{
+							idp.ChangeOktaAuthorizationServerID("aus1"),
+						},
+					)
+					return event
+				}(),
+			),
+		)(t),
+	}
+	got, err := c.UpdateOrgOktaProvider(context.Background(), "org1", "id1", OktaProvider{
+		Name:                  "name",
+		Issuer:                "https://example.okta.com",
+		AuthorizationServerID: "aus1",
+		ClientID:              "clientID",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.ObjectDetails{ResourceOwner: "org1"}, got)
+}
+
 func TestCommandSide_AddOrgGoogleIDP(t *testing.T) {
 	type fields struct {
 		eventstore   func(*testing.T) *eventstore.Eventstore
@@ -4305,6 +4641,39 @@ func TestCommandSide_AddOrgLDAPIDP(t *testing.T) {
 				},
 			},
 		},
+		{
+			"invalid group mapping",
+			fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+			},
+			args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				provider: LDAPProvider{
+					Name:              "name",
+					Servers:           []string{"server"},
+					BindDN:            "binddn",
+					BaseDN:            "baseDN",
+					BindPassword:      "password",
+					UserBase:          "user",
+					UserObjectClasses: []string{"object"},
+					UserFilters:       []string{"filter"},
+					Sync: &idp.LDAPSync{
+						Enabled:        true,
+						GroupAttribute: "memberOf",
+						GroupMappings: []idp.LDAPGroupMapping{
+							{Group: "cn=admins,dc=example,dc=com", ProjectID: "project1"},
+						},
+					},
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Eeph3", ""))
+				},
+			},
+		},
 		{
 			name: "ok",
 			fields: fields{
@@ -4329,6 +4698,7 @@ func TestCommandSide_AddOrgLDAPIDP(t *testing.T) {
 							[]string{"filter"},
 							time.Second*30,
 							idp.LDAPAttributes{},
+							nil,
 							idp.Options{},
 						),
 					),
@@ -4395,6 +4765,7 @@ func TestCommandSide_AddOrgLDAPIDP(t *testing.T) {
 								AvatarURLAttribute:         "avatarURL",
 								ProfileAttribute:           "profile",
 							},
+							nil,
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -4449,6 +4820,74 @@ func TestCommandSide_AddOrgLDAPIDP(t *testing.T) {
 				want: &domain.ObjectDetails{ResourceOwner: "org1"},
 			},
 		},
+		{
+			name: "ok with sync",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						org.NewLDAPIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"id1",
+							"name",
+							[]string{"server"},
+							false,
+							"baseDN",
+							"dn",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("password"),
+							},
+							"user",
+							[]string{"object"},
+							[]string{"filter"},
+							time.Second*30,
+							idp.LDAPAttributes{},
+							&idp.LDAPSync{
+								Enabled:           true,
+								DeactivateMissing: true,
+								GroupAttribute:    "memberOf",
+								GroupMappings: []idp.LDAPGroupMapping{
+									{Group: "cn=admins,dc=example,dc=com", ProjectID: "project1", Roles: []string{"admin"}},
+								},
+							},
+							idp.Options{},
+						),
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				provider: LDAPProvider{
+					Name:              "name",
+					Servers:           []string{"server"},
+					StartTLS:          false,
+					BaseDN:            "baseDN",
+					BindDN:            "dn",
+					BindPassword:      "password",
+					UserBase:          "user",
+					UserObjectClasses: []string{"object"},
+					UserFilters:       []string{"filter"},
+					Timeout:           time.Second * 30,
+					Sync: &idp.LDAPSync{
+						Enabled:           true,
+						DeactivateMissing: true,
+						GroupAttribute:    " memberOf ",
+						GroupMappings: []idp.LDAPGroupMapping{
+							{Group: "cn=admins,dc=example,dc=com", ProjectID: "project1", Roles: []string{"admin"}},
+						},
+					},
+				},
+			},
+			res: res{
+				id:   "id1",
+				want: &domain.ObjectDetails{ResourceOwner: "org1"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -4707,6 +5146,7 @@ func TestCommandSide_UpdateOrgLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								idp.LDAPAttributes{},
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -4755,6 +5195,7 @@ func TestCommandSide_UpdateOrgLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								idp.LDAPAttributes{},
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -5418,6 +5859,7 @@ func TestCommandSide_AddOrgSAMLIDP(t *testing.T) {
 							false,
 							nil,
 							"",
+							nil,
 							idp.Options{},
 						),
 					),
@@ -5459,6 +5901,7 @@ func TestCommandSide_AddOrgSAMLIDP(t *testing.T) {
 							true,
 							gu.Ptr(domain.SAMLNameIDFormatTransient),
 							"customAttribute",
+							nil,
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -5635,6 +6078,7 @@ func TestCommandSide_UpdateOrgSAMLIDP(t *testing.T) {
 								false,
 								nil,
 								"",
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -5674,6 +6118,7 @@ func TestCommandSide_UpdateOrgSAMLIDP(t *testing.T) {
 								false,
 								gu.Ptr(domain.SAMLNameIDFormatUnspecified),
 								"",
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -5822,6 +6267,7 @@ func TestCommandSide_RegenerateOrgSAMLProviderCertificate(t *testing.T) {
 								false,
 								gu.Ptr(domain.SAMLNameIDFormatUnspecified),
 								"",
+								nil,
 								idp.Options{},
 							)),
 					),
@@ -108,6 +108,7 @@ func TestAddMember(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						}, nil
 					}).
@@ -148,6 +149,7 @@ func TestAddMember(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						}, nil
 					}).
@@ -65,6 +65,8 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
 							),
 						),
 					),
@@ -96,6 +98,8 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 							&org.NewAggregate("org1").Aggregate,
 							8,
 							true, true, true, true,
+							0,
+							false,
 						),
 					),
 				),
@@ -219,6 +223,8 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
 							),
 						),
 					),
@@ -250,6 +256,8 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
 							),
 						),
 					),
@@ -362,6 +370,8 @@ func TestCommandSide_RemovePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
 							),
 						),
 					),
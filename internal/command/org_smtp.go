@@ -0,0 +1,324 @@
+package command
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (c *Commands) AddOrgSMTPConfig(ctx context.Context, orgID string, config *smtp.Config) (string, *domain.ObjectDetails, error) {
+	if orgID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "ORG-Smz8d", "Errors.ResourceOwnerMissing")
+	}
+	from := strings.TrimSpace(config.From)
+	if from == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "ORG-Hwq4e", "Errors.Invalid.Argument")
+	}
+	hostAndPort := strings.TrimSpace(config.SMTP.Host)
+	if _, _, err := net.SplitHostPort(hostAndPort); err != nil {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "ORG-Pq2nd", "Errors.Invalid.Argument")
+	}
+
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var smtpPassword *crypto.CryptoValue
+	if config.SMTP.Password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(config.SMTP.Password), c.smtpEncryption)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id, senderDomain(from))
+	if err != nil {
+		return "", nil, err
+	}
+	if !writeModel.domainVerified {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "ORG-Vb3ke", "Errors.SMTPConfig.SenderAdressNotOrgDomain")
+	}
+
+	err = c.pushAppendAndReduce(ctx, writeModel, org.NewSMTPConfigAddedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		id,
+		strings.TrimSpace(config.Description),
+		config.Tls,
+		from,
+		config.FromName,
+		strings.TrimSpace(config.ReplyToAddress),
+		hostAndPort,
+		config.SMTP.User,
+		smtpPassword,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeOrgSMTPConfig(ctx context.Context, orgID, id string, config *smtp.Config) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Kd9wq", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-j2Nfa", "Errors.IDMissing")
+	}
+	from := strings.TrimSpace(config.From)
+	if from == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Ue3mc", "Errors.Invalid.Argument")
+	}
+	hostAndPort := strings.TrimSpace(config.SMTP.Host)
+	if _, _, err := net.SplitHostPort(hostAndPort); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Xo1zt", "Errors.Invalid.Argument")
+	}
+
+	var smtpPassword *crypto.CryptoValue
+	var err error
+	if config.SMTP.Password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(config.SMTP.Password), c.smtpEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id, senderDomain(from))
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Gm6sx", "Errors.SMTPConfig.NotFound")
+	}
+	if !writeModel.domainVerified {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Ry7ct", "Errors.SMTPConfig.SenderAdressNotOrgDomain")
+	}
+
+	changedEvent, hasChanged, err := writeModel.NewChangedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		id,
+		strings.TrimSpace(config.Description),
+		config.Tls,
+		from,
+		config.FromName,
+		strings.TrimSpace(config.ReplyToAddress),
+		hostAndPort,
+		config.SMTP.User,
+		smtpPassword,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Fh2vm", "Errors.NoChangesFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeOrgSMTPConfigPassword(ctx context.Context, orgID, id, password string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Qa8cn", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Lz4pe", "Errors.IDMissing")
+	}
+	writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Wc5ux", "Errors.SMTPConfig.NotFound")
+	}
+
+	var smtpPassword *crypto.CryptoValue
+	if password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(password), c.smtpEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, org.NewSMTPConfigPasswordChangedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		id,
+		smtpPassword,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ActivateOrgSMTPConfig activates the configuration of the organization.
+// If activatedID is set, the currently active configuration is deactivated first,
+// because an organization can only have a single active configuration.
+func (c *Commands) ActivateOrgSMTPConfig(ctx context.Context, orgID, id, activatedID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Ot2bn", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Vy6qk", "Errors.IDMissing")
+	}
+	if activatedID != "" && activatedID != id {
+		if _, err := c.DeactivateOrgSMTPConfig(ctx, orgID, activatedID); err != nil {
+			return nil, err
+		}
+	}
+
+	writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Ib9rf", "Errors.SMTPConfig.NotFound")
+	}
+	if writeModel.State == domain.SMTPConfigStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Nd3xe", "Errors.SMTPConfig.AlreadyActive")
+	}
+
+	err = c.pushAppendAndReduce(ctx, writeModel, org.NewSMTPConfigActivatedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		id,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) DeactivateOrgSMTPConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Zs1mq", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Eu5ha", "Errors.IDMissing")
+	}
+
+	writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Tp7wd", "Errors.SMTPConfig.NotFound")
+	}
+	if writeModel.State == domain.SMTPConfigStateInactive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Cj8lb", "Errors.SMTPConfig.AlreadyDeactivated")
+	}
+
+	err = c.pushAppendAndReduce(ctx, writeModel, org.NewSMTPConfigDeactivatedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		id,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveOrgSMTPConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Bf4uo", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Ma2gs", "Errors.IDMissing")
+	}
+
+	writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Ys3np", "Errors.SMTPConfig.NotFound")
+	}
+
+	err = c.pushAppendAndReduce(ctx, writeModel, org.NewSMTPConfigRemovedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		id,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// TestOrgSMTPConfig sends a test mail to the passed email address using the passed configuration without persisting it.
+// If no password is passed, the password of the stored configuration of the organization identified by id is used.
+// The stored password is only sent to the stored host, so it cannot be leaked to another server.
+func (c *Commands) TestOrgSMTPConfig(ctx context.Context, orgID, id, email string, config *smtp.Config) error {
+	if email == "" {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Jr5yv", "Errors.SMTPConfig.TestEmailMissing")
+	}
+	if config.SMTP.Password == "" && id != "" {
+		writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id, "")
+		if err != nil {
+			return err
+		}
+		if !writeModel.State.Exists() {
+			return zerrors.ThrowNotFound(nil, "ORG-Aq4ob", "Errors.SMTPConfig.NotFound")
+		}
+		if writeModel.Host != config.SMTP.Host {
+			return zerrors.ThrowInvalidArgument(nil, "ORG-Oh7ae", "Errors.SMTPConfig.PasswordMissing")
+		}
+		config.SMTP.Password, err = decryptSMTPPassword(writeModel.Password, c.smtpEncryption)
+		if err != nil {
+			return err
+		}
+	}
+	return testSMTPConfig(email, config)
+}
+
+// TestOrgSMTPConfigByID sends a test mail to the passed email address using the stored configuration of the organization.
+func (c *Commands) TestOrgSMTPConfigByID(ctx context.Context, orgID, id, email string) error {
+	if id == "" {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Hk2td", "Errors.IDMissing")
+	}
+	if email == "" {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Gx8fr", "Errors.SMTPConfig.TestEmailMissing")
+	}
+	writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id, "")
+	if err != nil {
+		return err
+	}
+	if !writeModel.State.Exists() {
+		return zerrors.ThrowNotFound(nil, "ORG-Di6ma", "Errors.SMTPConfig.NotFound")
+	}
+	password, err := decryptSMTPPassword(writeModel.Password, c.smtpEncryption)
+	if err != nil {
+		return err
+	}
+	return testSMTPConfig(email, &smtp.Config{
+		Description:    writeModel.Description,
+		Tls:            writeModel.TLS,
+		From:           writeModel.SenderAddress,
+		FromName:       writeModel.SenderName,
+		ReplyToAddress: writeModel.ReplyToAddress,
+		SMTP: smtp.SMTP{
+			Host:     writeModel.Host,
+			User:     writeModel.User,
+			Password: password,
+		},
+	})
+}
+
+func (c *Commands) getOrgSMTPConfig(ctx context.Context, orgID, id, domain string) (*OrgSMTPConfigWriteModel, error) {
+	writeModel := NewOrgSMTPConfigWriteModel(orgID, id, domain)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
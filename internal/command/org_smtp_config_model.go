@@ -0,0 +1,206 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgSMTPConfigWriteModel struct {
+	eventstore.WriteModel
+
+	ID             string
+	Description    string
+	TLS            bool
+	Host           string
+	User           string
+	Password       *crypto.CryptoValue
+	SenderAddress  string
+	SenderName     string
+	ReplyToAddress string
+	State          domain.SMTPConfigState
+
+	domain         string
+	domainVerified bool
+}
+
+func NewOrgSMTPConfigWriteModel(orgID, id, domain string) *OrgSMTPConfigWriteModel {
+	return &OrgSMTPConfigWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		ID:     id,
+		domain: domain,
+	}
+}
+
+func (wm *OrgSMTPConfigWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.DomainVerifiedEvent:
+			if e.Domain != wm.domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *org.DomainRemovedEvent:
+			if e.Domain != wm.domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		default:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OrgSMTPConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.SMTPConfigAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.reduceSMTPConfigAddedEvent(e)
+		case *org.SMTPConfigChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.reduceSMTPConfigChangedEvent(e)
+		case *org.SMTPConfigPasswordChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Password = e.Password
+		case *org.SMTPConfigActivatedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.State = domain.SMTPConfigStateActive
+		case *org.SMTPConfigDeactivatedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.State = domain.SMTPConfigStateInactive
+		case *org.SMTPConfigRemovedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.reduceSMTPConfigRemovedEvent()
+		case *org.DomainVerifiedEvent:
+			wm.domainVerified = true
+		case *org.DomainRemovedEvent:
+			wm.domainVerified = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgSMTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.SMTPConfigAddedEventType,
+			org.SMTPConfigChangedEventType,
+			org.SMTPConfigPasswordChangedEventType,
+			org.SMTPConfigActivatedEventType,
+			org.SMTPConfigDeactivatedEventType,
+			org.SMTPConfigRemovedEventType,
+			org.OrgDomainVerifiedEventType,
+			org.OrgDomainRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgSMTPConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, description string, tls bool, fromAddress, fromName, replyToAddress, smtpHost, smtpUser string, smtpPassword *crypto.CryptoValue) (*org.SMTPConfigChangedEvent, bool, error) {
+	changes := make([]org.SMTPConfigChanges, 0)
+
+	if wm.Description != description {
+		changes = append(changes, org.ChangeSMTPConfigDescription(description))
+	}
+	if wm.TLS != tls {
+		changes = append(changes, org.ChangeSMTPConfigTLS(tls))
+	}
+	if wm.SenderAddress != fromAddress {
+		changes = append(changes, org.ChangeSMTPConfigFromAddress(fromAddress))
+	}
+	if wm.SenderName != fromName {
+		changes = append(changes, org.ChangeSMTPConfigFromName(fromName))
+	}
+	if wm.ReplyToAddress != replyToAddress {
+		changes = append(changes, org.ChangeSMTPConfigReplyToAddress(replyToAddress))
+	}
+	if wm.Host != smtpHost {
+		changes = append(changes, org.ChangeSMTPConfigSMTPHost(smtpHost))
+	}
+	if wm.User != smtpUser {
+		changes = append(changes, org.ChangeSMTPConfigSMTPUser(smtpUser))
+	}
+	if smtpPassword != nil {
+		changes = append(changes, org.ChangeSMTPConfigSMTPPassword(smtpPassword))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewSMTPConfigChangeEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *OrgSMTPConfigWriteModel) reduceSMTPConfigAddedEvent(e *org.SMTPConfigAddedEvent) {
+	wm.Description = e.Description
+	wm.TLS = e.TLS
+	wm.Host = e.Host
+	wm.User = e.User
+	wm.Password = e.Password
+	wm.SenderAddress = e.SenderAddress
+	wm.SenderName = e.SenderName
+	wm.ReplyToAddress = e.ReplyToAddress
+	wm.State = domain.SMTPConfigStateInactive
+}
+
+func (wm *OrgSMTPConfigWriteModel) reduceSMTPConfigChangedEvent(e *org.SMTPConfigChangedEvent) {
+	if e.Description != nil {
+		wm.Description = *e.Description
+	}
+	if e.TLS != nil {
+		wm.TLS = *e.TLS
+	}
+	if e.Host != nil {
+		wm.Host = *e.Host
+	}
+	if e.User != nil {
+		wm.User = *e.User
+	}
+	if e.Password != nil {
+		wm.Password = e.Password
+	}
+	if e.FromAddress != nil {
+		wm.SenderAddress = *e.FromAddress
+	}
+	if e.FromName != nil {
+		wm.SenderName = *e.FromName
+	}
+	if e.ReplyToAddress != nil {
+		wm.ReplyToAddress = *e.ReplyToAddress
+	}
+}
+
+func (wm *OrgSMTPConfigWriteModel) reduceSMTPConfigRemovedEvent() {
+	wm.Description = ""
+	wm.TLS = false
+	wm.SenderName = ""
+	wm.SenderAddress = ""
+	wm.ReplyToAddress = ""
+	wm.Host = ""
+	wm.User = ""
+	wm.Password = nil
+	wm.State = domain.SMTPConfigStateRemoved
+}
@@ -1194,6 +1194,7 @@ func TestCommandSide_RemoveOrg(t *testing.T) {
 								"description",
 								false,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -1215,10 +1216,10 @@ func TestCommandSide_RemoveOrg(t *testing.T) {
 					),
 					expectFilter(
 						eventFromEventPusher(
-							project.NewSAMLConfigAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "app1", "entity1", []byte{}, ""),
+							project.NewSAMLConfigAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "app1", "entity1", []byte{}, "", false),
 						),
 						eventFromEventPusher(
-							project.NewSAMLConfigAddedEvent(context.Background(), &project.NewAggregate("project2", "org1").Aggregate, "app2", "entity2", []byte{}, ""),
+							project.NewSAMLConfigAddedEvent(context.Background(), &project.NewAggregate("project2", "org1").Aggregate, "app2", "entity2", []byte{}, "", false),
 						),
 					),
 					expectPush(
@@ -1599,6 +1600,7 @@ func TestCommandSide_SetUpOrg(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 						eventFromEventPusher(
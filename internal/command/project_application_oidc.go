@@ -16,21 +16,25 @@ import (
 
 type addOIDCApp struct {
 	AddApp
-	Version                     domain.OIDCVersion
-	RedirectUris                []string
-	ResponseTypes               []domain.OIDCResponseType
-	GrantTypes                  []domain.OIDCGrantType
-	ApplicationType             domain.OIDCApplicationType
-	AuthMethodType              domain.OIDCAuthMethodType
-	PostLogoutRedirectUris      []string
-	DevMode                     bool
-	AccessTokenType             domain.OIDCTokenType
-	AccessTokenRoleAssertion    bool
-	IDTokenRoleAssertion        bool
-	IDTokenUserinfoAssertion    bool
-	ClockSkew                   time.Duration
-	AdditionalOrigins           []string
-	SkipSuccessPageForNativeApp bool
+	Version                          domain.OIDCVersion
+	RedirectUris                     []string
+	ResponseTypes                    []domain.OIDCResponseType
+	GrantTypes                       []domain.OIDCGrantType
+	ApplicationType                  domain.OIDCApplicationType
+	AuthMethodType                   domain.OIDCAuthMethodType
+	PostLogoutRedirectUris           []string
+	DevMode                          bool
+	AccessTokenType                  domain.OIDCTokenType
+	AccessTokenRoleAssertion         bool
+	IDTokenRoleAssertion             bool
+	IDTokenUserinfoAssertion         bool
+	ClockSkew                        time.Duration
+	AdditionalOrigins                []string
+	SkipSuccessPageForNativeApp      bool
+	BackChannelLogoutURI             string
+	BackChannelLogoutSessionRequired bool
+	DPoPBoundAccessTokens            bool
+	RequirePushedAuthRequests        bool
 
 	ClientID          string
 	ClientSecret      string
@@ -62,6 +66,10 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 			return nil, zerrors.ThrowInvalidArgument(nil, "V2-sLpW1", "Errors.Invalid.Argument")
 		}
 
+		if !domain.IsValidBackChannelLogoutURI(strings.TrimSpace(app.BackChannelLogoutURI)) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "V2-Bq2sZ", "Errors.Invalid.Argument")
+		}
+
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) (_ []eventstore.Command, err error) {
 			project, err := projectWriteModel(ctx, filter, app.Aggregate.ID, app.Aggregate.ResourceOwner)
 			if err != nil || !project.State.Valid() {
@@ -108,6 +116,10 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 					app.ClockSkew,
 					trimStringSliceWhiteSpaces(app.AdditionalOrigins),
 					app.SkipSuccessPageForNativeApp,
+					strings.TrimSpace(app.BackChannelLogoutURI),
+					app.BackChannelLogoutSessionRequired,
+					app.DPoPBoundAccessTokens,
+					app.RequirePushedAuthRequests,
 				),
 			}, nil
 		}, nil
@@ -193,6 +205,10 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 		oidcApp.ClockSkew,
 		trimStringSliceWhiteSpaces(oidcApp.AdditionalOrigins),
 		oidcApp.SkipNativeAppSuccessPage,
+		strings.TrimSpace(oidcApp.BackChannelLogoutURI),
+		oidcApp.BackChannelLogoutSessionRequired,
+		oidcApp.DPoPBoundAccessTokens,
+		oidcApp.RequirePushedAuthRequests,
 	))
 
 	addedApplication.AppID = oidcApp.AppID
@@ -245,6 +261,10 @@ func (c *Commands) ChangeOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		oidc.ClockSkew,
 		trimStringSliceWhiteSpaces(oidc.AdditionalOrigins),
 		oidc.SkipNativeAppSuccessPage,
+		strings.TrimSpace(oidc.BackChannelLogoutURI),
+		oidc.BackChannelLogoutSessionRequired,
+		oidc.DPoPBoundAccessTokens,
+		oidc.RequirePushedAuthRequests,
 	)
 	if err != nil {
 		return nil, err
@@ -174,6 +174,10 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						[]string{"https://sub.test.ch"},
 						false,
+						"",
+						false,
+						false,
+						false,
 					),
 				},
 			},
@@ -239,6 +243,10 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						nil,
 						false,
+						"",
+						false,
+						false,
+						false,
 					),
 				},
 			},
@@ -304,6 +312,10 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						nil,
 						false,
+						"",
+						false,
+						false,
+						false,
 					),
 				},
 			},
@@ -450,6 +462,10 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							true,
+							"",
+							false,
+							false,
+							false,
 						),
 					),
 				),
@@ -548,6 +564,10 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							true,
+							"",
+							false,
+							false,
+							false,
 						),
 					),
 				),
@@ -780,6 +800,10 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								"",
+								false,
+								false,
+								false,
 							),
 						),
 					),
@@ -849,6 +873,10 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								"",
+								false,
+								false,
+								false,
 							),
 						),
 					),
@@ -918,6 +946,10 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								"",
+								false,
+								false,
+								false,
 							),
 						),
 					),
@@ -1103,6 +1135,10 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								false,
+								"",
+								false,
+								false,
+								false,
 							),
 						),
 					),
@@ -1260,6 +1296,10 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							false,
+							"",
+							false,
+							false,
+							false,
 						),
 					),
 				),
@@ -1295,6 +1335,10 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							false,
+							"",
+							false,
+							false,
+							false,
 						),
 					),
 				),
@@ -1332,6 +1376,10 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							false,
+							"",
+							false,
+							false,
+							false,
 						),
 					),
 				),
@@ -76,6 +76,7 @@ func (c *Commands) addSAMLApplication(ctx context.Context, projectAgg *eventstor
 			string(entity.EntityID),
 			samlApp.Metadata,
 			samlApp.MetadataURL,
+			samlApp.AllowUnsignedLogout,
 		),
 	}, nil
 }
@@ -116,7 +117,9 @@ func (c *Commands) ChangeSAMLApplication(ctx context.Context, samlApp *domain.SA
 		samlApp.AppID,
 		string(entity.EntityID),
 		samlApp.Metadata,
-		samlApp.MetadataURL)
+		samlApp.MetadataURL,
+		samlApp.AllowUnsignedLogout,
+	)
 	if err != nil {
 		return nil, err
 	}
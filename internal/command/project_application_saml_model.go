@@ -12,11 +12,12 @@ import (
 type SAMLApplicationWriteModel struct {
 	eventstore.WriteModel
 
-	AppID       string
-	AppName     string
-	EntityID    string
-	Metadata    []byte
-	MetadataURL string
+	AppID               string
+	AppName             string
+	EntityID            string
+	Metadata            []byte
+	MetadataURL         string
+	AllowUnsignedLogout bool
 
 	State domain.AppState
 	saml  bool
@@ -121,6 +122,7 @@ func (wm *SAMLApplicationWriteModel) appendAddSAMLEvent(e *project.SAMLConfigAdd
 	wm.Metadata = e.Metadata
 	wm.MetadataURL = e.MetadataURL
 	wm.EntityID = e.EntityID
+	wm.AllowUnsignedLogout = e.AllowUnsignedLogout
 }
 
 func (wm *SAMLApplicationWriteModel) appendChangeSAMLEvent(e *project.SAMLConfigChangedEvent) {
@@ -134,6 +136,9 @@ func (wm *SAMLApplicationWriteModel) appendChangeSAMLEvent(e *project.SAMLConfig
 	if e.EntityID != "" {
 		wm.EntityID = e.EntityID
 	}
+	if e.AllowUnsignedLogout != nil {
+		wm.AllowUnsignedLogout = *e.AllowUnsignedLogout
+	}
 }
 
 func (wm *SAMLApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -161,6 +166,7 @@ func (wm *SAMLApplicationWriteModel) NewChangedEvent(
 	entityID string,
 	metadata []byte,
 	metadataURL string,
+	allowUnsignedLogout bool,
 ) (*project.SAMLConfigChangedEvent, bool, error) {
 	changes := make([]project.SAMLConfigChanges, 0)
 	var err error
@@ -173,6 +179,9 @@ func (wm *SAMLApplicationWriteModel) NewChangedEvent(
 	if wm.EntityID != entityID {
 		changes = append(changes, project.ChangeEntityID(entityID))
 	}
+	if wm.AllowUnsignedLogout != allowUnsignedLogout {
+		changes = append(changes, project.ChangeAllowUnsignedLogout(allowUnsignedLogout))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
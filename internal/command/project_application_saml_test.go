@@ -195,6 +195,7 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 							"https://test.com/saml/metadata",
 							testMetadata,
 							"",
+							false,
 						),
 					),
 				),
@@ -253,6 +254,7 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 							"https://test.com/saml/metadata",
 							testMetadata,
 							"http://localhost:8080/saml/metadata",
+							false,
 						),
 					),
 				),
@@ -473,6 +475,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"https://test.com/saml/metadata",
 								testMetadata,
 								"http://localhost:8080/saml/metadata",
+								false,
 							),
 						),
 					),
@@ -518,6 +521,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"https://test.com/saml/metadata",
 								testMetadata,
 								"",
+								false,
 							),
 						),
 					),
@@ -563,6 +567,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"https://test.com/saml/metadata",
 								testMetadata,
 								"http://localhost:8080/saml/metadata",
+								false,
 							),
 						),
 					),
@@ -629,6 +634,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"https://test.com/saml/metadata",
 								testMetadata,
 								"",
+								false,
 							),
 						),
 					),
@@ -675,6 +681,76 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change saml app, ok, allow unsigned logout",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewSAMLConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"https://test.com/saml/metadata",
+								testMetadata,
+								"",
+								false,
+							),
+						),
+					),
+					expectPush(
+						func() *project.SAMLConfigChangedEvent {
+							event, _ := project.NewSAMLConfigChangedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"https://test.com/saml/metadata",
+								[]project.SAMLConfigChanges{
+									project.ChangeAllowUnsignedLogout(true),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+				httpClient: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:               "app1",
+					AppName:             "app",
+					EntityID:            "https://test.com/saml/metadata",
+					Metadata:            testMetadata,
+					AllowUnsignedLogout: true,
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:               "app1",
+					AppName:             "app",
+					EntityID:            "https://test.com/saml/metadata",
+					Metadata:            testMetadata,
+					AllowUnsignedLogout: true,
+					State:               domain.AppStateActive,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
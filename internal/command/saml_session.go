@@ -0,0 +1,110 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddSAMLSession records that a SAML response for the user was issued to the service provider (app),
+// so the service provider can be logged out, when the user logs out.
+// The issuer is the entity id of ZITADEL used in the response, so the logout request is issued by the same entity.
+// The sessionID is optional and links the SAML session to the session, so it's logged out on the termination of the session.
+func (c *Commands) AddSAMLSession(ctx context.Context,
+	userID,
+	resourceOwner,
+	sessionID,
+	appID,
+	entityID,
+	issuer,
+	nameID string,
+	userAgent *domain.UserAgent,
+) (id string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || appID == "" || entityID == "" || issuer == "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "SAMLS-aD0wm", "Errors.Invalid.Argument")
+	}
+	id, err = c.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	id = IDPrefixV2 + id
+	writeModel := NewSAMLSessionWriteModel(id, resourceOwner)
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		samlsession.NewAddedEvent(ctx, writeModel.aggregate, userID, resourceOwner, sessionID, appID, entityID, issuer, nameID, userAgent),
+	)
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// RequestSAMLSessionLogout marks the SAML session as logged out by the service provider itself.
+// The provided data of the logout request are used to send the logout response,
+// after the other service providers of the user were logged out.
+func (c *Commands) RequestSAMLSessionLogout(ctx context.Context, samlSessionID, resourceOwner, requestID, relayState, binding, responseURL string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.activeSAMLSessionWriteModel(ctx, samlSessionID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, writeModel,
+		samlsession.NewLogoutRequestedEvent(ctx, writeModel.aggregate, requestID, relayState, binding, responseURL),
+	)
+}
+
+// SAMLSessionLogoutSent marks the SAML session as notified about the logout
+// by the logout request sent to the service provider over the front-channel.
+func (c *Commands) SAMLSessionLogoutSent(ctx context.Context, samlSessionID, resourceOwner, requestID, binding string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.activeSAMLSessionWriteModel(ctx, samlSessionID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, writeModel,
+		samlsession.NewLogoutSentEvent(ctx, writeModel.aggregate, requestID, binding),
+	)
+}
+
+// TerminateSAMLSession marks the SAML session as logged out at the service provider.
+// Terminating an already terminated session is a no-op.
+func (c *Commands) TerminateSAMLSession(ctx context.Context, samlSessionID, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewSAMLSessionWriteModel(samlSessionID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+	switch writeModel.State {
+	case domain.SAMLSessionStateUnspecified:
+		return zerrors.ThrowNotFound(nil, "SAMLS-SX28S", "Errors.SAMLSession.NotFound")
+	case domain.SAMLSessionStateTerminated:
+		return nil
+	}
+	return c.pushAppendAndReduce(ctx, writeModel, samlsession.NewTerminatedEvent(ctx, writeModel.aggregate))
+}
+
+func (c *Commands) activeSAMLSessionWriteModel(ctx context.Context, samlSessionID, resourceOwner string) (*SAMLSessionWriteModel, error) {
+	writeModel := NewSAMLSessionWriteModel(samlSessionID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	switch writeModel.State {
+	case domain.SAMLSessionStateUnspecified:
+		return nil, zerrors.ThrowNotFound(nil, "SAMLS-WDhld", "Errors.SAMLSession.NotFound")
+	case domain.SAMLSessionStateActive:
+		return writeModel, nil
+	default:
+		return nil, zerrors.ThrowPreconditionFailed(nil, "SAMLS-lDS0C", "Errors.SAMLSession.LoggedOut")
+	}
+}
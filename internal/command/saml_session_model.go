@@ -0,0 +1,80 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+)
+
+type SAMLSessionWriteModel struct {
+	eventstore.WriteModel
+
+	UserID            string
+	UserResourceOwner string
+	SessionID         string
+	AppID             string
+	EntityID          string
+	Issuer            string
+	NameID            string
+	UserAgent         *domain.UserAgent
+	State             domain.SAMLSessionState
+
+	aggregate *eventstore.Aggregate
+}
+
+func NewSAMLSessionWriteModel(id string, resourceOwner string) *SAMLSessionWriteModel {
+	return &SAMLSessionWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+		aggregate: &samlsession.NewAggregate(id, resourceOwner).Aggregate,
+	}
+}
+
+func (wm *SAMLSessionWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *samlsession.AddedEvent:
+			wm.reduceAdded(e)
+		case *samlsession.LogoutRequestedEvent:
+			wm.State = domain.SAMLSessionStateLogoutRequested
+		case *samlsession.LogoutSentEvent:
+			wm.State = domain.SAMLSessionStateLogoutPending
+		case *samlsession.TerminatedEvent:
+			wm.State = domain.SAMLSessionStateTerminated
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SAMLSessionWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(samlsession.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			samlsession.AddedType,
+			samlsession.LogoutRequestedType,
+			samlsession.LogoutSentType,
+			samlsession.TerminatedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+func (wm *SAMLSessionWriteModel) reduceAdded(e *samlsession.AddedEvent) {
+	wm.UserID = e.UserID
+	wm.UserResourceOwner = e.UserResourceOwner
+	wm.SessionID = e.SessionID
+	wm.AppID = e.AppID
+	wm.EntityID = e.EntityID
+	wm.Issuer = e.Issuer
+	wm.NameID = e.NameID
+	wm.UserAgent = e.UserAgent
+	wm.State = domain.SAMLSessionStateActive
+}
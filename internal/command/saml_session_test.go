@@ -0,0 +1,257 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func samlSessionAddedEvent() *samlsession.AddedEvent {
+	return samlsession.NewAddedEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate,
+		"userID", "org1", "sessionID", "appID", "https://sp.example.com/metadata", "https://zitadel.example.com/saml/v2/metadata", "user@example.com",
+		&domain.UserAgent{FingerprintID: gu.Ptr("browserFP")},
+	)
+}
+
+func TestCommands_AddSAMLSession(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx      context.Context
+		userID   string
+		appID    string
+		entityID string
+	}
+	type res struct {
+		id  string
+		err error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing app",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:    authz.WithInstanceID(context.Background(), "instanceID"),
+				userID: "userID",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "SAMLS-aD0wm", "Errors.Invalid.Argument"),
+			},
+		},
+		{
+			"added",
+			fields{
+				eventstore: expectEventstore(
+					expectPush(
+						samlSessionAddedEvent(),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "samlSessionID"),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instanceID"),
+				userID:   "userID",
+				appID:    "appID",
+				entityID: "https://sp.example.com/metadata",
+			},
+			res{
+				id: "V2_samlSessionID",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddSAMLSession(tt.args.ctx, tt.args.userID, "org1", "sessionID", tt.args.appID, tt.args.entityID, "https://zitadel.example.com/saml/v2/metadata", "user@example.com",
+				&domain.UserAgent{FingerprintID: gu.Ptr("browserFP")},
+			)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.id, got)
+		})
+	}
+}
+
+func TestCommands_RequestSAMLSessionLogout(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			"session not found",
+			expectEventstore(
+				expectFilter(),
+			),
+			zerrors.ThrowNotFound(nil, "SAMLS-WDhld", "Errors.SAMLSession.NotFound"),
+		},
+		{
+			"already logged out",
+			expectEventstore(
+				expectFilter(
+					eventFromEventPusher(samlSessionAddedEvent()),
+					eventFromEventPusher(
+						samlsession.NewTerminatedEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate),
+					),
+				),
+			),
+			zerrors.ThrowPreconditionFailed(nil, "SAMLS-lDS0C", "Errors.SAMLSession.LoggedOut"),
+		},
+		{
+			"requested",
+			expectEventstore(
+				expectFilter(
+					eventFromEventPusher(samlSessionAddedEvent()),
+				),
+				expectPush(
+					samlsession.NewLogoutRequestedEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate,
+						"requestID", "relayState", "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect", "https://sp.example.com/slo",
+					),
+				),
+			),
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.RequestSAMLSessionLogout(authz.WithInstanceID(context.Background(), "instanceID"), "V2_samlSessionID", "org1",
+				"requestID", "relayState", "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect", "https://sp.example.com/slo",
+			)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_SAMLSessionLogoutSent(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			"session not found",
+			expectEventstore(
+				expectFilter(),
+			),
+			zerrors.ThrowNotFound(nil, "SAMLS-WDhld", "Errors.SAMLSession.NotFound"),
+		},
+		{
+			"logout already sent",
+			expectEventstore(
+				expectFilter(
+					eventFromEventPusher(samlSessionAddedEvent()),
+					eventFromEventPusher(
+						samlsession.NewLogoutSentEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate,
+							"requestID", "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+						),
+					),
+				),
+			),
+			zerrors.ThrowPreconditionFailed(nil, "SAMLS-lDS0C", "Errors.SAMLSession.LoggedOut"),
+		},
+		{
+			"sent",
+			expectEventstore(
+				expectFilter(
+					eventFromEventPusher(samlSessionAddedEvent()),
+				),
+				expectPush(
+					samlsession.NewLogoutSentEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate,
+						"requestID", "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+					),
+				),
+			),
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.SAMLSessionLogoutSent(authz.WithInstanceID(context.Background(), "instanceID"), "V2_samlSessionID", "org1",
+				"requestID", "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+			)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_TerminateSAMLSession(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			"session not found",
+			expectEventstore(
+				expectFilter(),
+			),
+			zerrors.ThrowNotFound(nil, "SAMLS-SX28S", "Errors.SAMLSession.NotFound"),
+		},
+		{
+			"already terminated",
+			expectEventstore(
+				expectFilter(
+					eventFromEventPusher(samlSessionAddedEvent()),
+					eventFromEventPusher(
+						samlsession.NewTerminatedEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate),
+					),
+				),
+			),
+			nil,
+		},
+		{
+			"logout pending",
+			expectEventstore(
+				expectFilter(
+					eventFromEventPusher(samlSessionAddedEvent()),
+					eventFromEventPusher(
+						samlsession.NewLogoutSentEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate,
+							"requestID", "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+						),
+					),
+				),
+				expectPush(
+					samlsession.NewTerminatedEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate),
+				),
+			),
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.TerminateSAMLSession(authz.WithInstanceID(context.Background(), "instanceID"), "V2_samlSessionID", "org1")
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
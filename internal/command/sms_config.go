@@ -5,7 +5,10 @@ import (
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpsms"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -205,3 +208,262 @@ func (c *Commands) getSMSConfig(ctx context.Context, instanceID, id string) (_ *
 
 	return writeModel, nil
 }
+
+func (c *Commands) AddSMSConfigHTTP(ctx context.Context, instanceID string, config *httpsms.Config, priority uint32) (string, *domain.ObjectDetails, error) {
+	if err := config.Validate(); err != nil {
+		return "", nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return "", nil, err
+	}
+	authHeaderValue, err := c.encryptSMSSecret(config.AuthHeaderValue)
+	if err != nil {
+		return "", nil, err
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, instance.NewSMSConfigHTTPAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Endpoint,
+		config.Method,
+		config.BodyTemplate,
+		config.AuthHeaderName,
+		authHeaderValue,
+		config.SenderNumber,
+		priority,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+// ChangeSMSConfigHTTP changes the http sms provider.
+// The auth header value is only changed if set.
+func (c *Commands) ChangeSMSConfigHTTP(ctx context.Context, instanceID, id string, config *httpsms.Config) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-Ot6cDj3mYw", "Errors.IDMissing")
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Hs2eLq9vRn", "Errors.SMSConfig.NotFound")
+	}
+	authHeaderValue, err := c.encryptSMSSecret(config.AuthHeaderValue)
+	if err != nil {
+		return nil, err
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewHTTPChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Endpoint,
+		config.Method,
+		config.BodyTemplate,
+		config.AuthHeaderName,
+		config.SenderNumber,
+		authHeaderValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Zk5wBa1xTg", "Errors.NoChangesFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) AddSMSConfigVonage(ctx context.Context, instanceID string, config *vonage.Config, priority uint32) (string, *domain.ObjectDetails, error) {
+	if !config.IsValid() {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "SMS-Ej8rNw2kPa", "Errors.SMSConfig.Invalid")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return "", nil, err
+	}
+	apiSecret, err := c.encryptSMSSecret(config.APISecret)
+	if err != nil {
+		return "", nil, err
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, instance.NewSMSConfigVonageAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.APIKey,
+		apiSecret,
+		config.SenderNumber,
+		priority,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+// ChangeSMSConfigVonage changes the vonage sms provider.
+// The api secret is only changed if set.
+func (c *Commands) ChangeSMSConfigVonage(ctx context.Context, instanceID, id string, config *vonage.Config) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-Cu4mFh7sVe", "Errors.IDMissing")
+	}
+	if config.APIKey == "" || config.SenderNumber == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-Ql9xTb3dKo", "Errors.SMSConfig.Invalid")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Vonage == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Vy2nRg6wLc", "Errors.SMSConfig.NotFound")
+	}
+	apiSecret, err := c.encryptSMSSecret(config.APISecret)
+	if err != nil {
+		return nil, err
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewVonageChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.APIKey,
+		config.SenderNumber,
+		apiSecret,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ap3kWs8eZq", "Errors.NoChangesFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) AddSMSConfigMessageBird(ctx context.Context, instanceID string, config *messagebird.Config, priority uint32) (string, *domain.ObjectDetails, error) {
+	if !config.IsValid() {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "SMS-Gw7hYc1nMr", "Errors.SMSConfig.Invalid")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return "", nil, err
+	}
+	accessKey, err := c.encryptSMSSecret(config.AccessKey)
+	if err != nil {
+		return "", nil, err
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, instance.NewSMSConfigMessageBirdAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		accessKey,
+		config.Originator,
+		priority,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+// ChangeSMSConfigMessageBird changes the messagebird sms provider.
+// The access key is only changed if set.
+func (c *Commands) ChangeSMSConfigMessageBird(ctx context.Context, instanceID, id string, config *messagebird.Config) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-Xb5qPe2tJu", "Errors.IDMissing")
+	}
+	if config.Originator == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-Rf8sKz4wNi", "Errors.SMSConfig.Invalid")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.MessageBird == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Lm6dVs0aHy", "Errors.SMSConfig.NotFound")
+	}
+	accessKey, err := c.encryptSMSSecret(config.AccessKey)
+	if err != nil {
+		return nil, err
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewMessageBirdChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Originator,
+		accessKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Nt1gQx5cBe", "Errors.NoChangesFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+// ChangeSMSConfigPriority changes the priority of the sms provider.
+// Active providers are used in ascending order of their priority until one succeeds.
+func (c *Commands) ChangeSMSConfigPriority(ctx context.Context, instanceID, id string, priority uint32) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-Jd3wHy7pFa", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Sx9bMe4rTk", "Errors.SMSConfig.NotFound")
+	}
+	if smsConfigWriteModel.Priority == priority {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ur2fNc8wLo", "Errors.NoChangesFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, instance.NewSMSConfigPriorityChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		priority,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) encryptSMSSecret(secret string) (*crypto.CryptoValue, error) {
+	if secret == "" {
+		return nil, nil
+	}
+	return crypto.Encrypt([]byte(secret), c.smsEncryption)
+}
@@ -12,9 +12,13 @@ import (
 type IAMSMSConfigWriteModel struct {
 	eventstore.WriteModel
 
-	ID     string
-	Twilio *TwilioConfig
-	State  domain.SMSConfigState
+	ID          string
+	Priority    uint32
+	Twilio      *TwilioConfig
+	HTTP        *HTTPSMSConfig
+	Vonage      *VonageConfig
+	MessageBird *MessageBirdConfig
+	State       domain.SMSConfigState
 }
 
 type TwilioConfig struct {
@@ -23,6 +27,26 @@ type TwilioConfig struct {
 	SenderNumber string
 }
 
+type HTTPSMSConfig struct {
+	Endpoint        string
+	Method          string
+	BodyTemplate    string
+	AuthHeaderName  string
+	AuthHeaderValue *crypto.CryptoValue
+	SenderNumber    string
+}
+
+type VonageConfig struct {
+	APIKey       string
+	APISecret    *crypto.CryptoValue
+	SenderNumber string
+}
+
+type MessageBirdConfig struct {
+	AccessKey  *crypto.CryptoValue
+	Originator string
+}
+
 func NewIAMSMSConfigWriteModel(instanceID, id string) *IAMSMSConfigWriteModel {
 	return &IAMSMSConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -62,6 +86,91 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.Twilio.Token = e.Token
+		case *instance.SMSConfigHTTPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.HTTP = &HTTPSMSConfig{
+				Endpoint:        e.Endpoint,
+				Method:          e.Method,
+				BodyTemplate:    e.BodyTemplate,
+				AuthHeaderName:  e.AuthHeaderName,
+				AuthHeaderValue: e.AuthHeaderValue,
+				SenderNumber:    e.SenderNumber,
+			}
+			wm.Priority = e.Priority
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigHTTPChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Endpoint != nil {
+				wm.HTTP.Endpoint = *e.Endpoint
+			}
+			if e.Method != nil {
+				wm.HTTP.Method = *e.Method
+			}
+			if e.BodyTemplate != nil {
+				wm.HTTP.BodyTemplate = *e.BodyTemplate
+			}
+			if e.AuthHeaderName != nil {
+				wm.HTTP.AuthHeaderName = *e.AuthHeaderName
+			}
+			if e.AuthHeaderValue != nil {
+				wm.HTTP.AuthHeaderValue = e.AuthHeaderValue
+			}
+			if e.SenderNumber != nil {
+				wm.HTTP.SenderNumber = *e.SenderNumber
+			}
+		case *instance.SMSConfigVonageAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Vonage = &VonageConfig{
+				APIKey:       e.APIKey,
+				APISecret:    e.APISecret,
+				SenderNumber: e.SenderNumber,
+			}
+			wm.Priority = e.Priority
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigVonageChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.APIKey != nil {
+				wm.Vonage.APIKey = *e.APIKey
+			}
+			if e.APISecret != nil {
+				wm.Vonage.APISecret = e.APISecret
+			}
+			if e.SenderNumber != nil {
+				wm.Vonage.SenderNumber = *e.SenderNumber
+			}
+		case *instance.SMSConfigMessageBirdAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.MessageBird = &MessageBirdConfig{
+				AccessKey:  e.AccessKey,
+				Originator: e.Originator,
+			}
+			wm.Priority = e.Priority
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigMessageBirdChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.AccessKey != nil {
+				wm.MessageBird.AccessKey = e.AccessKey
+			}
+			if e.Originator != nil {
+				wm.MessageBird.Originator = *e.Originator
+			}
+		case *instance.SMSConfigPriorityChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Priority = e.Priority
 		case *instance.SMSConfigActivatedEvent:
 			if wm.ID != e.ID {
 				continue
@@ -77,6 +186,9 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.Twilio = nil
+			wm.HTTP = nil
+			wm.Vonage = nil
+			wm.MessageBird = nil
 			wm.State = domain.SMSConfigStateRemoved
 		}
 	}
@@ -94,7 +206,14 @@ func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMSConfigTwilioTokenChangedEventType,
 			instance.SMSConfigActivatedEventType,
 			instance.SMSConfigDeactivatedEventType,
-			instance.SMSConfigRemovedEventType).
+			instance.SMSConfigRemovedEventType,
+			instance.SMSConfigPriorityChangedEventType,
+			instance.SMSConfigHTTPAddedEventType,
+			instance.SMSConfigHTTPChangedEventType,
+			instance.SMSConfigVonageAddedEventType,
+			instance.SMSConfigVonageChangedEventType,
+			instance.SMSConfigMessageBirdAddedEventType,
+			instance.SMSConfigMessageBirdChangedEventType).
 		Builder()
 }
 
@@ -118,3 +237,78 @@ func (wm *IAMSMSConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate
 	}
 	return changeEvent, true, nil
 }
+
+func (wm *IAMSMSConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, endpoint, method, bodyTemplate, authHeaderName, senderNumber string, authHeaderValue *crypto.CryptoValue) (*instance.SMSConfigHTTPChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigHTTPChanges, 0)
+
+	if wm.HTTP.Endpoint != endpoint {
+		changes = append(changes, instance.ChangeSMSConfigHTTPEndpoint(endpoint))
+	}
+	if wm.HTTP.Method != method {
+		changes = append(changes, instance.ChangeSMSConfigHTTPMethod(method))
+	}
+	if wm.HTTP.BodyTemplate != bodyTemplate {
+		changes = append(changes, instance.ChangeSMSConfigHTTPBodyTemplate(bodyTemplate))
+	}
+	if wm.HTTP.AuthHeaderName != authHeaderName {
+		changes = append(changes, instance.ChangeSMSConfigHTTPAuthHeaderName(authHeaderName))
+	}
+	if authHeaderValue != nil {
+		changes = append(changes, instance.ChangeSMSConfigHTTPAuthHeaderValue(authHeaderValue))
+	}
+	if wm.HTTP.SenderNumber != senderNumber {
+		changes = append(changes, instance.ChangeSMSConfigHTTPSenderNumber(senderNumber))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigHTTPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) NewVonageChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, apiKey, senderNumber string, apiSecret *crypto.CryptoValue) (*instance.SMSConfigVonageChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigVonageChanges, 0)
+
+	if wm.Vonage.APIKey != apiKey {
+		changes = append(changes, instance.ChangeSMSConfigVonageAPIKey(apiKey))
+	}
+	if apiSecret != nil {
+		changes = append(changes, instance.ChangeSMSConfigVonageAPISecret(apiSecret))
+	}
+	if wm.Vonage.SenderNumber != senderNumber {
+		changes = append(changes, instance.ChangeSMSConfigVonageSenderNumber(senderNumber))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigVonageChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) NewMessageBirdChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, originator string, accessKey *crypto.CryptoValue) (*instance.SMSConfigMessageBirdChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigMessageBirdChanges, 0)
+
+	if accessKey != nil {
+		changes = append(changes, instance.ChangeSMSConfigMessageBirdAccessKey(accessKey))
+	}
+	if wm.MessageBird.Originator != originator {
+		changes = append(changes, instance.ChangeSMSConfigMessageBirdOriginator(originator))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigMessageBirdChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
@@ -285,6 +285,90 @@ func (c *Commands) RemoveSMTPConfig(ctx context.Context, instanceID, id string)
 	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
 }
 
+// TestSMTPConfig sends a test mail to the passed email address using the passed configuration without persisting it.
+// If no password is passed, the password of the stored configuration identified by id is used.
+// The stored password is only sent to the stored host, so it cannot be leaked to another server.
+func (c *Commands) TestSMTPConfig(ctx context.Context, instanceID, id, email string, config *smtp.Config) error {
+	if email == "" {
+		return zerrors.ThrowInvalidArgument(nil, "SMTP-p9uyq", "Errors.SMTPConfig.TestEmailMissing")
+	}
+	if config.SMTP.Password == "" && id != "" {
+		smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
+		if err != nil {
+			return err
+		}
+		if !smtpConfigWriteModel.State.Exists() {
+			return zerrors.ThrowNotFound(nil, "SMTP-Wn3oq", "Errors.SMTPConfig.NotFound")
+		}
+		if smtpConfigWriteModel.Host != config.SMTP.Host {
+			return zerrors.ThrowInvalidArgument(nil, "SMTP-Eim2o", "Errors.SMTPConfig.PasswordMissing")
+		}
+		config.SMTP.Password, err = decryptSMTPPassword(smtpConfigWriteModel.Password, c.smtpEncryption)
+		if err != nil {
+			return err
+		}
+	}
+	return testSMTPConfig(email, config)
+}
+
+// TestSMTPConfigByID sends a test mail to the passed email address using the stored configuration identified by id.
+func (c *Commands) TestSMTPConfigByID(ctx context.Context, instanceID, id, email string) error {
+	if id == "" {
+		return zerrors.ThrowInvalidArgument(nil, "SMTP-uc7Ma", "Errors.IDMissing")
+	}
+	if email == "" {
+		return zerrors.ThrowInvalidArgument(nil, "SMTP-Kp0sz", "Errors.SMTPConfig.TestEmailMissing")
+	}
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
+	if err != nil {
+		return err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return zerrors.ThrowNotFound(nil, "SMTP-99klw", "Errors.SMTPConfig.NotFound")
+	}
+	password, err := decryptSMTPPassword(smtpConfigWriteModel.Password, c.smtpEncryption)
+	if err != nil {
+		return err
+	}
+	return testSMTPConfig(email, &smtp.Config{
+		Description:    smtpConfigWriteModel.Description,
+		Tls:            smtpConfigWriteModel.TLS,
+		From:           smtpConfigWriteModel.SenderAddress,
+		FromName:       smtpConfigWriteModel.SenderName,
+		ReplyToAddress: smtpConfigWriteModel.ReplyToAddress,
+		SMTP: smtp.SMTP{
+			Host:     smtpConfigWriteModel.Host,
+			User:     smtpConfigWriteModel.User,
+			Password: password,
+		},
+	})
+}
+
+func testSMTPConfig(email string, config *smtp.Config) error {
+	if strings.TrimSpace(config.From) == "" {
+		return zerrors.ThrowInvalidArgument(nil, "SMTP-Bq3xu", "Errors.Invalid.Argument")
+	}
+	if _, _, err := net.SplitHostPort(strings.TrimSpace(config.SMTP.Host)); err != nil {
+		return zerrors.ThrowInvalidArgument(nil, "SMTP-Ya2lf", "Errors.Invalid.Argument")
+	}
+	if err := smtp.TestConfiguration(config, email); err != nil {
+		return zerrors.ThrowPreconditionFailed(err, "SMTP-Fx4cd", "Errors.SMTPConfig.TestFailed")
+	}
+	return nil
+}
+
+func decryptSMTPPassword(password *crypto.CryptoValue, alg crypto.EncryptionAlgorithm) (string, error) {
+	if password == nil {
+		return "", nil
+	}
+	return crypto.DecryptString(password, alg)
+}
+
+func senderDomain(from string) string {
+	fromSplitted := strings.Split(from, "@")
+	return fromSplitted[len(fromSplitted)-1]
+}
+
 func checkSenderAddress(writeModel *IAMSMTPConfigWriteModel) error {
 	if !writeModel.smtpSenderAddressMatchesInstanceDomain {
 		return nil
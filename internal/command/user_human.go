@@ -70,6 +70,9 @@ type AddHuman struct {
 
 	// TOTPSecret is optional
 	TOTPSecret string
+	// Inactive is optional, the user is deactivated together with its creation.
+	// As deactivated users can't be initialized, no init or email code is created for them.
+	Inactive bool
 
 	// Details are set after a successful execution of the command
 	Details *domain.ObjectDetails
@@ -273,6 +276,11 @@ func (c *Commands) addHumanCommandEmail(ctx context.Context, filter preparation.
 	if human.Email.Verified {
 		cmds = append(cmds, user.NewHumanEmailVerifiedEvent(ctx, &a.Aggregate))
 	}
+	// inactive users are created as already set up,
+	// as users in the initial state can't be deactivated (see DeactivateUserV2)
+	if human.Inactive {
+		return cmds, nil
+	}
 	// if allowInitMail, used for v1 api (system, admin, mgmt, auth):
 	// add init code if
 	// email not verified or
@@ -145,7 +145,7 @@ func (c *Commands) setPassword(
 	verificationCheck setPasswordVerification,
 ) (*domain.ObjectDetails, error) {
 	agg := user.NewAggregate(wm.AggregateID, wm.ResourceOwner)
-	command, err := c.setPasswordCommand(ctx, &agg.Aggregate, wm.UserState, password, encodedPassword, userAgentID, changeRequired, verificationCheck)
+	command, err := c.setPasswordCommand(ctx, &agg.Aggregate, wm.UserState, wm.PasswordHistory, password, encodedPassword, userAgentID, changeRequired, verificationCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -159,19 +159,32 @@ func (c *Commands) setPassword(
 // setPasswordCommand creates the command / intent for changing a user's password.
 // It will check the user's [domain.UserState] to be existing and not initial,
 // if the caller is allowed to change the password (permission, by code or by providing the current password),
-// and it will ensure the new password (if provided as plain) corresponds to the password complexity policy.
+// and it will ensure the new password (if provided as plain) corresponds to the password complexity policy
+// and does not match one of the recent passwords of the passwordHistory.
 // If not already encoded, the new password will be hashed.
-func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggregate, userState domain.UserState, password, encodedPassword, userAgentID string, changeRequired bool, verificationCheck setPasswordVerification) (_ eventstore.Command, err error) {
+func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggregate, userState domain.UserState, passwordHistory []string, password, encodedPassword, userAgentID string, changeRequired bool, verificationCheck setPasswordVerification) (_ eventstore.Command, err error) {
 	if !isUserStateExists(userState) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-G8dh3", "Errors.User.Password.NotFound")
 	}
 	if isUserStateInitial(userState) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-M9dse", "Errors.User.NotInitialised")
 	}
+	encodedPassword, err = c.encodeNewPassword(ctx, agg.ResourceOwner, passwordHistory, password, encodedPassword, verificationCheck)
+	if err != nil {
+		return nil, err
+	}
+	return user.NewHumanPasswordChangedEvent(ctx, agg, encodedPassword, changeRequired, userAgentID), nil
+}
+
+// encodeNewPassword checks if the caller is allowed to change the password using the verificationCheck,
+// ensures a plain password corresponds to the password complexity policy of the resourceOwner
+// and does not match one of the recent passwords of the passwordHistory.
+// It returns the encoded password, which is hashed if not already encoded.
+func (c *Commands) encodeNewPassword(ctx context.Context, resourceOwner string, passwordHistory []string, password, encodedPassword string, verificationCheck setPasswordVerification) (_ string, err error) {
 	if verificationCheck != nil {
 		newEncodedPassword, err := verificationCheck(ctx)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		// use the new hash from the verification in case there is one (e.g. existing pw check)
 		if newEncodedPassword != "" {
@@ -181,8 +194,8 @@ func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggre
 	// If password is provided, let's check if is compliant with the policy.
 	// If only a encodedPassword is passed, we can skip this.
 	if password != "" {
-		if err = c.checkPasswordComplexity(ctx, password, agg.ResourceOwner); err != nil {
-			return nil, err
+		if err = c.checkPasswordComplexity(ctx, password, resourceOwner, passwordHistory); err != nil {
+			return "", err
 		}
 	}
 
@@ -192,10 +205,10 @@ func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggre
 		encodedPassword, err = c.userPasswordHasher.Hash(password)
 		span.EndWithError(err)
 		if err = convertPasswapErr(err); err != nil {
-			return nil, err
+			return "", err
 		}
 	}
-	return user.NewHumanPasswordChangedEvent(ctx, agg, encodedPassword, changeRequired, userAgentID), nil
+	return encodedPassword, nil
 }
 
 // verifyAndUpdatePassword verify if the old password is correct with the encoded hash and
@@ -212,7 +225,7 @@ func (c *Commands) verifyAndUpdatePassword(ctx context.Context, encodedHash, old
 }
 
 // checkPasswordComplexity checks uf the given password can be used to be the password of a user
-func (c *Commands) checkPasswordComplexity(ctx context.Context, newPassword string, resourceOwner string) (err error) {
+func (c *Commands) checkPasswordComplexity(ctx context.Context, newPassword string, resourceOwner string, passwordHistory []string) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -224,6 +237,50 @@ func (c *Commands) checkPasswordComplexity(ctx context.Context, newPassword stri
 	if err := policy.Check(newPassword); err != nil {
 		return err
 	}
+	if err := c.checkPasswordHistory(ctx, newPassword, passwordHistory, policy.HistoryCount); err != nil {
+		return err
+	}
+	return c.checkPasswordBreached(ctx, newPassword, policy.CheckBreached)
+}
+
+// checkPasswordBreached rejects passwords found by the configured breach checker.
+// If the checker is not available, the password is accepted.
+func (c *Commands) checkPasswordBreached(ctx context.Context, newPassword string, checkBreached bool) (err error) {
+	if !checkBreached || c.passwordBreachChecker == nil {
+		return nil
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	breached, checkErr := c.passwordBreachChecker.Breached(ctx, newPassword)
+	if checkErr != nil {
+		logging.WithError(checkErr).Warn("unable to check password for breaches")
+		return nil
+	}
+	if breached {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Aing9", "Errors.User.Password.Breached")
+	}
+	return nil
+}
+
+// checkPasswordHistory ensures the given password does not match any of the last historyCount passwords
+// by verifying it against their encoded hashes
+func (c *Commands) checkPasswordHistory(ctx context.Context, newPassword string, passwordHistory []string, historyCount uint64) (err error) {
+	if historyCount == 0 {
+		return nil
+	}
+	_, spanPasswap := tracing.NewNamedSpan(ctx, "passwap.Verify")
+	defer func() { spanPasswap.EndWithError(err) }()
+
+	for i, encodedHash := range passwordHistory {
+		if uint64(i) >= historyCount {
+			break
+		}
+		// any error (e.g. mismatch or no longer supported hash) means the password was not reused
+		if _, verifyErr := c.userPasswordHasher.Verify(encodedHash, newPassword); verifyErr == nil {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-ue5Ah", "Errors.User.Password.RecentlyUsed")
+		}
+	}
 	return nil
 }
 
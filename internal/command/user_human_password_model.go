@@ -14,6 +14,8 @@ type HumanPasswordWriteModel struct {
 
 	EncodedHash          string
 	SecretChangeRequired bool
+	// PasswordHistory contains the encoded hashes of the current and previous passwords, most recent first
+	PasswordHistory []string
 
 	Code                     *crypto.CryptoValue
 	CodeCreationDate         time.Time
@@ -37,10 +39,12 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 		switch e := event.(type) {
 		case *user.HumanAddedEvent:
 			wm.EncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
+			wm.PasswordHistory = appendPasswordHistory(wm.PasswordHistory, wm.EncodedHash)
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.UserState = domain.UserStateActive
 		case *user.HumanRegisteredEvent:
 			wm.EncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
+			wm.PasswordHistory = appendPasswordHistory(wm.PasswordHistory, wm.EncodedHash)
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.UserState = domain.UserStateActive
 		case *user.HumanInitialCodeAddedEvent:
@@ -49,6 +53,7 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.UserState = domain.UserStateActive
 		case *user.HumanPasswordChangedEvent:
 			wm.EncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
+			wm.PasswordHistory = appendPasswordHistory(wm.PasswordHistory, wm.EncodedHash)
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.Code = nil
 			wm.PasswordCheckFailedCount = 0
@@ -75,11 +80,27 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.UserState = domain.UserStateDeleted
 		case *user.HumanPasswordHashUpdatedEvent:
 			wm.EncodedHash = e.EncodedHash
+			replaceCurrentPasswordHistory(wm.PasswordHistory, e.EncodedHash)
 		}
 	}
 	return wm.WriteModel.Reduce()
 }
 
+// appendPasswordHistory prepends the encoded hash of a newly set password to the history
+func appendPasswordHistory(history []string, encodedHash string) []string {
+	if encodedHash == "" {
+		return history
+	}
+	return append([]string{encodedHash}, history...)
+}
+
+// replaceCurrentPasswordHistory replaces the hash of the current password after it was rehashed
+func replaceCurrentPasswordHistory(history []string, encodedHash string) {
+	if len(history) > 0 {
+		history[0] = encodedHash
+	}
+}
+
 func (wm *HumanPasswordWriteModel) Query() *eventstore.SearchQueryBuilder {
 	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
 		AddQuery().
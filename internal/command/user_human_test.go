@@ -433,6 +433,8 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
 							),
 						),
 					),
@@ -503,6 +505,8 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
 							),
 						),
 					),
@@ -576,6 +580,8 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
 							),
 						),
 					),
@@ -650,6 +656,8 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
 							),
 						),
 					),
@@ -713,6 +721,8 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
 							),
 						),
 					),
@@ -776,6 +786,8 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
 							),
 						),
 					),
@@ -899,6 +911,8 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
 							),
 						),
 					),
@@ -978,6 +992,8 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
 							),
 						),
 					),
@@ -1119,6 +1135,8 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
 							),
 						),
 					),
@@ -1418,6 +1436,8 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
 									),
 								),
 							),
@@ -1463,6 +1483,8 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
 									),
 								),
 							),
@@ -1550,6 +1572,8 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
 									),
 								),
 							),
@@ -1631,6 +1655,8 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
 									),
 								),
 							),
@@ -1731,6 +1757,8 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
 									),
 								),
 							),
@@ -1835,6 +1863,8 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
 									),
 								),
 							),
@@ -1937,6 +1967,8 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
 									),
 								),
 							),
@@ -2033,6 +2065,8 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
 									),
 								),
 							),
@@ -2119,6 +2153,8 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
 									),
 								),
 							),
@@ -2206,6 +2242,8 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
 									),
 								),
 							),
@@ -2352,6 +2390,8 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
 									),
 								),
 							),
@@ -2795,6 +2835,7 @@ func newAddMachineEvent(userLoginMustBeDomain bool, accessTokenType domain.OIDCT
 		"description",
 		userLoginMustBeDomain,
 		accessTokenType,
+		false,
 	)
 }
 
@@ -2968,6 +3009,8 @@ func TestAddHumanCommand(t *testing.T) {
 									true,
 									true,
 									true,
+									0,
+									false,
 								),
 							}, nil
 						}).
@@ -3021,6 +3064,8 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									0,
+									false,
 								),
 							}, nil
 						}).
@@ -3092,6 +3137,8 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									0,
+									false,
 								),
 							}, nil
 						}).
@@ -3164,6 +3211,8 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									0,
+									false,
 								),
 							}, nil
 						}).
@@ -3236,6 +3285,8 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									0,
+									false,
 								),
 							}, nil
 						}).
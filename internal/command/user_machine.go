@@ -20,10 +20,11 @@ type AddMachine struct {
 type Machine struct {
 	models.ObjectRoot
 
-	Username        string
-	Name            string
-	Description     string
-	AccessTokenType domain.OIDCTokenType
+	Username              string
+	Name                  string
+	Description           string
+	AccessTokenType       domain.OIDCTokenType
+	DPoPBoundAccessTokens bool
 }
 
 func (m *Machine) IsZero() bool {
@@ -57,7 +58,7 @@ func AddMachineCommand(a *user.Aggregate, machine *Machine) preparation.Validati
 				return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-3M9fs", "Errors.Org.DomainPolicy.NotFound")
 			}
 			return []eventstore.Command{
-				user.NewMachineAddedEvent(ctx, &a.Aggregate, machine.Username, machine.Name, machine.Description, domainPolicy.UserLoginMustBeDomain, machine.AccessTokenType),
+				user.NewMachineAddedEvent(ctx, &a.Aggregate, machine.Username, machine.Name, machine.Description, domainPolicy.UserLoginMustBeDomain, machine.AccessTokenType, machine.DPoPBoundAccessTokens),
 			}, nil
 		}, nil
 	}
@@ -125,7 +126,7 @@ func changeMachineCommand(a *user.Aggregate, machine *Machine) preparation.Valid
 			if !isUserStateExists(writeModel.UserState) {
 				return nil, zerrors.ThrowNotFound(nil, "COMMAND-5M0od", "Errors.User.NotFound")
 			}
-			changedEvent, hasChanged, err := writeModel.NewChangedEvent(ctx, &a.Aggregate, machine.Name, machine.Description, machine.AccessTokenType)
+			changedEvent, hasChanged, err := writeModel.NewChangedEvent(ctx, &a.Aggregate, machine.Name, machine.Description, machine.AccessTokenType, machine.DPoPBoundAccessTokens)
 			if err != nil {
 				return nil, err
 			}
@@ -145,6 +145,7 @@ func TestCommands_AddMachineKey(t *testing.T) {
 								"",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -194,6 +195,7 @@ func TestCommands_AddMachineKey(t *testing.T) {
 								"",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
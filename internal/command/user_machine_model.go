@@ -14,11 +14,12 @@ type MachineWriteModel struct {
 
 	UserName string
 
-	Name            string
-	Description     string
-	UserState       domain.UserState
-	AccessTokenType domain.OIDCTokenType
-	HashedSecret    string
+	Name                  string
+	Description           string
+	UserState             domain.UserState
+	AccessTokenType       domain.OIDCTokenType
+	DPoPBoundAccessTokens bool
+	HashedSecret          string
 }
 
 func NewMachineWriteModel(userID, resourceOwner string) *MachineWriteModel {
@@ -38,6 +39,7 @@ func (wm *MachineWriteModel) Reduce() error {
 			wm.Name = e.Name
 			wm.Description = e.Description
 			wm.AccessTokenType = e.AccessTokenType
+			wm.DPoPBoundAccessTokens = e.DPoPBoundAccessTokens
 			wm.UserState = domain.UserStateActive
 		case *user.UsernameChangedEvent:
 			wm.UserName = e.UserName
@@ -51,6 +53,9 @@ func (wm *MachineWriteModel) Reduce() error {
 			if e.AccessTokenType != nil {
 				wm.AccessTokenType = *e.AccessTokenType
 			}
+			if e.DPoPBoundAccessTokens != nil {
+				wm.DPoPBoundAccessTokens = *e.DPoPBoundAccessTokens
+			}
 		case *user.UserLockedEvent:
 			if wm.UserState != domain.UserStateDeleted {
 				wm.UserState = domain.UserStateLocked
@@ -106,6 +111,7 @@ func (wm *MachineWriteModel) NewChangedEvent(
 	name,
 	description string,
 	accessTokenType domain.OIDCTokenType,
+	dpopBoundAccessTokens bool,
 ) (*user.MachineChangedEvent, bool, error) {
 	changes := make([]user.MachineChanges, 0)
 	var err error
@@ -119,6 +125,9 @@ func (wm *MachineWriteModel) NewChangedEvent(
 	if wm.AccessTokenType != accessTokenType {
 		changes = append(changes, user.ChangeAccessTokenType(accessTokenType))
 	}
+	if wm.DPoPBoundAccessTokens != dpopBoundAccessTokens {
+		changes = append(changes, user.ChangeDPoPBoundAccessTokens(dpopBoundAccessTokens))
+	}
 	if len(changes) == 0 {
 		return nil, false, nil
 	}
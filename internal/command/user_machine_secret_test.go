@@ -95,6 +95,7 @@ func TestCommandSide_GenerateMachineSecret(t *testing.T) {
 								"user",
 								false,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -228,6 +229,7 @@ func TestCommandSide_RemoveMachineSecret(t *testing.T) {
 								"user",
 								false,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -256,6 +258,7 @@ func TestCommandSide_RemoveMachineSecret(t *testing.T) {
 								"user",
 								false,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 						eventFromEventPusher(
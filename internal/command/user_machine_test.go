@@ -126,6 +126,7 @@ func TestCommandSide_AddMachine(t *testing.T) {
 							"description",
 							true,
 							domain.OIDCTokenTypeBearer,
+							false,
 						),
 					),
 				),
@@ -264,6 +265,7 @@ func TestCommandSide_ChangeMachine(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -299,6 +301,7 @@ func TestCommandSide_ChangeMachine(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -324,6 +327,55 @@ func TestCommandSide_ChangeMachine(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "require dpop bound access tokens, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewMachineAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"name",
+								"description",
+								true,
+								domain.OIDCTokenTypeBearer,
+								false,
+							),
+						),
+					),
+					expectPush(
+						func() *user.MachineChangedEvent {
+							event, _ := user.NewMachineChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								[]user.MachineChanges{
+									user.ChangeDPoPBoundAccessTokens(true),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				machine: &Machine{
+					ObjectRoot: models.ObjectRoot{
+						ResourceOwner: "org1",
+						AggregateID:   "user1",
+					},
+					Name:                  "name",
+					Description:           "description",
+					DPoPBoundAccessTokens: true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -57,6 +57,8 @@ func Test_customPasswordComplexityPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							0,
+							false,
 						),
 					}, nil
 				},
@@ -134,6 +136,8 @@ func Test_defaultPasswordComplexityPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							0,
+							false,
 						),
 					}, nil
 				},
@@ -202,6 +206,8 @@ func Test_passwordComplexityPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							0,
+							false,
 						),
 					}, nil
 				},
@@ -253,6 +259,8 @@ func Test_passwordComplexityPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								0,
+								false,
 							),
 						}, nil
 					}).
@@ -76,6 +76,7 @@ func TestCommands_AddPersonalAccessToken(t *testing.T) {
 								"",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -171,6 +172,7 @@ func TestCommands_AddPersonalAccessToken(t *testing.T) {
 								"",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -219,6 +221,7 @@ func TestCommands_AddPersonalAccessToken(t *testing.T) {
 								"",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -172,7 +172,7 @@ func (c *Commands) DeleteUserSchema(ctx context.Context, id, resourceOwner strin
 }
 
 func validateUserSchema(userSchema json.RawMessage) error {
-	_, err := domain_schema.NewSchema(0, bytes.NewReader(userSchema))
+	_, err := domain_schema.NewSchema(domain_schema.RoleUnspecified, bytes.NewReader(userSchema))
 	if err != nil {
 		return zerrors.ThrowInvalidArgument(err, "COMMA-W21tg", "Errors.UserSchema.Schema.Invalid")
 	}
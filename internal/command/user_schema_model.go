@@ -16,6 +16,7 @@ type UserSchemaWriteModel struct {
 	eventstore.WriteModel
 
 	SchemaType             string
+	SchemaRevision         uint64
 	Schema                 json.RawMessage
 	PossibleAuthenticators []domain.AuthenticatorType
 	State                  domain.UserSchemaState
@@ -36,6 +37,7 @@ func (wm *UserSchemaWriteModel) Reduce() error {
 		case *schema.CreatedEvent:
 			wm.SchemaType = e.SchemaType
 			wm.Schema = e.Schema
+			wm.SchemaRevision = 1
 			wm.PossibleAuthenticators = e.PossibleAuthenticators
 			wm.State = domain.UserSchemaStateActive
 		case *schema.UpdatedEvent:
@@ -44,6 +46,7 @@ func (wm *UserSchemaWriteModel) Reduce() error {
 			}
 			if len(e.Schema) > 0 {
 				wm.Schema = e.Schema
+				wm.SchemaRevision++
 			}
 			if len(e.PossibleAuthenticators) > 0 {
 				wm.PossibleAuthenticators = e.PossibleAuthenticators
@@ -1760,6 +1760,7 @@ func TestExistsUser(t *testing.T) {
 							"description",
 							true,
 							domain.OIDCTokenTypeBearer,
+							false,
 						),
 					}, nil
 				},
@@ -1782,6 +1783,7 @@ func TestExistsUser(t *testing.T) {
 							"description",
 							true,
 							domain.OIDCTokenTypeBearer,
+							false,
 						),
 						user.NewUserRemovedEvent(
 							context.Background(),
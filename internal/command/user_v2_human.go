@@ -229,6 +229,10 @@ func (c *Commands) AddUserHuman(ctx context.Context, resourceOwner string, human
 		)
 	}
 
+	if human.Inactive {
+		cmds = append(cmds, user.NewUserDeactivatedEvent(ctx, &existingHuman.Aggregate().Aggregate))
+	}
+
 	if len(cmds) == 0 {
 		human.Details = writeModelToObjectDetails(&existingHuman.WriteModel)
 		return nil
@@ -399,6 +403,7 @@ func (c *Commands) changeUserPassword(ctx context.Context, cmds []eventstore.Com
 		ctx,
 		&wm.Aggregate().Aggregate,
 		wm.UserState,
+		wm.PasswordHistory,
 		password.Password,
 		password.EncodedPasswordHash,
 		"",
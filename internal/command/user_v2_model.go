@@ -44,6 +44,7 @@ type UserV2WriteModel struct {
 
 	PasswordWriteModel       bool
 	PasswordEncodedHash      string
+	PasswordHistory          []string
 	PasswordChangeRequired   bool
 	PasswordCode             *crypto.CryptoValue
 	PasswordCodeCreationDate time.Time
@@ -261,12 +262,14 @@ func (wm *UserV2WriteModel) Reduce() error {
 
 		case *user.HumanPasswordHashUpdatedEvent:
 			wm.PasswordEncodedHash = e.EncodedHash
+			replaceCurrentPasswordHistory(wm.PasswordHistory, e.EncodedHash)
 		case *user.HumanPasswordCheckFailedEvent:
 			wm.PasswordCheckFailedCount += 1
 		case *user.HumanPasswordCheckSucceededEvent:
 			wm.PasswordCheckFailedCount = 0
 		case *user.HumanPasswordChangedEvent:
 			wm.PasswordEncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
+			wm.PasswordHistory = appendPasswordHistory(wm.PasswordHistory, wm.PasswordEncodedHash)
 			wm.PasswordChangeRequired = e.ChangeRequired
 			wm.EmptyPasswordCode()
 		case *user.HumanPasswordCodeAddedEvent:
@@ -459,6 +462,36 @@ func (wm *UserV2WriteModel) Query() *eventstore.SearchQueryBuilder {
 	return query
 }
 
+// SnapshotType implements [eventstore.Snapshotter]
+// the enabled parts of the write model are part of the type as they define the reduced events
+func (wm *UserV2WriteModel) SnapshotType() string {
+	typ := []byte("user_v2:")
+	for _, enabled := range []bool{
+		wm.HumanWriteModel,
+		wm.MachineWriteModel,
+		wm.MachineSecretWriteModel,
+		wm.ProfileWriteModel,
+		wm.AvatarWriteModel,
+		wm.PasswordWriteModel,
+		wm.EmailWriteModel,
+		wm.PhoneWriteModel,
+		wm.StateWriteModel,
+		wm.IDPLinkWriteModel,
+	} {
+		if enabled {
+			typ = append(typ, '1')
+			continue
+		}
+		typ = append(typ, '0')
+	}
+	return string(typ)
+}
+
+// SnapshotVersion implements [eventstore.Snapshotter]
+func (wm *UserV2WriteModel) SnapshotVersion() uint16 {
+	return 1
+}
+
 func (wm *UserV2WriteModel) reduceHumanAddedEvent(e *user.HumanAddedEvent) {
 	wm.UserName = e.UserName
 	wm.FirstName = e.FirstName
@@ -471,6 +504,7 @@ func (wm *UserV2WriteModel) reduceHumanAddedEvent(e *user.HumanAddedEvent) {
 	wm.Phone = e.PhoneNumber
 	wm.UserState = domain.UserStateActive
 	wm.PasswordEncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
+	wm.PasswordHistory = appendPasswordHistory(wm.PasswordHistory, wm.PasswordEncodedHash)
 	wm.PasswordChangeRequired = e.ChangeRequired
 }
 
@@ -486,6 +520,7 @@ func (wm *UserV2WriteModel) reduceHumanRegisteredEvent(e *user.HumanRegisteredEv
 	wm.Phone = e.PhoneNumber
 	wm.UserState = domain.UserStateActive
 	wm.PasswordEncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
+	wm.PasswordHistory = appendPasswordHistory(wm.PasswordHistory, wm.PasswordEncodedHash)
 	wm.PasswordChangeRequired = e.ChangeRequired
 }
 
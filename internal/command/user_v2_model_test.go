@@ -2,10 +2,12 @@ package command
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/crypto"
@@ -66,6 +68,7 @@ func TestCommandSide_userExistsWriteModel(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -105,6 +108,7 @@ func TestCommandSide_userExistsWriteModel(t *testing.T) {
 					LastName:               "lastname",
 					DisplayName:            "firstname lastname",
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -193,6 +197,7 @@ func TestCommandSide_userExistsWriteModel(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -257,6 +262,7 @@ func TestCommandSide_userExistsWriteModel(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -313,6 +319,7 @@ func TestCommandSide_userExistsWriteModel(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -368,6 +375,7 @@ func TestCommandSide_userExistsWriteModel(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -414,6 +422,7 @@ func TestCommandSide_userExistsWriteModel(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -554,6 +563,7 @@ func TestCommandSide_userHumanWriteModel_profile(t *testing.T) {
 					PreferredLanguage:      language.Afrikaans,
 					Gender:                 domain.GenderDiverse,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -642,6 +652,7 @@ func TestCommandSide_userHumanWriteModel_email(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "changed@test.com",
 					IsEmailVerified:        false,
@@ -699,6 +710,7 @@ func TestCommandSide_userHumanWriteModel_email(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -766,6 +778,7 @@ func TestCommandSide_userHumanWriteModel_email(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        true,
@@ -825,6 +838,7 @@ func TestCommandSide_userHumanWriteModel_email(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -899,6 +913,7 @@ func TestCommandSide_userHumanWriteModel_email(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "changed@test.com",
 					IsEmailVerified:        false,
@@ -987,6 +1002,7 @@ func TestCommandSide_userHumanWriteModel_phone(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1047,6 +1063,7 @@ func TestCommandSide_userHumanWriteModel_phone(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1120,6 +1137,7 @@ func TestCommandSide_userHumanWriteModel_phone(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1186,6 +1204,7 @@ func TestCommandSide_userHumanWriteModel_phone(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1266,6 +1285,7 @@ func TestCommandSide_userHumanWriteModel_phone(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1356,6 +1376,7 @@ func TestCommandSide_userHumanWriteModel_password(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "hash",
+					PasswordHistory:        []string{"hash"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1403,6 +1424,7 @@ func TestCommandSide_userHumanWriteModel_password(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "hash",
+					PasswordHistory:        []string{"hash", "$plain$x$password"},
 					PasswordChangeRequired: false,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1457,6 +1479,7 @@ func TestCommandSide_userHumanWriteModel_password(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1527,6 +1550,7 @@ func TestCommandSide_userHumanWriteModel_password(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "hash",
+					PasswordHistory:        []string{"hash", "$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1609,6 +1633,7 @@ func TestCommandSide_userStateWriteModel(t *testing.T) {
 					DisplayName:              "firstname lastname",
 					PreferredLanguage:        language.English,
 					PasswordEncodedHash:      "$plain$x$password",
+					PasswordHistory:          []string{"$plain$x$password"},
 					PasswordChangeRequired:   true,
 					PasswordCheckFailedCount: 0,
 					Email:                    "email@test.ch",
@@ -1654,6 +1679,7 @@ func TestCommandSide_userStateWriteModel(t *testing.T) {
 					DisplayName:              "firstname lastname",
 					PreferredLanguage:        language.English,
 					PasswordEncodedHash:      "$plain$x$password",
+					PasswordHistory:          []string{"$plain$x$password"},
 					PasswordChangeRequired:   true,
 					PasswordCheckFailedCount: 0,
 					Email:                    "email@test.ch",
@@ -1751,6 +1777,7 @@ func TestCommandSide_userStateWriteModel(t *testing.T) {
 					DisplayName:              "firstname lastname",
 					PreferredLanguage:        language.English,
 					PasswordEncodedHash:      "$plain$x$password",
+					PasswordHistory:          []string{"$plain$x$password"},
 					PasswordChangeRequired:   true,
 					PasswordCheckFailedCount: 3,
 					Email:                    "email@test.ch",
@@ -1819,6 +1846,7 @@ func TestCommandSide_userStateWriteModel(t *testing.T) {
 					DisplayName:              "firstname lastname",
 					PreferredLanguage:        language.English,
 					PasswordEncodedHash:      "$plain$x$password",
+					PasswordHistory:          []string{"$plain$x$password"},
 					PasswordChangeRequired:   true,
 					PasswordCheckFailedCount: 0,
 					Email:                    "email@test.ch",
@@ -1864,6 +1892,7 @@ func TestCommandSide_userStateWriteModel(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1913,6 +1942,7 @@ func TestCommandSide_userStateWriteModel(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -2001,6 +2031,7 @@ func TestCommandSide_userHumanWriteModel_avatar(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -2054,6 +2085,7 @@ func TestCommandSide_userHumanWriteModel_avatar(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -2145,6 +2177,7 @@ func TestCommandSide_userHumanWriteModel_idpLinks(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -2212,6 +2245,7 @@ func TestCommandSide_userHumanWriteModel_idpLinks(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -2303,6 +2337,7 @@ func TestCommandSide_userHumanWriteModel_idpLinks(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -2362,6 +2397,7 @@ func TestCommandSide_userHumanWriteModel_idpLinks(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        []string{"$plain$x$password"},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -2391,3 +2427,23 @@ func TestCommandSide_userHumanWriteModel_idpLinks(t *testing.T) {
 		})
 	}
 }
+
+func TestUserV2WriteModel_snapshot(t *testing.T) {
+	wm := NewUserHumanWriteModel("user1", "org1", true, true, false, true, false, true)
+	assert.Equal(t, "user_v2:1001011011", wm.SnapshotType())
+	assert.NotEqual(t, NewUserStateWriteModel("user1", "org1").SnapshotType(), wm.SnapshotType())
+
+	wm.UserName = "username"
+	wm.PreferredLanguage = language.German
+	wm.PasswordHistory = []string{"$plain$x$password"}
+	wm.EmailCode = &crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("code")}
+	wm.EmailCodeExpiry = time.Hour
+	wm.IDPLinks = []*domain.UserIDPLink{{IDPConfigID: "idp", ExternalUserID: "external", DisplayName: "name"}}
+	payload, err := json.Marshal(wm)
+	require.NoError(t, err)
+
+	restored := NewUserHumanWriteModel("user1", "org1", true, true, false, true, false, true)
+	require.NoError(t, json.Unmarshal(payload, restored))
+	restored.WriteModel = wm.WriteModel
+	assert.Equal(t, wm, restored)
+}
@@ -122,6 +122,7 @@ func TestCommandSide_LockUserV2(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 						eventFromEventPusher(
@@ -227,6 +228,7 @@ func TestCommandSide_LockUserV2(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -369,6 +371,7 @@ func TestCommandSide_UnlockUserV2(t *testing.T) {
 							"description",
 							true,
 							domain.OIDCTokenTypeBearer,
+							false,
 						),
 					),
 				),
@@ -476,6 +479,7 @@ func TestCommandSide_UnlockUserV2(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 						eventFromEventPusher(
@@ -749,6 +753,7 @@ func TestCommandSide_DeactivateUserV2(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 						eventFromEventPusher(
@@ -783,6 +788,7 @@ func TestCommandSide_DeactivateUserV2(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -926,6 +932,7 @@ func TestCommandSide_ReactivateUserV2(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
@@ -1034,6 +1041,7 @@ func TestCommandSide_ReactivateUserV2(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 						eventFromEventPusher(
@@ -1280,6 +1288,7 @@ func TestCommandSide_RemoveUserV2(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 						eventFromEventPusher(
@@ -1317,6 +1326,7 @@ func TestCommandSide_RemoveUserV2(t *testing.T) {
 								"description",
 								true,
 								domain.OIDCTokenTypeBearer,
+								false,
 							),
 						),
 					),
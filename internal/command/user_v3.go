@@ -0,0 +1,409 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	domain_schema "github.com/zitadel/zitadel/internal/domain/schema"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type CreateSchemaUser struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+
+	SchemaID       string
+	schemaRevision uint64
+
+	ID   string
+	Data json.RawMessage
+
+	Email           *Email
+	ReturnCodeEmail string
+	Phone           *Phone
+	ReturnCodePhone string
+
+	Usernames []*Username
+	// UsernameIDs are the generated ids of the Usernames in the same order
+	UsernameIDs []string
+	Password    *Password
+}
+
+func (s *CreateSchemaUser) Valid() error {
+	if s.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-urEJKa1tJM", "Errors.ResourceOwnerMissing")
+	}
+	if s.SchemaID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-TFo06JgnF2", "Errors.UserSchema.ID.Missing")
+	}
+	if s.Email != nil {
+		if err := validateSchemaUserEmail(s.Email); err != nil {
+			return err
+		}
+	}
+	if s.Phone != nil {
+		number, err := s.Phone.Number.Normalize()
+		if err != nil {
+			return err
+		}
+		s.Phone.Number = number
+	}
+	for _, username := range s.Usernames {
+		if err := username.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ChangeSchemaUser struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+
+	SchemaID       *string
+	schemaRevision uint64
+
+	ID   string
+	Data json.RawMessage
+
+	Email           *Email
+	ReturnCodeEmail string
+	Phone           *Phone
+	ReturnCodePhone string
+}
+
+func (s *ChangeSchemaUser) Valid() error {
+	if s.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-gEJR1QOGHb", "Errors.IDMissing")
+	}
+	if s.SchemaID != nil && *s.SchemaID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-2o8XcSSl3A", "Errors.UserSchema.ID.Missing")
+	}
+	if s.Email != nil {
+		if err := validateSchemaUserEmail(s.Email); err != nil {
+			return err
+		}
+	}
+	if s.Phone != nil {
+		number, err := s.Phone.Number.Normalize()
+		if err != nil {
+			return err
+		}
+		s.Phone.Number = number
+	}
+	return nil
+}
+
+// CreateSchemaUser creates a user based on a user schema.
+// The data of the user is validated against the JSON schema of the active user schema.
+// The usernames and the password of the user can be set as authenticators.
+func (c *Commands) CreateSchemaUser(ctx context.Context, user *CreateSchemaUser) (err error) {
+	if err := user.Valid(); err != nil {
+		return err
+	}
+	if user.Password != nil {
+		if err := user.Password.Validate(c.userPasswordHasher); err != nil {
+			return err
+		}
+	}
+	if err := c.checkPermission(ctx, domain.PermissionUserWrite, user.ResourceOwner, user.ID); err != nil {
+		return err
+	}
+	schemaWriteModel, err := c.activeUserSchemaWriteModel(ctx, user.SchemaID)
+	if err != nil {
+		return err
+	}
+	user.schemaRevision = schemaWriteModel.SchemaRevision
+	if user.Data, err = validateUserSchemaData(schemaWriteModel.Schema, user.Data, domain_schema.RoleOwner); err != nil {
+		return err
+	}
+
+	if user.ID == "" {
+		user.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	writeModel, err := c.userV3WriteModel(ctx, user.ResourceOwner, user.ID)
+	if err != nil {
+		return err
+	}
+	if writeModel.Exists() {
+		return zerrors.ThrowAlreadyExists(nil, "COMMAND-Nn8CRVlkeZ", "Errors.User.AlreadyExists")
+	}
+
+	aggregate := UserV3AggregateFromWriteModel(&writeModel.WriteModel)
+	events := []eventstore.Command{
+		schemauser.NewCreatedEvent(ctx, aggregate, user.SchemaID, user.schemaRevision, user.Data),
+	}
+	if user.Email != nil {
+		emailEvents, plainCode, err := c.schemaUserEmailEvents(ctx, aggregate, user.Email)
+		if err != nil {
+			return err
+		}
+		events = append(events, emailEvents...)
+		user.ReturnCodeEmail = plainCode
+	}
+	if user.Phone != nil {
+		phoneEvents, plainCode, err := c.schemaUserPhoneEvents(ctx, aggregate, user.Phone)
+		if err != nil {
+			return err
+		}
+		events = append(events, phoneEvents...)
+		user.ReturnCodePhone = plainCode
+	}
+	user.UsernameIDs = make([]string, len(user.Usernames))
+	for i, username := range user.Usernames {
+		usernameEvent, id, err := c.schemaUserUsernameEvent(ctx, aggregate, username)
+		if err != nil {
+			return err
+		}
+		events = append(events, usernameEvent)
+		user.UsernameIDs[i] = id
+	}
+	if user.Password != nil {
+		// the permission to create the user is already checked
+		passwordEvent, err := c.schemaUserPasswordEvent(ctx, aggregate, user.Password, nil)
+		if err != nil {
+			return err
+		}
+		events = append(events, passwordEvent)
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, events...); err != nil {
+		return err
+	}
+	user.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+// ChangeSchemaUser changes the schema, the data or the contact information of the user.
+// If either the schema or the data changes, the data is validated against the (new) user schema.
+// Users changing their own data are validated with the self permissions of the schema.
+func (c *Commands) ChangeSchemaUser(ctx context.Context, user *ChangeSchemaUser) (err error) {
+	if err := user.Valid(); err != nil {
+		return err
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, user.ResourceOwner, user.ID)
+	if err != nil {
+		return err
+	}
+	if err := c.checkPermissionUpdateUser(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return err
+	}
+
+	aggregate := UserV3AggregateFromWriteModel(&writeModel.WriteModel)
+	events := make([]eventstore.Command, 0, 5)
+	if user.SchemaID != nil || user.Data != nil {
+		schemaID := writeModel.SchemaID
+		if user.SchemaID != nil {
+			schemaID = *user.SchemaID
+		}
+		data := writeModel.Data
+		if user.Data != nil {
+			data = user.Data
+		}
+		schemaWriteModel, err := c.activeUserSchemaWriteModel(ctx, schemaID)
+		if err != nil {
+			return err
+		}
+		user.schemaRevision = schemaWriteModel.SchemaRevision
+		if data, err = validateUserSchemaData(schemaWriteModel.Schema, data, schemaUserRole(ctx, user.ID)); err != nil {
+			return err
+		}
+		if user.Data != nil {
+			user.Data = data
+		}
+		if updatedEvent := writeModel.NewUpdatedEvent(ctx, aggregate, schemaID, user.schemaRevision, user.Data); updatedEvent != nil {
+			events = append(events, updatedEvent)
+		}
+	}
+	if user.Email != nil && (user.Email.Address != writeModel.Email || user.Email.Verified && !writeModel.IsEmailVerified) {
+		emailEvents, plainCode, err := c.schemaUserEmailEvents(ctx, aggregate, user.Email)
+		if err != nil {
+			return err
+		}
+		events = append(events, emailEvents...)
+		user.ReturnCodeEmail = plainCode
+	}
+	if user.Phone != nil && (user.Phone.Number != writeModel.Phone || user.Phone.Verified && !writeModel.IsPhoneVerified) {
+		phoneEvents, plainCode, err := c.schemaUserPhoneEvents(ctx, aggregate, user.Phone)
+		if err != nil {
+			return err
+		}
+		events = append(events, phoneEvents...)
+		user.ReturnCodePhone = plainCode
+	}
+	if len(events) == 0 {
+		user.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+		return nil
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, events...); err != nil {
+		return err
+	}
+	user.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) DeleteSchemaUser(ctx context.Context, resourceOwner, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vs4wJCME7T", "Errors.IDMissing")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionDeleteUser(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	aggregate := UserV3AggregateFromWriteModel(&writeModel.WriteModel)
+	events := append(
+		schemaUserUsernamesRemovedEvents(ctx, aggregate, writeModel.Usernames),
+		schemauser.NewDeletedEvent(ctx, aggregate),
+	)
+	if err := c.pushAppendAndReduce(ctx, writeModel, events...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) LockSchemaUser(ctx context.Context, resourceOwner, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Eu8I2VAfjF", "Errors.IDMissing")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.UserStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-G4LOrnjY7q", "Errors.User.ShouldBeActiveOrInitial")
+	}
+	return c.pushSchemaUserStateEvent(ctx, writeModel,
+		schemauser.NewLockedEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel)),
+	)
+}
+
+func (c *Commands) UnlockSchemaUser(ctx context.Context, resourceOwner, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-krXtYscQZh", "Errors.IDMissing")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.UserStateLocked {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-gpBv46Lh9m", "Errors.User.NotLocked")
+	}
+	return c.pushSchemaUserStateEvent(ctx, writeModel,
+		schemauser.NewUnlockedEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel)),
+	)
+}
+
+func (c *Commands) DeactivateSchemaUser(ctx context.Context, resourceOwner, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-pjJhge86ZV", "Errors.IDMissing")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.UserStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ob6lR5iFTe", "Errors.User.ShouldBeActiveOrInitial")
+	}
+	return c.pushSchemaUserStateEvent(ctx, writeModel,
+		schemauser.NewDeactivatedEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel)),
+	)
+}
+
+func (c *Commands) ReactivateSchemaUser(ctx context.Context, resourceOwner, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-17XupGvxBJ", "Errors.IDMissing")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.UserStateInactive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-rQjbBr4J3j", "Errors.User.NotInactive")
+	}
+	return c.pushSchemaUserStateEvent(ctx, writeModel,
+		schemauser.NewReactivatedEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel)),
+	)
+}
+
+func (c *Commands) pushSchemaUserStateEvent(ctx context.Context, writeModel *UserV3WriteModel, event eventstore.Command) (*domain.ObjectDetails, error) {
+	if err := c.checkPermissionUpdateUser(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, event); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) userV3WriteModel(ctx context.Context, resourceOwner, id string) (*UserV3WriteModel, error) {
+	writeModel := NewUserV3WriteModel(resourceOwner, id)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) existingUserV3WriteModel(ctx context.Context, resourceOwner, id string) (*UserV3WriteModel, error) {
+	writeModel, err := c.userV3WriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-6Nyq8ihvBZ", "Errors.User.NotFound")
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) activeUserSchemaWriteModel(ctx context.Context, id string) (*UserSchemaWriteModel, error) {
+	writeModel := NewUserSchemaWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.UserSchemaStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-N9TP0gHAe8", "Errors.UserSchema.NotActive")
+	}
+	return writeModel, nil
+}
+
+// schemaUserRole returns the role the data of the user is validated with.
+// Users changing their own data are restricted by the self permissions of the schema.
+func schemaUserRole(ctx context.Context, userID string) domain_schema.Role {
+	if authz.GetCtxData(ctx).UserID == userID {
+		return domain_schema.RoleSelf
+	}
+	return domain_schema.RoleOwner
+}
+
+// validateUserSchemaData validates the data against the JSON schema for the role of the caller.
+// Empty data is validated as an empty object and valid data is returned compacted.
+func validateUserSchemaData(userSchema, data json.RawMessage, role domain_schema.Role) (json.RawMessage, error) {
+	if len(data) == 0 {
+		data = json.RawMessage("{}")
+	}
+	schema, err := domain_schema.NewSchema(role, bytes.NewReader(userSchema))
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-7o3ZGxtXUz", "Errors.User.Invalid")
+	}
+	if err := schema.Validate(v); err != nil {
+		return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-SlKXqLSeL6", "Errors.UserSchema.Data.Invalid")
+	}
+	compacted := new(bytes.Buffer)
+	if err := json.Compact(compacted, data); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-4c0JNMhZ1d", "Errors.User.Invalid")
+	}
+	return compacted.Bytes(), nil
+}
@@ -0,0 +1,189 @@
+package command
+
+import (
+	"context"
+	"io"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ChangeSchemaUserEmail struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Email      *Email
+	ReturnCode string
+}
+
+func (s *ChangeSchemaUserEmail) Valid() error {
+	if s.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-0oj2PquNGA", "Errors.IDMissing")
+	}
+	if s.Email == nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-aTQoVaWnpP", "Errors.User.Email.Empty")
+	}
+	return validateSchemaUserEmail(s.Email)
+}
+
+// validateSchemaUserEmail validates the address and, if set, the url template of the email.
+func validateSchemaUserEmail(email *Email) error {
+	if email.URLTemplate != "" {
+		if err := domain.RenderConfirmURLTemplate(io.Discard, email.URLTemplate, "userID", "code", "orgID"); err != nil {
+			return err
+		}
+	}
+	return email.Validate()
+}
+
+// ChangeSchemaUserEmail sets the contact email of the user.
+// Unless the email is set as verified, a verification code is generated,
+// which is either returned or sent to the user.
+func (c *Commands) ChangeSchemaUserEmail(ctx context.Context, user *ChangeSchemaUserEmail) (err error) {
+	if err := user.Valid(); err != nil {
+		return err
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, user.ResourceOwner, user.ID)
+	if err != nil {
+		return err
+	}
+	if err := c.checkPermissionUpdateUser(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return err
+	}
+	events, plainCode, err := c.schemaUserEmailEvents(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel), user.Email)
+	if err != nil {
+		return err
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, events...); err != nil {
+		return err
+	}
+	user.ReturnCode = plainCode
+	user.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+type ResendSchemaUserEmailCode struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	URLTemplate     string
+	ReturnCode      bool
+	PlainReturnCode string
+}
+
+// ResendSchemaUserEmailCode generates a new verification code for the unverified contact email of the user.
+func (c *Commands) ResendSchemaUserEmailCode(ctx context.Context, user *ResendSchemaUserEmailCode) (err error) {
+	if user.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-KvPc5o9GeJ", "Errors.IDMissing")
+	}
+	if user.URLTemplate != "" {
+		if err := domain.RenderConfirmURLTemplate(io.Discard, user.URLTemplate, user.ID, "code", "orgID"); err != nil {
+			return err
+		}
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, user.ResourceOwner, user.ID)
+	if err != nil {
+		return err
+	}
+	if err := c.checkPermissionUpdateUser(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return err
+	}
+	if writeModel.EmailCode == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-fEsHdgECXW", "Errors.User.Code.Empty")
+	}
+	event, plainCode, err := c.schemaUserEmailCodeEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel), user.URLTemplate, user.ReturnCode)
+	if err != nil {
+		return err
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, event); err != nil {
+		return err
+	}
+	user.PlainReturnCode = plainCode
+	user.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+// VerifySchemaUserEmail verifies the contact email of the user with the code.
+// A failed verification is recorded before the error is returned.
+func (c *Commands) VerifySchemaUserEmail(ctx context.Context, resourceOwner, id, code string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-bsB2aXoY5L", "Errors.IDMissing")
+	}
+	if code == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-iKs7jtS4w9", "Errors.User.Code.Empty")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.AggregateID != authz.GetCtxData(ctx).UserID {
+		if err := c.checkPermission(ctx, domain.PermissionUserWrite, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+			return nil, err
+		}
+	}
+	if writeModel.EmailCode == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-jmT4uUEvVw", "Errors.User.Code.Empty")
+	}
+	aggregate := UserV3AggregateFromWriteModel(&writeModel.WriteModel)
+	err = verifyEncryptedCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyEmailCode, c.userEncryption, writeModel.EmailCodeCreationDate, writeModel.EmailCodeExpiry, writeModel.EmailCode, code) //nolint:staticcheck
+	if err != nil {
+		_, pushErr := c.eventstore.Push(ctx, schemauser.NewEmailVerificationFailedEvent(ctx, aggregate))
+		logging.WithFields("id", "COMMAND-fm7fIiWLKP", "userID", id).OnError(pushErr).Error("NewEmailVerificationFailedEvent push failed")
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-t5UhMyNuzA", "Errors.User.Code.Invalid")
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, schemauser.NewEmailVerifiedEvent(ctx, aggregate)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// SchemaUserEmailCodeSent records that the verification code was sent to the contact email of the user.
+func (c *Commands) SchemaUserEmailCodeSent(ctx context.Context, resourceOwner, id string) error {
+	if id == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Weoy2Qz1Xk", "Errors.IDMissing")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, schemauser.NewEmailCodeSentEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel)))
+	return err
+}
+
+// schemaUserEmailEvents returns the events to set the contact email
+// and the plain verification code, if it should be returned.
+func (c *Commands) schemaUserEmailEvents(ctx context.Context, aggregate *eventstore.Aggregate, email *Email) ([]eventstore.Command, string, error) {
+	events := []eventstore.Command{
+		schemauser.NewEmailUpdatedEvent(ctx, aggregate, email.Address),
+	}
+	if email.Verified {
+		return append(events, schemauser.NewEmailVerifiedEvent(ctx, aggregate)), "", nil
+	}
+	codeEvent, plainCode, err := c.schemaUserEmailCodeEvent(ctx, aggregate, email.URLTemplate, email.ReturnCode)
+	if err != nil {
+		return nil, "", err
+	}
+	return append(events, codeEvent), plainCode, nil
+}
+
+// schemaUserEmailCodeEvent returns the event of a new verification code
+// and the plain code, if it should be returned.
+// Codes which are not returned are sent to the user by the notification handler.
+func (c *Commands) schemaUserEmailCodeEvent(ctx context.Context, aggregate *eventstore.Aggregate, urlTemplate string, returnCode bool) (eventstore.Command, string, error) {
+	code, err := c.newEmailCode(ctx, c.eventstore.Filter, c.userEncryption) //nolint:staticcheck
+	if err != nil {
+		return nil, "", err
+	}
+	var plainCode string
+	if returnCode {
+		plainCode = code.Plain
+	}
+	return schemauser.NewEmailCodeAddedEvent(ctx, aggregate, code.Crypted, code.Expiry, urlTemplate, returnCode), plainCode, nil
+}
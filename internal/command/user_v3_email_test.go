@@ -0,0 +1,315 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_ChangeSchemaUserEmail(t *testing.T) {
+	type fields struct {
+		eventstore       func(t *testing.T) *eventstore.Eventstore
+		checkPermission  domain.PermissionCheck
+		newEncryptedCode encrypedCodeFunc
+	}
+	type args struct {
+		ctx  context.Context
+		user *ChangeSchemaUserEmail
+	}
+	type res struct {
+		returnCode string
+		details    *domain.ObjectDetails
+		err        error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no email, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &ChangeSchemaUserEmail{
+					ID: "user1",
+				},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-aTQoVaWnpP", "Errors.User.Email.Empty"),
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &ChangeSchemaUserEmail{
+					ID:    "user1",
+					Email: &Email{Address: "test@example.com"},
+				},
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-6Nyq8ihvBZ", "Errors.User.NotFound"),
+			},
+		},
+		{
+			"email verified",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+					expectPush(
+						schemauser.NewEmailUpdatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"test@example.com",
+						),
+						schemauser.NewEmailVerifiedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &ChangeSchemaUserEmail{
+					ID:    "user1",
+					Email: &Email{Address: "test@example.com", Verified: true},
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"email changed, return code",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+					expectPush(
+						schemauser.NewEmailUpdatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"test@example.com",
+						),
+						schemauser.NewEmailCodeAddedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("emailCode"),
+							},
+							time.Hour,
+							"",
+							true,
+						),
+					),
+				),
+				checkPermission:  newMockPermissionCheckAllowed(),
+				newEncryptedCode: mockEncryptedCode("emailCode", time.Hour),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &ChangeSchemaUserEmail{
+					ID:    "user1",
+					Email: &Email{Address: "test@example.com", ReturnCode: true},
+				},
+			},
+			res{
+				returnCode: "emailCode",
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				checkPermission:  tt.fields.checkPermission,
+				newEncryptedCode: tt.fields.newEncryptedCode,
+			}
+			err := c.ChangeSchemaUserEmail(tt.args.ctx, tt.args.user)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, tt.args.user.Details)
+				assert.Equal(t, tt.res.returnCode, tt.args.user.ReturnCode)
+			}
+		})
+	}
+}
+
+func TestCommands_VerifySchemaUserEmail(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx  context.Context
+		id   string
+		code string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	emailCodeAdded := func() eventstore.Event {
+		return eventFromEventPusherWithCreationDateNow(
+			schemauser.NewEmailCodeAddedEvent(context.Background(),
+				&schemauser.NewAggregate("user1", "org1").Aggregate,
+				&crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "enc",
+					KeyID:      "id",
+					Crypted:    []byte("emailCode"),
+				},
+				time.Hour,
+				"",
+				false,
+			),
+		)
+	}
+	secretGeneratorAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			instance.NewSecretGeneratorAddedEvent(context.Background(),
+				&instance.NewAggregate("instanceID").Aggregate,
+				domain.SecretGeneratorTypeVerifyEmailCode,
+				12, time.Minute, true, true, true, true,
+			),
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no code, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-iKs7jtS4w9", "Errors.User.Code.Empty"),
+			},
+		},
+		{
+			"no code added, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:  authz.NewMockContext("instanceID", "", ""),
+				id:   "user1",
+				code: "emailCode",
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-jmT4uUEvVw", "Errors.User.Code.Empty"),
+			},
+		},
+		{
+			"wrong code, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+						emailCodeAdded(),
+					),
+					expectFilter(
+						secretGeneratorAdded(),
+					),
+					expectPush(
+						schemauser.NewEmailVerificationFailedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:  authz.NewMockContext("instanceID", "", ""),
+				id:   "user1",
+				code: "wrong",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-t5UhMyNuzA", "Errors.User.Code.Invalid"),
+			},
+		},
+		{
+			"email verified by self",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+						emailCodeAdded(),
+					),
+					expectFilter(
+						secretGeneratorAdded(),
+					),
+					expectPush(
+						schemauser.NewEmailVerifiedEvent(authz.NewMockContext("instanceID", "org1", "user1"),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx:  authz.NewMockContext("instanceID", "org1", "user1"),
+				id:   "user1",
+				code: "emailCode",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+				userEncryption:  crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			details, err := c.VerifySchemaUserEmail(tt.args.ctx, "", tt.args.id, tt.args.code)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.details, details)
+		})
+	}
+}
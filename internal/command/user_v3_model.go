@@ -0,0 +1,194 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+)
+
+type UserV3WriteModel struct {
+	eventstore.WriteModel
+
+	SchemaID       string
+	SchemaRevision uint64
+	Data           json.RawMessage
+
+	Email                 domain.EmailAddress
+	IsEmailVerified       bool
+	EmailCode             *crypto.CryptoValue
+	EmailCodeCreationDate time.Time
+	EmailCodeExpiry       time.Duration
+
+	Phone                 domain.PhoneNumber
+	IsPhoneVerified       bool
+	PhoneCode             *crypto.CryptoValue
+	PhoneCodeCreationDate time.Time
+	PhoneCodeExpiry       time.Duration
+
+	// Usernames are the usernames of the user by their id
+	Usernames map[string]*SchemaUserUsername
+
+	PasswordEncodedHash      string
+	PasswordChangeRequired   bool
+	PasswordCode             *crypto.CryptoValue
+	PasswordCodeCreationDate time.Time
+	PasswordCodeExpiry       time.Duration
+
+	State domain.UserState
+}
+
+type SchemaUserUsername struct {
+	Username      string
+	IsOrgSpecific bool
+}
+
+func NewUserV3WriteModel(resourceOwner, userID string) *UserV3WriteModel {
+	return &UserV3WriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		Usernames: make(map[string]*SchemaUserUsername),
+	}
+}
+
+func (wm *UserV3WriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *schemauser.CreatedEvent:
+			wm.SchemaID = e.SchemaID
+			wm.SchemaRevision = e.SchemaRevision
+			wm.Data = e.Data
+			wm.State = domain.UserStateActive
+		case *schemauser.UpdatedEvent:
+			if e.SchemaID != nil {
+				wm.SchemaID = *e.SchemaID
+			}
+			if e.SchemaRevision != nil {
+				wm.SchemaRevision = *e.SchemaRevision
+			}
+			if len(e.Data) > 0 {
+				wm.Data = e.Data
+			}
+		case *schemauser.DeletedEvent:
+			wm.State = domain.UserStateDeleted
+		case *schemauser.LockedEvent:
+			wm.State = domain.UserStateLocked
+		case *schemauser.UnlockedEvent:
+			wm.State = domain.UserStateActive
+		case *schemauser.DeactivatedEvent:
+			wm.State = domain.UserStateInactive
+		case *schemauser.ReactivatedEvent:
+			wm.State = domain.UserStateActive
+		case *schemauser.EmailUpdatedEvent:
+			wm.Email = e.Address
+			wm.IsEmailVerified = false
+			wm.EmailCode = nil
+		case *schemauser.EmailCodeAddedEvent:
+			wm.EmailCode = e.Code
+			wm.EmailCodeCreationDate = e.CreatedAt()
+			wm.EmailCodeExpiry = e.Expiry
+		case *schemauser.EmailVerifiedEvent:
+			wm.IsEmailVerified = true
+			wm.EmailCode = nil
+		case *schemauser.PhoneUpdatedEvent:
+			wm.Phone = e.Number
+			wm.IsPhoneVerified = false
+			wm.PhoneCode = nil
+		case *schemauser.PhoneCodeAddedEvent:
+			wm.PhoneCode = e.Code
+			wm.PhoneCodeCreationDate = e.CreatedAt()
+			wm.PhoneCodeExpiry = e.Expiry
+		case *schemauser.PhoneVerifiedEvent:
+			wm.IsPhoneVerified = true
+			wm.PhoneCode = nil
+		case *schemauser.UsernameAddedEvent:
+			wm.Usernames[e.ID] = &SchemaUserUsername{
+				Username:      e.Username,
+				IsOrgSpecific: e.IsOrgSpecific,
+			}
+		case *schemauser.UsernameRemovedEvent:
+			delete(wm.Usernames, e.ID)
+		case *schemauser.PasswordUpdatedEvent:
+			wm.PasswordEncodedHash = e.EncodedHash
+			wm.PasswordChangeRequired = e.ChangeRequired
+			wm.PasswordCode = nil
+		case *schemauser.PasswordCodeAddedEvent:
+			wm.PasswordCode = e.Code
+			wm.PasswordCodeCreationDate = e.CreatedAt()
+			wm.PasswordCodeExpiry = e.Expiry
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserV3WriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(schemauser.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			schemauser.CreatedType,
+			schemauser.UpdatedType,
+			schemauser.DeletedType,
+			schemauser.LockedType,
+			schemauser.UnlockedType,
+			schemauser.DeactivatedType,
+			schemauser.ReactivatedType,
+			schemauser.EmailUpdatedType,
+			schemauser.EmailCodeAddedType,
+			schemauser.EmailVerifiedType,
+			schemauser.PhoneUpdatedType,
+			schemauser.PhoneCodeAddedType,
+			schemauser.PhoneVerifiedType,
+			schemauser.UsernameAddedType,
+			schemauser.UsernameRemovedType,
+			schemauser.PasswordUpdatedType,
+			schemauser.PasswordCodeAddedType,
+		).
+		Builder()
+}
+
+func (wm *UserV3WriteModel) NewUpdatedEvent(
+	ctx context.Context,
+	agg *eventstore.Aggregate,
+	schemaID string,
+	schemaRevision uint64,
+	data json.RawMessage,
+) *schemauser.UpdatedEvent {
+	changes := make([]schemauser.Changes, 0)
+	if wm.SchemaID != schemaID {
+		changes = append(changes, schemauser.ChangeSchemaID(schemaID))
+	}
+	if wm.SchemaRevision != schemaRevision {
+		changes = append(changes, schemauser.ChangeSchemaRevision(schemaRevision))
+	}
+	if data != nil && !bytes.Equal(wm.Data, data) {
+		changes = append(changes, schemauser.ChangeData(data))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return schemauser.NewUpdatedEvent(ctx, agg, changes)
+}
+
+func (wm *UserV3WriteModel) Exists() bool {
+	return wm.State.Exists()
+}
+
+func UserV3AggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            wm.AggregateID,
+		Type:          schemauser.AggregateType,
+		ResourceOwner: wm.ResourceOwner,
+		InstanceID:    wm.InstanceID,
+		Version:       schemauser.AggregateVersion,
+	}
+}
@@ -0,0 +1,143 @@
+package command
+
+import (
+	"context"
+	"io"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type SetSchemaUserPassword struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	UserID        string
+
+	Password *Password
+}
+
+func (s *SetSchemaUserPassword) Validate(hasher *crypto.Hasher) error {
+	if s.UserID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-aS3Vz5t6BS", "Errors.IDMissing")
+	}
+	if s.Password == nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-3klekNx5Sd", "Errors.User.Password.Empty")
+	}
+	return s.Password.Validate(hasher)
+}
+
+// SetSchemaUserPassword sets the password of the user.
+// The caller has to provide either the current password, a password reset code or needs the permission to change the user.
+func (c *Commands) SetSchemaUserPassword(ctx context.Context, user *SetSchemaUserPassword) (err error) {
+	if err := user.Validate(c.userPasswordHasher); err != nil {
+		return err
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, user.ResourceOwner, user.UserID)
+	if err != nil {
+		return err
+	}
+	var verification setPasswordVerification
+	switch {
+	case user.Password.OldPassword != "":
+		verification = c.checkCurrentPassword(user.Password.Password, user.Password.EncodedPasswordHash, user.Password.OldPassword, writeModel.PasswordEncodedHash)
+	case user.Password.PasswordCode != "":
+		verification = c.setPasswordWithVerifyCode(writeModel.PasswordCodeCreationDate, writeModel.PasswordCodeExpiry, writeModel.PasswordCode, user.Password.PasswordCode)
+	default:
+		verification = c.setPasswordWithPermission(writeModel.AggregateID, writeModel.ResourceOwner)
+	}
+	event, err := c.schemaUserPasswordEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel), user.Password, verification)
+	if err != nil {
+		return err
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, event); err != nil {
+		return err
+	}
+	user.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+type RequestSchemaUserPasswordReset struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	UserID        string
+
+	NotificationType domain.NotificationType
+	URLTemplate      string
+	ReturnCode       bool
+	PlainReturnCode  string
+}
+
+// RequestSchemaUserPasswordReset generates a code to set the password of the user.
+// The code is either returned or sent to the contact email or phone of the user.
+func (c *Commands) RequestSchemaUserPasswordReset(ctx context.Context, user *RequestSchemaUserPasswordReset) (err error) {
+	if user.UserID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Fb3ne6FCKV", "Errors.IDMissing")
+	}
+	if user.URLTemplate != "" {
+		if err := domain.RenderConfirmURLTemplate(io.Discard, user.URLTemplate, user.UserID, "code", "orgID"); err != nil {
+			return err
+		}
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, user.ResourceOwner, user.UserID)
+	if err != nil {
+		return err
+	}
+	if writeModel.AggregateID != authz.GetCtxData(ctx).UserID {
+		if err := c.checkPermission(ctx, domain.PermissionUserWrite, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+			return err
+		}
+	}
+	if !user.ReturnCode {
+		switch user.NotificationType {
+		case domain.NotificationTypeSms:
+			if writeModel.Phone == "" {
+				return zerrors.ThrowPreconditionFailed(nil, "COMMAND-eP0TlwXq5f", "Errors.User.Phone.Empty")
+			}
+		case domain.NotificationTypeEmail:
+			if writeModel.Email == "" {
+				return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Fm0Mwi3ntq", "Errors.User.Email.Empty")
+			}
+		}
+	}
+	code, err := c.newEncryptedCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption) //nolint:staticcheck
+	if err != nil {
+		return err
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel,
+		schemauser.NewPasswordCodeAddedEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel), code.Crypted, code.Expiry, user.NotificationType, user.URLTemplate, user.ReturnCode),
+	); err != nil {
+		return err
+	}
+	if user.ReturnCode {
+		user.PlainReturnCode = code.Plain
+	}
+	user.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+// SchemaUserPasswordCodeSent records that the password reset code was sent to the user.
+func (c *Commands) SchemaUserPasswordCodeSent(ctx context.Context, resourceOwner, id string) error {
+	if id == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-oP1mTz6BvE", "Errors.IDMissing")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, schemauser.NewPasswordCodeSentEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel)))
+	return err
+}
+
+// schemaUserPasswordEvent returns the event to set the password,
+// which is checked against the password complexity policy and hashed if not already encoded.
+func (c *Commands) schemaUserPasswordEvent(ctx context.Context, aggregate *eventstore.Aggregate, password *Password, verification setPasswordVerification) (eventstore.Command, error) {
+	encodedPassword, err := c.encodeNewPassword(ctx, aggregate.ResourceOwner, nil, password.Password, password.EncodedPasswordHash, verification)
+	if err != nil {
+		return nil, err
+	}
+	return schemauser.NewPasswordUpdatedEvent(ctx, aggregate, encodedPassword, password.ChangeRequired), nil
+}
@@ -0,0 +1,464 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func schemaUserPasswordComplexityPolicyEvent() eventstore.Event {
+	return eventFromEventPusher(
+		org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+			&org.NewAggregate("org1").Aggregate,
+			1,
+			false,
+			false,
+			false,
+			false,
+			0,
+			false,
+		),
+	)
+}
+
+func TestCommands_SetSchemaUserPassword(t *testing.T) {
+	type fields struct {
+		eventstore         func(t *testing.T) *eventstore.Eventstore
+		checkPermission    domain.PermissionCheck
+		userPasswordHasher *crypto.Hasher
+		userEncryption     crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx  context.Context
+		user *SetSchemaUserPassword
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no password, error",
+			fields{
+				eventstore:         expectEventstore(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &SetSchemaUserPassword{
+					UserID:   "user1",
+					Password: &Password{},
+				},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-3klek4sbns", "Errors.User.Password.Empty"),
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &SetSchemaUserPassword{
+					UserID:   "user1",
+					Password: &Password{Password: "password"},
+				},
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-6Nyq8ihvBZ", "Errors.User.NotFound"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+				),
+				checkPermission:    newMockPermissionCheckNotAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &SetSchemaUserPassword{
+					UserID:   "user1",
+					Password: &Password{Password: "password"},
+				},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"password set with permission",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+					expectFilter(
+						schemaUserPasswordComplexityPolicyEvent(),
+					),
+					expectPush(
+						schemauser.NewPasswordUpdatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+							true,
+						),
+					),
+				),
+				checkPermission:    newMockPermissionCheckAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &SetSchemaUserPassword{
+					UserID:   "user1",
+					Password: &Password{Password: "password", ChangeRequired: true},
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"wrong current password, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+						eventFromEventPusher(
+							schemauser.NewPasswordUpdatedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password",
+								false,
+							),
+						),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &SetSchemaUserPassword{
+					UserID:   "user1",
+					Password: &Password{Password: "password2", OldPassword: "wrong"},
+				},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-3M0fs", "Errors.User.Password.Invalid"),
+			},
+		},
+		{
+			"password set with current password",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+						eventFromEventPusher(
+							schemauser.NewPasswordUpdatedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password",
+								false,
+							),
+						),
+					),
+					expectFilter(
+						schemaUserPasswordComplexityPolicyEvent(),
+					),
+					expectPush(
+						schemauser.NewPasswordUpdatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password2",
+							false,
+						),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &SetSchemaUserPassword{
+					UserID:   "user1",
+					Password: &Password{Password: "password2", OldPassword: "password"},
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"password set with code",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+						eventFromEventPusherWithCreationDateNow(
+							schemauser.NewPasswordCodeAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("passwordCode"),
+								},
+								time.Hour,
+								domain.NotificationTypeEmail,
+								"",
+								false,
+							),
+						),
+					),
+					expectFilter(
+						schemaUserPasswordComplexityPolicyEvent(),
+					),
+					expectPush(
+						schemauser.NewPasswordUpdatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+							false,
+						),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+				userEncryption:     crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &SetSchemaUserPassword{
+					UserID:   "user1",
+					Password: &Password{Password: "password", PasswordCode: "passwordCode"},
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:         tt.fields.eventstore(t),
+				checkPermission:    tt.fields.checkPermission,
+				userPasswordHasher: tt.fields.userPasswordHasher,
+				userEncryption:     tt.fields.userEncryption,
+			}
+			err := c.SetSchemaUserPassword(tt.args.ctx, tt.args.user)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, tt.args.user.Details)
+			}
+		})
+	}
+}
+
+func TestCommands_RequestSchemaUserPasswordReset(t *testing.T) {
+	type fields struct {
+		eventstore       func(t *testing.T) *eventstore.Eventstore
+		checkPermission  domain.PermissionCheck
+		newEncryptedCode encrypedCodeFunc
+	}
+	type args struct {
+		ctx  context.Context
+		user *RequestSchemaUserPasswordReset
+	}
+	type res struct {
+		returnCode string
+		details    *domain.ObjectDetails
+		err        error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"invalid url template, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &RequestSchemaUserPasswordReset{
+					UserID:      "user1",
+					URLTemplate: "{{",
+				},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "DOMAIN-oGh5e", "Errors.User.InvalidURLTemplate"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &RequestSchemaUserPasswordReset{
+					UserID:     "user1",
+					ReturnCode: true,
+				},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"send email without email, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &RequestSchemaUserPasswordReset{
+					UserID:           "user1",
+					NotificationType: domain.NotificationTypeEmail,
+				},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Fm0Mwi3ntq", "Errors.User.Email.Empty"),
+			},
+		},
+		{
+			"send email",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+						eventFromEventPusher(
+							schemauser.NewEmailUpdatedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"test@example.com",
+							),
+						),
+					),
+					expectPush(
+						schemauser.NewPasswordCodeAddedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("passwordCode"),
+							},
+							time.Hour,
+							domain.NotificationTypeEmail,
+							"https://example.com/password?userID={{.UserID}}&code={{.Code}}",
+							false,
+						),
+					),
+				),
+				checkPermission:  newMockPermissionCheckAllowed(),
+				newEncryptedCode: mockEncryptedCode("passwordCode", time.Hour),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &RequestSchemaUserPasswordReset{
+					UserID:           "user1",
+					NotificationType: domain.NotificationTypeEmail,
+					URLTemplate:      "https://example.com/password?userID={{.UserID}}&code={{.Code}}",
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"return code",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+					expectPush(
+						schemauser.NewPasswordCodeAddedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("passwordCode"),
+							},
+							time.Hour,
+							domain.NotificationTypeEmail,
+							"",
+							true,
+						),
+					),
+				),
+				checkPermission:  newMockPermissionCheckAllowed(),
+				newEncryptedCode: mockEncryptedCode("passwordCode", time.Hour),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &RequestSchemaUserPasswordReset{
+					UserID:     "user1",
+					ReturnCode: true,
+				},
+			},
+			res{
+				returnCode: "passwordCode",
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				checkPermission:  tt.fields.checkPermission,
+				newEncryptedCode: tt.fields.newEncryptedCode,
+			}
+			err := c.RequestSchemaUserPasswordReset(tt.args.ctx, tt.args.user)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, tt.args.user.Details)
+				assert.Equal(t, tt.res.returnCode, tt.args.user.PlainReturnCode)
+			}
+		})
+	}
+}
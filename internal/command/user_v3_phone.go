@@ -0,0 +1,177 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ChangeSchemaUserPhone struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Phone      *Phone
+	ReturnCode string
+}
+
+func (s *ChangeSchemaUserPhone) Valid() error {
+	if s.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-NOU4ZQwV92", "Errors.IDMissing")
+	}
+	if s.Phone == nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-u28YH4mCOR", "Errors.User.Phone.Empty")
+	}
+	number, err := s.Phone.Number.Normalize()
+	if err != nil {
+		return err
+	}
+	s.Phone.Number = number
+	return nil
+}
+
+// ChangeSchemaUserPhone sets the contact phone of the user.
+// Unless the email is set as verified, a verification code is generated,
+// which is either returned or sent to the user.
+func (c *Commands) ChangeSchemaUserPhone(ctx context.Context, user *ChangeSchemaUserPhone) (err error) {
+	if err := user.Valid(); err != nil {
+		return err
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, user.ResourceOwner, user.ID)
+	if err != nil {
+		return err
+	}
+	if err := c.checkPermissionUpdateUser(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return err
+	}
+	events, plainCode, err := c.schemaUserPhoneEvents(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel), user.Phone)
+	if err != nil {
+		return err
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, events...); err != nil {
+		return err
+	}
+	user.ReturnCode = plainCode
+	user.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+type ResendSchemaUserPhoneCode struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	ReturnCode      bool
+	PlainReturnCode string
+}
+
+// ResendSchemaUserPhoneCode generates a new verification code for the unverified contact phone of the user.
+func (c *Commands) ResendSchemaUserPhoneCode(ctx context.Context, user *ResendSchemaUserPhoneCode) (err error) {
+	if user.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-tX8UP1edj8", "Errors.IDMissing")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, user.ResourceOwner, user.ID)
+	if err != nil {
+		return err
+	}
+	if err := c.checkPermissionUpdateUser(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return err
+	}
+	if writeModel.PhoneCode == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-AEs1cT0qoI", "Errors.User.Code.Empty")
+	}
+	event, plainCode, err := c.schemaUserPhoneCodeEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel), user.ReturnCode)
+	if err != nil {
+		return err
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, event); err != nil {
+		return err
+	}
+	user.PlainReturnCode = plainCode
+	user.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+// VerifySchemaUserPhone verifies the contact phone of the user with the code.
+// A failed verification is recorded before the error is returned.
+func (c *Commands) VerifySchemaUserPhone(ctx context.Context, resourceOwner, id, code string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-a5vnt9perb", "Errors.IDMissing")
+	}
+	if code == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-4pyLcJN4Mp", "Errors.User.Code.Empty")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.AggregateID != authz.GetCtxData(ctx).UserID {
+		if err := c.checkPermission(ctx, domain.PermissionUserWrite, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+			return nil, err
+		}
+	}
+	if writeModel.PhoneCode == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-mwU33s0Kdx", "Errors.User.Code.Empty")
+	}
+	aggregate := UserV3AggregateFromWriteModel(&writeModel.WriteModel)
+	err = verifyEncryptedCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, writeModel.PhoneCodeCreationDate, writeModel.PhoneCodeExpiry, writeModel.PhoneCode, code) //nolint:staticcheck
+	if err != nil {
+		_, pushErr := c.eventstore.Push(ctx, schemauser.NewPhoneVerificationFailedEvent(ctx, aggregate))
+		logging.WithFields("id", "COMMAND-pmTB67gJkp", "userID", id).OnError(pushErr).Error("NewPhoneVerificationFailedEvent push failed")
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-oLKKsZ1JaR", "Errors.User.Code.Invalid")
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, schemauser.NewPhoneVerifiedEvent(ctx, aggregate)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// SchemaUserPhoneCodeSent records that the verification code was sent to the contact phone of the user.
+func (c *Commands) SchemaUserPhoneCodeSent(ctx context.Context, resourceOwner, id string) error {
+	if id == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-gH3lq8Xb0R", "Errors.IDMissing")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, resourceOwner, id)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, schemauser.NewPhoneCodeSentEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel)))
+	return err
+}
+
+// schemaUserPhoneEvents returns the events to set the contact phone
+// and the plain verification code, if it should be returned.
+func (c *Commands) schemaUserPhoneEvents(ctx context.Context, aggregate *eventstore.Aggregate, phone *Phone) ([]eventstore.Command, string, error) {
+	events := []eventstore.Command{
+		schemauser.NewPhoneUpdatedEvent(ctx, aggregate, phone.Number),
+	}
+	if phone.Verified {
+		return append(events, schemauser.NewPhoneVerifiedEvent(ctx, aggregate)), "", nil
+	}
+	codeEvent, plainCode, err := c.schemaUserPhoneCodeEvent(ctx, aggregate, phone.ReturnCode)
+	if err != nil {
+		return nil, "", err
+	}
+	return append(events, codeEvent), plainCode, nil
+}
+
+// schemaUserPhoneCodeEvent returns the event of a new verification code
+// and the plain code, if it should be returned.
+// Codes which are not returned are sent to the user by the notification handler.
+func (c *Commands) schemaUserPhoneCodeEvent(ctx context.Context, aggregate *eventstore.Aggregate, returnCode bool) (eventstore.Command, string, error) {
+	code, err := c.newPhoneCode(ctx, c.eventstore.Filter, c.userEncryption) //nolint:staticcheck
+	if err != nil {
+		return nil, "", err
+	}
+	var plainCode string
+	if returnCode {
+		plainCode = code.Plain
+	}
+	return schemauser.NewPhoneCodeAddedEvent(ctx, aggregate, code.Crypted, code.Expiry, returnCode), plainCode, nil
+}
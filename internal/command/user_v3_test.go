@@ -0,0 +1,779 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user/schema"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const testUserSchema = `{
+	"$schema": "urn:zitadel:schema:v1",
+	"type": "object",
+	"properties": {
+		"name": {
+			"type": "string",
+			"urn:zitadel:schema:permission": {
+				"owner": "rw",
+				"self": "r"
+			}
+		}
+	},
+	"required": ["name"]
+}`
+
+func userSchemaCreatedEvent(ctx context.Context) eventstore.Event {
+	return eventFromEventPusher(
+		schema.NewCreatedEvent(ctx,
+			&schema.NewAggregate("schema1", "instanceID").Aggregate,
+			"type",
+			json.RawMessage(testUserSchema),
+			[]domain.AuthenticatorType{domain.AuthenticatorTypeUsername},
+		),
+	)
+}
+
+func schemaUserCreatedEvent(ctx context.Context) eventstore.Event {
+	return eventFromEventPusher(
+		schemauser.NewCreatedEvent(ctx,
+			&schemauser.NewAggregate("user1", "org1").Aggregate,
+			"schema1",
+			1,
+			json.RawMessage(`{"name": "user"}`),
+		),
+	)
+}
+
+func TestCommands_CreateSchemaUser(t *testing.T) {
+	type fields struct {
+		eventstore         func(t *testing.T) *eventstore.Eventstore
+		idGenerator        id.Generator
+		checkPermission    domain.PermissionCheck
+		newEncryptedCode   encrypedCodeFunc
+		userPasswordHasher *crypto.Hasher
+	}
+	type args struct {
+		ctx  context.Context
+		user *CreateSchemaUser
+	}
+	type res struct {
+		returnCodeEmail string
+		usernameIDs     []string
+		details         *domain.ObjectDetails
+		err             error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no resource owner, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:  authz.NewMockContext("instanceID", "", ""),
+				user: &CreateSchemaUser{},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-urEJKa1tJM", "Errors.ResourceOwnerMissing"),
+			},
+		},
+		{
+			"no schema id, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &CreateSchemaUser{
+					ResourceOwner: "org1",
+				},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-TFo06JgnF2", "Errors.UserSchema.ID.Missing"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &CreateSchemaUser{
+					ResourceOwner: "org1",
+					SchemaID:      "schema1",
+				},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"schema not active, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userSchemaCreatedEvent(context.Background()),
+						eventFromEventPusher(
+							schema.NewDeactivatedEvent(context.Background(),
+								&schema.NewAggregate("schema1", "instanceID").Aggregate,
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &CreateSchemaUser{
+					ResourceOwner: "org1",
+					SchemaID:      "schema1",
+				},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-N9TP0gHAe8", "Errors.UserSchema.NotActive"),
+			},
+		},
+		{
+			"data not matching schema, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userSchemaCreatedEvent(context.Background()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &CreateSchemaUser{
+					ResourceOwner: "org1",
+					SchemaID:      "schema1",
+					Data:          json.RawMessage(`{"name": 1}`),
+				},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-SlKXqLSeL6", "Errors.UserSchema.Data.Invalid"),
+			},
+		},
+		{
+			"user already exists, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userSchemaCreatedEvent(context.Background()),
+					),
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &CreateSchemaUser{
+					ResourceOwner: "org1",
+					SchemaID:      "schema1",
+					ID:            "user1",
+					Data:          json.RawMessage(`{"name": "user"}`),
+				},
+			},
+			res{
+				err: zerrors.ThrowAlreadyExists(nil, "COMMAND-Nn8CRVlkeZ", "Errors.User.AlreadyExists"),
+			},
+		},
+		{
+			"user created",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userSchemaCreatedEvent(context.Background()),
+					),
+					expectFilter(),
+					expectPush(
+						schemauser.NewCreatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"schema1",
+							1,
+							json.RawMessage(`{"name":"user"}`),
+						),
+					),
+				),
+				idGenerator:     mock.ExpectID(t, "user1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &CreateSchemaUser{
+					ResourceOwner: "org1",
+					SchemaID:      "schema1",
+					Data:          json.RawMessage(`{"name": "user"}`),
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"user created with email, return code",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userSchemaCreatedEvent(context.Background()),
+					),
+					expectFilter(),
+					expectPush(
+						schemauser.NewCreatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"schema1",
+							1,
+							json.RawMessage(`{"name":"user"}`),
+						),
+						schemauser.NewEmailUpdatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"test@example.com",
+						),
+						schemauser.NewEmailCodeAddedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("emailCode"),
+							},
+							time.Hour,
+							"",
+							true,
+						),
+					),
+				),
+				checkPermission:  newMockPermissionCheckAllowed(),
+				newEncryptedCode: mockEncryptedCode("emailCode", time.Hour),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &CreateSchemaUser{
+					ResourceOwner: "org1",
+					SchemaID:      "schema1",
+					ID:            "user1",
+					Data:          json.RawMessage(`{"name": "user"}`),
+					Email: &Email{
+						Address:    "test@example.com",
+						ReturnCode: true,
+					},
+				},
+			},
+			res{
+				returnCodeEmail: "emailCode",
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"user created with username and password",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userSchemaCreatedEvent(context.Background()),
+					),
+					expectFilter(),
+					expectFilter(
+						schemaUserPasswordComplexityPolicyEvent(),
+					),
+					expectPush(
+						schemauser.NewCreatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"schema1",
+							1,
+							json.RawMessage(`{"name":"user"}`),
+						),
+						schemauser.NewUsernameAddedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"username1",
+							"username",
+							false,
+						),
+						schemauser.NewPasswordUpdatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+							true,
+						),
+					),
+				),
+				idGenerator:        mock.ExpectID(t, "username1"),
+				checkPermission:    newMockPermissionCheckAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &CreateSchemaUser{
+					ResourceOwner: "org1",
+					SchemaID:      "schema1",
+					ID:            "user1",
+					Data:          json.RawMessage(`{"name": "user"}`),
+					Usernames:     []*Username{{Username: "username"}},
+					Password:      &Password{Password: "password", ChangeRequired: true},
+				},
+			},
+			res{
+				usernameIDs: []string{"username1"},
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:         tt.fields.eventstore(t),
+				idGenerator:        tt.fields.idGenerator,
+				checkPermission:    tt.fields.checkPermission,
+				newEncryptedCode:   tt.fields.newEncryptedCode,
+				userPasswordHasher: tt.fields.userPasswordHasher,
+			}
+			err := c.CreateSchemaUser(tt.args.ctx, tt.args.user)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, tt.args.user.Details)
+				assert.Equal(t, tt.res.returnCodeEmail, tt.args.user.ReturnCodeEmail)
+				if tt.res.usernameIDs != nil {
+					assert.Equal(t, tt.res.usernameIDs, tt.args.user.UsernameIDs)
+				}
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeSchemaUser(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx  context.Context
+		user *ChangeSchemaUser
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no id, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:  authz.NewMockContext("instanceID", "", ""),
+				user: &ChangeSchemaUser{},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-gEJR1QOGHb", "Errors.IDMissing"),
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &ChangeSchemaUser{
+					ID: "user1",
+				},
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-6Nyq8ihvBZ", "Errors.User.NotFound"),
+			},
+		},
+		{
+			"self change of read only data, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+					expectFilter(
+						userSchemaCreatedEvent(context.Background()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "org1", "user1"),
+				user: &ChangeSchemaUser{
+					ID:   "user1",
+					Data: json.RawMessage(`{"name": "changed"}`),
+				},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-SlKXqLSeL6", "Errors.UserSchema.Data.Invalid"),
+			},
+		},
+		{
+			"no changes",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+					expectFilter(
+						userSchemaCreatedEvent(context.Background()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &ChangeSchemaUser{
+					ID:   "user1",
+					Data: json.RawMessage(`{"name": "user"}`),
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"data changed",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+					expectFilter(
+						userSchemaCreatedEvent(context.Background()),
+					),
+					expectPush(
+						schemauser.NewUpdatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							[]schemauser.Changes{
+								schemauser.ChangeData(json.RawMessage(`{"name":"changed"}`)),
+							},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &ChangeSchemaUser{
+					ID:   "user1",
+					Data: json.RawMessage(`{"name": "changed"}`),
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"schema changed",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							schema.NewCreatedEvent(context.Background(),
+								&schema.NewAggregate("schema2", "instanceID").Aggregate,
+								"type2",
+								json.RawMessage(testUserSchema),
+								[]domain.AuthenticatorType{domain.AuthenticatorTypeUsername},
+							),
+						),
+					),
+					expectPush(
+						schemauser.NewUpdatedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							[]schemauser.Changes{
+								schemauser.ChangeSchemaID("schema2"),
+							},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &ChangeSchemaUser{
+					ID:       "user1",
+					SchemaID: gu.Ptr("schema2"),
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			err := c.ChangeSchemaUser(tt.args.ctx, tt.args.user)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, tt.args.user.Details)
+			}
+		})
+	}
+}
+
+func TestCommands_LockSchemaUser(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no id, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Eu8I2VAfjF", "Errors.IDMissing"),
+			},
+		},
+		{
+			"user already locked, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+						eventFromEventPusher(
+							schemauser.NewLockedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-G4LOrnjY7q", "Errors.User.ShouldBeActiveOrInitial"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"user locked",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+					expectPush(
+						schemauser.NewLockedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.LockSchemaUser(tt.args.ctx, "", tt.args.id)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.details, details)
+		})
+	}
+}
+
+func TestCommands_DeleteSchemaUser(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"user already deleted, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+						eventFromEventPusher(
+							schemauser.NewDeletedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-6Nyq8ihvBZ", "Errors.User.NotFound"),
+			},
+		},
+		{
+			"user deleted",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+					expectPush(
+						schemauser.NewDeletedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"user with username deleted",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+						schemaUserUsernameAddedEvent(context.Background()),
+					),
+					expectPush(
+						schemauser.NewUsernameRemovedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"username1",
+							"username",
+							false,
+						),
+						schemauser.NewDeletedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.DeleteSchemaUser(tt.args.ctx, "", tt.args.id)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.details, details)
+		})
+	}
+}
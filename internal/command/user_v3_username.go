@@ -0,0 +1,121 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type Username struct {
+	Username      string
+	IsOrgSpecific bool
+}
+
+func (u *Username) Validate() error {
+	u.Username = strings.TrimSpace(u.Username)
+	if u.Username == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-bV8bZtoMjm", "Errors.User.Username.Empty")
+	}
+	return nil
+}
+
+type AddSchemaUserUsername struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	UserID        string
+
+	Username *Username
+	// ID is the generated id of the username
+	ID string
+}
+
+func (s *AddSchemaUserUsername) Valid() error {
+	if s.UserID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-oGCKIcd7TF", "Errors.IDMissing")
+	}
+	if s.Username == nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-l9cCmdwChE", "Errors.User.Username.Empty")
+	}
+	return s.Username.Validate()
+}
+
+// AddSchemaUserUsername adds a username to the user, which can be used to identify the user during the login.
+// Usernames are unique across all users, org specific usernames are only unique inside the organization.
+func (c *Commands) AddSchemaUserUsername(ctx context.Context, username *AddSchemaUserUsername) (err error) {
+	if err := username.Valid(); err != nil {
+		return err
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, username.ResourceOwner, username.UserID)
+	if err != nil {
+		return err
+	}
+	if err := c.checkPermissionUpdateUser(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return err
+	}
+	event, id, err := c.schemaUserUsernameEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel), username.Username)
+	if err != nil {
+		return err
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, event); err != nil {
+		return err
+	}
+	username.ID = id
+	username.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+// RemoveSchemaUserUsername removes the username of the user, so it can be used by other users.
+func (c *Commands) RemoveSchemaUserUsername(ctx context.Context, resourceOwner, userID, usernameID string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-J6ybG5WZiy", "Errors.IDMissing")
+	}
+	if usernameID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-PoSU5BOZCi", "Errors.IDMissing")
+	}
+	writeModel, err := c.existingUserV3WriteModel(ctx, resourceOwner, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateUser(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	username, ok := writeModel.Usernames[usernameID]
+	if !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-uEii8L6Awp", "Errors.User.Username.NotFound")
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel,
+		schemauser.NewUsernameRemovedEvent(ctx, UserV3AggregateFromWriteModel(&writeModel.WriteModel), usernameID, username.Username, username.IsOrgSpecific),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// schemaUserUsernameEvent returns the event to add the username and the generated id of the username.
+func (c *Commands) schemaUserUsernameEvent(ctx context.Context, aggregate *eventstore.Aggregate, username *Username) (eventstore.Command, string, error) {
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, "", err
+	}
+	return schemauser.NewUsernameAddedEvent(ctx, aggregate, id, username.Username, username.IsOrgSpecific), id, nil
+}
+
+// schemaUserUsernamesRemovedEvents returns the events to remove all usernames of the user,
+// which releases the usernames for other users.
+func schemaUserUsernamesRemovedEvents(ctx context.Context, aggregate *eventstore.Aggregate, usernames map[string]*SchemaUserUsername) []eventstore.Command {
+	ids := make([]string, 0, len(usernames))
+	for id := range usernames {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	events := make([]eventstore.Command, 0, len(ids))
+	for _, id := range ids {
+		events = append(events, schemauser.NewUsernameRemovedEvent(ctx, aggregate, id, usernames[id].Username, usernames[id].IsOrgSpecific))
+	}
+	return events
+}
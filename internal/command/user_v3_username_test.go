@@ -0,0 +1,250 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func schemaUserUsernameAddedEvent(ctx context.Context) eventstore.Event {
+	return eventFromEventPusher(
+		schemauser.NewUsernameAddedEvent(ctx,
+			&schemauser.NewAggregate("user1", "org1").Aggregate,
+			"username1",
+			"username",
+			false,
+		),
+	)
+}
+
+func TestCommands_AddSchemaUserUsername(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx      context.Context
+		username *AddSchemaUserUsername
+	}
+	type res struct {
+		id      string
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no username, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				username: &AddSchemaUserUsername{
+					UserID:   "user1",
+					Username: &Username{Username: " "},
+				},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-bV8bZtoMjm", "Errors.User.Username.Empty"),
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				username: &AddSchemaUserUsername{
+					UserID:   "user1",
+					Username: &Username{Username: "username"},
+				},
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-6Nyq8ihvBZ", "Errors.User.NotFound"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				username: &AddSchemaUserUsername{
+					UserID:   "user1",
+					Username: &Username{Username: "username"},
+				},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"username added",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+					expectPush(
+						schemauser.NewUsernameAddedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"username1",
+							"username",
+							true,
+						),
+					),
+				),
+				idGenerator:     mock.ExpectID(t, "username1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				username: &AddSchemaUserUsername{
+					UserID:   "user1",
+					Username: &Username{Username: " username ", IsOrgSpecific: true},
+				},
+			},
+			res{
+				id: "username1",
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			err := c.AddSchemaUserUsername(tt.args.ctx, tt.args.username)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, tt.args.username.Details)
+				assert.Equal(t, tt.res.id, tt.args.username.ID)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveSchemaUserUsername(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx        context.Context
+		userID     string
+		usernameID string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no username id, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:    authz.NewMockContext("instanceID", "", ""),
+				userID: "user1",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-PoSU5BOZCi", "Errors.IDMissing"),
+			},
+		},
+		{
+			"username not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:        authz.NewMockContext("instanceID", "", ""),
+				userID:     "user1",
+				usernameID: "username1",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-uEii8L6Awp", "Errors.User.Username.NotFound"),
+			},
+		},
+		{
+			"username removed",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent(context.Background()),
+						schemaUserUsernameAddedEvent(context.Background()),
+					),
+					expectPush(
+						schemauser.NewUsernameRemovedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"username1",
+							"username",
+							false,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:        authz.NewMockContext("instanceID", "", ""),
+				userID:     "user1",
+				usernameID: "username1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.RemoveSchemaUserUsername(tt.args.ctx, "", tt.args.userID, tt.args.usernameID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.details, details)
+		})
+	}
+}
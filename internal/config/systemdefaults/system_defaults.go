@@ -4,16 +4,18 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/crypto/breach"
 )
 
 type SystemDefaults struct {
-	SecretGenerators   SecretGenerators
-	PasswordHasher     crypto.HashConfig
-	SecretHasher       crypto.HashConfig
-	Multifactors       MultifactorConfig
-	DomainVerification DomainVerification
-	Notifications      Notifications
-	KeyConfig          KeyConfig
+	SecretGenerators    SecretGenerators
+	PasswordHasher      crypto.HashConfig
+	SecretHasher        crypto.HashConfig
+	PasswordBreachCheck breach.Config
+	Multifactors        MultifactorConfig
+	DomainVerification  DomainVerification
+	Notifications       Notifications
+	KeyConfig           KeyConfig
 }
 
 type SecretGenerators struct {
@@ -0,0 +1,53 @@
+package breach
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type CheckerType string
+
+const (
+	CheckerTypeNone CheckerType = ""
+	CheckerTypeFile CheckerType = "file"
+	CheckerTypeHTTP CheckerType = "http"
+)
+
+// Checker checks passwords against a corpus of known breached passwords.
+type Checker interface {
+	// Breached returns true if the password is part of the corpus.
+	Breached(ctx context.Context, password string) (bool, error)
+}
+
+type Config struct {
+	// Type of the checker, either empty (disabled), "file" or "http"
+	Type CheckerType
+	File FileConfig
+	HTTP HTTPConfig
+}
+
+// NewChecker returns the configured [Checker] or nil if the check is disabled.
+func (c *Config) NewChecker(client *http.Client) (Checker, error) {
+	switch c.Type {
+	case CheckerTypeNone:
+		return nil, nil
+	case CheckerTypeFile:
+		return NewFileChecker(c.File)
+	case CheckerTypeHTTP:
+		return NewHTTPChecker(c.HTTP, client)
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "BREACH-Eif4o", "unknown password breach checker type %q", c.Type)
+	}
+}
+
+// hashPassword returns the upper case hex encoded SHA-1 hash of the password,
+// which is the format used by breach corpora like Have I Been Pwned.
+func hashPassword(password string) string {
+	sum := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
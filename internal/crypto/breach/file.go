@@ -0,0 +1,143 @@
+package breach
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const defaultFalsePositiveRate = 0.001
+
+type FileConfig struct {
+	// Path to a file containing one upper case hex encoded SHA-1 hash per line.
+	// The Have I Been Pwned format "HASH:COUNT" is supported as well.
+	Path string
+	// FalsePositiveRate of the bloom filter the hashes are loaded into
+	FalsePositiveRate float64
+}
+
+// FileChecker checks passwords against a local list of SHA-1 hashes.
+// The hashes are held in a bloom filter, so a password might be falsely
+// reported as breached with the configured false positive rate.
+type FileChecker struct {
+	filter *bloomFilter
+}
+
+func NewFileChecker(config FileConfig) (*FileChecker, error) {
+	file, err := os.Open(config.Path)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "BREACH-ooG4a", "unable to open password breach file")
+	}
+	defer file.Close()
+	count, err := countLines(file)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, zerrors.ThrowInternal(err, "BREACH-Aesh3", "unable to read password breach file")
+	}
+	return newFileChecker(file, count, config.FalsePositiveRate)
+}
+
+func newFileChecker(r io.Reader, count uint64, falsePositiveRate float64) (*FileChecker, error) {
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = defaultFalsePositiveRate
+	}
+	filter := newBloomFilter(count, falsePositiveRate)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		digest, ok := parseHashLine(scanner.Text())
+		if !ok {
+			continue
+		}
+		filter.add(digest)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "BREACH-Ied3u", "unable to read password breach file")
+	}
+	return &FileChecker{filter: filter}, nil
+}
+
+func (c *FileChecker) Breached(_ context.Context, password string) (bool, error) {
+	digest, _ := hex.DecodeString(hashPassword(password))
+	return c.filter.contains(digest), nil
+}
+
+func countLines(r io.Reader) (uint64, error) {
+	var count uint64
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		count++
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, zerrors.ThrowInternal(err, "BREACH-Zoh6e", "unable to read password breach file")
+	}
+	return count, nil
+}
+
+// parseHashLine returns the decoded SHA-1 hash of a line in the format "HASH[:COUNT]".
+func parseHashLine(line string) ([]byte, bool) {
+	hash, _, _ := strings.Cut(strings.TrimSpace(line), ":")
+	if len(hash) != 40 {
+		return nil, false
+	}
+	digest, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, false
+	}
+	return digest, true
+}
+
+type bloomFilter struct {
+	bits   []uint64
+	size   uint64
+	hashes uint64
+}
+
+func newBloomFilter(n uint64, p float64) *bloomFilter {
+	if n == 0 {
+		n = 1
+	}
+	size := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	hashes := uint64(math.Max(1, math.Round(float64(size)/float64(n)*math.Ln2)))
+	return &bloomFilter{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+	}
+}
+
+// indexes uses double hashing on the already uniformly distributed SHA-1 digest.
+func (f *bloomFilter) indexes(digest []byte) func(i uint64) uint64 {
+	h1 := binary.BigEndian.Uint64(digest[0:8])
+	h2 := binary.BigEndian.Uint64(digest[8:16]) | 1
+	return func(i uint64) uint64 {
+		return (h1 + i*h2) % f.size
+	}
+}
+
+func (f *bloomFilter) add(digest []byte) {
+	index := f.indexes(digest)
+	for i := uint64(0); i < f.hashes; i++ {
+		idx := index(i)
+		f.bits[idx/64] |= 1 << (idx % 64)
+	}
+}
+
+func (f *bloomFilter) contains(digest []byte) bool {
+	index := f.indexes(digest)
+	for i := uint64(0); i < f.hashes; i++ {
+		idx := index(i)
+		if f.bits[idx/64]&(1<<(idx%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package breach
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileChecker_Breached(t *testing.T) {
+	corpus := strings.Join([]string{
+		hashPassword("password") + ":3861493",
+		hashPassword("123456"),
+		"invalid line",
+		"",
+	}, "\n")
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte(corpus), 0600))
+
+	checker, err := NewFileChecker(FileConfig{Path: path, FalsePositiveRate: 0.0001})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{
+			name:     "breached with count",
+			password: "password",
+			want:     true,
+		},
+		{
+			name:     "breached without count",
+			password: "123456",
+			want:     true,
+		},
+		{
+			name:     "not breached",
+			password: "Correct-Horse-Battery-Staple-42",
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checker.Breached(context.Background(), tt.password)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewFileChecker_missingFile(t *testing.T) {
+	_, err := NewFileChecker(FileConfig{Path: filepath.Join(t.TempDir(), "missing.txt")})
+	assert.Error(t, err)
+}
+
+func Test_bloomFilter_falsePositiveRate(t *testing.T) {
+	const n = 10000
+	filter := newBloomFilter(n, 0.01)
+	for i := 0; i < n; i++ {
+		digest, _ := parseHashLine(hashPassword("breached" + strconv.Itoa(i)))
+		filter.add(digest)
+	}
+	var falsePositives int
+	for i := 0; i < n; i++ {
+		digest, _ := parseHashLine(hashPassword("unique" + strconv.Itoa(i)))
+		if filter.contains(digest) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, float64(falsePositives)/n, 0.02)
+}
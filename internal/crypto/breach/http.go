@@ -0,0 +1,81 @@
+package breach
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const defaultRangeEndpoint = "https://api.pwnedpasswords.com/range/"
+
+type HTTPConfig struct {
+	// Endpoint of a Have I Been Pwned range API compatible service.
+	// The first five characters of the SHA-1 hash are appended to it.
+	Endpoint string
+	Timeout  time.Duration
+}
+
+// HTTPChecker checks passwords using the k-anonymity model of the range API:
+// only the first five characters of the password hash leave the system.
+type HTTPChecker struct {
+	endpoint string
+	timeout  time.Duration
+	client   *http.Client
+}
+
+func NewHTTPChecker(config HTTPConfig, client *http.Client) (*HTTPChecker, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = defaultRangeEndpoint
+	}
+	return &HTTPChecker{
+		endpoint: endpoint,
+		timeout:  config.Timeout,
+		client:   client,
+	}, nil
+}
+
+func (c *HTTPChecker) Breached(ctx context.Context, password string) (bool, error) {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	hash := hashPassword(password)
+	prefix, suffix := hash[:5], hash[5:]
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+prefix, nil)
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "BREACH-Ou3ai", "unable to create password breach request")
+	}
+	req.Header.Set("Add-Padding", "true")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, zerrors.ThrowUnavailable(err, "BREACH-aiW6u", "password breach service unavailable")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, zerrors.ThrowUnavailablef(nil, "BREACH-Ohn4e", "password breach service returned status %d", resp.StatusCode)
+	}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lineSuffix, count, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !strings.EqualFold(lineSuffix, suffix) {
+			continue
+		}
+		// padded entries have a count of 0
+		n, err := strconv.ParseUint(count, 10, 64)
+		return err != nil || n > 0, nil
+	}
+	if err = scanner.Err(); err != nil {
+		return false, zerrors.ThrowInternal(err, "BREACH-ieB0o", "unable to read password breach response")
+	}
+	return false, nil
+}
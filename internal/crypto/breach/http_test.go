@@ -0,0 +1,76 @@
+package breach
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPChecker_Breached(t *testing.T) {
+	breached := hashPassword("password")
+	padded := hashPassword("padded")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		prefix := strings.TrimPrefix(r.URL.Path, "/range/")
+		assert.Len(t, prefix, 5)
+		assert.Equal(t, "true", r.Header.Get("Add-Padding"))
+		switch prefix {
+		case breached[:5]:
+			fmt.Fprintf(w, "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n%s:3861493\r\n", breached[5:])
+		case padded[:5]:
+			fmt.Fprintf(w, "%s:0\r\n", padded[5:])
+		case hashPassword("unavailable")[:5]:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			fmt.Fprint(w, "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n")
+		}
+	}))
+	defer server.Close()
+
+	checker, err := NewHTTPChecker(HTTPConfig{Endpoint: server.URL + "/range/"}, server.Client())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name:     "breached",
+			password: "password",
+			want:     true,
+		},
+		{
+			name:     "padding entry, not breached",
+			password: "padded",
+			want:     false,
+		},
+		{
+			name:     "not breached",
+			password: "Correct-Horse-Battery-Staple-42",
+			want:     false,
+		},
+		{
+			name:     "service unavailable, error",
+			password: "unavailable",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checker.Breached(context.Background(), tt.password)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
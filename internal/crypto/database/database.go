@@ -41,6 +41,9 @@ func (d *Database) ReadKeys() (crypto.Keys, error) {
 	keys := make(map[string]string)
 	stmt, args, err := sq.Select(encryptionKeysIDCol, encryptionKeysKeyCol).
 		From(EncryptionKeysTable).
+		// keys of personal data are only read by id
+		Where(sq.NotLike{encryptionKeysIDCol: crypto.PersonalDataKeyIDPrefix + "%"}).
+		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "", "unable to read keys")
@@ -132,6 +135,20 @@ func (d *Database) CreateKeys(ctx context.Context, keys ...*crypto.Key) error {
 	return nil
 }
 
+func (d *Database) DeleteKeys(ctx context.Context, ids ...string) error {
+	stmt, args, err := sq.Delete(EncryptionKeysTable).
+		Where(sq.Eq{encryptionKeysIDCol: ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return zerrors.ThrowInternal(err, "", "unable to delete keys")
+	}
+	if _, err = d.client.ExecContext(ctx, stmt, args...); err != nil {
+		return zerrors.ThrowInternal(err, "", "unable to delete keys")
+	}
+	return nil
+}
+
 func checkMasterKeyLength(masterKey string) error {
 	if length := len([]byte(masterKey)); length != 32 {
 		return zerrors.ThrowInternalf(nil, "", "masterkey must be 32 bytes, but is %d", length)
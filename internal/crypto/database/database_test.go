@@ -36,7 +36,7 @@ func Test_database_ReadKeys(t *testing.T) {
 		{
 			"query fails, error",
 			fields{
-				client:    dbMock(t, expectQueryErr("SELECT id, key FROM system.encryption_keys", sql.ErrConnDone)),
+				client:    dbMock(t, expectQueryErr("SELECT id, key FROM system.encryption_keys WHERE id NOT LIKE $1", sql.ErrConnDone, "personal-data:%")),
 				masterKey: "",
 				decrypt:   nil,
 			},
@@ -50,14 +50,15 @@ func Test_database_ReadKeys(t *testing.T) {
 			"decryption error",
 			fields{
 				client: dbMock(t, expectQueryScanErr(
-					"SELECT id, key FROM system.encryption_keys",
+					"SELECT id, key FROM system.encryption_keys WHERE id NOT LIKE $1",
 					[]string{"id", "key"},
 					[][]driver.Value{
 						{
 							"id1",
 							"key1",
 						},
-					})),
+					},
+					"personal-data:%")),
 				masterKey: "wrong key",
 				decrypt: func(encryptedKey, masterKey string) (key string, err error) {
 					return "", fmt.Errorf("wrong masterkey")
@@ -71,14 +72,15 @@ func Test_database_ReadKeys(t *testing.T) {
 			"single key ok",
 			fields{
 				client: dbMock(t, expectQuery(
-					"SELECT id, key FROM system.encryption_keys",
+					"SELECT id, key FROM system.encryption_keys WHERE id NOT LIKE $1",
 					[]string{"id", "key"},
 					[][]driver.Value{
 						{
 							"id1",
 							"key1",
 						},
-					})),
+					},
+					"personal-data:%")),
 				masterKey: "masterKey",
 				decrypt: func(encryptedKey, masterKey string) (key string, err error) {
 					return encryptedKey, nil
@@ -92,7 +94,7 @@ func Test_database_ReadKeys(t *testing.T) {
 			"multiple keys ok",
 			fields{
 				client: dbMock(t, expectQuery(
-					"SELECT id, key FROM system.encryption_keys",
+					"SELECT id, key FROM system.encryption_keys WHERE id NOT LIKE $1",
 					[]string{"id", "key"},
 					[][]driver.Value{
 						{
@@ -103,7 +105,8 @@ func Test_database_ReadKeys(t *testing.T) {
 							"id2",
 							"key2",
 						},
-					})),
+					},
+					"personal-data:%")),
 				masterKey: "masterKey",
 				decrypt: func(encryptedKey, masterKey string) (key string, err error) {
 					return encryptedKey, nil
@@ -410,6 +413,51 @@ func Test_database_CreateKeys(t *testing.T) {
 	}
 }
 
+func Test_database_DeleteKeys(t *testing.T) {
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		client db
+		ids    []string
+		res    res
+	}{
+		{
+			"delete fails, error",
+			dbMock(t, expectExec("DELETE FROM system.encryption_keys WHERE id IN ($1)", sql.ErrConnDone, "personal-data:id1")),
+			[]string{"personal-data:id1"},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, sql.ErrConnDone)
+				},
+			},
+		},
+		{
+			"delete ok",
+			dbMock(t, expectExec("DELETE FROM system.encryption_keys WHERE id IN ($1,$2)", nil, "personal-data:id1", "personal-data:id2")),
+			[]string{"personal-data:id1", "personal-data:id2"},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Database{
+				client: tt.client.db,
+			}
+			err := d.DeleteKeys(context.Background(), tt.ids...)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			} else if !tt.res.err(err) {
+				t.Errorf("got wrong err: %v", err)
+			}
+			if err := tt.client.mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func Test_checkMasterKeyLength(t *testing.T) {
 	type args struct {
 		masterKey string
@@ -8,6 +8,10 @@ import (
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
+// PersonalDataKeyIDPrefix prefixes the ids of the keys encrypting the personal data of a single aggregate.
+// These keys are not returned by [KeyStorage.ReadKeys].
+const PersonalDataKeyIDPrefix = "personal-data:"
+
 type KeyConfig struct {
 	EncryptionKeyID  string
 	DecryptionKeyIDs []string
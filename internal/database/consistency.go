@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"sync"
+)
+
+type consistencyKey struct{}
+
+// consistency is shared by all reads and writes of a request
+type consistency struct {
+	mu       sync.Mutex
+	position float64
+	primary  bool
+	// writes counts the writes on the primary the reads must reflect, e.g. triggered projections,
+	// replicated is the count of writes the replica is known to have replayed
+	writes     uint64
+	replicated uint64
+}
+
+// WithConsistency adds a holder for the required position to the context.
+// Reads of a request which requires a position or triggered projections
+// are executed on the replica once it replayed the writes of the primary, see [DB.WithReplica].
+func WithConsistency(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(consistencyKey{}).(*consistency); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, consistencyKey{}, new(consistency))
+}
+
+func consistencyFromCtx(ctx context.Context) *consistency {
+	c, _ := ctx.Value(consistencyKey{}).(*consistency)
+	return c
+}
+
+// RequirePosition requires the following reads of the context to reflect at least the given position.
+// It has no effect if the context was not created by [WithConsistency].
+func RequirePosition(ctx context.Context, position float64) {
+	c := consistencyFromCtx(ctx)
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if position > c.position {
+		c.position = position
+	}
+	c.writes++
+}
+
+// RequireReplicated requires the following reads of the context to reflect the current writes of the primary,
+// e.g. after projections were triggered on the primary.
+// It has no effect if the context was not created by [WithConsistency].
+func RequireReplicated(ctx context.Context) {
+	c := consistencyFromCtx(ctx)
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.writes++
+}
+
+// RequirePrimary routes all following reads of the context to the primary.
+// It has no effect if the context was not created by [WithConsistency].
+func RequirePrimary(ctx context.Context) {
+	c := consistencyFromCtx(ctx)
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.primary = true
+}
+
+// RequiredPosition returns the position the reads of the context must reflect
+// and if they must be executed on the primary
+func RequiredPosition(ctx context.Context) (position float64, primary bool) {
+	c := consistencyFromCtx(ctx)
+	if c == nil {
+		return 0, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.position, c.primary
+}
+
+// unreplicatedWrites returns the count of writes the reads must reflect
+// or 0 if the replica is known to have replayed them
+func (c *consistency) unreplicatedWrites() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.writes == c.replicated {
+		return 0
+	}
+	return c.writes
+}
+
+// replayed records that the replica replayed the given count of writes
+func (c *consistency) replayed(writes uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if writes > c.replicated {
+		c.replicated = writes
+	}
+}
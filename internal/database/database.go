@@ -13,6 +13,7 @@ import (
 
 	_ "github.com/zitadel/zitadel/internal/database/cockroach"
 	"github.com/zitadel/zitadel/internal/database/dialect"
+	_ "github.com/zitadel/zitadel/internal/database/embedded"
 	_ "github.com/zitadel/zitadel/internal/database/postgres"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -32,6 +33,21 @@ func (c *Config) SetConnector(connector dialect.Connector) {
 type DB struct {
 	*sql.DB
 	dialect.Database
+	// replica is nil if reads are executed on the primary
+	replica *replica
+	// beforeRead is called before the reads of [DB.QueryContext] and [DB.QueryRowContext]
+	beforeRead func(ctx context.Context, query string)
+}
+
+// WithBeforeRead returns a copy of the client which calls hook before every read of [DB.QueryContext] and [DB.QueryRowContext],
+// e.g. to wait until the read data reflect the position required by the context, see [RequiredPosition].
+func (db *DB) WithBeforeRead(hook func(ctx context.Context, query string)) *DB {
+	return &DB{
+		DB:         db.DB,
+		Database:   db.Database,
+		replica:    db.replica,
+		beforeRead: hook,
+	}
 }
 
 func (db *DB) Query(scan func(*sql.Rows) error, query string, args ...any) error {
@@ -39,8 +55,11 @@ func (db *DB) Query(scan func(*sql.Rows) error, query string, args ...any) error
 }
 
 func (db *DB) QueryContext(ctx context.Context, scan func(rows *sql.Rows) error, query string, args ...any) (err error) {
+	if db.beforeRead != nil {
+		db.beforeRead(ctx, query)
+	}
 	ctx, spanBeginTx := tracing.NewNamedSpan(ctx, "db.BeginTx")
-	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true, Isolation: sql.LevelReadCommitted})
+	tx, err := db.reader(ctx).BeginTx(ctx, &sql.TxOptions{ReadOnly: true, Isolation: sql.LevelReadCommitted})
 	spanBeginTx.EndWithError(err)
 	if err != nil {
 		return err
@@ -74,8 +93,11 @@ func (db *DB) QueryRow(scan func(*sql.Row) error, query string, args ...any) (er
 }
 
 func (db *DB) QueryRowContext(ctx context.Context, scan func(row *sql.Row) error, query string, args ...any) (err error) {
+	if db.beforeRead != nil {
+		db.beforeRead(ctx, query)
+	}
 	ctx, spanBeginTx := tracing.NewNamedSpan(ctx, "db.BeginTx")
-	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true, Isolation: sql.LevelReadCommitted})
+	tx, err := db.reader(ctx).BeginTx(ctx, &sql.TxOptions{ReadOnly: true, Isolation: sql.LevelReadCommitted})
 	spanBeginTx.EndWithError(err)
 	if err != nil {
 		return err
@@ -90,3 +90,27 @@ func TestQueryJSONObject(t *testing.T) {
 		})
 	}
 }
+
+func TestDB_WithBeforeRead(t *testing.T) {
+	const query = `select $1;`
+	client := mock.NewSQLMock(t,
+		mock.ExpectBegin(nil),
+		mock.ExpectQuery(query,
+			mock.WithQueryArgs(1),
+			mock.WithQueryResult([]string{"value"}, [][]driver.Value{{1}}),
+		),
+		mock.ExpectCommit(nil),
+	)
+	defer client.Assert(t)
+
+	var hooked []string
+	db := (&DB{DB: client.DB}).WithBeforeRead(func(_ context.Context, query string) {
+		hooked = append(hooked, query)
+	})
+	err := db.QueryRowContext(context.Background(), func(row *sql.Row) error {
+		var value int
+		return row.Scan(&value)
+	}, query, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{query}, hooked)
+}
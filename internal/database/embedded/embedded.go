@@ -0,0 +1,163 @@
+// Package embedded provides a database dialect which runs a PostgreSQL server started by ZITADEL.
+// It's meant for local development and tests, where ZITADEL should run without an external database.
+// The PostgreSQL distribution is downloaded on the first start, a local installation isn't required.
+//
+// The server is started on the first connection and reused by all connection pools of the process.
+// As it is a PostgreSQL server, all statements of the postgres dialect are used.
+package embedded
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/zitadel/zitadel/internal/database/dialect"
+	"github.com/zitadel/zitadel/internal/database/postgres"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func init() {
+	config := new(Config)
+	dialect.Register(config, config, false)
+}
+
+const (
+	DefaultDataPath = ".zitadel/postgres"
+	defaultPort     = 5433
+	defaultDatabase = "zitadel"
+	defaultVersion  = "16"
+	// adminUser is the superuser created by initdb
+	adminUser = "postgres"
+	// user is the user created by zitadel init
+	user = "zitadel"
+	// the server requires passwords, it only listens on localhost
+	adminPassword       = "postgres"
+	userPassword        = "zitadel"
+	defaultStartTimeout = 30 * time.Second
+)
+
+// versions are the supported major versions of PostgreSQL
+var versions = map[string]embeddedpostgres.PostgresVersion{
+	"14": embeddedpostgres.V14,
+	"15": embeddedpostgres.V15,
+	"16": embeddedpostgres.V16,
+}
+
+type Config struct {
+	// Version is the major version of the downloaded PostgreSQL distribution
+	Version string
+	// BinaryRepositoryURL is the maven repository the distribution is downloaded from, e.g. a mirror.
+	// Defaults to maven central.
+	BinaryRepositoryURL string
+	// BinaryPath is the directory of an already extracted distribution, which contains bin/pg_ctl.
+	// The distribution is downloaded if empty.
+	BinaryPath string
+	// DataPath contains the database cluster, the downloaded distribution and the log file.
+	// The cluster is initialized if it doesn't exist.
+	DataPath string
+	// Port the server listens on localhost
+	Port            int32
+	Database        string
+	MaxOpenConns    uint32
+	MaxIdleConns    uint32
+	MaxConnLifetime time.Duration
+	MaxConnIdleTime time.Duration
+	// StartTimeout is the maximum duration to wait until the server accepts connections
+	StartTimeout time.Duration
+}
+
+func (c *Config) MatchName(name string) bool {
+	return strings.TrimSpace(strings.ToLower(name)) == "embedded"
+}
+
+func (_ *Config) Decode(configs []interface{}) (dialect.Connector, error) {
+	connector := &Config{
+		Version:      defaultVersion,
+		DataPath:     DefaultDataPath,
+		Port:         defaultPort,
+		Database:     defaultDatabase,
+		StartTimeout: defaultStartTimeout,
+	}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           connector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, config := range configs {
+		if err = decoder.Decode(config); err != nil {
+			return nil, err
+		}
+	}
+
+	return connector, nil
+}
+
+// Connect starts the server if it isn't running yet and connects to it
+func (c *Config) Connect(useAdmin bool, pusherRatio, spoolerRatio float64, purpose dialect.DBPurpose) (*sql.DB, error) {
+	if err := start(c); err != nil {
+		return nil, err
+	}
+	return c.postgres().Connect(useAdmin, pusherRatio, spoolerRatio, purpose)
+}
+
+// postgres returns the config to connect to the embedded server
+func (c *Config) postgres() *postgres.Config {
+	return &postgres.Config{
+		Host:            "127.0.0.1",
+		Port:            c.Port,
+		Database:        c.Database,
+		MaxOpenConns:    c.MaxOpenConns,
+		MaxIdleConns:    c.MaxIdleConns,
+		MaxConnLifetime: c.MaxConnLifetime,
+		MaxConnIdleTime: c.MaxConnIdleTime,
+		User: postgres.User{
+			Username: user,
+			Password: userPassword,
+			SSL:      postgres.SSL{Mode: "disable"},
+		},
+		Admin: postgres.AdminUser{
+			ExistingDatabase: "postgres",
+			User: postgres.User{
+				Username: adminUser,
+				Password: adminPassword,
+				SSL:      postgres.SSL{Mode: "disable"},
+			},
+		},
+	}
+}
+
+func (c *Config) DatabaseName() string {
+	return c.Database
+}
+
+func (c *Config) Username() string {
+	return user
+}
+
+func (c *Config) Password() string {
+	return userPassword
+}
+
+func (c *Config) version() (embeddedpostgres.PostgresVersion, error) {
+	version, ok := versions[c.Version]
+	if !ok {
+		return "", zerrors.ThrowInvalidArgumentf(nil, "EMBED-Pho4e", "unsupported postgres version %q", c.Version)
+	}
+	return version, nil
+}
+
+// Type returns postgres, so the statements of the postgres dialect are used
+func (c *Config) Type() string {
+	return "postgres"
+}
+
+func (c *Config) Timetravel(time.Duration) string {
+	return ""
+}
@@ -0,0 +1,70 @@
+package embedded
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConfig_Decode(t *testing.T) {
+	tests := []struct {
+		name    string
+		configs []interface{}
+		want    *Config
+	}{
+		{
+			name:    "defaults",
+			configs: []interface{}{map[string]interface{}{}},
+			want: &Config{
+				Version:      defaultVersion,
+				DataPath:     DefaultDataPath,
+				Port:         defaultPort,
+				Database:     defaultDatabase,
+				StartTimeout: defaultStartTimeout,
+			},
+		},
+		{
+			name: "overwrite",
+			configs: []interface{}{map[string]interface{}{
+				"version":         15,
+				"binarypath":      "/opt/postgres",
+				"datapath":        "/tmp/zitadel",
+				"port":            "5434",
+				"maxopenconns":    10,
+				"maxconnlifetime": "30m",
+				"starttimeout":    "1m",
+			}},
+			want: &Config{
+				Version:         "15",
+				BinaryPath:      "/opt/postgres",
+				DataPath:        "/tmp/zitadel",
+				Port:            5434,
+				Database:        defaultDatabase,
+				MaxOpenConns:    10,
+				MaxConnLifetime: 30 * time.Minute,
+				StartTimeout:    time.Minute,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := new(Config).Decode(tt.configs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Errorf("Decode() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_postgres(t *testing.T) {
+	config := &Config{Port: 5434, Database: "zitadel"}
+	if got, want := config.postgres().String(false, "zitadel"), "host=127.0.0.1 port=5434 user=zitadel application_name=zitadel sslmode=disable password=zitadel dbname=zitadel"; got != want {
+		t.Errorf("user connection = %q, want %q", got, want)
+	}
+	if got, want := config.postgres().String(true, "zitadel"), "host=127.0.0.1 port=5434 user=postgres application_name=zitadel sslmode=disable password=postgres dbname=postgres"; got != want {
+		t.Errorf("admin connection = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,126 @@
+package embedded
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"sync"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database/dialect"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type server struct {
+	postgres *embeddedpostgres.EmbeddedPostgres
+	logFile  *os.File
+}
+
+var (
+	// servers are the started servers by their data path
+	servers   = make(map[string]*server)
+	serversMu sync.Mutex
+)
+
+// start downloads the PostgreSQL distribution, initializes the data directory and starts the server if it isn't running yet.
+// A server which is already listening on the port, e.g. started by another ZITADEL process, is reused.
+func start(c *Config) error {
+	dataPath, err := filepath.Abs(c.DataPath)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "EMBED-Ahs3e", "invalid data path")
+	}
+
+	serversMu.Lock()
+	defer serversMu.Unlock()
+
+	if ping(c) == nil {
+		if _, ok := servers[dataPath]; !ok {
+			logging.WithFields("port", c.Port).Info("reuse running embedded database")
+		}
+		return nil
+	}
+	// the server of this process stopped, e.g. it was killed
+	if s, ok := servers[dataPath]; ok {
+		s.stop()
+		delete(servers, dataPath)
+	}
+
+	version, err := c.version()
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dataPath, 0o700); err != nil {
+		return zerrors.ThrowInternal(err, "EMBED-ieC4a", "unable to create data directory")
+	}
+	logFile, err := os.OpenFile(filepath.Join(dataPath, "postgres.log"), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "EMBED-ooF5a", "unable to open log file")
+	}
+	config := embeddedpostgres.DefaultConfig().
+		Version(version).
+		Port(uint32(c.Port)).
+		Username(adminUser).
+		Password(adminPassword).
+		// the database of ZITADEL is created by zitadel init
+		Database("postgres").
+		DataPath(filepath.Join(dataPath, "data")).
+		// the runtime directory is removed on every start
+		RuntimePath(filepath.Join(dataPath, "runtime")).
+		CachePath(filepath.Join(dataPath, "cache")).
+		Locale("C").
+		Encoding("UTF8").
+		StartParameters(map[string]string{
+			"listen_addresses": "127.0.0.1",
+			// long data paths exceed the maximum length of unix socket paths
+			"unix_socket_directories": "",
+		}).
+		StartTimeout(c.StartTimeout).
+		Logger(logFile)
+	if c.BinaryPath != "" {
+		config = config.BinariesPath(c.BinaryPath)
+	}
+	if c.BinaryRepositoryURL != "" {
+		config = config.BinaryRepositoryURL(c.BinaryRepositoryURL)
+	}
+	s := &server{
+		postgres: embeddedpostgres.NewDatabase(config),
+		logFile:  logFile,
+	}
+	logging.WithFields("version", version, "data", dataPath).Info("start embedded database, the distribution is downloaded on the first start")
+	if err = s.postgres.Start(); err != nil {
+		logFile.Close()
+		return zerrors.ThrowInternal(err, "EMBED-Oow8e", "unable to start embedded database, see postgres.log in the data directory")
+	}
+	servers[dataPath] = s
+	logging.WithFields("port", c.Port, "data", dataPath).Info("embedded database started")
+	return nil
+}
+
+// Stop shuts down all servers started by this process
+func Stop() {
+	serversMu.Lock()
+	defer serversMu.Unlock()
+
+	for dataPath, s := range servers {
+		s.stop()
+		delete(servers, dataPath)
+	}
+}
+
+// stop requests a fast shutdown, which terminates open connections
+func (s *server) stop() {
+	err := s.postgres.Stop()
+	logging.OnError(err).Warn("unable to stop embedded database")
+	s.logFile.Close()
+}
+
+func ping(c *Config) error {
+	db, err := sql.Open("pgx", c.postgres().String(true, dialect.QueryAppName))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return db.Ping()
+}
@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ReplicaConfig struct {
+	Enabled bool
+	// Database is the connection to the replica, configured like the primary
+	Database Config
+}
+
+type replica struct {
+	client *sql.DB
+}
+
+// WithReplica returns a copy of the client which executes reads of [DB.QueryContext] and [DB.QueryRowContext]
+// on the replica.
+// Reads of a request which requires a position or triggered projections are only executed on the replica
+// if it already replayed the writes of the primary, otherwise they are executed on the primary, see [WithConsistency].
+// Transactions and writes are always executed on the primary.
+// Only streaming replicas of postgres are supported.
+func (db *DB) WithReplica(client *DB, config *ReplicaConfig) (*DB, error) {
+	if db.Type() != "postgres" || client.Type() != "postgres" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "DATAB-Nai5o", "read replicas are only supported for postgres")
+	}
+	return &DB{
+		DB:         db.DB,
+		Database:   db.Database,
+		beforeRead: db.beforeRead,
+		replica: &replica{
+			client: client.DB,
+		},
+	}, nil
+}
+
+// reader returns the client which executes the reads of the context
+func (db *DB) reader(ctx context.Context) *sql.DB {
+	if db.replica == nil {
+		return db.DB
+	}
+	c := consistencyFromCtx(ctx)
+	if c == nil {
+		return db.replica.client
+	}
+	if _, primary := RequiredPosition(ctx); primary {
+		return db.DB
+	}
+	writes := c.unreplicatedWrites()
+	if writes == 0 {
+		return db.replica.client
+	}
+	if !db.replica.replayed(ctx, db.DB) {
+		return db.DB
+	}
+	c.replayed(writes)
+	return db.replica.client
+}
+
+const (
+	primaryWALPositionStmt = "SELECT pg_current_wal_lsn()::TEXT"
+	replicaReplayedStmt    = "SELECT COALESCE(pg_last_wal_replay_lsn() >= $1::PG_LSN, FALSE)"
+)
+
+// replayed returns if the replica replayed the write-ahead log up to the current position of the primary,
+// so it reflects all writes committed on the primary before.
+func (r *replica) replayed(ctx context.Context, primary *sql.DB) bool {
+	var position string
+	if err := primary.QueryRowContext(ctx, primaryWALPositionStmt).Scan(&position); err != nil {
+		logging.WithError(err).Info("unable to query the wal position of the primary")
+		return false
+	}
+	var replayed bool
+	if err := r.client.QueryRowContext(ctx, replicaReplayedStmt, position).Scan(&replayed); err != nil {
+		logging.WithError(err).Info("unable to query the replayed wal position of the replica")
+		return false
+	}
+	return replayed
+}
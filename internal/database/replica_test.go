@@ -0,0 +1,174 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/database/mock"
+)
+
+func TestDB_reader(t *testing.T) {
+	primaryPosition := mock.ExpectQuery(primaryWALPositionStmt,
+		mock.WithQueryResult([]string{"pg_current_wal_lsn"}, [][]driver.Value{{"0/3000060"}}),
+	)
+	replicaReplayed := func(replayed bool) func(m sqlmock.Sqlmock) {
+		return mock.ExpectQuery(replicaReplayedStmt,
+			mock.WithQueryArgs("0/3000060"),
+			mock.WithQueryResult([]string{"replayed"}, [][]driver.Value{{replayed}}),
+		)
+	}
+	tests := []struct {
+		name        string
+		ctx         func() context.Context
+		primary     func(*testing.T) *mock.SQLMock
+		replica     func(*testing.T) *mock.SQLMock
+		wantReplica bool
+	}{
+		{
+			name: "no consistency",
+			ctx:  context.Background,
+			primary: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+			replica: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+			wantReplica: true,
+		},
+		{
+			name: "nothing required",
+			ctx: func() context.Context {
+				return WithConsistency(context.Background())
+			},
+			primary: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+			replica: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+			wantReplica: true,
+		},
+		{
+			name: "primary required",
+			ctx: func() context.Context {
+				ctx := WithConsistency(context.Background())
+				RequirePrimary(ctx)
+				return ctx
+			},
+			primary: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+			replica: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+			wantReplica: false,
+		},
+		{
+			name: "position required, replica replayed",
+			ctx: func() context.Context {
+				ctx := WithConsistency(context.Background())
+				RequirePosition(ctx, 1700000000.5)
+				return ctx
+			},
+			primary: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, primaryPosition)
+			},
+			replica: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, replicaReplayed(true))
+			},
+			wantReplica: true,
+		},
+		{
+			name: "projections triggered, replica behind",
+			ctx: func() context.Context {
+				ctx := WithConsistency(context.Background())
+				RequireReplicated(ctx)
+				return ctx
+			},
+			primary: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, primaryPosition)
+			},
+			replica: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, replicaReplayed(false))
+			},
+			wantReplica: false,
+		},
+		{
+			name: "primary position failed",
+			ctx: func() context.Context {
+				ctx := WithConsistency(context.Background())
+				RequireReplicated(ctx)
+				return ctx
+			},
+			primary: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, mock.ExpectQuery(primaryWALPositionStmt, mock.WithQueryErr(sql.ErrConnDone)))
+			},
+			replica: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+			wantReplica: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primaryMock := tt.primary(t)
+			defer primaryMock.Assert(t)
+			replicaMock := tt.replica(t)
+			defer replicaMock.Assert(t)
+			db := &DB{
+				DB: primaryMock.DB,
+				replica: &replica{
+					client: replicaMock.DB,
+				},
+			}
+			got := db.reader(tt.ctx())
+			assert.Equal(t, tt.wantReplica, got == replicaMock.DB)
+		})
+	}
+}
+
+func TestDB_reader_replayedOnce(t *testing.T) {
+	primaryMock := mock.NewSQLMock(t,
+		mock.ExpectQuery(primaryWALPositionStmt,
+			mock.WithQueryResult([]string{"pg_current_wal_lsn"}, [][]driver.Value{{"0/3000060"}}),
+		),
+	)
+	defer primaryMock.Assert(t)
+	replicaMock := mock.NewSQLMock(t,
+		mock.ExpectQuery(replicaReplayedStmt,
+			mock.WithQueryArgs("0/3000060"),
+			mock.WithQueryResult([]string{"replayed"}, [][]driver.Value{{true}}),
+		),
+	)
+	defer replicaMock.Assert(t)
+	db := &DB{
+		DB: primaryMock.DB,
+		replica: &replica{
+			client: replicaMock.DB,
+		},
+	}
+	ctx := WithConsistency(context.Background())
+	RequireReplicated(ctx)
+
+	// the replay is only checked until the replica reached the writes of the request
+	assert.Equal(t, replicaMock.DB, db.reader(ctx))
+	assert.Equal(t, replicaMock.DB, db.reader(ctx))
+}
+
+func TestRequirePosition(t *testing.T) {
+	ctx := WithConsistency(context.Background())
+	RequirePosition(ctx, 2)
+	RequirePosition(ctx, 1)
+	// the holder is reused
+	RequirePosition(WithConsistency(ctx), 3)
+	RequirePosition(context.Background(), 4)
+
+	position, primary := RequiredPosition(ctx)
+	assert.Equal(t, 3.0, position)
+	assert.False(t, primary)
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"net/url"
 	"strings"
 	"time"
 
@@ -45,6 +46,15 @@ type OIDCApp struct {
 	ClockSkew                time.Duration
 	AdditionalOrigins        []string
 	SkipNativeAppSuccessPage bool
+	// BackChannelLogoutURI is called with a logout token, when a session of the app's tokens is terminated
+	// (OpenID Connect Back-Channel Logout 1.0)
+	BackChannelLogoutURI string
+	// BackChannelLogoutSessionRequired requires the `sid` claim to be included in the logout token
+	BackChannelLogoutSessionRequired bool
+	// DPoPBoundAccessTokens requires the tokens of the app to be bound to a DPoP key (RFC 9449)
+	DPoPBoundAccessTokens bool
+	// RequirePushedAuthRequests requires the app to use pushed authorization requests (RFC 9126)
+	RequirePushedAuthRequests bool
 
 	State AppState
 }
@@ -123,7 +133,7 @@ const (
 )
 
 func (a *OIDCApp) IsValid() bool {
-	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() {
+	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() || !a.BackChannelLogoutURIValid() {
 		return false
 	}
 	grantTypes := a.getRequiredGrantTypes()
@@ -148,6 +158,22 @@ func (a *OIDCApp) OriginsValid() bool {
 	return true
 }
 
+// BackChannelLogoutURIValid checks that the back-channel logout uri (if set) is an absolute http(s) url without fragment
+func (a *OIDCApp) BackChannelLogoutURIValid() bool {
+	return IsValidBackChannelLogoutURI(a.BackChannelLogoutURI)
+}
+
+func IsValidBackChannelLogoutURI(uri string) bool {
+	if uri == "" {
+		return true
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != "" && parsed.Fragment == ""
+}
+
 func ContainsRequiredGrantTypes(responseTypes []OIDCResponseType, grantTypes []OIDCGrantType) bool {
 	required := RequiredOIDCGrantTypes(responseTypes, grantTypes)
 	return ContainsOIDCGrantTypes(required, grantTypes)
@@ -12,6 +12,9 @@ type SAMLApp struct {
 	EntityID    string
 	Metadata    []byte
 	MetadataURL string
+	// AllowUnsignedLogout accepts logout requests and responses without signature,
+	// even though the service provider provides signing certificates in its metadata
+	AllowUnsignedLogout bool
 
 	State AppState
 }
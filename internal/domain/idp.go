@@ -38,6 +38,11 @@ const (
 	IDPTypeGoogle
 	IDPTypeApple
 	IDPTypeSAML
+	IDPTypeOkta
+	IDPTypeKeycloak
+	IDPTypeBitbucket
+	IDPTypeDiscord
+	IDPTypeSlack
 )
 
 func (t IDPType) GetCSSClass() string {
@@ -54,6 +59,16 @@ func (t IDPType) GetCSSClass() string {
 		return "azure"
 	case IDPTypeApple:
 		return "apple"
+	case IDPTypeOkta:
+		return "okta"
+	case IDPTypeKeycloak:
+		return "keycloak"
+	case IDPTypeBitbucket:
+		return "bitbucket"
+	case IDPTypeDiscord:
+		return "discord"
+	case IDPTypeSlack:
+		return "slack"
 	case IDPTypeUnspecified,
 		IDPTypeOIDC,
 		IDPTypeJWT,
@@ -85,6 +100,12 @@ func (t IDPType) DisplayName() string {
 		return "Google"
 	case IDPTypeApple:
 		return "Apple"
+	case IDPTypeBitbucket:
+		return "Bitbucket"
+	case IDPTypeDiscord:
+		return "Discord"
+	case IDPTypeSlack:
+		return "Slack"
 	case IDPTypeUnspecified,
 		IDPTypeOIDC,
 		IDPTypeJWT,
@@ -93,7 +114,9 @@ func (t IDPType) DisplayName() string {
 		IDPTypeAzureAD,
 		IDPTypeGitHubEnterprise,
 		IDPTypeGitLabSelfHosted,
-		IDPTypeSAML:
+		IDPTypeSAML,
+		IDPTypeOkta,
+		IDPTypeKeycloak:
 		fallthrough
 	default:
 		// we should never get here, so log it
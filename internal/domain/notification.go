@@ -31,3 +31,25 @@ const (
 
 	notificationProviderTypeCount
 )
+
+type NotificationState int32
+
+const (
+	NotificationStateUnspecified NotificationState = iota
+	NotificationStateQueued
+	NotificationStateSent
+	NotificationStateFailed
+	NotificationStateDeadLetter
+
+	notificationStateCount
+)
+
+func (s NotificationState) Exists() bool {
+	return s != NotificationStateUnspecified
+}
+
+// Resendable returns true if the delivery of the notification failed
+// and the notification is therefore allowed to be resent.
+func (s NotificationState) Resendable() bool {
+	return s == NotificationStateFailed || s == NotificationStateDeadLetter
+}
@@ -8,4 +8,7 @@ type ObjectDetails struct {
 	Sequence      uint64
 	EventDate     time.Time
 	ResourceOwner string
+	// Position of the last event, queries reflect the change
+	// as soon as their projections reached the position
+	Position float64
 }
@@ -16,6 +16,7 @@ type OIDCSettings struct {
 	IdTokenLifetime            time.Duration
 	RefreshTokenIdleExpiration time.Duration
 	RefreshTokenExpiration     time.Duration
+	RequirePushedAuthRequests  bool
 }
 
 type OIDCSettingsState int32
@@ -33,4 +33,10 @@ const (
 	PermissionUserCredentialWrite = "user.credential.write"
 	PermissionSessionWrite        = "session.write"
 	PermissionSessionDelete       = "session.delete"
+	PermissionProjectRoleRead     = "project.role.read"
+	PermissionProjectRoleWrite    = "project.role.write"
+	PermissionProjectRoleDelete   = "project.role.delete"
+	PermissionUserGrantRead       = "user.grant.read"
+	PermissionUserGrantWrite      = "user.grant.write"
+	PermissionUserGrantDelete     = "user.grant.delete"
 )
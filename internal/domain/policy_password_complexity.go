@@ -17,11 +17,13 @@ var (
 type PasswordComplexityPolicy struct {
 	models.ObjectRoot
 
-	MinLength    uint64
-	HasLowercase bool
-	HasUppercase bool
-	HasNumber    bool
-	HasSymbol    bool
+	MinLength     uint64
+	HasLowercase  bool
+	HasUppercase  bool
+	HasNumber     bool
+	HasSymbol     bool
+	HistoryCount  uint64
+	CheckBreached bool
 
 	Default bool
 }
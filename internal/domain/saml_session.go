@@ -0,0 +1,15 @@
+package domain
+
+type SAMLSessionState int32
+
+const (
+	SAMLSessionStateUnspecified SAMLSessionState = iota
+	SAMLSessionStateActive
+	// SAMLSessionStateLogoutRequested is the state of a session, for which the service provider requested the logout
+	// and still has to receive the logout response
+	SAMLSessionStateLogoutRequested
+	// SAMLSessionStateLogoutPending is the state of a session, for which a logout request was sent to the service provider
+	// over the front-channel and the logout response is still outstanding
+	SAMLSessionStateLogoutPending
+	SAMLSessionStateTerminated
+)
@@ -14,7 +14,7 @@ import (
 
 func TestPermissionExtension(t *testing.T) {
 	type args struct {
-		role     role
+		role     Role
 		schema   string
 		instance string
 	}
@@ -104,7 +104,7 @@ func TestPermissionExtension(t *testing.T) {
 		{
 			"invalid permission self, validation err",
 			args{
-				role: roleSelf,
+				role: RoleSelf,
 				schema: `{
 							"type": "object",
 							"properties": {
@@ -126,7 +126,7 @@ func TestPermissionExtension(t *testing.T) {
 		{
 			"invalid permission owner, validation err",
 			args{
-				role: roleOwner,
+				role: RoleOwner,
 				schema: `{
 							"type": "object",
 							"properties": {
@@ -148,7 +148,7 @@ func TestPermissionExtension(t *testing.T) {
 		{
 			"valid permission self, ok",
 			args{
-				role: roleSelf,
+				role: RoleSelf,
 				schema: `{
 							"type": "object",
 							"properties": {
@@ -170,7 +170,7 @@ func TestPermissionExtension(t *testing.T) {
 		{
 			"valid permission owner, ok",
 			args{
-				role: roleOwner,
+				role: RoleOwner,
 				schema: `{
 							"type": "object",
 							"properties": {
@@ -192,7 +192,7 @@ func TestPermissionExtension(t *testing.T) {
 		{
 			"no role, validation err",
 			args{
-				role: roleUnspecified,
+				role: RoleUnspecified,
 				schema: `{
 							"type": "object",
 							"properties": {
@@ -214,7 +214,7 @@ func TestPermissionExtension(t *testing.T) {
 		{
 			"no permission required, ok",
 			args{
-				role: roleSelf,
+				role: RoleSelf,
 				schema: `{
 							"type": "object",
 							"properties": {
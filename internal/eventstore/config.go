@@ -2,6 +2,8 @@ package eventstore
 
 import (
 	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
 )
 
 type Config struct {
@@ -10,4 +12,10 @@ type Config struct {
 
 	Pusher  Pusher
 	Querier Querier
+
+	Snapshots     SnapshotConfig
+	SnapshotStore SnapshotStore
+
+	PersonalData     PersonalDataConfig
+	PersonalDataKeys crypto.KeyStorage
 }
@@ -33,6 +33,15 @@ type Command interface {
 	UniqueConstraints() []*UniqueConstraint
 }
 
+// SequenceGuarded is implemented by commands which are only pushed
+// if their aggregate wasn't changed since the write model they are based on was reduced,
+// e.g. to use something only once without a unique constraint.
+type SequenceGuarded interface {
+	// GuardedSequence returns the latest sequence of the aggregate the command expects
+	// and the translation key of the error returned if the aggregate has a different sequence
+	GuardedSequence() (sequence uint64, errMessage string)
+}
+
 // Event is a stored activity
 type Event interface {
 	action
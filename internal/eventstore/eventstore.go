@@ -22,6 +22,11 @@ type Eventstore struct {
 	pusher  Pusher
 	querier Querier
 
+	snapshots        SnapshotStore
+	snapshotInterval uint32
+
+	personalData *PersonalDataCipher
+
 	instances         []string
 	lastInstanceQuery time.Time
 	instancesMu       sync.Mutex
@@ -58,7 +63,7 @@ type eventTypeInterceptors struct {
 }
 
 func NewEventstore(config *Config) *Eventstore {
-	return &Eventstore{
+	es := &Eventstore{
 		PushTimeout: config.PushTimeout,
 		maxRetries:  int(config.MaxRetries),
 
@@ -67,6 +72,22 @@ func NewEventstore(config *Config) *Eventstore {
 
 		instancesMu: sync.Mutex{},
 	}
+	if config.PersonalData.Enabled && config.PersonalDataKeys != nil {
+		es.personalData = NewPersonalDataCipher(config.PersonalDataKeys, config.PersonalData)
+		if pusher, ok := config.Pusher.(personalDataEncrypter); ok {
+			pusher.EncryptPersonalData(es.personalData)
+		}
+	}
+	if config.Snapshots.Enabled && config.SnapshotStore != nil {
+		// snapshots contain personal data and secrets of the write models and are therefore encrypted with the personal data keys
+		if es.personalData == nil {
+			logging.Warn("snapshots are disabled, they require the encryption of personal data")
+		} else {
+			es.snapshots = config.SnapshotStore
+			es.snapshotInterval = config.Snapshots.Interval
+		}
+	}
+	return es
 }
 
 // Health checks if the eventstore can properly work
@@ -106,7 +127,6 @@ retry:
 	if err != nil {
 		return nil, err
 	}
-
 	mappedEvents, err := es.mapEvents(events)
 	if err != nil {
 		return mappedEvents, err
@@ -164,6 +184,10 @@ func (es *Eventstore) mapEvent(event Event) (Event, error) {
 }
 
 func (es *Eventstore) mapEventLocked(event Event) (Event, error) {
+	event, err := es.decryptPersonalData(event)
+	if err != nil {
+		return nil, err
+	}
 	interceptors, ok := eventInterceptors[event.Type()]
 	if !ok || interceptors.eventMapper == nil {
 		return BaseEventFromRepo(event), nil
@@ -238,7 +262,11 @@ type QueryReducer interface {
 
 // FilterToQueryReducer filters the events based on the search query of the query function,
 // appends all events to the reducer and calls it's reduce function
+// If snapshots are enabled and r implements [Snapshotter] only the events after the latest snapshot are filtered
 func (es *Eventstore) FilterToQueryReducer(ctx context.Context, r QueryReducer) error {
+	if snapshotter, ok := r.(Snapshotter); ok && es.snapshots != nil {
+		return es.filterToSnapshotter(ctx, snapshotter)
+	}
 	return es.FilterToReducer(ctx, r.Query(), r)
 }
 
@@ -380,6 +380,7 @@ func (h *Handler) existingInstances(ctx context.Context) ([]string, error) {
 type triggerConfig struct {
 	awaitRunning bool
 	maxPosition  float64
+	minPosition  float64
 }
 
 type TriggerOpt func(conf *triggerConfig)
@@ -396,11 +397,28 @@ func WithMaxPosition(position float64) TriggerOpt {
 	}
 }
 
+// WithMinPosition skips the trigger if the projection already reduced all events up to the position
+func WithMinPosition(position float64) TriggerOpt {
+	return func(conf *triggerConfig) {
+		conf.minPosition = position
+	}
+}
+
 func (h *Handler) Trigger(ctx context.Context, opts ...TriggerOpt) (_ context.Context, err error) {
 	config := new(triggerConfig)
 	for _, opt := range opts {
 		opt(config)
 	}
+	// the projection is updated on the primary, following reads must wait for the replica to replay it
+	database.RequireReplicated(ctx)
+
+	if config.minPosition > 0 {
+		reached, err := h.reachedPosition(ctx, config.minPosition)
+		h.log().OnError(err).Debug("unable to check position of projection")
+		if reached {
+			return call.ResetTimestamp(ctx), nil
+		}
+	}
 
 	cancel := h.lockInstance(ctx, config)
 	if cancel == nil {
@@ -607,7 +625,7 @@ func (h *Handler) executeStatements(ctx context.Context, tx *sql.Tx, currentStat
 }
 
 func (h *Handler) executeStatement(ctx context.Context, tx *sql.Tx, currentState *state, statement *Statement) (err error) {
-	if statement.Execute == nil {
+	if statement.Execute == nil && statement.ExecuteContext == nil {
 		return nil
 	}
 
@@ -624,11 +642,19 @@ func (h *Handler) executeStatement(ctx context.Context, tx *sql.Tx, currentState
 		}
 	}()
 
-	if err = statement.Execute(tx, h.projection.Name()); err != nil {
+	if statement.ExecuteContext != nil {
+		err = statement.ExecuteContext(ctx, tx, h.projection.Name())
+	} else {
+		err = statement.Execute(tx, h.projection.Name())
+	}
+	if err != nil {
 		h.log().WithError(err).Error("statement execution failed")
 
 		shouldContinue = h.handleFailedStmt(tx, failureFromStatement(statement, err))
 		if shouldContinue {
+			if statement.Skipped != nil {
+				statement.Skipped(err)
+			}
 			return nil
 		}
 
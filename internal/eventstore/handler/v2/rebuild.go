@@ -0,0 +1,471 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const rebuildSuffix = "_rebuild"
+
+// RebuildProgress is reported after every bulk of events projected into the shadow tables
+type RebuildProgress struct {
+	InstanceID string
+	// Instance is the number of the instance currently rebuilt, starting at 1
+	Instance  int
+	Instances int
+	// Position of the last event projected into the shadow tables
+	Position float64
+	// TargetPosition is the position of the latest event of the instance when its rebuild started.
+	// Events pushed afterwards are projected as well.
+	TargetPosition float64
+}
+
+// shadowProjection reduces the events of the projection into tables named after the shadow
+type shadowProjection struct {
+	Projection
+	name string
+}
+
+func (p *shadowProjection) Name() string {
+	return p.name
+}
+
+func (p *shadowProjection) Init() *handler.Check {
+	if check, ok := p.Projection.(initializer); ok {
+		return check.Init()
+	}
+	return new(handler.Check)
+}
+
+// Rebuild projects all events of the instances into shadow tables starting at position zero.
+// Afterwards the shadow tables replace the tables of the projection in a single transaction,
+// so queries never see partially rebuilt tables.
+// If no instance ids are passed, the tables of all instances are swapped,
+// otherwise only the rows of the passed instances are replaced.
+func (h *Handler) Rebuild(ctx context.Context, instanceIDs []string, progress func(*RebuildProgress)) (err error) {
+	if _, ok := h.projection.(initializer); !ok {
+		return zerrors.ThrowPreconditionFailed(nil, "V2-ahF0e", "projection has no tables to rebuild")
+	}
+	shadow := h.shadow()
+	// remove leftovers of previous rebuilds
+	if err = shadow.dropShadow(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			logging.WithFields("projection", h.projection.Name()).OnError(shadow.dropShadow(context.WithoutCancel(ctx))).Warn("unable to drop shadow tables")
+		}
+	}()
+	if err = shadow.Init(ctx); err != nil {
+		return err
+	}
+
+	instances := instanceIDs
+	if len(instances) == 0 {
+		if instances, err = h.existingInstances(ctx); err != nil {
+			return err
+		}
+	}
+	for i, instanceID := range instances {
+		err = shadow.rebuildInstance(ctx, &RebuildProgress{
+			InstanceID: instanceID,
+			Instance:   i + 1,
+			Instances:  len(instances),
+		}, progress)
+		if err != nil {
+			return err
+		}
+	}
+	return h.swapShadow(ctx, shadow, instanceIDs)
+}
+
+func (h *Handler) shadow() *Handler {
+	return &Handler{
+		projection: &shadowProjection{
+			Projection: h.projection,
+			name:       h.projection.Name() + rebuildSuffix,
+		},
+		client:           h.client,
+		es:               h.es,
+		bulkLimit:        h.bulkLimit,
+		eventTypes:       h.eventTypes,
+		maxFailureCount:  h.maxFailureCount,
+		retryFailedAfter: h.retryFailedAfter,
+		txDuration:       h.txDuration,
+		now:              h.now,
+	}
+}
+
+func (h *Handler) rebuildInstance(ctx context.Context, progress *RebuildProgress, report func(*RebuildProgress)) (err error) {
+	ctx = authz.WithInstanceID(ctx, progress.InstanceID)
+	if progress.TargetPosition, err = h.latestPosition(ctx, progress.InstanceID); err != nil {
+		return err
+	}
+	report(progress)
+	for {
+		additionalIteration, err := h.processEvents(ctx, new(triggerConfig))
+		if err != nil {
+			return err
+		}
+		if progress.Position, err = h.statePosition(ctx, progress.InstanceID); err != nil {
+			return err
+		}
+		report(progress)
+		if !additionalIteration {
+			return nil
+		}
+	}
+}
+
+// latestPosition returns the position of the latest event reduced by the projection
+func (h *Handler) latestPosition(ctx context.Context, instanceID string) (float64, error) {
+	builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(instanceID).
+		OrderDesc().
+		Limit(1)
+	for aggregateType, eventTypes := range h.eventTypes {
+		builder = builder.AddQuery().
+			AggregateTypes(aggregateType).
+			EventTypes(eventTypes...).
+			Builder()
+	}
+	events, err := h.es.Filter(ctx, builder)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+	return events[0].Position(), nil
+}
+
+func (h *Handler) statePosition(ctx context.Context, instanceID string) (position float64, err error) {
+	err = h.client.QueryRowContext(ctx,
+		func(row *sql.Row) error {
+			var pos sql.NullFloat64
+			err := row.Scan(&pos)
+			position = pos.Float64
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		},
+		"SELECT position FROM projections.current_states WHERE projection_name = $1 AND instance_id = $2",
+		h.projection.Name(), instanceID,
+	)
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "V2-Ohh5o", "unable to query state")
+	}
+	return position, nil
+}
+
+// swapShadow replaces the tables of the projection with the shadow tables
+// and continues the projection at the position of the shadow
+func (h *Handler) swapShadow(ctx context.Context, shadow *Handler, instanceIDs []string) (err error) {
+	tx, err := h.client.BeginTx(ctx, nil)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-Leeh3", "begin failed")
+	}
+	defer func() {
+		if err != nil {
+			logging.OnError(tx.Rollback()).Debug("unable to rollback")
+			return
+		}
+		if err = tx.Commit(); err != nil {
+			err = zerrors.ThrowInternal(err, "V2-Ohl8a", "commit failed")
+		}
+	}()
+
+	// blocks the handler of the projection until the tables are swapped
+	lockStmt := "SELECT instance_id FROM projections.current_states WHERE projection_name = $1"
+	args := []any{h.projection.Name()}
+	if len(instanceIDs) > 0 {
+		lockStmt += " AND instance_id = ANY($2)"
+		args = append(args, database.TextArray[string](instanceIDs))
+	}
+	if _, err = tx.ExecContext(ctx, lockStmt+" FOR UPDATE", args...); err != nil {
+		return zerrors.ThrowInternal(err, "V2-aiT2a", "unable to lock states")
+	}
+
+	schema, relations, err := shadowRelations(ctx, tx, h.projection.Name(), shadow.projection.Name())
+	if err != nil {
+		return err
+	}
+	if len(instanceIDs) == 0 {
+		err = swapRelations(ctx, tx, schema, relations)
+	} else {
+		err = copyInstanceRows(ctx, tx, schema, relations, instanceIDs)
+	}
+	if err != nil {
+		return err
+	}
+	return moveStates(ctx, tx, shadow.projection.Name(), h.projection.Name(), instanceIDs)
+}
+
+// dropShadow removes the shadow tables and the states of the shadow projection
+func (h *Handler) dropShadow(ctx context.Context) (err error) {
+	projectionName := strings.TrimSuffix(h.projection.Name(), rebuildSuffix)
+	tx, err := h.client.BeginTx(ctx, nil)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-Eix6o", "begin failed")
+	}
+	defer func() {
+		if err != nil {
+			logging.OnError(tx.Rollback()).Debug("unable to rollback")
+			return
+		}
+		if err = tx.Commit(); err != nil {
+			err = zerrors.ThrowInternal(err, "V2-ku5Ai", "commit failed")
+		}
+	}()
+
+	schema, relations, err := shadowRelations(ctx, tx, projectionName, h.projection.Name())
+	if err != nil {
+		return err
+	}
+	shadows := make([]*relation, len(relations))
+	for i, r := range relations {
+		shadows[i] = &relation{name: r.shadow, isView: r.isView}
+	}
+	if err = dropRelations(ctx, tx, schema, shadows); err != nil {
+		return err
+	}
+	for _, stmt := range []string{
+		"DELETE FROM projections.current_states WHERE projection_name = $1",
+		"DELETE FROM projections.failed_events2 WHERE projection_name = $1",
+	} {
+		if _, err = tx.ExecContext(ctx, stmt, h.projection.Name()); err != nil {
+			return zerrors.ThrowInternal(err, "V2-ooT6e", "unable to delete shadow states")
+		}
+	}
+	return nil
+}
+
+type relation struct {
+	name   string
+	isView bool
+}
+
+// shadowRelation maps a table or view of the shadow projection to the one of the projection
+type shadowRelation struct {
+	shadow string
+	live   string
+	isView bool
+}
+
+// shadowRelations returns the tables and views of the shadow projection, the primary table first
+func shadowRelations(ctx context.Context, tx *sql.Tx, projectionName, shadowName string) (schema string, relations []*shadowRelation, err error) {
+	schema, liveTable, ok := strings.Cut(projectionName, ".")
+	if !ok {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "V2-Ee5ie", "projection name must contain the schema")
+	}
+	_, shadowTable, _ := strings.Cut(shadowName, ".")
+
+	rows, err := tx.QueryContext(ctx,
+		"SELECT table_name, table_type FROM information_schema.tables WHERE table_schema = $1 AND (table_name = $2 OR table_name LIKE $3)",
+		schema, shadowTable, strings.ReplaceAll(shadowTable, "_", `\_`)+`\_%`,
+	)
+	if err != nil {
+		return "", nil, zerrors.ThrowInternal(err, "V2-Oow5u", "unable to query shadow tables")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, tableType string
+		if err = rows.Scan(&name, &tableType); err != nil {
+			return "", nil, zerrors.ThrowInternal(err, "V2-ohV5a", "unable to scan shadow tables")
+		}
+		relations = append(relations, &shadowRelation{
+			shadow: name,
+			live:   liveTable + strings.TrimPrefix(name, shadowTable),
+			isView: tableType == "VIEW",
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return "", nil, zerrors.ThrowInternal(err, "V2-Ap8ee", "unable to query shadow tables")
+	}
+	slices.SortFunc(relations, func(a, b *shadowRelation) int {
+		if a.shadow == shadowTable {
+			return -1
+		}
+		if b.shadow == shadowTable {
+			return 1
+		}
+		return strings.Compare(a.shadow, b.shadow)
+	})
+	return schema, relations, nil
+}
+
+// swapRelations replaces the tables of the projection with the shadow tables.
+// Views are recreated, because they still reference the dropped tables otherwise.
+func swapRelations(ctx context.Context, tx *sql.Tx, schema string, relations []*shadowRelation) error {
+	live := make([]*relation, 0, len(relations))
+	shadows := make([]*relation, 0, len(relations))
+	views := make(map[string]string)
+	for _, r := range relations {
+		live = append(live, &relation{name: r.live, isView: r.isView})
+		shadows = append(shadows, &relation{name: r.shadow, isView: r.isView})
+		if !r.isView {
+			continue
+		}
+		var definition string
+		err := tx.QueryRowContext(ctx, "SELECT view_definition FROM information_schema.views WHERE table_schema = $1 AND table_name = $2", schema, r.shadow).Scan(&definition)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "V2-Jei8o", "unable to query view definition")
+		}
+		views[r.live] = definition
+	}
+	// the views of the shadow reference the tables of the projection, so they are dropped as well
+	if err := dropRelations(ctx, tx, schema, slices.DeleteFunc(shadows, func(r *relation) bool { return !r.isView })); err != nil {
+		return err
+	}
+	if err := dropRelations(ctx, tx, schema, live); err != nil {
+		return err
+	}
+	for _, r := range relations {
+		if r.isView {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "ALTER TABLE "+schema+"."+r.shadow+" RENAME TO "+r.live); err != nil {
+			return zerrors.ThrowInternal(err, "V2-uo6Ei", "unable to rename shadow table")
+		}
+		if err := renameTableObjects(ctx, tx, schema, r); err != nil {
+			return err
+		}
+	}
+	for _, r := range relations {
+		if !r.isView {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "CREATE VIEW "+schema+"."+r.live+" AS "+views[r.live]); err != nil {
+			return zerrors.ThrowInternal(err, "V2-eiS3u", "unable to create view")
+		}
+	}
+	return nil
+}
+
+// renameTableObjects renames the indexes and foreign keys of a renamed shadow table,
+// so their names don't collide with the objects of the next rebuild
+func renameTableObjects(ctx context.Context, tx *sql.Tx, schema string, r *shadowRelation) error {
+	shadowPrefix, livePrefix := r.shadow, r.live
+	// the name of the primary table is part of the names of all objects
+	if prefix, _, ok := strings.Cut(r.shadow, rebuildSuffix); ok {
+		shadowPrefix, livePrefix = prefix+rebuildSuffix, prefix
+	}
+
+	indexes, err := queryNames(ctx, tx, "SELECT indexname FROM pg_indexes WHERE schemaname = $1 AND tablename = $2", schema, r.live)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-ieQu4", "unable to query indexes")
+	}
+	for _, index := range indexes {
+		if !strings.Contains(index, shadowPrefix) {
+			continue
+		}
+		if _, err = tx.ExecContext(ctx, "ALTER INDEX "+schema+"."+index+" RENAME TO "+strings.ReplaceAll(index, shadowPrefix, livePrefix)); err != nil {
+			return zerrors.ThrowInternal(err, "V2-Thae7", "unable to rename index")
+		}
+	}
+
+	foreignKeys, err := queryNames(ctx, tx, "SELECT constraint_name FROM information_schema.table_constraints WHERE table_schema = $1 AND table_name = $2 AND constraint_type = 'FOREIGN KEY'", schema, r.live)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-Eeph1", "unable to query foreign keys")
+	}
+	for _, foreignKey := range foreignKeys {
+		if !strings.Contains(foreignKey, shadowPrefix) {
+			continue
+		}
+		if _, err = tx.ExecContext(ctx, "ALTER TABLE "+schema+"."+r.live+" RENAME CONSTRAINT "+foreignKey+" TO "+strings.ReplaceAll(foreignKey, shadowPrefix, livePrefix)); err != nil {
+			return zerrors.ThrowInternal(err, "V2-uSh4a", "unable to rename foreign key")
+		}
+	}
+	return nil
+}
+
+// copyInstanceRows replaces the rows of the instances in the tables of the projection
+// with the rows of the shadow tables and drops the shadow tables afterwards
+func copyInstanceRows(ctx context.Context, tx *sql.Tx, schema string, relations []*shadowRelation, instanceIDs []string) error {
+	shadows := make([]*relation, 0, len(relations))
+	for _, r := range relations {
+		shadows = append(shadows, &relation{name: r.shadow, isView: r.isView})
+		if r.isView {
+			continue
+		}
+		columns, err := queryNames(ctx, tx, "SELECT column_name FROM information_schema.columns WHERE table_schema = $1 AND table_name = $2 ORDER BY ordinal_position", schema, r.shadow)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "V2-Kie4o", "unable to query columns")
+		}
+		if !slices.Contains(columns, "instance_id") {
+			return zerrors.ThrowPreconditionFailedf(nil, "V2-vai3O", "table %s has no instance_id column", r.live)
+		}
+		if _, err = tx.ExecContext(ctx, "DELETE FROM "+schema+"."+r.live+" WHERE instance_id = ANY($1)", database.TextArray[string](instanceIDs)); err != nil {
+			return zerrors.ThrowInternal(err, "V2-ohX9a", "unable to delete rows")
+		}
+		cols := strings.Join(columns, ", ")
+		if _, err = tx.ExecContext(ctx, "INSERT INTO "+schema+"."+r.live+" ("+cols+") SELECT "+cols+" FROM "+schema+"."+r.shadow+" WHERE instance_id = ANY($1)", database.TextArray[string](instanceIDs)); err != nil {
+			return zerrors.ThrowInternal(err, "V2-Cha8a", "unable to copy rows")
+		}
+	}
+	return dropRelations(ctx, tx, schema, shadows)
+}
+
+func dropRelations(ctx context.Context, tx *sql.Tx, schema string, relations []*relation) error {
+	tables := make([]string, 0, len(relations))
+	for _, r := range relations {
+		if !r.isView {
+			tables = append(tables, schema+"."+r.name)
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "DROP VIEW IF EXISTS "+schema+"."+r.name); err != nil {
+			return zerrors.ThrowInternal(err, "V2-Ie9ai", "unable to drop view")
+		}
+	}
+	if len(tables) == 0 {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, "DROP TABLE IF EXISTS "+strings.Join(tables, ", ")); err != nil {
+		return zerrors.ThrowInternal(err, "V2-Weeb4", "unable to drop tables")
+	}
+	return nil
+}
+
+// moveStates replaces the states and failed events of the projection with the ones of the shadow
+func moveStates(ctx context.Context, tx *sql.Tx, shadowName, projectionName string, instanceIDs []string) error {
+	for _, table := range []string{"projections.current_states", "projections.failed_events2"} {
+		deleteStmt := "DELETE FROM " + table + " WHERE projection_name = $1"
+		args := []any{projectionName}
+		if len(instanceIDs) > 0 {
+			deleteStmt += " AND instance_id = ANY($2)"
+			args = append(args, database.TextArray[string](instanceIDs))
+		}
+		if _, err := tx.ExecContext(ctx, deleteStmt, args...); err != nil {
+			return zerrors.ThrowInternal(err, "V2-Ya0ei", "unable to delete states")
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE "+table+" SET projection_name = $1 WHERE projection_name = $2", projectionName, shadowName); err != nil {
+			return zerrors.ThrowInternal(err, "V2-Ahj4i", "unable to move states")
+		}
+	}
+	return nil
+}
+
+func queryNames(ctx context.Context, tx *sql.Tx, stmt string, args ...any) (names []string, err error) {
+	rows, err := tx.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database/mock"
+)
+
+func TestHandler_shadow(t *testing.T) {
+	h := &Handler{
+		projection: &projection{name: "projections.users"},
+		bulkLimit:  200,
+	}
+	shadow := h.shadow()
+	if name := shadow.projection.Name(); name != "projections.users_rebuild" {
+		t.Errorf("unexpected shadow name: %s", name)
+	}
+	if shadow.bulkLimit != h.bulkLimit {
+		t.Errorf("config not copied")
+	}
+	if check := shadow.projection.(initializer).Init(); !check.IsNoop() {
+		t.Errorf("expected noop check for projection without tables")
+	}
+}
+
+func Test_shadowRelations(t *testing.T) {
+	mock := mock.NewSQLMock(t,
+		mock.ExpectBegin(nil),
+		mock.ExpectQuery(
+			"SELECT table_name, table_type FROM information_schema.tables WHERE table_schema = $1 AND (table_name = $2 OR table_name LIKE $3)",
+			mock.WithQueryArgs("projections", "login_names_rebuild", `login\_names\_rebuild\_%`),
+			mock.WithQueryResult(
+				[]string{"table_name", "table_type"},
+				[][]driver.Value{
+					{"login_names_rebuild_users", "BASE TABLE"},
+					{"login_names_rebuild", "VIEW"},
+					{"login_names_rebuild_domains", "BASE TABLE"},
+				},
+			),
+		),
+	)
+	defer mock.Assert(t)
+
+	tx, err := mock.DB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, relations, err := shadowRelations(context.Background(), tx, "projections.login_names", "projections.login_names_rebuild")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schema != "projections" {
+		t.Errorf("unexpected schema: %s", schema)
+	}
+	want := []*shadowRelation{
+		{shadow: "login_names_rebuild", live: "login_names", isView: true},
+		{shadow: "login_names_rebuild_domains", live: "login_names_domains"},
+		{shadow: "login_names_rebuild_users", live: "login_names_users"},
+	}
+	if !reflect.DeepEqual(want, relations) {
+		t.Errorf("unexpected relations: want %+v, got %+v", want, relations)
+	}
+}
+
+func Test_moveStates(t *testing.T) {
+	mock := mock.NewSQLMock(t,
+		mock.ExpectBegin(nil),
+		mock.ExcpectExec(
+			"DELETE FROM projections.current_states WHERE projection_name = $1",
+			mock.WithExecArgs("projections.users"),
+			mock.WithExecRowsAffected(2),
+		),
+		mock.ExcpectExec(
+			"UPDATE projections.current_states SET projection_name = $1 WHERE projection_name = $2",
+			mock.WithExecArgs("projections.users", "projections.users_rebuild"),
+			mock.WithExecRowsAffected(2),
+		),
+		mock.ExcpectExec(
+			"DELETE FROM projections.failed_events2 WHERE projection_name = $1",
+			mock.WithExecArgs("projections.users"),
+			mock.WithExecRowsAffected(0),
+		),
+		mock.ExcpectExec(
+			"UPDATE projections.failed_events2 SET projection_name = $1 WHERE projection_name = $2",
+			mock.WithExecArgs("projections.users", "projections.users_rebuild"),
+			mock.WithExecRowsAffected(1),
+		),
+	)
+	defer mock.Assert(t)
+
+	tx, err := mock.DB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = moveStates(context.Background(), tx, "projections.users_rebuild", "projections.users", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -31,10 +31,31 @@ var (
 	updateStateStmt string
 	//go:embed state_lock.sql
 	lockStateStmt string
+	//go:embed state_get_position.sql
+	currentPositionStmt string
 
 	errJustUpdated = errors.New("projection was just updated")
 )
 
+// reachedPosition returns if the projection already reduced all events up to the position.
+// The current state isn't locked, so a running trigger doesn't block the check.
+func (h *Handler) reachedPosition(ctx context.Context, position float64) (bool, error) {
+	current := new(sql.NullFloat64)
+	err := h.client.QueryRowContext(ctx,
+		func(row *sql.Row) error {
+			return row.Scan(current)
+		},
+		currentPositionStmt, authz.GetInstance(ctx).InstanceID(), h.projection.Name(),
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return current.Float64 >= position, nil
+}
+
 func (h *Handler) currentState(ctx context.Context, tx *sql.Tx, config *triggerConfig) (currentState *state, err error) {
 	currentState = &state{
 		instanceID: authz.GetInstance(ctx).InstanceID(),
@@ -13,6 +13,7 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/database/mock"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -449,3 +450,93 @@ func TestHandler_currentState(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_reachedPosition(t *testing.T) {
+	tests := []struct {
+		name        string
+		mock        func(*testing.T) *mock.SQLMock
+		position    float64
+		wantReached bool
+		wantErr     error
+	}{
+		{
+			name: "reached",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectBegin(nil),
+					mock.ExpectQuery(currentPositionStmt,
+						mock.WithQueryArgs("instance", "projection"),
+						mock.WithQueryResult([]string{"position"}, [][]driver.Value{{42.5}}),
+					),
+					mock.ExpectCommit(nil),
+				)
+			},
+			position:    42,
+			wantReached: true,
+		},
+		{
+			name: "not reached",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectBegin(nil),
+					mock.ExpectQuery(currentPositionStmt,
+						mock.WithQueryArgs("instance", "projection"),
+						mock.WithQueryResult([]string{"position"}, [][]driver.Value{{41.5}}),
+					),
+					mock.ExpectCommit(nil),
+				)
+			},
+			position:    42,
+			wantReached: false,
+		},
+		{
+			name: "no state",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectBegin(nil),
+					mock.ExpectQuery(currentPositionStmt,
+						mock.WithQueryArgs("instance", "projection"),
+						mock.WithQueryResult([]string{"position"}, [][]driver.Value{}),
+					),
+				)
+			},
+			position:    42,
+			wantReached: false,
+		},
+		{
+			name: "query failed",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectBegin(nil),
+					mock.ExpectQuery(currentPositionStmt,
+						mock.WithQueryArgs("instance", "projection"),
+						mock.WithQueryErr(sql.ErrConnDone),
+					),
+				)
+			},
+			position:    42,
+			wantReached: false,
+			wantErr:     sql.ErrConnDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlMock := tt.mock(t)
+			h := &Handler{
+				projection: &projection{
+					name: "projection",
+				},
+				client: &database.DB{DB: sqlMock.DB},
+			}
+
+			reached, err := h.reachedPosition(authz.WithInstanceID(context.Background(), "instance"), tt.position)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("unexpected error, want: %v, got: %v", tt.wantErr, err)
+			}
+			if reached != tt.wantReached {
+				t.Errorf("Handler.reachedPosition() = %v, want %v", reached, tt.wantReached)
+			}
+			sqlMock.Assert(t)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -90,10 +91,18 @@ type Statement struct {
 	offset uint32
 
 	Execute Exec
+	// ExecuteContext is used instead of Execute if set,
+	// it receives the context of the transaction the statement is executed in
+	ExecuteContext ExecContext
+	// Skipped is called if the execution failed
+	// and the statement is skipped because the max failure count is reached
+	Skipped func(err error)
 }
 
 type Exec func(ex Executer, projectionName string) error
 
+type ExecContext func(ctx context.Context, ex Executer, projectionName string) error
+
 func WithTableSuffix(name string) func(*execConfig) {
 	return func(o *execConfig) {
 		o.tableName += "_" + name
@@ -118,6 +127,14 @@ func NewStatement(event eventstore.Event, e Exec) *Statement {
 	}
 }
 
+// NewStatementContext creates a statement whose execution depends on the context of the transaction,
+// e.g. to cancel requests to other systems if the transaction times out
+func NewStatementContext(event eventstore.Event, e ExecContext) *Statement {
+	statement := NewStatement(event, nil)
+	statement.ExecuteContext = e
+	return statement
+}
+
 func NewCreateStatement(event eventstore.Event, values []Column, opts ...execOption) *Statement {
 	cols, params, args := columnsToQuery(values)
 	columnNames := strings.Join(cols, ", ")
@@ -0,0 +1,327 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// personalDataPrefix marks encrypted values in the payload of an event
+const personalDataPrefix = "$pii$"
+
+var (
+	personalDataFields   = map[EventType][]string{}
+	personalDataErasures = map[EventType]bool{}
+)
+
+// RegisterPersonalData marks fields of the payload of the event type as personal data.
+// If personal data encryption is enabled, string values of the fields are encrypted with a key of the aggregate.
+func RegisterPersonalData(eventType EventType, fields ...string) {
+	personalDataFields[eventType] = append(personalDataFields[eventType], fields...)
+}
+
+// HasPersonalData returns if fields of the event type are marked as personal data
+func HasPersonalData(eventType EventType) bool {
+	return len(personalDataFields[eventType]) > 0
+}
+
+// RegisterPersonalDataErasure marks the event type to erase the personal data of its aggregate.
+// The key of the aggregate is destroyed after the event was pushed,
+// so the personal data of all previous events of the aggregate become unreadable.
+func RegisterPersonalDataErasure(eventType EventType) {
+	personalDataErasures[eventType] = true
+}
+
+// IsPersonalDataErasure returns if the event type erases the personal data of its aggregate
+func IsPersonalDataErasure(eventType EventType) bool {
+	return personalDataErasures[eventType]
+}
+
+type PersonalDataConfig struct {
+	Enabled bool
+	// KeyCacheDuration defines how long keys are cached.
+	// Other ZITADEL processes might still decrypt data with a destroyed key for this duration.
+	KeyCacheDuration time.Duration
+}
+
+// personalDataEncrypter is implemented by pushers which encrypt the personal data of the pushed events
+type personalDataEncrypter interface {
+	EncryptPersonalData(*PersonalDataCipher)
+}
+
+// PersonalDataCipher encrypts and decrypts the personal data of events
+// with a key per aggregate stored in the [crypto.KeyStorage].
+type PersonalDataCipher struct {
+	keys          crypto.KeyStorage
+	cacheDuration time.Duration
+	now           func() time.Time
+
+	mu          sync.Mutex
+	cache       map[string]*personalDataKey
+	lastCleanup time.Time
+}
+
+type personalDataKey struct {
+	// value is empty if the key doesn't exist
+	value   string
+	expires time.Time
+}
+
+func NewPersonalDataCipher(keys crypto.KeyStorage, config PersonalDataConfig) *PersonalDataCipher {
+	return &PersonalDataCipher{
+		keys:          keys,
+		cacheDuration: config.KeyCacheDuration,
+		now:           time.Now,
+		cache:         make(map[string]*personalDataKey),
+	}
+}
+
+// Encrypt encrypts the personal data in the payload of the event.
+// The key of the aggregate is created if it doesn't exist yet.
+func (c *PersonalDataCipher) Encrypt(ctx context.Context, aggregate *Aggregate, eventType EventType, payload []byte) ([]byte, error) {
+	fields := personalDataFields[eventType]
+	if len(fields) == 0 || len(payload) == 0 {
+		return payload, nil
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &values); err != nil {
+		return nil, zerrors.ThrowInternal(err, "V2-ohY3e", "Errors.Internal")
+	}
+	var key string
+	for _, field := range fields {
+		value, ok := values[field]
+		if !ok || !isPlainString(value) {
+			continue
+		}
+		if key == "" {
+			var err error
+			if key, err = c.key(ctx, aggregate, true); err != nil {
+				return nil, err
+			}
+		}
+		encrypted, err := crypto.EncryptAES(value, key)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "V2-Eo4ai", "Errors.Internal")
+		}
+		if values[field], err = json.Marshal(personalDataPrefix + base64.RawStdEncoding.EncodeToString(encrypted)); err != nil {
+			return nil, zerrors.ThrowInternal(err, "V2-ieJ4o", "Errors.Internal")
+		}
+	}
+	if key == "" {
+		return payload, nil
+	}
+	return json.Marshal(values)
+}
+
+// Decrypt decrypts the personal data in the payload of the event.
+// Values encrypted with a destroyed key are replaced by null.
+func (c *PersonalDataCipher) Decrypt(ctx context.Context, aggregate *Aggregate, eventType EventType, payload []byte) ([]byte, error) {
+	fields := personalDataFields[eventType]
+	if len(fields) == 0 || len(payload) == 0 {
+		return payload, nil
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &values); err != nil {
+		return nil, zerrors.ThrowInternal(err, "V2-Aeth9", "Errors.Internal")
+	}
+	var (
+		key     string
+		changed bool
+	)
+	for _, field := range fields {
+		encrypted, ok := encryptedValue(values[field])
+		if !ok {
+			continue
+		}
+		if !changed {
+			var err error
+			if key, err = c.key(ctx, aggregate, false); err != nil {
+				return nil, err
+			}
+			changed = true
+		}
+		if key == "" {
+			values[field] = json.RawMessage("null")
+			continue
+		}
+		decrypted, err := crypto.DecryptAES(encrypted, key)
+		// the value was encrypted with a destroyed key if the aggregate got a new key afterwards
+		if err != nil || !isPlainString(decrypted) {
+			values[field] = json.RawMessage("null")
+			continue
+		}
+		values[field] = decrypted
+	}
+	if !changed {
+		return payload, nil
+	}
+	return json.Marshal(values)
+}
+
+// EncryptSnapshot encrypts the whole snapshot of a write model with the key of its aggregate,
+// because write models hold personal data and secrets like password hashes.
+func (c *PersonalDataCipher) EncryptSnapshot(ctx context.Context, aggregate *Aggregate, payload []byte) ([]byte, error) {
+	key, err := c.key(ctx, aggregate, true)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := crypto.EncryptAES(payload, key)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V2-Ub7ae", "Errors.Internal")
+	}
+	return encrypted, nil
+}
+
+// DecryptSnapshot decrypts the snapshot of a write model.
+// Nil is returned if the key of the aggregate was destroyed after the snapshot was stored.
+func (c *PersonalDataCipher) DecryptSnapshot(ctx context.Context, aggregate *Aggregate, payload []byte) ([]byte, error) {
+	key, err := c.key(ctx, aggregate, false)
+	if err != nil || key == "" {
+		return nil, err
+	}
+	decrypted, err := crypto.DecryptAES(payload, key)
+	// the snapshot was encrypted with a destroyed key if the aggregate got a new key afterwards
+	if err != nil || !json.Valid(decrypted) {
+		return nil, nil
+	}
+	return decrypted, nil
+}
+
+// Destroy deletes the keys of the aggregates, the encrypted personal data of their events become unreadable
+func (c *PersonalDataCipher) Destroy(ctx context.Context, aggregates ...*Aggregate) error {
+	ids := make([]string, len(aggregates))
+	for i, aggregate := range aggregates {
+		ids[i] = personalDataKeyID(aggregate)
+	}
+	if err := c.keys.DeleteKeys(ctx, ids...); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		c.cacheKey(id, "")
+	}
+	return nil
+}
+
+func (c *PersonalDataCipher) key(ctx context.Context, aggregate *Aggregate, create bool) (string, error) {
+	id := personalDataKeyID(aggregate)
+	c.mu.Lock()
+	cached, ok := c.cache[id]
+	c.mu.Unlock()
+	if ok && cached.expires.After(c.now()) && (cached.value != "" || !create) {
+		return cached.value, nil
+	}
+
+	key, err := c.readKey(id)
+	if err != nil {
+		return "", err
+	}
+	if key == nil && create {
+		if key, err = crypto.NewKey(id); err != nil {
+			return "", zerrors.ThrowInternal(err, "V2-aiT8e", "Errors.Internal")
+		}
+		if err = c.keys.CreateKeys(ctx, key); err != nil {
+			// the key might have been created concurrently
+			logging.WithFields("key", id).WithError(err).Debug("unable to create personal data key")
+			if key, err = c.readKey(id); err != nil || key == nil {
+				return "", zerrors.ThrowInternal(err, "V2-ooV1a", "Errors.Internal")
+			}
+		}
+	}
+	var value string
+	if key != nil {
+		value = key.Value
+	}
+	c.cacheKey(id, value)
+	return value, nil
+}
+
+func (c *PersonalDataCipher) readKey(id string) (*crypto.Key, error) {
+	key, err := c.keys.ReadKey(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return key, err
+}
+
+func (c *PersonalDataCipher) cacheKey(id, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if now.Sub(c.lastCleanup) > c.cacheDuration {
+		for cachedID, cached := range c.cache {
+			if !cached.expires.After(now) {
+				delete(c.cache, cachedID)
+			}
+		}
+		c.lastCleanup = now
+	}
+	c.cache[id] = &personalDataKey{value: value, expires: now.Add(c.cacheDuration)}
+}
+
+func personalDataKeyID(aggregate *Aggregate) string {
+	return crypto.PersonalDataKeyIDPrefix + aggregate.InstanceID + ":" + string(aggregate.Type) + ":" + aggregate.ID
+}
+
+func isPlainString(value json.RawMessage) bool {
+	var s string
+	if len(value) == 0 || value[0] != '"' || json.Unmarshal(value, &s) != nil {
+		return false
+	}
+	return !strings.HasPrefix(s, personalDataPrefix)
+}
+
+func encryptedValue(value json.RawMessage) ([]byte, bool) {
+	var s string
+	if len(value) == 0 || value[0] != '"' || json.Unmarshal(value, &s) != nil {
+		return nil, false
+	}
+	encoded, ok := strings.CutPrefix(s, personalDataPrefix)
+	if !ok {
+		return nil, false
+	}
+	encrypted, err := base64.RawStdEncoding.DecodeString(encoded)
+	return encrypted, err == nil
+}
+
+// personalDataEvent is an event with decrypted personal data
+type personalDataEvent struct {
+	Event
+	data []byte
+}
+
+func (e *personalDataEvent) Unmarshal(ptr any) error {
+	if len(e.data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(e.data, ptr)
+}
+
+func (e *personalDataEvent) DataAsBytes() []byte {
+	return e.data
+}
+
+func (es *Eventstore) decryptPersonalData(event Event) (Event, error) {
+	if es.personalData == nil || !HasPersonalData(event.Type()) {
+		return event, nil
+	}
+	data, err := es.personalData.Decrypt(context.Background(), event.Aggregate(), event.Type(), event.DataAsBytes())
+	if err != nil {
+		return nil, err
+	}
+	return &personalDataEvent{Event: event, data: data}, nil
+}
+
+// PersonalData returns the cipher of the personal data of the events, nil if the encryption is disabled
+func (es *Eventstore) PersonalData() *PersonalDataCipher {
+	return es.personalData
+}
@@ -0,0 +1,138 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func init() {
+	RegisterPersonalData("personal.added", "name", "email")
+	RegisterPersonalDataErasure("personal.removed")
+}
+
+type testKeyStorage struct {
+	keys  crypto.Keys
+	reads int
+}
+
+func (s *testKeyStorage) ReadKeys() (crypto.Keys, error) {
+	return s.keys, nil
+}
+
+func (s *testKeyStorage) ReadKey(id string) (*crypto.Key, error) {
+	s.reads++
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, zerrors.ThrowInternal(sql.ErrNoRows, "", "unable to read key")
+	}
+	return &crypto.Key{ID: id, Value: key}, nil
+}
+
+func (s *testKeyStorage) CreateKeys(_ context.Context, keys ...*crypto.Key) error {
+	for _, key := range keys {
+		s.keys[key.ID] = key.Value
+	}
+	return nil
+}
+
+func (s *testKeyStorage) DeleteKeys(_ context.Context, ids ...string) error {
+	for _, id := range ids {
+		delete(s.keys, id)
+	}
+	return nil
+}
+
+func TestPersonalDataCipher(t *testing.T) {
+	ctx := context.Background()
+	aggregate := &Aggregate{ID: "user1", Type: "personal", InstanceID: "instance"}
+	keys := &testKeyStorage{keys: crypto.Keys{}}
+	cipher := NewPersonalDataCipher(keys, PersonalDataConfig{Enabled: true, KeyCacheDuration: time.Minute})
+	payload := []byte(`{"name":"Gigi","email":"gigi@example.com","userName":"gigi","age":3}`)
+
+	unregistered, err := cipher.Encrypt(ctx, aggregate, "personal.changed", payload)
+	if err != nil || string(unregistered) != string(payload) {
+		t.Fatalf("payload of unregistered event type must not change: %s, %v", unregistered, err)
+	}
+
+	encrypted, err := cipher.Encrypt(ctx, aggregate, "personal.added", payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := keys.keys["personal-data:instance:personal:user1"]; !ok {
+		t.Errorf("key of aggregate not created: %v", keys.keys)
+	}
+	var values map[string]any
+	if err = json.Unmarshal(encrypted, &values); err != nil {
+		t.Fatalf("encrypted payload invalid: %v", err)
+	}
+	for _, field := range []string{"name", "email"} {
+		if value, _ := values[field].(string); !strings.HasPrefix(value, personalDataPrefix) {
+			t.Errorf("field %s not encrypted: %v", field, values[field])
+		}
+	}
+	if values["userName"] != "gigi" || values["age"] != float64(3) {
+		t.Errorf("other fields must not be encrypted: %v", values)
+	}
+	if reencrypted, err := cipher.Encrypt(ctx, aggregate, "personal.added", encrypted); err != nil || string(reencrypted) != string(encrypted) {
+		t.Errorf("encrypted values must not be encrypted again: %s, %v", reencrypted, err)
+	}
+
+	decrypted, err := cipher.Decrypt(ctx, aggregate, "personal.added", encrypted)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `{"age":3,"email":"gigi@example.com","name":"Gigi","userName":"gigi"}`; string(decrypted) != want {
+		t.Errorf("decrypted payload: want %s, got %s", want, decrypted)
+	}
+	if keys.reads != 1 {
+		t.Errorf("key must be cached, got %d reads", keys.reads)
+	}
+
+	if err = cipher.Destroy(ctx, aggregate); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys.keys) > 0 {
+		t.Errorf("key not destroyed: %v", keys.keys)
+	}
+	erased, err := NewPersonalDataCipher(keys, PersonalDataConfig{}).Decrypt(ctx, aggregate, "personal.added", encrypted)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `{"age":3,"email":null,"name":null,"userName":"gigi"}`; string(erased) != want {
+		t.Errorf("erased payload: want %s, got %s", want, erased)
+	}
+}
+
+func TestEventstore_mapEvent_personalData(t *testing.T) {
+	ctx := context.Background()
+	aggregate := &Aggregate{ID: "user1", Type: "personal", InstanceID: "instance"}
+	es := NewEventstore(&Config{
+		PersonalData:     PersonalDataConfig{Enabled: true, KeyCacheDuration: time.Minute},
+		PersonalDataKeys: &testKeyStorage{keys: crypto.Keys{}},
+	})
+	encrypted, err := es.personalData.Encrypt(ctx, aggregate, "personal.added", []byte(`{"name":"Gigi"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mapped, err := es.mapEvent(&BaseEvent{Agg: aggregate, EventType: "personal.added", Data: encrypted})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	payload := new(struct {
+		Name string `json:"name"`
+	})
+	if err = mapped.Unmarshal(payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Name != "Gigi" {
+		t.Errorf("personal data not decrypted: %q", payload.Name)
+	}
+}
@@ -117,6 +117,9 @@ func (m *MockRepository) ExpectPush(expectedCommands []eventstore.Command, sleep
 				if !assert.ElementsMatch(m.MockPusher.ctrl.T, expectedCommand.UniqueConstraints(), commands[i].UniqueConstraints()) {
 					m.MockPusher.ctrl.T.Errorf("invalid command.UniqueConstraints [%d]: expected: %#v got: %#v", i, expectedCommand.UniqueConstraints(), commands[i].UniqueConstraints())
 				}
+				if !assert.Equal(m.MockPusher.ctrl.T, guardedSequence(expectedCommand), guardedSequence(commands[i])) {
+					m.MockPusher.ctrl.T.Errorf("invalid command.GuardedSequence [%d]: expected: %d got: %d", i, guardedSequence(expectedCommand), guardedSequence(commands[i]))
+				}
 			}
 			events := make([]eventstore.Event, len(commands))
 			for i, command := range commands {
@@ -145,6 +148,7 @@ func (m *MockRepository) ExpectPushFailed(err error, expectedCommands []eventsto
 				assert.Equal(m.MockPusher.ctrl.T, expectedCommand.Revision(), commands[i].Revision())
 				assert.Equal(m.MockPusher.ctrl.T, expectedCommand.Payload(), commands[i].Payload())
 				assert.ElementsMatch(m.MockPusher.ctrl.T, expectedCommand.UniqueConstraints(), commands[i].UniqueConstraints())
+				assert.Equal(m.MockPusher.ctrl.T, guardedSequence(expectedCommand), guardedSequence(commands[i]))
 			}
 
 			return nil, err
@@ -153,6 +157,16 @@ func (m *MockRepository) ExpectPushFailed(err error, expectedCommands []eventsto
 	return m
 }
 
+// guardedSequence returns the sequence expected by a [eventstore.SequenceGuarded] command or nil
+func guardedSequence(command eventstore.Command) *uint64 {
+	guarded, ok := command.(eventstore.SequenceGuarded)
+	if !ok {
+		return nil
+	}
+	sequence, _ := guarded.GuardedSequence()
+	return &sequence
+}
+
 type mockEvent struct {
 	eventstore.Command
 	sequence  uint64
@@ -0,0 +1,105 @@
+package retention
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/repository/deviceauth"
+	"github.com/zitadel/zitadel/internal/repository/idpintent"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// aggregate describes an ephemeral aggregate type which can be removed from the eventstore
+type aggregate struct {
+	aggregateType string
+	// terminalEventTypes end the lifetime of the aggregate
+	terminalEventTypes []string
+	// projections contain a row per aggregate
+	projections []*projectionTable
+}
+
+// projectionTable is a projection which stores a row per aggregate
+type projectionTable struct {
+	name             string
+	instanceIDColumn string
+	idColumn         string
+}
+
+// aggregates are the aggregate types supported by the retention.
+// Unique constraints of the aggregates (e.g. the user codes of device authorizations) are kept,
+// so they are never reused.
+var aggregates = map[string]*aggregate{
+	authrequest.AggregateType: {
+		aggregateType: authrequest.AggregateType,
+		terminalEventTypes: []string{
+			string(authrequest.SucceededType),
+			string(authrequest.FailedType),
+		},
+		projections: []*projectionTable{
+			{
+				name:             projection.AuthRequestsProjectionTable,
+				instanceIDColumn: projection.AuthRequestColumnInstanceID,
+				idColumn:         projection.AuthRequestColumnID,
+			},
+		},
+	},
+	deviceauth.AggregateType: {
+		aggregateType: deviceauth.AggregateType,
+		terminalEventTypes: []string{
+			string(deviceauth.ApprovedEventType),
+			string(deviceauth.CanceledEventType),
+			string(deviceauth.DoneEventType),
+		},
+		projections: []*projectionTable{
+			{
+				name:             projection.DeviceAuthRequestProjectionTable,
+				instanceIDColumn: projection.DeviceAuthRequestColumnInstanceID,
+				idColumn:         projection.DeviceAuthRequestColumnDeviceCode,
+			},
+		},
+	},
+	// oidc sessions are never terminated explicitly, they expire with their last refresh token
+	oidcsession.AggregateType: {
+		aggregateType: oidcsession.AggregateType,
+	},
+	samlsession.AggregateType: {
+		aggregateType: samlsession.AggregateType,
+		terminalEventTypes: []string{
+			string(samlsession.TerminatedType),
+		},
+	},
+	session.AggregateType: {
+		aggregateType: session.AggregateType,
+		terminalEventTypes: []string{
+			string(session.TerminateType),
+		},
+		projections: []*projectionTable{
+			{
+				name:             projection.SessionsProjectionTable,
+				instanceIDColumn: projection.SessionColumnInstanceID,
+				idColumn:         projection.SessionColumnID,
+			},
+		},
+	},
+	// succeeded intents are still required to check the intent on a session
+	idpintent.AggregateType: {
+		aggregateType: idpintent.AggregateType,
+		terminalEventTypes: []string{
+			string(idpintent.FailedEventType),
+		},
+	},
+}
+
+func (p *projectionTable) delete(ctx context.Context, tx *sql.Tx, instanceID string, ids []string) error {
+	stmt := "DELETE FROM " + p.name + " WHERE " + p.instanceIDColumn + " = $1 AND " + p.idColumn + " = ANY($2)"
+	if _, err := tx.ExecContext(ctx, stmt, instanceID, database.TextArray[string](ids)); err != nil {
+		return zerrors.ThrowInternal(err, "RETEN-Ohb4a", "Errors.Internal")
+	}
+	return nil
+}
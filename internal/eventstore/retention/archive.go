@@ -0,0 +1,176 @@
+package retention
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ArchiveType string
+
+const (
+	// ArchiveTypeNone deletes the events without archiving them
+	ArchiveTypeNone ArchiveType = "none"
+	// ArchiveTypeTable moves the events into eventstore.events2_archive
+	ArchiveTypeTable ArchiveType = "table"
+	// ArchiveTypeFile writes the events into a gzipped JSON lines file per transaction
+	ArchiveTypeFile ArchiveType = "file"
+)
+
+type ArchiveConfig struct {
+	Type ArchiveType
+	File FileArchiveConfig
+}
+
+type FileArchiveConfig struct {
+	// Path is the directory the archive files are written to
+	Path string
+}
+
+func (c *ArchiveConfig) newArchive() (archive, error) {
+	switch c.Type {
+	case ArchiveTypeNone:
+		return new(noneArchive), nil
+	case ArchiveTypeTable:
+		return new(tableArchive), nil
+	case ArchiveTypeFile:
+		if err := os.MkdirAll(c.File.Path, 0o700); err != nil {
+			return nil, zerrors.ThrowInternal(err, "RETEN-Ieb2o", "unable to create archive directory")
+		}
+		return &fileArchive{path: c.File.Path}, nil
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "RETEN-oo3Ph", "unknown archive type %q", c.Type)
+	}
+}
+
+// archive moves the events out of the eventstore
+type archive interface {
+	// move deletes the events matching the condition and stores them in the archive.
+	// undo is called if the transaction is rolled back afterwards.
+	move(ctx context.Context, tx *sql.Tx, condition string, args []any) (undo func(), err error)
+}
+
+type noneArchive struct{}
+
+func (*noneArchive) move(ctx context.Context, tx *sql.Tx, condition string, args []any) (func(), error) {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM eventstore.events2 WHERE "+condition, args...); err != nil {
+		return nil, zerrors.ThrowInternal(err, "RETEN-ua9Ae", "Errors.Internal")
+	}
+	return nil, nil
+}
+
+const eventColumns = `instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order`
+
+type tableArchive struct{}
+
+func (*tableArchive) move(ctx context.Context, tx *sql.Tx, condition string, args []any) (func(), error) {
+	stmt := "WITH archived AS (DELETE FROM eventstore.events2 WHERE " + condition + " RETURNING " + eventColumns + ")" +
+		" INSERT INTO eventstore.events2_archive (" + eventColumns + ") SELECT " + eventColumns + " FROM archived"
+	if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, zerrors.ThrowInternal(err, "RETEN-Zai4u", "Errors.Internal")
+	}
+	return nil, nil
+}
+
+// ArchivedEvent is a line of an archive file
+type ArchivedEvent struct {
+	InstanceID    string          `json:"instanceId"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	Type          string          `json:"type"`
+	Sequence      uint64          `json:"sequence"`
+	Revision      uint16          `json:"revision"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+	Creator       string          `json:"creator"`
+	Owner         string          `json:"owner"`
+	Position      float64         `json:"position"`
+	InTxOrder     uint32          `json:"inTxOrder"`
+}
+
+type fileArchive struct {
+	path string
+}
+
+func (a *fileArchive) move(ctx context.Context, tx *sql.Tx, condition string, args []any) (_ func(), err error) {
+	rows, err := tx.QueryContext(ctx, "DELETE FROM eventstore.events2 WHERE "+condition+" RETURNING "+eventColumns, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "RETEN-ieH0a", "Errors.Internal")
+	}
+	defer rows.Close()
+
+	name := filepath.Join(a.path, "events-"+strconv.FormatInt(time.Now().UnixNano(), 10)+".jsonl.gz")
+	file, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "RETEN-Eeng7", "unable to create archive file")
+	}
+	undo := func() {
+		removeErr := os.Remove(name)
+		logging.OnError(removeErr).WithField("file", name).Warn("unable to remove archive file of rolled back transaction")
+	}
+	defer func() {
+		if err != nil {
+			undo()
+		}
+	}()
+
+	if err = writeArchive(file, rows); err != nil {
+		return nil, err
+	}
+	return undo, nil
+}
+
+func writeArchive(file *os.File, rows *sql.Rows) (err error) {
+	defer func() {
+		closeErr := file.Close()
+		if err == nil && closeErr != nil {
+			err = zerrors.ThrowInternal(closeErr, "RETEN-ahW5o", "unable to write archive file")
+		}
+	}()
+	gz := gzip.NewWriter(file)
+	encoder := json.NewEncoder(gz)
+	for rows.Next() {
+		event := new(ArchivedEvent)
+		var payload []byte
+		err = rows.Scan(
+			&event.InstanceID,
+			&event.AggregateType,
+			&event.AggregateID,
+			&event.Type,
+			&event.Sequence,
+			&event.Revision,
+			&event.CreatedAt,
+			&payload,
+			&event.Creator,
+			&event.Owner,
+			&event.Position,
+			&event.InTxOrder,
+		)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "RETEN-oSh4e", "Errors.Internal")
+		}
+		event.Payload = payload
+		if err = encoder.Encode(event); err != nil {
+			return zerrors.ThrowInternal(err, "RETEN-Jee4i", "unable to write archive file")
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return zerrors.ThrowInternal(err, "RETEN-Bai0e", "Errors.Internal")
+	}
+	if err = gz.Close(); err != nil {
+		return zerrors.ThrowInternal(err, "RETEN-ooY1e", "unable to write archive file")
+	}
+	if err = file.Sync(); err != nil {
+		return zerrors.ThrowInternal(err, "RETEN-Aeb9u", "unable to write archive file")
+	}
+	return nil
+}
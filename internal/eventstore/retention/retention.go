@@ -0,0 +1,298 @@
+// Package retention removes the events of ephemeral aggregates like auth requests, device authorizations,
+// OIDC sessions, sessions and IdP intents from the eventstore after they are terminated or expired.
+// The events are moved into an archive before they are deleted,
+// rows of projections which belong to the removed aggregates are deleted in the same transaction.
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type Config struct {
+	Enabled bool
+	// Interval between two runs of the retention job
+	Interval time.Duration
+	// BulkLimit is the amount of aggregates checked per page and the maximum amount removed in one transaction
+	BulkLimit uint16
+	Archive   ArchiveConfig
+	// AggregateTypes configures the retention per aggregate type,
+	// aggregate types which are not configured are kept forever
+	AggregateTypes map[string]*AggregateConfig
+}
+
+type AggregateConfig struct {
+	// Terminated is the duration since the last event an aggregate is kept after it was terminated,
+	// e.g. after an auth request succeeded or a session was terminated. 0 disables the removal.
+	Terminated time.Duration
+	// Expired is the duration since the last event an aggregate is kept regardless of its state.
+	// It must exceed the maximum lifetime of the aggregate. 0 disables the removal.
+	Expired time.Duration
+}
+
+type Retention struct {
+	client     *database.DB
+	interval   time.Duration
+	bulkLimit  uint16
+	archive    archive
+	aggregates []*aggregateRetention
+}
+
+type aggregateRetention struct {
+	*aggregate
+	terminated time.Duration
+	expired    time.Duration
+}
+
+func New(client *database.DB, config *Config) (*Retention, error) {
+	archive, err := config.Archive.newArchive()
+	if err != nil {
+		return nil, err
+	}
+	retention := &Retention{
+		client:    client,
+		interval:  config.Interval,
+		bulkLimit: config.BulkLimit,
+		archive:   archive,
+	}
+	for aggregateType, aggregateConfig := range config.AggregateTypes {
+		aggregate, ok := aggregates[aggregateType]
+		if !ok {
+			return nil, zerrors.ThrowInvalidArgumentf(nil, "RETEN-Quo4e", "retention of aggregate type %q is not supported", aggregateType)
+		}
+		if aggregateConfig == nil || (aggregateConfig.Terminated == 0 && aggregateConfig.Expired == 0) {
+			continue
+		}
+		retention.aggregates = append(retention.aggregates, &aggregateRetention{
+			aggregate:  aggregate,
+			terminated: aggregateConfig.Terminated,
+			expired:    aggregateConfig.Expired,
+		})
+	}
+	slices.SortFunc(retention.aggregates, func(a, b *aggregateRetention) int {
+		return strings.Compare(a.aggregateType, b.aggregateType)
+	})
+	if retention.interval <= 0 {
+		retention.interval = time.Hour
+	}
+	if retention.bulkLimit == 0 {
+		retention.bulkLimit = 100
+	}
+	return retention, nil
+}
+
+// Start executes the retention job in the configured interval until the context is done
+func (r *Retention) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := r.Prune(ctx)
+				logging.OnError(err).Warn("eventstore retention failed")
+			}
+		}
+	}()
+}
+
+// Prune removes all aggregates which exceeded their retention.
+// The aggregates are paged per instance and aggregate type outside of a transaction,
+// each transaction removes at most BulkLimit aggregates of the page.
+func (r *Retention) Prune(ctx context.Context) (err error) {
+	var instanceID string
+	for {
+		instanceID, err = r.nextInstance(ctx, instanceID)
+		if err != nil || instanceID == "" {
+			return err
+		}
+		for _, aggregate := range r.aggregates {
+			if err = r.pruneInstance(ctx, aggregate, instanceID, time.Now()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// nextInstanceStmt seeks the next instance on the primary key of the events
+const nextInstanceStmt = "SELECT instance_id FROM eventstore.events2 WHERE instance_id > $1 ORDER BY instance_id LIMIT 1"
+
+// nextInstance returns the instance following the given one or an empty string if there is none
+func (r *Retention) nextInstance(ctx context.Context, after string) (instanceID string, err error) {
+	err = r.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&instanceID)
+	}, nextInstanceStmt, after)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", zerrors.ThrowInternal(err, "RETEN-Chu0e", "Errors.Internal")
+	}
+	return instanceID, nil
+}
+
+// pruneInstance pages through the aggregates of the instance and removes the expired ones of each page
+func (r *Retention) pruneInstance(ctx context.Context, aggregate *aggregateRetention, instanceID string, now time.Time) error {
+	var after string
+	for {
+		ids, last, err := r.expiredAggregates(ctx, aggregate, instanceID, after, now)
+		if err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			removed, err := r.prune(ctx, aggregate, instanceID, ids, now)
+			if err != nil {
+				return err
+			}
+			if removed > 0 {
+				logging.WithFields("instance", instanceID, "aggregate_type", aggregate.aggregateType, "count", removed).Info("aggregates removed from eventstore")
+			}
+		}
+		if last == "" {
+			return nil
+		}
+		after = last
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// prune removes the given aggregates in a short transaction.
+// The aggregates are checked again, so aggregates which got new events since they were paged are kept.
+func (r *Retention) prune(ctx context.Context, aggregate *aggregateRetention, instanceID string, ids []string, now time.Time) (_ int, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	// serializable prevents the removal of aggregates which got new events in the meantime,
+	// the transaction only reads the events of the given aggregates
+	tx, err := r.client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "RETEN-ahG3e", "Errors.Internal")
+	}
+	var undo func()
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		}
+		if err == nil {
+			return
+		}
+		rollbackErr := tx.Rollback()
+		logging.OnError(rollbackErr).Debug("unable to rollback")
+		if undo != nil {
+			undo()
+		}
+	}()
+
+	ids, err = r.stillExpired(ctx, tx, aggregate, instanceID, ids, now)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	undo, err = r.archive.move(ctx, tx, "instance_id = $1 AND aggregate_type = $2 AND aggregate_id = ANY($3)",
+		[]any{instanceID, aggregate.aggregateType, database.TextArray[string](ids)})
+	if err != nil {
+		return 0, err
+	}
+	for _, projection := range aggregate.projections {
+		if err = projection.delete(ctx, tx, instanceID, ids); err != nil {
+			return 0, err
+		}
+	}
+	return len(ids), nil
+}
+
+// expiredCondition returns the condition on the grouped events of an aggregate which is true if the aggregate is expired.
+// The placeholders start after the given offset, the condition is empty if the aggregate never expires.
+func (a *aggregateRetention) expiredCondition(now time.Time, offset int) (string, []any) {
+	args := make([]any, 0, 3)
+	conditions := make([]string, 0, 2)
+	if a.expired > 0 {
+		args = append(args, now.Add(-a.expired))
+		conditions = append(conditions, "MAX(created_at) < $"+strconv.Itoa(offset+len(args)))
+	}
+	if a.terminated > 0 && len(a.terminalEventTypes) > 0 {
+		args = append(args, now.Add(-a.terminated), database.TextArray[string](a.terminalEventTypes))
+		conditions = append(conditions, "(MAX(created_at) < $"+strconv.Itoa(offset+len(args)-1)+" AND bool_or(event_type = ANY($"+strconv.Itoa(offset+len(args))+")))")
+	}
+	return strings.Join(conditions, " OR "), args
+}
+
+// expiredAggregatesStmt pages through the aggregates of an instance on the primary key of the events,
+// the expired condition and the limit are added by [Retention.expiredAggregates]
+const expiredAggregatesStmt = "SELECT aggregate_id, %s FROM eventstore.events2 WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id > $3 GROUP BY aggregate_id ORDER BY aggregate_id LIMIT $%d"
+
+// expiredAggregates returns the expired aggregates of the page following the given aggregate id.
+// last is the last aggregate id of the page or empty if it is the last page.
+func (r *Retention) expiredAggregates(ctx context.Context, aggregate *aggregateRetention, instanceID, after string, now time.Time) (ids []string, last string, err error) {
+	args := []any{instanceID, aggregate.aggregateType, after}
+	condition, conditionArgs := aggregate.expiredCondition(now, len(args))
+	if condition == "" {
+		return nil, "", nil
+	}
+	args = append(append(args, conditionArgs...), r.bulkLimit)
+	stmt := fmt.Sprintf(expiredAggregatesStmt, condition, len(args))
+
+	var count int
+	ids = make([]string, 0, r.bulkLimit)
+	err = r.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var expired bool
+			if err := rows.Scan(&last, &expired); err != nil {
+				return err
+			}
+			count++
+			if expired {
+				ids = append(ids, last)
+			}
+		}
+		return nil
+	}, stmt, args...)
+	if err != nil {
+		return nil, "", zerrors.ThrowInternal(err, "RETEN-Iek0u", "Errors.Internal")
+	}
+	if count < int(r.bulkLimit) {
+		last = ""
+	}
+	return ids, last, nil
+}
+
+// stillExpiredStmt checks the given aggregates again, the HAVING clause is appended by [Retention.stillExpired]
+const stillExpiredStmt = "SELECT aggregate_id FROM eventstore.events2 WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = ANY($3) GROUP BY aggregate_id HAVING "
+
+func (r *Retention) stillExpired(ctx context.Context, tx *sql.Tx, aggregate *aggregateRetention, instanceID string, ids []string, now time.Time) ([]string, error) {
+	args := []any{instanceID, aggregate.aggregateType, database.TextArray[string](ids)}
+	condition, conditionArgs := aggregate.expiredCondition(now, len(args))
+	rows, err := tx.QueryContext(ctx, stillExpiredStmt+condition, append(args, conditionArgs...)...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "RETEN-Eew7o", "Errors.Internal")
+	}
+	defer rows.Close()
+
+	expired := make([]string, 0, len(ids))
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, zerrors.ThrowInternal(err, "RETEN-aeN3u", "Errors.Internal")
+		}
+		expired = append(expired, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "RETEN-Thoo4", "Errors.Internal")
+	}
+	return expired, nil
+}
@@ -0,0 +1,302 @@
+package retention
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/zitadel/zitadel/internal/database"
+	db_mock "github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/database/postgres"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *Config
+		want    []string
+		wantErr func(error) bool
+	}{
+		{
+			name: "unknown archive",
+			config: &Config{
+				Archive: ArchiveConfig{Type: "s3"},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "unsupported aggregate type",
+			config: &Config{
+				Archive:        ArchiveConfig{Type: ArchiveTypeNone},
+				AggregateTypes: map[string]*AggregateConfig{"user": {Expired: time.Hour}},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "disabled aggregate types are skipped",
+			config: &Config{
+				Archive: ArchiveConfig{Type: ArchiveTypeNone},
+				AggregateTypes: map[string]*AggregateConfig{
+					"session":      {Terminated: time.Hour},
+					"oidc_session": {},
+					"auth_request": {Expired: time.Hour},
+					"idpintent":    nil,
+				},
+			},
+			want: []string{"auth_request", "session"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(nil, tt.config)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			aggregateTypes := make([]string, len(got.aggregates))
+			for i, aggregate := range got.aggregates {
+				aggregateTypes[i] = aggregate.aggregateType
+			}
+			if !reflect.DeepEqual(tt.want, aggregateTypes) {
+				t.Errorf("unexpected aggregate types: want %v, got %v", tt.want, aggregateTypes)
+			}
+			if got.bulkLimit != 100 || got.interval != time.Hour {
+				t.Errorf("defaults not set: %d %s", got.bulkLimit, got.interval)
+			}
+		})
+	}
+}
+
+func TestRetention_Prune(t *testing.T) {
+	client, mock, err := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+	if err != nil {
+		t.Fatalf("unable to create sql mock: %v", err)
+	}
+	expiredAggregatesQuery := regexp.QuoteMeta(fmt.Sprintf(expiredAggregatesStmt, "MAX(created_at) < $4 OR (MAX(created_at) < $5 AND bool_or(event_type = ANY($6)))", 7))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(nextInstanceStmt)).
+		WithArgs("").
+		WillReturnRows(sqlmock.NewRows([]string{"instance_id"}).AddRow("instance1"))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(expiredAggregatesQuery).
+		WithArgs("instance1", "session", "", sqlmock.AnyArg(), sqlmock.AnyArg(), []string{"session.terminated"}, uint16(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"aggregate_id", "expired"}).
+			AddRow("session1", true).
+			AddRow("session2", false),
+		)
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(stillExpiredStmt+"MAX(created_at) < $4 OR (MAX(created_at) < $5 AND bool_or(event_type = ANY($6)))")).
+		WithArgs("instance1", "session", []string{"session1"}, sqlmock.AnyArg(), sqlmock.AnyArg(), []string{"session.terminated"}).
+		WillReturnRows(sqlmock.NewRows([]string{"aggregate_id"}).AddRow("session1"))
+	mock.ExpectExec(regexp.QuoteMeta("WITH archived AS (DELETE FROM eventstore.events2 WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = ANY($3) RETURNING "+eventColumns+")"+
+		" INSERT INTO eventstore.events2_archive ("+eventColumns+") SELECT "+eventColumns+" FROM archived")).
+		WithArgs("instance1", "session", []string{"session1"}).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM projections.sessions8 WHERE instance_id = $1 AND id = ANY($2)")).
+		WithArgs("instance1", []string{"session1"}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(expiredAggregatesQuery).
+		WithArgs("instance1", "session", "session2", sqlmock.AnyArg(), sqlmock.AnyArg(), []string{"session.terminated"}, uint16(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"aggregate_id", "expired"}))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(nextInstanceStmt)).
+		WithArgs("instance1").
+		WillReturnRows(sqlmock.NewRows([]string{"instance_id"}))
+	mock.ExpectRollback()
+
+	retention, err := New(&database.DB{DB: client, Database: new(postgres.Config)}, &Config{
+		BulkLimit: 2,
+		Archive:   ArchiveConfig{Type: ArchiveTypeTable},
+		AggregateTypes: map[string]*AggregateConfig{
+			"session": {Terminated: time.Hour, Expired: 720 * time.Hour},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = retention.Prune(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRetention_prune_newEvents(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	client, mock, err := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+	if err != nil {
+		t.Fatalf("unable to create sql mock: %v", err)
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(stillExpiredStmt+"MAX(created_at) < $4")).
+		WithArgs("instance1", "auth_request", []string{"authrequest1"}, now.Add(-time.Hour)).
+		WillReturnRows(sqlmock.NewRows([]string{"aggregate_id"}))
+	mock.ExpectCommit()
+
+	retention, err := New(&database.DB{DB: client, Database: new(postgres.Config)}, &Config{
+		Archive: ArchiveConfig{Type: ArchiveTypeTable},
+		AggregateTypes: map[string]*AggregateConfig{
+			"auth_request": {Expired: time.Hour},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	removed, err := retention.prune(context.Background(), retention.aggregates[0], "instance1", []string{"authrequest1"}, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if removed != 0 {
+		t.Errorf("expected no removed aggregates, got %d", removed)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRetention_prune_fileArchive(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	path := t.TempDir()
+	client, mock, err := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+	if err != nil {
+		t.Fatalf("unable to create sql mock: %v", err)
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(stillExpiredStmt+"MAX(created_at) < $4")).
+		WithArgs("instance1", "oidc_session", []string{"oidcsession1"}, now.Add(-time.Hour)).
+		WillReturnRows(sqlmock.NewRows([]string{"aggregate_id"}).AddRow("oidcsession1"))
+	mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM eventstore.events2 WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = ANY($3) RETURNING "+eventColumns)).
+		WithArgs("instance1", "oidc_session", []string{"oidcsession1"}).
+		WillReturnRows(sqlmock.NewRows([]string{"instance_id", "aggregate_type", "aggregate_id", "event_type", "sequence", "revision", "created_at", "payload", "creator", "owner", "position", "in_tx_order"}).
+			AddRow("instance1", "oidc_session", "oidcsession1", "oidc_session.added", uint64(1), uint16(1), now, []byte(`{"userID":"user1"}`), "user1", "org1", 1.5, uint32(0)),
+		)
+	mock.ExpectCommit()
+
+	retention, err := New(&database.DB{DB: client, Database: new(postgres.Config)}, &Config{
+		Archive: ArchiveConfig{Type: ArchiveTypeFile, File: FileArchiveConfig{Path: path}},
+		AggregateTypes: map[string]*AggregateConfig{
+			"oidc_session": {Terminated: time.Minute, Expired: time.Hour},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err = retention.prune(context.Background(), retention.aggregates[0], "instance1", []string{"oidcsession1"}, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(path, "events-*.jsonl.gz"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("expected one archive file, got %v: %v", files, err)
+	}
+	got := readArchive(t, files[0])
+	want := []*ArchivedEvent{{
+		InstanceID:    "instance1",
+		AggregateType: "oidc_session",
+		AggregateID:   "oidcsession1",
+		Type:          "oidc_session.added",
+		Sequence:      1,
+		Revision:      1,
+		CreatedAt:     now,
+		Payload:       json.RawMessage(`{"userID":"user1"}`),
+		Creator:       "user1",
+		Owner:         "org1",
+		Position:      1.5,
+	}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("unexpected archive: want %+v, got %+v", want, got)
+	}
+}
+
+func TestRetention_prune_fileArchiveRolledBack(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	path := t.TempDir()
+	client, mock, err := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+	if err != nil {
+		t.Fatalf("unable to create sql mock: %v", err)
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(stillExpiredStmt+"(MAX(created_at) < $4 AND bool_or(event_type = ANY($5)))")).
+		WithArgs("instance1", "device_auth", []string{"device1"}, now.Add(-time.Hour), []string{"device.authorization.approved", "device.authorization.canceled", "device.authorization.done"}).
+		WillReturnRows(sqlmock.NewRows([]string{"aggregate_id"}).AddRow("device1"))
+	mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM eventstore.events2 WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = ANY($3) RETURNING "+eventColumns)).
+		WithArgs("instance1", "device_auth", []string{"device1"}).
+		WillReturnRows(sqlmock.NewRows([]string{"instance_id", "aggregate_type", "aggregate_id", "event_type", "sequence", "revision", "created_at", "payload", "creator", "owner", "position", "in_tx_order"}).
+			AddRow("instance1", "device_auth", "device1", "device.authorization.added", uint64(1), uint16(1), now, nil, "user1", "instance1", 1.5, uint32(0)),
+		)
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM projections.device_auth_requests2 WHERE instance_id = $1 AND device_code = ANY($2)")).
+		WithArgs("instance1", []string{"device1"}).
+		WillReturnError(sqlmock.ErrCancelled)
+	mock.ExpectRollback()
+
+	retention, err := New(&database.DB{DB: client, Database: new(postgres.Config)}, &Config{
+		Archive: ArchiveConfig{Type: ArchiveTypeFile, File: FileArchiveConfig{Path: path}},
+		AggregateTypes: map[string]*AggregateConfig{
+			"device_auth": {Terminated: time.Hour},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err = retention.prune(context.Background(), retention.aggregates[0], "instance1", []string{"device1"}, now); !zerrors.IsInternal(err) {
+		t.Errorf("expected internal error, got %v", err)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	files, err := filepath.Glob(filepath.Join(path, "*"))
+	if err != nil || len(files) != 0 {
+		t.Errorf("expected archive file to be removed, got %v: %v", files, err)
+	}
+}
+
+func readArchive(t *testing.T, name string) []*ArchivedEvent {
+	t.Helper()
+	file, err := os.Open(name)
+	if err != nil {
+		t.Fatalf("unable to open archive: %v", err)
+	}
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatalf("unable to read archive: %v", err)
+	}
+	var events []*ArchivedEvent
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		event := new(ArchivedEvent)
+		if err = json.Unmarshal(scanner.Bytes(), event); err != nil {
+			t.Fatalf("unable to unmarshal event: %v", err)
+		}
+		events = append(events, event)
+	}
+	if err = scanner.Err(); err != nil {
+		t.Fatalf("unable to read archive: %v", err)
+	}
+	return events
+}
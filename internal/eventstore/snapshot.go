@@ -0,0 +1,188 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Snapshotter is a [QueryReducer] which can be restored from a snapshot
+// instead of replaying all events of its aggregate.
+// The reducer is stored as json, the fields of the embedded [WriteModel] are stored next to it.
+// The json is encrypted with the personal data key of the aggregate,
+// so snapshots of erased aggregates become unreadable like the personal data of their events.
+// Snapshots are only used if the query is restricted to a single aggregate.
+type Snapshotter interface {
+	QueryReducer
+	// SnapshotType identifies the write model including all parameters
+	// which change the query or the reduced state, e.g. the id of an idp config
+	SnapshotType() string
+	// SnapshotVersion must be increased as soon as the reducers or the fields of the write model change.
+	// Snapshots of other versions are ignored.
+	SnapshotVersion() uint16
+
+	writeModel() *WriteModel
+}
+
+// SnapshotStore stores the latest snapshot of a write model per aggregate
+type SnapshotStore interface {
+	// Snapshot returns the latest snapshot of the key or nil if there is none
+	Snapshot(ctx context.Context, key *SnapshotKey) (*Snapshot, error)
+	// StoreSnapshot replaces the stored snapshot if it's older or of another version
+	StoreSnapshot(ctx context.Context, snapshot *Snapshot) error
+}
+
+type SnapshotConfig struct {
+	Enabled bool
+	// Interval is the amount of replayed events after which a new snapshot is stored
+	Interval uint32
+}
+
+type SnapshotKey struct {
+	InstanceID     string
+	AggregateType  AggregateType
+	AggregateID    string
+	WriteModelType string
+}
+
+type Snapshot struct {
+	SnapshotKey
+	Version       uint16
+	Sequence      uint64
+	Position      float64
+	ResourceOwner string
+	ChangeDate    time.Time
+	// Payload is the encrypted json of the [Snapshotter]
+	Payload []byte
+}
+
+// aggregate returns the aggregate of the snapshot, its key encrypts the payload
+func (k *SnapshotKey) aggregate() *Aggregate {
+	return &Aggregate{
+		ID:         k.AggregateID,
+		Type:       k.AggregateType,
+		InstanceID: k.InstanceID,
+	}
+}
+
+func (wm *WriteModel) writeModel() *WriteModel {
+	return wm
+}
+
+// filterToSnapshotter restores the reducer from the latest snapshot
+// and only replays the events after the sequence of the snapshot.
+// A new snapshot is stored if at least the configured interval of events was replayed.
+func (es *Eventstore) filterToSnapshotter(ctx context.Context, r Snapshotter) error {
+	query := r.Query()
+	query.ensureInstanceID(ctx)
+	key := snapshotKey(query)
+	if key == nil {
+		return es.FilterToReducer(ctx, query, r)
+	}
+	key.WriteModelType = r.SnapshotType()
+
+	var sequence uint64
+	if snapshot := es.restorableSnapshot(ctx, key, r, query.resourceOwner); snapshot != nil {
+		if err := json.Unmarshal(snapshot.Payload, r); err != nil {
+			return zerrors.ThrowInternal(err, "V2-Ohp4e", "Errors.Internal")
+		}
+		wm := r.writeModel()
+		wm.AggregateID = snapshot.AggregateID
+		wm.InstanceID = snapshot.InstanceID
+		wm.ResourceOwner = snapshot.ResourceOwner
+		wm.ProcessedSequence = snapshot.Sequence
+		wm.Position = snapshot.Position
+		wm.ChangeDate = snapshot.ChangeDate
+		sequence = snapshot.Sequence
+		query.SequenceGreater(sequence)
+	}
+
+	counter := &eventCounter{reducer: r}
+	if err := es.FilterToReducer(ctx, query, counter); err != nil {
+		return err
+	}
+	wm := r.writeModel()
+	if counter.count < es.snapshotInterval || wm.ProcessedSequence <= sequence {
+		return nil
+	}
+	payload, err := json.Marshal(r)
+	if err != nil {
+		logging.WithFields("write_model", key.WriteModelType).WithError(err).Warn("unable to marshal snapshot")
+		return nil
+	}
+	if payload, err = es.personalData.EncryptSnapshot(ctx, key.aggregate(), payload); err != nil {
+		logging.WithFields("write_model", key.WriteModelType, "aggregate_id", key.AggregateID).WithError(err).Warn("unable to encrypt snapshot")
+		return nil
+	}
+	err = es.snapshots.StoreSnapshot(ctx, &Snapshot{
+		SnapshotKey:   *key,
+		Version:       r.SnapshotVersion(),
+		Sequence:      wm.ProcessedSequence,
+		Position:      wm.Position,
+		ResourceOwner: wm.ResourceOwner,
+		ChangeDate:    wm.ChangeDate,
+		Payload:       payload,
+	})
+	logging.WithFields("write_model", key.WriteModelType, "aggregate_id", key.AggregateID).OnError(err).Warn("unable to store snapshot")
+	return nil
+}
+
+// restorableSnapshot returns the latest snapshot with decrypted payload
+// if it matches the version of the reducer and the resource owner of the query.
+// The snapshot is only an optimization, without it all events are replayed.
+func (es *Eventstore) restorableSnapshot(ctx context.Context, key *SnapshotKey, r Snapshotter, resourceOwner string) *Snapshot {
+	logger := logging.WithFields("write_model", key.WriteModelType, "aggregate_id", key.AggregateID)
+	snapshot, err := es.snapshots.Snapshot(ctx, key)
+	if err != nil {
+		logger.WithError(err).Warn("unable to load snapshot")
+		return nil
+	}
+	if snapshot == nil || snapshot.Version != r.SnapshotVersion() ||
+		(resourceOwner != "" && resourceOwner != snapshot.ResourceOwner) {
+		return nil
+	}
+	payload, err := es.personalData.DecryptSnapshot(ctx, key.aggregate(), snapshot.Payload)
+	logger.OnError(err).Warn("unable to decrypt snapshot")
+	if payload == nil {
+		return nil
+	}
+	restored := *snapshot
+	restored.Payload = payload
+	return &restored
+}
+
+// snapshotKey returns the key of the aggregate the query is restricted to.
+// nil is returned if the query cannot be continued from a snapshot.
+func snapshotKey(query *SearchQueryBuilder) *SnapshotKey {
+	if query.instanceID == nil || *query.instanceID == "" || len(query.instanceIDs) > 0 ||
+		query.columns != ColumnsEvent || len(query.queries) != 1 || query.tx != nil || query.allowTimeTravel || query.desc ||
+		query.limit > 0 || query.offset > 0 || query.editorUser != "" ||
+		query.positionAfter > 0 || query.eventSequenceGreater > 0 ||
+		!query.creationDateAfter.IsZero() || !query.creationDateBefore.IsZero() {
+		return nil
+	}
+	q := query.queries[0]
+	if len(q.aggregateTypes) != 1 || len(q.aggregateIDs) != 1 || q.aggregateIDs[0] == "" || len(q.eventData) > 0 {
+		return nil
+	}
+	return &SnapshotKey{
+		InstanceID:    *query.instanceID,
+		AggregateType: q.aggregateTypes[0],
+		AggregateID:   q.aggregateIDs[0],
+	}
+}
+
+// eventCounter counts the events appended to the reducer
+type eventCounter struct {
+	reducer
+	count uint32
+}
+
+func (c *eventCounter) AppendEvents(events ...Event) {
+	c.count += uint32(len(events))
+	c.reducer.AppendEvents(events...)
+}
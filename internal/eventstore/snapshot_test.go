@@ -0,0 +1,313 @@
+package eventstore
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+)
+
+type testSnapshotter struct {
+	WriteModel
+
+	version uint16
+	Types   []EventType
+}
+
+func (wm *testSnapshotter) Reduce() error {
+	for _, event := range wm.Events {
+		wm.Types = append(wm.Types, event.Type())
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *testSnapshotter) Query() *SearchQueryBuilder {
+	return NewSearchQueryBuilder(ColumnsEvent).
+		InstanceID("instance").
+		AddQuery().
+		AggregateTypes("test.aggregate").
+		AggregateIDs("agg1").
+		Builder()
+}
+
+func (wm *testSnapshotter) SnapshotType() string {
+	return "test"
+}
+
+func (wm *testSnapshotter) SnapshotVersion() uint16 {
+	return wm.version
+}
+
+type testSnapshotStore struct {
+	snapshot *Snapshot
+	stored   *Snapshot
+}
+
+func (s *testSnapshotStore) Snapshot(_ context.Context, key *SnapshotKey) (*Snapshot, error) {
+	if s.snapshot == nil || s.snapshot.SnapshotKey != *key {
+		return nil, nil
+	}
+	return s.snapshot, nil
+}
+
+func (s *testSnapshotStore) StoreSnapshot(_ context.Context, snapshot *Snapshot) error {
+	s.stored = snapshot
+	return nil
+}
+
+type testSequenceQuerier struct {
+	testQuerier
+	sequenceGreater uint64
+}
+
+func (repo *testSequenceQuerier) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, reduce Reducer) error {
+	repo.sequenceGreater = searchQuery.GetEventSequenceGreater()
+	for _, event := range repo.events {
+		if event.Sequence() <= repo.sequenceGreater {
+			continue
+		}
+		if err := reduce(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestEventstore_FilterToQueryReducer_snapshot(t *testing.T) {
+	changeDate := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	events := make([]Event, 3)
+	for i, typ := range []EventType{"test.added", "test.changed", "test.removed"} {
+		events[i] = &BaseEvent{
+			Agg: &Aggregate{
+				ID:            "agg1",
+				Type:          "test.aggregate",
+				ResourceOwner: "ro",
+				InstanceID:    "instance",
+			},
+			EventType: typ,
+			Seq:       uint64(i + 1),
+			Pos:       float64(i + 1),
+			Creation:  changeDate,
+		}
+	}
+	key := SnapshotKey{
+		InstanceID:     "instance",
+		AggregateType:  "test.aggregate",
+		AggregateID:    "agg1",
+		WriteModelType: "test",
+	}
+	tests := []struct {
+		name                string
+		snapshot            *Snapshot
+		interval            uint32
+		erased              bool
+		wantSequenceGreater uint64
+		wantTypes           []EventType
+		wantStored          *Snapshot
+	}{
+		{
+			name:                "no snapshot, interval not reached",
+			interval:            5,
+			wantSequenceGreater: 0,
+			wantTypes:           []EventType{"test.added", "test.changed", "test.removed"},
+		},
+		{
+			name:                "no snapshot, stored",
+			interval:            3,
+			wantSequenceGreater: 0,
+			wantTypes:           []EventType{"test.added", "test.changed", "test.removed"},
+			wantStored: &Snapshot{
+				SnapshotKey:   key,
+				Version:       1,
+				Sequence:      3,
+				Position:      3,
+				ResourceOwner: "ro",
+				ChangeDate:    changeDate,
+				Payload:       []byte(`{"Types":["test.added","test.changed","test.removed"]}`),
+			},
+		},
+		{
+			name:     "snapshot restored",
+			interval: 3,
+			snapshot: &Snapshot{
+				SnapshotKey:   key,
+				Version:       1,
+				Sequence:      2,
+				Position:      2,
+				ResourceOwner: "ro",
+				ChangeDate:    changeDate,
+				Payload:       []byte(`{"Types":["test.added","test.changed"]}`),
+			},
+			wantSequenceGreater: 2,
+			wantTypes:           []EventType{"test.added", "test.changed", "test.removed"},
+		},
+		{
+			name:     "snapshot of erased aggregate ignored",
+			interval: 5,
+			erased:   true,
+			snapshot: &Snapshot{
+				SnapshotKey:   key,
+				Version:       1,
+				Sequence:      2,
+				Position:      2,
+				ResourceOwner: "ro",
+				ChangeDate:    changeDate,
+				Payload:       []byte(`{"Types":["test.added","test.changed"]}`),
+			},
+			wantSequenceGreater: 0,
+			wantTypes:           []EventType{"test.added", "test.changed", "test.removed"},
+		},
+		{
+			name:     "snapshot of other version ignored",
+			interval: 3,
+			snapshot: &Snapshot{
+				SnapshotKey: key,
+				Version:     0,
+				Sequence:    2,
+				Payload:     []byte(`{"Types":["outdated"]}`),
+			},
+			wantSequenceGreater: 0,
+			wantTypes:           []EventType{"test.added", "test.changed", "test.removed"},
+			wantStored: &Snapshot{
+				SnapshotKey:   key,
+				Version:       1,
+				Sequence:      3,
+				Position:      3,
+				ResourceOwner: "ro",
+				ChangeDate:    changeDate,
+				Payload:       []byte(`{"Types":["test.added","test.changed","test.removed"]}`),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			keys := &testKeyStorage{keys: crypto.Keys{}}
+			cipher := NewPersonalDataCipher(keys, PersonalDataConfig{Enabled: true})
+			store := new(testSnapshotStore)
+			if tt.snapshot != nil {
+				encrypted, err := cipher.EncryptSnapshot(ctx, tt.snapshot.aggregate(), tt.snapshot.Payload)
+				require.NoError(t, err)
+				snapshot := *tt.snapshot
+				snapshot.Payload = encrypted
+				store.snapshot = &snapshot
+			}
+			if tt.erased {
+				require.NoError(t, cipher.Destroy(ctx, key.aggregate()))
+			}
+			querier := &testSequenceQuerier{testQuerier: testQuerier{events: events, t: t}}
+			es := NewEventstore(&Config{
+				Querier:          querier,
+				Snapshots:        SnapshotConfig{Enabled: true, Interval: tt.interval},
+				SnapshotStore:    store,
+				PersonalData:     PersonalDataConfig{Enabled: true},
+				PersonalDataKeys: keys,
+			})
+			wm := &testSnapshotter{version: 1}
+			require.NoError(t, es.FilterToQueryReducer(ctx, wm))
+			assert.Equal(t, tt.wantSequenceGreater, querier.sequenceGreater)
+			assert.Equal(t, tt.wantTypes, wm.Types)
+			assert.Equal(t, uint64(3), wm.ProcessedSequence)
+			assert.Equal(t, float64(3), wm.Position)
+			assert.Equal(t, "ro", wm.ResourceOwner)
+			assert.Equal(t, "agg1", wm.AggregateID)
+			if tt.wantStored == nil {
+				assert.Nil(t, store.stored)
+				return
+			}
+			require.NotNil(t, store.stored)
+			assert.NotContains(t, string(store.stored.Payload), "test.added", "payload must be encrypted")
+			decrypted, err := cipher.DecryptSnapshot(ctx, store.stored.aggregate(), store.stored.Payload)
+			require.NoError(t, err)
+			stored := *store.stored
+			stored.Payload = decrypted
+			assert.Equal(t, *tt.wantStored, stored)
+		})
+	}
+}
+
+func TestEventstore_FilterToQueryReducer_snapshotRequiresPersonalData(t *testing.T) {
+	store := new(testSnapshotStore)
+	es := NewEventstore(&Config{
+		Querier:       &testSequenceQuerier{testQuerier: testQuerier{t: t}},
+		Snapshots:     SnapshotConfig{Enabled: true, Interval: 0},
+		SnapshotStore: store,
+	})
+	require.NoError(t, es.FilterToQueryReducer(context.Background(), &testSnapshotter{version: 1}))
+	assert.Nil(t, store.stored)
+}
+
+func Test_snapshotKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *SearchQueryBuilder
+		want  *SnapshotKey
+	}{
+		{
+			name: "single aggregate",
+			query: NewSearchQueryBuilder(ColumnsEvent).
+				InstanceID("instance").
+				ResourceOwner("ro").
+				AddQuery().
+				AggregateTypes("test.aggregate").
+				AggregateIDs("agg1").
+				EventTypes("test.added").
+				Builder(),
+			want: &SnapshotKey{
+				InstanceID:    "instance",
+				AggregateType: "test.aggregate",
+				AggregateID:   "agg1",
+			},
+		},
+		{
+			name: "no instance",
+			query: NewSearchQueryBuilder(ColumnsEvent).
+				AddQuery().
+				AggregateTypes("test.aggregate").
+				AggregateIDs("agg1").
+				Builder(),
+		},
+		{
+			name: "multiple aggregates",
+			query: NewSearchQueryBuilder(ColumnsEvent).
+				InstanceID("instance").
+				AddQuery().
+				AggregateTypes("test.aggregate").
+				AggregateIDs("agg1", "agg2").
+				Builder(),
+		},
+		{
+			name: "multiple queries",
+			query: NewSearchQueryBuilder(ColumnsEvent).
+				InstanceID("instance").
+				AddQuery().
+				AggregateTypes("test.aggregate").
+				AggregateIDs("agg1").
+				Or().
+				AggregateTypes("other.aggregate").
+				Builder(),
+		},
+		{
+			name: "sequence filtered",
+			query: NewSearchQueryBuilder(ColumnsEvent).
+				InstanceID("instance").
+				SequenceGreater(3).
+				AddQuery().
+				AggregateTypes("test.aggregate").
+				AggregateIDs("agg1").
+				Builder(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snapshotKey(tt.query); !reflect.DeepEqual(tt.want, got) {
+				t.Errorf("snapshotKey() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
 )
 
 var (
@@ -14,7 +15,8 @@ var (
 )
 
 type Eventstore struct {
-	client *database.DB
+	client       *database.DB
+	personalData *eventstore.PersonalDataCipher
 }
 
 func NewEventstore(client *database.DB) *Eventstore {
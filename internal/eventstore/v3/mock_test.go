@@ -42,6 +42,18 @@ func (m *mockCommand) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return m.constraints
 }
 
+var _ eventstore.SequenceGuarded = (*mockGuardedCommand)(nil)
+
+type mockGuardedCommand struct {
+	mockCommand
+	sequence uint64
+}
+
+// GuardedSequence implements [eventstore.SequenceGuarded]
+func (m *mockGuardedCommand) GuardedSequence() (uint64, string) {
+	return m.sequence, "Errors.Guarded"
+}
+
 func mockEvent(aggregate *eventstore.Aggregate, sequence uint64, payload Payload) eventstore.Event {
 	return &event{
 		aggregate: aggregate,
@@ -0,0 +1,94 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// EncryptPersonalData enables the encryption of personal data in the payload of pushed events
+func (es *Eventstore) EncryptPersonalData(cipher *eventstore.PersonalDataCipher) {
+	es.personalData = cipher
+}
+
+// personalDataCommand is a command with encrypted personal data in its payload
+type personalDataCommand struct {
+	eventstore.Command
+	payload json.RawMessage
+}
+
+// Payload implements [eventstore.Command]
+func (c *personalDataCommand) Payload() any {
+	return c.payload
+}
+
+func (es *Eventstore) encryptPersonalData(ctx context.Context, commands []eventstore.Command) ([]eventstore.Command, error) {
+	if es.personalData == nil {
+		return commands, nil
+	}
+	encrypted := make([]eventstore.Command, len(commands))
+	for i, command := range commands {
+		encrypted[i] = command
+		if command.Payload() == nil || !eventstore.HasPersonalData(command.Type()) {
+			continue
+		}
+		payload, err := json.Marshal(command.Payload())
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "V3-ahXe4", "Errors.Internal")
+		}
+		if command.Aggregate().InstanceID == "" {
+			command.Aggregate().InstanceID = authz.GetInstance(ctx).InstanceID()
+		}
+		encryptedPayload, err := es.personalData.Encrypt(ctx, command.Aggregate(), command.Type(), payload)
+		if err != nil {
+			return nil, err
+		}
+		encrypted[i] = &personalDataCommand{Command: command, payload: encryptedPayload}
+	}
+	return encrypted, nil
+}
+
+const deleteSnapshotsStmt = "DELETE FROM eventstore.snapshots WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = $3"
+
+// deleteErasedSnapshots deletes the snapshots of aggregates whose personal data are erased,
+// as the snapshots contain the decrypted personal data
+func (es *Eventstore) deleteErasedSnapshots(ctx context.Context, tx *sql.Tx, commands []eventstore.Command) error {
+	if es.personalData == nil {
+		return nil
+	}
+	for _, command := range commands {
+		if !eventstore.IsPersonalDataErasure(command.Type()) {
+			continue
+		}
+		_, err := tx.ExecContext(ctx, deleteSnapshotsStmt, command.Aggregate().InstanceID, command.Aggregate().Type, command.Aggregate().ID)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "V3-Eix3u", "Errors.Internal")
+		}
+	}
+	return nil
+}
+
+// destroyPersonalDataKeys destroys the keys of aggregates whose personal data are erased
+// after the events were pushed successfully
+func (es *Eventstore) destroyPersonalDataKeys(ctx context.Context, commands []eventstore.Command) {
+	if es.personalData == nil {
+		return
+	}
+	for _, command := range commands {
+		if !eventstore.IsPersonalDataErasure(command.Type()) {
+			continue
+		}
+		err := es.personalData.Destroy(ctx, command.Aggregate())
+		logging.WithFields(
+			"instance", command.Aggregate().InstanceID,
+			"aggType", command.Aggregate().Type,
+			"aggID", command.Aggregate().ID,
+		).OnError(err).Error("unable to destroy personal data key")
+	}
+}
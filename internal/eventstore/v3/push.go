@@ -19,6 +19,11 @@ import (
 )
 
 func (es *Eventstore) Push(ctx context.Context, commands ...eventstore.Command) (events []eventstore.Event, err error) {
+	commands, err = es.encryptPersonalData(ctx, commands)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, spanBeginTx := tracing.NewNamedSpan(ctx, "db.BeginTx")
 	tx, err := es.client.BeginTx(ctx, nil)
 	spanBeginTx.EndWithError(err)
@@ -36,11 +41,19 @@ func (es *Eventstore) Push(ctx context.Context, commands ...eventstore.Command)
 			return err
 		}
 
+		if err = checkGuardedSequences(sequences, commands); err != nil {
+			return err
+		}
+
 		events, err = insertEvents(ctx, tx, sequences, commands)
 		if err != nil {
 			return err
 		}
 
+		if err = es.deleteErasedSnapshots(ctx, tx, commands); err != nil {
+			return err
+		}
+
 		return handleUniqueConstraints(ctx, tx, commands)
 	})
 
@@ -48,6 +61,7 @@ func (es *Eventstore) Push(ctx context.Context, commands ...eventstore.Command)
 		return nil, err
 	}
 
+	es.destroyPersonalDataKeys(ctx, commands)
 	return events, nil
 }
 
@@ -44,6 +44,23 @@ func latestSequences(ctx context.Context, tx *sql.Tx, commands []eventstore.Comm
 	return sequences, nil
 }
 
+// checkGuardedSequences ensures the aggregates of [eventstore.SequenceGuarded] commands
+// weren't changed since the commands were created.
+// The latest sequences are locked by the push, so concurrent pushes are checked one after the other.
+func checkGuardedSequences(sequences []*latestSequence, commands []eventstore.Command) error {
+	for _, command := range commands {
+		guarded, ok := command.(eventstore.SequenceGuarded)
+		if !ok {
+			continue
+		}
+		expected, errMessage := guarded.GuardedSequence()
+		if sequence := searchSequenceByCommand(sequences, command); sequence == nil || sequence.sequence != expected {
+			return zerrors.ThrowPreconditionFailed(nil, "V3-Ohs4u", errMessage)
+		}
+	}
+	return nil
+}
+
 func searchSequenceByCommand(sequences []*latestSequence, command eventstore.Command) *latestSequence {
 	for _, sequence := range sequences {
 		if sequence.aggregate.Type == command.Aggregate().Type &&
@@ -10,6 +10,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 func Test_searchSequence(t *testing.T) {
@@ -86,6 +87,47 @@ func Test_searchSequence(t *testing.T) {
 	}
 }
 
+func Test_checkGuardedSequences(t *testing.T) {
+	sequences := []*latestSequence{
+		{
+			aggregate: mockAggregate("V3-Ohs4u"),
+			sequence:  2,
+		},
+	}
+	tests := []struct {
+		name     string
+		commands []eventstore.Command
+		wantErr  error
+	}{
+		{
+			name: "not guarded",
+			commands: []eventstore.Command{
+				&mockCommand{aggregate: mockAggregate("V3-Ohs4u")},
+			},
+		},
+		{
+			name: "sequence matches",
+			commands: []eventstore.Command{
+				&mockCommand{aggregate: mockAggregate("V3-Ohs4u")},
+				&mockGuardedCommand{mockCommand: mockCommand{aggregate: mockAggregate("V3-Ohs4u")}, sequence: 2},
+			},
+		},
+		{
+			name: "aggregate changed",
+			commands: []eventstore.Command{
+				&mockGuardedCommand{mockCommand: mockCommand{aggregate: mockAggregate("V3-Ohs4u")}, sequence: 1},
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "V3-Ohs4u", "Errors.Guarded"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkGuardedSequences(sequences, tt.commands)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
 func Test_commandsToSequences(t *testing.T) {
 	aggregate := mockAggregate("V3-MKHTF")
 	type args struct {
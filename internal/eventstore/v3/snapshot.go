@@ -0,0 +1,68 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var _ eventstore.SnapshotStore = (*Eventstore)(nil)
+
+const snapshotStmt = "SELECT version, sequence, position, owner, change_date, payload FROM eventstore.snapshots" +
+	" WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = $3 AND write_model_type = $4"
+
+//go:embed snapshot_store.sql
+var storeSnapshotStmt string
+
+// Snapshot implements [eventstore.SnapshotStore]
+func (es *Eventstore) Snapshot(ctx context.Context, key *eventstore.SnapshotKey) (*eventstore.Snapshot, error) {
+	snapshot := &eventstore.Snapshot{SnapshotKey: *key}
+	err := es.client.QueryRowContext(ctx,
+		func(row *sql.Row) error {
+			return row.Scan(
+				&snapshot.Version,
+				&snapshot.Sequence,
+				&snapshot.Position,
+				&snapshot.ResourceOwner,
+				&snapshot.ChangeDate,
+				&snapshot.Payload,
+			)
+		},
+		snapshotStmt,
+		key.InstanceID,
+		key.AggregateType,
+		key.AggregateID,
+		key.WriteModelType,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-ieS4u", "Errors.Internal")
+	}
+	return snapshot, nil
+}
+
+// StoreSnapshot implements [eventstore.SnapshotStore]
+func (es *Eventstore) StoreSnapshot(ctx context.Context, snapshot *eventstore.Snapshot) error {
+	_, err := es.client.ExecContext(ctx, storeSnapshotStmt,
+		snapshot.InstanceID,
+		snapshot.AggregateType,
+		snapshot.AggregateID,
+		snapshot.WriteModelType,
+		snapshot.Version,
+		snapshot.Sequence,
+		snapshot.Position,
+		snapshot.ResourceOwner,
+		snapshot.ChangeDate,
+		snapshot.Payload,
+	)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V3-Shoo6", "Errors.Internal")
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+INSERT INTO eventstore.snapshots (
+    instance_id
+    , aggregate_type
+    , aggregate_id
+    , write_model_type
+    , version
+    , sequence
+    , position
+    , owner
+    , change_date
+    , payload
+    , created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now()
+) ON CONFLICT (instance_id, aggregate_type, aggregate_id, write_model_type) DO UPDATE SET
+    version = EXCLUDED.version
+    , sequence = EXCLUDED.sequence
+    , position = EXCLUDED.position
+    , owner = EXCLUDED.owner
+    , change_date = EXCLUDED.change_date
+    , payload = EXCLUDED.payload
+    , created_at = EXCLUDED.created_at
+WHERE
+    -- a concurrent command might already have stored a newer snapshot
+    eventstore.snapshots.sequence < EXCLUDED.sequence
+    OR eventstore.snapshots.version <> EXCLUDED.version;
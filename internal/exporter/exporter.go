@@ -0,0 +1,248 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// ExporterProjectionTable stores the events whose publishing failed until they are exported by the retry
+	ExporterProjectionTable = "projections.event_exporter"
+
+	ExporterInstanceIDCol    = "instance_id"
+	ExporterAggregateTypeCol = "aggregate_type"
+	ExporterAggregateIDCol   = "aggregate_id"
+	ExporterSequenceCol      = "sequence"
+	ExporterMessageCol       = "message"
+	ExporterErrorCol         = "error"
+	ExporterFailedAtCol      = "failed_at"
+
+	retryLockName = "event_exporter_retry"
+	// retryLockDuration is the duration of the lock of an instance, it's renewed until the retry is done
+	retryLockDuration    = time.Minute
+	defaultRetryInterval = time.Minute
+)
+
+type Config struct {
+	// Enabled starts the exporter. The position of the exporter is stored in the current_states,
+	// so enabling it for the first time exports all stored events.
+	Enabled bool
+	// AggregateTypes limits the exported events to the given aggregate types.
+	// All aggregate types are exported if empty.
+	AggregateTypes []string
+	// EventTypes limits the exported events to the given event types.
+	// A trailing * matches all event types with the given prefix, e.g. user.human.*
+	// All event types are exported if empty.
+	EventTypes []string
+	// RedactKeys are the keys of the event payload whose values are replaced before publishing.
+	// Encrypted values (crypto.CryptoValue) are always redacted.
+	RedactKeys []string
+	// RetryInterval is the interval in which the events whose publishing failed are published again
+	RetryInterval time.Duration
+	Sink          SinkConfig
+}
+
+// Exporter publishes the events and retries the events whose publishing failed
+type Exporter struct {
+	handler  *handler.Handler
+	exporter *exporter
+	client   *database.DB
+	locker   crdb.Locker
+	interval time.Duration
+}
+
+type exporter struct {
+	sink     Sink
+	redactor *redactor
+	reducers []handler.AggregateReducer
+}
+
+// NewExporter creates a handler which publishes the events to the configured sink.
+// A statement is executed inside the transaction which updates the current_states,
+// so an event is only marked as exported after the sink acknowledged it or it was stored in the ExporterProjectionTable.
+// Stored events are published again by the retry until the sink acknowledges them,
+// which guarantees at-least-once delivery without skipping events.
+// The events of an aggregate are published in the order of their sequence.
+func NewExporter(
+	ctx context.Context,
+	config Config,
+	handlerCfg handler.Config,
+	eventTypes []string,
+) (*Exporter, error) {
+	sink, err := config.Sink.NewSink()
+	if err != nil {
+		return nil, err
+	}
+	e := &exporter{
+		sink:     sink,
+		redactor: newRedactor(config.RedactKeys),
+	}
+	e.reducers = e.eventReducers(eventTypes, config.AggregateTypes, config.EventTypes)
+	exp := &Exporter{
+		handler:  handler.NewHandler(ctx, &handlerCfg, e),
+		exporter: e,
+		client:   handlerCfg.Client,
+		locker:   crdb.NewLocker(handlerCfg.Client.DB, projection.LocksTable, retryLockName),
+		interval: config.RetryInterval,
+	}
+	if exp.interval <= 0 {
+		exp.interval = defaultRetryInterval
+	}
+	return exp, nil
+}
+
+// Start creates the ExporterProjectionTable, starts the handler and retries the failed events in the configured interval
+func (e *Exporter) Start(ctx context.Context) error {
+	if err := e.handler.Init(ctx); err != nil {
+		return err
+	}
+	e.handler.Start(ctx)
+	go func() {
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := e.Retry(ctx)
+				logging.OnError(err).Warn("retry of event exporter failed")
+			}
+		}
+	}()
+	return nil
+}
+
+func (*exporter) Name() string {
+	return ExporterProjectionTable
+}
+
+func (*exporter) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(ExporterInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ExporterAggregateTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(ExporterAggregateIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ExporterSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(ExporterMessageCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(ExporterErrorCol, handler.ColumnTypeText),
+			handler.NewColumn(ExporterFailedAtCol, handler.ColumnTypeTimestamp),
+		},
+			handler.NewPrimaryKey(ExporterInstanceIDCol, ExporterAggregateTypeCol, ExporterAggregateIDCol, ExporterSequenceCol),
+		),
+	)
+}
+
+func (e *exporter) Reducers() []handler.AggregateReducer {
+	return e.reducers
+}
+
+// eventReducers registers the reduce function for all registered event types matching the filters
+func (e *exporter) eventReducers(eventTypes, aggregateTypeFilter, eventTypeFilter []string) []handler.AggregateReducer {
+	byAggregate := make(map[eventstore.AggregateType][]handler.EventReducer)
+	for _, eventType := range eventTypes {
+		aggregateType := eventstore.AggregateTypeFromEventType(eventstore.EventType(eventType))
+		if aggregateType == "" {
+			continue
+		}
+		if len(aggregateTypeFilter) > 0 && !slices.Contains(aggregateTypeFilter, string(aggregateType)) {
+			continue
+		}
+		if len(eventTypeFilter) > 0 && !matchesEventType(eventTypeFilter, eventType) {
+			continue
+		}
+		byAggregate[aggregateType] = append(byAggregate[aggregateType], handler.EventReducer{
+			Event:  eventstore.EventType(eventType),
+			Reduce: e.reduceExport,
+		})
+	}
+
+	reducers := make([]handler.AggregateReducer, 0, len(byAggregate))
+	for aggregateType, eventReducers := range byAggregate {
+		reducers = append(reducers, handler.AggregateReducer{
+			Aggregate:     aggregateType,
+			EventReducers: eventReducers,
+		})
+	}
+	slices.SortFunc(reducers, func(a, b handler.AggregateReducer) int {
+		return strings.Compare(string(a.Aggregate), string(b.Aggregate))
+	})
+	return reducers
+}
+
+func matchesEventType(filter []string, eventType string) bool {
+	for _, f := range filter {
+		if prefix, ok := strings.CutSuffix(f, "*"); ok && strings.HasPrefix(eventType, prefix) {
+			return true
+		}
+		if f == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *exporter) reduceExport(event eventstore.Event) (*handler.Statement, error) {
+	msg, err := newMessage(event, e.redactor)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXPOR-Ohc3a", "unable to create message")
+	}
+	return handler.NewStatementContext(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		return e.export(ctx, ex, projectionName, msg)
+	}), nil
+}
+
+// export publishes the message or stores it for the retry if publishing failed.
+// The message is stored without publishing if an older event of the aggregate wasn't published yet.
+func (e *exporter) export(ctx context.Context, ex handler.Executer, projectionName string, msg *Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	res, err := ex.Exec(
+		"INSERT INTO "+projectionName+" ("+failedColumns+") SELECT $1, $2, $3, $4, $5, $6, now()"+
+			" WHERE EXISTS (SELECT 1 FROM "+projectionName+" WHERE "+
+			ExporterInstanceIDCol+" = $1 AND "+ExporterAggregateTypeCol+" = $2 AND "+ExporterAggregateIDCol+" = $3)"+
+			" ON CONFLICT DO NOTHING",
+		msg.InstanceID, msg.AggregateType, msg.AggregateID, msg.Sequence, data, "previous event of aggregate not exported",
+	)
+	if err != nil {
+		return err
+	}
+	if rows, err := res.RowsAffected(); err != nil || rows > 0 {
+		return err
+	}
+	publishErr := e.sink.Publish(ctx, msg)
+	if publishErr == nil {
+		return nil
+	}
+	logging.WithFields("instance", msg.InstanceID, "aggregate", msg.AggregateID, "sequence", msg.Sequence).WithError(publishErr).Warn("publish of event failed, event is retried")
+	_, err = ex.Exec(
+		"INSERT INTO "+projectionName+" ("+failedColumns+") VALUES ($1, $2, $3, $4, $5, $6, now()) ON CONFLICT DO NOTHING",
+		msg.InstanceID, msg.AggregateType, msg.AggregateID, msg.Sequence, data, publishErr.Error(),
+	)
+	return err
+}
+
+var failedColumns = strings.Join([]string{
+	ExporterInstanceIDCol,
+	ExporterAggregateTypeCol,
+	ExporterAggregateIDCol,
+	ExporterSequenceCol,
+	ExporterMessageCol,
+	ExporterErrorCol,
+	ExporterFailedAtCol,
+}, ", ")
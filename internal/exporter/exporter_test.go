@@ -0,0 +1,190 @@
+package exporter
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+func init() {
+	for aggregateType, eventTypes := range map[eventstore.AggregateType][]eventstore.EventType{
+		"user":    {"user.human.added", "user.human.password.changed"},
+		"org":     {"org.added"},
+		"project": {"project.added"},
+	} {
+		for _, eventType := range eventTypes {
+			eventstore.RegisterFilterEventMapper(aggregateType, eventType, func(event eventstore.Event) (eventstore.Event, error) {
+				return event, nil
+			})
+		}
+	}
+}
+
+type mockSink struct {
+	published []*Message
+	err       error
+}
+
+func (s *mockSink) Publish(_ context.Context, msg *Message) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.published = append(s.published, msg)
+	return nil
+}
+
+func Test_exporter_eventReducers(t *testing.T) {
+	eventTypes := []string{"org.added", "project.added", "unknown.added", "user.human.added", "user.human.password.changed"}
+	tests := []struct {
+		name            string
+		aggregateTypes  []string
+		eventTypes      []string
+		wantAggregates  []eventstore.AggregateType
+		wantEventsCount int
+	}{
+		{
+			name:            "all events",
+			wantAggregates:  []eventstore.AggregateType{"org", "project", "user"},
+			wantEventsCount: 4,
+		},
+		{
+			name:            "aggregate filter",
+			aggregateTypes:  []string{"user"},
+			wantAggregates:  []eventstore.AggregateType{"user"},
+			wantEventsCount: 2,
+		},
+		{
+			name:            "event type filter with prefix",
+			eventTypes:      []string{"user.human.password.*", "org.added"},
+			wantAggregates:  []eventstore.AggregateType{"org", "user"},
+			wantEventsCount: 2,
+		},
+		{
+			name:            "aggregate and event type filter",
+			aggregateTypes:  []string{"project"},
+			eventTypes:      []string{"org.added"},
+			wantAggregates:  []eventstore.AggregateType{},
+			wantEventsCount: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reducers := new(exporter).eventReducers(eventTypes, tt.aggregateTypes, tt.eventTypes)
+			aggregates := make([]eventstore.AggregateType, 0, len(reducers))
+			var eventsCount int
+			for _, reducer := range reducers {
+				aggregates = append(aggregates, reducer.Aggregate)
+				eventsCount += len(reducer.EventReducers)
+			}
+			assert.Equal(t, tt.wantAggregates, aggregates)
+			assert.Equal(t, tt.wantEventsCount, eventsCount)
+		})
+	}
+}
+
+func Test_exporter_reduceExport(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	event := eventstore.BaseEventFromRepo(&repository.Event{
+		Seq:           15,
+		Pos:           42.5,
+		CreationDate:  createdAt,
+		Typ:           "user.human.password.changed",
+		Data:          []byte(`{"encodedHash":"$2a$14$hash","userAgentID":"agent"}`),
+		EditorUser:    "editor",
+		Version:       "v2",
+		AggregateID:   "user1",
+		AggregateType: "user",
+		ResourceOwner: sql.NullString{String: "org1", Valid: true},
+		InstanceID:    "instance1",
+	})
+	tests := []struct {
+		name          string
+		sink          *mockSink
+		pending       bool
+		wantPublished bool
+		wantStored    string
+	}{
+		{
+			name:          "published",
+			sink:          &mockSink{},
+			wantPublished: true,
+		},
+		{
+			name:       "publish failed, stored for retry",
+			sink:       &mockSink{err: errors.New("unavailable")},
+			wantStored: "unavailable",
+		},
+		{
+			name:    "previous event pending, stored without publish",
+			sink:    &mockSink{},
+			pending: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &exporter{sink: tt.sink, redactor: newRedactor(nil)}
+			stmt, err := e.reduceExport(event)
+			require.NoError(t, err)
+			assert.Equal(t, eventstore.AggregateType("user"), stmt.AggregateType)
+			assert.Equal(t, uint64(15), stmt.Sequence)
+
+			ex := &mockExecuter{pending: tt.pending}
+			require.NoError(t, stmt.ExecuteContext(context.Background(), ex, ExporterProjectionTable))
+			assert.Equal(t, "INSERT INTO projections.event_exporter (instance_id, aggregate_type, aggregate_id, sequence, message, error, failed_at) SELECT $1, $2, $3, $4, $5, $6, now()"+
+				" WHERE EXISTS (SELECT 1 FROM projections.event_exporter WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = $3)"+
+				" ON CONFLICT DO NOTHING", ex.stmts[0])
+			if tt.wantStored != "" {
+				require.Len(t, ex.stmts, 2)
+				assert.Equal(t, "INSERT INTO projections.event_exporter (instance_id, aggregate_type, aggregate_id, sequence, message, error, failed_at) VALUES ($1, $2, $3, $4, $5, $6, now()) ON CONFLICT DO NOTHING", ex.stmts[1])
+				assert.Equal(t, tt.wantStored, ex.args[1][5])
+			} else {
+				assert.Len(t, ex.stmts, 1)
+			}
+			if !tt.wantPublished {
+				assert.Empty(t, tt.sink.published)
+				return
+			}
+			assert.Equal(t, []*Message{{
+				InstanceID:       "instance1",
+				AggregateType:    "user",
+				AggregateID:      "user1",
+				AggregateVersion: "v2",
+				ResourceOwner:    "org1",
+				EventType:        "user.human.password.changed",
+				Sequence:         15,
+				Position:         42.5,
+				CreatedAt:        createdAt,
+				Creator:          "editor",
+				Payload:          []byte(`{"encodedHash":"[REDACTED]","userAgentID":"agent"}`),
+			}}, tt.sink.published)
+		})
+	}
+}
+
+// mockExecuter records the statements, the first insert only affects a row if an event of the aggregate is pending
+type mockExecuter struct {
+	pending bool
+	stmts   []string
+	args    [][]interface{}
+}
+
+func (ex *mockExecuter) Exec(stmt string, args ...interface{}) (sql.Result, error) {
+	ex.stmts = append(ex.stmts, stmt)
+	ex.args = append(ex.args, args)
+	if len(ex.stmts) == 1 && !ex.pending {
+		return driver.RowsAffected(0), nil
+	}
+	return driver.RowsAffected(1), nil
+}
+
+var _ handler.Projection = (*exporter)(nil)
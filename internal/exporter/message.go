@@ -0,0 +1,150 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+// Message is the representation of an event published to the sinks
+type Message struct {
+	InstanceID       string          `json:"instanceId"`
+	AggregateType    string          `json:"aggregateType"`
+	AggregateID      string          `json:"aggregateId"`
+	AggregateVersion string          `json:"aggregateVersion"`
+	ResourceOwner    string          `json:"resourceOwner"`
+	EventType        string          `json:"eventType"`
+	Revision         uint16          `json:"revision"`
+	Sequence         uint64          `json:"sequence"`
+	Position         float64         `json:"position"`
+	CreatedAt        time.Time       `json:"createdAt"`
+	Creator          string          `json:"creator"`
+	Payload          json.RawMessage `json:"payload,omitempty"`
+}
+
+func newMessage(event eventstore.Event, redactor *redactor) (*Message, error) {
+	payload, err := redactor.redact(event.DataAsBytes())
+	if err != nil {
+		return nil, err
+	}
+	return &Message{
+		InstanceID:       event.Aggregate().InstanceID,
+		AggregateType:    string(event.Aggregate().Type),
+		AggregateID:      event.Aggregate().ID,
+		AggregateVersion: string(event.Aggregate().Version),
+		ResourceOwner:    event.Aggregate().ResourceOwner,
+		EventType:        string(event.Type()),
+		Revision:         event.Revision(),
+		Sequence:         event.Sequence(),
+		Position:         event.Position(),
+		CreatedAt:        event.CreatedAt(),
+		Creator:          event.Creator(),
+		Payload:          payload,
+	}, nil
+}
+
+// Key is used for partitioning, so all events of an aggregate keep their order
+func (m *Message) Key() string {
+	return m.InstanceID + ":" + m.AggregateType + ":" + m.AggregateID
+}
+
+// Subject is the hierarchical name of the message, e.g. <prefix>.<instance>.user.human.added
+func (m *Message) Subject(prefix string) string {
+	subject := m.InstanceID + "." + m.EventType
+	if prefix == "" {
+		return subject
+	}
+	return strings.TrimSuffix(prefix, ".") + "." + subject
+}
+
+const redactedValue = "[REDACTED]"
+
+var defaultRedactKeys = []string{
+	"accessKey",
+	"apiKey",
+	"apiSecret",
+	"assertion",
+	"authHeaderValue",
+	"bindPassword",
+	"clientSecret",
+	"code",
+	"encodedHash",
+	"hashedSecret",
+	"idpAccessToken",
+	"idpIdToken",
+	"key",
+	"otpSecret",
+	"password",
+	"privateKey",
+	"refreshToken",
+	"secret",
+	"storeKey",
+	"token",
+	"validationCode",
+}
+
+type redactor struct {
+	keys map[string]struct{}
+}
+
+// newRedactor uses the default keys if none are configured
+func newRedactor(keys []string) *redactor {
+	if len(keys) == 0 {
+		keys = defaultRedactKeys
+	}
+	r := &redactor{keys: make(map[string]struct{}, len(keys))}
+	for _, key := range keys {
+		r.keys[strings.ToLower(key)] = struct{}{}
+	}
+	return r
+}
+
+func (r *redactor) redact(payload []byte) (json.RawMessage, error) {
+	if len(bytes.TrimSpace(payload)) == 0 {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	return json.Marshal(r.redactValue(data))
+}
+
+func (r *redactor) redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		if isCryptoValue(v) {
+			return redactedValue
+		}
+		for key, field := range v {
+			if _, ok := r.keys[strings.ToLower(key)]; ok && field != nil {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = r.redactValue(field)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = r.redactValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// isCryptoValue checks for the fields of a marshalled crypto.CryptoValue
+func isCryptoValue(v map[string]any) bool {
+	for key := range v {
+		if strings.EqualFold(key, "crypted") {
+			return true
+		}
+	}
+	return false
+}
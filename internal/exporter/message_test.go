@@ -0,0 +1,62 @@
+package exporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_redactor_redact(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []string
+		payload string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "empty payload",
+			payload: "",
+			want:    "",
+		},
+		{
+			name:    "invalid payload",
+			payload: "{",
+			wantErr: true,
+		},
+		{
+			name:    "default keys",
+			payload: `{"userName":"user","password":"secret","ClientSecret":{"CryptoType":0,"Algorithm":"aes","KeyID":"id","Crypted":"c2VjcmV0"}}`,
+			want:    `{"ClientSecret":"[REDACTED]","password":"[REDACTED]","userName":"user"}`,
+		},
+		{
+			name:    "nested crypto value",
+			payload: `{"config":{"clientID":"client","secret":null,"value":{"cryptoType":0,"crypted":"c2VjcmV0"}},"list":[{"token":"t"}]}`,
+			want:    `{"config":{"clientID":"client","secret":null,"value":"[REDACTED]"},"list":[{"token":"[REDACTED]"}]}`,
+		},
+		{
+			name:    "custom keys, numbers kept",
+			keys:    []string{"email"},
+			payload: `{"email":"user@example.com","password":"secret","age":12345678901234567890}`,
+			want:    `{"age":12345678901234567890,"email":"[REDACTED]","password":"secret"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newRedactor(tt.keys).redact([]byte(tt.payload))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestMessage_Subject(t *testing.T) {
+	msg := &Message{InstanceID: "instance", EventType: "user.human.added"}
+	assert.Equal(t, "instance.user.human.added", msg.Subject(""))
+	assert.Equal(t, "zitadel.events.instance.user.human.added", msg.Subject("zitadel.events."))
+}
@@ -0,0 +1,122 @@
+package exporter
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Retry publishes the events whose publishing failed once.
+// The events of an instance are skipped if another ZITADEL process is retrying them.
+// The events of an aggregate are published in order, the remaining events of an aggregate are kept if one fails.
+func (e *Exporter) Retry(ctx context.Context) error {
+	// published events are removed on the primary, a replica could return them again
+	ctx = database.WithConsistency(ctx)
+	database.RequirePrimary(ctx)
+
+	var instanceIDs []string
+	err := e.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var instanceID string
+			if err := rows.Scan(&instanceID); err != nil {
+				return err
+			}
+			instanceIDs = append(instanceIDs, instanceID)
+		}
+		return rows.Err()
+	}, "SELECT DISTINCT "+ExporterInstanceIDCol+" FROM "+ExporterProjectionTable)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "EXPOR-Aeng4", "unable to query failed events")
+	}
+	for _, instanceID := range instanceIDs {
+		err := e.retryInstance(ctx, instanceID)
+		logging.WithFields("instance", instanceID).OnError(err).Warn("unable to retry failed events of instance")
+	}
+	return nil
+}
+
+// retryInstance publishes the failed events of an instance while holding the lock of the instance
+func (e *Exporter) retryInstance(ctx context.Context, instanceID string) (err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := e.locker.Lock(ctx, retryLockDuration, instanceID)
+	err, ok := <-errs
+	if err != nil || !ok {
+		if zerrors.IsErrorAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	defer func() {
+		cancel()
+		err := e.locker.Unlock(instanceID)
+		logging.WithFields("instance", instanceID).OnError(err).Debug("unable to unlock event exporter retry")
+	}()
+	// the lock is renewed until the context is canceled, the retry stops if it's lost
+	go func() {
+		for err := range errs {
+			if err != nil {
+				logging.WithFields("instance", instanceID).WithError(err).Warn("event exporter retry lost lock")
+				cancel()
+			}
+		}
+	}()
+
+	err = e.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		var failedAggregate string
+		for rows.Next() {
+			var (
+				aggregateType, aggregateID string
+				sequence                   uint64
+				data                       []byte
+			)
+			if err := rows.Scan(&aggregateType, &aggregateID, &sequence, &data); err != nil {
+				return err
+			}
+			// newer events of the aggregate are kept until the failed one is published
+			if aggregateType+":"+aggregateID == failedAggregate {
+				continue
+			}
+			if err := e.retryEvent(ctx, instanceID, aggregateType, aggregateID, sequence, data); err != nil {
+				logging.WithFields("instance", instanceID, "aggregate", aggregateID, "sequence", sequence).WithError(err).Info("retry of event failed")
+				failedAggregate = aggregateType + ":" + aggregateID
+			}
+		}
+		return rows.Err()
+	},
+		"SELECT "+ExporterAggregateTypeCol+", "+ExporterAggregateIDCol+", "+ExporterSequenceCol+", "+ExporterMessageCol+
+			" FROM "+ExporterProjectionTable+
+			" WHERE "+ExporterInstanceIDCol+" = $1"+
+			" ORDER BY "+ExporterAggregateTypeCol+", "+ExporterAggregateIDCol+", "+ExporterSequenceCol,
+		instanceID,
+	)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "EXPOR-ieY4o", "unable to retry failed events")
+	}
+	return nil
+}
+
+// retryEvent publishes the event and removes it, the error is updated if publishing failed
+func (e *Exporter) retryEvent(ctx context.Context, instanceID, aggregateType, aggregateID string, sequence uint64, data []byte) error {
+	msg := new(Message)
+	if err := json.Unmarshal(data, msg); err != nil {
+		return err
+	}
+	pk := " WHERE " + ExporterInstanceIDCol + " = $1 AND " + ExporterAggregateTypeCol + " = $2 AND " + ExporterAggregateIDCol + " = $3 AND " + ExporterSequenceCol + " = $4"
+	if publishErr := e.exporter.sink.Publish(ctx, msg); publishErr != nil {
+		_, err := e.client.ExecContext(ctx,
+			"UPDATE "+ExporterProjectionTable+" SET ("+ExporterErrorCol+", "+ExporterFailedAtCol+") = ($5, now())"+pk,
+			instanceID, aggregateType, aggregateID, sequence, publishErr.Error(),
+		)
+		logging.OnError(err).Debug("unable to update error of failed event")
+		return publishErr
+	}
+	_, err := e.client.ExecContext(ctx, "DELETE FROM "+ExporterProjectionTable+pk, instanceID, aggregateType, aggregateID, sequence)
+	return err
+}
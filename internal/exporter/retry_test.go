@@ -0,0 +1,138 @@
+package exporter
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// mockLocker fails to lock the locked instances
+type mockLocker struct {
+	locked   []string
+	unlocked []string
+}
+
+func (m *mockLocker) Lock(ctx context.Context, _ time.Duration, instanceIDs ...string) <-chan error {
+	errs := make(chan error, 1)
+	if slices.Contains(m.locked, instanceIDs[0]) {
+		errs <- zerrors.ThrowAlreadyExists(nil, "TEST-Ieh4e", "projection already locked")
+	} else {
+		errs <- nil
+	}
+	go func() {
+		<-ctx.Done()
+		close(errs)
+	}()
+	return errs
+}
+
+func (m *mockLocker) Unlock(instanceIDs ...string) error {
+	m.unlocked = append(m.unlocked, instanceIDs...)
+	return nil
+}
+
+// failingSink fails to publish the messages of the given aggregates
+type failingSink struct {
+	mockSink
+	failing []string
+}
+
+func (s *failingSink) Publish(ctx context.Context, msg *Message) error {
+	if slices.Contains(s.failing, msg.AggregateID) {
+		return errors.New("unavailable")
+	}
+	return s.mockSink.Publish(ctx, msg)
+}
+
+func TestExporter_Retry(t *testing.T) {
+	const (
+		instancesQuery = "SELECT DISTINCT instance_id FROM projections.event_exporter"
+		eventsQuery    = "SELECT aggregate_type, aggregate_id, sequence, message FROM projections.event_exporter WHERE instance_id = $1 ORDER BY aggregate_type, aggregate_id, sequence"
+		deleteStmt     = "DELETE FROM projections.event_exporter WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = $3 AND sequence = $4"
+		updateStmt     = "UPDATE projections.event_exporter SET (error, failed_at) = ($5, now()) WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = $3 AND sequence = $4"
+	)
+	eventsColumns := []string{"aggregate_type", "aggregate_id", "sequence", "message"}
+	message := func(aggregateID string, sequence uint64) []byte {
+		return []byte(`{"instanceId":"instance1","aggregateType":"user","aggregateId":"` + aggregateID + `","sequence":` + strconv.FormatUint(sequence, 10) + `}`)
+	}
+	tests := []struct {
+		name          string
+		locked        []string
+		failing       []string
+		client        func(t *testing.T) *mock.SQLMock
+		wantPublished []uint64
+		wantUnlocked  []string
+	}{
+		{
+			name:   "instance locked by another process",
+			locked: []string{"instance1"},
+			client: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectBegin(nil),
+					mock.ExpectQuery(instancesQuery, mock.WithQueryResult([]string{"instance_id"}, [][]driver.Value{{"instance1"}})),
+					mock.ExpectCommit(nil),
+				)
+			},
+		},
+		{
+			name:    "events published in order, newer events of failing aggregate kept",
+			failing: []string{"user2"},
+			client: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectBegin(nil),
+					mock.ExpectQuery(instancesQuery, mock.WithQueryResult([]string{"instance_id"}, [][]driver.Value{{"instance1"}})),
+					mock.ExpectCommit(nil),
+					mock.ExpectBegin(nil),
+					mock.ExpectQuery(eventsQuery,
+						mock.WithQueryArgs("instance1"),
+						mock.WithQueryResult(eventsColumns, [][]driver.Value{
+							{"user", "user1", uint64(1), message("user1", 1)},
+							{"user", "user1", uint64(2), message("user1", 2)},
+							{"user", "user2", uint64(1), message("user2", 1)},
+							{"user", "user2", uint64(2), message("user2", 2)},
+						}),
+					),
+					mock.ExcpectExec(deleteStmt, mock.WithExecArgs("instance1", "user", "user1", uint64(1)), mock.WithExecRowsAffected(1)),
+					mock.ExcpectExec(deleteStmt, mock.WithExecArgs("instance1", "user", "user1", uint64(2)), mock.WithExecRowsAffected(1)),
+					mock.ExcpectExec(updateStmt, mock.WithExecArgs("instance1", "user", "user2", uint64(1), "unavailable"), mock.WithExecRowsAffected(1)),
+					mock.ExpectCommit(nil),
+				)
+			},
+			wantPublished: []uint64{1, 2},
+			wantUnlocked:  []string{"instance1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := tt.client(t)
+			sink := &failingSink{failing: tt.failing}
+			locker := &mockLocker{locked: tt.locked}
+			e := &Exporter{
+				exporter: &exporter{sink: sink},
+				client:   &database.DB{DB: client.DB},
+				locker:   locker,
+			}
+
+			require.NoError(t, e.Retry(context.Background()))
+			var published []uint64
+			for _, msg := range sink.published {
+				assert.Equal(t, "user1", msg.AggregateID)
+				published = append(published, msg.Sequence)
+			}
+			assert.Equal(t, tt.wantPublished, published)
+			assert.Equal(t, tt.wantUnlocked, locker.unlocked)
+			client.Assert(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package exporter
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Sink publishes the exported events.
+// Publish must only return nil if the message was persisted by the receiver.
+type Sink interface {
+	Publish(ctx context.Context, msg *Message) error
+}
+
+type SinkType string
+
+const (
+	SinkTypeFile  SinkType = "file"
+	SinkTypeHTTP  SinkType = "http"
+	SinkTypeKafka SinkType = "kafka"
+	SinkTypeNATS  SinkType = "nats"
+)
+
+type SinkConfig struct {
+	Type  SinkType
+	File  FileConfig
+	HTTP  HTTPConfig
+	Kafka KafkaConfig
+	NATS  NATSConfig
+}
+
+func (c *SinkConfig) NewSink() (Sink, error) {
+	switch c.Type {
+	case SinkTypeFile:
+		return NewFileSink(c.File)
+	case SinkTypeHTTP:
+		return NewHTTPSink(c.HTTP)
+	case SinkTypeKafka:
+		return NewKafkaSink(c.Kafka)
+	case SinkTypeNATS:
+		return NewNATSSink(c.NATS)
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "EXPOR-ieG4a", "unknown sink type %q", c.Type)
+	}
+}
+
+func httpClient(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout}
+}
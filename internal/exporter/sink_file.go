@@ -0,0 +1,49 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type FileConfig struct {
+	// Path of the file the messages are appended to as JSON lines
+	Path string
+}
+
+// FileSink appends the messages to a local file, it is meant for local testing
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFileSink(config FileConfig) (*FileSink, error) {
+	if config.Path == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "EXPOR-Ahb3e", "path of file sink missing")
+	}
+	file, err := os.OpenFile(config.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXPOR-Voh8i", "unable to open file")
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Publish(_ context.Context, msg *Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err = s.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
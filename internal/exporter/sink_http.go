@@ -0,0 +1,74 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type HTTPConfig struct {
+	// Endpoint receives every message as JSON body of a POST request
+	Endpoint string
+	// Headers are sent with every request, e.g. for authentication
+	Headers http.Header
+	Timeout time.Duration
+}
+
+// HTTPSink posts the messages to an endpoint and expects a 2xx response
+type HTTPSink struct {
+	endpoint string
+	headers  http.Header
+	client   *http.Client
+}
+
+func NewHTTPSink(config HTTPConfig) (*HTTPSink, error) {
+	if config.Endpoint == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "EXPOR-ooR5e", "endpoint of http sink missing")
+	}
+	return &HTTPSink{
+		endpoint: config.Endpoint,
+		headers:  config.Headers,
+		client:   httpClient(config.Timeout),
+	}, nil
+}
+
+func (s *HTTPSink) Publish(ctx context.Context, msg *Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, s.client, s.endpoint, "application/json", s.headers, data, nil)
+}
+
+// postJSON sends the body and decodes the response into v if v is not nil
+func postJSON(ctx context.Context, client *http.Client, endpoint, contentType string, headers http.Header, body []byte, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for key, values := range headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("publish to %s failed with status %d: %s", endpoint, resp.StatusCode, respBody)
+	}
+	if v == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,81 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type KafkaConfig struct {
+	// Endpoint of the Kafka REST proxy (v2 API), e.g. http://localhost:8082
+	// The brokers can't be used directly, the Kafka protocol is not implemented.
+	Endpoint string
+	Topic    string
+	// Headers are sent with every request, e.g. for authentication
+	Headers http.Header
+	Timeout time.Duration
+}
+
+// KafkaSink produces the messages using a Kafka REST proxy, e.g. the Confluent REST proxy.
+// It does not connect to the brokers directly, so a REST proxy is required to use Kafka.
+// The message key is the aggregate, so all events of an aggregate are written to the same partition.
+type KafkaSink struct {
+	url     string
+	headers http.Header
+	client  *http.Client
+}
+
+func NewKafkaSink(config KafkaConfig) (*KafkaSink, error) {
+	if config.Endpoint == "" || config.Topic == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "EXPOR-Ra7ae", "endpoint or topic of kafka sink missing")
+	}
+	return &KafkaSink{
+		url:     strings.TrimSuffix(config.Endpoint, "/") + "/topics/" + url.PathEscape(config.Topic),
+		headers: config.Headers,
+		client:  httpClient(config.Timeout),
+	}, nil
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaRecord struct {
+	Key   string   `json:"key"`
+	Value *Message `json:"value"`
+}
+
+type kafkaOffsets struct {
+	Offsets []struct {
+		Partition int    `json:"partition"`
+		Offset    int64  `json:"offset"`
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+func (s *KafkaSink) Publish(ctx context.Context, msg *Message) error {
+	data, err := json.Marshal(&kafkaRecords{Records: []kafkaRecord{{Key: msg.Key(), Value: msg}}})
+	if err != nil {
+		return err
+	}
+	offsets := new(kafkaOffsets)
+	if err = postJSON(ctx, s.client, s.url, "application/vnd.kafka.json.v2+json", s.headers, data, offsets); err != nil {
+		return err
+	}
+	if len(offsets.Offsets) == 0 {
+		return fmt.Errorf("kafka rest proxy returned no offsets")
+	}
+	for _, offset := range offsets.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("kafka rest proxy returned error %d: %s", *offset.ErrorCode, offset.Error)
+		}
+	}
+	return nil
+}
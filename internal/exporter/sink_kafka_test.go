@@ -0,0 +1,64 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaSink_Publish(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		wantErr  bool
+	}{
+		{
+			name:     "produced",
+			response: `{"offsets":[{"partition":1,"offset":42,"error_code":null,"error":null}]}`,
+		},
+		{
+			name:     "record error",
+			response: `{"offsets":[{"partition":null,"offset":null,"error_code":50002,"error":"unable to produce"}]}`,
+			wantErr:  true,
+		},
+		{
+			name:     "no offsets",
+			response: `{"offsets":[]}`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got struct {
+				Records []struct {
+					Key   string  `json:"key"`
+					Value Message `json:"value"`
+				} `json:"records"`
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/topics/zitadel-events", r.URL.Path)
+				assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			sink, err := NewKafkaSink(KafkaConfig{Endpoint: server.URL + "/", Topic: "zitadel-events"})
+			require.NoError(t, err)
+			err = sink.Publish(context.Background(), &Message{InstanceID: "instance", AggregateType: "user", AggregateID: "user1"})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, got.Records, 1)
+			assert.Equal(t, "instance:user:user1", got.Records[0].Key)
+			assert.Equal(t, "user1", got.Records[0].Value.AggregateID)
+		})
+	}
+}
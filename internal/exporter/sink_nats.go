@@ -0,0 +1,121 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const defaultNATSTimeout = 10 * time.Second
+
+type NATSConfig struct {
+	// Address of the NATS server, e.g. localhost:4222
+	Address string
+	// SubjectPrefix is prepended to the subjects <instance id>.<event type>
+	SubjectPrefix string
+	Username      string
+	Password      string
+	Token         string
+	// TLS is used if enabled or required by the server
+	TLS bool
+	// JetStream waits for the acknowledgement of the stream the subject belongs to.
+	// Without JetStream a message is only acknowledged by the server, but not persisted.
+	JetStream bool
+	Timeout   time.Duration
+}
+
+// NATSSink publishes the messages using the NATS client.
+// The connection is established on the first publish, reconnects are handled by the client.
+type NATSSink struct {
+	config NATSConfig
+
+	mu   sync.Mutex
+	conn *nats.Conn
+	js   jetstream.JetStream
+}
+
+func NewNATSSink(config NATSConfig) (*NATSSink, error) {
+	if config.Address == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "EXPOR-Eiph7", "address of nats sink missing")
+	}
+	if config.Timeout == 0 {
+		config.Timeout = defaultNATSTimeout
+	}
+	return &NATSSink{config: config}, nil
+}
+
+func (s *NATSSink) Publish(ctx context.Context, msg *Message) error {
+	natsMsg, err := s.natsMessage(msg)
+	if err != nil {
+		return err
+	}
+	if err = s.connect(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+	if s.config.JetStream {
+		_, err = s.js.PublishMsg(ctx, natsMsg)
+		return err
+	}
+	if err = s.conn.PublishMsg(natsMsg); err != nil {
+		return err
+	}
+	// the flush returns after the server processed the message
+	return s.conn.FlushWithContext(ctx)
+}
+
+func (s *NATSSink) natsMessage(msg *Message) (*nats.Msg, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	natsMsg := nats.NewMsg(msg.Subject(s.config.SubjectPrefix))
+	natsMsg.Data = data
+	if s.config.JetStream {
+		// the message id allows JetStream to deduplicate messages which are published again after a failure
+		natsMsg.Header.Set(jetstream.MsgIDHeader, msg.Key()+":"+strconv.FormatUint(msg.Sequence, 10))
+	}
+	return natsMsg, nil
+}
+
+func (s *NATSSink) connect() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn != nil && !s.conn.IsClosed() {
+		return nil
+	}
+	opts := []nats.Option{
+		nats.Name("zitadel-event-exporter"),
+		nats.Timeout(s.config.Timeout),
+		// unlimited reconnects, a closed connection is reestablished on the next publish
+		nats.MaxReconnects(-1),
+	}
+	if s.config.Username != "" {
+		opts = append(opts, nats.UserInfo(s.config.Username, s.config.Password))
+	}
+	if s.config.Token != "" {
+		opts = append(opts, nats.Token(s.config.Token))
+	}
+	if s.config.TLS {
+		opts = append(opts, nats.Secure())
+	}
+	conn, err := nats.Connect(s.config.Address, opts...)
+	if err != nil {
+		return err
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	s.conn, s.js = conn, js
+	return nil
+}
@@ -0,0 +1,66 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNATSSink_natsMessage(t *testing.T) {
+	tests := []struct {
+		name        string
+		jetStream   bool
+		wantSubject string
+		wantMsgID   string
+	}{
+		{
+			name:        "core publish",
+			wantSubject: "zitadel.instance.user.human.added",
+		},
+		{
+			name:        "jetstream publish",
+			jetStream:   true,
+			wantSubject: "zitadel.instance.user.human.added",
+			wantMsgID:   "instance:user:user1:1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink, err := NewNATSSink(NATSConfig{
+				Address:       "localhost:4222",
+				SubjectPrefix: "zitadel",
+				JetStream:     tt.jetStream,
+			})
+			require.NoError(t, err)
+
+			msg := &Message{InstanceID: "instance", AggregateType: "user", AggregateID: "user1", EventType: "user.human.added", Sequence: 1}
+			natsMsg, err := sink.natsMessage(msg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSubject, natsMsg.Subject)
+			assert.Equal(t, tt.wantMsgID, natsMsg.Header.Get("Nats-Msg-Id"))
+			published := new(Message)
+			require.NoError(t, json.Unmarshal(natsMsg.Data, published))
+			assert.Equal(t, msg, published)
+		})
+	}
+}
+
+func TestNATSSink_Publish_unavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	sink, err := NewNATSSink(NATSConfig{
+		Address: address,
+		Timeout: time.Second,
+	})
+	require.NoError(t, err)
+	msg := &Message{InstanceID: "instance", AggregateType: "user", AggregateID: "user1", EventType: "user.human.added", Sequence: 1}
+	assert.Error(t, sink.Publish(context.Background(), msg))
+}
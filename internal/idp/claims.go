@@ -0,0 +1,40 @@
+package idp
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ClaimsUser is implemented by [User]s which provide the raw claims (or attributes)
+// returned by the identity provider, e.g. to apply the claim mappings of the provider.
+type ClaimsUser interface {
+	// GetClaim returns all values of the claim, nil if the claim is missing
+	GetClaim(name string) []string
+}
+
+// ClaimValues converts the (json) value of a claim into a list of strings:
+// lists are flattened, objects are returned as their json representation
+func ClaimValues(value any) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, ClaimValues(item)...)
+		}
+		return values
+	case map[string]any:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		return []string{string(data)}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
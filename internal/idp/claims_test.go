@@ -0,0 +1,46 @@
+package idp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  []string
+	}{
+		{
+			name:  "missing",
+			value: nil,
+			want:  nil,
+		},
+		{
+			name:  "string",
+			value: "sales",
+			want:  []string{"sales"},
+		},
+		{
+			name:  "list",
+			value: []any{"admins", "users", float64(1)},
+			want:  []string{"admins", "users", "1"},
+		},
+		{
+			name:  "bool",
+			value: true,
+			want:  []string{"true"},
+		},
+		{
+			name:  "object",
+			value: map[string]any{"name": "sales"},
+			want:  []string{`{"name":"sales"}`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClaimValues(tt.value))
+		})
+	}
+}
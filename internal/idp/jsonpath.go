@@ -0,0 +1,173 @@
+package idp
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	errJSONPathRoot    = errors.New("must start with $")
+	errJSONPathMember  = errors.New("member name is missing")
+	errJSONPathBracket = errors.New("bracket is not closed")
+	errJSONPathIndex   = errors.New("index must be a number, a quoted name or *")
+	errJSONPathSyntax  = errors.New("expected . or [")
+)
+
+// JSONPath is a parsed JSONPath expression used to read (nested) claims.
+// It supports the root `$`, members in dot (`$.profile.email`) or bracket notation (`$['custom:email']`),
+// array indexes (`$.emails[0]`, negative indexes count from the end) and wildcards (`$.groups[*]`).
+type JSONPath struct {
+	expression string
+	segments   []jsonPathSegment
+}
+
+type jsonPathSegment struct {
+	name     string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// ParseJSONPath parses the expression into a [JSONPath]
+func ParseJSONPath(expression string) (*JSONPath, error) {
+	path := strings.TrimSpace(expression)
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid jsonpath %q: %w", expression, errJSONPathRoot)
+	}
+	jsonPath := &JSONPath{expression: expression}
+	rest := path[1:]
+	for rest != "" {
+		var (
+			segment jsonPathSegment
+			err     error
+		)
+		switch rest[0] {
+		case '.':
+			segment, rest, err = parseJSONPathMember(rest[1:])
+		case '[':
+			segment, rest, err = parseJSONPathBracket(rest[1:])
+		default:
+			err = errJSONPathSyntax
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid jsonpath %q: %w", expression, err)
+		}
+		jsonPath.segments = append(jsonPath.segments, segment)
+	}
+	return jsonPath, nil
+}
+
+func parseJSONPathMember(path string) (jsonPathSegment, string, error) {
+	end := strings.IndexAny(path, ".[")
+	if end == -1 {
+		end = len(path)
+	}
+	name := path[:end]
+	if name == "" {
+		return jsonPathSegment{}, "", errJSONPathMember
+	}
+	if name == "*" {
+		return jsonPathSegment{wildcard: true}, path[end:], nil
+	}
+	return jsonPathSegment{name: name}, path[end:], nil
+}
+
+func parseJSONPathBracket(path string) (jsonPathSegment, string, error) {
+	if strings.HasPrefix(path, "'") || strings.HasPrefix(path, `"`) {
+		end := strings.IndexByte(path[1:], path[0])
+		if end == -1 {
+			return jsonPathSegment{}, "", errJSONPathBracket
+		}
+		name, rest := path[1:end+1], path[end+2:]
+		if !strings.HasPrefix(rest, "]") {
+			return jsonPathSegment{}, "", errJSONPathBracket
+		}
+		if name == "" {
+			return jsonPathSegment{}, "", errJSONPathMember
+		}
+		return jsonPathSegment{name: name}, rest[1:], nil
+	}
+	end := strings.IndexByte(path, ']')
+	if end == -1 {
+		return jsonPathSegment{}, "", errJSONPathBracket
+	}
+	content, rest := strings.TrimSpace(path[:end]), path[end+1:]
+	if content == "*" {
+		return jsonPathSegment{wildcard: true}, rest, nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return jsonPathSegment{}, "", errJSONPathIndex
+	}
+	return jsonPathSegment{index: index, isIndex: true}, rest, nil
+}
+
+// String returns the original expression
+func (p *JSONPath) String() string {
+	return p.expression
+}
+
+// Evaluate returns all values matching the path in the (json decoded) data,
+// missing members or indexes are skipped
+func (p *JSONPath) Evaluate(data any) []any {
+	values := []any{data}
+	for _, segment := range p.segments {
+		next := make([]any, 0, len(values))
+		for _, value := range values {
+			next = segment.evaluate(value, next)
+		}
+		values = next
+	}
+	return values
+}
+
+func (s jsonPathSegment) evaluate(value any, results []any) []any {
+	switch v := value.(type) {
+	case map[string]any:
+		if s.wildcard {
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				results = appendJSONPathValue(results, v[key])
+			}
+			return results
+		}
+		if s.isIndex {
+			return results
+		}
+		return appendJSONPathValue(results, v[s.name])
+	case []any:
+		if s.wildcard {
+			for _, item := range v {
+				results = appendJSONPathValue(results, item)
+			}
+			return results
+		}
+		if !s.isIndex {
+			return results
+		}
+		index := s.index
+		if index < 0 {
+			index += len(v)
+		}
+		if index < 0 || index >= len(v) {
+			return results
+		}
+		return appendJSONPathValue(results, v[index])
+	default:
+		return results
+	}
+}
+
+func appendJSONPathValue(results []any, value any) []any {
+	if value == nil {
+		return results
+	}
+	return append(results, value)
+}
@@ -0,0 +1,142 @@
+package idp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSONPath(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    error
+	}{
+		{
+			name:       "missing root",
+			expression: "profile.email",
+			wantErr:    errJSONPathRoot,
+		},
+		{
+			name:       "empty member",
+			expression: "$.profile..email",
+			wantErr:    errJSONPathMember,
+		},
+		{
+			name:       "unclosed bracket",
+			expression: "$.emails[0",
+			wantErr:    errJSONPathBracket,
+		},
+		{
+			name:       "unclosed quote",
+			expression: "$['custom:email]",
+			wantErr:    errJSONPathBracket,
+		},
+		{
+			name:       "invalid index",
+			expression: "$.emails[first]",
+			wantErr:    errJSONPathIndex,
+		},
+		{
+			name:       "invalid syntax",
+			expression: "$email",
+			wantErr:    errJSONPathSyntax,
+		},
+		{
+			name:       "root",
+			expression: "$",
+		},
+		{
+			name:       "nested",
+			expression: "$.profile['custom:email'].values[-1][*]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseJSONPath(tt.expression)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.expression, got.String())
+			}
+		})
+	}
+}
+
+func TestJSONPath_Evaluate(t *testing.T) {
+	data := map[string]any{
+		"sub": "id1",
+		"profile": map[string]any{
+			"name":         map[string]any{"given": "first", "family": "last"},
+			"custom:email": "email@test.ch",
+		},
+		"emails": []any{
+			map[string]any{"value": "primary@test.ch"},
+			map[string]any{"value": "secondary@test.ch"},
+		},
+		"groups": []any{"admins", "users"},
+	}
+	tests := []struct {
+		name       string
+		expression string
+		want       []any
+	}{
+		{
+			name:       "member",
+			expression: "$.sub",
+			want:       []any{"id1"},
+		},
+		{
+			name:       "nested member",
+			expression: "$.profile.name.given",
+			want:       []any{"first"},
+		},
+		{
+			name:       "bracket member",
+			expression: "$.profile['custom:email']",
+			want:       []any{"email@test.ch"},
+		},
+		{
+			name:       "index",
+			expression: "$.emails[0].value",
+			want:       []any{"primary@test.ch"},
+		},
+		{
+			name:       "negative index",
+			expression: "$.emails[-1].value",
+			want:       []any{"secondary@test.ch"},
+		},
+		{
+			name:       "wildcard",
+			expression: "$.emails[*].value",
+			want:       []any{"primary@test.ch", "secondary@test.ch"},
+		},
+		{
+			name:       "object wildcard",
+			expression: "$.profile.name.*",
+			want:       []any{"last", "first"},
+		},
+		{
+			name:       "missing",
+			expression: "$.profile.phone",
+			want:       []any{},
+		},
+		{
+			name:       "index out of range",
+			expression: "$.groups[2]",
+			want:       []any{},
+		},
+		{
+			name:       "member of list",
+			expression: "$.groups.name",
+			want:       []any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := ParseJSONPath(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, path.Evaluate(data))
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Package ldapsync periodically synchronizes the users of all LDAP identity providers with an enabled synchronization.
+package ldapsync
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SyncUserID is the creator of the events pushed by the synchronization
+const SyncUserID = command.LDAPSyncUserID
+
+const (
+	lockName = "ldap_sync"
+	// lockDuration is the duration of the lock of an instance, it's renewed until the synchronization is done
+	lockDuration = time.Minute
+)
+
+type Config struct {
+	Enabled bool
+	// Interval between two synchronizations of all providers
+	Interval time.Duration
+}
+
+type Queries interface {
+	LDAPSyncIDPs(ctx context.Context) ([]*query.LDAPSyncIDP, error)
+	InstanceByID(ctx context.Context) (authz.Instance, error)
+}
+
+type Commands interface {
+	SyncLDAPProvider(ctx context.Context, idpID string, dryRun bool) (*command.LDAPSyncReport, error)
+}
+
+type Sync struct {
+	queries  Queries
+	commands Commands
+	locker   crdb.Locker
+	interval time.Duration
+}
+
+func New(queries Queries, commands Commands, client *database.DB, config *Config) *Sync {
+	sync := &Sync{
+		queries:  queries,
+		commands: commands,
+		locker:   crdb.NewLocker(client.DB, projection.LocksTable, lockName),
+		interval: config.Interval,
+	}
+	if sync.interval <= 0 {
+		sync.interval = time.Hour
+	}
+	return sync
+}
+
+// Start executes the synchronization in the configured interval until the context is done
+func (s *Sync) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := s.Run(ctx)
+				logging.OnError(err).Warn("ldap synchronization failed")
+			}
+		}
+	}()
+}
+
+// Run synchronizes all LDAP identity providers with an enabled synchronization once.
+// The providers of an instance are skipped if another ZITADEL process is synchronizing them.
+// A failing provider doesn't prevent the synchronization of the others.
+func (s *Sync) Run(ctx context.Context) error {
+	idps, err := s.queries.LDAPSyncIDPs(ctx)
+	if err != nil {
+		return err
+	}
+	// the providers are ordered by instance
+	for start := 0; start < len(idps); {
+		end := start + 1
+		for end < len(idps) && idps[end].InstanceID == idps[start].InstanceID {
+			end++
+		}
+		err := s.syncInstance(ctx, idps[start].InstanceID, idps[start:end])
+		logging.WithFields("instance", idps[start].InstanceID).OnError(err).Warn("unable to synchronize ldap providers of instance")
+		start = end
+	}
+	return nil
+}
+
+// syncInstance synchronizes the providers of an instance while holding the lock of the instance
+func (s *Sync) syncInstance(ctx context.Context, instanceID string, idps []*query.LDAPSyncIDP) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := s.locker.Lock(ctx, lockDuration, instanceID)
+	err, ok := <-errs
+	if err != nil || !ok {
+		if zerrors.IsErrorAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	defer func() {
+		cancel()
+		err := s.locker.Unlock(instanceID)
+		logging.WithFields("instance", instanceID).OnError(err).Debug("unable to unlock ldap synchronization")
+	}()
+	// the lock is renewed until the context is canceled, the synchronization stops if it's lost
+	go func() {
+		for err := range errs {
+			if err != nil {
+				logging.WithFields("instance", instanceID).WithError(err).Warn("ldap synchronization lost lock")
+				cancel()
+			}
+		}
+	}()
+
+	for _, idp := range idps {
+		report, err := s.sync(ctx, idp)
+		if err != nil {
+			logging.WithFields("instance", idp.InstanceID, "idp", idp.IDPID).WithError(err).Warn("unable to synchronize ldap provider")
+			continue
+		}
+		logReport(idp, report)
+	}
+	return ctx.Err()
+}
+
+func (s *Sync) sync(ctx context.Context, idp *query.LDAPSyncIDP) (*command.LDAPSyncReport, error) {
+	ctx = authz.WithInstanceID(ctx, idp.InstanceID)
+	instance, err := s.queries.InstanceByID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = authz.WithInstance(ctx, instance)
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: SyncUserID, OrgID: idp.ResourceOwner})
+	return s.commands.SyncLDAPProvider(ctx, idp.IDPID, false)
+}
+
+func logReport(idp *query.LDAPSyncIDP, report *command.LDAPSyncReport) {
+	for _, failure := range report.Failed {
+		logging.WithFields("instance", idp.InstanceID, "idp", idp.IDPID, "externalUserID", failure.ExternalUserID).
+			WithError(failure.Err).
+			Warn("unable to synchronize ldap user")
+	}
+	logging.WithFields(
+		"instance", idp.InstanceID,
+		"idp", idp.IDPID,
+		"dryRun", report.DryRun,
+		"created", report.Created,
+		"updated", report.Updated,
+		"deactivated", report.Deactivated,
+		"reactivated", report.Reactivated,
+		"skipped", report.Skipped,
+		"grants", report.Grants,
+	).Info("ldap provider synchronized")
+}
@@ -0,0 +1,151 @@
+package ldapsync
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type mockQueries struct {
+	idps    []*query.LDAPSyncIDP
+	idpsErr error
+}
+
+func (m *mockQueries) LDAPSyncIDPs(context.Context) ([]*query.LDAPSyncIDP, error) {
+	return m.idps, m.idpsErr
+}
+
+func (m *mockQueries) InstanceByID(ctx context.Context) (authz.Instance, error) {
+	return authz.GetInstance(ctx), nil
+}
+
+type syncCall struct {
+	instanceID string
+	userID     string
+	orgID      string
+	idpID      string
+}
+
+type mockCommands struct {
+	calls []syncCall
+	err   map[string]error
+}
+
+func (m *mockCommands) SyncLDAPProvider(ctx context.Context, idpID string, dryRun bool) (*command.LDAPSyncReport, error) {
+	m.calls = append(m.calls, syncCall{
+		instanceID: authz.GetInstance(ctx).InstanceID(),
+		userID:     authz.GetCtxData(ctx).UserID,
+		orgID:      authz.GetCtxData(ctx).OrgID,
+		idpID:      idpID,
+	})
+	if err := m.err[idpID]; err != nil {
+		return nil, err
+	}
+	return &command.LDAPSyncReport{IDPID: idpID, DryRun: dryRun}, nil
+}
+
+// mockLocker fails to lock the locked instances
+type mockLocker struct {
+	locked   []string
+	unlocked []string
+}
+
+func (m *mockLocker) Lock(ctx context.Context, _ time.Duration, instanceIDs ...string) <-chan error {
+	errs := make(chan error, 1)
+	if slices.Contains(m.locked, instanceIDs[0]) {
+		errs <- zerrors.ThrowAlreadyExists(nil, "TEST-ohV3a", "projection already locked")
+	} else {
+		errs <- nil
+	}
+	go func() {
+		<-ctx.Done()
+		close(errs)
+	}()
+	return errs
+}
+
+func (m *mockLocker) Unlock(instanceIDs ...string) error {
+	m.unlocked = append(m.unlocked, instanceIDs...)
+	return nil
+}
+
+func TestSync_Run(t *testing.T) {
+	tests := []struct {
+		name         string
+		queries      *mockQueries
+		commands     *mockCommands
+		locked       []string
+		wantCalls    []syncCall
+		wantUnlocked []string
+		wantErr      bool
+	}{
+		{
+			name:     "query error",
+			queries:  &mockQueries{idpsErr: errors.New("query failed")},
+			commands: &mockCommands{},
+			wantErr:  true,
+		},
+		{
+			name: "failing provider doesn't stop the others",
+			queries: &mockQueries{
+				idps: []*query.LDAPSyncIDP{
+					{InstanceID: "instance1", IDPID: "idp1", ResourceOwner: "instance1"},
+					{InstanceID: "instance2", IDPID: "idp2", ResourceOwner: "org2"},
+				},
+			},
+			commands: &mockCommands{
+				err: map[string]error{"idp1": errors.New("sync failed")},
+			},
+			wantCalls: []syncCall{
+				{instanceID: "instance1", userID: SyncUserID, orgID: "instance1", idpID: "idp1"},
+				{instanceID: "instance2", userID: SyncUserID, orgID: "org2", idpID: "idp2"},
+			},
+			wantUnlocked: []string{"instance1", "instance2"},
+		},
+		{
+			name: "instance locked by another process",
+			queries: &mockQueries{
+				idps: []*query.LDAPSyncIDP{
+					{InstanceID: "instance1", IDPID: "idp1", ResourceOwner: "instance1"},
+					{InstanceID: "instance1", IDPID: "idp2", ResourceOwner: "org1"},
+					{InstanceID: "instance2", IDPID: "idp3", ResourceOwner: "org2"},
+				},
+			},
+			commands: &mockCommands{},
+			locked:   []string{"instance2"},
+			wantCalls: []syncCall{
+				{instanceID: "instance1", userID: SyncUserID, orgID: "instance1", idpID: "idp1"},
+				{instanceID: "instance1", userID: SyncUserID, orgID: "org1", idpID: "idp2"},
+			},
+			wantUnlocked: []string{"instance1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locker := &mockLocker{locked: tt.locked}
+			s := &Sync{
+				queries:  tt.queries,
+				commands: tt.commands,
+				locker:   locker,
+			}
+			err := s.Run(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCalls, tt.commands.calls)
+			assert.Equal(t, tt.wantUnlocked, locker.unlocked)
+		})
+	}
+}
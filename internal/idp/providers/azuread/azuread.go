@@ -57,6 +57,14 @@ func (p *Provider) issuer() string {
 	return fmt.Sprintf(issuerTemplate, p.tenant)
 }
 
+// isMultiTenant returns if users of multiple tenants are allowed to sign in,
+// which will result in id_tokens issued by the tenant of the user
+func (p *Provider) isMultiTenant() bool {
+	return p.tenant == CommonTenant ||
+		p.tenant == OrganizationsTenant ||
+		p.tenant == ConsumersTenant
+}
+
 // keysEndpoint returns the OIDC jwks_url based on the [TenantType]
 func (p *Provider) keysEndpoint() string {
 	return fmt.Sprintf(keysURLTemplate, p.tenant)
@@ -2,6 +2,8 @@ package azuread
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/zitadel/oidc/v3/pkg/client/rp"
@@ -12,6 +14,8 @@ import (
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 )
 
+var ErrMissingTenantID = errors.New("id_token does not contain a tenant id")
+
 // Session extends the [oauth.Session] to be able to handle the id_token and to implement the [idp.SessionSupportsMigration] functionality
 type Session struct {
 	*Provider
@@ -48,20 +52,24 @@ func (s *Session) FetchUser(ctx context.Context) (user idp.User, err error) {
 	if err != nil {
 		return nil, err
 	}
-	// since azure will sign the id_token always with the issuer of the application it might differ from
-	// the issuer the auth and token were based on, e.g. when allowing all account types to login,
-	// then the auth endpoint must be `https://login.microsoftonline.com/common/oauth2/v2.0/authorize`
-	// even though the issuer would be like `https://login.microsoftonline.com/d8cdd43f-fd94-4576-8deb-f3bfea72dc2e/v2.0`
-	if s.Provider.tenant == CommonTenant ||
-		s.Provider.tenant == OrganizationsTenant ||
-		s.Provider.tenant == ConsumersTenant {
-		return user, nil
-	}
 	idToken, ok := s.oauth().Tokens.Extra("id_token").(string)
 	if !ok {
 		return user, nil
 	}
-	idTokenVerifier := rp.NewIDTokenVerifier(s.Provider.issuer(), s.Provider.OAuthConfig().ClientID, rp.NewRemoteKeySet(s.Provider.HttpClient(), s.Provider.keysEndpoint()))
+	issuer := s.Provider.issuer()
+	// since azure will sign the id_token always with the issuer of the user's tenant it differs from
+	// the issuer the auth and token were based on, when allowing multiple tenants to login,
+	// e.g. the auth endpoint must be `https://login.microsoftonline.com/common/oauth2/v2.0/authorize`
+	// even though the issuer would be like `https://login.microsoftonline.com/d8cdd43f-fd94-4576-8deb-f3bfea72dc2e/v2.0`
+	// so the issuer is derived from the tenant (`tid` claim) of the id_token, the signature is still verified
+	// with the keys of the multi-tenant endpoint
+	if s.Provider.isMultiTenant() {
+		issuer, err = tenantIssuer(idToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+	idTokenVerifier := rp.NewIDTokenVerifier(issuer, s.Provider.OAuthConfig().ClientID, rp.NewRemoteKeySet(s.Provider.HttpClient(), s.Provider.keysEndpoint()))
 	s.oauth().Tokens.IDTokenClaims, err = rp.VerifyTokens[*oidc.IDTokenClaims](ctx, s.oauth().Tokens.AccessToken, idToken, idTokenVerifier)
 	if err != nil {
 		return nil, err
@@ -70,6 +78,21 @@ func (s *Session) FetchUser(ctx context.Context) (user idp.User, err error) {
 	return user, nil
 }
 
+// tenantIssuer returns the issuer of the tenant the id_token was issued for,
+// the token is only parsed and not verified
+func tenantIssuer(idToken string) (string, error) {
+	claims := new(struct {
+		TenantID string `json:"tid"`
+	})
+	if _, err := oidc.ParseToken(idToken, claims); err != nil {
+		return "", err
+	}
+	if claims.TenantID == "" {
+		return "", ErrMissingTenantID
+	}
+	return fmt.Sprintf(issuerTemplate, claims.TenantID), nil
+}
+
 // Tokens returns the [oidc.Tokens] of the underlying [oauth.Session].
 func (s *Session) Tokens() *oidc.Tokens[*oidc.IDTokenClaims] {
 	return s.oauth().Tokens
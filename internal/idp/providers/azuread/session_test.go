@@ -2,6 +2,7 @@ package azuread
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"net/http"
 	"testing"
@@ -414,3 +415,40 @@ func TestSession_RetrievePreviousID(t *testing.T) {
 		})
 	}
 }
+
+func Test_tenantIssuer(t *testing.T) {
+	tests := []struct {
+		name    string
+		idToken string
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "invalid token",
+			idToken: "invalid",
+			wantErr: oidc.ErrParse,
+		},
+		{
+			name:    "missing tenant",
+			idToken: unsignedToken(`{"iss":"https://login.microsoftonline.com/common/v2.0","sub":"sub"}`),
+			wantErr: ErrMissingTenantID,
+		},
+		{
+			name:    "tenant",
+			idToken: unsignedToken(`{"iss":"https://login.microsoftonline.com/d8cdd43f-fd94-4576-8deb-f3bfea72dc2e/v2.0","sub":"sub","tid":"d8cdd43f-fd94-4576-8deb-f3bfea72dc2e"}`),
+			want:    "https://login.microsoftonline.com/d8cdd43f-fd94-4576-8deb-f3bfea72dc2e/v2.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tenantIssuer(tt.idToken)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func unsignedToken(payload string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
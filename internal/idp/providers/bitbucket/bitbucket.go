@@ -0,0 +1,160 @@
+package bitbucket
+
+import (
+	"golang.org/x/oauth2"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
+)
+
+const (
+	authURL    = "https://bitbucket.org/site/oauth2/authorize"
+	tokenURL   = "https://bitbucket.org/site/oauth2/access_token"
+	profileURL = "https://api.bitbucket.org/2.0/user"
+	emailsURL  = "https://api.bitbucket.org/2.0/user/emails"
+	name       = "Bitbucket"
+
+	ScopeAccount = "account"
+	ScopeEmail   = "email"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Bitbucket (Cloud)
+type Provider struct {
+	*oauth.Provider
+}
+
+// New creates a Bitbucket provider using the [oauth.Provider] (OAuth 2.0 generic provider).
+// If no scopes are provided, it will request `account email`.
+// Use the [Session] to retrieve the user, since the email has to be retrieved from a separate endpoint.
+func New(clientID, secret, callbackURL string, scopes []string, options ...oauth.ProviderOpts) (*Provider, error) {
+	if len(scopes) == 0 {
+		scopes = []string{ScopeAccount, ScopeEmail}
+	}
+	rp, err := oauth.New(
+		&oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: secret,
+			RedirectURL:  callbackURL,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  authURL,
+				TokenURL: tokenURL,
+			},
+			Scopes: scopes,
+		},
+		name,
+		profileURL,
+		func() idp.User {
+			return new(User)
+		},
+		options...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: rp,
+	}, nil
+}
+
+// User is a representation of the authenticated Bitbucket user and implements the [idp.User] interface
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-users/#api-user-get
+//
+// Bitbucket does not return the email on the user endpoint,
+// it's set from the primary address of the emails endpoint by the [Session].
+type User struct {
+	UUID          string              `json:"uuid"`
+	AccountID     string              `json:"account_id"`
+	Username      string              `json:"username,omitempty"`
+	Nickname      string              `json:"nickname,omitempty"`
+	DisplayName   string              `json:"display_name,omitempty"`
+	Links         Links               `json:"links"`
+	Email         domain.EmailAddress `json:"email,omitempty"`
+	EmailVerified bool                `json:"email_verified,omitempty"`
+}
+
+type Links struct {
+	Avatar Link `json:"avatar"`
+	HTML   Link `json:"html"`
+}
+
+type Link struct {
+	Href string `json:"href"`
+}
+
+// GetID is an implementation of the [idp.User] interface.
+func (u *User) GetID() string {
+	return u.UUID
+}
+
+// GetFirstName is an implementation of the [idp.User] interface.
+// It returns an empty string because Bitbucket does not provide the user's firstname.
+func (u *User) GetFirstName() string {
+	return ""
+}
+
+// GetLastName is an implementation of the [idp.User] interface.
+// It returns an empty string because Bitbucket does not provide the user's lastname.
+func (u *User) GetLastName() string {
+	return ""
+}
+
+// GetDisplayName is an implementation of the [idp.User] interface.
+func (u *User) GetDisplayName() string {
+	return u.DisplayName
+}
+
+// GetNickname is an implementation of the [idp.User] interface.
+func (u *User) GetNickname() string {
+	return u.Nickname
+}
+
+// GetPreferredUsername is an implementation of the [idp.User] interface.
+// It returns the username and falls back to the nickname, since Bitbucket no longer returns the username for all accounts.
+func (u *User) GetPreferredUsername() string {
+	if u.Username != "" {
+		return u.Username
+	}
+	return u.Nickname
+}
+
+// GetEmail is an implementation of the [idp.User] interface.
+func (u *User) GetEmail() domain.EmailAddress {
+	return u.Email
+}
+
+// IsEmailVerified is an implementation of the [idp.User] interface.
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerified
+}
+
+// GetPhone is an implementation of the [idp.User] interface.
+// It returns an empty string because Bitbucket does not provide the user's phone.
+func (u *User) GetPhone() domain.PhoneNumber {
+	return ""
+}
+
+// IsPhoneVerified is an implementation of the [idp.User] interface.
+// It returns false because Bitbucket does not provide the user's phone.
+func (u *User) IsPhoneVerified() bool {
+	return false
+}
+
+// GetPreferredLanguage is an implementation of the [idp.User] interface.
+// It returns [language.Und] because Bitbucket does not provide the user's language.
+func (u *User) GetPreferredLanguage() language.Tag {
+	return language.Und
+}
+
+// GetAvatarURL is an implementation of the [idp.User] interface.
+func (u *User) GetAvatarURL() string {
+	return u.Links.Avatar.Href
+}
+
+// GetProfile is an implementation of the [idp.User] interface.
+func (u *User) GetProfile() string {
+	return u.Links.HTML.Href
+}
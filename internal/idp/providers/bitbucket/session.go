@@ -0,0 +1,61 @@
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
+)
+
+var _ idp.Session = (*Session)(nil)
+
+// Session extends the [oauth.Session] to retrieve the email of the user,
+// which Bitbucket only returns on a separate endpoint.
+type Session struct {
+	*oauth.Session
+}
+
+type emails struct {
+	Values []email `json:"values"`
+}
+
+type email struct {
+	Email       domain.EmailAddress `json:"email"`
+	IsPrimary   bool                `json:"is_primary"`
+	IsConfirmed bool                `json:"is_confirmed"`
+}
+
+// FetchUser implements the [idp.Session] interface.
+// It will execute an OAuth 2.0 code exchange if needed to retrieve the access token,
+// call the user endpoint and additionally the emails endpoint to set the primary email on the [User].
+func (s *Session) FetchUser(ctx context.Context) (idp.User, error) {
+	user, err := s.Session.FetchUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bitbucketUser, ok := user.(*User)
+	if !ok {
+		return user, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, emailsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("authorization", s.Tokens.TokenType+" "+s.Tokens.AccessToken)
+	addresses := new(emails)
+	if err := httphelper.HttpRequest(s.Provider.RelyingParty.HttpClient(), req, addresses); err != nil {
+		return nil, err
+	}
+	for _, address := range addresses.Values {
+		if address.IsPrimary {
+			bitbucketUser.Email = address.Email
+			bitbucketUser.EmailVerified = address.IsConfirmed
+			break
+		}
+	}
+	return bitbucketUser, nil
+}
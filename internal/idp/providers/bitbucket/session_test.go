@@ -0,0 +1,204 @@
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"golang.org/x/oauth2"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
+)
+
+func TestSession_FetchUser(t *testing.T) {
+	type fields struct {
+		clientID     string
+		clientSecret string
+		redirectURI  string
+		httpMock     func()
+		authURL      string
+		code         string
+		tokens       *oidc.Tokens[*oidc.IDTokenClaims]
+		scopes       []string
+		options      []oauth.ProviderOpts
+	}
+	type want struct {
+		err               func(error) bool
+		user              idp.User
+		id                string
+		displayName       string
+		nickName          string
+		preferredUsername string
+		email             string
+		isEmailVerified   bool
+		preferredLanguage language.Tag
+		avatarURL         string
+		profile           string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   want
+	}{
+		{
+			name: "unauthenticated session, error",
+			fields: fields{
+				clientID:     "clientID",
+				clientSecret: "clientSecret",
+				redirectURI:  "redirectURI",
+				httpMock: func() {
+					gock.New("https://api.bitbucket.org").
+						Get("/2.0/user").
+						Reply(200).
+						JSON(userinfo())
+				},
+				authURL: "https://bitbucket.org/site/oauth2/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&state=testState",
+				tokens:  nil,
+			},
+			want: want{
+				err: func(err error) bool {
+					return errors.Is(err, oauth.ErrCodeMissing)
+				},
+			},
+		},
+		{
+			name: "emails error",
+			fields: fields{
+				clientID:     "clientID",
+				clientSecret: "clientSecret",
+				redirectURI:  "redirectURI",
+				httpMock: func() {
+					gock.New("https://api.bitbucket.org").
+						Get("/2.0/user/emails").
+						Reply(http.StatusInternalServerError)
+					gock.New("https://api.bitbucket.org").
+						Get("/2.0/user").
+						Reply(200).
+						JSON(userinfo())
+				},
+				authURL: "https://bitbucket.org/site/oauth2/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&state=testState",
+				tokens: &oidc.Tokens[*oidc.IDTokenClaims]{
+					Token: &oauth2.Token{
+						AccessToken: "accessToken",
+						TokenType:   oidc.BearerToken,
+					},
+				},
+			},
+			want: want{
+				err: func(err error) bool {
+					return err.Error() == "http status not ok: 500 Internal Server Error "
+				},
+			},
+		},
+		{
+			name: "successful fetch",
+			fields: fields{
+				clientID:     "clientID",
+				clientSecret: "clientSecret",
+				redirectURI:  "redirectURI",
+				httpMock: func() {
+					gock.New("https://api.bitbucket.org").
+						Get("/2.0/user/emails").
+						Reply(200).
+						JSON(map[string]any{
+							"values": []map[string]any{
+								{"email": "secondary@bitbucket.org", "is_primary": false, "is_confirmed": true},
+								{"email": "primary@bitbucket.org", "is_primary": true, "is_confirmed": true},
+							},
+						})
+					gock.New("https://api.bitbucket.org").
+						Get("/2.0/user").
+						Reply(200).
+						JSON(userinfo())
+				},
+				authURL: "https://bitbucket.org/site/oauth2/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&state=testState",
+				tokens: &oidc.Tokens[*oidc.IDTokenClaims]{
+					Token: &oauth2.Token{
+						AccessToken: "accessToken",
+						TokenType:   oidc.BearerToken,
+					},
+				},
+			},
+			want: want{
+				user: func() *User {
+					user := userinfo()
+					user.Email = "primary@bitbucket.org"
+					user.EmailVerified = true
+					return user
+				}(),
+				id:                "{b3b5e1d2-0d3a-4f3e-9f6a-5f4f2a1c1e2d}",
+				displayName:       "Display Name",
+				nickName:          "nickname",
+				preferredUsername: "username",
+				email:             "primary@bitbucket.org",
+				isEmailVerified:   true,
+				preferredLanguage: language.Und,
+				avatarURL:         "https://avatar.bitbucket.org/avatar.png",
+				profile:           "https://bitbucket.org/username/",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer gock.Off()
+			tt.fields.httpMock()
+			a := assert.New(t)
+
+			provider, err := New(tt.fields.clientID, tt.fields.clientSecret, tt.fields.redirectURI, tt.fields.scopes, tt.fields.options...)
+			require.NoError(t, err)
+
+			session := &Session{
+				Session: &oauth.Session{
+					AuthURL:  tt.fields.authURL,
+					Code:     tt.fields.code,
+					Tokens:   tt.fields.tokens,
+					Provider: provider.Provider,
+				},
+			}
+
+			user, err := session.FetchUser(context.Background())
+			if tt.want.err != nil && !tt.want.err(err) {
+				a.Fail("invalid error", err)
+			}
+			if tt.want.err == nil {
+				a.NoError(err)
+				a.Equal(tt.want.user, user)
+				a.Equal(tt.want.id, user.GetID())
+				a.Equal("", user.GetFirstName())
+				a.Equal("", user.GetLastName())
+				a.Equal(tt.want.displayName, user.GetDisplayName())
+				a.Equal(tt.want.nickName, user.GetNickname())
+				a.Equal(tt.want.preferredUsername, user.GetPreferredUsername())
+				a.Equal(domain.EmailAddress(tt.want.email), user.GetEmail())
+				a.Equal(tt.want.isEmailVerified, user.IsEmailVerified())
+				a.Equal(domain.PhoneNumber(""), user.GetPhone())
+				a.False(user.IsPhoneVerified())
+				a.Equal(tt.want.preferredLanguage, user.GetPreferredLanguage())
+				a.Equal(tt.want.avatarURL, user.GetAvatarURL())
+				a.Equal(tt.want.profile, user.GetProfile())
+			}
+		})
+	}
+}
+
+func userinfo() *User {
+	return &User{
+		UUID:        "{b3b5e1d2-0d3a-4f3e-9f6a-5f4f2a1c1e2d}",
+		AccountID:   "accountID",
+		Username:    "username",
+		Nickname:    "nickname",
+		DisplayName: "Display Name",
+		Links: Links{
+			Avatar: Link{Href: "https://avatar.bitbucket.org/avatar.png"},
+			HTML:   Link{Href: "https://bitbucket.org/username/"},
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package keycloak
+
+import (
+	"net/url"
+	"strings"
+
+	openid "github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Keycloak
+type Provider struct {
+	*oidc.Provider
+}
+
+// New creates a Keycloak provider using the [oidc.Provider] (OIDC generic provider).
+// The configuration is discovered from the issuer of the realm, see [Issuer].
+func New(name, baseURL, realm, clientID, clientSecret, redirectURI string, scopes []string, options ...oidc.ProviderOpts) (*Provider, error) {
+	if len(scopes) == 0 {
+		// the OIDC provider would set `openid profile email phone` as default scope,
+		// but the `phone` scope is not assigned to clients by default and Keycloak would return an error
+		scopes = []string{openid.ScopeOpenID, openid.ScopeProfile, openid.ScopeEmail}
+	}
+	rp, err := oidc.New(name, Issuer(baseURL, realm), clientID, clientSecret, redirectURI, scopes, oidc.DefaultMapper, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: rp,
+	}, nil
+}
+
+// Issuer returns the issuer of the realm, e.g. `https://keycloak.example.com/realms/zitadel`.
+// Keycloak versions prior to 17 are served under the `/auth` path, which then needs to be part of the baseURL.
+func Issuer(baseURL, realm string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/realms/" + url.PathEscape(realm)
+}
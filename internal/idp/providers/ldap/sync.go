@@ -0,0 +1,113 @@
+package ldap
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// syncPagingSize is the amount of entries requested per page when searching all users of the directory
+const syncPagingSize = 500
+
+// SyncUser is a user of the directory returned by [Provider.SearchUsers]
+type SyncUser struct {
+	*User
+	DN     string
+	Groups []string
+}
+
+// SearchUsers returns all users of the directory matching the configured object classes and the optional filter.
+// If a groupAttribute is provided, its values are returned as groups of the user.
+// Entries without a value for the id attribute are ignored.
+func (p *Provider) SearchUsers(_ context.Context, filter, groupAttribute string) (users []*SyncUser, err error) {
+	for _, server := range p.servers {
+		users, err = p.searchUsers(server, filter, groupAttribute)
+		if err == nil {
+			return users, nil
+		}
+	}
+	return nil, err
+}
+
+func (p *Provider) searchUsers(server, filter, groupAttribute string) ([]*SyncUser, error) {
+	conn, err := getConnection(server, p.startTLS, p.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.Bind(p.bindDN, p.bindPassword); err != nil {
+		return nil, err
+	}
+
+	attributes := p.getNecessaryAttributes()
+	if groupAttribute != "" {
+		attributes = append(attributes, groupAttribute)
+	}
+	searchRequest := ldap.NewSearchRequest(
+		p.baseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(p.timeout.Seconds()), false,
+		syncSearchQuery(p.userObjectClasses, filter),
+		attributes,
+		nil,
+	)
+	sr, err := conn.SearchWithPaging(searchRequest, syncPagingSize)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*SyncUser, 0, len(sr.Entries))
+	for _, entry := range sr.Entries {
+		user, err := mapLDAPEntryToUser(
+			entry,
+			p.idAttribute,
+			p.firstNameAttribute,
+			p.lastNameAttribute,
+			p.displayNameAttribute,
+			p.nickNameAttribute,
+			p.preferredUsernameAttribute,
+			p.emailAttribute,
+			p.emailVerifiedAttribute,
+			p.phoneAttribute,
+			p.phoneVerifiedAttribute,
+			p.preferredLanguageAttribute,
+			p.avatarURLAttribute,
+			p.profileAttribute,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if user.ID == "" {
+			continue
+		}
+		syncUser := &SyncUser{
+			User: user,
+			DN:   entry.DN,
+		}
+		if groupAttribute != "" {
+			syncUser.Groups = entry.GetAttributeValues(groupAttribute)
+		}
+		users = append(users, syncUser)
+	}
+	return users, nil
+}
+
+// syncSearchQuery combines the object classes and the optional filter,
+// the filter can be provided with or without the enclosing parentheses
+func syncSearchQuery(objectClasses []string, filter string) string {
+	queries := make([]string, 0, len(objectClasses)+1)
+	for _, class := range objectClasses {
+		queries = append(queries, objectClassesToSearchQuery([]string{class}))
+	}
+	if filter = strings.TrimSpace(filter); filter != "" {
+		if !strings.HasPrefix(filter, "(") {
+			filter = "(" + filter + ")"
+		}
+		queries = append(queries, filter)
+	}
+	if len(queries) == 0 {
+		return "(objectClass=*)"
+	}
+	return queriesAndToSearchQuery(queries...)
+}
@@ -0,0 +1,67 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvider_syncSearchQuery(t *testing.T) {
+	type args struct {
+		objectClasses []string
+		filter        string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "zero",
+			args: args{},
+			want: "(objectClass=*)",
+		},
+		{
+			name: "one object class",
+			args: args{
+				objectClasses: []string{"person"},
+			},
+			want: "(objectClass=person)",
+		},
+		{
+			name: "multiple object classes",
+			args: args{
+				objectClasses: []string{"person", "inetOrgPerson"},
+			},
+			want: "(&(objectClass=person)(objectClass=inetOrgPerson))",
+		},
+		{
+			name: "filter only",
+			args: args{
+				filter: "(memberOf=cn=zitadel,dc=example,dc=com)",
+			},
+			want: "(memberOf=cn=zitadel,dc=example,dc=com)",
+		},
+		{
+			name: "object class and filter without parentheses",
+			args: args{
+				objectClasses: []string{"person"},
+				filter:        " department=sales ",
+			},
+			want: "(&(objectClass=person)(department=sales))",
+		},
+		{
+			name: "object classes and filter",
+			args: args{
+				objectClasses: []string{"person", "inetOrgPerson"},
+				filter:        "(|(department=sales)(department=support))",
+			},
+			want: "(&(objectClass=person)(objectClass=inetOrgPerson)(|(department=sales)(department=support)))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, syncSearchQuery(tt.args.objectClasses, tt.args.filter))
+		})
+	}
+}
@@ -11,7 +11,10 @@ import (
 	"github.com/zitadel/zitadel/internal/idp"
 )
 
-var _ idp.User = (*UserMapper)(nil)
+var (
+	_ idp.User       = (*UserMapper)(nil)
+	_ idp.ClaimsUser = (*UserMapper)(nil)
+)
 
 // UserMapper is an implementation of [idp.User].
 // It can be used in ZITADEL actions to map the `RawInfo`
@@ -108,3 +111,8 @@ func (u *UserMapper) GetAvatarURL() string {
 func (u *UserMapper) GetProfile() string {
 	return ""
 }
+
+// GetClaim is an implementation of the [idp.ClaimsUser] interface.
+func (u *UserMapper) GetClaim(name string) []string {
+	return idp.ClaimValues(u.RawInfo[name])
+}
@@ -19,6 +19,7 @@ type Provider struct {
 	name              string
 	userEndpoint      string
 	userMapper        func() idp.User
+	userMapping       *idp.UserMapping
 	isLinkingAllowed  bool
 	isCreationAllowed bool
 	isAutoCreation    bool
@@ -63,6 +64,13 @@ func WithRelyingPartyOption(option rp.Option) ProviderOpts {
 	}
 }
 
+// WithUserMapping maps the fields of the user from the (nested) attributes returned by the user endpoint.
+func WithUserMapping(mapping *idp.UserMapping) ProviderOpts {
+	return func(p *Provider) {
+		p.userMapping = mapping
+	}
+}
+
 // New creates a generic OAuth 2.0 provider
 func New(config *oauth2.Config, name, userEndpoint string, userMapper func() idp.User, options ...ProviderOpts) (provider *Provider, err error) {
 	provider = &Provider{
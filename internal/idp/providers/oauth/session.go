@@ -48,6 +48,9 @@ func (s *Session) FetchUser(ctx context.Context) (user idp.User, err error) {
 	if err := httphelper.HttpRequest(s.Provider.RelyingParty.HttpClient(), req, &mapper); err != nil {
 		return nil, err
 	}
+	if raw, ok := mapper.(*UserMapper); ok {
+		return s.Provider.userMapping.MapUser(mapper, raw.RawInfo), nil
+	}
 	return mapper, nil
 }
 
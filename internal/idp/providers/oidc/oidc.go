@@ -23,6 +23,7 @@ type Provider struct {
 	isAutoUpdate      bool
 	useIDToken        bool
 	userInfoMapper    func(info *oidc.UserInfo) idp.User
+	userMapping       *idp.UserMapping
 	authOptions       []func(bool) rp.AuthURLOpt
 }
 
@@ -64,6 +65,13 @@ func WithIDTokenMapping() ProviderOpts {
 	}
 }
 
+// WithUserMapping maps the fields of the user from the (nested) claims of the userinfo (or id_token).
+func WithUserMapping(mapping *idp.UserMapping) ProviderOpts {
+	return func(p *Provider) {
+		p.userMapping = mapping
+	}
+}
+
 // WithRelyingPartyOption allows to set an additional [rp.Option] like [rp.WithPKCE].
 func WithRelyingPartyOption(option rp.Option) ProviderOpts {
 	return func(p *Provider) {
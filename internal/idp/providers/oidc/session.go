@@ -2,6 +2,7 @@ package oidc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/zitadel/oidc/v3/pkg/client/rp"
@@ -54,7 +55,10 @@ func (s *Session) FetchUser(ctx context.Context) (user idp.User, err error) {
 		}
 	}
 	u := s.Provider.userInfoMapper(info)
-	return u, nil
+	if s.Provider.userMapping.IsZero() {
+		return u, nil
+	}
+	return s.Provider.userMapping.MapUser(u, userInfoClaims(info)), nil
 }
 
 func (s *Session) Authorize(ctx context.Context) (err error) {
@@ -65,6 +69,8 @@ func (s *Session) Authorize(ctx context.Context) (err error) {
 	return err
 }
 
+var _ idp.ClaimsUser = (*User)(nil)
+
 func NewUser(info *oidc.UserInfo) *User {
 	return &User{UserInfo: info}
 }
@@ -124,3 +130,22 @@ func (u *User) GetAvatarURL() string {
 func (u *User) GetProfile() string {
 	return u.Profile
 }
+
+// GetClaim is an implementation of the [idp.ClaimsUser] interface,
+// it includes the standard claims as well as any additional claims of the userinfo.
+func (u *User) GetClaim(name string) []string {
+	return idp.ClaimValues(userInfoClaims(u.UserInfo)[name])
+}
+
+// userInfoClaims returns the standard and additional claims of the userinfo as (json decoded) map
+func userInfoClaims(info *oidc.UserInfo) map[string]any {
+	claims := make(map[string]any)
+	data, err := json.Marshal(info)
+	if err != nil {
+		return claims
+	}
+	if err := json.Unmarshal(data, &claims); err != nil {
+		return claims
+	}
+	return claims
+}
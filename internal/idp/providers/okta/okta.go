@@ -0,0 +1,40 @@
+package okta
+
+import (
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Okta
+type Provider struct {
+	*oidc.Provider
+}
+
+// New creates an Okta provider using the [oidc.Provider] (OIDC generic provider).
+// The issuer is the URL of the Okta organization, e.g. `https://example.okta.com`.
+// If no authorizationServerID is provided, the org authorization server will be used,
+// otherwise the custom authorization server with the specified id (e.g. `default`).
+func New(name, issuer, authorizationServerID, clientID, clientSecret, redirectURI string, scopes []string, options ...oidc.ProviderOpts) (*Provider, error) {
+	rp, err := oidc.New(name, Issuer(issuer, authorizationServerID), clientID, clientSecret, redirectURI, scopes, oidc.DefaultMapper, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: rp,
+	}, nil
+}
+
+// Issuer returns the issuer of the authorization server.
+// The org authorization server is served directly on the organization URL,
+// where custom authorization servers are served on `/oauth2/{authorizationServerID}`.
+func Issuer(issuer, authorizationServerID string) string {
+	issuer = strings.TrimSuffix(issuer, "/")
+	if authorizationServerID == "" {
+		return issuer
+	}
+	return issuer + "/oauth2/" + authorizationServerID
+}
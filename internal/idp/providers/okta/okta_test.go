@@ -0,0 +1,125 @@
+package okta
+
+import (
+	"context"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	openid "github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+func TestProvider_BeginAuth(t *testing.T) {
+	type fields struct {
+		name                  string
+		issuer                string
+		authorizationServerID string
+		clientID              string
+		clientSecret          string
+		redirectURI           string
+		scopes                []string
+		opts                  []oidc.ProviderOpts
+		endpoints             string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   idp.Session
+	}{
+		{
+			name: "org authorization server",
+			fields: fields{
+				name:         "okta",
+				issuer:       "https://example.okta.com/",
+				clientID:     "clientID",
+				clientSecret: "clientSecret",
+				redirectURI:  "redirectURI",
+				scopes:       []string{"openid"},
+				endpoints:    "https://example.okta.com/oauth2/v1",
+			},
+			want: &oidc.Session{
+				AuthURL: "https://example.okta.com/oauth2/v1/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&scope=openid&state=testState",
+			},
+		},
+		{
+			name: "custom authorization server",
+			fields: fields{
+				name:                  "okta",
+				issuer:                "https://example.okta.com",
+				authorizationServerID: "default",
+				clientID:              "clientID",
+				clientSecret:          "clientSecret",
+				redirectURI:           "redirectURI",
+				scopes:                []string{"openid"},
+				endpoints:             "https://example.okta.com/oauth2/default/v1",
+			},
+			want: &oidc.Session{
+				AuthURL: "https://example.okta.com/oauth2/default/v1/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&scope=openid&state=testState",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer gock.Off()
+			issuer := Issuer(tt.fields.issuer, tt.fields.authorizationServerID)
+			gock.New(issuer).
+				Get(openid.DiscoveryEndpoint).
+				Reply(200).
+				JSON(&openid.DiscoveryConfiguration{
+					Issuer:                issuer,
+					AuthorizationEndpoint: tt.fields.endpoints + "/authorize",
+					TokenEndpoint:         tt.fields.endpoints + "/token",
+					UserinfoEndpoint:      tt.fields.endpoints + "/userinfo",
+				})
+			a := assert.New(t)
+			r := require.New(t)
+
+			provider, err := New(tt.fields.name, tt.fields.issuer, tt.fields.authorizationServerID, tt.fields.clientID, tt.fields.clientSecret, tt.fields.redirectURI, tt.fields.scopes, tt.fields.opts...)
+			r.NoError(err)
+
+			ctx := context.Background()
+			session, err := provider.BeginAuth(ctx, "testState")
+			r.NoError(err)
+
+			wantHeaders, wantContent := tt.want.GetAuth(ctx)
+			gotHeaders, gotContent := session.GetAuth(ctx)
+			a.Equal(wantHeaders, gotHeaders)
+			a.Equal(wantContent, gotContent)
+		})
+	}
+}
+
+func TestIssuer(t *testing.T) {
+	tests := []struct {
+		name                  string
+		issuer                string
+		authorizationServerID string
+		want                  string
+	}{
+		{
+			name:   "org authorization server",
+			issuer: "https://example.okta.com",
+			want:   "https://example.okta.com",
+		},
+		{
+			name:   "org authorization server, trailing slash",
+			issuer: "https://example.okta.com/",
+			want:   "https://example.okta.com",
+		},
+		{
+			name:                  "custom authorization server",
+			issuer:                "https://example.okta.com/",
+			authorizationServerID: "default",
+			want:                  "https://example.okta.com/oauth2/default",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Issuer(tt.issuer, tt.authorizationServerID))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package slack
+
+import (
+	openid "github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+const (
+	issuer = "https://slack.com"
+	name   = "Slack"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Slack
+type Provider struct {
+	*oidc.Provider
+}
+
+// New creates a Slack provider using the [oidc.Provider] (OIDC generic provider)
+func New(clientID, clientSecret, redirectURI string, scopes []string, options ...oidc.ProviderOpts) (*Provider, error) {
+	if len(scopes) == 0 {
+		// the OIDC provider would set `openid profile email phone` as default scope,
+		// but Slack only supports `openid profile email` and returns an error for the others
+		scopes = []string{openid.ScopeOpenID, openid.ScopeProfile, openid.ScopeEmail}
+	}
+	rp, err := oidc.New(name, issuer, clientID, clientSecret, redirectURI, scopes, userMapper, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: rp,
+	}, nil
+}
+
+var userMapper = func(info *openid.UserInfo) idp.User {
+	return &User{oidc.DefaultMapper(info)}
+}
+
+// User is a representation of the authenticated Slack user and implements the [idp.User] interface
+// by wrapping an [idp.User] (implemented by [oidc.User]). It overwrites the [GetPreferredUsername] to use the `email` claim.
+type User struct {
+	idp.User
+}
+
+// GetPreferredUsername implements the [idp.User] interface.
+// It returns the email, because Slack does not return a username.
+func (u *User) GetPreferredUsername() string {
+	return string(u.GetEmail())
+}
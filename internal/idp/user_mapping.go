@@ -0,0 +1,261 @@
+package idp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// UserMapping defines [JSONPath] expressions to map the fields of a [User]
+// from the (nested) claims returned by the identity provider, e.g. `$.profile.given_name`.
+// Fields without an expression keep the value provided by the identity provider.
+type UserMapping struct {
+	ID                string `json:"id,omitempty"`
+	FirstName         string `json:"firstName,omitempty"`
+	LastName          string `json:"lastName,omitempty"`
+	DisplayName       string `json:"displayName,omitempty"`
+	NickName          string `json:"nickName,omitempty"`
+	PreferredUsername string `json:"preferredUsername,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     string `json:"emailVerified,omitempty"`
+	Phone             string `json:"phone,omitempty"`
+	PhoneVerified     string `json:"phoneVerified,omitempty"`
+	PreferredLanguage string `json:"preferredLanguage,omitempty"`
+	AvatarURL         string `json:"avatarUrl,omitempty"`
+	Profile           string `json:"profile,omitempty"`
+}
+
+// IsZero returns if no field is mapped
+func (m *UserMapping) IsZero() bool {
+	return m == nil || *m == UserMapping{}
+}
+
+// Equal returns if both mappings map the same fields, a missing mapping equals an empty one
+func (m *UserMapping) Equal(mapping *UserMapping) bool {
+	if m.IsZero() || mapping.IsZero() {
+		return m.IsZero() == mapping.IsZero()
+	}
+	return *m == *mapping
+}
+
+// Validate checks that all expressions are valid [JSONPath] expressions
+func (m *UserMapping) Validate() error {
+	if m.IsZero() {
+		return nil
+	}
+	for _, expression := range m.expressions() {
+		if expression == "" {
+			continue
+		}
+		if _, err := ParseJSONPath(expression); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *UserMapping) expressions() []string {
+	return []string{
+		m.ID,
+		m.FirstName,
+		m.LastName,
+		m.DisplayName,
+		m.NickName,
+		m.PreferredUsername,
+		m.Email,
+		m.EmailVerified,
+		m.Phone,
+		m.PhoneVerified,
+		m.PreferredLanguage,
+		m.AvatarURL,
+		m.Profile,
+	}
+}
+
+// MapUser returns the user with the mapped fields evaluated on the claims,
+// the user is returned unchanged if there's no mapping
+func (m *UserMapping) MapUser(user User, claims map[string]any) User {
+	if m.IsZero() {
+		return user
+	}
+	return &MappedUser{
+		User:    user,
+		mapping: m,
+		claims:  claims,
+	}
+}
+
+// Scan implements the [sql.Scanner] interface for the projection column
+func (m *UserMapping) Scan(src any) error {
+	if b, ok := src.([]byte); ok {
+		return json.Unmarshal(b, m)
+	}
+	if str, ok := src.(string); ok {
+		return json.Unmarshal([]byte(str), m)
+	}
+	return nil
+}
+
+// Value implements the [driver.Valuer] interface for the projection column
+func (m UserMapping) Value() (driver.Value, error) {
+	if m.IsZero() {
+		return nil, nil
+	}
+	return json.Marshal(m)
+}
+
+var (
+	_ User       = (*MappedUser)(nil)
+	_ ClaimsUser = (*MappedUser)(nil)
+)
+
+// MappedUser is an implementation of [User], which overwrites the fields of the user
+// with the values of the [UserMapping], the first value is used if an expression matches multiple.
+// Missing claims result in empty values.
+type MappedUser struct {
+	User
+	mapping *UserMapping
+	claims  map[string]any
+}
+
+// MarshalJSON returns the json of the original user to keep the raw information of the identity provider
+func (u *MappedUser) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.User)
+}
+
+func (u *MappedUser) value(expression string) (string, bool) {
+	if expression == "" {
+		return "", false
+	}
+	path, err := ParseJSONPath(expression)
+	if err != nil {
+		return "", false
+	}
+	values := ClaimValues(path.Evaluate(u.claims))
+	if len(values) == 0 {
+		return "", true
+	}
+	return values[0], true
+}
+
+func (u *MappedUser) boolValue(expression string) (bool, bool) {
+	value, ok := u.value(expression)
+	if !ok {
+		return false, false
+	}
+	verified, _ := strconv.ParseBool(value)
+	return verified, true
+}
+
+// GetID is an implementation of the [User] interface.
+func (u *MappedUser) GetID() string {
+	if value, ok := u.value(u.mapping.ID); ok {
+		return value
+	}
+	return u.User.GetID()
+}
+
+// GetFirstName is an implementation of the [User] interface.
+func (u *MappedUser) GetFirstName() string {
+	if value, ok := u.value(u.mapping.FirstName); ok {
+		return value
+	}
+	return u.User.GetFirstName()
+}
+
+// GetLastName is an implementation of the [User] interface.
+func (u *MappedUser) GetLastName() string {
+	if value, ok := u.value(u.mapping.LastName); ok {
+		return value
+	}
+	return u.User.GetLastName()
+}
+
+// GetDisplayName is an implementation of the [User] interface.
+func (u *MappedUser) GetDisplayName() string {
+	if value, ok := u.value(u.mapping.DisplayName); ok {
+		return value
+	}
+	return u.User.GetDisplayName()
+}
+
+// GetNickname is an implementation of the [User] interface.
+func (u *MappedUser) GetNickname() string {
+	if value, ok := u.value(u.mapping.NickName); ok {
+		return value
+	}
+	return u.User.GetNickname()
+}
+
+// GetPreferredUsername is an implementation of the [User] interface.
+func (u *MappedUser) GetPreferredUsername() string {
+	if value, ok := u.value(u.mapping.PreferredUsername); ok {
+		return value
+	}
+	return u.User.GetPreferredUsername()
+}
+
+// GetEmail is an implementation of the [User] interface.
+func (u *MappedUser) GetEmail() domain.EmailAddress {
+	if value, ok := u.value(u.mapping.Email); ok {
+		return domain.EmailAddress(value)
+	}
+	return u.User.GetEmail()
+}
+
+// IsEmailVerified is an implementation of the [User] interface.
+func (u *MappedUser) IsEmailVerified() bool {
+	if verified, ok := u.boolValue(u.mapping.EmailVerified); ok {
+		return verified
+	}
+	return u.User.IsEmailVerified()
+}
+
+// GetPhone is an implementation of the [User] interface.
+func (u *MappedUser) GetPhone() domain.PhoneNumber {
+	if value, ok := u.value(u.mapping.Phone); ok {
+		return domain.PhoneNumber(value)
+	}
+	return u.User.GetPhone()
+}
+
+// IsPhoneVerified is an implementation of the [User] interface.
+func (u *MappedUser) IsPhoneVerified() bool {
+	if verified, ok := u.boolValue(u.mapping.PhoneVerified); ok {
+		return verified
+	}
+	return u.User.IsPhoneVerified()
+}
+
+// GetPreferredLanguage is an implementation of the [User] interface.
+func (u *MappedUser) GetPreferredLanguage() language.Tag {
+	if value, ok := u.value(u.mapping.PreferredLanguage); ok {
+		return language.Make(value)
+	}
+	return u.User.GetPreferredLanguage()
+}
+
+// GetAvatarURL is an implementation of the [User] interface.
+func (u *MappedUser) GetAvatarURL() string {
+	if value, ok := u.value(u.mapping.AvatarURL); ok {
+		return value
+	}
+	return u.User.GetAvatarURL()
+}
+
+// GetProfile is an implementation of the [User] interface.
+func (u *MappedUser) GetProfile() string {
+	if value, ok := u.value(u.mapping.Profile); ok {
+		return value
+	}
+	return u.User.GetProfile()
+}
+
+// GetClaim is an implementation of the [ClaimsUser] interface.
+func (u *MappedUser) GetClaim(name string) []string {
+	return ClaimValues(u.claims[name])
+}
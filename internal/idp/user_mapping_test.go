@@ -0,0 +1,112 @@
+package idp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+type testUser struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+}
+
+func (u *testUser) GetID() string                      { return u.ID }
+func (u *testUser) GetFirstName() string               { return "" }
+func (u *testUser) GetLastName() string                { return "" }
+func (u *testUser) GetDisplayName() string             { return "" }
+func (u *testUser) GetNickname() string                { return "" }
+func (u *testUser) GetPreferredUsername() string       { return "" }
+func (u *testUser) GetEmail() domain.EmailAddress      { return domain.EmailAddress(u.Email) }
+func (u *testUser) IsEmailVerified() bool              { return false }
+func (u *testUser) GetPhone() domain.PhoneNumber       { return "" }
+func (u *testUser) IsPhoneVerified() bool              { return false }
+func (u *testUser) GetPreferredLanguage() language.Tag { return language.Und }
+func (u *testUser) GetAvatarURL() string               { return "" }
+func (u *testUser) GetProfile() string                 { return "" }
+
+func TestUserMapping_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping *UserMapping
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			mapping: nil,
+		},
+		{
+			name: "valid",
+			mapping: &UserMapping{
+				ID:    "$.sub",
+				Email: "$.emails[0].value",
+			},
+		},
+		{
+			name: "invalid",
+			mapping: &UserMapping{
+				ID:    "$.sub",
+				Phone: "phone",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mapping.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUserMapping_MapUser(t *testing.T) {
+	user := &testUser{ID: "id1", Email: "email@test.ch"}
+	claims := map[string]any{
+		"sub": "id1",
+		"attributes": map[string]any{
+			"uid":            float64(123),
+			"given_name":     "first",
+			"email_verified": "true",
+			"locale":         "de",
+			"groups":         []any{"admins", "users"},
+		},
+	}
+
+	t.Run("no mapping", func(t *testing.T) {
+		var mapping *UserMapping
+		assert.Same(t, user, mapping.MapUser(user, claims))
+	})
+
+	t.Run("mapped", func(t *testing.T) {
+		mapping := &UserMapping{
+			ID:                "$.attributes.uid",
+			FirstName:         "$.attributes.given_name",
+			EmailVerified:     "$.attributes.email_verified",
+			PreferredLanguage: "$.attributes.locale",
+			PreferredUsername: "$.attributes.groups",
+			Phone:             "$.attributes.phone",
+		}
+		mapped := mapping.MapUser(user, claims)
+		assert.Equal(t, "123", mapped.GetID())
+		assert.Equal(t, "first", mapped.GetFirstName())
+		assert.Equal(t, "", mapped.GetLastName())
+		assert.Equal(t, domain.EmailAddress("email@test.ch"), mapped.GetEmail())
+		assert.True(t, mapped.IsEmailVerified())
+		assert.Equal(t, language.German, mapped.GetPreferredLanguage())
+		assert.Equal(t, "admins", mapped.GetPreferredUsername())
+		assert.Equal(t, domain.PhoneNumber(""), mapped.GetPhone())
+
+		data, err := json.Marshal(mapped)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"id":"id1","email":"email@test.ch"}`, string(data))
+	})
+}
@@ -47,6 +47,8 @@ var (
 	cockroachYAML []byte
 	//go:embed config/postgres.yaml
 	postgresYAML []byte
+	//go:embed config/embedded.yaml
+	embeddedYAML []byte
 	//go:embed config/system-user-key.pem
 	systemUserKey []byte
 )
@@ -307,8 +309,8 @@ func (s *Tester) Done() {
 // NewTester start a new Zitadel server by passing the default commandline.
 // The server will listen on the configured port.
 // The database configuration that will be used can be set by the
-// INTEGRATION_DB_FLAVOR environment variable and can have the values "cockroach"
-// or "postgres". Defaults to "cockroach".
+// INTEGRATION_DB_FLAVOR environment variable and can have the values "cockroach",
+// "postgres" or "embedded". Defaults to "cockroach".
 //
 // The default Instance and Organisation are read from the DB and system
 // users are created as needed.
@@ -337,6 +339,8 @@ func NewTester(ctx context.Context, zitadelConfigYAML ...string) *Tester {
 		err = viper.MergeConfig(bytes.NewBuffer(cockroachYAML))
 	case "postgres":
 		err = viper.MergeConfig(bytes.NewBuffer(postgresYAML))
+	case "embedded":
+		err = viper.MergeConfig(bytes.NewBuffer(embeddedYAML))
 	default:
 		logging.New().WithField("flavor", flavor).Fatal("unknown db flavor set in INTEGRATION_DB_FLAVOR")
 	}
@@ -5,8 +5,9 @@ import (
 
 	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/internal/notification/channels/set"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
-	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/soap"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
 	"github.com/zitadel/zitadel/internal/notification/senders"
@@ -25,6 +26,8 @@ type deliveryMetrics struct {
 	email string
 	sms   string
 	json  string
+	set   string
+	soap  string
 }
 
 type channels struct {
@@ -40,11 +43,15 @@ func newChannels(q *handlers.NotificationQueries) *channels {
 				email: "successful_deliveries_email",
 				sms:   "successful_deliveries_sms",
 				json:  "successful_deliveries_json",
+				set:   "successful_deliveries_set",
+				soap:  "successful_deliveries_soap",
 			},
 			failed: deliveryMetrics{
 				email: "failed_deliveries_email",
 				sms:   "failed_deliveries_sms",
 				json:  "failed_deliveries_json",
+				set:   "failed_deliveries_set",
+				soap:  "failed_deliveries_soap",
 			},
 		},
 	}
@@ -54,6 +61,10 @@ func newChannels(q *handlers.NotificationQueries) *channels {
 	registerCounter(c.counters.failed.sms, "Failed SMS deliveries")
 	registerCounter(c.counters.success.json, "Successfully delivered JSON messages")
 	registerCounter(c.counters.failed.json, "Failed JSON message deliveries")
+	registerCounter(c.counters.success.set, "Successfully delivered security event tokens")
+	registerCounter(c.counters.failed.set, "Failed security event token deliveries")
+	registerCounter(c.counters.success.soap, "Successfully delivered SOAP messages")
+	registerCounter(c.counters.failed.soap, "Failed SOAP message deliveries")
 	return c
 }
 
@@ -62,8 +73,8 @@ func registerCounter(counter, desc string) {
 	logging.WithFields("metric", counter).OnError(err).Panic("unable to register counter")
 }
 
-func (c *channels) Email(ctx context.Context) (*senders.Chain, *smtp.Config, error) {
-	smtpCfg, err := c.q.GetSMTPConfig(ctx)
+func (c *channels) Email(ctx context.Context, resourceOwner string) (*senders.Chain, *smtp.Config, error) {
+	smtpCfg, err := c.q.GetSMTPConfig(ctx, resourceOwner)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -78,20 +89,19 @@ func (c *channels) Email(ctx context.Context) (*senders.Chain, *smtp.Config, err
 	return chain, smtpCfg, err
 }
 
-func (c *channels) SMS(ctx context.Context) (*senders.Chain, *twilio.Config, error) {
-	twilioCfg, err := c.q.GetTwilioConfig(ctx)
+func (c *channels) SMS(ctx context.Context) (*senders.Chain, error) {
+	smsCfgs, err := c.q.GetActiveSMSConfigs(ctx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	chain, err := senders.SMSChannels(
+	return senders.SMSChannels(
 		ctx,
-		twilioCfg,
+		smsCfgs,
 		c.q.GetFileSystemProvider,
 		c.q.GetLogProvider,
 		c.counters.success.sms,
 		c.counters.failed.sms,
 	)
-	return chain, twilioCfg, err
 }
 
 func (c *channels) Webhook(ctx context.Context, cfg webhook.Config) (*senders.Chain, error) {
@@ -104,3 +114,25 @@ func (c *channels) Webhook(ctx context.Context, cfg webhook.Config) (*senders.Ch
 		c.counters.failed.json,
 	)
 }
+
+func (c *channels) SecurityTokenEvent(ctx context.Context, cfg set.Config) (*senders.Chain, error) {
+	return senders.SecurityEventTokenChannels(
+		ctx,
+		cfg,
+		c.q.GetFileSystemProvider,
+		c.q.GetLogProvider,
+		c.counters.success.set,
+		c.counters.failed.set,
+	)
+}
+
+func (c *channels) SOAP(ctx context.Context, cfg soap.Config) (*senders.Chain, error) {
+	return senders.SOAPChannels(
+		ctx,
+		cfg,
+		c.q.GetFileSystemProvider,
+		c.q.GetLogProvider,
+		c.counters.success.soap,
+		c.counters.failed.soap,
+	)
+}
@@ -0,0 +1,99 @@
+package httpsms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type bodyData struct {
+	SenderNumber    string
+	RecipientNumber string
+	Content         string
+}
+
+func InitChannel(ctx context.Context, cfg Config) (channels.NotificationChannel, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	tmpl, err := cfg.template()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Debug("successfully initialized http sms channel")
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		msg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "HTTPSMS-2eNTb8Mqfz", "message is not SMS")
+		}
+		content, err := msg.GetContent()
+		if err != nil {
+			return err
+		}
+		sender := msg.SenderPhoneNumber
+		if sender == "" {
+			sender = cfg.SenderNumber
+		}
+		body, err := renderBody(tmpl, sender, msg.RecipientPhoneNumber, content)
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(requestCtx, cfg.method(), cfg.Endpoint, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if cfg.AuthHeaderName != "" {
+			req.Header.Set(cfg.AuthHeaderName, cfg.AuthHeaderValue)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "HTTPSMS-Vq8mYt3oRk", "could not send message")
+		}
+		if err = resp.Body.Close(); err != nil {
+			return err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return zerrors.ThrowUnknown(fmt.Errorf("calling url %s returned %s", cfg.Endpoint, resp.Status), "HTTPSMS-J3uWc7nAhd", "sms provider didn't return a success status")
+		}
+		logging.WithFields("endpoint", cfg.Endpoint, "method", cfg.method()).Debug("sms sent")
+		return nil
+	}), nil
+}
+
+// renderBody executes the body template with JSON encoded values
+// and ensures the result is valid JSON.
+func renderBody(tmpl *template.Template, sender, recipient, content string) ([]byte, error) {
+	data := bodyData{
+		SenderNumber:    jsonString(sender),
+		RecipientNumber: jsonString(recipient),
+		Content:         jsonString(content),
+	}
+	body := new(bytes.Buffer)
+	if err := tmpl.Execute(body, data); err != nil {
+		return nil, zerrors.ThrowInternal(err, "HTTPSMS-Ws5aQe0hUj", "could not render sms body")
+	}
+	if !json.Valid(body.Bytes()) {
+		return nil, zerrors.ThrowInternal(nil, "HTTPSMS-Ba4tZy9gNo", "rendered sms body is not valid JSON")
+	}
+	return body.Bytes(), nil
+}
+
+func jsonString(value string) string {
+	// marshalling a string can't fail
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
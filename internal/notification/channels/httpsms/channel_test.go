@@ -0,0 +1,100 @@
+package httpsms
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/notification/messages"
+)
+
+func TestInitChannel(t *testing.T) {
+	type want struct {
+		body       string
+		authHeader string
+		err        bool
+	}
+	tests := []struct {
+		name       string
+		cfg        Config
+		statusCode int
+		message    *messages.SMS
+		want       want
+	}{
+		{
+			name: "default template",
+			cfg: Config{
+				SenderNumber: "+41000000000",
+			},
+			statusCode: http.StatusOK,
+			message: &messages.SMS{
+				RecipientPhoneNumber: "+41111111111",
+				Content:              `your code is "123"`,
+			},
+			want: want{
+				body: `{"from":"+41000000000","to":"+41111111111","text":"your code is \"123\""}`,
+			},
+		},
+		{
+			name: "custom template and auth header",
+			cfg: Config{
+				BodyTemplate:    `{"messages":[{"destination":{{.RecipientNumber}},"content":{{.Content}}}]}`,
+				AuthHeaderName:  "Authorization",
+				AuthHeaderValue: "Bearer token",
+			},
+			statusCode: http.StatusCreated,
+			message: &messages.SMS{
+				RecipientPhoneNumber: "+41111111111",
+				Content:              "content",
+			},
+			want: want{
+				body:       `{"messages":[{"destination":"+41111111111","content":"content"}]}`,
+				authHeader: "Bearer token",
+			},
+		},
+		{
+			name: "error status",
+			cfg: Config{
+				SenderNumber: "+41000000000",
+			},
+			statusCode: http.StatusBadRequest,
+			message: &messages.SMS{
+				RecipientPhoneNumber: "+41111111111",
+				Content:              "content",
+			},
+			want: want{
+				body: `{"from":"+41000000000","to":"+41111111111","text":"content"}`,
+				err:  true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, tt.want.authHeader, r.Header.Get("Authorization"))
+				assert.JSONEq(t, tt.want.body, string(body))
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+			tt.cfg.Endpoint = server.URL
+
+			channel, err := InitChannel(context.Background(), tt.cfg)
+			require.NoError(t, err)
+			err = channel.HandleMessage(tt.message)
+			if tt.want.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package httpsms
+
+import (
+	"net/http"
+	"net/url"
+	"text/template"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// DefaultBodyTemplate is used if no body template is configured.
+// All values are rendered as JSON strings including the surrounding quotes.
+const DefaultBodyTemplate = `{"from":{{.SenderNumber}},"to":{{.RecipientNumber}},"text":{{.Content}}}`
+
+type Config struct {
+	Endpoint string
+	Method   string
+	// BodyTemplate is a text/template rendering the JSON request body.
+	// The fields SenderNumber, RecipientNumber and Content are available as JSON encoded strings.
+	BodyTemplate    string
+	AuthHeaderName  string
+	AuthHeaderValue string
+	SenderNumber    string
+}
+
+func (c *Config) Validate() error {
+	endpoint, err := url.Parse(c.Endpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return zerrors.ThrowInvalidArgument(err, "HTTPSMS-9ox2Hv6sWq", "Errors.SMSConfig.HTTP.InvalidEndpoint")
+	}
+	switch c.Method {
+	case "", http.MethodPost, http.MethodPut:
+	default:
+		return zerrors.ThrowInvalidArgument(nil, "HTTPSMS-Yl0bN2cKfa", "Errors.SMSConfig.HTTP.InvalidMethod")
+	}
+	if _, err := c.template(); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "HTTPSMS-xP4wgU1uXe", "Errors.SMSConfig.HTTP.InvalidTemplate")
+	}
+	return nil
+}
+
+func (c *Config) method() string {
+	if c.Method == "" {
+		return http.MethodPost
+	}
+	return c.Method
+}
+
+func (c *Config) template() (*template.Template, error) {
+	body := c.BodyTemplate
+	if body == "" {
+		body = DefaultBodyTemplate
+	}
+	return template.New("body").Option("missingkey=error").Parse(body)
+}
@@ -0,0 +1,72 @@
+package messagebird
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const endpoint = "https://rest.messagebird.com/messages"
+
+type errorResponse struct {
+	Errors []struct {
+		Code        int    `json:"code"`
+		Description string `json:"description"`
+	} `json:"errors"`
+}
+
+func InitChannel(ctx context.Context, config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized messagebird sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		msg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "MSGBI-Ha2kWq7tEz", "message is not SMS")
+		}
+		content, err := msg.GetContent()
+		if err != nil {
+			return err
+		}
+		originator := msg.SenderPhoneNumber
+		if originator == "" {
+			originator = config.Originator
+		}
+		form := url.Values{
+			"originator": {originator},
+			"recipients": {strings.TrimPrefix(msg.RecipientPhoneNumber, "+")},
+			"body":       {content},
+		}
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Authorization", "AccessKey "+config.AccessKey)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "MSGBI-c9RbPn4xLu", "could not send message")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			errs := new(errorResponse)
+			if err = json.NewDecoder(resp.Body).Decode(errs); err == nil && len(errs.Errors) > 0 {
+				return zerrors.ThrowUnknown(fmt.Errorf("messagebird returned %s: %s", resp.Status, errs.Errors[0].Description), "MSGBI-Tz6eJg1sVo", "could not send message")
+			}
+			return zerrors.ThrowUnknown(fmt.Errorf("messagebird returned %s", resp.Status), "MSGBI-Tz6eJg1sVo", "could not send message")
+		}
+		logging.Debug("sms sent")
+		return nil
+	})
+}
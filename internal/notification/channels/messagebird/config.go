@@ -0,0 +1,10 @@
+package messagebird
+
+type Config struct {
+	AccessKey  string
+	Originator string
+}
+
+func (c *Config) IsValid() bool {
+	return c.AccessKey != "" && c.Originator != ""
+}
@@ -0,0 +1,14 @@
+package set
+
+import (
+	"net/url"
+)
+
+type Config struct {
+	CallURL string
+}
+
+func (s *Config) Validate() error {
+	_, err := url.Parse(s.CallURL)
+	return err
+}
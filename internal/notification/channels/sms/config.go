@@ -0,0 +1,25 @@
+package sms
+
+import (
+	"github.com/zitadel/zitadel/internal/notification/channels/httpsms"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
+)
+
+// Config is the configuration of a single SMS provider.
+// Exactly one of the provider specific configs is set.
+type Config struct {
+	ProviderConfig    *Provider
+	TwilioConfig      *twilio.Config
+	HTTPConfig        *httpsms.Config
+	VonageConfig      *vonage.Config
+	MessageBirdConfig *messagebird.Config
+}
+
+type Provider struct {
+	ID string
+	// Priority defines the order in which the providers are used.
+	// Lower values are tried first.
+	Priority uint32
+}
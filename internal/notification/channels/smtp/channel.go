@@ -50,18 +50,41 @@ func (email *Email) HandleMessage(message channels.Message) error {
 	emailMsg.SenderEmail = email.senderAddress
 	emailMsg.SenderName = email.senderName
 	emailMsg.ReplyToAddress = email.replyToAddress
+	return sendMessage(email.smtpClient, emailMsg)
+}
+
+// TestConfiguration connects to the SMTP server of the passed configuration
+// and sends a test mail to the testEmail address.
+func TestConfiguration(cfg *Config, testEmail string) error {
+	client, err := cfg.SMTP.connectToSMTP(cfg.Tls)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return sendMessage(client, &messages.Email{
+		Recipients:     []string{testEmail},
+		Subject:        "Test email",
+		Content:        "This is a test email to check if your SMTP provider works fine",
+		SenderEmail:    cfg.From,
+		SenderName:     cfg.FromName,
+		ReplyToAddress: cfg.ReplyToAddress,
+	})
+}
+
+func sendMessage(client *smtp.Client, emailMsg *messages.Email) error {
 	// To && From
-	if err := email.smtpClient.Mail(emailMsg.SenderEmail); err != nil {
+	if err := client.Mail(emailMsg.SenderEmail); err != nil {
 		return zerrors.ThrowInternalf(err, "EMAIL-s3is3", "could not set sender: %v", emailMsg.SenderEmail)
 	}
 	for _, recp := range append(append(emailMsg.Recipients, emailMsg.CC...), emailMsg.BCC...) {
-		if err := email.smtpClient.Rcpt(recp); err != nil {
+		if err := client.Rcpt(recp); err != nil {
 			return zerrors.ThrowInternalf(err, "EMAIL-s4is4", "could not set recipient: %v", recp)
 		}
 	}
 
 	// Data
-	w, err := email.smtpClient.Data()
+	w, err := client.Data()
 	if err != nil {
 		return err
 	}
@@ -81,7 +104,7 @@ func (email *Email) HandleMessage(message channels.Message) error {
 		return err
 	}
 
-	return email.smtpClient.Quit()
+	return client.Quit()
 }
 
 func (smtpConfig SMTP) connectToSMTP(tlsRequired bool) (client *smtp.Client, err error) {
@@ -0,0 +1,57 @@
+package soap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// InitChannel initializes a channel, which posts SOAP envelopes (e.g. SAML logout requests)
+// to the configured url
+func InitChannel(ctx context.Context, cfg Config) (channels.NotificationChannel, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	logging.Debug("successfully initialized soap channel")
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		msg, ok := message.(*messages.SOAP)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "SOAP-oW9ab", "message is not a soap envelope")
+		}
+		payload, err := msg.GetContent()
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, cfg.CallURL, strings.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+		if cfg.SOAPAction != "" {
+			req.Header.Set("SOAPAction", cfg.SOAPAction)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		if err = resp.Body.Close(); err != nil {
+			return err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return zerrors.ThrowUnknown(fmt.Errorf("calling url %s returned %s", cfg.CallURL, resp.Status), "SOAP-Bee3u", "soap endpoint didn't return a success status")
+		}
+		logging.WithFields("calling_url", cfg.CallURL).Debug("soap endpoint called")
+		return nil
+	}), nil
+}
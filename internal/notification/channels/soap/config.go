@@ -0,0 +1,15 @@
+package soap
+
+import (
+	"net/url"
+)
+
+type Config struct {
+	CallURL    string
+	SOAPAction string
+}
+
+func (s *Config) Validate() error {
+	_, err := url.Parse(s.CallURL)
+	return err
+}
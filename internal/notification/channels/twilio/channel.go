@@ -23,7 +23,11 @@ func InitChannel(config Config) channels.NotificationChannel {
 		if err != nil {
 			return err
 		}
-		m, err := client.Messages.SendMessage(twilioMsg.SenderPhoneNumber, twilioMsg.RecipientPhoneNumber, content, nil)
+		sender := twilioMsg.SenderPhoneNumber
+		if sender == "" {
+			sender = config.SenderNumber
+		}
+		m, err := client.Messages.SendMessage(sender, twilioMsg.RecipientPhoneNumber, content, nil)
 		if err != nil {
 			return zerrors.ThrowInternal(err, "TWILI-osk3S", "could not send message")
 		}
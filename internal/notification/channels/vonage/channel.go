@@ -0,0 +1,80 @@
+package vonage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const endpoint = "https://rest.nexmo.com/sms/json"
+
+type response struct {
+	Messages []struct {
+		Status    string `json:"status"`
+		ErrorText string `json:"error-text"`
+		MessageID string `json:"message-id"`
+	} `json:"messages"`
+}
+
+func InitChannel(ctx context.Context, config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized vonage sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		msg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "VONAG-Lw3cR8xnYe", "message is not SMS")
+		}
+		content, err := msg.GetContent()
+		if err != nil {
+			return err
+		}
+		sender := msg.SenderPhoneNumber
+		if sender == "" {
+			sender = config.SenderNumber
+		}
+		form := url.Values{
+			"api_key":    {config.APIKey},
+			"api_secret": {config.APISecret},
+			"from":       {sender},
+			"to":         {strings.TrimPrefix(msg.RecipientPhoneNumber, "+")},
+			"text":       {content},
+		}
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "VONAG-u5PqZk2mTs", "could not send message")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return zerrors.ThrowUnknown(fmt.Errorf("vonage returned %s", resp.Status), "VONAG-e8GfHw4bJc", "could not send message")
+		}
+		result := new(response)
+		if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return zerrors.ThrowInternal(err, "VONAG-Xo1nVd6yQa", "could not parse response")
+		}
+		// status "0" means success, every other status is an error
+		for _, m := range result.Messages {
+			if m.Status != "0" {
+				return zerrors.ThrowUnknown(fmt.Errorf("vonage returned status %s: %s", m.Status, m.ErrorText), "VONAG-Rk7sMi3wBp", "could not send message")
+			}
+			logging.WithFields("message_id", m.MessageID).Debug("sms sent")
+		}
+		return nil
+	})
+}
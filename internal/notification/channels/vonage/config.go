@@ -0,0 +1,11 @@
+package vonage
+
+type Config struct {
+	APIKey       string
+	APISecret    string
+	SenderNumber string
+}
+
+func (c *Config) IsValid() bool {
+	return c.APIKey != "" && c.APISecret != "" && c.SenderNumber != ""
+}
@@ -0,0 +1,334 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/zitadel/logging"
+	"github.com/zitadel/oidc/v3/pkg/crypto"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	zcrypto "github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/notification/channels/set"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	BackChannelLogoutNotificationsProjectionTable = "projections.notifications_back_channel_logout"
+
+	backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+	logoutTokenType        = "logout+jwt"
+	logoutTokenLifetime    = 2 * time.Minute
+)
+
+type backChannelLogoutNotifier struct {
+	commands    Commands
+	queries     *NotificationQueries
+	channels    types.ChannelChains
+	keyEncAlg   zcrypto.EncryptionAlgorithm
+	idGenerator id.Generator
+}
+
+func NewBackChannelLogoutNotifier(
+	ctx context.Context,
+	config handler.Config,
+	commands Commands,
+	queries *NotificationQueries,
+	channels types.ChannelChains,
+	keyEncAlg zcrypto.EncryptionAlgorithm,
+	idGenerator id.Generator,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &backChannelLogoutNotifier{
+		commands:    commands,
+		queries:     queries,
+		channels:    channels,
+		keyEncAlg:   keyEncAlg,
+		idGenerator: idGenerator,
+	})
+}
+
+func (*backChannelLogoutNotifier) Name() string {
+	return BackChannelLogoutNotificationsProjectionTable
+}
+
+func (u *backChannelLogoutNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.TerminateType,
+					Reduce: u.reduceSessionTerminated,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanSignedOutType,
+					Reduce: u.reduceUserSignedOut,
+				},
+			},
+		},
+	}
+}
+
+func (u *backChannelLogoutNotifier) reduceSessionTerminated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.TerminateEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-D6H2h", "reduce.wrong.event.type %s", session.TerminateType)
+	}
+
+	return u.terminateOIDCSessionsStatement(e, map[string]interface{}{
+		"sessionID": e.Aggregate().ID,
+	}), nil
+}
+
+func (u *backChannelLogoutNotifier) reduceUserSignedOut(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanSignedOutEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Wu4ie", "reduce.wrong.event.type %s", user.HumanSignedOutType)
+	}
+
+	return u.terminateOIDCSessionsStatement(e, map[string]interface{}{
+		"userID": e.Aggregate().ID,
+		"userAgent": map[string]interface{}{
+			"fingerprint_id": e.UserAgentID,
+		},
+	}), nil
+}
+
+// terminateOIDCSessionsStatement sends the logout tokens for the OIDC sessions matching the provided data.
+// If a client cannot be reached, the statement fails, so the handler retries the event.
+// Only after the max failure count is reached, the failure is recorded on the OIDC sessions of the client.
+func (u *backChannelLogoutNotifier) terminateOIDCSessionsStatement(event eventstore.Event, data map[string]interface{}) *handler.Statement {
+	var failed []*oidcSessionToLogout
+	stmt := handler.NewStatement(event, func(ex handler.Executer, projectionName string) (err error) {
+		failed, err = u.terminateOIDCSessions(HandlerContext(event.Aggregate()), event, data)
+		return err
+	})
+	stmt.Skipped = func(err error) {
+		ctx := HandlerContext(event.Aggregate())
+		for _, oidcSession := range failed {
+			logging.WithFields("instance", event.Aggregate().InstanceID, "oidc_session", oidcSession.id).
+				OnError(u.commands.BackChannelLogoutFailed(ctx, oidcSession.id, oidcSession.resourceOwner, err)).
+				Error("unable to record failed back-channel logout")
+		}
+	}
+	return stmt
+}
+
+// terminateOIDCSessions sends a logout token to every client with a back-channel logout uri,
+// which issued tokens for the OIDC sessions matching the provided data.
+// A client, which cannot be reached, does not block the logout of the other clients.
+// Its OIDC sessions are returned together with the send errors.
+func (u *backChannelLogoutNotifier) terminateOIDCSessions(ctx context.Context, event eventstore.Event, data map[string]interface{}) (failed []*oidcSessionToLogout, err error) {
+	sessions, err := u.queries.oidcSessionsToLogout(ctx, event, data)
+	if err != nil || len(sessions) == 0 {
+		return nil, err
+	}
+	ctx, err = u.queries.Origin(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		signer   jose.Signer
+		sendErrs []error
+	)
+	for _, clientSessions := range groupByClient(sessions) {
+		client, err := u.queries.GetOIDCClientByID(ctx, clientSessions[0].clientID, false)
+		if zerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return failed, err
+		}
+		if client.BackChannelLogoutURI == "" {
+			continue
+		}
+		if client.BackChannelLogoutSessionRequired && clientSessions[0].sessionID == "" {
+			logging.WithFields("instance", event.Aggregate().InstanceID, "client", client.ClientID).
+				Warn("back-channel logout requires a session id, which is not available for the oidc session")
+			continue
+		}
+		if signer == nil {
+			signer, err = u.signer(ctx)
+			if err != nil {
+				return failed, err
+			}
+		}
+		if sendErr := u.sendLogoutToken(ctx, event, signer, client.BackChannelLogoutURI, clientSessions[0]); sendErr != nil {
+			logging.WithFields("instance", event.Aggregate().InstanceID, "client", client.ClientID).WithError(sendErr).
+				Warn("unable to send back-channel logout token")
+			failed = append(failed, clientSessions...)
+			sendErrs = append(sendErrs, sendErr)
+			continue
+		}
+		for _, oidcSession := range clientSessions {
+			if err = u.commands.BackChannelLogoutSent(ctx, oidcSession.id, oidcSession.resourceOwner); err != nil {
+				return failed, err
+			}
+		}
+	}
+	return failed, errors.Join(sendErrs...)
+}
+
+func (u *backChannelLogoutNotifier) sendLogoutToken(ctx context.Context, event eventstore.Event, signer jose.Signer, uri string, oidcSession *oidcSessionToLogout) error {
+	jwtID, err := u.idGenerator.Next()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	token, err := crypto.Sign(&LogoutTokenClaims{
+		Issuer:     http_utils.ComposedOrigin(ctx),
+		Subject:    oidcSession.userID,
+		Audience:   oidc.Audience{oidcSession.clientID},
+		IssuedAt:   oidc.FromTime(now),
+		Expiration: oidc.FromTime(now.Add(logoutTokenLifetime)),
+		JWTID:      jwtID,
+		Events: map[string]any{
+			backChannelLogoutEvent: struct{}{},
+		},
+		SessionID: oidcSession.sessionID,
+	}, signer)
+	if err != nil {
+		return err
+	}
+	return types.SendSecurityTokenEvent(ctx, set.Config{CallURL: uri}, u.channels, &LogoutTokenMessage{LogoutToken: token}, event).WithoutTemplate()
+}
+
+func (u *backChannelLogoutNotifier) signer(ctx context.Context) (jose.Signer, error) {
+	keys, err := u.queries.ActivePrivateSigningKey(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(keys.Keys) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "HANDL-DF3nf", "no active signing key")
+	}
+	key := keys.Keys[len(keys.Keys)-1]
+	keyData, err := zcrypto.Decrypt(key.Key(), u.keyEncAlg)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := zcrypto.BytesToPrivateKey(keyData)
+	if err != nil {
+		return nil, err
+	}
+	return jose.NewSigner(
+		jose.SigningKey{
+			Algorithm: jose.SignatureAlgorithm(key.Algorithm()),
+			Key:       &jose.JSONWebKey{Key: privateKey, KeyID: key.ID()},
+		},
+		(&jose.SignerOptions{}).WithType(logoutTokenType),
+	)
+}
+
+// LogoutTokenClaims are the claims of the logout token
+// as defined in OpenID Connect Back-Channel Logout 1.0, section 2.4
+type LogoutTokenClaims struct {
+	Issuer     string         `json:"iss"`
+	Subject    string         `json:"sub,omitempty"`
+	Audience   oidc.Audience  `json:"aud"`
+	IssuedAt   oidc.Time      `json:"iat"`
+	Expiration oidc.Time      `json:"exp"`
+	JWTID      string         `json:"jti"`
+	Events     map[string]any `json:"events"`
+	SessionID  string         `json:"sid,omitempty"`
+}
+
+// LogoutTokenMessage is the form body of the back-channel logout request
+type LogoutTokenMessage struct {
+	LogoutToken string `schema:"logout_token"`
+}
+
+type oidcSessionToLogout struct {
+	id            string
+	resourceOwner string
+	userID        string
+	sessionID     string
+	clientID      string
+}
+
+// oidcSessionsToLogout returns the OIDC sessions matching the data, which were created before the event
+// and for which no back-channel logout was sent yet.
+// Sessions with a failed back-channel logout stay eligible, so they are logged out with the next event.
+func (n *NotificationQueries) oidcSessionsToLogout(ctx context.Context, event eventstore.Event, data map[string]interface{}) ([]*oidcSessionToLogout, error) {
+	events, err := n.es.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(event.Aggregate().InstanceID).
+		CreationDateBefore(event.CreatedAt()).
+		AddQuery().
+		AggregateTypes(oidcsession.AggregateType).
+		EventTypes(oidcsession.AddedType).
+		EventData(data).
+		Builder(),
+	)
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+	sessions := make(map[string]*oidcSessionToLogout, len(events))
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		e, ok := event.(*oidcsession.AddedEvent)
+		if !ok {
+			continue
+		}
+		sessions[e.Aggregate().ID] = &oidcSessionToLogout{
+			id:            e.Aggregate().ID,
+			resourceOwner: e.Aggregate().ResourceOwner,
+			userID:        e.UserID,
+			sessionID:     e.SessionID,
+			clientID:      e.ClientID,
+		}
+		ids = append(ids, e.Aggregate().ID)
+	}
+	sent, err := n.es.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(event.Aggregate().InstanceID).
+		AddQuery().
+		AggregateTypes(oidcsession.AggregateType).
+		AggregateIDs(ids...).
+		EventTypes(oidcsession.BackChannelLogoutSentType).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range sent {
+		delete(sessions, event.Aggregate().ID)
+	}
+	result := make([]*oidcSessionToLogout, 0, len(sessions))
+	for _, id := range ids {
+		if oidcSession, ok := sessions[id]; ok {
+			result = append(result, oidcSession)
+		}
+	}
+	return result, nil
+}
+
+// groupByClient groups the OIDC sessions by client and (ZITADEL) session id,
+// so a client only receives one logout token per session.
+func groupByClient(sessions []*oidcSessionToLogout) [][]*oidcSessionToLogout {
+	indexes := make(map[[2]string]int)
+	grouped := make([][]*oidcSessionToLogout, 0, len(sessions))
+	for _, oidcSession := range sessions {
+		key := [2]string{oidcSession.clientID, oidcSession.sessionID}
+		i, ok := indexes[key]
+		if !ok {
+			indexes[key] = len(grouped)
+			grouped = append(grouped, []*oidcSessionToLogout{oidcSession})
+			continue
+		}
+		grouped[i] = append(grouped[i], oidcSession)
+	}
+	return grouped
+}
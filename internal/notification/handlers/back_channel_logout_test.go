@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	channel_mock "github.com/zitadel/zitadel/internal/notification/channels/mock"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	sessionID            = "session1"
+	oidcSessionID        = "V2_oidcSession1"
+	clientID             = "client1"
+	backChannelLogoutURI = "https://client.domain/logout"
+	signingKeyID         = "key1"
+)
+
+func Test_backChannelLogoutNotifier_reduceSessionTerminated(t *testing.T) {
+	tests := []struct {
+		name    string
+		test    func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands, *channel_mock.MockNotificationChannel) []eventstore.Event
+		wantErr error
+	}{
+		{
+			name: "no oidc sessions",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, channel *channel_mock.MockNotificationChannel) []eventstore.Event {
+				return nil
+			},
+		},
+		{
+			name: "client without back-channel logout uri",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, channel *channel_mock.MockNotificationChannel) []eventstore.Event {
+				queries.EXPECT().GetOIDCClientByID(gomock.Any(), clientID, false).Return(&query.OIDCClient{
+					ClientID: clientID,
+				}, nil)
+				return []eventstore.Event{oidcSessionAddedEvent(sessionID)}
+			},
+		},
+		{
+			name: "client removed",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, channel *channel_mock.MockNotificationChannel) []eventstore.Event {
+				queries.EXPECT().GetOIDCClientByID(gomock.Any(), clientID, false).Return(nil, zerrors.ThrowNotFound(nil, "QUERY-wu6Ee", "Errors.App.NotFound"))
+				return []eventstore.Event{oidcSessionAddedEvent(sessionID)}
+			},
+		},
+		{
+			name: "logout token sent",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, channel *channel_mock.MockNotificationChannel) []eventstore.Event {
+				queries.EXPECT().GetOIDCClientByID(gomock.Any(), clientID, false).Return(&query.OIDCClient{
+					ClientID:                         clientID,
+					BackChannelLogoutURI:             backChannelLogoutURI,
+					BackChannelLogoutSessionRequired: true,
+				}, nil)
+				expectSigningKey(queries)
+				channel.EXPECT().HandleMessage(gomock.Any()).DoAndReturn(func(message *messages.Form) error {
+					content, err := message.GetContent()
+					require.NoError(t, err)
+					values, err := url.ParseQuery(content)
+					require.NoError(t, err)
+					claims := verifyLogoutToken(t, values.Get("logout_token"))
+					assert.Equal(t, eventOrigin, claims.Issuer)
+					assert.Equal(t, userID, claims.Subject)
+					assert.Equal(t, []string{clientID}, []string(claims.Audience))
+					assert.Equal(t, sessionID, claims.SessionID)
+					assert.Contains(t, claims.Events, backChannelLogoutEvent)
+					return nil
+				})
+				commands.EXPECT().BackChannelLogoutSent(gomock.Any(), oidcSessionID, orgID).Return(nil)
+				return []eventstore.Event{oidcSessionAddedEvent(sessionID)}
+			},
+		},
+		{
+			name: "sending failed",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, channel *channel_mock.MockNotificationChannel) []eventstore.Event {
+				queries.EXPECT().GetOIDCClientByID(gomock.Any(), clientID, false).Return(&query.OIDCClient{
+					ClientID:             clientID,
+					BackChannelLogoutURI: backChannelLogoutURI,
+				}, nil)
+				expectSigningKey(queries)
+				channel.EXPECT().HandleMessage(gomock.Any()).Return(zerrors.ThrowUnknown(nil, "SET-DF3dq", "failed"))
+				commands.EXPECT().BackChannelLogoutFailed(gomock.Any(), oidcSessionID, orgID, gomock.Any()).Return(nil)
+				return []eventstore.Event{oidcSessionAddedEvent(sessionID)}
+			},
+			wantErr: zerrors.ThrowUnknown(nil, "SET-DF3dq", "failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			channel := channel_mock.NewMockNotificationChannel(ctrl)
+			queries.EXPECT().NotificationProviderByIDAndType(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&query.DebugNotificationProvider{}, nil)
+			idGenerator := id_mock.NewMockGenerator(ctrl)
+			idGenerator.EXPECT().Next().AnyTimes().Return("jwtID", nil)
+			oidcSessions := tt.test(ctrl, queries, commands, channel)
+			repo := es_repo_mock.NewRepo(t).ExpectFilterEvents(oidcSessions...)
+			if len(oidcSessions) > 0 {
+				repo.ExpectFilterEvents()
+			}
+			notifier := &backChannelLogoutNotifier{
+				commands: commands,
+				queries: NewNotificationQueries(
+					queries,
+					eventstore.NewEventstore(&eventstore.Config{Querier: repo.MockQuerier}),
+					externalDomain,
+					externalPort,
+					externalSecure,
+					"",
+					nil,
+					nil,
+					nil,
+				),
+				channels:    &channels{Chain: *senders.ChainChannels(channel)},
+				keyEncAlg:   keyEncryptionAlgorithm(ctrl),
+				idGenerator: idGenerator,
+			}
+			stmt, err := notifier.reduceSessionTerminated(&session.TerminateEvent{
+				BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+					AggregateID:   sessionID,
+					AggregateType: session.AggregateType,
+					ResourceOwner: sql.NullString{String: orgID},
+					CreationDate:  time.Now().UTC(),
+				}),
+				TriggeredAtOrigin: eventOrigin,
+			})
+			require.NoError(t, err)
+			err = stmt.Execute(nil, "")
+			require.ErrorIs(t, err, tt.wantErr)
+			if err != nil {
+				// the failure is only recorded, once the handler skips the event
+				stmt.Skipped(err)
+			}
+		})
+	}
+}
+
+func Test_groupByClient(t *testing.T) {
+	sessions := []*oidcSessionToLogout{
+		{id: "1", clientID: "client1", sessionID: "session1"},
+		{id: "2", clientID: "client2", sessionID: "session1"},
+		{id: "3", clientID: "client1", sessionID: "session1"},
+		{id: "4", clientID: "client1", sessionID: "session2"},
+	}
+	assert.Equal(t, [][]*oidcSessionToLogout{
+		{sessions[0], sessions[2]},
+		{sessions[1]},
+		{sessions[3]},
+	}, groupByClient(sessions))
+}
+
+func oidcSessionAddedEvent(sessionID string) eventstore.Event {
+	event := oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate(oidcSessionID, orgID).Aggregate,
+		userID, orgID, sessionID, clientID, []string{clientID}, []string{"openid"},
+		[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, time.Now(), "", nil, nil,
+		"",
+	)
+	data, _ := eventstore.EventData(event)
+	return &repository.Event{
+		Typ:           event.Type(),
+		Data:          data,
+		Version:       event.Aggregate().Version,
+		AggregateID:   event.Aggregate().ID,
+		AggregateType: event.Aggregate().Type,
+		ResourceOwner: sql.NullString{String: orgID, Valid: true},
+	}
+}
+
+type testPrivateKey struct {
+	key *crypto.CryptoValue
+}
+
+func (k *testPrivateKey) ID() string               { return signingKeyID }
+func (k *testPrivateKey) Algorithm() string        { return string(jose.RS256) }
+func (k *testPrivateKey) Use() domain.KeyUsage     { return domain.KeyUsageSigning }
+func (k *testPrivateKey) Sequence() uint64         { return 1 }
+func (k *testPrivateKey) Expiry() time.Time        { return time.Now().Add(time.Hour) }
+func (k *testPrivateKey) Key() *crypto.CryptoValue { return k.key }
+
+var testSigningKey, testPublicKey, _ = crypto.GenerateKeyPair(2048)
+
+func keyEncryptionAlgorithm(ctrl *gomock.Controller) crypto.EncryptionAlgorithm {
+	encAlg := crypto.NewMockEncryptionAlgorithm(ctrl)
+	encAlg.EXPECT().Algorithm().AnyTimes().Return("enc")
+	encAlg.EXPECT().EncryptionKeyID().AnyTimes().Return("id")
+	encAlg.EXPECT().DecryptionKeyIDs().AnyTimes().Return([]string{"id"})
+	encAlg.EXPECT().Decrypt(gomock.Any(), gomock.Any()).AnyTimes().Return(crypto.PrivateKeyToBytes(testSigningKey), nil)
+	return encAlg
+}
+
+func expectSigningKey(queries *mock.MockQueries) {
+	queries.EXPECT().ActivePrivateSigningKey(gomock.Any(), gomock.Any()).Return(&query.PrivateKeys{
+		Keys: []query.PrivateKey{
+			&testPrivateKey{key: &crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "enc",
+				KeyID:      "id",
+				Crypted:    []byte("key"),
+			}},
+		},
+	}, nil)
+}
+
+func verifyLogoutToken(t *testing.T, token string) *LogoutTokenClaims {
+	jws, err := jose.ParseSigned(token, []jose.SignatureAlgorithm{jose.RS256})
+	require.NoError(t, err)
+	assert.Equal(t, signingKeyID, jws.Signatures[0].Header.KeyID)
+	assert.Equal(t, logoutTokenType, jws.Signatures[0].Header.ExtraHeaders[jose.HeaderType])
+	payload, err := jws.Verify(testPublicKey)
+	require.NoError(t, err)
+	claims := new(LogoutTokenClaims)
+	require.NoError(t, json.Unmarshal(payload, claims))
+	return claims
+}
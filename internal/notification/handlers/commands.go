@@ -2,7 +2,10 @@ package handlers
 
 import (
 	"context"
+	"time"
 
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/repository/milestone"
 	"github.com/zitadel/zitadel/internal/repository/quota"
 )
@@ -19,6 +22,16 @@ type Commands interface {
 	HumanPasswordlessInitCodeSent(ctx context.Context, userID, resourceOwner, codeID string) error
 	PasswordChangeSent(ctx context.Context, orgID, userID string) error
 	HumanPhoneVerificationCodeSent(ctx context.Context, orgID, userID string) error
+	SchemaUserEmailCodeSent(ctx context.Context, resourceOwner, id string) error
+	SchemaUserPhoneCodeSent(ctx context.Context, resourceOwner, id string) error
+	SchemaUserPasswordCodeSent(ctx context.Context, resourceOwner, id string) error
 	UsageNotificationSent(ctx context.Context, dueEvent *quota.NotificationDueEvent) error
 	MilestonePushed(ctx context.Context, msType milestone.Type, endpoints []string, primaryDomain string) error
+	BackChannelLogoutSent(ctx context.Context, oidcSessionID, resourceOwner string) error
+	BackChannelLogoutFailed(ctx context.Context, oidcSessionID, resourceOwner string, sendErr error) error
+	TerminateSAMLSession(ctx context.Context, samlSessionID, resourceOwner string) error
+	RequestNotification(ctx context.Context, request *command.NotificationRequest) (domain.NotificationState, error)
+	NotificationSent(ctx context.Context, id, resourceOwner string) error
+	NotificationFailed(ctx context.Context, id, resourceOwner string, sendErr error, retryAt time.Time) error
+	NotificationDeadLettered(ctx context.Context, id, resourceOwner string, reason error) error
 }
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpsms"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GetActiveSMSConfigs reads the active iam SMS provider configs ordered by their priority
+func (n *NotificationQueries) GetActiveSMSConfigs(ctx context.Context) ([]*sms.Config, error) {
+	active, err := query.NewSMSProviderStateQuery(domain.SMSConfigStateActive)
+	if err != nil {
+		return nil, err
+	}
+	configs, err := n.SearchSMSConfigs(ctx, &query.SMSConfigsSearchQueries{
+		SearchRequest: query.SearchRequest{
+			SortingColumn: query.SMSConfigColumnPriority,
+			Asc:           true,
+		},
+		Queries: []query.SearchQuery{active},
+	})
+	if err != nil {
+		return nil, err
+	}
+	smsConfigs := make([]*sms.Config, 0, len(configs.Configs))
+	for _, config := range configs.Configs {
+		smsConfig, err := n.smsConfig(config)
+		if err != nil {
+			logging.WithFields("sms_config_id", config.ID).WithError(err).Warn("unable to read sms provider config")
+			continue
+		}
+		smsConfigs = append(smsConfigs, smsConfig)
+	}
+	if len(smsConfigs) == 0 {
+		return nil, zerrors.ThrowNotFound(nil, "HANDLER-8nfow", "Errors.SMSConfig.NotFound")
+	}
+	return smsConfigs, nil
+}
+
+func (n *NotificationQueries) smsConfig(config *query.SMSConfig) (*sms.Config, error) {
+	smsConfig := &sms.Config{
+		ProviderConfig: &sms.Provider{
+			ID:       config.ID,
+			Priority: config.Priority,
+		},
+	}
+	switch {
+	case config.TwilioConfig != nil:
+		token, err := crypto.DecryptString(config.TwilioConfig.Token, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		smsConfig.TwilioConfig = &twilio.Config{
+			SID:          config.TwilioConfig.SID,
+			Token:        token,
+			SenderNumber: config.TwilioConfig.SenderNumber,
+		}
+	case config.HTTPConfig != nil:
+		var authHeaderValue string
+		if config.HTTPConfig.AuthHeaderValue != nil {
+			value, err := crypto.DecryptString(config.HTTPConfig.AuthHeaderValue, n.SMSTokenCrypto)
+			if err != nil {
+				return nil, err
+			}
+			authHeaderValue = value
+		}
+		smsConfig.HTTPConfig = &httpsms.Config{
+			Endpoint:        config.HTTPConfig.Endpoint,
+			Method:          config.HTTPConfig.Method,
+			BodyTemplate:    config.HTTPConfig.BodyTemplate,
+			AuthHeaderName:  config.HTTPConfig.AuthHeaderName,
+			AuthHeaderValue: authHeaderValue,
+			SenderNumber:    config.HTTPConfig.SenderNumber,
+		}
+	case config.VonageConfig != nil:
+		secret, err := crypto.DecryptString(config.VonageConfig.APISecret, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		smsConfig.VonageConfig = &vonage.Config{
+			APIKey:       config.VonageConfig.APIKey,
+			APISecret:    secret,
+			SenderNumber: config.VonageConfig.SenderNumber,
+		}
+	case config.MessageBirdConfig != nil:
+		accessKey, err := crypto.DecryptString(config.MessageBirdConfig.AccessKey, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		smsConfig.MessageBirdConfig = &messagebird.Config{
+			AccessKey:  accessKey,
+			Originator: config.MessageBirdConfig.Originator,
+		}
+	default:
+		return nil, zerrors.ThrowNotFound(nil, "HANDLER-Qm2Ue7cTzs", "Errors.SMSConfig.NotFound")
+	}
+	return smsConfig, nil
+}
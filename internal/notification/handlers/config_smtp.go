@@ -6,18 +6,39 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-// GetSMTPConfig reads the iam SMTP provider config
-func (n *NotificationQueries) GetSMTPConfig(ctx context.Context) (*smtp.Config, error) {
-	config, err := n.SMTPConfigActive(ctx, authz.GetInstance(ctx).InstanceID())
-	if err != nil {
-		return nil, err
+// GetSMTPConfig reads the SMTP provider config used for mails to users of the resourceOwner.
+// An active config of the organization is preferred over the active config of the instance.
+func (n *NotificationQueries) GetSMTPConfig(ctx context.Context, resourceOwner string) (*smtp.Config, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if resourceOwner != "" && resourceOwner != instanceID {
+		config, err := n.OrgSMTPConfigActive(ctx, resourceOwner)
+		if err == nil {
+			return n.smtpConfig(config)
+		}
+		if !zerrors.IsNotFound(err) {
+			return nil, err
+		}
 	}
-	password, err := crypto.DecryptString(config.Password, n.SMTPPasswordCrypto)
+	config, err := n.SMTPConfigActive(ctx, instanceID)
 	if err != nil {
 		return nil, err
 	}
+	return n.smtpConfig(config)
+}
+
+func (n *NotificationQueries) smtpConfig(config *query.SMTPConfig) (*smtp.Config, error) {
+	var password string
+	if config.Password != nil {
+		var err error
+		password, err = crypto.DecryptString(config.Password, n.SMTPPasswordCrypto)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &smtp.Config{
 		Description:    config.Description,
 		From:           config.SenderAddress,
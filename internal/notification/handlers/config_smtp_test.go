@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationQueries_GetSMTPConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		resourceOwner string
+		expect        func(queries *mock.MockQueries)
+		want          *smtp.Config
+		wantErr       func(error) bool
+	}{
+		{
+			name:          "instance config",
+			resourceOwner: "instanceID",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().SMTPConfigActive(gomock.Any(), "instanceID").Return(&query.SMTPConfig{
+					SenderAddress: "instance@domain.ch",
+					Host:          "instance:587",
+				}, nil)
+			},
+			want: &smtp.Config{
+				From: "instance@domain.ch",
+				SMTP: smtp.SMTP{Host: "instance:587"},
+			},
+		},
+		{
+			name:          "org config preferred",
+			resourceOwner: "orgID",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().OrgSMTPConfigActive(gomock.Any(), "orgID").Return(&query.SMTPConfig{
+					SenderAddress: "org@org.ch",
+					Host:          "org:587",
+				}, nil)
+			},
+			want: &smtp.Config{
+				From: "org@org.ch",
+				SMTP: smtp.SMTP{Host: "org:587"},
+			},
+		},
+		{
+			name:          "no org config, instance fallback",
+			resourceOwner: "orgID",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().OrgSMTPConfigActive(gomock.Any(), "orgID").Return(nil, zerrors.ThrowNotFound(nil, "QUERY-fwofw", "Errors.SMTPConfig.NotFound"))
+				queries.EXPECT().SMTPConfigActive(gomock.Any(), "instanceID").Return(&query.SMTPConfig{
+					SenderAddress: "instance@domain.ch",
+					Host:          "instance:587",
+				}, nil)
+			},
+			want: &smtp.Config{
+				From: "instance@domain.ch",
+				SMTP: smtp.SMTP{Host: "instance:587"},
+			},
+		},
+		{
+			name:          "org config error",
+			resourceOwner: "orgID",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().OrgSMTPConfigActive(gomock.Any(), "orgID").Return(nil, zerrors.ThrowInternal(nil, "QUERY-9k87F", "Errors.Internal"))
+			},
+			wantErr: zerrors.IsInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := mock.NewMockQueries(gomock.NewController(t))
+			tt.expect(queries)
+			n := &NotificationQueries{Queries: queries}
+			got, err := n.GetSMTPConfig(authz.WithInstanceID(context.Background(), "instanceID"), tt.resourceOwner)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
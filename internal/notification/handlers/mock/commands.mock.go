@@ -12,7 +12,10 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	command "github.com/zitadel/zitadel/internal/command"
+	domain "github.com/zitadel/zitadel/internal/domain"
 	milestone "github.com/zitadel/zitadel/internal/repository/milestone"
 	quota "github.com/zitadel/zitadel/internal/repository/quota"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +44,34 @@ func (m *MockCommands) EXPECT() *MockCommandsMockRecorder {
 	return m.recorder
 }
 
+// BackChannelLogoutFailed mocks base method.
+func (m *MockCommands) BackChannelLogoutFailed(arg0 context.Context, arg1, arg2 string, arg3 error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackChannelLogoutFailed", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackChannelLogoutFailed indicates an expected call of BackChannelLogoutFailed.
+func (mr *MockCommandsMockRecorder) BackChannelLogoutFailed(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackChannelLogoutFailed", reflect.TypeOf((*MockCommands)(nil).BackChannelLogoutFailed), arg0, arg1, arg2, arg3)
+}
+
+// BackChannelLogoutSent mocks base method.
+func (m *MockCommands) BackChannelLogoutSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackChannelLogoutSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackChannelLogoutSent indicates an expected call of BackChannelLogoutSent.
+func (mr *MockCommandsMockRecorder) BackChannelLogoutSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackChannelLogoutSent", reflect.TypeOf((*MockCommands)(nil).BackChannelLogoutSent), arg0, arg1, arg2)
+}
+
 // HumanEmailVerificationCodeSent mocks base method.
 func (m *MockCommands) HumanEmailVerificationCodeSent(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -139,6 +170,48 @@ func (mr *MockCommandsMockRecorder) MilestonePushed(arg0, arg1, arg2, arg3 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MilestonePushed", reflect.TypeOf((*MockCommands)(nil).MilestonePushed), arg0, arg1, arg2, arg3)
 }
 
+// NotificationDeadLettered mocks base method.
+func (m *MockCommands) NotificationDeadLettered(arg0 context.Context, arg1, arg2 string, arg3 error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationDeadLettered", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationDeadLettered indicates an expected call of NotificationDeadLettered.
+func (mr *MockCommandsMockRecorder) NotificationDeadLettered(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationDeadLettered", reflect.TypeOf((*MockCommands)(nil).NotificationDeadLettered), arg0, arg1, arg2, arg3)
+}
+
+// NotificationFailed mocks base method.
+func (m *MockCommands) NotificationFailed(arg0 context.Context, arg1, arg2 string, arg3 error, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationFailed", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationFailed indicates an expected call of NotificationFailed.
+func (mr *MockCommandsMockRecorder) NotificationFailed(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationFailed", reflect.TypeOf((*MockCommands)(nil).NotificationFailed), arg0, arg1, arg2, arg3, arg4)
+}
+
+// NotificationSent mocks base method.
+func (m *MockCommands) NotificationSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationSent indicates an expected call of NotificationSent.
+func (mr *MockCommandsMockRecorder) NotificationSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationSent", reflect.TypeOf((*MockCommands)(nil).NotificationSent), arg0, arg1, arg2)
+}
+
 // OTPEmailSent mocks base method.
 func (m *MockCommands) OTPEmailSent(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -195,6 +268,77 @@ func (mr *MockCommandsMockRecorder) PasswordCodeSent(arg0, arg1, arg2 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordCodeSent", reflect.TypeOf((*MockCommands)(nil).PasswordCodeSent), arg0, arg1, arg2)
 }
 
+// RequestNotification mocks base method.
+func (m *MockCommands) RequestNotification(arg0 context.Context, arg1 *command.NotificationRequest) (domain.NotificationState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestNotification", arg0, arg1)
+	ret0, _ := ret[0].(domain.NotificationState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestNotification indicates an expected call of RequestNotification.
+func (mr *MockCommandsMockRecorder) RequestNotification(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestNotification", reflect.TypeOf((*MockCommands)(nil).RequestNotification), arg0, arg1)
+}
+
+// SchemaUserEmailCodeSent mocks base method.
+func (m *MockCommands) SchemaUserEmailCodeSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchemaUserEmailCodeSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SchemaUserEmailCodeSent indicates an expected call of SchemaUserEmailCodeSent.
+func (mr *MockCommandsMockRecorder) SchemaUserEmailCodeSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaUserEmailCodeSent", reflect.TypeOf((*MockCommands)(nil).SchemaUserEmailCodeSent), arg0, arg1, arg2)
+}
+
+// SchemaUserPasswordCodeSent mocks base method.
+func (m *MockCommands) SchemaUserPasswordCodeSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchemaUserPasswordCodeSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SchemaUserPasswordCodeSent indicates an expected call of SchemaUserPasswordCodeSent.
+func (mr *MockCommandsMockRecorder) SchemaUserPasswordCodeSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaUserPasswordCodeSent", reflect.TypeOf((*MockCommands)(nil).SchemaUserPasswordCodeSent), arg0, arg1, arg2)
+}
+
+// SchemaUserPhoneCodeSent mocks base method.
+func (m *MockCommands) SchemaUserPhoneCodeSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchemaUserPhoneCodeSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SchemaUserPhoneCodeSent indicates an expected call of SchemaUserPhoneCodeSent.
+func (mr *MockCommandsMockRecorder) SchemaUserPhoneCodeSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaUserPhoneCodeSent", reflect.TypeOf((*MockCommands)(nil).SchemaUserPhoneCodeSent), arg0, arg1, arg2)
+}
+
+// TerminateSAMLSession mocks base method.
+func (m *MockCommands) TerminateSAMLSession(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateSAMLSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TerminateSAMLSession indicates an expected call of TerminateSAMLSession.
+func (mr *MockCommandsMockRecorder) TerminateSAMLSession(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateSAMLSession", reflect.TypeOf((*MockCommands)(nil).TerminateSAMLSession), arg0, arg1, arg2)
+}
+
 // UsageNotificationSent mocks base method.
 func (m *MockCommands) UsageNotificationSent(arg0 context.Context, arg1 *quota.NotificationDueEvent) error {
 	m.ctrl.T.Helper()
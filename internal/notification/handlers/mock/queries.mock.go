@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/zitadel/zitadel/internal/domain"
 	query "github.com/zitadel/zitadel/internal/query"
@@ -42,6 +43,21 @@ func (m *MockQueries) EXPECT() *MockQueriesMockRecorder {
 	return m.recorder
 }
 
+// ActiveCertificates mocks base method.
+func (m *MockQueries) ActiveCertificates(arg0 context.Context, arg1 time.Time, arg2 domain.KeyUsage) (*query.Certificates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveCertificates", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*query.Certificates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveCertificates indicates an expected call of ActiveCertificates.
+func (mr *MockQueriesMockRecorder) ActiveCertificates(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveCertificates", reflect.TypeOf((*MockQueries)(nil).ActiveCertificates), arg0, arg1, arg2)
+}
+
 // ActiveLabelPolicyByOrg mocks base method.
 func (m *MockQueries) ActiveLabelPolicyByOrg(arg0 context.Context, arg1 string, arg2 bool) (*query.LabelPolicy, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +73,36 @@ func (mr *MockQueriesMockRecorder) ActiveLabelPolicyByOrg(arg0, arg1, arg2 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveLabelPolicyByOrg", reflect.TypeOf((*MockQueries)(nil).ActiveLabelPolicyByOrg), arg0, arg1, arg2)
 }
 
+// ActivePrivateSigningKey mocks base method.
+func (m *MockQueries) ActivePrivateSigningKey(arg0 context.Context, arg1 time.Time) (*query.PrivateKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivePrivateSigningKey", arg0, arg1)
+	ret0, _ := ret[0].(*query.PrivateKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivePrivateSigningKey indicates an expected call of ActivePrivateSigningKey.
+func (mr *MockQueriesMockRecorder) ActivePrivateSigningKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivePrivateSigningKey", reflect.TypeOf((*MockQueries)(nil).ActivePrivateSigningKey), arg0, arg1)
+}
+
+// AppByID mocks base method.
+func (m *MockQueries) AppByID(arg0 context.Context, arg1 string) (*query.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppByID", arg0, arg1)
+	ret0, _ := ret[0].(*query.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppByID indicates an expected call of AppByID.
+func (mr *MockQueriesMockRecorder) AppByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppByID", reflect.TypeOf((*MockQueries)(nil).AppByID), arg0, arg1)
+}
+
 // CustomTextListByTemplate mocks base method.
 func (m *MockQueries) CustomTextListByTemplate(arg0 context.Context, arg1, arg2 string, arg3 bool) (*query.CustomTexts, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +147,21 @@ func (mr *MockQueriesMockRecorder) GetInstanceRestrictions(arg0 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceRestrictions", reflect.TypeOf((*MockQueries)(nil).GetInstanceRestrictions), arg0)
 }
 
+// GetNotifySchemaUserByID mocks base method.
+func (m *MockQueries) GetNotifySchemaUserByID(arg0 context.Context, arg1 bool, arg2 string) (*query.NotifyUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifySchemaUserByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*query.NotifyUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifySchemaUserByID indicates an expected call of GetNotifySchemaUserByID.
+func (mr *MockQueriesMockRecorder) GetNotifySchemaUserByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifySchemaUserByID", reflect.TypeOf((*MockQueries)(nil).GetNotifySchemaUserByID), arg0, arg1, arg2)
+}
+
 // GetNotifyUserByID mocks base method.
 func (m *MockQueries) GetNotifyUserByID(arg0 context.Context, arg1 bool, arg2 string) (*query.NotifyUser, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +177,21 @@ func (mr *MockQueriesMockRecorder) GetNotifyUserByID(arg0, arg1, arg2 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifyUserByID", reflect.TypeOf((*MockQueries)(nil).GetNotifyUserByID), arg0, arg1, arg2)
 }
 
+// GetOIDCClientByID mocks base method.
+func (m *MockQueries) GetOIDCClientByID(arg0 context.Context, arg1 string, arg2 bool) (*query.OIDCClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOIDCClientByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*query.OIDCClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOIDCClientByID indicates an expected call of GetOIDCClientByID.
+func (mr *MockQueriesMockRecorder) GetOIDCClientByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOIDCClientByID", reflect.TypeOf((*MockQueries)(nil).GetOIDCClientByID), arg0, arg1, arg2)
+}
+
 // MailTemplateByOrg mocks base method.
 func (m *MockQueries) MailTemplateByOrg(arg0 context.Context, arg1 string, arg2 bool) (*query.MailTemplate, error) {
 	m.ctrl.T.Helper()
@@ -161,24 +237,19 @@ func (mr *MockQueriesMockRecorder) NotificationProviderByIDAndType(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationProviderByIDAndType", reflect.TypeOf((*MockQueries)(nil).NotificationProviderByIDAndType), arg0, arg1, arg2)
 }
 
-// SMSProviderConfig mocks base method.
-func (m *MockQueries) SMSProviderConfig(arg0 context.Context, arg1 ...query.SearchQuery) (*query.SMSConfig, error) {
+// OrgSMTPConfigActive mocks base method.
+func (m *MockQueries) OrgSMTPConfigActive(arg0 context.Context, arg1 string) (*query.SMTPConfig, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{arg0}
-	for _, a := range arg1 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SMSProviderConfig", varargs...)
-	ret0, _ := ret[0].(*query.SMSConfig)
+	ret := m.ctrl.Call(m, "OrgSMTPConfigActive", arg0, arg1)
+	ret0, _ := ret[0].(*query.SMTPConfig)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SMSProviderConfig indicates an expected call of SMSProviderConfig.
-func (mr *MockQueriesMockRecorder) SMSProviderConfig(arg0 any, arg1 ...any) *gomock.Call {
+// OrgSMTPConfigActive indicates an expected call of OrgSMTPConfigActive.
+func (mr *MockQueriesMockRecorder) OrgSMTPConfigActive(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{arg0}, arg1...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMSProviderConfig", reflect.TypeOf((*MockQueries)(nil).SMSProviderConfig), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgSMTPConfigActive", reflect.TypeOf((*MockQueries)(nil).OrgSMTPConfigActive), arg0, arg1)
 }
 
 // SMTPConfigActive mocks base method.
@@ -226,6 +297,36 @@ func (mr *MockQueriesMockRecorder) SearchMilestones(arg0, arg1, arg2 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMilestones", reflect.TypeOf((*MockQueries)(nil).SearchMilestones), arg0, arg1, arg2)
 }
 
+// SearchNotificationRequests mocks base method.
+func (m *MockQueries) SearchNotificationRequests(arg0 context.Context, arg1 bool, arg2 *query.NotificationRequestSearchQueries) (*query.NotificationRequests, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchNotificationRequests", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*query.NotificationRequests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchNotificationRequests indicates an expected call of SearchNotificationRequests.
+func (mr *MockQueriesMockRecorder) SearchNotificationRequests(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNotificationRequests", reflect.TypeOf((*MockQueries)(nil).SearchNotificationRequests), arg0, arg1, arg2)
+}
+
+// SearchSMSConfigs mocks base method.
+func (m *MockQueries) SearchSMSConfigs(arg0 context.Context, arg1 *query.SMSConfigsSearchQueries) (*query.SMSConfigs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSMSConfigs", arg0, arg1)
+	ret0, _ := ret[0].(*query.SMSConfigs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSMSConfigs indicates an expected call of SearchSMSConfigs.
+func (mr *MockQueriesMockRecorder) SearchSMSConfigs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSMSConfigs", reflect.TypeOf((*MockQueries)(nil).SearchSMSConfigs), arg0, arg1)
+}
+
 // SessionByID mocks base method.
 func (m *MockQueries) SessionByID(arg0 context.Context, arg1 bool, arg2, arg3 string) (*query.Session, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/pseudo"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	NotificationWorkerProjectionTable = "projections.notification_worker"
+)
+
+var (
+	errMaxAttemptsReached   = errors.New("maximum number of attempts reached")
+	errTriggerEventNotFound = errors.New("triggering event not found")
+	errUnsupportedEvent     = errors.New("triggering event does not support notifications")
+	errNotificationObsolete = errors.New("notification not required anymore, e.g. because the code expired")
+)
+
+type NotificationWorkerConfig struct {
+	// MaxAttempts is the number of delivery attempts before a notification is dead lettered
+	MaxAttempts uint8
+	// MinRetryDelay is the delay after the first failed attempt
+	MinRetryDelay time.Duration
+	// MaxRetryDelay caps the exponentially growing delay between two attempts
+	MaxRetryDelay time.Duration
+	// RetryDelayFactor is multiplied to the delay after each failed attempt
+	RetryDelayFactor float32
+	// Limit is the maximum number of notifications retried per instance and run
+	Limit uint64
+}
+
+// retryDelay returns the delay before the next attempt after the given failed attempt.
+func (c NotificationWorkerConfig) retryDelay(attempt uint8) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	factor := math.Max(float64(c.RetryDelayFactor), 1)
+	delay := time.Duration(float64(c.MinRetryDelay) * math.Pow(factor, float64(attempt-1)))
+	if c.MaxRetryDelay > 0 && (delay > c.MaxRetryDelay || delay < 0) {
+		return c.MaxRetryDelay
+	}
+	return delay
+}
+
+type notificationWorker struct {
+	cfg      NotificationWorkerConfig
+	commands Commands
+	queries  *NotificationQueries
+	notifier *userNotifier
+	now      func() time.Time
+}
+
+// NewNotificationWorker returns a handler which periodically retries failed notifications
+// and dead letters them after the configured number of attempts.
+func NewNotificationWorker(
+	ctx context.Context,
+	workerCfg NotificationWorkerConfig,
+	handlerCfg handler.Config,
+	commands Commands,
+	queries *NotificationQueries,
+	channels types.ChannelChains,
+	otpEmailTmpl string,
+) *handler.Handler {
+	worker := &notificationWorker{
+		cfg:      workerCfg,
+		commands: commands,
+		queries:  queries,
+		notifier: &userNotifier{
+			commands:     commands,
+			queries:      queries,
+			channels:     channels,
+			otpEmailTmpl: otpEmailTmpl,
+			retryConfig:  workerCfg,
+		},
+		now: time.Now,
+	}
+	handlerCfg.TriggerWithoutEvents = worker.retryNotifications
+	return handler.NewHandler(
+		ctx,
+		&handlerCfg,
+		worker,
+	)
+}
+
+func (w *notificationWorker) Name() string {
+	return NotificationWorkerProjectionTable
+}
+
+func (w *notificationWorker) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{{
+		Aggregate: pseudo.AggregateType,
+		EventReducers: []handler.EventReducer{{
+			Event:  pseudo.ScheduledEventType,
+			Reduce: w.retryNotifications,
+		}},
+	}}
+}
+
+func (w *notificationWorker) retryNotifications(event eventstore.Event) (*handler.Statement, error) {
+	scheduledEvent, ok := event.(*pseudo.ScheduledEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ohr7a", "reduce.wrong.event.type %s", event.Type())
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		for _, instanceID := range scheduledEvent.InstanceIDs {
+			if err := w.retryInstanceNotifications(authz.WithInstanceID(context.Background(), instanceID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+func (w *notificationWorker) retryInstanceNotifications(ctx context.Context) error {
+	failed, err := query.NewNotificationRequestStateSearchQuery(domain.NotificationStateFailed)
+	if err != nil {
+		return err
+	}
+	due, err := query.NewNotificationRequestDueSearchQuery(w.now())
+	if err != nil {
+		return err
+	}
+	requests, err := w.queries.SearchNotificationRequests(ctx, true, &query.NotificationRequestSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Limit:         w.cfg.Limit,
+			SortingColumn: query.NotificationRequestColumnNextAttempt,
+			Asc:           true,
+		},
+		Queries: []query.SearchQuery{failed, due},
+	})
+	if err != nil {
+		return err
+	}
+	for _, request := range requests.NotificationRequests {
+		if err = w.retryNotification(ctx, request); err != nil {
+			logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "notification", request.ID).WithError(err).Warn("unable to retry notification")
+		}
+	}
+	return nil
+}
+
+func (w *notificationWorker) retryNotification(ctx context.Context, request *query.NotificationRequest) error {
+	ctx = HandlerContext(&eventstore.Aggregate{
+		InstanceID:    authz.GetInstance(ctx).InstanceID(),
+		ResourceOwner: request.ResourceOwner,
+	})
+	if request.Attempts >= w.cfg.MaxAttempts {
+		return w.commands.NotificationDeadLettered(ctx, request.ID, request.ResourceOwner, fmt.Errorf("%w: %s", errMaxAttemptsReached, request.LastError))
+	}
+	event, err := w.queries.notificationTriggerEvent(ctx, request)
+	if err != nil {
+		return err
+	}
+	if event == nil {
+		return w.commands.NotificationDeadLettered(ctx, request.ID, request.ResourceOwner, errTriggerEventNotFound)
+	}
+	build, ok := w.notifier.notificationBuilders()[event.Type()]
+	if !ok {
+		return w.commands.NotificationDeadLettered(ctx, request.ID, request.ResourceOwner, errUnsupportedEvent)
+	}
+	ctx = HandlerContext(event.Aggregate())
+	n, err := build(ctx, event)
+	if err != nil {
+		return err
+	}
+	if n == nil {
+		return w.commands.NotificationDeadLettered(ctx, request.ID, request.ResourceOwner, errNotificationObsolete)
+	}
+	alreadyHandled, err := n.alreadyHandled(ctx)
+	if err != nil {
+		return err
+	}
+	if alreadyHandled {
+		return w.commands.NotificationDeadLettered(ctx, request.ID, request.ResourceOwner, errNotificationObsolete)
+	}
+	return w.notifier.deliver(ctx, request.ID, n, request.Attempts+1)
+}
+
+// notificationTriggerEvent returns the event which triggered the notification or nil if it does not exist anymore.
+func (n *NotificationQueries) notificationTriggerEvent(ctx context.Context, request *query.NotificationRequest) (eventstore.Event, error) {
+	events, err := n.es.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(authz.GetInstance(ctx).InstanceID()).
+		SequenceGreater(request.TriggerSequence-1).
+		Limit(1).
+		AddQuery().
+		AggregateTypes(request.TriggerAggregateType).
+		AggregateIDs(request.TriggerAggregateID).
+		EventTypes(request.TriggerEventType).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+	return events[0], nil
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationWorkerConfig_retryDelay(t *testing.T) {
+	cfg := NotificationWorkerConfig{
+		MinRetryDelay:    time.Second,
+		MaxRetryDelay:    time.Minute,
+		RetryDelayFactor: 2,
+	}
+	tests := []struct {
+		name    string
+		cfg     NotificationWorkerConfig
+		attempt uint8
+		want    time.Duration
+	}{
+		{
+			name:    "first attempt",
+			cfg:     cfg,
+			attempt: 1,
+			want:    time.Second,
+		},
+		{
+			name:    "third attempt",
+			cfg:     cfg,
+			attempt: 3,
+			want:    4 * time.Second,
+		},
+		{
+			name:    "capped by max delay",
+			cfg:     cfg,
+			attempt: 10,
+			want:    time.Minute,
+		},
+		{
+			name:    "overflow capped by max delay",
+			cfg:     cfg,
+			attempt: 255,
+			want:    time.Minute,
+		},
+		{
+			name: "factor below 1",
+			cfg: NotificationWorkerConfig{
+				MinRetryDelay:    time.Second,
+				RetryDelayFactor: 0.5,
+			},
+			attempt: 3,
+			want:    time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.cfg.retryDelay(tt.attempt))
+		})
+	}
+}
+
+func Test_notificationWorker_retryNotification(t *testing.T) {
+	const notificationID = "user1-15"
+	tests := []struct {
+		name    string
+		request *query.NotificationRequest
+		test    func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands, *es_repo_mock.MockRepository) crypto.EncryptionAlgorithm
+		wantErr error
+	}{
+		{
+			name: "max attempts reached, dead lettered",
+			request: &query.NotificationRequest{
+				ID:            notificationID,
+				ResourceOwner: orgID,
+				Attempts:      3,
+				LastError:     "failed",
+			},
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, repo *es_repo_mock.MockRepository) crypto.EncryptionAlgorithm {
+				commands.EXPECT().NotificationDeadLettered(gomock.Any(), notificationID, orgID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, reason error) error {
+						assert.ErrorIs(t, reason, errMaxAttemptsReached)
+						return nil
+					})
+				return nil
+			},
+		},
+		{
+			name:    "trigger event not found, dead lettered",
+			request: initCodeNotificationRequest(notificationID),
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, repo *es_repo_mock.MockRepository) crypto.EncryptionAlgorithm {
+				repo.ExpectFilterEvents()
+				commands.EXPECT().NotificationDeadLettered(gomock.Any(), notificationID, orgID, errTriggerEventNotFound).Return(nil)
+				return nil
+			},
+		},
+		{
+			name:    "code expired, dead lettered",
+			request: initCodeNotificationRequest(notificationID),
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, repo *es_repo_mock.MockRepository) crypto.EncryptionAlgorithm {
+				_, code := cryptoValue(t, ctrl, "testcode")
+				repo.ExpectFilterEvents(initCodeAddedRepoEvent(code, time.Now().Add(-2*time.Hour)))
+				commands.EXPECT().NotificationDeadLettered(gomock.Any(), notificationID, orgID, errNotificationObsolete).Return(nil)
+				return nil
+			},
+		},
+		{
+			name:    "delivery failed again",
+			request: initCodeNotificationRequest(notificationID),
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, repo *es_repo_mock.MockRepository) crypto.EncryptionAlgorithm {
+				codeAlg, code := cryptoValue(t, ctrl, "testcode")
+				repo.ExpectFilterEvents(initCodeAddedRepoEvent(code, time.Now())).ExpectFilterEvents()
+				queries.EXPECT().ActiveLabelPolicyByOrg(gomock.Any(), orgID, false).Return(nil, zerrors.ThrowInternal(nil, "QUERY-Ahl3b", "failed"))
+				commands.EXPECT().NotificationFailed(gomock.Any(), notificationID, orgID, zerrors.ThrowInternal(nil, "QUERY-Ahl3b", "failed"), gomock.Any()).Return(nil)
+				return codeAlg
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			repo := es_repo_mock.NewRepo(t)
+			userDataCrypto := tt.test(ctrl, queries, commands, repo)
+			notificationQueries := NewNotificationQueries(
+				queries,
+				eventstore.NewEventstore(&eventstore.Config{Querier: repo.MockQuerier}),
+				externalDomain,
+				externalPort,
+				externalSecure,
+				"",
+				userDataCrypto,
+				nil,
+				nil,
+			)
+			cfg := NotificationWorkerConfig{
+				MaxAttempts:      3,
+				MinRetryDelay:    time.Second,
+				MaxRetryDelay:    time.Minute,
+				RetryDelayFactor: 2,
+			}
+			w := &notificationWorker{
+				cfg:      cfg,
+				commands: commands,
+				queries:  notificationQueries,
+				notifier: &userNotifier{
+					commands:    commands,
+					queries:     notificationQueries,
+					retryConfig: cfg,
+				},
+				now: time.Now,
+			}
+			err := w.retryNotification(authz.WithInstanceID(context.Background(), testInstanceID), tt.request)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+const testInstanceID = "instance1"
+
+func initCodeNotificationRequest(id string) *query.NotificationRequest {
+	return &query.NotificationRequest{
+		ID:                   id,
+		ResourceOwner:        orgID,
+		State:                domain.NotificationStateFailed,
+		UserID:               userID,
+		NotificationType:     domain.NotificationTypeEmail,
+		MessageType:          domain.InitCodeMessageType,
+		TriggerAggregateType: user.AggregateType,
+		TriggerAggregateID:   userID,
+		TriggerEventType:     user.HumanInitialCodeAddedType,
+		TriggerSequence:      15,
+		Attempts:             1,
+		LastError:            "failed",
+	}
+}
+
+func initCodeAddedRepoEvent(code *crypto.CryptoValue, creationDate time.Time) eventstore.Event {
+	event := user.NewHumanInitialCodeAddedEvent(context.Background(), &user.NewAggregate(userID, orgID).Aggregate, code, time.Hour, "")
+	data, _ := eventstore.EventData(event)
+	return &repository.Event{
+		Seq:           15,
+		CreationDate:  creationDate,
+		Typ:           event.Type(),
+		Data:          data,
+		Version:       event.Aggregate().Version,
+		AggregateID:   event.Aggregate().ID,
+		AggregateType: event.Aggregate().Type,
+		ResourceOwner: sql.NullString{String: orgID, Valid: true},
+		InstanceID:    testInstanceID,
+	}
+}
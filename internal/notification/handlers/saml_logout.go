@@ -0,0 +1,278 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/key"
+	saml_xml "github.com/zitadel/saml/pkg/provider/xml"
+
+	"github.com/zitadel/zitadel/internal/api/saml/slo"
+	zcrypto "github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/channels/soap"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	SAMLLogoutNotificationsProjectionTable = "projections.notifications_saml_logout"
+)
+
+type samlLogoutNotifier struct {
+	commands  Commands
+	queries   *NotificationQueries
+	channels  types.ChannelChains
+	keyEncAlg zcrypto.EncryptionAlgorithm
+}
+
+func NewSAMLLogoutNotifier(
+	ctx context.Context,
+	config handler.Config,
+	commands Commands,
+	queries *NotificationQueries,
+	channels types.ChannelChains,
+	keyEncAlg zcrypto.EncryptionAlgorithm,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &samlLogoutNotifier{
+		commands:  commands,
+		queries:   queries,
+		channels:  channels,
+		keyEncAlg: keyEncAlg,
+	})
+}
+
+func (*samlLogoutNotifier) Name() string {
+	return SAMLLogoutNotificationsProjectionTable
+}
+
+func (u *samlLogoutNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.TerminateType,
+					Reduce: u.reduceSessionTerminated,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanSignedOutType,
+					Reduce: u.reduceUserSignedOut,
+				},
+			},
+		},
+	}
+}
+
+func (u *samlLogoutNotifier) reduceSessionTerminated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.TerminateEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-eiZ4o", "reduce.wrong.event.type %s", session.TerminateType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		return u.terminateSAMLSessions(ctx, e, map[string]interface{}{
+			"sessionID": e.Aggregate().ID,
+		})
+	}), nil
+}
+
+func (u *samlLogoutNotifier) reduceUserSignedOut(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanSignedOutEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-7NilY", "reduce.wrong.event.type %s", user.HumanSignedOutType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		return u.terminateSAMLSessions(ctx, e, map[string]interface{}{
+			"userID": e.Aggregate().ID,
+			"userAgent": map[string]interface{}{
+				"fingerprint_id": e.UserAgentID,
+			},
+		})
+	}), nil
+}
+
+// terminateSAMLSessions sends a logout request to every service provider with a single logout service using the SOAP binding,
+// which received a SAML response for the SAML sessions matching the provided data.
+// Service providers without SOAP binding are logged out over the front-channel by the SAML provider.
+// SAML sessions, which were already terminated, are skipped, so failed deliveries can be retried by the handler.
+func (u *samlLogoutNotifier) terminateSAMLSessions(ctx context.Context, event eventstore.Event, data map[string]interface{}) error {
+	sessions, err := u.queries.samlSessionsToLogout(ctx, event, data)
+	if err != nil || len(sessions) == 0 {
+		return err
+	}
+	var certAndKey *key.CertificateAndKey
+	var sendErr error
+	for _, samlSession := range sessions {
+		location, err := u.soapLogoutLocation(ctx, samlSession.appID)
+		if err != nil {
+			return err
+		}
+		if location == "" {
+			continue
+		}
+		if certAndKey == nil {
+			certAndKey, err = u.certificateAndKey(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		if err = u.sendLogoutRequest(ctx, event, certAndKey, location, samlSession); err != nil {
+			logging.WithFields("instance", event.Aggregate().InstanceID, "app", samlSession.appID).WithError(err).
+				Warn("unable to send saml logout request")
+			sendErr = err
+			continue
+		}
+		if err = u.commands.TerminateSAMLSession(ctx, samlSession.id, samlSession.resourceOwner); err != nil {
+			return err
+		}
+	}
+	return sendErr
+}
+
+// soapLogoutLocation returns the location of the single logout service with SOAP binding of the app.
+// It returns an empty string if the app does no longer exist or does not provide such a service.
+func (u *samlLogoutNotifier) soapLogoutLocation(ctx context.Context, appID string) (string, error) {
+	app, err := u.queries.AppByID(ctx, appID)
+	if zerrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if app.SAMLConfig == nil {
+		return "", nil
+	}
+	metadata, err := saml_xml.ParseMetadataXmlIntoStruct(app.SAMLConfig.Metadata)
+	if err != nil {
+		logging.WithFields("app", appID).WithError(err).Warn("unable to parse saml metadata")
+		return "", nil
+	}
+	service := slo.ServiceByBinding(slo.Services(metadata), provider.SOAPBinding)
+	if service == nil {
+		return "", nil
+	}
+	return service.Location, nil
+}
+
+func (u *samlLogoutNotifier) sendLogoutRequest(ctx context.Context, event eventstore.Event, certAndKey *key.CertificateAndKey, location string, samlSession *samlSessionToLogout) error {
+	request := slo.NewLogoutRequest(samlSession.issuer, location, samlSession.nameID)
+	if err := slo.SignRequest(request, certAndKey); err != nil {
+		return err
+	}
+	envelope, err := slo.SOAPEnvelope(request)
+	if err != nil {
+		return err
+	}
+	return types.SendSOAP(ctx, soap.Config{CallURL: location, SOAPAction: slo.SOAPAction}, u.channels, envelope, event).WithoutTemplate()
+}
+
+// certificateAndKey returns the latest certificate used to sign the SAML responses,
+// so the service providers can verify the logout requests with the same certificate.
+func (u *samlLogoutNotifier) certificateAndKey(ctx context.Context) (*key.CertificateAndKey, error) {
+	certs, err := u.queries.ActiveCertificates(ctx, time.Now(), domain.KeyUsageSAMLResponseSinging)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs.Certificates) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "HANDL-OYwrK", "no active saml response certificate")
+	}
+	cert := certs.Certificates[len(certs.Certificates)-1]
+	keyData, err := zcrypto.Decrypt(cert.Key(), u.keyEncAlg)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := zcrypto.BytesToPrivateKey(keyData)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := zcrypto.BytesToCertificate(cert.Certificate())
+	if err != nil {
+		return nil, err
+	}
+	return &key.CertificateAndKey{
+		Key:         privateKey,
+		Certificate: certificate,
+	}, nil
+}
+
+type samlSessionToLogout struct {
+	id            string
+	resourceOwner string
+	appID         string
+	issuer        string
+	nameID        string
+}
+
+// samlSessionsToLogout returns the SAML sessions matching the data, which were created before the event
+// and which are neither terminated nor logged out over the front-channel.
+func (n *NotificationQueries) samlSessionsToLogout(ctx context.Context, event eventstore.Event, data map[string]interface{}) ([]*samlSessionToLogout, error) {
+	events, err := n.es.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(event.Aggregate().InstanceID).
+		CreationDateBefore(event.CreatedAt()).
+		AddQuery().
+		AggregateTypes(samlsession.AggregateType).
+		EventTypes(samlsession.AddedType).
+		EventData(data).
+		Builder(),
+	)
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+	sessions := make(map[string]*samlSessionToLogout, len(events))
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		e, ok := event.(*samlsession.AddedEvent)
+		if !ok {
+			continue
+		}
+		sessions[e.Aggregate().ID] = &samlSessionToLogout{
+			id:            e.Aggregate().ID,
+			resourceOwner: e.Aggregate().ResourceOwner,
+			appID:         e.AppID,
+			issuer:        e.Issuer,
+			nameID:        e.NameID,
+		}
+		ids = append(ids, e.Aggregate().ID)
+	}
+	loggedOut, err := n.es.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(event.Aggregate().InstanceID).
+		AddQuery().
+		AggregateTypes(samlsession.AggregateType).
+		AggregateIDs(ids...).
+		EventTypes(
+			samlsession.LogoutRequestedType,
+			samlsession.LogoutSentType,
+			samlsession.TerminatedType,
+		).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range loggedOut {
+		delete(sessions, event.Aggregate().ID)
+	}
+	result := make([]*samlSessionToLogout, 0, len(sessions))
+	for _, id := range ids {
+		if samlSession, ok := sessions[id]; ok {
+			result = append(result, samlSession)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,269 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
+	channel_mock "github.com/zitadel/zitadel/internal/notification/channels/mock"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	samlSessionID   = "V2_samlSession1"
+	samlAppID       = "app1"
+	samlEntityID    = "https://sp.domain/metadata"
+	samlIssuer      = "https://triggered.here/saml/v2/metadata"
+	samlNameID      = "user@domain"
+	samlUserAgentID = "agent1"
+	samlSOAPLogout  = "https://sp.domain/slo/soap"
+
+	samlMetadataRedirect = `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.domain/metadata">
+  <SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://sp.domain/slo"/>
+  </SPSSODescriptor>
+</EntityDescriptor>`
+	samlMetadataSOAP = `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.domain/metadata">
+  <SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://sp.domain/slo"/>
+    <SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:SOAP" Location="https://sp.domain/slo/soap"/>
+  </SPSSODescriptor>
+</EntityDescriptor>`
+)
+
+func Test_samlLogoutNotifier_reduceUserSignedOut(t *testing.T) {
+	tests := []struct {
+		name    string
+		test    func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands, *channel_mock.MockNotificationChannel) (added, loggedOut []eventstore.Event)
+		wantErr error
+	}{
+		{
+			name: "no saml sessions",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, channel *channel_mock.MockNotificationChannel) ([]eventstore.Event, []eventstore.Event) {
+				return nil, nil
+			},
+		},
+		{
+			name: "saml session already logged out",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, channel *channel_mock.MockNotificationChannel) ([]eventstore.Event, []eventstore.Event) {
+				return []eventstore.Event{samlSessionAddedEvent()}, []eventstore.Event{samlSessionEvent(
+					samlsession.NewTerminatedEvent(context.Background(), &samlsession.NewAggregate(samlSessionID, orgID).Aggregate),
+				)}
+			},
+		},
+		{
+			name: "app without soap logout",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, channel *channel_mock.MockNotificationChannel) ([]eventstore.Event, []eventstore.Event) {
+				queries.EXPECT().AppByID(gomock.Any(), samlAppID).Return(&query.App{
+					ID:         samlAppID,
+					SAMLConfig: &query.SAMLApp{Metadata: []byte(samlMetadataRedirect), EntityID: samlEntityID},
+				}, nil)
+				return []eventstore.Event{samlSessionAddedEvent()}, nil
+			},
+		},
+		{
+			name: "app removed",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, channel *channel_mock.MockNotificationChannel) ([]eventstore.Event, []eventstore.Event) {
+				queries.EXPECT().AppByID(gomock.Any(), samlAppID).Return(nil, zerrors.ThrowNotFound(nil, "QUERY-ieR2w", "Errors.Project.App.NotFound"))
+				return []eventstore.Event{samlSessionAddedEvent()}, nil
+			},
+		},
+		{
+			name: "logout request sent",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, channel *channel_mock.MockNotificationChannel) ([]eventstore.Event, []eventstore.Event) {
+				queries.EXPECT().AppByID(gomock.Any(), samlAppID).Return(&query.App{
+					ID:         samlAppID,
+					SAMLConfig: &query.SAMLApp{Metadata: []byte(samlMetadataSOAP), EntityID: samlEntityID},
+				}, nil)
+				expectSAMLCertificate(t, queries)
+				channel.EXPECT().HandleMessage(gomock.Any()).DoAndReturn(func(message *messages.SOAP) error {
+					content, err := message.GetContent()
+					require.NoError(t, err)
+					assert.Contains(t, content, `<LogoutRequest xmlns="urn:oasis:names:tc:SAML:2.0:protocol"`)
+					assert.Contains(t, content, `Destination="`+samlSOAPLogout+`"`)
+					assert.Contains(t, content, samlIssuer+`</Issuer>`)
+					assert.Contains(t, content, samlNameID+`</NameID>`)
+					assert.Contains(t, content, `<SignatureValue`)
+					return nil
+				})
+				commands.EXPECT().TerminateSAMLSession(gomock.Any(), samlSessionID, orgID).Return(nil)
+				return []eventstore.Event{samlSessionAddedEvent()}, nil
+			},
+		},
+		{
+			name: "sending failed",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands, channel *channel_mock.MockNotificationChannel) ([]eventstore.Event, []eventstore.Event) {
+				queries.EXPECT().AppByID(gomock.Any(), samlAppID).Return(&query.App{
+					ID:         samlAppID,
+					SAMLConfig: &query.SAMLApp{Metadata: []byte(samlMetadataSOAP), EntityID: samlEntityID},
+				}, nil)
+				expectSAMLCertificate(t, queries)
+				channel.EXPECT().HandleMessage(gomock.Any()).Return(zerrors.ThrowUnknown(nil, "SOAP-Bee3u", "failed"))
+				return []eventstore.Event{samlSessionAddedEvent()}, nil
+			},
+			wantErr: zerrors.ThrowUnknown(nil, "SOAP-Bee3u", "failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			channel := channel_mock.NewMockNotificationChannel(ctrl)
+			queries.EXPECT().NotificationProviderByIDAndType(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&query.DebugNotificationProvider{}, nil)
+			added, loggedOut := tt.test(ctrl, queries, commands, channel)
+			repo := es_repo_mock.NewRepo(t).ExpectFilterEvents(added...)
+			if len(added) > 0 {
+				repo.ExpectFilterEvents(loggedOut...)
+			}
+			notifier := &samlLogoutNotifier{
+				commands: commands,
+				queries: NewNotificationQueries(
+					queries,
+					eventstore.NewEventstore(&eventstore.Config{Querier: repo.MockQuerier}),
+					externalDomain,
+					externalPort,
+					externalSecure,
+					"",
+					nil,
+					nil,
+					nil,
+				),
+				channels:  &channels{Chain: *senders.ChainChannels(channel)},
+				keyEncAlg: keyEncryptionAlgorithm(ctrl),
+			}
+			stmt, err := notifier.reduceUserSignedOut(&user.HumanSignedOutEvent{
+				BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+					AggregateID:   userID,
+					AggregateType: user.AggregateType,
+					ResourceOwner: sql.NullString{String: orgID},
+					CreationDate:  time.Now().UTC(),
+				}),
+				UserAgentID:       samlUserAgentID,
+				TriggeredAtOrigin: eventOrigin,
+			})
+			require.NoError(t, err)
+			err = stmt.Execute(nil, "")
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_samlLogoutNotifier_reduceSessionTerminated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	queries := mock.NewMockQueries(ctrl)
+	commands := mock.NewMockCommands(ctrl)
+	channel := channel_mock.NewMockNotificationChannel(ctrl)
+	queries.EXPECT().NotificationProviderByIDAndType(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&query.DebugNotificationProvider{}, nil)
+	queries.EXPECT().AppByID(gomock.Any(), samlAppID).Return(&query.App{
+		ID:         samlAppID,
+		SAMLConfig: &query.SAMLApp{Metadata: []byte(samlMetadataSOAP), EntityID: samlEntityID},
+	}, nil)
+	expectSAMLCertificate(t, queries)
+	channel.EXPECT().HandleMessage(gomock.Any()).Return(nil)
+	commands.EXPECT().TerminateSAMLSession(gomock.Any(), samlSessionID, orgID).Return(nil)
+	notifier := &samlLogoutNotifier{
+		commands: commands,
+		queries: NewNotificationQueries(
+			queries,
+			eventstore.NewEventstore(&eventstore.Config{
+				Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(samlSessionAddedEvent()).ExpectFilterEvents().MockQuerier,
+			}),
+			externalDomain,
+			externalPort,
+			externalSecure,
+			"",
+			nil,
+			nil,
+			nil,
+		),
+		channels:  &channels{Chain: *senders.ChainChannels(channel)},
+		keyEncAlg: keyEncryptionAlgorithm(ctrl),
+	}
+	stmt, err := notifier.reduceSessionTerminated(&session.TerminateEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+			AggregateID:   sessionID,
+			AggregateType: session.AggregateType,
+			ResourceOwner: sql.NullString{String: orgID},
+			CreationDate:  time.Now().UTC(),
+		}),
+		TriggeredAtOrigin: eventOrigin,
+	})
+	require.NoError(t, err)
+	require.NoError(t, stmt.Execute(nil, ""))
+}
+
+func samlSessionAddedEvent() eventstore.Event {
+	return samlSessionEvent(samlsession.NewAddedEvent(context.Background(), &samlsession.NewAggregate(samlSessionID, orgID).Aggregate,
+		userID, orgID, sessionID, samlAppID, samlEntityID, samlIssuer, samlNameID,
+		&domain.UserAgent{FingerprintID: gu.Ptr(samlUserAgentID)},
+	))
+}
+
+func samlSessionEvent(event eventstore.Command) eventstore.Event {
+	data, _ := eventstore.EventData(event)
+	return &repository.Event{
+		Typ:           event.Type(),
+		Data:          data,
+		Version:       event.Aggregate().Version,
+		AggregateID:   event.Aggregate().ID,
+		AggregateType: event.Aggregate().Type,
+		ResourceOwner: sql.NullString{String: orgID, Valid: true},
+	}
+}
+
+type testCertificate struct {
+	testPrivateKey
+	certificate []byte
+}
+
+func (c *testCertificate) Use() domain.KeyUsage { return domain.KeyUsageSAMLResponseSinging }
+func (c *testCertificate) Certificate() []byte  { return c.certificate }
+
+func expectSAMLCertificate(t *testing.T, queries *mock.MockQueries) {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, testPublicKey, testSigningKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	certificate, err := crypto.CertificateToBytes(cert)
+	require.NoError(t, err)
+	queries.EXPECT().ActiveCertificates(gomock.Any(), gomock.Any(), domain.KeyUsageSAMLResponseSinging).Return(&query.Certificates{
+		Certificates: []query.Certificate{
+			&testCertificate{
+				testPrivateKey: testPrivateKey{key: &crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "enc",
+					KeyID:      "id",
+					Crypted:    []byte("key"),
+				}},
+				certificate: certificate,
+			},
+		},
+	}, nil)
+}
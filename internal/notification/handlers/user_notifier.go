@@ -5,8 +5,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/zitadel/logging"
+
 	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -15,6 +18,7 @@ import (
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -27,6 +31,7 @@ type userNotifier struct {
 	queries      *NotificationQueries
 	channels     types.ChannelChains
 	otpEmailTmpl string
+	retryConfig  NotificationWorkerConfig
 }
 
 func NewUserNotifier(
@@ -36,12 +41,14 @@ func NewUserNotifier(
 	queries *NotificationQueries,
 	channels types.ChannelChains,
 	otpEmailTmpl string,
+	retryConfig NotificationWorkerConfig,
 ) *handler.Handler {
 	return handler.NewHandler(ctx, &config, &userNotifier{
 		commands:     commands,
 		queries:      queries,
 		otpEmailTmpl: otpEmailTmpl,
 		channels:     channels,
+		retryConfig:  retryConfig,
 	})
 }
 
@@ -106,6 +113,18 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanOTPEmailCodeAddedType,
 					Reduce: u.reduceOTPEmailCodeAdded,
 				},
+				{
+					Event:  schemauser.EmailCodeAddedType,
+					Reduce: u.reduceSchemaUserEmailCodeAdded,
+				},
+				{
+					Event:  schemauser.PhoneCodeAddedType,
+					Reduce: u.reduceSchemaUserPhoneCodeAdded,
+				},
+				{
+					Event:  schemauser.PasswordCodeAddedType,
+					Reduce: u.reduceSchemaUserPasswordCodeAdded,
+				},
 			},
 		},
 		{
@@ -124,181 +143,325 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 	}
 }
 
-func (u *userNotifier) reduceInitCodeAdded(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*user.HumanInitialCodeAddedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-EFe2f", "reduce.wrong.event.type %s", user.HumanInitialCodeAddedType)
+// notification describes a single message to a user triggered by an event.
+type notification struct {
+	userID           string
+	resourceOwner    string
+	notificationType domain.NotificationType
+	messageType      string
+	// alreadyHandled returns true if the message must not be sent (anymore),
+	// e.g. because it was already sent or the code expired.
+	alreadyHandled func(ctx context.Context) (bool, error)
+	// send delivers the message and marks the triggering event as sent.
+	send func(ctx context.Context) error
+}
+
+// notificationBuilder describes the notification triggered by the event.
+// It returns nil if the event does not require a notification.
+type notificationBuilder func(ctx context.Context, event eventstore.Event) (*notification, error)
+
+// notificationBuilders returns the builders for all event types handled by the userNotifier,
+// so that failed notifications can be rebuilt from their triggering event.
+func (u *userNotifier) notificationBuilders() map[eventstore.EventType]notificationBuilder {
+	return map[eventstore.EventType]notificationBuilder{
+		user.UserV1InitialCodeAddedType:             u.initCodeNotification,
+		user.HumanInitialCodeAddedType:              u.initCodeNotification,
+		user.UserV1EmailCodeAddedType:               u.emailCodeNotification,
+		user.HumanEmailCodeAddedType:                u.emailCodeNotification,
+		user.UserV1PasswordCodeAddedType:            u.passwordCodeNotification,
+		user.HumanPasswordCodeAddedType:             u.passwordCodeNotification,
+		user.UserDomainClaimedType:                  u.domainClaimedNotification,
+		user.HumanPasswordlessInitCodeRequestedType: u.passwordlessCodeNotification,
+		user.UserV1PhoneCodeAddedType:               u.phoneCodeNotification,
+		user.HumanPhoneCodeAddedType:                u.phoneCodeNotification,
+		user.HumanPasswordChangedType:               u.passwordChangedNotification,
+		user.HumanOTPSMSCodeAddedType:               u.otpSMSCodeNotification,
+		user.HumanOTPEmailCodeAddedType:             u.otpEmailCodeNotification,
+		schemauser.EmailCodeAddedType:               u.schemaUserEmailCodeNotification,
+		schemauser.PhoneCodeAddedType:               u.schemaUserPhoneCodeNotification,
+		schemauser.PasswordCodeAddedType:            u.schemaUserPasswordCodeNotification,
+		session.OTPSMSChallengedType:                u.sessionOTPSMSNotification,
+		session.OTPEmailChallengedType:              u.sessionOTPEmailNotification,
 	}
+}
+
+func (u *userNotifier) reduceInitCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.initCodeNotification)
+}
+
+func (u *userNotifier) reduceEmailCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.emailCodeNotification)
+}
+
+func (u *userNotifier) reducePasswordCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.passwordCodeNotification)
+}
 
+func (u *userNotifier) reduceOTPSMSCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.otpSMSCodeNotification)
+}
+
+func (u *userNotifier) reduceSessionOTPSMSChallenged(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.sessionOTPSMSNotification)
+}
+
+func (u *userNotifier) reduceOTPEmailCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.otpEmailCodeNotification)
+}
+
+func (u *userNotifier) reduceSessionOTPEmailChallenged(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.sessionOTPEmailNotification)
+}
+
+func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.domainClaimedNotification)
+}
+
+func (u *userNotifier) reducePasswordlessCodeRequested(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.passwordlessCodeNotification)
+}
+
+func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.passwordChangedNotification)
+}
+
+func (u *userNotifier) reducePhoneCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.phoneCodeNotification)
+}
+
+func (u *userNotifier) reduceSchemaUserEmailCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.schemaUserEmailCodeNotification)
+}
+
+func (u *userNotifier) reduceSchemaUserPhoneCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.schemaUserPhoneCodeNotification)
+}
+
+func (u *userNotifier) reduceSchemaUserPasswordCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	return u.reduceNotification(event, u.schemaUserPasswordCodeNotification)
+}
+
+// reduceNotification records the notification triggered by the event and delivers it.
+// Delivery errors do not block the projection, the failed notification is retried by the notification worker.
+func (u *userNotifier) reduceNotification(event eventstore.Event, build notificationBuilder) (*handler.Statement, error) {
+	ctx := HandlerContext(event.Aggregate())
+	n, err := build(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		return handler.NewNoOpStatement(event), nil
+	}
 	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
-		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
-			user.UserV1InitialCodeAddedType, user.UserV1InitialCodeSentType,
-			user.HumanInitialCodeAddedType, user.HumanInitialCodeSentType)
+		alreadyHandled, err := n.alreadyHandled(ctx)
 		if err != nil {
 			return err
 		}
 		if alreadyHandled {
 			return nil
 		}
-		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
-		if err != nil {
-			return err
+		request := &command.NotificationRequest{
+			UserID:           n.userID,
+			ResourceOwner:    n.resourceOwner,
+			NotificationType: n.notificationType,
+			MessageType:      n.messageType,
+			TriggerEvent:     event,
 		}
-		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		state, err := u.commands.RequestNotification(ctx, request)
 		if err != nil {
 			return err
 		}
-
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
+		if state != domain.NotificationStateQueued {
+			return nil
 		}
+		return u.deliver(ctx, request.ID(), n, 1)
+	}), nil
+}
 
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
-		if err != nil {
-			return err
-		}
-		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.InitCodeMessageType)
-		if err != nil {
-			return err
-		}
+// deliver sends the notification and records the result of the attempt.
+func (u *userNotifier) deliver(ctx context.Context, id string, n *notification, attempt uint8) error {
+	sendErr := n.send(ctx)
+	if sendErr == nil {
+		return u.commands.NotificationSent(ctx, id, n.resourceOwner)
+	}
+	logging.WithFields("notification", id, "attempt", attempt).WithError(sendErr).Warn("unable to send notification")
+	return u.commands.NotificationFailed(ctx, id, n.resourceOwner, sendErr, time.Now().Add(u.retryConfig.retryDelay(attempt)))
+}
 
-		ctx, err = u.queries.Origin(ctx, e)
-		if err != nil {
-			return err
-		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
-			SendUserInitCode(ctx, notifyUser, code, e.AuthRequestID)
-		if err != nil {
-			return err
-		}
-		return u.commands.HumanInitCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
-	}), nil
+func (u *userNotifier) initCodeNotification(_ context.Context, event eventstore.Event) (*notification, error) {
+	e, ok := event.(*user.HumanInitialCodeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-EFe2f", "reduce.wrong.event.type %s", user.HumanInitialCodeAddedType)
+	}
+	return &notification{
+		userID:           e.Aggregate().ID,
+		resourceOwner:    e.Aggregate().ResourceOwner,
+		notificationType: domain.NotificationTypeEmail,
+		messageType:      domain.InitCodeMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			return u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+				user.UserV1InitialCodeAddedType, user.UserV1InitialCodeSentType,
+				user.HumanInitialCodeAddedType, user.HumanInitialCodeSentType)
+		},
+		send: func(ctx context.Context) error {
+			code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+			if err != nil {
+				return err
+			}
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
+
+			template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
+
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.InitCodeMessageType)
+			if err != nil {
+				return err
+			}
+
+			ctx, err = u.queries.Origin(ctx, e)
+			if err != nil {
+				return err
+			}
+			err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+				SendUserInitCode(ctx, notifyUser, code, e.AuthRequestID)
+			if err != nil {
+				return err
+			}
+			return u.commands.HumanInitCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+		},
+	}, nil
 }
 
-func (u *userNotifier) reduceEmailCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+func (u *userNotifier) emailCodeNotification(_ context.Context, event eventstore.Event) (*notification, error) {
 	e, ok := event.(*user.HumanEmailCodeAddedEvent)
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-SWf3g", "reduce.wrong.event.type %s", user.HumanEmailCodeAddedType)
 	}
-
 	if e.CodeReturned {
-		return handler.NewNoOpStatement(e), nil
+		return nil, nil
 	}
+	return &notification{
+		userID:           e.Aggregate().ID,
+		resourceOwner:    e.Aggregate().ResourceOwner,
+		notificationType: domain.NotificationTypeEmail,
+		messageType:      domain.VerifyEmailMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			return u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+				user.UserV1EmailCodeAddedType, user.UserV1EmailCodeSentType,
+				user.HumanEmailCodeAddedType, user.HumanEmailCodeSentType)
+		},
+		send: func(ctx context.Context) error {
+			code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+			if err != nil {
+				return err
+			}
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
-		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
-			user.UserV1EmailCodeAddedType, user.UserV1EmailCodeSentType,
-			user.HumanEmailCodeAddedType, user.HumanEmailCodeSentType)
-		if err != nil {
-			return err
-		}
-		if alreadyHandled {
-			return nil
-		}
-		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
-		if err != nil {
-			return err
-		}
-		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
-		}
-
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
-		}
+			template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
-		if err != nil {
-			return err
-		}
-		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifyEmailMessageType)
-		if err != nil {
-			return err
-		}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifyEmailMessageType)
+			if err != nil {
+				return err
+			}
 
-		ctx, err = u.queries.Origin(ctx, e)
-		if err != nil {
-			return err
-		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
-			SendEmailVerificationCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
-		if err != nil {
-			return err
-		}
-		return u.commands.HumanEmailVerificationCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
-	}), nil
+			ctx, err = u.queries.Origin(ctx, e)
+			if err != nil {
+				return err
+			}
+			err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+				SendEmailVerificationCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
+			if err != nil {
+				return err
+			}
+			return u.commands.HumanEmailVerificationCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+		},
+	}, nil
 }
 
-func (u *userNotifier) reducePasswordCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+func (u *userNotifier) passwordCodeNotification(_ context.Context, event eventstore.Event) (*notification, error) {
 	e, ok := event.(*user.HumanPasswordCodeAddedEvent)
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Eeg3s", "reduce.wrong.event.type %s", user.HumanPasswordCodeAddedType)
 	}
 	if e.CodeReturned {
-		return handler.NewNoOpStatement(e), nil
+		return nil, nil
 	}
+	return &notification{
+		userID:           e.Aggregate().ID,
+		resourceOwner:    e.Aggregate().ResourceOwner,
+		notificationType: e.NotificationType,
+		messageType:      domain.PasswordResetMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			return u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+				user.UserV1PasswordCodeAddedType, user.UserV1PasswordCodeSentType,
+				user.HumanPasswordCodeAddedType, user.HumanPasswordCodeSentType)
+		},
+		send: func(ctx context.Context) error {
+			code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+			if err != nil {
+				return err
+			}
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
-		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
-			user.UserV1PasswordCodeAddedType, user.UserV1PasswordCodeSentType,
-			user.HumanPasswordCodeAddedType, user.HumanPasswordCodeSentType)
-		if err != nil {
-			return err
-		}
-		if alreadyHandled {
-			return nil
-		}
-		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
-		if err != nil {
-			return err
-		}
-		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
-		}
-
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
-		}
+			template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
-		if err != nil {
-			return err
-		}
-		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.PasswordResetMessageType)
-		if err != nil {
-			return err
-		}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.PasswordResetMessageType)
+			if err != nil {
+				return err
+			}
 
-		ctx, err = u.queries.Origin(ctx, e)
-		if err != nil {
-			return err
-		}
-		notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e)
-		if e.NotificationType == domain.NotificationTypeSms {
-			notify = types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, e)
-		}
-		err = notify.SendPasswordCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
-		if err != nil {
-			return err
-		}
-		return u.commands.PasswordCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
-	}), nil
+			ctx, err = u.queries.Origin(ctx, e)
+			if err != nil {
+				return err
+			}
+			notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e)
+			if e.NotificationType == domain.NotificationTypeSms {
+				notify = types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, e)
+			}
+			err = notify.SendPasswordCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
+			if err != nil {
+				return err
+			}
+			return u.commands.PasswordCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+		},
+	}, nil
 }
 
-func (u *userNotifier) reduceOTPSMSCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+func (u *userNotifier) otpSMSCodeNotification(_ context.Context, event eventstore.Event) (*notification, error) {
 	e, ok := event.(*user.HumanOTPSMSCodeAddedEvent)
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-ASF3g", "reduce.wrong.event.type %s", user.HumanOTPSMSCodeAddedType)
 	}
-	return u.reduceOTPSMS(
+	return u.otpSMSNotification(
 		e,
 		e.Code,
 		e.Expiry,
@@ -307,23 +470,22 @@ func (u *userNotifier) reduceOTPSMSCodeAdded(event eventstore.Event) (*handler.S
 		u.commands.HumanOTPSMSCodeSent,
 		user.HumanOTPSMSCodeAddedType,
 		user.HumanOTPSMSCodeSentType,
-	)
+	), nil
 }
 
-func (u *userNotifier) reduceSessionOTPSMSChallenged(event eventstore.Event) (*handler.Statement, error) {
+func (u *userNotifier) sessionOTPSMSNotification(ctx context.Context, event eventstore.Event) (*notification, error) {
 	e, ok := event.(*session.OTPSMSChallengedEvent)
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Sk32L", "reduce.wrong.event.type %s", session.OTPSMSChallengedType)
 	}
 	if e.CodeReturned {
-		return handler.NewNoOpStatement(e), nil
+		return nil, nil
 	}
-	ctx := HandlerContext(event.Aggregate())
 	s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "")
 	if err != nil {
 		return nil, err
 	}
-	return u.reduceOTPSMS(
+	return u.otpSMSNotification(
 		e,
 		e.Code,
 		e.Expiry,
@@ -332,10 +494,10 @@ func (u *userNotifier) reduceSessionOTPSMSChallenged(event eventstore.Event) (*h
 		u.commands.OTPSMSSent,
 		session.OTPSMSChallengedType,
 		session.OTPSMSSentType,
-	)
+	), nil
 }
 
-func (u *userNotifier) reduceOTPSMS(
+func (u *userNotifier) otpSMSNotification(
 	event eventstore.Event,
 	code *crypto.CryptoValue,
 	expiry time.Duration,
@@ -343,49 +505,48 @@ func (u *userNotifier) reduceOTPSMS(
 	resourceOwner string,
 	sentCommand func(ctx context.Context, userID string, resourceOwner string) (err error),
 	eventTypes ...eventstore.EventType,
-) (*handler.Statement, error) {
-	ctx := HandlerContext(event.Aggregate())
-	alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, expiry, nil, eventTypes...)
-	if err != nil {
-		return nil, err
-	}
-	if alreadyHandled {
-		return handler.NewNoOpStatement(event), nil
-	}
-	plainCode, err := crypto.DecryptString(code, u.queries.UserDataCrypto)
-	if err != nil {
-		return nil, err
-	}
-	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, resourceOwner, false)
-	if err != nil {
-		return nil, err
-	}
+) *notification {
+	return &notification{
+		userID:           userID,
+		resourceOwner:    resourceOwner,
+		notificationType: domain.NotificationTypeSms,
+		messageType:      domain.VerifySMSOTPMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			return u.checkIfCodeAlreadyHandledOrExpired(ctx, event, expiry, nil, eventTypes...)
+		},
+		send: func(ctx context.Context) error {
+			plainCode, err := crypto.DecryptString(code, u.queries.UserDataCrypto)
+			if err != nil {
+				return err
+			}
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, resourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
-	if err != nil {
-		return nil, err
-	}
-	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifySMSOTPMessageType)
-	if err != nil {
-		return nil, err
-	}
-	ctx, err = u.queries.Origin(ctx, event)
-	if err != nil {
-		return nil, err
-	}
-	notify := types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, event)
-	err = notify.SendOTPSMSCode(ctx, plainCode, expiry)
-	if err != nil {
-		return nil, err
-	}
-	err = sentCommand(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner)
-	if err != nil {
-		return nil, err
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifySMSOTPMessageType)
+			if err != nil {
+				return err
+			}
+			ctx, err = u.queries.Origin(ctx, event)
+			if err != nil {
+				return err
+			}
+			notify := types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, event)
+			err = notify.SendOTPSMSCode(ctx, plainCode, expiry)
+			if err != nil {
+				return err
+			}
+			return sentCommand(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner)
+		},
 	}
-	return handler.NewNoOpStatement(event), nil
 }
 
-func (u *userNotifier) reduceOTPEmailCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+func (u *userNotifier) otpEmailCodeNotification(_ context.Context, event eventstore.Event) (*notification, error) {
 	e, ok := event.(*user.HumanOTPEmailCodeAddedEvent)
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-JL3hw", "reduce.wrong.event.type %s", user.HumanOTPEmailCodeAddedType)
@@ -397,7 +558,7 @@ func (u *userNotifier) reduceOTPEmailCodeAdded(event eventstore.Event) (*handler
 	url := func(code, origin string, _ *query.NotifyUser) (string, error) {
 		return login.OTPLink(origin, authRequestID, code, domain.MFATypeOTPEmail), nil
 	}
-	return u.reduceOTPEmail(
+	return u.otpEmailNotification(
 		e,
 		e.Code,
 		e.Expiry,
@@ -407,18 +568,17 @@ func (u *userNotifier) reduceOTPEmailCodeAdded(event eventstore.Event) (*handler
 		u.commands.HumanOTPEmailCodeSent,
 		user.HumanOTPEmailCodeAddedType,
 		user.HumanOTPEmailCodeSentType,
-	)
+	), nil
 }
 
-func (u *userNotifier) reduceSessionOTPEmailChallenged(event eventstore.Event) (*handler.Statement, error) {
+func (u *userNotifier) sessionOTPEmailNotification(ctx context.Context, event eventstore.Event) (*notification, error) {
 	e, ok := event.(*session.OTPEmailChallengedEvent)
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-zbsgt", "reduce.wrong.event.type %s", session.OTPEmailChallengedType)
 	}
 	if e.ReturnCode {
-		return handler.NewNoOpStatement(e), nil
+		return nil, nil
 	}
-	ctx := HandlerContext(event.Aggregate())
 	s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "")
 	if err != nil {
 		return nil, err
@@ -434,7 +594,7 @@ func (u *userNotifier) reduceSessionOTPEmailChallenged(event eventstore.Event) (
 		}
 		return buf.String(), nil
 	}
-	return u.reduceOTPEmail(
+	return u.otpEmailNotification(
 		e,
 		e.Code,
 		e.Expiry,
@@ -444,10 +604,10 @@ func (u *userNotifier) reduceSessionOTPEmailChallenged(event eventstore.Event) (
 		u.commands.OTPEmailSent,
 		user.HumanOTPEmailCodeAddedType,
 		user.HumanOTPEmailCodeSentType,
-	)
+	), nil
 }
 
-func (u *userNotifier) reduceOTPEmail(
+func (u *userNotifier) otpEmailNotification(
 	event eventstore.Event,
 	code *crypto.CryptoValue,
 	expiry time.Duration,
@@ -456,266 +616,430 @@ func (u *userNotifier) reduceOTPEmail(
 	urlTmpl func(code, origin string, user *query.NotifyUser) (string, error),
 	sentCommand func(ctx context.Context, userID string, resourceOwner string) (err error),
 	eventTypes ...eventstore.EventType,
-) (*handler.Statement, error) {
-	ctx := HandlerContext(event.Aggregate())
-	alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, expiry, nil, eventTypes...)
-	if err != nil {
-		return nil, err
-	}
-	if alreadyHandled {
-		return handler.NewNoOpStatement(event), nil
-	}
-	plainCode, err := crypto.DecryptString(code, u.queries.UserDataCrypto)
-	if err != nil {
-		return nil, err
-	}
-	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, resourceOwner, false)
-	if err != nil {
-		return nil, err
-	}
+) *notification {
+	return &notification{
+		userID:           userID,
+		resourceOwner:    resourceOwner,
+		notificationType: domain.NotificationTypeEmail,
+		messageType:      domain.VerifyEmailOTPMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			return u.checkIfCodeAlreadyHandledOrExpired(ctx, event, expiry, nil, eventTypes...)
+		},
+		send: func(ctx context.Context) error {
+			plainCode, err := crypto.DecryptString(code, u.queries.UserDataCrypto)
+			if err != nil {
+				return err
+			}
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, resourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-	template, err := u.queries.MailTemplateByOrg(ctx, resourceOwner, false)
-	if err != nil {
-		return nil, err
-	}
+			template, err := u.queries.MailTemplateByOrg(ctx, resourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
-	if err != nil {
-		return nil, err
-	}
-	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, resourceOwner, domain.VerifyEmailOTPMessageType)
-	if err != nil {
-		return nil, err
-	}
-	ctx, err = u.queries.Origin(ctx, event)
-	if err != nil {
-		return nil, err
-	}
-	url, err := urlTmpl(plainCode, http_util.ComposedOrigin(ctx), notifyUser)
-	if err != nil {
-		return nil, err
-	}
-	notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event)
-	err = notify.SendOTPEmailCode(ctx, url, plainCode, expiry)
-	if err != nil {
-		return nil, err
-	}
-	err = sentCommand(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner)
-	if err != nil {
-		return nil, err
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, resourceOwner, domain.VerifyEmailOTPMessageType)
+			if err != nil {
+				return err
+			}
+			ctx, err = u.queries.Origin(ctx, event)
+			if err != nil {
+				return err
+			}
+			url, err := urlTmpl(plainCode, http_util.ComposedOrigin(ctx), notifyUser)
+			if err != nil {
+				return err
+			}
+			notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event)
+			err = notify.SendOTPEmailCode(ctx, url, plainCode, expiry)
+			if err != nil {
+				return err
+			}
+			return sentCommand(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner)
+		},
 	}
-	return handler.NewNoOpStatement(event), nil
 }
 
-func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Statement, error) {
+func (u *userNotifier) domainClaimedNotification(_ context.Context, event eventstore.Event) (*notification, error) {
 	e, ok := event.(*user.DomainClaimedEvent)
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Drh5w", "reduce.wrong.event.type %s", user.UserDomainClaimedType)
 	}
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
-		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil,
-			user.UserDomainClaimedType, user.UserDomainClaimedSentType)
-		if err != nil {
-			return err
-		}
-		if alreadyHandled {
-			return nil
-		}
-		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
-		}
+	return &notification{
+		userID:           e.Aggregate().ID,
+		resourceOwner:    e.Aggregate().ResourceOwner,
+		notificationType: domain.NotificationTypeEmail,
+		messageType:      domain.DomainClaimedMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			return u.queries.IsAlreadyHandled(ctx, event, nil,
+				user.UserDomainClaimedType, user.UserDomainClaimedSentType)
+		},
+		send: func(ctx context.Context) error {
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
-		}
+			template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
-		if err != nil {
-			return err
-		}
-		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.DomainClaimedMessageType)
-		if err != nil {
-			return err
-		}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.DomainClaimedMessageType)
+			if err != nil {
+				return err
+			}
 
-		ctx, err = u.queries.Origin(ctx, e)
-		if err != nil {
-			return err
-		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
-			SendDomainClaimed(ctx, notifyUser, e.UserName)
-		if err != nil {
-			return err
-		}
-		return u.commands.UserDomainClaimedSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
-	}), nil
+			ctx, err = u.queries.Origin(ctx, e)
+			if err != nil {
+				return err
+			}
+			err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+				SendDomainClaimed(ctx, notifyUser, e.UserName)
+			if err != nil {
+				return err
+			}
+			return u.commands.UserDomainClaimedSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+		},
+	}, nil
 }
 
-func (u *userNotifier) reducePasswordlessCodeRequested(event eventstore.Event) (*handler.Statement, error) {
+func (u *userNotifier) passwordlessCodeNotification(_ context.Context, event eventstore.Event) (*notification, error) {
 	e, ok := event.(*user.HumanPasswordlessInitCodeRequestedEvent)
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-EDtjd", "reduce.wrong.event.type %s", user.HumanPasswordlessInitCodeAddedType)
 	}
 	if e.CodeReturned {
-		return handler.NewNoOpStatement(e), nil
+		return nil, nil
 	}
+	return &notification{
+		userID:           e.Aggregate().ID,
+		resourceOwner:    e.Aggregate().ResourceOwner,
+		notificationType: domain.NotificationTypeEmail,
+		messageType:      domain.PasswordlessRegistrationMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			return u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, map[string]interface{}{"id": e.ID}, user.HumanPasswordlessInitCodeSentType)
+		},
+		send: func(ctx context.Context) error {
+			code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+			if err != nil {
+				return err
+			}
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
-		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, map[string]interface{}{"id": e.ID}, user.HumanPasswordlessInitCodeSentType)
-		if err != nil {
-			return err
-		}
-		if alreadyHandled {
-			return nil
-		}
-		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
-		if err != nil {
-			return err
-		}
-		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
-		}
-
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
-		}
+			template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
-		if err != nil {
-			return err
-		}
-		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.PasswordlessRegistrationMessageType)
-		if err != nil {
-			return err
-		}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.PasswordlessRegistrationMessageType)
+			if err != nil {
+				return err
+			}
 
-		ctx, err = u.queries.Origin(ctx, e)
-		if err != nil {
-			return err
-		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
-			SendPasswordlessRegistrationLink(ctx, notifyUser, code, e.ID, e.URLTemplate)
-		if err != nil {
-			return err
-		}
-		return u.commands.HumanPasswordlessInitCodeSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner, e.ID)
-	}), nil
+			ctx, err = u.queries.Origin(ctx, e)
+			if err != nil {
+				return err
+			}
+			err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+				SendPasswordlessRegistrationLink(ctx, notifyUser, code, e.ID, e.URLTemplate)
+			if err != nil {
+				return err
+			}
+			return u.commands.HumanPasswordlessInitCodeSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner, e.ID)
+		},
+	}, nil
 }
 
-func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.Statement, error) {
+func (u *userNotifier) passwordChangedNotification(_ context.Context, event eventstore.Event) (*notification, error) {
 	e, ok := event.(*user.HumanPasswordChangedEvent)
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Yko2z8", "reduce.wrong.event.type %s", user.HumanPasswordChangedType)
 	}
+	return &notification{
+		userID:           e.Aggregate().ID,
+		resourceOwner:    e.Aggregate().ResourceOwner,
+		notificationType: domain.NotificationTypeEmail,
+		messageType:      domain.PasswordChangeMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.HumanPasswordChangeSentType)
+			if err != nil || alreadyHandled {
+				return alreadyHandled, err
+			}
+			notificationPolicy, err := u.queries.NotificationPolicyByOrg(ctx, true, e.Aggregate().ResourceOwner, false)
+			if zerrors.IsNotFound(err) {
+				return true, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			return !notificationPolicy.PasswordChange, nil
+		},
+		send: func(ctx context.Context) error {
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
-		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.HumanPasswordChangeSentType)
-		if err != nil {
-			return err
-		}
-		if alreadyHandled {
-			return nil
-		}
+			template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-		notificationPolicy, err := u.queries.NotificationPolicyByOrg(ctx, true, e.Aggregate().ResourceOwner, false)
-		if zerrors.IsNotFound(err) {
-			return nil
-		}
-		if err != nil {
-			return err
-		}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.PasswordChangeMessageType)
+			if err != nil {
+				return err
+			}
+			ctx, err = u.queries.Origin(ctx, e)
+			if err != nil {
+				return err
+			}
+			err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+				SendPasswordChange(ctx, notifyUser)
+			if err != nil {
+				return err
+			}
+			return u.commands.PasswordChangeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+		},
+	}, nil
+}
 
-		if !notificationPolicy.PasswordChange {
-			return nil
-		}
+func (u *userNotifier) phoneCodeNotification(_ context.Context, event eventstore.Event) (*notification, error) {
+	e, ok := event.(*user.HumanPhoneCodeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-He83g", "reduce.wrong.event.type %s", user.HumanPhoneCodeAddedType)
+	}
+	if e.CodeReturned {
+		return nil, nil
+	}
+	return &notification{
+		userID:           e.Aggregate().ID,
+		resourceOwner:    e.Aggregate().ResourceOwner,
+		notificationType: domain.NotificationTypeSms,
+		messageType:      domain.VerifyPhoneMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			return u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+				user.UserV1PhoneCodeAddedType, user.UserV1PhoneCodeSentType,
+				user.HumanPhoneCodeAddedType, user.HumanPhoneCodeSentType)
+		},
+		send: func(ctx context.Context) error {
+			code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+			if err != nil {
+				return err
+			}
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
-		}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifyPhoneMessageType)
+			if err != nil {
+				return err
+			}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
-		}
+			ctx, err = u.queries.Origin(ctx, e)
+			if err != nil {
+				return err
+			}
+			err = types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, e).
+				SendPhoneVerificationCode(ctx, code)
+			if err != nil {
+				return err
+			}
+			return u.commands.HumanPhoneVerificationCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+		},
+	}, nil
+}
 
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
-		if err != nil {
-			return err
-		}
-		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.PasswordChangeMessageType)
-		if err != nil {
-			return err
-		}
-		ctx, err = u.queries.Origin(ctx, e)
-		if err != nil {
-			return err
-		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
-			SendPasswordChange(ctx, notifyUser)
-		if err != nil {
-			return err
-		}
-		return u.commands.PasswordChangeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
-	}), nil
+// schemaUserEmailCodeNotification sends the verification code of the contact email of a user based on a user schema.
+func (u *userNotifier) schemaUserEmailCodeNotification(_ context.Context, event eventstore.Event) (*notification, error) {
+	e, ok := event.(*schemauser.EmailCodeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ohpa6", "reduce.wrong.event.type %s", schemauser.EmailCodeAddedType)
+	}
+	if e.CodeReturned {
+		return nil, nil
+	}
+	return &notification{
+		userID:           e.Aggregate().ID,
+		resourceOwner:    e.Aggregate().ResourceOwner,
+		notificationType: domain.NotificationTypeEmail,
+		messageType:      domain.VerifyEmailMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			return u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+				schemauser.EmailCodeAddedType, schemauser.EmailCodeSentType)
+		},
+		send: func(ctx context.Context) error {
+			code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+			if err != nil {
+				return err
+			}
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
+
+			template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
+
+			notifyUser, err := u.queries.GetNotifySchemaUserByID(ctx, true, e.Aggregate().ID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifyEmailMessageType)
+			if err != nil {
+				return err
+			}
+
+			ctx, err = u.queries.Origin(ctx, e)
+			if err != nil {
+				return err
+			}
+			err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+				SendEmailVerificationCode(ctx, notifyUser, code, e.URLTemplate, "")
+			if err != nil {
+				return err
+			}
+			return u.commands.SchemaUserEmailCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+		},
+	}, nil
 }
 
-func (u *userNotifier) reducePhoneCodeAdded(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*user.HumanPhoneCodeAddedEvent)
+// schemaUserPhoneCodeNotification sends the verification code of the contact phone of a user based on a user schema.
+func (u *userNotifier) schemaUserPhoneCodeNotification(_ context.Context, event eventstore.Event) (*notification, error) {
+	e, ok := event.(*schemauser.PhoneCodeAddedEvent)
 	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-He83g", "reduce.wrong.event.type %s", user.HumanPhoneCodeAddedType)
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Gie3u", "reduce.wrong.event.type %s", schemauser.PhoneCodeAddedType)
 	}
 	if e.CodeReturned {
-		return handler.NewNoOpStatement(e), nil
+		return nil, nil
 	}
+	return &notification{
+		userID:           e.Aggregate().ID,
+		resourceOwner:    e.Aggregate().ResourceOwner,
+		notificationType: domain.NotificationTypeSms,
+		messageType:      domain.VerifyPhoneMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			return u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+				schemauser.PhoneCodeAddedType, schemauser.PhoneCodeSentType)
+		},
+		send: func(ctx context.Context) error {
+			code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+			if err != nil {
+				return err
+			}
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
 
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
-		ctx := HandlerContext(event.Aggregate())
-		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
-			user.UserV1PhoneCodeAddedType, user.UserV1PhoneCodeSentType,
-			user.HumanPhoneCodeAddedType, user.HumanPhoneCodeSentType)
-		if err != nil {
-			return err
-		}
-		if alreadyHandled {
-			return nil
-		}
-		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
-		if err != nil {
-			return err
-		}
-		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
-		if err != nil {
-			return err
-		}
+			notifyUser, err := u.queries.GetNotifySchemaUserByID(ctx, true, e.Aggregate().ID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifyPhoneMessageType)
+			if err != nil {
+				return err
+			}
 
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
-		if err != nil {
-			return err
-		}
-		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifyPhoneMessageType)
-		if err != nil {
-			return err
-		}
+			ctx, err = u.queries.Origin(ctx, e)
+			if err != nil {
+				return err
+			}
+			err = types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, e).
+				SendPhoneVerificationCode(ctx, code)
+			if err != nil {
+				return err
+			}
+			return u.commands.SchemaUserPhoneCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+		},
+	}, nil
+}
 
-		ctx, err = u.queries.Origin(ctx, e)
-		if err != nil {
-			return err
-		}
-		err = types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, e).
-			SendPhoneVerificationCode(ctx, code)
-		if err != nil {
-			return err
-		}
-		return u.commands.HumanPhoneVerificationCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
-	}), nil
+// schemaUserPasswordCodeNotification sends the password reset code to the contact email or phone of a user based on a user schema.
+func (u *userNotifier) schemaUserPasswordCodeNotification(_ context.Context, event eventstore.Event) (*notification, error) {
+	e, ok := event.(*schemauser.PasswordCodeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Kai9o", "reduce.wrong.event.type %s", schemauser.PasswordCodeAddedType)
+	}
+	if e.CodeReturned {
+		return nil, nil
+	}
+	return &notification{
+		userID:           e.Aggregate().ID,
+		resourceOwner:    e.Aggregate().ResourceOwner,
+		notificationType: e.NotificationType,
+		messageType:      domain.PasswordResetMessageType,
+		alreadyHandled: func(ctx context.Context) (bool, error) {
+			return u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+				schemauser.PasswordCodeAddedType, schemauser.PasswordCodeSentType)
+		},
+		send: func(ctx context.Context) error {
+			code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+			if err != nil {
+				return err
+			}
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
+
+			template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+			if err != nil {
+				return err
+			}
+
+			notifyUser, err := u.queries.GetNotifySchemaUserByID(ctx, true, e.Aggregate().ID)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.PasswordResetMessageType)
+			if err != nil {
+				return err
+			}
+
+			ctx, err = u.queries.Origin(ctx, e)
+			if err != nil {
+				return err
+			}
+			notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e)
+			if e.NotificationType == domain.NotificationTypeSms {
+				notify = types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, e)
+			}
+			err = notify.SendPasswordCode(ctx, notifyUser, code, e.URLTemplate, "")
+			if err != nil {
+				return err
+			}
+			return u.commands.SchemaUserPasswordCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+		},
+	}, nil
 }
 
 func (u *userNotifier) checkIfCodeAlreadyHandledOrExpired(ctx context.Context, event eventstore.Event, expiry time.Duration, data map[string]interface{}, eventTypes ...eventstore.EventType) (bool, error) {
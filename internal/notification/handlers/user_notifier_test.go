@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -12,12 +13,14 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
 	channel_mock "github.com/zitadel/zitadel/internal/notification/channels/mock"
+	"github.com/zitadel/zitadel/internal/notification/channels/set"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
-	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/soap"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
 	"github.com/zitadel/zitadel/internal/notification/messages"
@@ -26,6 +29,7 @@ import (
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
 )
 
 const (
@@ -1402,12 +1406,245 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			queries := mock.NewMockQueries(ctrl)
 			commands := mock.NewMockCommands(ctrl)
 			f, a, w := tt.test(ctrl, queries, commands)
-			_, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceSessionOTPEmailChallenged(a.event)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceSessionOTPEmailChallenged(a.event)
 			if w.err != nil {
 				w.err(t, err)
 			} else {
 				assert.NoError(t, err)
 			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceSchemaUserEmailCodeAdded(t *testing.T) {
+	expectMailSubject := "Verify email"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "button url with url template",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.URL}}"
+			urlTemplate := "https://my.custom.url/org/{{.OrgID}}/user/{{.UserID}}/verify/{{.Code}}"
+			testCode := "testcode"
+			expectContent := fmt.Sprintf("https://my.custom.url/org/%s/user/%s/verify/%s", orgID, userID, testCode)
+			w.message = messages.Email{
+				Recipients: []string{lastEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			codeAlg, code := cryptoValue(t, ctrl, testCode)
+			expectSchemaUserTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().SchemaUserEmailCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+					userDataCrypto: codeAlg,
+				}, args{
+					event: &schemauser.EmailCodeAddedEvent{
+						BaseEvent: eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						Code:              code,
+						Expiry:            time.Hour,
+						URLTemplate:       urlTemplate,
+						TriggeredAtOrigin: eventOrigin,
+					},
+				}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceSchemaUserEmailCodeAdded(a.event)
+			assert.NoError(t, err)
+			err = stmt.Execute(nil, "")
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_userNotifier_reduceSchemaUserPasswordCodeAdded(t *testing.T) {
+	expectMailSubject := "Reset password"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "button url with url template",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.URL}}"
+			urlTemplate := "https://my.custom.url/org/{{.OrgID}}/user/{{.UserID}}/password/{{.Code}}"
+			testCode := "testcode"
+			expectContent := fmt.Sprintf("https://my.custom.url/org/%s/user/%s/password/%s", orgID, userID, testCode)
+			w.message = messages.Email{
+				Recipients: []string{lastEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			codeAlg, code := cryptoValue(t, ctrl, testCode)
+			expectSchemaUserTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().SchemaUserPasswordCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+					userDataCrypto: codeAlg,
+				}, args{
+					event: &schemauser.PasswordCodeAddedEvent{
+						BaseEvent: eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						Code:              code,
+						Expiry:            time.Hour,
+						NotificationType:  domain.NotificationTypeEmail,
+						URLTemplate:       urlTemplate,
+						TriggeredAtOrigin: eventOrigin,
+					},
+				}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceSchemaUserPasswordCodeAdded(a.event)
+			assert.NoError(t, err)
+			err = stmt.Execute(nil, "")
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_userNotifier_schemaUserCodeReturned(t *testing.T) {
+	baseEvent := func() *eventstore.BaseEvent {
+		return eventstore.BaseEventFromRepo(&repository.Event{
+			AggregateID:   userID,
+			ResourceOwner: sql.NullString{String: orgID},
+			CreationDate:  time.Now().UTC(),
+		})
+	}
+	u := &userNotifier{}
+	tests := []struct {
+		name  string
+		build notificationBuilder
+		event eventstore.Event
+	}{
+		{
+			name:  "email code",
+			build: u.schemaUserEmailCodeNotification,
+			event: &schemauser.EmailCodeAddedEvent{BaseEvent: baseEvent(), CodeReturned: true},
+		},
+		{
+			name:  "phone code",
+			build: u.schemaUserPhoneCodeNotification,
+			event: &schemauser.PhoneCodeAddedEvent{BaseEvent: baseEvent(), CodeReturned: true},
+		},
+		{
+			name:  "password code",
+			build: u.schemaUserPasswordCodeNotification,
+			event: &schemauser.PasswordCodeAddedEvent{BaseEvent: baseEvent(), CodeReturned: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := tt.build(context.Background(), tt.event)
+			assert.NoError(t, err)
+			assert.Nil(t, n)
+		})
+	}
+}
+
+func Test_userNotifier_reduceNotification(t *testing.T) {
+	sendErr := errors.New("send failed")
+	tests := []struct {
+		name           string
+		alreadyHandled bool
+		sendErr        error
+		expect         func(*mock.MockCommands)
+		wantSent       bool
+	}{
+		{
+			name:           "already handled",
+			alreadyHandled: true,
+			expect:         func(*mock.MockCommands) {},
+		},
+		{
+			name: "already requested",
+			expect: func(commands *mock.MockCommands) {
+				commands.EXPECT().RequestNotification(gomock.Any(), gomock.Any()).Return(domain.NotificationStateFailed, nil)
+			},
+		},
+		{
+			name: "sent",
+			expect: func(commands *mock.MockCommands) {
+				commands.EXPECT().RequestNotification(gomock.Any(), gomock.Any()).Return(domain.NotificationStateQueued, nil)
+				commands.EXPECT().NotificationSent(gomock.Any(), userID+"-15", orgID).Return(nil)
+			},
+			wantSent: true,
+		},
+		{
+			name:    "send failed",
+			sendErr: sendErr,
+			expect: func(commands *mock.MockCommands) {
+				commands.EXPECT().RequestNotification(gomock.Any(), gomock.Any()).Return(domain.NotificationStateQueued, nil)
+				commands.EXPECT().NotificationFailed(gomock.Any(), userID+"-15", orgID, sendErr, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ error, retryAt time.Time) error {
+						assert.WithinDuration(t, time.Now().Add(time.Second), retryAt, time.Second)
+						return nil
+					})
+			},
+			wantSent: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			commands := mock.NewMockCommands(ctrl)
+			tt.expect(commands)
+			u := &userNotifier{
+				commands: commands,
+				retryConfig: NotificationWorkerConfig{
+					MinRetryDelay:    time.Second,
+					RetryDelayFactor: 2,
+				},
+			}
+			var sent bool
+			stmt, err := u.reduceNotification(initCodeAddedRepoEvent(nil, time.Now()), func(context.Context, eventstore.Event) (*notification, error) {
+				return &notification{
+					userID:        userID,
+					resourceOwner: orgID,
+					alreadyHandled: func(context.Context) (bool, error) {
+						return tt.alreadyHandled, nil
+					},
+					send: func(context.Context) error {
+						sent = true
+						return tt.sendErr
+					},
+				}, nil
+			})
+			assert.NoError(t, err)
+			assert.NoError(t, stmt.Execute(nil, ""))
+			assert.Equal(t, tt.wantSent, sent)
 		})
 	}
 }
@@ -1434,6 +1671,8 @@ func newUserNotifier(t *testing.T, ctrl *gomock.Controller, queries *mock.MockQu
 	if w.err == nil {
 		w.message.TriggeringEvent = a.event
 		channel.EXPECT().HandleMessage(&w.message).Return(nil)
+		f.commands.EXPECT().RequestNotification(gomock.Any(), gomock.Any()).Return(domain.NotificationStateQueued, nil)
+		f.commands.EXPECT().NotificationSent(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 	}
 	return &userNotifier{
 		commands: f.commands,
@@ -1459,18 +1698,26 @@ type channels struct {
 	senders.Chain
 }
 
-func (c *channels) Email(context.Context) (*senders.Chain, *smtp.Config, error) {
+func (c *channels) Email(context.Context, string) (*senders.Chain, *smtp.Config, error) {
 	return &c.Chain, nil, nil
 }
 
-func (c *channels) SMS(context.Context) (*senders.Chain, *twilio.Config, error) {
-	return &c.Chain, nil, nil
+func (c *channels) SMS(context.Context) (*senders.Chain, error) {
+	return &c.Chain, nil
 }
 
 func (c *channels) Webhook(context.Context, webhook.Config) (*senders.Chain, error) {
 	return &c.Chain, nil
 }
 
+func (c *channels) SecurityTokenEvent(context.Context, set.Config) (*senders.Chain, error) {
+	return &c.Chain, nil
+}
+
+func (c *channels) SOAP(context.Context, soap.Config) (*senders.Chain, error) {
+	return &c.Chain, nil
+}
+
 func expectTemplateQueries(queries *mock.MockQueries, template string) {
 	queries.EXPECT().GetInstanceRestrictions(gomock.Any()).Return(query.Restrictions{
 		AllowedLanguages: []language.Tag{language.English},
@@ -1493,6 +1740,27 @@ func expectTemplateQueries(queries *mock.MockQueries, template string) {
 	queries.EXPECT().CustomTextListByTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(&query.CustomTexts{}, nil)
 }
 
+func expectSchemaUserTemplateQueries(queries *mock.MockQueries, template string) {
+	queries.EXPECT().GetInstanceRestrictions(gomock.Any()).Return(query.Restrictions{
+		AllowedLanguages: []language.Tag{language.English},
+	}, nil)
+	queries.EXPECT().ActiveLabelPolicyByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.LabelPolicy{
+		ID: policyID,
+		Light: query.Theme{
+			LogoURL: logoURL,
+		},
+	}, nil)
+	queries.EXPECT().MailTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.MailTemplate{Template: []byte(template)}, nil)
+	queries.EXPECT().GetNotifySchemaUserByID(gomock.Any(), gomock.Any(), userID).Return(&query.NotifyUser{
+		ID:            userID,
+		ResourceOwner: orgID,
+		LastEmail:     lastEmail,
+		VerifiedEmail: verifiedEmail,
+	}, nil)
+	queries.EXPECT().GetDefaultLanguage(gomock.Any()).Return(language.English)
+	queries.EXPECT().CustomTextListByTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(&query.CustomTexts{}, nil)
+}
+
 func cryptoValue(t *testing.T, ctrl *gomock.Controller, value string) (*crypto.MockEncryptionAlgorithm, *crypto.CryptoValue) {
 	encAlg := crypto.NewMockEncryptionAlgorithm(ctrl)
 	encAlg.EXPECT().Algorithm().AnyTimes().Return("enc")
@@ -0,0 +1,27 @@
+package messages
+
+import (
+	"net/url"
+
+	"github.com/gorilla/schema"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+)
+
+var _ channels.Message = (*Form)(nil)
+
+type Form struct {
+	Serializable    interface{}
+	TriggeringEvent eventstore.Event
+}
+
+func (msg *Form) GetContent() (string, error) {
+	values := make(url.Values)
+	err := schema.NewEncoder().Encode(msg.Serializable, values)
+	return values.Encode(), err
+}
+
+func (msg *Form) GetTriggeringEvent() eventstore.Event {
+	return msg.TriggeringEvent
+}
@@ -0,0 +1,22 @@
+package messages
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+)
+
+var _ channels.Message = (*SOAP)(nil)
+
+// SOAP is a message of the SAML SOAP binding, e.g. a logout request
+type SOAP struct {
+	Envelope        []byte
+	TriggeringEvent eventstore.Event
+}
+
+func (msg *SOAP) GetContent() (string, error) {
+	return string(msg.Envelope), nil
+}
+
+func (msg *SOAP) GetTriggeringEvent() eventstore.Event {
+	return msg.TriggeringEvent
+}
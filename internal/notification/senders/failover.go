@@ -0,0 +1,39 @@
+package senders
+
+import (
+	"errors"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+)
+
+var _ channels.NotificationChannel = (*Failover)(nil)
+
+type Failover struct {
+	channels []channels.NotificationChannel
+}
+
+func FailoverChannels(channel ...channels.NotificationChannel) *Failover {
+	return &Failover{channels: channel}
+}
+
+// HandleMessage sends the message to the channels in the same order they were provided to FailoverChannels()
+// and returns as soon as one channel succeeds.
+// If all channels fail, the joined errors are returned.
+func (f *Failover) HandleMessage(message channels.Message) error {
+	errs := make([]error, 0, len(f.channels))
+	for i := range f.channels {
+		err := f.channels[i].HandleMessage(message)
+		if err == nil {
+			return nil
+		}
+		logging.WithFields("channel", i).WithError(err).Warn("notification channel failed, trying next")
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (f *Failover) Len() int {
+	return len(f.channels)
+}
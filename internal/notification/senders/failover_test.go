@@ -0,0 +1,65 @@
+package senders
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	channel_mock "github.com/zitadel/zitadel/internal/notification/channels/mock"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+)
+
+func TestFailover_HandleMessage(t *testing.T) {
+	message := &messages.SMS{Content: "content"}
+	errProvider := errors.New("provider failed")
+	tests := []struct {
+		name     string
+		channels func(ctrl *gomock.Controller) []channels.NotificationChannel
+		wantErr  error
+	}{
+		{
+			name: "first succeeds",
+			channels: func(ctrl *gomock.Controller) []channels.NotificationChannel {
+				first := channel_mock.NewMockNotificationChannel(ctrl)
+				first.EXPECT().HandleMessage(message).Return(nil)
+				second := channel_mock.NewMockNotificationChannel(ctrl)
+				return []channels.NotificationChannel{first, second}
+			},
+		},
+		{
+			name: "first fails, second succeeds",
+			channels: func(ctrl *gomock.Controller) []channels.NotificationChannel {
+				first := channel_mock.NewMockNotificationChannel(ctrl)
+				first.EXPECT().HandleMessage(message).Return(errProvider)
+				second := channel_mock.NewMockNotificationChannel(ctrl)
+				second.EXPECT().HandleMessage(message).Return(nil)
+				return []channels.NotificationChannel{first, second}
+			},
+		},
+		{
+			name: "all fail",
+			channels: func(ctrl *gomock.Controller) []channels.NotificationChannel {
+				first := channel_mock.NewMockNotificationChannel(ctrl)
+				first.EXPECT().HandleMessage(message).Return(errProvider)
+				second := channel_mock.NewMockNotificationChannel(ctrl)
+				second.EXPECT().HandleMessage(message).Return(errProvider)
+				return []channels.NotificationChannel{first, second}
+			},
+			wantErr: errProvider,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			err := FailoverChannels(tt.channels(ctrl)...).HandleMessage(message)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -3,36 +3,76 @@ package senders
 import (
 	"context"
 
+	"github.com/zitadel/logging"
+
 	"github.com/zitadel/zitadel/internal/notification/channels"
 	"github.com/zitadel/zitadel/internal/notification/channels/fs"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpsms"
 	"github.com/zitadel/zitadel/internal/notification/channels/instrumenting"
 	"github.com/zitadel/zitadel/internal/notification/channels/log"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 )
 
-const twilioSpanName = "twilio.NotificationChannel"
+const (
+	twilioSpanName      = "twilio.NotificationChannel"
+	httpSMSSpanName     = "httpsms.NotificationChannel"
+	vonageSpanName      = "vonage.NotificationChannel"
+	messageBirdSpanName = "messagebird.NotificationChannel"
+)
 
+// SMSChannels creates a chain which sends the message to the first succeeding provider of smsConfigs
+// (providers are tried in the order of the slice) and to the debug channels.
 func SMSChannels(
 	ctx context.Context,
-	twilioConfig *twilio.Config,
+	smsConfigs []*sms.Config,
 	getFileSystemProvider func(ctx context.Context) (*fs.Config, error),
 	getLogProvider func(ctx context.Context) (*log.Config, error),
 	successMetricName,
 	failureMetricName string,
 ) (chain *Chain, err error) {
-	channels := make([]channels.NotificationChannel, 0, 3)
-	if twilioConfig != nil {
-		channels = append(
-			channels,
+	providers := make([]channels.NotificationChannel, 0, len(smsConfigs))
+	for _, smsConfig := range smsConfigs {
+		channel, spanName, err := smsProviderChannel(ctx, smsConfig)
+		if err != nil {
+			logging.WithError(err).Warn("unable to initialize sms provider")
+			continue
+		}
+		if channel == nil {
+			continue
+		}
+		providers = append(
+			providers,
 			instrumenting.Wrap(
 				ctx,
-				twilio.InitChannel(*twilioConfig),
-				twilioSpanName,
+				channel,
+				spanName,
 				successMetricName,
 				failureMetricName,
 			),
 		)
 	}
+	channels := make([]channels.NotificationChannel, 0, 3)
+	if len(providers) > 0 {
+		channels = append(channels, FailoverChannels(providers...))
+	}
 	channels = append(channels, debugChannels(ctx, getFileSystemProvider, getLogProvider)...)
 	return ChainChannels(channels...), nil
 }
+
+func smsProviderChannel(ctx context.Context, smsConfig *sms.Config) (channels.NotificationChannel, string, error) {
+	switch {
+	case smsConfig.TwilioConfig != nil:
+		return twilio.InitChannel(*smsConfig.TwilioConfig), twilioSpanName, nil
+	case smsConfig.HTTPConfig != nil:
+		channel, err := httpsms.InitChannel(ctx, *smsConfig.HTTPConfig)
+		return channel, httpSMSSpanName, err
+	case smsConfig.VonageConfig != nil:
+		return vonage.InitChannel(ctx, *smsConfig.VonageConfig), vonageSpanName, nil
+	case smsConfig.MessageBirdConfig != nil:
+		return messagebird.InitChannel(ctx, *smsConfig.MessageBirdConfig), messageBirdSpanName, nil
+	}
+	return nil, "", nil
+}
@@ -5,8 +5,9 @@ import (
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/notification/channels/set"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
-	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/soap"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/templates"
@@ -21,9 +22,11 @@ type Notify func(
 ) error
 
 type ChannelChains interface {
-	Email(context.Context) (*senders.Chain, *smtp.Config, error)
-	SMS(context.Context) (*senders.Chain, *twilio.Config, error)
+	Email(ctx context.Context, resourceOwner string) (*senders.Chain, *smtp.Config, error)
+	SMS(context.Context) (*senders.Chain, error)
 	Webhook(context.Context, webhook.Config) (*senders.Chain, error)
+	SecurityTokenEvent(context.Context, set.Config) (*senders.Chain, error)
+	SOAP(context.Context, soap.Config) (*senders.Chain, error)
 }
 
 func SendEmail(
@@ -103,3 +106,39 @@ func SendJSON(
 		)
 	}
 }
+
+func SendSecurityTokenEvent(
+	ctx context.Context,
+	setConfig set.Config,
+	channels ChannelChains,
+	token any,
+	triggeringEvent eventstore.Event,
+) Notify {
+	return func(_ string, _ map[string]interface{}, _ string, _ bool) error {
+		return handleSecurityTokenEvent(
+			ctx,
+			setConfig,
+			channels,
+			token,
+			triggeringEvent,
+		)
+	}
+}
+
+func SendSOAP(
+	ctx context.Context,
+	soapConfig soap.Config,
+	channels ChannelChains,
+	envelope []byte,
+	triggeringEvent eventstore.Event,
+) Notify {
+	return func(_ string, _ map[string]interface{}, _ string, _ bool) error {
+		return handleSOAP(
+			ctx,
+			soapConfig,
+			channels,
+			envelope,
+			triggeringEvent,
+		)
+	}
+}
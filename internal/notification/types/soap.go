@@ -0,0 +1,27 @@
+package types
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels/soap"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+)
+
+func handleSOAP(
+	ctx context.Context,
+	soapConfig soap.Config,
+	channels ChannelChains,
+	envelope []byte,
+	triggeringEvent eventstore.Event,
+) error {
+	message := &messages.SOAP{
+		Envelope:        envelope,
+		TriggeringEvent: triggeringEvent,
+	}
+	soapChannels, err := channels.SOAP(ctx, soapConfig)
+	if err != nil {
+		return err
+	}
+	return soapChannels.HandleMessage(message)
+}
@@ -29,7 +29,7 @@ func generateEmail(
 	if lastEmail {
 		message.Recipients = []string{user.LastEmail}
 	}
-	emailChannels, _, err := channels.Email(ctx)
+	emailChannels, _, err := channels.Email(ctx, user.ResourceOwner)
 	if err != nil {
 		return err
 	}
@@ -19,17 +19,12 @@ func generateSms(
 	lastPhone bool,
 	triggeringEvent eventstore.Event,
 ) error {
-	number := ""
-	smsChannels, twilioConfig, err := channels.SMS(ctx)
+	smsChannels, err := channels.SMS(ctx)
 	logging.OnError(err).Error("could not create sms channel")
 	if smsChannels == nil || smsChannels.Len() == 0 {
 		return zerrors.ThrowPreconditionFailed(nil, "PHONE-w8nfow", "Errors.Notification.Channels.NotPresent")
 	}
-	if err == nil {
-		number = twilioConfig.SenderNumber
-	}
 	message := &messages.SMS{
-		SenderPhoneNumber:    number,
 		RecipientPhoneNumber: user.VerifiedPhone,
 		Content:              content,
 		TriggeringEvent:      triggeringEvent,
@@ -13,7 +13,6 @@ import (
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
-	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -41,30 +40,35 @@ type App struct {
 }
 
 type OIDCApp struct {
-	RedirectURIs             database.TextArray[string]
-	ResponseTypes            database.NumberArray[domain.OIDCResponseType]
-	GrantTypes               database.NumberArray[domain.OIDCGrantType]
-	AppType                  domain.OIDCApplicationType
-	ClientID                 string
-	AuthMethodType           domain.OIDCAuthMethodType
-	PostLogoutRedirectURIs   database.TextArray[string]
-	Version                  domain.OIDCVersion
-	ComplianceProblems       database.TextArray[string]
-	IsDevMode                bool
-	AccessTokenType          domain.OIDCTokenType
-	AssertAccessTokenRole    bool
-	AssertIDTokenRole        bool
-	AssertIDTokenUserinfo    bool
-	ClockSkew                time.Duration
-	AdditionalOrigins        database.TextArray[string]
-	AllowedOrigins           database.TextArray[string]
-	SkipNativeAppSuccessPage bool
+	RedirectURIs                     database.TextArray[string]
+	ResponseTypes                    database.NumberArray[domain.OIDCResponseType]
+	GrantTypes                       database.NumberArray[domain.OIDCGrantType]
+	AppType                          domain.OIDCApplicationType
+	ClientID                         string
+	AuthMethodType                   domain.OIDCAuthMethodType
+	PostLogoutRedirectURIs           database.TextArray[string]
+	Version                          domain.OIDCVersion
+	ComplianceProblems               database.TextArray[string]
+	IsDevMode                        bool
+	AccessTokenType                  domain.OIDCTokenType
+	AssertAccessTokenRole            bool
+	AssertIDTokenRole                bool
+	AssertIDTokenUserinfo            bool
+	ClockSkew                        time.Duration
+	AdditionalOrigins                database.TextArray[string]
+	AllowedOrigins                   database.TextArray[string]
+	SkipNativeAppSuccessPage         bool
+	BackChannelLogoutURI             string
+	BackChannelLogoutSessionRequired bool
+	DPoPBoundAccessTokens            bool
+	RequirePushedAuthRequests        bool
 }
 
 type SAMLApp struct {
-	Metadata    []byte
-	MetadataURL string
-	EntityID    string
+	Metadata            []byte
+	MetadataURL         string
+	EntityID            string
+	AllowUnsignedLogout bool
 }
 
 type APIApp struct {
@@ -149,6 +153,10 @@ var (
 		name:  projection.AppSAMLConfigColumnMetadataURL,
 		table: appSAMLConfigsTable,
 	}
+	AppSAMLConfigColumnAllowUnsignedLogout = Column{
+		name:  projection.AppSAMLConfigColumnAllowUnsignedLogout,
+		table: appSAMLConfigsTable,
+	}
 )
 
 var (
@@ -243,6 +251,22 @@ var (
 		name:  projection.AppOIDCConfigColumnSkipNativeAppSuccessPage,
 		table: appOIDCConfigsTable,
 	}
+	AppOIDCConfigColumnBackChannelLogoutURI = Column{
+		name:  projection.AppOIDCConfigColumnBackChannelLogoutURI,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnBackChannelLogoutSessionRequired = Column{
+		name:  projection.AppOIDCConfigColumnBackChannelLogoutSessionRequired,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnDPoPBoundAccessTokens = Column{
+		name:  projection.AppOIDCConfigColumnDPoPBoundAccessTokens,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRequirePushedAuthRequests = Column{
+		name:  projection.AppOIDCConfigColumnRequirePushedAuthRequests,
+		table: appOIDCConfigsTable,
+	}
 )
 
 func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bool, projectID, appID string) (app *App, err error) {
@@ -251,7 +275,7 @@ func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bo
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerAppProjection")
-		ctx, err = projection.AppProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.AppProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("trigger failed")
 		traceSpan.EndWithError(err)
 	}
@@ -461,7 +485,7 @@ func (q *Queries) SearchClientIDs(ctx context.Context, queries *AppSearchQueries
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerAppProjection")
-		ctx, err = projection.AppProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.AppProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("trigger failed")
 		traceSpan.EndWithError(err)
 	}
@@ -523,11 +547,16 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutSessionRequired.identifier(),
+			AppOIDCConfigColumnDPoPBoundAccessTokens.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthRequests.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
 			AppSAMLConfigColumnMetadata.identifier(),
 			AppSAMLConfigColumnMetadataURL.identifier(),
+			AppSAMLConfigColumnAllowUnsignedLogout.identifier(),
 		).From(appsTable.identifier()).
 			LeftJoin(join(AppAPIConfigColumnAppID, AppColumnID)).
 			LeftJoin(join(AppOIDCConfigColumnAppID, AppColumnID)).
@@ -572,11 +601,16 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 				&oidcConfig.clockSkew,
 				&oidcConfig.additionalOrigins,
 				&oidcConfig.skipNativeAppSuccessPage,
+				&oidcConfig.backChannelLogoutURI,
+				&oidcConfig.backChannelLogoutSessionRequired,
+				&oidcConfig.dpopBoundAccessTokens,
+				&oidcConfig.requirePushedAuthRequests,
 
 				&samlConfig.appID,
 				&samlConfig.entityID,
 				&samlConfig.metadata,
 				&samlConfig.metadataURL,
+				&samlConfig.allowUnsignedLogout,
 			)
 
 			if err != nil {
@@ -622,6 +656,10 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutSessionRequired.identifier(),
+			AppOIDCConfigColumnDPoPBoundAccessTokens.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthRequests.identifier(),
 		).From(appsTable.identifier()).
 			Join(join(AppOIDCConfigColumnAppID, AppColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*App, error) {
@@ -658,6 +696,10 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 				&oidcConfig.clockSkew,
 				&oidcConfig.additionalOrigins,
 				&oidcConfig.skipNativeAppSuccessPage,
+				&oidcConfig.backChannelLogoutURI,
+				&oidcConfig.backChannelLogoutSessionRequired,
+				&oidcConfig.dpopBoundAccessTokens,
+				&oidcConfig.requirePushedAuthRequests,
 			)
 
 			if err != nil {
@@ -688,6 +730,7 @@ func prepareSAMLAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			AppSAMLConfigColumnEntityID.identifier(),
 			AppSAMLConfigColumnMetadata.identifier(),
 			AppSAMLConfigColumnMetadataURL.identifier(),
+			AppSAMLConfigColumnAllowUnsignedLogout.identifier(),
 		).From(appsTable.identifier()).
 			Join(join(AppSAMLConfigColumnAppID, AppColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*App, error) {
@@ -711,6 +754,7 @@ func prepareSAMLAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				&samlConfig.entityID,
 				&samlConfig.metadata,
 				&samlConfig.metadataURL,
+				&samlConfig.allowUnsignedLogout,
 			)
 
 			if err != nil {
@@ -867,11 +911,16 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutSessionRequired.identifier(),
+			AppOIDCConfigColumnDPoPBoundAccessTokens.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthRequests.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
 			AppSAMLConfigColumnMetadata.identifier(),
 			AppSAMLConfigColumnMetadataURL.identifier(),
+			AppSAMLConfigColumnAllowUnsignedLogout.identifier(),
 			countColumn.identifier(),
 		).From(appsTable.identifier()).
 			LeftJoin(join(AppAPIConfigColumnAppID, AppColumnID)).
@@ -919,11 +968,16 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 					&oidcConfig.clockSkew,
 					&oidcConfig.additionalOrigins,
 					&oidcConfig.skipNativeAppSuccessPage,
+					&oidcConfig.backChannelLogoutURI,
+					&oidcConfig.backChannelLogoutSessionRequired,
+					&oidcConfig.dpopBoundAccessTokens,
+					&oidcConfig.requirePushedAuthRequests,
 
 					&samlConfig.appID,
 					&samlConfig.entityID,
 					&samlConfig.metadata,
 					&samlConfig.metadataURL,
+					&samlConfig.allowUnsignedLogout,
 
 					&apps.Count,
 				)
@@ -974,23 +1028,27 @@ func prepareClientIDsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 }
 
 type sqlOIDCConfig struct {
-	appID                    sql.NullString
-	version                  sql.NullInt32
-	clientID                 sql.NullString
-	redirectUris             database.TextArray[string]
-	applicationType          sql.NullInt16
-	authMethodType           sql.NullInt16
-	postLogoutRedirectUris   database.TextArray[string]
-	devMode                  sql.NullBool
-	accessTokenType          sql.NullInt16
-	accessTokenRoleAssertion sql.NullBool
-	iDTokenRoleAssertion     sql.NullBool
-	iDTokenUserinfoAssertion sql.NullBool
-	clockSkew                sql.NullInt64
-	additionalOrigins        database.TextArray[string]
-	responseTypes            database.NumberArray[domain.OIDCResponseType]
-	grantTypes               database.NumberArray[domain.OIDCGrantType]
-	skipNativeAppSuccessPage sql.NullBool
+	appID                            sql.NullString
+	version                          sql.NullInt32
+	clientID                         sql.NullString
+	redirectUris                     database.TextArray[string]
+	applicationType                  sql.NullInt16
+	authMethodType                   sql.NullInt16
+	postLogoutRedirectUris           database.TextArray[string]
+	devMode                          sql.NullBool
+	accessTokenType                  sql.NullInt16
+	accessTokenRoleAssertion         sql.NullBool
+	iDTokenRoleAssertion             sql.NullBool
+	iDTokenUserinfoAssertion         sql.NullBool
+	clockSkew                        sql.NullInt64
+	additionalOrigins                database.TextArray[string]
+	responseTypes                    database.NumberArray[domain.OIDCResponseType]
+	grantTypes                       database.NumberArray[domain.OIDCGrantType]
+	skipNativeAppSuccessPage         sql.NullBool
+	backChannelLogoutURI             sql.NullString
+	backChannelLogoutSessionRequired sql.NullBool
+	dpopBoundAccessTokens            sql.NullBool
+	requirePushedAuthRequests        sql.NullBool
 }
 
 func (c sqlOIDCConfig) set(app *App) {
@@ -998,22 +1056,26 @@ func (c sqlOIDCConfig) set(app *App) {
 		return
 	}
 	app.OIDCConfig = &OIDCApp{
-		Version:                  domain.OIDCVersion(c.version.Int32),
-		ClientID:                 c.clientID.String,
-		RedirectURIs:             c.redirectUris,
-		AppType:                  domain.OIDCApplicationType(c.applicationType.Int16),
-		AuthMethodType:           domain.OIDCAuthMethodType(c.authMethodType.Int16),
-		PostLogoutRedirectURIs:   c.postLogoutRedirectUris,
-		IsDevMode:                c.devMode.Bool,
-		AccessTokenType:          domain.OIDCTokenType(c.accessTokenType.Int16),
-		AssertAccessTokenRole:    c.accessTokenRoleAssertion.Bool,
-		AssertIDTokenRole:        c.iDTokenRoleAssertion.Bool,
-		AssertIDTokenUserinfo:    c.iDTokenUserinfoAssertion.Bool,
-		ClockSkew:                time.Duration(c.clockSkew.Int64),
-		AdditionalOrigins:        c.additionalOrigins,
-		ResponseTypes:            c.responseTypes,
-		GrantTypes:               c.grantTypes,
-		SkipNativeAppSuccessPage: c.skipNativeAppSuccessPage.Bool,
+		Version:                          domain.OIDCVersion(c.version.Int32),
+		ClientID:                         c.clientID.String,
+		RedirectURIs:                     c.redirectUris,
+		AppType:                          domain.OIDCApplicationType(c.applicationType.Int16),
+		AuthMethodType:                   domain.OIDCAuthMethodType(c.authMethodType.Int16),
+		PostLogoutRedirectURIs:           c.postLogoutRedirectUris,
+		IsDevMode:                        c.devMode.Bool,
+		AccessTokenType:                  domain.OIDCTokenType(c.accessTokenType.Int16),
+		AssertAccessTokenRole:            c.accessTokenRoleAssertion.Bool,
+		AssertIDTokenRole:                c.iDTokenRoleAssertion.Bool,
+		AssertIDTokenUserinfo:            c.iDTokenUserinfoAssertion.Bool,
+		ClockSkew:                        time.Duration(c.clockSkew.Int64),
+		AdditionalOrigins:                c.additionalOrigins,
+		ResponseTypes:                    c.responseTypes,
+		GrantTypes:                       c.grantTypes,
+		SkipNativeAppSuccessPage:         c.skipNativeAppSuccessPage.Bool,
+		BackChannelLogoutURI:             c.backChannelLogoutURI.String,
+		BackChannelLogoutSessionRequired: c.backChannelLogoutSessionRequired.Bool,
+		DPoPBoundAccessTokens:            c.dpopBoundAccessTokens.Bool,
+		RequirePushedAuthRequests:        c.requirePushedAuthRequests.Bool,
 	}
 	compliance := domain.GetOIDCCompliance(app.OIDCConfig.Version, app.OIDCConfig.AppType, app.OIDCConfig.GrantTypes, app.OIDCConfig.ResponseTypes, app.OIDCConfig.AuthMethodType, app.OIDCConfig.RedirectURIs)
 	app.OIDCConfig.ComplianceProblems = compliance.Problems
@@ -1024,10 +1086,11 @@ func (c sqlOIDCConfig) set(app *App) {
 }
 
 type sqlSAMLConfig struct {
-	appID       sql.NullString
-	entityID    sql.NullString
-	metadataURL sql.NullString
-	metadata    []byte
+	appID               sql.NullString
+	entityID            sql.NullString
+	metadataURL         sql.NullString
+	metadata            []byte
+	allowUnsignedLogout sql.NullBool
 }
 
 func (c sqlSAMLConfig) set(app *App) {
@@ -1035,9 +1098,10 @@ func (c sqlSAMLConfig) set(app *App) {
 		return
 	}
 	app.SAMLConfig = &SAMLApp{
-		MetadataURL: c.metadataURL.String,
-		Metadata:    c.metadata,
-		EntityID:    c.entityID.String,
+		MetadataURL:         c.metadataURL.String,
+		Metadata:            c.metadata,
+		EntityID:            c.entityID.String,
+		AllowUnsignedLogout: c.allowUnsignedLogout.Bool,
 	}
 }
 
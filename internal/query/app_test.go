@@ -15,98 +15,108 @@ import (
 )
 
 var (
-	expectedAppQuery = regexp.QuoteMeta(`SELECT projections.apps7.id,` +
-		` projections.apps7.name,` +
-		` projections.apps7.project_id,` +
-		` projections.apps7.creation_date,` +
-		` projections.apps7.change_date,` +
-		` projections.apps7.resource_owner,` +
-		` projections.apps7.state,` +
-		` projections.apps7.sequence,` +
+	expectedAppQuery = regexp.QuoteMeta(`SELECT projections.apps11.id,` +
+		` projections.apps11.name,` +
+		` projections.apps11.project_id,` +
+		` projections.apps11.creation_date,` +
+		` projections.apps11.change_date,` +
+		` projections.apps11.resource_owner,` +
+		` projections.apps11.state,` +
+		` projections.apps11.sequence,` +
 		// api config
-		` projections.apps7_api_configs.app_id,` +
-		` projections.apps7_api_configs.client_id,` +
-		` projections.apps7_api_configs.auth_method,` +
+		` projections.apps11_api_configs.app_id,` +
+		` projections.apps11_api_configs.client_id,` +
+		` projections.apps11_api_configs.auth_method,` +
 		// oidc config
-		` projections.apps7_oidc_configs.app_id,` +
-		` projections.apps7_oidc_configs.version,` +
-		` projections.apps7_oidc_configs.client_id,` +
-		` projections.apps7_oidc_configs.redirect_uris,` +
-		` projections.apps7_oidc_configs.response_types,` +
-		` projections.apps7_oidc_configs.grant_types,` +
-		` projections.apps7_oidc_configs.application_type,` +
-		` projections.apps7_oidc_configs.auth_method_type,` +
-		` projections.apps7_oidc_configs.post_logout_redirect_uris,` +
-		` projections.apps7_oidc_configs.is_dev_mode,` +
-		` projections.apps7_oidc_configs.access_token_type,` +
-		` projections.apps7_oidc_configs.access_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_userinfo_assertion,` +
-		` projections.apps7_oidc_configs.clock_skew,` +
-		` projections.apps7_oidc_configs.additional_origins,` +
-		` projections.apps7_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps11_oidc_configs.app_id,` +
+		` projections.apps11_oidc_configs.version,` +
+		` projections.apps11_oidc_configs.client_id,` +
+		` projections.apps11_oidc_configs.redirect_uris,` +
+		` projections.apps11_oidc_configs.response_types,` +
+		` projections.apps11_oidc_configs.grant_types,` +
+		` projections.apps11_oidc_configs.application_type,` +
+		` projections.apps11_oidc_configs.auth_method_type,` +
+		` projections.apps11_oidc_configs.post_logout_redirect_uris,` +
+		` projections.apps11_oidc_configs.is_dev_mode,` +
+		` projections.apps11_oidc_configs.access_token_type,` +
+		` projections.apps11_oidc_configs.access_token_role_assertion,` +
+		` projections.apps11_oidc_configs.id_token_role_assertion,` +
+		` projections.apps11_oidc_configs.id_token_userinfo_assertion,` +
+		` projections.apps11_oidc_configs.clock_skew,` +
+		` projections.apps11_oidc_configs.additional_origins,` +
+		` projections.apps11_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps11_oidc_configs.back_channel_logout_uri,` +
+		` projections.apps11_oidc_configs.back_channel_logout_session_required,` +
+		` projections.apps11_oidc_configs.dpop_bound_access_tokens,` +
+		` projections.apps11_oidc_configs.require_pushed_auth_requests,` +
 		//saml config
-		` projections.apps7_saml_configs.app_id,` +
-		` projections.apps7_saml_configs.entity_id,` +
-		` projections.apps7_saml_configs.metadata,` +
-		` projections.apps7_saml_configs.metadata_url` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+		` projections.apps11_saml_configs.app_id,` +
+		` projections.apps11_saml_configs.entity_id,` +
+		` projections.apps11_saml_configs.metadata,` +
+		` projections.apps11_saml_configs.metadata_url,` +
+		` projections.apps11_saml_configs.allow_unsigned_logout` +
+		` FROM projections.apps11` +
+		` LEFT JOIN projections.apps11_api_configs ON projections.apps11.id = projections.apps11_api_configs.app_id AND projections.apps11.instance_id = projections.apps11_api_configs.instance_id` +
+		` LEFT JOIN projections.apps11_oidc_configs ON projections.apps11.id = projections.apps11_oidc_configs.app_id AND projections.apps11.instance_id = projections.apps11_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps11_saml_configs ON projections.apps11.id = projections.apps11_saml_configs.app_id AND projections.apps11.instance_id = projections.apps11_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedAppsQuery = regexp.QuoteMeta(`SELECT projections.apps7.id,` +
-		` projections.apps7.name,` +
-		` projections.apps7.project_id,` +
-		` projections.apps7.creation_date,` +
-		` projections.apps7.change_date,` +
-		` projections.apps7.resource_owner,` +
-		` projections.apps7.state,` +
-		` projections.apps7.sequence,` +
+	expectedAppsQuery = regexp.QuoteMeta(`SELECT projections.apps11.id,` +
+		` projections.apps11.name,` +
+		` projections.apps11.project_id,` +
+		` projections.apps11.creation_date,` +
+		` projections.apps11.change_date,` +
+		` projections.apps11.resource_owner,` +
+		` projections.apps11.state,` +
+		` projections.apps11.sequence,` +
 		// api config
-		` projections.apps7_api_configs.app_id,` +
-		` projections.apps7_api_configs.client_id,` +
-		` projections.apps7_api_configs.auth_method,` +
+		` projections.apps11_api_configs.app_id,` +
+		` projections.apps11_api_configs.client_id,` +
+		` projections.apps11_api_configs.auth_method,` +
 		// oidc config
-		` projections.apps7_oidc_configs.app_id,` +
-		` projections.apps7_oidc_configs.version,` +
-		` projections.apps7_oidc_configs.client_id,` +
-		` projections.apps7_oidc_configs.redirect_uris,` +
-		` projections.apps7_oidc_configs.response_types,` +
-		` projections.apps7_oidc_configs.grant_types,` +
-		` projections.apps7_oidc_configs.application_type,` +
-		` projections.apps7_oidc_configs.auth_method_type,` +
-		` projections.apps7_oidc_configs.post_logout_redirect_uris,` +
-		` projections.apps7_oidc_configs.is_dev_mode,` +
-		` projections.apps7_oidc_configs.access_token_type,` +
-		` projections.apps7_oidc_configs.access_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_userinfo_assertion,` +
-		` projections.apps7_oidc_configs.clock_skew,` +
-		` projections.apps7_oidc_configs.additional_origins,` +
-		` projections.apps7_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps11_oidc_configs.app_id,` +
+		` projections.apps11_oidc_configs.version,` +
+		` projections.apps11_oidc_configs.client_id,` +
+		` projections.apps11_oidc_configs.redirect_uris,` +
+		` projections.apps11_oidc_configs.response_types,` +
+		` projections.apps11_oidc_configs.grant_types,` +
+		` projections.apps11_oidc_configs.application_type,` +
+		` projections.apps11_oidc_configs.auth_method_type,` +
+		` projections.apps11_oidc_configs.post_logout_redirect_uris,` +
+		` projections.apps11_oidc_configs.is_dev_mode,` +
+		` projections.apps11_oidc_configs.access_token_type,` +
+		` projections.apps11_oidc_configs.access_token_role_assertion,` +
+		` projections.apps11_oidc_configs.id_token_role_assertion,` +
+		` projections.apps11_oidc_configs.id_token_userinfo_assertion,` +
+		` projections.apps11_oidc_configs.clock_skew,` +
+		` projections.apps11_oidc_configs.additional_origins,` +
+		` projections.apps11_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps11_oidc_configs.back_channel_logout_uri,` +
+		` projections.apps11_oidc_configs.back_channel_logout_session_required,` +
+		` projections.apps11_oidc_configs.dpop_bound_access_tokens,` +
+		` projections.apps11_oidc_configs.require_pushed_auth_requests,` +
 		//saml config
-		` projections.apps7_saml_configs.app_id,` +
-		` projections.apps7_saml_configs.entity_id,` +
-		` projections.apps7_saml_configs.metadata,` +
-		` projections.apps7_saml_configs.metadata_url,` +
+		` projections.apps11_saml_configs.app_id,` +
+		` projections.apps11_saml_configs.entity_id,` +
+		` projections.apps11_saml_configs.metadata,` +
+		` projections.apps11_saml_configs.metadata_url,` +
+		` projections.apps11_saml_configs.allow_unsigned_logout,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+		` FROM projections.apps11` +
+		` LEFT JOIN projections.apps11_api_configs ON projections.apps11.id = projections.apps11_api_configs.app_id AND projections.apps11.instance_id = projections.apps11_api_configs.instance_id` +
+		` LEFT JOIN projections.apps11_oidc_configs ON projections.apps11.id = projections.apps11_oidc_configs.app_id AND projections.apps11.instance_id = projections.apps11_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps11_saml_configs ON projections.apps11.id = projections.apps11_saml_configs.app_id AND projections.apps11.instance_id = projections.apps11_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedAppIDsQuery = regexp.QuoteMeta(`SELECT projections.apps7_api_configs.client_id,` +
-		` projections.apps7_oidc_configs.client_id` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
+	expectedAppIDsQuery = regexp.QuoteMeta(`SELECT projections.apps11_api_configs.client_id,` +
+		` projections.apps11_oidc_configs.client_id` +
+		` FROM projections.apps11` +
+		` LEFT JOIN projections.apps11_api_configs ON projections.apps11.id = projections.apps11_api_configs.app_id AND projections.apps11.instance_id = projections.apps11_api_configs.instance_id` +
+		` LEFT JOIN projections.apps11_oidc_configs ON projections.apps11.id = projections.apps11_oidc_configs.app_id AND projections.apps11.instance_id = projections.apps11_oidc_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedProjectIDByAppQuery = regexp.QuoteMeta(`SELECT projections.apps7.project_id` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+	expectedProjectIDByAppQuery = regexp.QuoteMeta(`SELECT projections.apps11.project_id` +
+		` FROM projections.apps11` +
+		` LEFT JOIN projections.apps11_api_configs ON projections.apps11.id = projections.apps11_api_configs.app_id AND projections.apps11.instance_id = projections.apps11_api_configs.instance_id` +
+		` LEFT JOIN projections.apps11_oidc_configs ON projections.apps11.id = projections.apps11_oidc_configs.app_id AND projections.apps11.instance_id = projections.apps11_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps11_saml_configs ON projections.apps11.id = projections.apps11_saml_configs.app_id AND projections.apps11.instance_id = projections.apps11_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 	expectedProjectByAppQuery = regexp.QuoteMeta(`SELECT projections.projects4.id,` +
 		` projections.projects4.creation_date,` +
@@ -120,10 +130,10 @@ var (
 		` projections.projects4.has_project_check,` +
 		` projections.projects4.private_labeling_setting` +
 		` FROM projections.projects4` +
-		` JOIN projections.apps7 ON projections.projects4.id = projections.apps7.project_id AND projections.projects4.instance_id = projections.apps7.instance_id` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+		` JOIN projections.apps11 ON projections.projects4.id = projections.apps11.project_id AND projections.projects4.instance_id = projections.apps11.instance_id` +
+		` LEFT JOIN projections.apps11_api_configs ON projections.apps11.id = projections.apps11_api_configs.app_id AND projections.apps11.instance_id = projections.apps11_api_configs.instance_id` +
+		` LEFT JOIN projections.apps11_oidc_configs ON projections.apps11.id = projections.apps11_oidc_configs.app_id AND projections.apps11.instance_id = projections.apps11_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps11_saml_configs ON projections.apps11.id = projections.apps11_saml_configs.app_id AND projections.apps11.instance_id = projections.apps11_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 
 	appCols = database.TextArray[string]{
@@ -157,11 +167,16 @@ var (
 		"clock_skew",
 		"additional_origins",
 		"skip_native_app_success_page",
+		"back_channel_logout_uri",
+		"back_channel_logout_session_required",
+		"dpop_bound_access_tokens",
+		"require_pushed_auth_requests",
 		//saml config
 		"app_id",
 		"entity_id",
 		"metadata",
 		"metadata_url",
+		"allow_unsigned_logout",
 	}
 	appsCols = append(appCols, "count")
 )
@@ -228,11 +243,16 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -294,11 +314,16 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -363,11 +388,16 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
 							[]byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 							"https://test.com/saml/metadata",
+							false,
 						},
 					},
 				),
@@ -434,11 +464,16 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -520,11 +555,16 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -606,11 +646,16 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -692,11 +737,16 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -778,11 +828,16 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -864,11 +919,16 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							true,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -950,11 +1010,16 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 						{
 							"api-app-id",
@@ -987,11 +1052,16 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 						{
 							"saml-app-id",
@@ -1024,11 +1094,16 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
 							[]byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 							"https://test.com/saml/metadata",
+							false,
 						},
 					},
 				),
@@ -1195,11 +1270,16 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
 						nil,
 						nil,
+						nil,
 					},
 				),
 			},
@@ -1253,11 +1333,16 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1316,11 +1401,16 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1394,11 +1484,16 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
 							[]byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 							"https://test.com/saml/metadata",
+							false,
 						},
 					},
 				),
@@ -1458,11 +1553,16 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1537,11 +1637,16 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1616,11 +1721,16 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1695,11 +1805,16 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							"",
+							false,
+							false,
+							false,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -14,7 +14,6 @@ import (
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
-	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -54,7 +53,7 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerAuthRequestProjection")
-		ctx, err = projection.AuthRequestProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.AuthRequestProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("trigger failed")
 		traceSpan.EndWithError(err)
 	}
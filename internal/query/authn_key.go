@@ -12,7 +12,6 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/domain"
-	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -183,7 +182,7 @@ func (q *Queries) GetAuthNKeyByID(ctx context.Context, shouldTriggerBulk bool, i
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerAuthNKeyProjection")
-		ctx, err = projection.AuthNKeyProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.AuthNKeyProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("trigger failed")
 		traceSpan.EndWithError(err)
 	}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -127,6 +128,14 @@ func (q *Queries) ClearCurrentSequence(ctx context.Context, projectionName strin
 	return nil
 }
 
+// RebuildProjection rebuilds the projection without truncating its tables, see [projection.Rebuild]
+func (q *Queries) RebuildProjection(ctx context.Context, projectionName string, instanceIDs []string, progress func(*handler.RebuildProgress)) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return projection.Rebuild(ctx, projectionName, instanceIDs, progress)
+}
+
 func (q *Queries) checkAndLock(tx *sql.Tx, projectionName string) (name string, err error) {
 	stmt, args, err := sq.Select(CurrentStateColProjectionName.identifier()).
 		From(currentStateTable.identifier()).
@@ -12,7 +12,6 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/domain"
-	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -94,7 +93,7 @@ func (q *Queries) DomainPolicyByOrg(ctx context.Context, shouldTriggerBulk bool,
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerDomainPolicyProjection")
-		ctx, err = projection.DomainPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.DomainPolicyProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("trigger failed")
 		traceSpan.EndWithError(err)
 	}
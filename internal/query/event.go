@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
@@ -14,6 +15,7 @@ type Event struct {
 	Editor       *EventEditor
 	Aggregate    *eventstore.Aggregate
 	Sequence     uint64
+	Position     float64
 	CreationDate time.Time
 	Type         string
 	Payload      []byte
@@ -71,6 +73,47 @@ func filterAuditLogRetention(ctx context.Context, auditLogRetention time.Duratio
 	return builder
 }
 
+// AddEventTypesAndAggregatesQuery adds a query for the event types, aggregate id and aggregate types to the builder.
+// If no aggregate types are given, they are derived from the event types.
+func AddEventTypesAndAggregatesQuery(builder *eventstore.SearchQueryBuilder, reqEventTypes []string, reqAggregateID string, reqAggregateTypes []string) {
+	eventTypes := make([]eventstore.EventType, len(reqEventTypes))
+	for i, eventType := range reqEventTypes {
+		eventTypes[i] = eventstore.EventType(eventType)
+	}
+
+	aggregateIDs := make([]string, 0, 1)
+	if reqAggregateID != "" {
+		aggregateIDs = append(aggregateIDs, reqAggregateID)
+	}
+
+	aggregateTypes := make([]eventstore.AggregateType, len(reqAggregateTypes))
+	for i, aggregateType := range reqAggregateTypes {
+		aggregateTypes[i] = eventstore.AggregateType(aggregateType)
+	}
+	if len(aggregateTypes) == 0 {
+		aggregateTypes = aggregateTypesFromEventTypes(eventTypes)
+	}
+	aggregateTypes = slices.Compact(aggregateTypes)
+
+	if len(aggregateIDs) > 0 || len(aggregateTypes) > 0 || len(eventTypes) > 0 {
+		builder.AddQuery().
+			AggregateIDs(aggregateIDs...).
+			AggregateTypes(aggregateTypes...).
+			EventTypes(eventTypes...).
+			Builder()
+	}
+}
+
+func aggregateTypesFromEventTypes(eventTypes []eventstore.EventType) []eventstore.AggregateType {
+	aggregateTypes := make([]eventstore.AggregateType, 0, len(eventTypes))
+
+	for _, eventType := range eventTypes {
+		aggregateTypes = append(aggregateTypes, eventstore.AggregateTypeFromEventType(eventType))
+	}
+
+	return aggregateTypes
+}
+
 func (q *Queries) SearchEventTypes(ctx context.Context) []string {
 	return q.eventstore.EventTypes()
 }
@@ -104,6 +147,7 @@ func (er *eventsReducer) convertEvent(ctx context.Context, event eventstore.Even
 		},
 		Aggregate:    event.Aggregate(),
 		Sequence:     event.Sequence(),
+		Position:     event.Position(),
 		CreationDate: event.CreatedAt(),
 		Type:         string(event.Type()),
 		Payload:      event.DataAsBytes(),
@@ -0,0 +1,71 @@
+package query
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+type EventWatchConfig struct {
+	// PollInterval is the delay between two queries once all stored events were sent
+	PollInterval time.Duration
+	// BulkLimit is the maximum amount of events queried at once
+	BulkLimit uint16
+}
+
+// EventCursor points to the last event sent to a watcher.
+// Events pushed in the same transaction share their position,
+// so Offset counts the events already sent at Position.
+type EventCursor struct {
+	Position float64
+	Offset   uint32
+}
+
+func (c EventCursor) next(event *Event) EventCursor {
+	if event.Position == c.Position {
+		return EventCursor{Position: c.Position, Offset: c.Offset + 1}
+	}
+	return EventCursor{Position: event.Position, Offset: 1}
+}
+
+// WatchEvents sends all events matching the query which were stored after the cursor
+// and keeps sending new events until the context is done or send returns an error.
+// The events are polled from the events table, which allows watchers to resume from any cursor,
+// e.g. after a restart of ZITADEL or the watcher.
+func (q *Queries) WatchEvents(ctx context.Context, config EventWatchConfig, query *eventstore.SearchQueryBuilder, cursor EventCursor, send func(event *Event, cursor EventCursor) error) error {
+	query = query.
+		OrderAsc().
+		AwaitOpenTransactions().
+		Limit(uint64(config.BulkLimit))
+	for {
+		events, err := q.SearchEvents(ctx, eventWatchQuery(query, cursor))
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			cursor = cursor.next(event)
+			if err = send(event, cursor); err != nil {
+				return err
+			}
+		}
+		// more events are stored if the limit was reached
+		if config.BulkLimit > 0 && len(events) == int(config.BulkLimit) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(config.PollInterval):
+		}
+	}
+}
+
+func eventWatchQuery(query *eventstore.SearchQueryBuilder, cursor EventCursor) *eventstore.SearchQueryBuilder {
+	if cursor.Position > 0 {
+		// decrease position by 10 because builder.PositionAfter filters for position > and we need position >=
+		query = query.PositionAfter(math.Float64frombits(math.Float64bits(cursor.Position) - 10))
+	}
+	return query.Offset(cursor.Offset)
+}
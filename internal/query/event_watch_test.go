@@ -0,0 +1,72 @@
+package query
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func TestEventCursor_next(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor EventCursor
+		event  *Event
+		want   EventCursor
+	}{
+		{
+			name:   "initial cursor",
+			cursor: EventCursor{},
+			event:  &Event{Position: 42.1},
+			want:   EventCursor{Position: 42.1, Offset: 1},
+		},
+		{
+			name:   "same position",
+			cursor: EventCursor{Position: 42.1, Offset: 1},
+			event:  &Event{Position: 42.1},
+			want:   EventCursor{Position: 42.1, Offset: 2},
+		},
+		{
+			name:   "next position",
+			cursor: EventCursor{Position: 42.1, Offset: 2},
+			event:  &Event{Position: 43.5},
+			want:   EventCursor{Position: 43.5, Offset: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.cursor.next(tt.event))
+		})
+	}
+}
+
+func Test_eventWatchQuery(t *testing.T) {
+	tests := []struct {
+		name              string
+		cursor            EventCursor
+		wantPositionAfter float64
+		wantOffset        uint32
+	}{
+		{
+			name:              "no cursor",
+			cursor:            EventCursor{},
+			wantPositionAfter: 0,
+			wantOffset:        0,
+		},
+		{
+			name:              "cursor, position included",
+			cursor:            EventCursor{Position: 42.1, Offset: 2},
+			wantPositionAfter: math.Float64frombits(math.Float64bits(42.1) - 10),
+			wantOffset:        2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := eventWatchQuery(eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent), tt.cursor)
+			assert.Equal(t, tt.wantPositionAfter, got.GetPositionAfter())
+			assert.Equal(t, tt.wantOffset, got.GetOffset())
+		})
+	}
+}
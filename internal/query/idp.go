@@ -14,7 +14,6 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
-	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -196,7 +195,7 @@ func (q *Queries) IDPByIDAndResourceOwner(ctx context.Context, shouldTriggerBulk
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerIDPProjection")
-		ctx, err = projection.IDPProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.IDPProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("trigger failed")
 		traceSpan.EndWithError(err)
 	}
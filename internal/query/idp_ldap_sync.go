@@ -0,0 +1,47 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// LDAPSyncIDP references an active LDAP identity provider with an enabled synchronisation
+type LDAPSyncIDP struct {
+	InstanceID    string
+	IDPID         string
+	ResourceOwner string
+	OwnerType     domain.IdentityProviderType
+}
+
+//go:embed idp_ldap_sync.sql
+var ldapSyncIDPsQuery string
+
+// LDAPSyncIDPs returns the LDAP identity providers of all instances which have the synchronisation enabled.
+func (q *Queries) LDAPSyncIDPs(ctx context.Context) (idps []*LDAPSyncIDP, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	err = q.client.QueryContext(ctx,
+		func(rows *sql.Rows) error {
+			for rows.Next() {
+				idp := new(LDAPSyncIDP)
+				if err := rows.Scan(&idp.InstanceID, &idp.IDPID, &idp.ResourceOwner, &idp.OwnerType); err != nil {
+					return err
+				}
+				idps = append(idps, idp)
+			}
+			return rows.Err()
+		},
+		ldapSyncIDPsQuery,
+		domain.IDPStateActive,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-eiK5a", "Errors.Internal")
+	}
+	return idps, nil
+}
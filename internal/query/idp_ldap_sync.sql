@@ -0,0 +1,17 @@
+SELECT
+    t.instance_id
+    , t.id
+    , t.resource_owner
+    , t.owner_type
+FROM
+    projections.idp_templates6 t
+JOIN
+    projections.idp_templates6_ldap2 l
+    ON l.instance_id = t.instance_id
+    AND l.idp_id = t.id
+WHERE
+    t.state = $1
+    AND (l.sync->>'enabled')::BOOLEAN
+ORDER BY
+    t.instance_id
+    , t.id;
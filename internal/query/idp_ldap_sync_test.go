@@ -0,0 +1,75 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_LDAPSyncIDPs(t *testing.T) {
+	expQuery := regexp.QuoteMeta(ldapSyncIDPsQuery)
+	cols := []string{"instance_id", "id", "resource_owner", "owner_type"}
+
+	tests := []struct {
+		name    string
+		expect  sqlExpectation
+		want    []*LDAPSyncIDP
+		wantErr error
+	}{
+		{
+			name:   "no idps",
+			expect: mockQueries(expQuery, cols, nil, domain.IDPStateActive),
+			want:   nil,
+		},
+		{
+			name: "idps",
+			expect: mockQueries(expQuery, cols, [][]driver.Value{
+				{"instance1", "idp1", "instance1", domain.IdentityProviderTypeSystem},
+				{"instance2", "idp2", "org1", domain.IdentityProviderTypeOrg},
+			}, domain.IDPStateActive),
+			want: []*LDAPSyncIDP{
+				{
+					InstanceID:    "instance1",
+					IDPID:         "idp1",
+					ResourceOwner: "instance1",
+					OwnerType:     domain.IdentityProviderTypeSystem,
+				},
+				{
+					InstanceID:    "instance2",
+					IDPID:         "idp2",
+					ResourceOwner: "org1",
+					OwnerType:     domain.IdentityProviderTypeOrg,
+				},
+			},
+		},
+		{
+			name:    "query error",
+			expect:  mockQueryErr(expQuery, sql.ErrConnDone, domain.IDPStateActive),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-eiK5a", "Errors.Internal"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.expect, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB:       db,
+						Database: &prepareDB{},
+					},
+				}
+				got, err := q.LDAPSyncIDPs(context.Background())
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
-	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -36,6 +36,8 @@ type IDPTemplate struct {
 	IsAutoCreation    bool
 	IsAutoUpdate      bool
 	AutoLinking       domain.AutoLinkingOption
+	// ClaimMappings are only set on SAML, OIDC and OAuth templates
+	ClaimMappings idp.ClaimMappings
 	*OAuthIDPTemplate
 	*OIDCIDPTemplate
 	*JWTIDPTemplate
@@ -43,7 +45,12 @@ type IDPTemplate struct {
 	*GitHubIDPTemplate
 	*GitHubEnterpriseIDPTemplate
 	*GitLabIDPTemplate
+	*BitbucketIDPTemplate
+	*DiscordIDPTemplate
+	*SlackIDPTemplate
 	*GitLabSelfHostedIDPTemplate
+	*KeycloakIDPTemplate
+	*OktaIDPTemplate
 	*GoogleIDPTemplate
 	*LDAPIDPTemplate
 	*AppleIDPTemplate
@@ -64,6 +71,7 @@ type OAuthIDPTemplate struct {
 	UserEndpoint          string
 	Scopes                database.TextArray[string]
 	IDAttribute           string
+	UserMapping           *providers.UserMapping
 }
 
 type OIDCIDPTemplate struct {
@@ -73,6 +81,7 @@ type OIDCIDPTemplate struct {
 	Issuer           string
 	Scopes           database.TextArray[string]
 	IsIDTokenMapping bool
+	UserMapping      *providers.UserMapping
 }
 
 type JWTIDPTemplate struct {
@@ -116,6 +125,27 @@ type GitLabIDPTemplate struct {
 	Scopes       database.TextArray[string]
 }
 
+type BitbucketIDPTemplate struct {
+	IDPID        string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
+type DiscordIDPTemplate struct {
+	IDPID        string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
+type SlackIDPTemplate struct {
+	IDPID        string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
 type GitLabSelfHostedIDPTemplate struct {
 	IDPID        string
 	Issuer       string
@@ -124,6 +154,24 @@ type GitLabSelfHostedIDPTemplate struct {
 	Scopes       database.TextArray[string]
 }
 
+type KeycloakIDPTemplate struct {
+	IDPID        string
+	BaseURL      string
+	Realm        string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
+type OktaIDPTemplate struct {
+	IDPID                 string
+	Issuer                string
+	AuthorizationServerID string
+	ClientID              string
+	ClientSecret          *crypto.CryptoValue
+	Scopes                database.TextArray[string]
+}
+
 type GoogleIDPTemplate struct {
 	IDPID        string
 	ClientID     string
@@ -143,6 +191,9 @@ type LDAPIDPTemplate struct {
 	UserFilters       []string
 	Timeout           time.Duration
 	idp.LDAPAttributes
+	Sync *idp.LDAPSync
+	// SyncReport is the report of the last synchronization
+	SyncReport *idp.LDAPSyncReport
 }
 
 type AppleIDPTemplate struct {
@@ -234,6 +285,10 @@ var (
 		name:  projection.IDPTemplateAutoLinkingCol,
 		table: idpTemplateTable,
 	}
+	IDPTemplateClaimMappingsCol = Column{
+		name:  projection.IDPTemplateClaimMappingsCol,
+		table: idpTemplateTable,
+	}
 )
 
 var (
@@ -277,6 +332,10 @@ var (
 		name:  projection.OAuthIDAttributeCol,
 		table: oauthIdpTemplateTable,
 	}
+	OAuthUserMappingCol = Column{
+		name:  projection.OAuthUserMappingCol,
+		table: oauthIdpTemplateTable,
+	}
 )
 
 var (
@@ -312,6 +371,10 @@ var (
 		name:  projection.OIDCIDTokenMappingCol,
 		table: oidcIdpTemplateTable,
 	}
+	OIDCUserMappingCol = Column{
+		name:  projection.OIDCUserMappingCol,
+		table: oidcIdpTemplateTable,
+	}
 )
 
 var (
@@ -473,6 +536,87 @@ var (
 	}
 )
 
+var (
+	bitbucketIdpTemplateTable = table{
+		name:          projection.IDPTemplateBitbucketTable,
+		instanceIDCol: projection.BitbucketInstanceIDCol,
+	}
+	BitbucketIDCol = Column{
+		name:  projection.BitbucketIDCol,
+		table: bitbucketIdpTemplateTable,
+	}
+	BitbucketInstanceIDCol = Column{
+		name:  projection.BitbucketInstanceIDCol,
+		table: bitbucketIdpTemplateTable,
+	}
+	BitbucketClientIDCol = Column{
+		name:  projection.BitbucketClientIDCol,
+		table: bitbucketIdpTemplateTable,
+	}
+	BitbucketClientSecretCol = Column{
+		name:  projection.BitbucketClientSecretCol,
+		table: bitbucketIdpTemplateTable,
+	}
+	BitbucketScopesCol = Column{
+		name:  projection.BitbucketScopesCol,
+		table: bitbucketIdpTemplateTable,
+	}
+)
+
+var (
+	discordIdpTemplateTable = table{
+		name:          projection.IDPTemplateDiscordTable,
+		instanceIDCol: projection.DiscordInstanceIDCol,
+	}
+	DiscordIDCol = Column{
+		name:  projection.DiscordIDCol,
+		table: discordIdpTemplateTable,
+	}
+	DiscordInstanceIDCol = Column{
+		name:  projection.DiscordInstanceIDCol,
+		table: discordIdpTemplateTable,
+	}
+	DiscordClientIDCol = Column{
+		name:  projection.DiscordClientIDCol,
+		table: discordIdpTemplateTable,
+	}
+	DiscordClientSecretCol = Column{
+		name:  projection.DiscordClientSecretCol,
+		table: discordIdpTemplateTable,
+	}
+	DiscordScopesCol = Column{
+		name:  projection.DiscordScopesCol,
+		table: discordIdpTemplateTable,
+	}
+)
+
+var (
+	slackIdpTemplateTable = table{
+		name:          projection.IDPTemplateSlackTable,
+		instanceIDCol: projection.SlackInstanceIDCol,
+	}
+	SlackIDCol = Column{
+		name:  projection.SlackIDCol,
+		table: slackIdpTemplateTable,
+	}
+	SlackInstanceIDCol = Column{
+		name:  projection.SlackInstanceIDCol,
+		table: slackIdpTemplateTable,
+	}
+	SlackClientIDCol = Column{
+		name:  projection.SlackClientIDCol,
+		table: slackIdpTemplateTable,
+	}
+	SlackClientSecretCol = Column{
+		name:  projection.SlackClientSecretCol,
+		table: slackIdpTemplateTable,
+	}
+	SlackScopesCol = Column{
+		name:  projection.SlackScopesCol,
+		table: slackIdpTemplateTable,
+	}
+)
+
 var (
 	gitlabSelfHostedIdpTemplateTable = table{
 		name:          projection.IDPTemplateGitLabSelfHostedTable,
@@ -504,6 +648,76 @@ var (
 	}
 )
 
+var (
+	keycloakIdpTemplateTable = table{
+		name:          projection.IDPTemplateKeycloakTable,
+		instanceIDCol: projection.KeycloakInstanceIDCol,
+	}
+	KeycloakIDCol = Column{
+		name:  projection.KeycloakIDCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakInstanceIDCol = Column{
+		name:  projection.KeycloakInstanceIDCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakBaseURLCol = Column{
+		name:  projection.KeycloakBaseURLCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakRealmCol = Column{
+		name:  projection.KeycloakRealmCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakClientIDCol = Column{
+		name:  projection.KeycloakClientIDCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakClientSecretCol = Column{
+		name:  projection.KeycloakClientSecretCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakScopesCol = Column{
+		name:  projection.KeycloakScopesCol,
+		table: keycloakIdpTemplateTable,
+	}
+)
+
+var (
+	oktaIdpTemplateTable = table{
+		name:          projection.IDPTemplateOktaTable,
+		instanceIDCol: projection.OktaInstanceIDCol,
+	}
+	OktaIDCol = Column{
+		name:  projection.OktaIDCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaInstanceIDCol = Column{
+		name:  projection.OktaInstanceIDCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaIssuerCol = Column{
+		name:  projection.OktaIssuerCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaAuthorizationServerIDCol = Column{
+		name:  projection.OktaAuthorizationServerIDCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaClientIDCol = Column{
+		name:  projection.OktaClientIDCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaClientSecretCol = Column{
+		name:  projection.OktaClientSecretCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaScopesCol = Column{
+		name:  projection.OktaScopesCol,
+		table: oktaIdpTemplateTable,
+	}
+)
+
 var (
 	googleIdpTemplateTable = table{
 		name:          projection.IDPTemplateGoogleTable,
@@ -632,6 +846,14 @@ var (
 		name:  projection.LDAPProfileAttributeCol,
 		table: ldapIdpTemplateTable,
 	}
+	LDAPSyncCol = Column{
+		name:  projection.LDAPSyncCol,
+		table: ldapIdpTemplateTable,
+	}
+	LDAPSyncReportCol = Column{
+		name:  projection.LDAPSyncReportCol,
+		table: ldapIdpTemplateTable,
+	}
 )
 
 var (
@@ -719,7 +941,7 @@ func (q *Queries) IDPTemplateByID(ctx context.Context, shouldTriggerBulk bool, i
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerIDPTemplateProjection")
-		ctx, err = projection.IDPTemplateProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.IDPTemplateProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("unable to trigger")
 		traceSpan.EndWithError(err)
 	}
@@ -828,6 +1050,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			IDPTemplateIsAutoCreationCol.identifier(),
 			IDPTemplateIsAutoUpdateCol.identifier(),
 			IDPTemplateAutoLinkingCol.identifier(),
+			IDPTemplateClaimMappingsCol.identifier(),
 			// oauth
 			OAuthIDCol.identifier(),
 			OAuthClientIDCol.identifier(),
@@ -837,6 +1060,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			OAuthUserEndpointCol.identifier(),
 			OAuthScopesCol.identifier(),
 			OAuthIDAttributeCol.identifier(),
+			OAuthUserMappingCol.identifier(),
 			// oidc
 			OIDCIDCol.identifier(),
 			OIDCIssuerCol.identifier(),
@@ -844,6 +1068,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			OIDCClientSecretCol.identifier(),
 			OIDCScopesCol.identifier(),
 			OIDCIDTokenMappingCol.identifier(),
+			OIDCUserMappingCol.identifier(),
 			// jwt
 			JWTIDCol.identifier(),
 			JWTIssuerCol.identifier(),
@@ -875,12 +1100,41 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			GitLabClientIDCol.identifier(),
 			GitLabClientSecretCol.identifier(),
 			GitLabScopesCol.identifier(),
+			// bitbucket
+			BitbucketIDCol.identifier(),
+			BitbucketClientIDCol.identifier(),
+			BitbucketClientSecretCol.identifier(),
+			BitbucketScopesCol.identifier(),
+			// discord
+			DiscordIDCol.identifier(),
+			DiscordClientIDCol.identifier(),
+			DiscordClientSecretCol.identifier(),
+			DiscordScopesCol.identifier(),
+			// slack
+			SlackIDCol.identifier(),
+			SlackClientIDCol.identifier(),
+			SlackClientSecretCol.identifier(),
+			SlackScopesCol.identifier(),
 			// gitlab self hosted
 			GitLabSelfHostedIDCol.identifier(),
 			GitLabSelfHostedIssuerCol.identifier(),
 			GitLabSelfHostedClientIDCol.identifier(),
 			GitLabSelfHostedClientSecretCol.identifier(),
 			GitLabSelfHostedScopesCol.identifier(),
+			// keycloak
+			KeycloakIDCol.identifier(),
+			KeycloakBaseURLCol.identifier(),
+			KeycloakRealmCol.identifier(),
+			KeycloakClientIDCol.identifier(),
+			KeycloakClientSecretCol.identifier(),
+			KeycloakScopesCol.identifier(),
+			// okta
+			OktaIDCol.identifier(),
+			OktaIssuerCol.identifier(),
+			OktaAuthorizationServerIDCol.identifier(),
+			OktaClientIDCol.identifier(),
+			OktaClientSecretCol.identifier(),
+			OktaScopesCol.identifier(),
 			// google
 			GoogleIDCol.identifier(),
 			GoogleClientIDCol.identifier(),
@@ -919,6 +1173,8 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			LDAPPreferredLanguageAttributeCol.identifier(),
 			LDAPAvatarURLAttributeCol.identifier(),
 			LDAPProfileAttributeCol.identifier(),
+			LDAPSyncCol.identifier(),
+			LDAPSyncReportCol.identifier(),
 			// apple
 			AppleIDCol.identifier(),
 			AppleClientIDCol.identifier(),
@@ -934,7 +1190,12 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			LeftJoin(join(GitHubIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(GitHubEnterpriseIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(GitLabIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(BitbucketIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(DiscordIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(SlackIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(GitLabSelfHostedIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(KeycloakIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(OktaIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(GoogleIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(SAMLIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(LDAPIDCol, IDPTemplateIDCol)).
@@ -953,6 +1214,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			oauthUserEndpoint := sql.NullString{}
 			oauthScopes := database.TextArray[string]{}
 			oauthIDAttribute := sql.NullString{}
+			oauthUserMapping := new(providers.UserMapping)
 
 			oidcID := sql.NullString{}
 			oidcIssuer := sql.NullString{}
@@ -960,6 +1222,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			oidcClientSecret := new(crypto.CryptoValue)
 			oidcScopes := database.TextArray[string]{}
 			oidcIDTokenMapping := sql.NullBool{}
+			oidcUserMapping := new(providers.UserMapping)
 
 			jwtID := sql.NullString{}
 			jwtIssuer := sql.NullString{}
@@ -992,12 +1255,41 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			gitlabClientSecret := new(crypto.CryptoValue)
 			gitlabScopes := database.TextArray[string]{}
 
+			bitbucketID := sql.NullString{}
+			bitbucketClientID := sql.NullString{}
+			bitbucketClientSecret := new(crypto.CryptoValue)
+			bitbucketScopes := database.TextArray[string]{}
+
+			discordID := sql.NullString{}
+			discordClientID := sql.NullString{}
+			discordClientSecret := new(crypto.CryptoValue)
+			discordScopes := database.TextArray[string]{}
+
+			slackID := sql.NullString{}
+			slackClientID := sql.NullString{}
+			slackClientSecret := new(crypto.CryptoValue)
+			slackScopes := database.TextArray[string]{}
+
 			gitlabSelfHostedID := sql.NullString{}
 			gitlabSelfHostedIssuer := sql.NullString{}
 			gitlabSelfHostedClientID := sql.NullString{}
 			gitlabSelfHostedClientSecret := new(crypto.CryptoValue)
 			gitlabSelfHostedScopes := database.TextArray[string]{}
 
+			keycloakID := sql.NullString{}
+			keycloakBaseURL := sql.NullString{}
+			keycloakRealm := sql.NullString{}
+			keycloakClientID := sql.NullString{}
+			keycloakClientSecret := new(crypto.CryptoValue)
+			keycloakScopes := database.TextArray[string]{}
+
+			oktaID := sql.NullString{}
+			oktaIssuer := sql.NullString{}
+			oktaAuthorizationServerID := sql.NullString{}
+			oktaClientID := sql.NullString{}
+			oktaClientSecret := new(crypto.CryptoValue)
+			oktaScopes := database.TextArray[string]{}
+
 			googleID := sql.NullString{}
 			googleClientID := sql.NullString{}
 			googleClientSecret := new(crypto.CryptoValue)
@@ -1035,6 +1327,8 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			ldapPreferredLanguageAttribute := sql.NullString{}
 			ldapAvatarURLAttribute := sql.NullString{}
 			ldapProfileAttribute := sql.NullString{}
+			ldapSync := sql.Null[idp.LDAPSync]{}
+			ldapSyncReport := sql.Null[idp.LDAPSyncReport]{}
 
 			appleID := sql.NullString{}
 			appleClientID := sql.NullString{}
@@ -1058,6 +1352,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&idpTemplate.IsAutoCreation,
 				&idpTemplate.IsAutoUpdate,
 				&idpTemplate.AutoLinking,
+				&idpTemplate.ClaimMappings,
 				// oauth
 				&oauthID,
 				&oauthClientID,
@@ -1067,6 +1362,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&oauthUserEndpoint,
 				&oauthScopes,
 				&oauthIDAttribute,
+				oauthUserMapping,
 				// oidc
 				&oidcID,
 				&oidcIssuer,
@@ -1074,6 +1370,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&oidcClientSecret,
 				&oidcScopes,
 				&oidcIDTokenMapping,
+				oidcUserMapping,
 				// jwt
 				&jwtID,
 				&jwtIssuer,
@@ -1105,12 +1402,41 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&gitlabClientID,
 				&gitlabClientSecret,
 				&gitlabScopes,
+				// bitbucket
+				&bitbucketID,
+				&bitbucketClientID,
+				&bitbucketClientSecret,
+				&bitbucketScopes,
+				// discord
+				&discordID,
+				&discordClientID,
+				&discordClientSecret,
+				&discordScopes,
+				// slack
+				&slackID,
+				&slackClientID,
+				&slackClientSecret,
+				&slackScopes,
 				// gitlab self hosted
 				&gitlabSelfHostedID,
 				&gitlabSelfHostedIssuer,
 				&gitlabSelfHostedClientID,
 				&gitlabSelfHostedClientSecret,
 				&gitlabSelfHostedScopes,
+				// keycloak
+				&keycloakID,
+				&keycloakBaseURL,
+				&keycloakRealm,
+				&keycloakClientID,
+				&keycloakClientSecret,
+				&keycloakScopes,
+				// okta
+				&oktaID,
+				&oktaIssuer,
+				&oktaAuthorizationServerID,
+				&oktaClientID,
+				&oktaClientSecret,
+				&oktaScopes,
 				// google
 				&googleID,
 				&googleClientID,
@@ -1149,6 +1475,8 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&ldapPreferredLanguageAttribute,
 				&ldapAvatarURLAttribute,
 				&ldapProfileAttribute,
+				&ldapSync,
+				&ldapSyncReport,
 				// apple
 				&appleID,
 				&appleClientID,
@@ -1176,6 +1504,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 					UserEndpoint:          oauthUserEndpoint.String,
 					Scopes:                oauthScopes,
 					IDAttribute:           oauthIDAttribute.String,
+					UserMapping:           nilIfZeroUserMapping(oauthUserMapping),
 				}
 			}
 			if oidcID.Valid {
@@ -1186,6 +1515,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 					Issuer:           oidcIssuer.String,
 					Scopes:           oidcScopes,
 					IsIDTokenMapping: oidcIDTokenMapping.Bool,
+					UserMapping:      nilIfZeroUserMapping(oidcUserMapping),
 				}
 			}
 			if jwtID.Valid {
@@ -1234,6 +1564,30 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 					Scopes:       gitlabScopes,
 				}
 			}
+			if bitbucketID.Valid {
+				idpTemplate.BitbucketIDPTemplate = &BitbucketIDPTemplate{
+					IDPID:        bitbucketID.String,
+					ClientID:     bitbucketClientID.String,
+					ClientSecret: bitbucketClientSecret,
+					Scopes:       bitbucketScopes,
+				}
+			}
+			if discordID.Valid {
+				idpTemplate.DiscordIDPTemplate = &DiscordIDPTemplate{
+					IDPID:        discordID.String,
+					ClientID:     discordClientID.String,
+					ClientSecret: discordClientSecret,
+					Scopes:       discordScopes,
+				}
+			}
+			if slackID.Valid {
+				idpTemplate.SlackIDPTemplate = &SlackIDPTemplate{
+					IDPID:        slackID.String,
+					ClientID:     slackClientID.String,
+					ClientSecret: slackClientSecret,
+					Scopes:       slackScopes,
+				}
+			}
 			if gitlabSelfHostedID.Valid {
 				idpTemplate.GitLabSelfHostedIDPTemplate = &GitLabSelfHostedIDPTemplate{
 					IDPID:        gitlabSelfHostedID.String,
@@ -1243,6 +1597,26 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 					Scopes:       gitlabSelfHostedScopes,
 				}
 			}
+			if keycloakID.Valid {
+				idpTemplate.KeycloakIDPTemplate = &KeycloakIDPTemplate{
+					IDPID:        keycloakID.String,
+					BaseURL:      keycloakBaseURL.String,
+					Realm:        keycloakRealm.String,
+					ClientID:     keycloakClientID.String,
+					ClientSecret: keycloakClientSecret,
+					Scopes:       keycloakScopes,
+				}
+			}
+			if oktaID.Valid {
+				idpTemplate.OktaIDPTemplate = &OktaIDPTemplate{
+					IDPID:                 oktaID.String,
+					Issuer:                oktaIssuer.String,
+					AuthorizationServerID: oktaAuthorizationServerID.String,
+					ClientID:              oktaClientID.String,
+					ClientSecret:          oktaClientSecret,
+					Scopes:                oktaScopes,
+				}
+			}
 			if googleID.Valid {
 				idpTemplate.GoogleIDPTemplate = &GoogleIDPTemplate{
 					IDPID:        googleID.String,
@@ -1291,6 +1665,12 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 						ProfileAttribute:           ldapProfileAttribute.String,
 					},
 				}
+				if ldapSync.Valid {
+					idpTemplate.LDAPIDPTemplate.Sync = &ldapSync.V
+				}
+				if ldapSyncReport.Valid {
+					idpTemplate.LDAPIDPTemplate.SyncReport = &ldapSyncReport.V
+				}
 			}
 			if appleID.Valid {
 				idpTemplate.AppleIDPTemplate = &AppleIDPTemplate{
@@ -1323,6 +1703,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			IDPTemplateIsAutoCreationCol.identifier(),
 			IDPTemplateIsAutoUpdateCol.identifier(),
 			IDPTemplateAutoLinkingCol.identifier(),
+			IDPTemplateClaimMappingsCol.identifier(),
 			// oauth
 			OAuthIDCol.identifier(),
 			OAuthClientIDCol.identifier(),
@@ -1332,6 +1713,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			OAuthUserEndpointCol.identifier(),
 			OAuthScopesCol.identifier(),
 			OAuthIDAttributeCol.identifier(),
+			OAuthUserMappingCol.identifier(),
 			// oidc
 			OIDCIDCol.identifier(),
 			OIDCIssuerCol.identifier(),
@@ -1339,6 +1721,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			OIDCClientSecretCol.identifier(),
 			OIDCScopesCol.identifier(),
 			OIDCIDTokenMappingCol.identifier(),
+			OIDCUserMappingCol.identifier(),
 			// jwt
 			JWTIDCol.identifier(),
 			JWTIssuerCol.identifier(),
@@ -1370,12 +1753,41 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			GitLabClientIDCol.identifier(),
 			GitLabClientSecretCol.identifier(),
 			GitLabScopesCol.identifier(),
+			// bitbucket
+			BitbucketIDCol.identifier(),
+			BitbucketClientIDCol.identifier(),
+			BitbucketClientSecretCol.identifier(),
+			BitbucketScopesCol.identifier(),
+			// discord
+			DiscordIDCol.identifier(),
+			DiscordClientIDCol.identifier(),
+			DiscordClientSecretCol.identifier(),
+			DiscordScopesCol.identifier(),
+			// slack
+			SlackIDCol.identifier(),
+			SlackClientIDCol.identifier(),
+			SlackClientSecretCol.identifier(),
+			SlackScopesCol.identifier(),
 			// gitlab self hosted
 			GitLabSelfHostedIDCol.identifier(),
 			GitLabSelfHostedIssuerCol.identifier(),
 			GitLabSelfHostedClientIDCol.identifier(),
 			GitLabSelfHostedClientSecretCol.identifier(),
 			GitLabSelfHostedScopesCol.identifier(),
+			// keycloak
+			KeycloakIDCol.identifier(),
+			KeycloakBaseURLCol.identifier(),
+			KeycloakRealmCol.identifier(),
+			KeycloakClientIDCol.identifier(),
+			KeycloakClientSecretCol.identifier(),
+			KeycloakScopesCol.identifier(),
+			// okta
+			OktaIDCol.identifier(),
+			OktaIssuerCol.identifier(),
+			OktaAuthorizationServerIDCol.identifier(),
+			OktaClientIDCol.identifier(),
+			OktaClientSecretCol.identifier(),
+			OktaScopesCol.identifier(),
 			// google
 			GoogleIDCol.identifier(),
 			GoogleClientIDCol.identifier(),
@@ -1414,6 +1826,8 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			LDAPPreferredLanguageAttributeCol.identifier(),
 			LDAPAvatarURLAttributeCol.identifier(),
 			LDAPProfileAttributeCol.identifier(),
+			LDAPSyncCol.identifier(),
+			LDAPSyncReportCol.identifier(),
 			// apple
 			AppleIDCol.identifier(),
 			AppleClientIDCol.identifier(),
@@ -1431,7 +1845,12 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			LeftJoin(join(GitHubIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(GitHubEnterpriseIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(GitLabIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(BitbucketIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(DiscordIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(SlackIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(GitLabSelfHostedIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(KeycloakIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(OktaIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(GoogleIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(SAMLIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(LDAPIDCol, IDPTemplateIDCol)).
@@ -1453,6 +1872,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				oauthUserEndpoint := sql.NullString{}
 				oauthScopes := database.TextArray[string]{}
 				oauthIDAttribute := sql.NullString{}
+				oauthUserMapping := new(providers.UserMapping)
 
 				oidcID := sql.NullString{}
 				oidcIssuer := sql.NullString{}
@@ -1460,6 +1880,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				oidcClientSecret := new(crypto.CryptoValue)
 				oidcScopes := database.TextArray[string]{}
 				oidcIDTokenMapping := sql.NullBool{}
+				oidcUserMapping := new(providers.UserMapping)
 
 				jwtID := sql.NullString{}
 				jwtIssuer := sql.NullString{}
@@ -1492,12 +1913,41 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				gitlabClientSecret := new(crypto.CryptoValue)
 				gitlabScopes := database.TextArray[string]{}
 
+				bitbucketID := sql.NullString{}
+				bitbucketClientID := sql.NullString{}
+				bitbucketClientSecret := new(crypto.CryptoValue)
+				bitbucketScopes := database.TextArray[string]{}
+
+				discordID := sql.NullString{}
+				discordClientID := sql.NullString{}
+				discordClientSecret := new(crypto.CryptoValue)
+				discordScopes := database.TextArray[string]{}
+
+				slackID := sql.NullString{}
+				slackClientID := sql.NullString{}
+				slackClientSecret := new(crypto.CryptoValue)
+				slackScopes := database.TextArray[string]{}
+
 				gitlabSelfHostedID := sql.NullString{}
 				gitlabSelfHostedIssuer := sql.NullString{}
 				gitlabSelfHostedClientID := sql.NullString{}
 				gitlabSelfHostedClientSecret := new(crypto.CryptoValue)
 				gitlabSelfHostedScopes := database.TextArray[string]{}
 
+				keycloakID := sql.NullString{}
+				keycloakBaseURL := sql.NullString{}
+				keycloakRealm := sql.NullString{}
+				keycloakClientID := sql.NullString{}
+				keycloakClientSecret := new(crypto.CryptoValue)
+				keycloakScopes := database.TextArray[string]{}
+
+				oktaID := sql.NullString{}
+				oktaIssuer := sql.NullString{}
+				oktaAuthorizationServerID := sql.NullString{}
+				oktaClientID := sql.NullString{}
+				oktaClientSecret := new(crypto.CryptoValue)
+				oktaScopes := database.TextArray[string]{}
+
 				googleID := sql.NullString{}
 				googleClientID := sql.NullString{}
 				googleClientSecret := new(crypto.CryptoValue)
@@ -1535,6 +1985,8 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				ldapPreferredLanguageAttribute := sql.NullString{}
 				ldapAvatarURLAttribute := sql.NullString{}
 				ldapProfileAttribute := sql.NullString{}
+				ldapSync := sql.Null[idp.LDAPSync]{}
+				ldapSyncReport := sql.Null[idp.LDAPSyncReport]{}
 
 				appleID := sql.NullString{}
 				appleClientID := sql.NullString{}
@@ -1558,6 +2010,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&idpTemplate.IsAutoCreation,
 					&idpTemplate.IsAutoUpdate,
 					&idpTemplate.AutoLinking,
+					&idpTemplate.ClaimMappings,
 					// oauth
 					&oauthID,
 					&oauthClientID,
@@ -1567,6 +2020,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&oauthUserEndpoint,
 					&oauthScopes,
 					&oauthIDAttribute,
+					oauthUserMapping,
 					// oidc
 					&oidcID,
 					&oidcIssuer,
@@ -1574,6 +2028,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&oidcClientSecret,
 					&oidcScopes,
 					&oidcIDTokenMapping,
+					oidcUserMapping,
 					// jwt
 					&jwtID,
 					&jwtIssuer,
@@ -1605,12 +2060,41 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&gitlabClientID,
 					&gitlabClientSecret,
 					&gitlabScopes,
+					// bitbucket
+					&bitbucketID,
+					&bitbucketClientID,
+					&bitbucketClientSecret,
+					&bitbucketScopes,
+					// discord
+					&discordID,
+					&discordClientID,
+					&discordClientSecret,
+					&discordScopes,
+					// slack
+					&slackID,
+					&slackClientID,
+					&slackClientSecret,
+					&slackScopes,
 					// gitlab self hosted
 					&gitlabSelfHostedID,
 					&gitlabSelfHostedIssuer,
 					&gitlabSelfHostedClientID,
 					&gitlabSelfHostedClientSecret,
 					&gitlabSelfHostedScopes,
+					// keycloak
+					&keycloakID,
+					&keycloakBaseURL,
+					&keycloakRealm,
+					&keycloakClientID,
+					&keycloakClientSecret,
+					&keycloakScopes,
+					// okta
+					&oktaID,
+					&oktaIssuer,
+					&oktaAuthorizationServerID,
+					&oktaClientID,
+					&oktaClientSecret,
+					&oktaScopes,
 					// google
 					&googleID,
 					&googleClientID,
@@ -1649,6 +2133,8 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&ldapPreferredLanguageAttribute,
 					&ldapAvatarURLAttribute,
 					&ldapProfileAttribute,
+					&ldapSync,
+					&ldapSyncReport,
 					// apple
 					&appleID,
 					&appleClientID,
@@ -1675,6 +2161,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 						UserEndpoint:          oauthUserEndpoint.String,
 						Scopes:                oauthScopes,
 						IDAttribute:           oauthIDAttribute.String,
+						UserMapping:           nilIfZeroUserMapping(oauthUserMapping),
 					}
 				}
 				if oidcID.Valid {
@@ -1685,6 +2172,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 						Issuer:           oidcIssuer.String,
 						Scopes:           oidcScopes,
 						IsIDTokenMapping: oidcIDTokenMapping.Bool,
+						UserMapping:      nilIfZeroUserMapping(oidcUserMapping),
 					}
 				}
 				if jwtID.Valid {
@@ -1733,6 +2221,30 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 						Scopes:       gitlabScopes,
 					}
 				}
+				if bitbucketID.Valid {
+					idpTemplate.BitbucketIDPTemplate = &BitbucketIDPTemplate{
+						IDPID:        bitbucketID.String,
+						ClientID:     bitbucketClientID.String,
+						ClientSecret: bitbucketClientSecret,
+						Scopes:       bitbucketScopes,
+					}
+				}
+				if discordID.Valid {
+					idpTemplate.DiscordIDPTemplate = &DiscordIDPTemplate{
+						IDPID:        discordID.String,
+						ClientID:     discordClientID.String,
+						ClientSecret: discordClientSecret,
+						Scopes:       discordScopes,
+					}
+				}
+				if slackID.Valid {
+					idpTemplate.SlackIDPTemplate = &SlackIDPTemplate{
+						IDPID:        slackID.String,
+						ClientID:     slackClientID.String,
+						ClientSecret: slackClientSecret,
+						Scopes:       slackScopes,
+					}
+				}
 				if gitlabSelfHostedID.Valid {
 					idpTemplate.GitLabSelfHostedIDPTemplate = &GitLabSelfHostedIDPTemplate{
 						IDPID:        gitlabSelfHostedID.String,
@@ -1742,6 +2254,26 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 						Scopes:       gitlabSelfHostedScopes,
 					}
 				}
+				if keycloakID.Valid {
+					idpTemplate.KeycloakIDPTemplate = &KeycloakIDPTemplate{
+						IDPID:        keycloakID.String,
+						BaseURL:      keycloakBaseURL.String,
+						Realm:        keycloakRealm.String,
+						ClientID:     keycloakClientID.String,
+						ClientSecret: keycloakClientSecret,
+						Scopes:       keycloakScopes,
+					}
+				}
+				if oktaID.Valid {
+					idpTemplate.OktaIDPTemplate = &OktaIDPTemplate{
+						IDPID:                 oktaID.String,
+						Issuer:                oktaIssuer.String,
+						AuthorizationServerID: oktaAuthorizationServerID.String,
+						ClientID:              oktaClientID.String,
+						ClientSecret:          oktaClientSecret,
+						Scopes:                oktaScopes,
+					}
+				}
 				if googleID.Valid {
 					idpTemplate.GoogleIDPTemplate = &GoogleIDPTemplate{
 						IDPID:        googleID.String,
@@ -1790,6 +2322,12 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 							ProfileAttribute:           ldapProfileAttribute.String,
 						},
 					}
+					if ldapSync.Valid {
+						idpTemplate.LDAPIDPTemplate.Sync = &ldapSync.V
+					}
+					if ldapSyncReport.Valid {
+						idpTemplate.LDAPIDPTemplate.SyncReport = &ldapSyncReport.V
+					}
 				}
 				if appleID.Valid {
 					idpTemplate.AppleIDPTemplate = &AppleIDPTemplate{
@@ -1816,3 +2354,11 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			}, nil
 		}
 }
+
+// nilIfZeroUserMapping returns nil for templates without user mapping
+func nilIfZeroUserMapping(mapping *providers.UserMapping) *providers.UserMapping {
+	if mapping.IsZero() {
+		return nil
+	}
+	return mapping
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
+	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -30,6 +31,7 @@ var (
 		` projections.idp_templates6.is_auto_creation,` +
 		` projections.idp_templates6.is_auto_update,` +
 		` projections.idp_templates6.auto_linking,` +
+		` projections.idp_templates6.claim_mappings,` +
 		// oauth
 		` projections.idp_templates6_oauth2.idp_id,` +
 		` projections.idp_templates6_oauth2.client_id,` +
@@ -39,6 +41,7 @@ var (
 		` projections.idp_templates6_oauth2.user_endpoint,` +
 		` projections.idp_templates6_oauth2.scopes,` +
 		` projections.idp_templates6_oauth2.id_attribute,` +
+		` projections.idp_templates6_oauth2.user_mapping,` +
 		// oidc
 		` projections.idp_templates6_oidc.idp_id,` +
 		` projections.idp_templates6_oidc.issuer,` +
@@ -46,6 +49,7 @@ var (
 		` projections.idp_templates6_oidc.client_secret,` +
 		` projections.idp_templates6_oidc.scopes,` +
 		` projections.idp_templates6_oidc.id_token_mapping,` +
+		` projections.idp_templates6_oidc.user_mapping,` +
 		// jwt
 		` projections.idp_templates6_jwt.idp_id,` +
 		` projections.idp_templates6_jwt.issuer,` +
@@ -77,12 +81,41 @@ var (
 		` projections.idp_templates6_gitlab.client_id,` +
 		` projections.idp_templates6_gitlab.client_secret,` +
 		` projections.idp_templates6_gitlab.scopes,` +
+		// bitbucket
+		` projections.idp_templates6_bitbucket.idp_id,` +
+		` projections.idp_templates6_bitbucket.client_id,` +
+		` projections.idp_templates6_bitbucket.client_secret,` +
+		` projections.idp_templates6_bitbucket.scopes,` +
+		// discord
+		` projections.idp_templates6_discord.idp_id,` +
+		` projections.idp_templates6_discord.client_id,` +
+		` projections.idp_templates6_discord.client_secret,` +
+		` projections.idp_templates6_discord.scopes,` +
+		// slack
+		` projections.idp_templates6_slack.idp_id,` +
+		` projections.idp_templates6_slack.client_id,` +
+		` projections.idp_templates6_slack.client_secret,` +
+		` projections.idp_templates6_slack.scopes,` +
 		// gitlab self hosted
 		` projections.idp_templates6_gitlab_self_hosted.idp_id,` +
 		` projections.idp_templates6_gitlab_self_hosted.issuer,` +
 		` projections.idp_templates6_gitlab_self_hosted.client_id,` +
 		` projections.idp_templates6_gitlab_self_hosted.client_secret,` +
 		` projections.idp_templates6_gitlab_self_hosted.scopes,` +
+		// keycloak
+		` projections.idp_templates6_keycloak.idp_id,` +
+		` projections.idp_templates6_keycloak.base_url,` +
+		` projections.idp_templates6_keycloak.realm,` +
+		` projections.idp_templates6_keycloak.client_id,` +
+		` projections.idp_templates6_keycloak.client_secret,` +
+		` projections.idp_templates6_keycloak.scopes,` +
+		// okta
+		` projections.idp_templates6_okta.idp_id,` +
+		` projections.idp_templates6_okta.issuer,` +
+		` projections.idp_templates6_okta.authorization_server_id,` +
+		` projections.idp_templates6_okta.client_id,` +
+		` projections.idp_templates6_okta.client_secret,` +
+		` projections.idp_templates6_okta.scopes,` +
 		// google
 		` projections.idp_templates6_google.idp_id,` +
 		` projections.idp_templates6_google.client_id,` +
@@ -121,6 +154,8 @@ var (
 		` projections.idp_templates6_ldap2.preferred_language_attribute,` +
 		` projections.idp_templates6_ldap2.avatar_url_attribute,` +
 		` projections.idp_templates6_ldap2.profile_attribute,` +
+		` projections.idp_templates6_ldap2.sync,` +
+		` projections.idp_templates6_ldap2.sync_report,` +
 		// apple
 		` projections.idp_templates6_apple.idp_id,` +
 		` projections.idp_templates6_apple.client_id,` +
@@ -136,7 +171,12 @@ var (
 		` LEFT JOIN projections.idp_templates6_github ON projections.idp_templates6.id = projections.idp_templates6_github.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_github.instance_id` +
 		` LEFT JOIN projections.idp_templates6_github_enterprise ON projections.idp_templates6.id = projections.idp_templates6_github_enterprise.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_github_enterprise.instance_id` +
 		` LEFT JOIN projections.idp_templates6_gitlab ON projections.idp_templates6.id = projections.idp_templates6_gitlab.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_gitlab.instance_id` +
+		` LEFT JOIN projections.idp_templates6_bitbucket ON projections.idp_templates6.id = projections.idp_templates6_bitbucket.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_bitbucket.instance_id` +
+		` LEFT JOIN projections.idp_templates6_discord ON projections.idp_templates6.id = projections.idp_templates6_discord.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_discord.instance_id` +
+		` LEFT JOIN projections.idp_templates6_slack ON projections.idp_templates6.id = projections.idp_templates6_slack.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_slack.instance_id` +
 		` LEFT JOIN projections.idp_templates6_gitlab_self_hosted ON projections.idp_templates6.id = projections.idp_templates6_gitlab_self_hosted.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_gitlab_self_hosted.instance_id` +
+		` LEFT JOIN projections.idp_templates6_keycloak ON projections.idp_templates6.id = projections.idp_templates6_keycloak.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_keycloak.instance_id` +
+		` LEFT JOIN projections.idp_templates6_okta ON projections.idp_templates6.id = projections.idp_templates6_okta.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_okta.instance_id` +
 		` LEFT JOIN projections.idp_templates6_google ON projections.idp_templates6.id = projections.idp_templates6_google.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_google.instance_id` +
 		` LEFT JOIN projections.idp_templates6_saml ON projections.idp_templates6.id = projections.idp_templates6_saml.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_saml.instance_id` +
 		` LEFT JOIN projections.idp_templates6_ldap2 ON projections.idp_templates6.id = projections.idp_templates6_ldap2.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_ldap2.instance_id` +
@@ -157,6 +197,7 @@ var (
 		"is_auto_creation",
 		"is_auto_update",
 		"auto_linking",
+		"claim_mappings",
 		// oauth config
 		"idp_id",
 		"client_id",
@@ -166,6 +207,7 @@ var (
 		"user_endpoint",
 		"scopes",
 		"id_attribute",
+		"user_mapping",
 		// oidc config
 		"id_id",
 		"issuer",
@@ -173,6 +215,7 @@ var (
 		"client_secret",
 		"scopes",
 		"id_token_mapping",
+		"user_mapping",
 		// jwt
 		"idp_id",
 		"issuer",
@@ -204,12 +247,41 @@ var (
 		"client_id",
 		"client_secret",
 		"scopes",
+		// bitbucket config
+		"idp_id",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// discord config
+		"idp_id",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// slack config
+		"idp_id",
+		"client_id",
+		"client_secret",
+		"scopes",
 		// gitlab self hosted config
 		"idp_id",
 		"issuer",
 		"client_id",
 		"client_secret",
 		"scopes",
+		// keycloak config
+		"idp_id",
+		"base_url",
+		"realm",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// okta config
+		"idp_id",
+		"issuer",
+		"authorization_server_id",
+		"client_id",
+		"client_secret",
+		"scopes",
 		// google config
 		"idp_id",
 		"client_id",
@@ -248,6 +320,8 @@ var (
 		"preferred_language_attribute",
 		"avatar_url_attribute",
 		"profile_attribute",
+		"sync",
+		"sync_report",
 		// apple config
 		"idp_id",
 		"client_id",
@@ -270,6 +344,7 @@ var (
 		` projections.idp_templates6.is_auto_creation,` +
 		` projections.idp_templates6.is_auto_update,` +
 		` projections.idp_templates6.auto_linking,` +
+		` projections.idp_templates6.claim_mappings,` +
 		// oauth
 		` projections.idp_templates6_oauth2.idp_id,` +
 		` projections.idp_templates6_oauth2.client_id,` +
@@ -279,6 +354,7 @@ var (
 		` projections.idp_templates6_oauth2.user_endpoint,` +
 		` projections.idp_templates6_oauth2.scopes,` +
 		` projections.idp_templates6_oauth2.id_attribute,` +
+		` projections.idp_templates6_oauth2.user_mapping,` +
 		// oidc
 		` projections.idp_templates6_oidc.idp_id,` +
 		` projections.idp_templates6_oidc.issuer,` +
@@ -286,6 +362,7 @@ var (
 		` projections.idp_templates6_oidc.client_secret,` +
 		` projections.idp_templates6_oidc.scopes,` +
 		` projections.idp_templates6_oidc.id_token_mapping,` +
+		` projections.idp_templates6_oidc.user_mapping,` +
 		// jwt
 		` projections.idp_templates6_jwt.idp_id,` +
 		` projections.idp_templates6_jwt.issuer,` +
@@ -317,12 +394,41 @@ var (
 		` projections.idp_templates6_gitlab.client_id,` +
 		` projections.idp_templates6_gitlab.client_secret,` +
 		` projections.idp_templates6_gitlab.scopes,` +
+		// bitbucket
+		` projections.idp_templates6_bitbucket.idp_id,` +
+		` projections.idp_templates6_bitbucket.client_id,` +
+		` projections.idp_templates6_bitbucket.client_secret,` +
+		` projections.idp_templates6_bitbucket.scopes,` +
+		// discord
+		` projections.idp_templates6_discord.idp_id,` +
+		` projections.idp_templates6_discord.client_id,` +
+		` projections.idp_templates6_discord.client_secret,` +
+		` projections.idp_templates6_discord.scopes,` +
+		// slack
+		` projections.idp_templates6_slack.idp_id,` +
+		` projections.idp_templates6_slack.client_id,` +
+		` projections.idp_templates6_slack.client_secret,` +
+		` projections.idp_templates6_slack.scopes,` +
 		// gitlab self hosted
 		` projections.idp_templates6_gitlab_self_hosted.idp_id,` +
 		` projections.idp_templates6_gitlab_self_hosted.issuer,` +
 		` projections.idp_templates6_gitlab_self_hosted.client_id,` +
 		` projections.idp_templates6_gitlab_self_hosted.client_secret,` +
 		` projections.idp_templates6_gitlab_self_hosted.scopes,` +
+		// keycloak
+		` projections.idp_templates6_keycloak.idp_id,` +
+		` projections.idp_templates6_keycloak.base_url,` +
+		` projections.idp_templates6_keycloak.realm,` +
+		` projections.idp_templates6_keycloak.client_id,` +
+		` projections.idp_templates6_keycloak.client_secret,` +
+		` projections.idp_templates6_keycloak.scopes,` +
+		// okta
+		` projections.idp_templates6_okta.idp_id,` +
+		` projections.idp_templates6_okta.issuer,` +
+		` projections.idp_templates6_okta.authorization_server_id,` +
+		` projections.idp_templates6_okta.client_id,` +
+		` projections.idp_templates6_okta.client_secret,` +
+		` projections.idp_templates6_okta.scopes,` +
 		// google
 		` projections.idp_templates6_google.idp_id,` +
 		` projections.idp_templates6_google.client_id,` +
@@ -361,6 +467,8 @@ var (
 		` projections.idp_templates6_ldap2.preferred_language_attribute,` +
 		` projections.idp_templates6_ldap2.avatar_url_attribute,` +
 		` projections.idp_templates6_ldap2.profile_attribute,` +
+		` projections.idp_templates6_ldap2.sync,` +
+		` projections.idp_templates6_ldap2.sync_report,` +
 		// apple
 		` projections.idp_templates6_apple.idp_id,` +
 		` projections.idp_templates6_apple.client_id,` +
@@ -377,7 +485,12 @@ var (
 		` LEFT JOIN projections.idp_templates6_github ON projections.idp_templates6.id = projections.idp_templates6_github.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_github.instance_id` +
 		` LEFT JOIN projections.idp_templates6_github_enterprise ON projections.idp_templates6.id = projections.idp_templates6_github_enterprise.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_github_enterprise.instance_id` +
 		` LEFT JOIN projections.idp_templates6_gitlab ON projections.idp_templates6.id = projections.idp_templates6_gitlab.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_gitlab.instance_id` +
+		` LEFT JOIN projections.idp_templates6_bitbucket ON projections.idp_templates6.id = projections.idp_templates6_bitbucket.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_bitbucket.instance_id` +
+		` LEFT JOIN projections.idp_templates6_discord ON projections.idp_templates6.id = projections.idp_templates6_discord.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_discord.instance_id` +
+		` LEFT JOIN projections.idp_templates6_slack ON projections.idp_templates6.id = projections.idp_templates6_slack.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_slack.instance_id` +
 		` LEFT JOIN projections.idp_templates6_gitlab_self_hosted ON projections.idp_templates6.id = projections.idp_templates6_gitlab_self_hosted.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_gitlab_self_hosted.instance_id` +
+		` LEFT JOIN projections.idp_templates6_keycloak ON projections.idp_templates6.id = projections.idp_templates6_keycloak.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_keycloak.instance_id` +
+		` LEFT JOIN projections.idp_templates6_okta ON projections.idp_templates6.id = projections.idp_templates6_okta.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_okta.instance_id` +
 		` LEFT JOIN projections.idp_templates6_google ON projections.idp_templates6.id = projections.idp_templates6_google.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_google.instance_id` +
 		` LEFT JOIN projections.idp_templates6_saml ON projections.idp_templates6.id = projections.idp_templates6_saml.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_saml.instance_id` +
 		` LEFT JOIN projections.idp_templates6_ldap2 ON projections.idp_templates6.id = projections.idp_templates6_ldap2.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_ldap2.instance_id` +
@@ -398,6 +511,7 @@ var (
 		"is_auto_creation",
 		"is_auto_update",
 		"auto_linking",
+		"claim_mappings",
 		// oauth config
 		"idp_id",
 		"client_id",
@@ -407,6 +521,7 @@ var (
 		"user_endpoint",
 		"scopes",
 		"id_attribute",
+		"user_mapping",
 		// oidc config
 		"id_id",
 		"issuer",
@@ -414,6 +529,7 @@ var (
 		"client_secret",
 		"scopes",
 		"id_token_mapping",
+		"user_mapping",
 		// jwt
 		"idp_id",
 		"issuer",
@@ -445,12 +561,41 @@ var (
 		"client_id",
 		"client_secret",
 		"scopes",
+		// bitbucket config
+		"idp_id",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// discord config
+		"idp_id",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// slack config
+		"idp_id",
+		"client_id",
+		"client_secret",
+		"scopes",
 		// gitlab self hosted config
 		"idp_id",
 		"issuer",
 		"client_id",
 		"client_secret",
 		"scopes",
+		// keycloak config
+		"idp_id",
+		"base_url",
+		"realm",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// okta config
+		"idp_id",
+		"issuer",
+		"authorization_server_id",
+		"client_id",
+		"client_secret",
+		"scopes",
 		// google config
 		"idp_id",
 		"client_id",
@@ -489,6 +634,8 @@ var (
 		"preferred_language_attribute",
 		"avatar_url_attribute",
 		"profile_attribute",
+		"sync",
+		"sync_report",
 		// apple config
 		"idp_id",
 		"client_id",
@@ -551,6 +698,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						"idp-id",
 						"client_id",
@@ -560,6 +708,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						"user",
 						database.TextArray[string]{"profile"},
 						"id-attribute",
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -567,6 +716,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -598,12 +748,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+						// slack
+						nil,
+						nil,
+						nil,
+						nil,
 						// gitlab self hosted
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// google
 						nil,
 						nil,
@@ -642,6 +821,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -701,6 +882,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -710,6 +892,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						"idp-id",
 						"issuer",
@@ -717,6 +900,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						database.TextArray[string]{"profile"},
 						true,
+						[]byte(`{"id": "$.attributes.uid", "email": "$.emails[0].value"}`),
 						// jwt
 						nil,
 						nil,
@@ -748,12 +932,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+						// slack
+						nil,
+						nil,
+						nil,
+						nil,
 						// gitlab self hosted
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// google
 						nil,
 						nil,
@@ -792,6 +1005,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -824,6 +1039,10 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 					ClientSecret:     nil,
 					Scopes:           []string{"profile"},
 					IsIDTokenMapping: true,
+					UserMapping: &providers.UserMapping{
+						ID:    "$.attributes.uid",
+						Email: "$.emails[0].value",
+					},
 				},
 			},
 		},
@@ -849,6 +1068,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -858,6 +1078,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -865,6 +1086,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						"idp-id",
 						"issuer",
@@ -896,12 +1118,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+						// slack
+						nil,
+						nil,
+						nil,
+						nil,
 						// gitlab self hosted
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// google
 						nil,
 						nil,
@@ -940,6 +1191,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -996,6 +1249,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1005,6 +1259,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1012,6 +1267,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1043,12 +1299,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+						// slack
+						nil,
+						nil,
+						nil,
+						nil,
 						// gitlab self hosted
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// google
 						nil,
 						nil,
@@ -1087,6 +1372,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1142,6 +1429,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1151,6 +1439,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1158,6 +1447,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1189,12 +1479,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						"client_id",
 						nil,
 						database.TextArray[string]{"profile"},
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+						// slack
+						nil,
+						nil,
+						nil,
+						nil,
 						// gitlab self hosted
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// google
 						nil,
 						nil,
@@ -1233,6 +1552,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1288,6 +1609,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1297,6 +1619,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1304,6 +1627,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1335,12 +1659,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+						// slack
+						nil,
+						nil,
+						nil,
+						nil,
 						// gitlab self hosted
 						"idp-id",
 						"issuer",
 						"client_id",
 						nil,
 						database.TextArray[string]{"profile"},
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// google
 						nil,
 						nil,
@@ -1379,6 +1732,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1435,6 +1790,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1444,6 +1800,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1451,6 +1808,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1482,12 +1840,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+						// slack
+						nil,
+						nil,
+						nil,
+						nil,
 						// gitlab self hosted
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// google
 						"idp-id",
 						"client_id",
@@ -1526,6 +1913,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1581,6 +1970,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						[]byte(`[{"claim": "department", "metadataKey": "department"}]`),
 						// oauth
 						nil,
 						nil,
@@ -1590,6 +1980,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1597,6 +1988,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1628,26 +2020,55 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
-						// gitlab self hosted
+						// bitbucket
 						nil,
 						nil,
 						nil,
 						nil,
+						// discord
 						nil,
-						// google
 						nil,
 						nil,
 						nil,
+						// slack
 						nil,
-						// saml
-						"idp-id",
-						[]byte("metadata"),
 						nil,
 						nil,
-						"binding",
-						false,
-						domain.SAMLNameIDFormatTransient,
-						"customAttribute",
+						nil,
+						// gitlab self hosted
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// google
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml
+						"idp-id",
+						[]byte("metadata"),
+						nil,
+						nil,
+						"binding",
+						false,
+						domain.SAMLNameIDFormatTransient,
+						"customAttribute",
 						// ldap config
 						nil,
 						nil,
@@ -1672,6 +2093,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1697,6 +2120,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 				IsAutoCreation:    true,
 				IsAutoUpdate:      true,
 				AutoLinking:       domain.AutoLinkingOptionUsername,
+				ClaimMappings:     idp.ClaimMappings{{Claim: "department", MetadataKey: "department"}},
 				SAMLIDPTemplate: &SAMLIDPTemplate{
 					IDPID:                         "idp-id",
 					Metadata:                      []byte("metadata"),
@@ -1731,6 +2155,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1740,6 +2165,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1747,6 +2173,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1778,12 +2205,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+						// slack
+						nil,
+						nil,
+						nil,
+						nil,
 						// gitlab self hosted
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// google
 						nil,
 						nil,
@@ -1822,6 +2278,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						"lang",
 						"avatar",
 						"profile",
+						[]byte(`{"enabled": true, "groupAttribute": "memberOf"}`),
+						[]byte(`{"dryRun": true, "created": ["ext1"]}`),
 						// apple
 						nil,
 						nil,
@@ -1872,6 +2330,14 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						AvatarURLAttribute:         "avatar",
 						ProfileAttribute:           "profile",
 					},
+					Sync: &idp.LDAPSync{
+						Enabled:        true,
+						GroupAttribute: "memberOf",
+					},
+					SyncReport: &idp.LDAPSyncReport{
+						DryRun:  true,
+						Created: []string{"ext1"},
+					},
 				},
 			},
 		},
@@ -1897,6 +2363,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1906,6 +2373,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1913,6 +2381,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1944,12 +2413,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+						// slack
+						nil,
+						nil,
+						nil,
+						nil,
 						// gitlab self hosted
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// google
 						nil,
 						nil,
@@ -1988,6 +2486,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// apple
 						"idp-id",
 						"client_id",
@@ -2023,6 +2523,188 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareIDPTemplateByIDQuery keycloak idp",
+			prepare: prepareIDPTemplateByIDQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(idpTemplateQuery),
+					idpTemplateCols,
+					[]driver.Value{
+						"idp-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211109),
+						domain.IDPConfigStateActive,
+						"idp-name",
+						domain.IDPTypeKeycloak,
+						domain.IdentityProviderTypeOrg,
+						true,
+						true,
+						true,
+						true,
+						domain.AutoLinkingOptionUsername,
+						nil,
+						// oauth
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// oidc
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// jwt
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// azure
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// github
+						nil,
+						nil,
+						nil,
+						nil,
+						// github enterprise
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// gitlab
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+						// slack
+						nil,
+						nil,
+						nil,
+						nil,
+						// gitlab self hosted
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						"idp-id",
+						"base_url",
+						"realm",
+						"client_id",
+						nil,
+						database.TextArray[string]{"profile"},
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// google
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// apple
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &IDPTemplate{
+				CreationDate:      testNow,
+				ChangeDate:        testNow,
+				Sequence:          20211109,
+				ResourceOwner:     "ro",
+				ID:                "idp-id",
+				State:             domain.IDPStateActive,
+				Name:              "idp-name",
+				Type:              domain.IDPTypeKeycloak,
+				OwnerType:         domain.IdentityProviderTypeOrg,
+				IsCreationAllowed: true,
+				IsLinkingAllowed:  true,
+				IsAutoCreation:    true,
+				IsAutoUpdate:      true,
+				AutoLinking:       domain.AutoLinkingOptionUsername,
+				KeycloakIDPTemplate: &KeycloakIDPTemplate{
+					IDPID:        "idp-id",
+					BaseURL:      "base_url",
+					Realm:        "realm",
+					ClientID:     "client_id",
+					ClientSecret: nil,
+					Scopes:       []string{"profile"},
+				},
+			},
+		},
 		{
 			name:    "prepareIDPTemplateByIDQuery no config",
 			prepare: prepareIDPTemplateByIDQuery,
@@ -2045,6 +2727,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -2054,45 +2737,76 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
-						// oidc
+						nil,
+						// oidc
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// jwt
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// azure
+						nil,
+						nil,
+						nil,
 						nil,
 						nil,
 						nil,
+						// github
 						nil,
 						nil,
 						nil,
-						// jwt
 						nil,
+						// github enterprise
 						nil,
 						nil,
 						nil,
 						nil,
-						// azure
 						nil,
 						nil,
 						nil,
+						// gitlab
 						nil,
 						nil,
 						nil,
-						// github
 						nil,
+						// bitbucket
 						nil,
 						nil,
 						nil,
-						// github enterprise
 						nil,
+						// discord
 						nil,
 						nil,
 						nil,
 						nil,
+						// slack
 						nil,
 						nil,
-						// gitlab
 						nil,
 						nil,
+						// gitlab self hosted
 						nil,
 						nil,
-						// gitlab self hosted
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
 						nil,
 						nil,
 						nil,
@@ -2136,6 +2850,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -2221,6 +2937,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -2230,6 +2947,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2237,6 +2955,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -2268,12 +2987,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
+							// slack
+							nil,
+							nil,
+							nil,
+							nil,
 							// gitlab self hosted
 							nil,
 							nil,
 							nil,
 							nil,
 							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// google config
 							nil,
 							nil,
@@ -2312,6 +3060,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							"lang",
 							"avatar",
 							"profile",
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2396,6 +3146,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -2405,6 +3156,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2412,6 +3164,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -2443,12 +3196,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
+							// slack
+							nil,
+							nil,
+							nil,
+							nil,
 							// gitlab self hosted
 							nil,
 							nil,
 							nil,
 							nil,
 							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// google config
 							nil,
 							nil,
@@ -2487,6 +3269,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2545,6 +3329,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -2554,6 +3339,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2561,6 +3347,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -2592,12 +3379,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
+							// slack
+							nil,
+							nil,
+							nil,
+							nil,
 							// gitlab self hosted
 							nil,
 							nil,
 							nil,
 							nil,
 							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// google config
 							nil,
 							nil,
@@ -2636,6 +3452,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							"lang",
 							"avatar",
 							"profile",
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2659,6 +3477,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -2668,6 +3487,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2675,6 +3495,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -2706,12 +3527,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
+							// slack
+							nil,
+							nil,
+							nil,
+							nil,
 							// gitlab self hosted
 							nil,
 							nil,
 							nil,
 							nil,
 							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// google
 							nil,
 							nil,
@@ -2750,6 +3600,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2773,6 +3625,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -2782,6 +3635,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2789,6 +3643,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -2820,12 +3675,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
+							// slack
+							nil,
+							nil,
+							nil,
+							nil,
 							// gitlab self hosted
 							nil,
 							nil,
 							nil,
 							nil,
 							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// google
 							"idp-id-google",
 							"client_id",
@@ -2864,6 +3748,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2887,6 +3773,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							"idp-id-oauth",
 							"client_id",
@@ -2896,6 +3783,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							"user",
 							database.TextArray[string]{"profile"},
 							"id-attribute",
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2903,6 +3791,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -2934,12 +3823,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
+							// slack
+							nil,
+							nil,
+							nil,
+							nil,
 							// gitlab self hosted
 							nil,
 							nil,
 							nil,
 							nil,
 							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// google
 							nil,
 							nil,
@@ -2978,6 +3896,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -3001,6 +3921,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -3010,6 +3931,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							"idp-id-oidc",
 							"issuer",
@@ -3017,6 +3939,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							database.TextArray[string]{"profile"},
 							true,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -3048,12 +3971,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
+							// slack
+							nil,
+							nil,
+							nil,
+							nil,
 							// gitlab self hosted
 							nil,
 							nil,
 							nil,
 							nil,
 							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// google
 							nil,
 							nil,
@@ -3092,6 +4044,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -3115,6 +4069,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -3124,6 +4079,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -3131,6 +4087,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							"idp-id-jwt",
 							"issuer",
@@ -3162,12 +4119,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
+							// slack
+							nil,
+							nil,
+							nil,
+							nil,
 							// gitlab self hosted
 							nil,
 							nil,
 							nil,
 							nil,
 							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// google
 							nil,
 							nil,
@@ -3206,6 +4192,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
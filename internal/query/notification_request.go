@@ -0,0 +1,235 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type NotificationRequests struct {
+	SearchResponse
+	NotificationRequests []*NotificationRequest
+}
+
+func (n *NotificationRequests) SetState(s *State) {
+	n.State = s
+}
+
+type NotificationRequest struct {
+	ID                   string
+	CreationDate         time.Time
+	ChangeDate           time.Time
+	Sequence             uint64
+	ResourceOwner        string
+	State                domain.NotificationState
+	UserID               string
+	NotificationType     domain.NotificationType
+	MessageType          string
+	TriggerAggregateType eventstore.AggregateType
+	TriggerAggregateID   string
+	TriggerEventType     eventstore.EventType
+	TriggerSequence      uint64
+	Attempts             uint8
+	LastError            string
+	NextAttempt          time.Time
+}
+
+type NotificationRequestSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *NotificationRequestSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+var (
+	notificationRequestsTable = table{
+		name:          projection.NotificationRequestProjectionTable,
+		instanceIDCol: projection.NotificationRequestColumnInstanceID,
+	}
+	NotificationRequestColumnID = Column{
+		name:  projection.NotificationRequestColumnID,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnCreationDate = Column{
+		name:  projection.NotificationRequestColumnCreationDate,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnChangeDate = Column{
+		name:  projection.NotificationRequestColumnChangeDate,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnSequence = Column{
+		name:  projection.NotificationRequestColumnSequence,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnState = Column{
+		name:  projection.NotificationRequestColumnState,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnResourceOwner = Column{
+		name:  projection.NotificationRequestColumnResourceOwner,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnInstanceID = Column{
+		name:  projection.NotificationRequestColumnInstanceID,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnUserID = Column{
+		name:  projection.NotificationRequestColumnUserID,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnNotificationType = Column{
+		name:  projection.NotificationRequestColumnNotificationType,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnMessageType = Column{
+		name:  projection.NotificationRequestColumnMessageType,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnTriggerAggType = Column{
+		name:  projection.NotificationRequestColumnTriggerAggType,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnTriggerAggID = Column{
+		name:  projection.NotificationRequestColumnTriggerAggID,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnTriggerEventType = Column{
+		name:  projection.NotificationRequestColumnTriggerEventType,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnTriggerSequence = Column{
+		name:  projection.NotificationRequestColumnTriggerSequence,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnAttempts = Column{
+		name:  projection.NotificationRequestColumnAttempts,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnLastError = Column{
+		name:  projection.NotificationRequestColumnLastError,
+		table: notificationRequestsTable,
+	}
+	NotificationRequestColumnNextAttempt = Column{
+		name:  projection.NotificationRequestColumnNextAttempt,
+		table: notificationRequestsTable,
+	}
+)
+
+func (q *Queries) SearchNotificationRequests(ctx context.Context, shouldTriggerBulk bool, queries *NotificationRequestSearchQueries) (requests *NotificationRequests, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerNotificationRequestProjection")
+		ctx, err = projection.NotificationRequestProjection.Trigger(ctx, triggerOpts(ctx)...)
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	eq := sq.Eq{
+		NotificationRequestColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareNotificationRequestsQuery()
+	return genericRowsQueryWithState[*NotificationRequests](ctx, q.client, notificationRequestsTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewNotificationRequestUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(NotificationRequestColumnUserID, userID, TextEquals)
+}
+
+func NewNotificationRequestStateSearchQuery(state domain.NotificationState) (SearchQuery, error) {
+	return NewNumberQuery(NotificationRequestColumnState, state, NumberEquals)
+}
+
+// NewNotificationRequestDueSearchQuery returns notifications which are due to be retried at the given time.
+func NewNotificationRequestDueSearchQuery(at time.Time) (SearchQuery, error) {
+	return NewTimestampQuery(NotificationRequestColumnNextAttempt, at, TimestampLessOrEquals)
+}
+
+func prepareNotificationRequestsQuery() (sq.SelectBuilder, func(*sql.Rows) (*NotificationRequests, error)) {
+	return sq.Select(
+			NotificationRequestColumnID.identifier(),
+			NotificationRequestColumnCreationDate.identifier(),
+			NotificationRequestColumnChangeDate.identifier(),
+			NotificationRequestColumnSequence.identifier(),
+			NotificationRequestColumnResourceOwner.identifier(),
+			NotificationRequestColumnState.identifier(),
+			NotificationRequestColumnUserID.identifier(),
+			NotificationRequestColumnNotificationType.identifier(),
+			NotificationRequestColumnMessageType.identifier(),
+			NotificationRequestColumnTriggerAggType.identifier(),
+			NotificationRequestColumnTriggerAggID.identifier(),
+			NotificationRequestColumnTriggerEventType.identifier(),
+			NotificationRequestColumnTriggerSequence.identifier(),
+			NotificationRequestColumnAttempts.identifier(),
+			NotificationRequestColumnLastError.identifier(),
+			NotificationRequestColumnNextAttempt.identifier(),
+			countColumn.identifier(),
+		).
+			From(notificationRequestsTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*NotificationRequests, error) {
+			requests := make([]*NotificationRequest, 0)
+			var count uint64
+			for rows.Next() {
+				var (
+					request     = new(NotificationRequest)
+					lastError   sql.NullString
+					nextAttempt sql.NullTime
+				)
+				err := rows.Scan(
+					&request.ID,
+					&request.CreationDate,
+					&request.ChangeDate,
+					&request.Sequence,
+					&request.ResourceOwner,
+					&request.State,
+					&request.UserID,
+					&request.NotificationType,
+					&request.MessageType,
+					&request.TriggerAggregateType,
+					&request.TriggerAggregateID,
+					&request.TriggerEventType,
+					&request.TriggerSequence,
+					&request.Attempts,
+					&lastError,
+					&nextAttempt,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				request.LastError = lastError.String
+				request.NextAttempt = nextAttempt.Time
+				requests = append(requests, request)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Aeth2", "Errors.Query.CloseRows")
+			}
+
+			return &NotificationRequests{
+				NotificationRequests: requests,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
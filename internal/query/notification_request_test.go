@@ -0,0 +1,190 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	prepareNotificationRequestsStmt = `SELECT projections.notification_requests.id,` +
+		` projections.notification_requests.creation_date,` +
+		` projections.notification_requests.change_date,` +
+		` projections.notification_requests.sequence,` +
+		` projections.notification_requests.resource_owner,` +
+		` projections.notification_requests.state,` +
+		` projections.notification_requests.user_id,` +
+		` projections.notification_requests.notification_type,` +
+		` projections.notification_requests.message_type,` +
+		` projections.notification_requests.trigger_aggregate_type,` +
+		` projections.notification_requests.trigger_aggregate_id,` +
+		` projections.notification_requests.trigger_event_type,` +
+		` projections.notification_requests.trigger_sequence,` +
+		` projections.notification_requests.attempts,` +
+		` projections.notification_requests.last_error,` +
+		` projections.notification_requests.next_attempt,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.notification_requests`
+	prepareNotificationRequestsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"state",
+		"user_id",
+		"notification_type",
+		"message_type",
+		"trigger_aggregate_type",
+		"trigger_aggregate_id",
+		"trigger_event_type",
+		"trigger_sequence",
+		"attempts",
+		"last_error",
+		"next_attempt",
+		"count",
+	}
+)
+
+func Test_NotificationRequestPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNotificationRequestsQuery no result",
+			prepare: prepareNotificationRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationRequestsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &NotificationRequests{NotificationRequests: []*NotificationRequest{}},
+		},
+		{
+			name:    "prepareNotificationRequestsQuery multiple result",
+			prepare: prepareNotificationRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationRequestsStmt),
+					prepareNotificationRequestsCols,
+					[][]driver.Value{
+						{
+							"user-id-10",
+							testNow,
+							testNow,
+							uint64(20211109),
+							"ro",
+							domain.NotificationStateSent,
+							"user-id",
+							domain.NotificationTypeEmail,
+							domain.InitCodeMessageType,
+							"user",
+							"user-id",
+							"user.human.initialization.code.added",
+							uint64(10),
+							1,
+							nil,
+							nil,
+						},
+						{
+							"user-id-12",
+							testNow,
+							testNow,
+							uint64(20211110),
+							"ro",
+							domain.NotificationStateFailed,
+							"user-id",
+							domain.NotificationTypeSms,
+							domain.VerifyPhoneMessageType,
+							"user",
+							"user-id",
+							"user.human.phone.code.added",
+							uint64(12),
+							2,
+							"connection refused",
+							testNow,
+						},
+					},
+				),
+			},
+			object: &NotificationRequests{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				NotificationRequests: []*NotificationRequest{
+					{
+						ID:                   "user-id-10",
+						CreationDate:         testNow,
+						ChangeDate:           testNow,
+						Sequence:             20211109,
+						ResourceOwner:        "ro",
+						State:                domain.NotificationStateSent,
+						UserID:               "user-id",
+						NotificationType:     domain.NotificationTypeEmail,
+						MessageType:          domain.InitCodeMessageType,
+						TriggerAggregateType: eventstore.AggregateType("user"),
+						TriggerAggregateID:   "user-id",
+						TriggerEventType:     eventstore.EventType("user.human.initialization.code.added"),
+						TriggerSequence:      10,
+						Attempts:             1,
+					},
+					{
+						ID:                   "user-id-12",
+						CreationDate:         testNow,
+						ChangeDate:           testNow,
+						Sequence:             20211110,
+						ResourceOwner:        "ro",
+						State:                domain.NotificationStateFailed,
+						UserID:               "user-id",
+						NotificationType:     domain.NotificationTypeSms,
+						MessageType:          domain.VerifyPhoneMessageType,
+						TriggerAggregateType: eventstore.AggregateType("user"),
+						TriggerAggregateID:   "user-id",
+						TriggerEventType:     eventstore.EventType("user.human.phone.code.added"),
+						TriggerSequence:      12,
+						Attempts:             2,
+						LastError:            "connection refused",
+						NextAttempt:          testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareNotificationRequestsQuery sql err",
+			prepare: prepareNotificationRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareNotificationRequestsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationRequests)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
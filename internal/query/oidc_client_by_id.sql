@@ -3,9 +3,10 @@ with client as (
 		c.app_id, a.state, c.client_id, c.client_secret, c.redirect_uris, c.response_types, c.grant_types,
 		c.application_type, c.auth_method_type, c.post_logout_redirect_uris, c.is_dev_mode,
 		c.access_token_type, c.access_token_role_assertion, c.id_token_role_assertion,
-		c.id_token_userinfo_assertion, c.clock_skew, c.additional_origins, a.project_id, p.project_role_assertion
-	from projections.apps7_oidc_configs c
-	join projections.apps7 a on a.id = c.app_id and a.instance_id = c.instance_id
+		c.id_token_userinfo_assertion, c.clock_skew, c.additional_origins, c.back_channel_logout_uri,
+		c.back_channel_logout_session_required, c.dpop_bound_access_tokens, c.require_pushed_auth_requests, a.project_id, p.project_role_assertion
+	from projections.apps11_oidc_configs c
+	join projections.apps11 a on a.id = c.app_id and a.instance_id = c.instance_id
 	join projections.projects4 p on p.id = a.project_id and p.instance_id = a.instance_id
 	where c.instance_id = $1
 		and c.client_id = $2
@@ -31,9 +32,10 @@ settings as (
 		'access_token_lifetime', access_token_lifetime,
 		'id_token_lifetime', id_token_lifetime,
 		'refresh_token_idle_expiration', refresh_token_idle_expiration,
-		'refresh_token_expiration', refresh_token_expiration
+		'refresh_token_expiration', refresh_token_expiration,
+		'require_pushed_auth_requests', require_pushed_auth_requests
 	) as settings
-	from projections.oidc_settings2
+	from projections.oidc_settings3
 	where aggregate_id = $1
 		and instance_id = $1
 )
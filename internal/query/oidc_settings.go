@@ -60,6 +60,10 @@ var (
 		name:  projection.OIDCSettingsColumnRefreshTokenExpiration,
 		table: oidcSettingsTable,
 	}
+	OIDCSettingsColumnRequirePushedAuthRequests = Column{
+		name:  projection.OIDCSettingsColumnRequirePushedAuthRequests,
+		table: oidcSettingsTable,
+	}
 )
 
 type OIDCSettings struct {
@@ -73,6 +77,7 @@ type OIDCSettings struct {
 	IdTokenLifetime            time.Duration `json:"id_token_lifetime,omitempty"`
 	RefreshTokenIdleExpiration time.Duration `json:"refresh_token_idle_expiration,omitempty"`
 	RefreshTokenExpiration     time.Duration `json:"refresh_token_expiration,omitempty"`
+	RequirePushedAuthRequests  bool          `json:"require_pushed_auth_requests,omitempty"`
 }
 
 func (q *Queries) OIDCSettingsByAggID(ctx context.Context, aggregateID string) (settings *OIDCSettings, err error) {
@@ -105,7 +110,8 @@ func prepareOIDCSettingsQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			OIDCSettingsColumnAccessTokenLifetime.identifier(),
 			OIDCSettingsColumnIdTokenLifetime.identifier(),
 			OIDCSettingsColumnRefreshTokenIdleExpiration.identifier(),
-			OIDCSettingsColumnRefreshTokenExpiration.identifier()).
+			OIDCSettingsColumnRefreshTokenExpiration.identifier(),
+			OIDCSettingsColumnRequirePushedAuthRequests.identifier()).
 			From(oidcSettingsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*OIDCSettings, error) {
@@ -120,6 +126,7 @@ func prepareOIDCSettingsQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				&oidcSettings.IdTokenLifetime,
 				&oidcSettings.RefreshTokenIdleExpiration,
 				&oidcSettings.RefreshTokenExpiration,
+				&oidcSettings.RequirePushedAuthRequests,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -16,7 +16,7 @@ import (
 )
 
 const (
-	AppProjectionTable = "projections.apps7"
+	AppProjectionTable = "projections.apps11"
 	AppAPITable        = AppProjectionTable + "_" + appAPITableSuffix
 	AppOIDCTable       = AppProjectionTable + "_" + appOIDCTableSuffix
 	AppSAMLTable       = AppProjectionTable + "_" + appSAMLTableSuffix
@@ -38,33 +38,38 @@ const (
 	AppAPIConfigColumnClientSecret = "client_secret"
 	AppAPIConfigColumnAuthMethod   = "auth_method"
 
-	appOIDCTableSuffix                          = "oidc_configs"
-	AppOIDCConfigColumnAppID                    = "app_id"
-	AppOIDCConfigColumnInstanceID               = "instance_id"
-	AppOIDCConfigColumnVersion                  = "version"
-	AppOIDCConfigColumnClientID                 = "client_id"
-	AppOIDCConfigColumnClientSecret             = "client_secret"
-	AppOIDCConfigColumnRedirectUris             = "redirect_uris"
-	AppOIDCConfigColumnResponseTypes            = "response_types"
-	AppOIDCConfigColumnGrantTypes               = "grant_types"
-	AppOIDCConfigColumnApplicationType          = "application_type"
-	AppOIDCConfigColumnAuthMethodType           = "auth_method_type"
-	AppOIDCConfigColumnPostLogoutRedirectUris   = "post_logout_redirect_uris"
-	AppOIDCConfigColumnDevMode                  = "is_dev_mode"
-	AppOIDCConfigColumnAccessTokenType          = "access_token_type"
-	AppOIDCConfigColumnAccessTokenRoleAssertion = "access_token_role_assertion"
-	AppOIDCConfigColumnIDTokenRoleAssertion     = "id_token_role_assertion"
-	AppOIDCConfigColumnIDTokenUserinfoAssertion = "id_token_userinfo_assertion"
-	AppOIDCConfigColumnClockSkew                = "clock_skew"
-	AppOIDCConfigColumnAdditionalOrigins        = "additional_origins"
-	AppOIDCConfigColumnSkipNativeAppSuccessPage = "skip_native_app_success_page"
-
-	appSAMLTableSuffix             = "saml_configs"
-	AppSAMLConfigColumnAppID       = "app_id"
-	AppSAMLConfigColumnInstanceID  = "instance_id"
-	AppSAMLConfigColumnEntityID    = "entity_id"
-	AppSAMLConfigColumnMetadata    = "metadata"
-	AppSAMLConfigColumnMetadataURL = "metadata_url"
+	appOIDCTableSuffix                                  = "oidc_configs"
+	AppOIDCConfigColumnAppID                            = "app_id"
+	AppOIDCConfigColumnInstanceID                       = "instance_id"
+	AppOIDCConfigColumnVersion                          = "version"
+	AppOIDCConfigColumnClientID                         = "client_id"
+	AppOIDCConfigColumnClientSecret                     = "client_secret"
+	AppOIDCConfigColumnRedirectUris                     = "redirect_uris"
+	AppOIDCConfigColumnResponseTypes                    = "response_types"
+	AppOIDCConfigColumnGrantTypes                       = "grant_types"
+	AppOIDCConfigColumnApplicationType                  = "application_type"
+	AppOIDCConfigColumnAuthMethodType                   = "auth_method_type"
+	AppOIDCConfigColumnPostLogoutRedirectUris           = "post_logout_redirect_uris"
+	AppOIDCConfigColumnDevMode                          = "is_dev_mode"
+	AppOIDCConfigColumnAccessTokenType                  = "access_token_type"
+	AppOIDCConfigColumnAccessTokenRoleAssertion         = "access_token_role_assertion"
+	AppOIDCConfigColumnIDTokenRoleAssertion             = "id_token_role_assertion"
+	AppOIDCConfigColumnIDTokenUserinfoAssertion         = "id_token_userinfo_assertion"
+	AppOIDCConfigColumnClockSkew                        = "clock_skew"
+	AppOIDCConfigColumnAdditionalOrigins                = "additional_origins"
+	AppOIDCConfigColumnSkipNativeAppSuccessPage         = "skip_native_app_success_page"
+	AppOIDCConfigColumnBackChannelLogoutURI             = "back_channel_logout_uri"
+	AppOIDCConfigColumnBackChannelLogoutSessionRequired = "back_channel_logout_session_required"
+	AppOIDCConfigColumnDPoPBoundAccessTokens            = "dpop_bound_access_tokens"
+	AppOIDCConfigColumnRequirePushedAuthRequests        = "require_pushed_auth_requests"
+
+	appSAMLTableSuffix                     = "saml_configs"
+	AppSAMLConfigColumnAppID               = "app_id"
+	AppSAMLConfigColumnInstanceID          = "instance_id"
+	AppSAMLConfigColumnEntityID            = "entity_id"
+	AppSAMLConfigColumnMetadata            = "metadata"
+	AppSAMLConfigColumnMetadataURL         = "metadata_url"
+	AppSAMLConfigColumnAllowUnsignedLogout = "allow_unsigned_logout"
 )
 
 type appProjection struct{}
@@ -125,6 +130,10 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppOIDCConfigColumnClockSkew, handler.ColumnTypeInt64, handler.Default(0)),
 			handler.NewColumn(AppOIDCConfigColumnAdditionalOrigins, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnSkipNativeAppSuccessPage, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnBackChannelLogoutURI, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnBackChannelLogoutSessionRequired, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnDPoPBoundAccessTokens, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnRequirePushedAuthRequests, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(AppOIDCConfigColumnInstanceID, AppOIDCConfigColumnAppID),
 			appOIDCTableSuffix,
@@ -137,6 +146,7 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppSAMLConfigColumnEntityID, handler.ColumnTypeText),
 			handler.NewColumn(AppSAMLConfigColumnMetadata, handler.ColumnTypeBytes),
 			handler.NewColumn(AppSAMLConfigColumnMetadataURL, handler.ColumnTypeText),
+			handler.NewColumn(AppSAMLConfigColumnAllowUnsignedLogout, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(AppSAMLConfigColumnInstanceID, AppSAMLConfigColumnAppID),
 			appSAMLTableSuffix,
@@ -500,6 +510,10 @@ func (p *appProjection) reduceOIDCConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppOIDCConfigColumnClockSkew, e.ClockSkew),
 				handler.NewCol(AppOIDCConfigColumnAdditionalOrigins, database.TextArray[string](e.AdditionalOrigins)),
 				handler.NewCol(AppOIDCConfigColumnSkipNativeAppSuccessPage, e.SkipNativeAppSuccessPage),
+				handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, e.BackChannelLogoutURI),
+				handler.NewCol(AppOIDCConfigColumnBackChannelLogoutSessionRequired, e.BackChannelLogoutSessionRequired),
+				handler.NewCol(AppOIDCConfigColumnDPoPBoundAccessTokens, e.DPoPBoundAccessTokens),
+				handler.NewCol(AppOIDCConfigColumnRequirePushedAuthRequests, e.RequirePushedAuthRequests),
 			},
 			handler.WithTableSuffix(appOIDCTableSuffix),
 		),
@@ -522,7 +536,7 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-GNHU1", "reduce.wrong.event.type %s", project.OIDCConfigChangedType)
 	}
 
-	cols := make([]handler.Column, 0, 15)
+	cols := make([]handler.Column, 0, 17)
 	if e.Version != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnVersion, *e.Version))
 	}
@@ -568,6 +582,18 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	if e.SkipNativeAppSuccessPage != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnSkipNativeAppSuccessPage, *e.SkipNativeAppSuccessPage))
 	}
+	if e.BackChannelLogoutURI != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, *e.BackChannelLogoutURI))
+	}
+	if e.BackChannelLogoutSessionRequired != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnBackChannelLogoutSessionRequired, *e.BackChannelLogoutSessionRequired))
+	}
+	if e.DPoPBoundAccessTokens != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnDPoPBoundAccessTokens, *e.DPoPBoundAccessTokens))
+	}
+	if e.RequirePushedAuthRequests != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequirePushedAuthRequests, *e.RequirePushedAuthRequests))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
@@ -685,6 +711,7 @@ func (p *appProjection) reduceSAMLConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppSAMLConfigColumnEntityID, e.EntityID),
 				handler.NewCol(AppSAMLConfigColumnMetadata, e.Metadata),
 				handler.NewCol(AppSAMLConfigColumnMetadataURL, e.MetadataURL),
+				handler.NewCol(AppSAMLConfigColumnAllowUnsignedLogout, e.AllowUnsignedLogout),
 			},
 			handler.WithTableSuffix(appSAMLTableSuffix),
 		),
@@ -707,7 +734,7 @@ func (p *appProjection) reduceSAMLConfigChanged(event eventstore.Event) (*handle
 		return nil, zerrors.ThrowInvalidArgument(nil, "HANDL-GMHU2", "reduce.wrong.event.type")
 	}
 
-	cols := make([]handler.Column, 0, 3)
+	cols := make([]handler.Column, 0, 4)
 	if e.Metadata != nil {
 		cols = append(cols, handler.NewCol(AppSAMLConfigColumnMetadata, e.Metadata))
 	}
@@ -717,6 +744,9 @@ func (p *appProjection) reduceSAMLConfigChanged(event eventstore.Event) (*handle
 	if e.EntityID != "" {
 		cols = append(cols, handler.NewCol(AppSAMLConfigColumnEntityID, e.EntityID))
 	}
+	if e.AllowUnsignedLogout != nil {
+		cols = append(cols, handler.NewCol(AppSAMLConfigColumnAllowUnsignedLogout, *e.AllowUnsignedLogout))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
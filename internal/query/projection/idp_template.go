@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/repository/idpconfig"
 	"github.com/zitadel/zitadel/internal/repository/instance"
@@ -25,7 +26,12 @@ const (
 	IDPTemplateGitHubTable           = IDPTemplateTable + "_" + IDPTemplateGitHubSuffix
 	IDPTemplateGitHubEnterpriseTable = IDPTemplateTable + "_" + IDPTemplateGitHubEnterpriseSuffix
 	IDPTemplateGitLabTable           = IDPTemplateTable + "_" + IDPTemplateGitLabSuffix
+	IDPTemplateBitbucketTable        = IDPTemplateTable + "_" + IDPTemplateBitbucketSuffix
+	IDPTemplateDiscordTable          = IDPTemplateTable + "_" + IDPTemplateDiscordSuffix
+	IDPTemplateSlackTable            = IDPTemplateTable + "_" + IDPTemplateSlackSuffix
 	IDPTemplateGitLabSelfHostedTable = IDPTemplateTable + "_" + IDPTemplateGitLabSelfHostedSuffix
+	IDPTemplateKeycloakTable         = IDPTemplateTable + "_" + IDPTemplateKeycloakSuffix
+	IDPTemplateOktaTable             = IDPTemplateTable + "_" + IDPTemplateOktaSuffix
 	IDPTemplateGoogleTable           = IDPTemplateTable + "_" + IDPTemplateGoogleSuffix
 	IDPTemplateLDAPTable             = IDPTemplateTable + "_" + IDPTemplateLDAPSuffix
 	IDPTemplateAppleTable            = IDPTemplateTable + "_" + IDPTemplateAppleSuffix
@@ -38,7 +44,12 @@ const (
 	IDPTemplateGitHubSuffix           = "github"
 	IDPTemplateGitHubEnterpriseSuffix = "github_enterprise"
 	IDPTemplateGitLabSuffix           = "gitlab"
+	IDPTemplateBitbucketSuffix        = "bitbucket"
+	IDPTemplateDiscordSuffix          = "discord"
+	IDPTemplateSlackSuffix            = "slack"
 	IDPTemplateGitLabSelfHostedSuffix = "gitlab_self_hosted"
+	IDPTemplateKeycloakSuffix         = "keycloak"
+	IDPTemplateOktaSuffix             = "okta"
 	IDPTemplateGoogleSuffix           = "google"
 	IDPTemplateLDAPSuffix             = "ldap2"
 	IDPTemplateAppleSuffix            = "apple"
@@ -60,6 +71,7 @@ const (
 	IDPTemplateIsAutoCreationCol    = "is_auto_creation"
 	IDPTemplateIsAutoUpdateCol      = "is_auto_update"
 	IDPTemplateAutoLinkingCol       = "auto_linking"
+	IDPTemplateClaimMappingsCol     = "claim_mappings"
 
 	OAuthIDCol                    = "idp_id"
 	OAuthInstanceIDCol            = "instance_id"
@@ -70,6 +82,7 @@ const (
 	OAuthUserEndpointCol          = "user_endpoint"
 	OAuthScopesCol                = "scopes"
 	OAuthIDAttributeCol           = "id_attribute"
+	OAuthUserMappingCol           = "user_mapping"
 
 	OIDCIDCol             = "idp_id"
 	OIDCInstanceIDCol     = "instance_id"
@@ -78,6 +91,7 @@ const (
 	OIDCClientSecretCol   = "client_secret"
 	OIDCScopesCol         = "scopes"
 	OIDCIDTokenMappingCol = "id_token_mapping"
+	OIDCUserMappingCol    = "user_mapping"
 
 	JWTIDCol           = "idp_id"
 	JWTInstanceIDCol   = "instance_id"
@@ -115,6 +129,24 @@ const (
 	GitLabClientSecretCol = "client_secret"
 	GitLabScopesCol       = "scopes"
 
+	BitbucketIDCol           = "idp_id"
+	BitbucketInstanceIDCol   = "instance_id"
+	BitbucketClientIDCol     = "client_id"
+	BitbucketClientSecretCol = "client_secret"
+	BitbucketScopesCol       = "scopes"
+
+	DiscordIDCol           = "idp_id"
+	DiscordInstanceIDCol   = "instance_id"
+	DiscordClientIDCol     = "client_id"
+	DiscordClientSecretCol = "client_secret"
+	DiscordScopesCol       = "scopes"
+
+	SlackIDCol           = "idp_id"
+	SlackInstanceIDCol   = "instance_id"
+	SlackClientIDCol     = "client_id"
+	SlackClientSecretCol = "client_secret"
+	SlackScopesCol       = "scopes"
+
 	GitLabSelfHostedIDCol           = "idp_id"
 	GitLabSelfHostedInstanceIDCol   = "instance_id"
 	GitLabSelfHostedIssuerCol       = "issuer"
@@ -122,6 +154,22 @@ const (
 	GitLabSelfHostedClientSecretCol = "client_secret"
 	GitLabSelfHostedScopesCol       = "scopes"
 
+	KeycloakIDCol           = "idp_id"
+	KeycloakInstanceIDCol   = "instance_id"
+	KeycloakBaseURLCol      = "base_url"
+	KeycloakRealmCol        = "realm"
+	KeycloakClientIDCol     = "client_id"
+	KeycloakClientSecretCol = "client_secret"
+	KeycloakScopesCol       = "scopes"
+
+	OktaIDCol                    = "idp_id"
+	OktaInstanceIDCol            = "instance_id"
+	OktaIssuerCol                = "issuer"
+	OktaAuthorizationServerIDCol = "authorization_server_id"
+	OktaClientIDCol              = "client_id"
+	OktaClientSecretCol          = "client_secret"
+	OktaScopesCol                = "scopes"
+
 	GoogleIDCol           = "idp_id"
 	GoogleInstanceIDCol   = "instance_id"
 	GoogleClientIDCol     = "client_id"
@@ -152,6 +200,8 @@ const (
 	LDAPPreferredLanguageAttributeCol = "preferred_language_attribute"
 	LDAPAvatarURLAttributeCol         = "avatar_url_attribute"
 	LDAPProfileAttributeCol           = "profile_attribute"
+	LDAPSyncCol                       = "sync"
+	LDAPSyncReportCol                 = "sync_report"
 
 	AppleIDCol         = "idp_id"
 	AppleInstanceIDCol = "instance_id"
@@ -201,6 +251,7 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(IDPTemplateIsAutoCreationCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(IDPTemplateIsAutoUpdateCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(IDPTemplateAutoLinkingCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(IDPTemplateClaimMappingsCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(IDPTemplateInstanceIDCol, IDPTemplateIDCol),
 			handler.WithIndex(handler.NewIndex("resource_owner", []string{IDPTemplateResourceOwnerCol})),
@@ -216,6 +267,7 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(OAuthUserEndpointCol, handler.ColumnTypeText),
 			handler.NewColumn(OAuthScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(OAuthIDAttributeCol, handler.ColumnTypeText),
+			handler.NewColumn(OAuthUserMappingCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(OAuthInstanceIDCol, OAuthIDCol),
 			IDPTemplateOAuthSuffix,
@@ -229,6 +281,7 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(OIDCClientSecretCol, handler.ColumnTypeJSONB),
 			handler.NewColumn(OIDCScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(OIDCIDTokenMappingCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(OIDCUserMappingCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(OIDCInstanceIDCol, OIDCIDCol),
 			IDPTemplateOIDCSuffix,
@@ -295,6 +348,39 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			IDPTemplateGitLabSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(BitbucketIDCol, handler.ColumnTypeText),
+			handler.NewColumn(BitbucketInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(BitbucketClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(BitbucketClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(BitbucketScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(BitbucketInstanceIDCol, BitbucketIDCol),
+			IDPTemplateBitbucketSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(DiscordIDCol, handler.ColumnTypeText),
+			handler.NewColumn(DiscordInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(DiscordClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(DiscordClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(DiscordScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(DiscordInstanceIDCol, DiscordIDCol),
+			IDPTemplateDiscordSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SlackIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SlackInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SlackClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SlackClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(SlackScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(SlackInstanceIDCol, SlackIDCol),
+			IDPTemplateSlackSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 		handler.NewSuffixedTable([]*handler.InitColumn{
 			handler.NewColumn(GitLabSelfHostedIDCol, handler.ColumnTypeText),
 			handler.NewColumn(GitLabSelfHostedInstanceIDCol, handler.ColumnTypeText),
@@ -307,6 +393,32 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			IDPTemplateGitLabSelfHostedSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(KeycloakIDCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakBaseURLCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakRealmCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(KeycloakScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(KeycloakInstanceIDCol, KeycloakIDCol),
+			IDPTemplateKeycloakSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(OktaIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaIssuerCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaAuthorizationServerIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(OktaScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(OktaInstanceIDCol, OktaIDCol),
+			IDPTemplateOktaSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 		handler.NewSuffixedTable([]*handler.InitColumn{
 			handler.NewColumn(GoogleIDCol, handler.ColumnTypeText),
 			handler.NewColumn(GoogleInstanceIDCol, handler.ColumnTypeText),
@@ -343,6 +455,8 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(LDAPPreferredLanguageAttributeCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(LDAPAvatarURLAttributeCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(LDAPProfileAttributeCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(LDAPSyncCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(LDAPSyncReportCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(LDAPInstanceIDCol, LDAPIDCol),
 			IDPTemplateLDAPSuffix,
@@ -472,6 +586,30 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.GitLabIDPChangedEventType,
 					Reduce: p.reduceGitLabIDPChanged,
 				},
+				{
+					Event:  instance.BitbucketIDPAddedEventType,
+					Reduce: p.reduceBitbucketIDPAdded,
+				},
+				{
+					Event:  instance.BitbucketIDPChangedEventType,
+					Reduce: p.reduceBitbucketIDPChanged,
+				},
+				{
+					Event:  instance.DiscordIDPAddedEventType,
+					Reduce: p.reduceDiscordIDPAdded,
+				},
+				{
+					Event:  instance.DiscordIDPChangedEventType,
+					Reduce: p.reduceDiscordIDPChanged,
+				},
+				{
+					Event:  instance.SlackIDPAddedEventType,
+					Reduce: p.reduceSlackIDPAdded,
+				},
+				{
+					Event:  instance.SlackIDPChangedEventType,
+					Reduce: p.reduceSlackIDPChanged,
+				},
 				{
 					Event:  instance.GitLabSelfHostedIDPAddedEventType,
 					Reduce: p.reduceGitLabSelfHostedIDPAdded,
@@ -480,6 +618,22 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.GitLabSelfHostedIDPChangedEventType,
 					Reduce: p.reduceGitLabSelfHostedIDPChanged,
 				},
+				{
+					Event:  instance.KeycloakIDPAddedEventType,
+					Reduce: p.reduceKeycloakIDPAdded,
+				},
+				{
+					Event:  instance.KeycloakIDPChangedEventType,
+					Reduce: p.reduceKeycloakIDPChanged,
+				},
+				{
+					Event:  instance.OktaIDPAddedEventType,
+					Reduce: p.reduceOktaIDPAdded,
+				},
+				{
+					Event:  instance.OktaIDPChangedEventType,
+					Reduce: p.reduceOktaIDPChanged,
+				},
 				{
 					Event:  instance.GoogleIDPAddedEventType,
 					Reduce: p.reduceGoogleIDPAdded,
@@ -496,6 +650,10 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.LDAPIDPChangedEventType,
 					Reduce: p.reduceLDAPIDPChanged,
 				},
+				{
+					Event:  instance.LDAPIDPSyncedEventType,
+					Reduce: p.reduceLDAPIDPSynced,
+				},
 				{
 					Event:  instance.AppleIDPAddedEventType,
 					Reduce: p.reduceAppleIDPAdded,
@@ -617,6 +775,30 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.GitLabIDPChangedEventType,
 					Reduce: p.reduceGitLabIDPChanged,
 				},
+				{
+					Event:  org.BitbucketIDPAddedEventType,
+					Reduce: p.reduceBitbucketIDPAdded,
+				},
+				{
+					Event:  org.BitbucketIDPChangedEventType,
+					Reduce: p.reduceBitbucketIDPChanged,
+				},
+				{
+					Event:  org.DiscordIDPAddedEventType,
+					Reduce: p.reduceDiscordIDPAdded,
+				},
+				{
+					Event:  org.DiscordIDPChangedEventType,
+					Reduce: p.reduceDiscordIDPChanged,
+				},
+				{
+					Event:  org.SlackIDPAddedEventType,
+					Reduce: p.reduceSlackIDPAdded,
+				},
+				{
+					Event:  org.SlackIDPChangedEventType,
+					Reduce: p.reduceSlackIDPChanged,
+				},
 				{
 					Event:  org.GitLabSelfHostedIDPAddedEventType,
 					Reduce: p.reduceGitLabSelfHostedIDPAdded,
@@ -625,6 +807,22 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.GitLabSelfHostedIDPChangedEventType,
 					Reduce: p.reduceGitLabSelfHostedIDPChanged,
 				},
+				{
+					Event:  org.KeycloakIDPAddedEventType,
+					Reduce: p.reduceKeycloakIDPAdded,
+				},
+				{
+					Event:  org.KeycloakIDPChangedEventType,
+					Reduce: p.reduceKeycloakIDPChanged,
+				},
+				{
+					Event:  org.OktaIDPAddedEventType,
+					Reduce: p.reduceOktaIDPAdded,
+				},
+				{
+					Event:  org.OktaIDPChangedEventType,
+					Reduce: p.reduceOktaIDPChanged,
+				},
 				{
 					Event:  org.GoogleIDPAddedEventType,
 					Reduce: p.reduceGoogleIDPAdded,
@@ -641,6 +839,10 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.LDAPIDPChangedEventType,
 					Reduce: p.reduceLDAPIDPChanged,
 				},
+				{
+					Event:  org.LDAPIDPSyncedEventType,
+					Reduce: p.reduceLDAPIDPSynced,
+				},
 				{
 					Event:  org.AppleIDPAddedEventType,
 					Reduce: p.reduceAppleIDPAdded,
@@ -691,7 +893,7 @@ func (p *idpTemplateProjection) reduceOAuthIDPAdded(event eventstore.Event) (*ha
 	return handler.NewMultiStatement(
 		&idpEvent,
 		handler.AddCreateStatement(
-			[]handler.Column{
+			appendClaimMappingsCol([]handler.Column{
 				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
 				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
 				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
@@ -707,10 +909,10 @@ func (p *idpTemplateProjection) reduceOAuthIDPAdded(event eventstore.Event) (*ha
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
-			},
+			}, idpEvent.ClaimMappings),
 		),
 		handler.AddCreateStatement(
-			[]handler.Column{
+			appendUserMappingCol([]handler.Column{
 				handler.NewCol(OAuthIDCol, idpEvent.ID),
 				handler.NewCol(OAuthInstanceIDCol, idpEvent.Aggregate().InstanceID),
 				handler.NewCol(OAuthClientIDCol, idpEvent.ClientID),
@@ -720,7 +922,7 @@ func (p *idpTemplateProjection) reduceOAuthIDPAdded(event eventstore.Event) (*ha
 				handler.NewCol(OAuthUserEndpointCol, idpEvent.UserEndpoint),
 				handler.NewCol(OAuthScopesCol, database.TextArray[string](idpEvent.Scopes)),
 				handler.NewCol(OAuthIDAttributeCol, idpEvent.IDAttribute),
-			},
+			}, OAuthUserMappingCol, idpEvent.UserMapping),
 			handler.WithTableSuffix(IDPTemplateOAuthSuffix),
 		),
 	), nil
@@ -740,7 +942,7 @@ func (p *idpTemplateProjection) reduceOAuthIDPChanged(event eventstore.Event) (*
 	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
 	ops = append(ops,
 		handler.AddUpdateStatement(
-			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			appendClaimMappingsChangedCol(reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges), idpEvent.ClaimMappings),
 			[]handler.Condition{
 				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
 				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
@@ -784,7 +986,7 @@ func (p *idpTemplateProjection) reduceOIDCIDPAdded(event eventstore.Event) (*han
 	return handler.NewMultiStatement(
 		&idpEvent,
 		handler.AddCreateStatement(
-			[]handler.Column{
+			appendClaimMappingsCol([]handler.Column{
 				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
 				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
 				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
@@ -800,10 +1002,10 @@ func (p *idpTemplateProjection) reduceOIDCIDPAdded(event eventstore.Event) (*han
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
-			},
+			}, idpEvent.ClaimMappings),
 		),
 		handler.AddCreateStatement(
-			[]handler.Column{
+			appendUserMappingCol([]handler.Column{
 				handler.NewCol(OIDCIDCol, idpEvent.ID),
 				handler.NewCol(OIDCInstanceIDCol, idpEvent.Aggregate().InstanceID),
 				handler.NewCol(OIDCIssuerCol, idpEvent.Issuer),
@@ -811,7 +1013,7 @@ func (p *idpTemplateProjection) reduceOIDCIDPAdded(event eventstore.Event) (*han
 				handler.NewCol(OIDCClientSecretCol, idpEvent.ClientSecret),
 				handler.NewCol(OIDCScopesCol, database.TextArray[string](idpEvent.Scopes)),
 				handler.NewCol(OIDCIDTokenMappingCol, idpEvent.IsIDTokenMapping),
-			},
+			}, OIDCUserMappingCol, idpEvent.UserMapping),
 			handler.WithTableSuffix(IDPTemplateOIDCSuffix),
 		),
 	), nil
@@ -831,7 +1033,7 @@ func (p *idpTemplateProjection) reduceOIDCIDPChanged(event eventstore.Event) (*h
 	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
 	ops = append(ops,
 		handler.AddUpdateStatement(
-			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			appendClaimMappingsChangedCol(reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges), idpEvent.ClaimMappings),
 			[]handler.Condition{
 				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
 				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
@@ -1669,6 +1871,273 @@ func (p *idpTemplateProjection) reduceGitLabIDPChanged(event eventstore.Event) (
 	), nil
 }
 
+func (p *idpTemplateProjection) reduceBitbucketIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.BitbucketIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.BitbucketIDPAddedEvent:
+		idpEvent = e.BitbucketIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.BitbucketIDPAddedEvent:
+		idpEvent = e.BitbucketIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-ncu1B", "reduce.wrong.event.type %v", []eventstore.EventType{org.BitbucketIDPAddedEventType, instance.BitbucketIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeBitbucket),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(BitbucketIDCol, idpEvent.ID),
+				handler.NewCol(BitbucketInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(BitbucketClientIDCol, idpEvent.ClientID),
+				handler.NewCol(BitbucketClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(BitbucketScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateBitbucketSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceBitbucketIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.BitbucketIDPChangedEvent
+	switch e := event.(type) {
+	case *org.BitbucketIDPChangedEvent:
+		idpEvent = e.BitbucketIDPChangedEvent
+	case *instance.BitbucketIDPChangedEvent:
+		idpEvent = e.BitbucketIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-0FQNk", "reduce.wrong.event.type %v", []eventstore.EventType{org.BitbucketIDPChangedEventType, instance.BitbucketIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	bitbucketCols := reduceBitbucketIDPChangedColumns(idpEvent)
+	if len(bitbucketCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				bitbucketCols,
+				[]handler.Condition{
+					handler.NewCond(BitbucketIDCol, idpEvent.ID),
+					handler.NewCond(BitbucketInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateBitbucketSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceDiscordIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.DiscordIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.DiscordIDPAddedEvent:
+		idpEvent = e.DiscordIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.DiscordIDPAddedEvent:
+		idpEvent = e.DiscordIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Y6iDQ", "reduce.wrong.event.type %v", []eventstore.EventType{org.DiscordIDPAddedEventType, instance.DiscordIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeDiscord),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(DiscordIDCol, idpEvent.ID),
+				handler.NewCol(DiscordInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(DiscordClientIDCol, idpEvent.ClientID),
+				handler.NewCol(DiscordClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(DiscordScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateDiscordSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceDiscordIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.DiscordIDPChangedEvent
+	switch e := event.(type) {
+	case *org.DiscordIDPChangedEvent:
+		idpEvent = e.DiscordIDPChangedEvent
+	case *instance.DiscordIDPChangedEvent:
+		idpEvent = e.DiscordIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-gMPZe", "reduce.wrong.event.type %v", []eventstore.EventType{org.DiscordIDPChangedEventType, instance.DiscordIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	discordCols := reduceDiscordIDPChangedColumns(idpEvent)
+	if len(discordCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				discordCols,
+				[]handler.Condition{
+					handler.NewCond(DiscordIDCol, idpEvent.ID),
+					handler.NewCond(DiscordInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateDiscordSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceSlackIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.SlackIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.SlackIDPAddedEvent:
+		idpEvent = e.SlackIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.SlackIDPAddedEvent:
+		idpEvent = e.SlackIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-NEXje", "reduce.wrong.event.type %v", []eventstore.EventType{org.SlackIDPAddedEventType, instance.SlackIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeSlack),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SlackIDCol, idpEvent.ID),
+				handler.NewCol(SlackInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(SlackClientIDCol, idpEvent.ClientID),
+				handler.NewCol(SlackClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(SlackScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateSlackSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceSlackIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.SlackIDPChangedEvent
+	switch e := event.(type) {
+	case *org.SlackIDPChangedEvent:
+		idpEvent = e.SlackIDPChangedEvent
+	case *instance.SlackIDPChangedEvent:
+		idpEvent = e.SlackIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-0mlm7", "reduce.wrong.event.type %v", []eventstore.EventType{org.SlackIDPChangedEventType, instance.SlackIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	slackCols := reduceSlackIDPChangedColumns(idpEvent)
+	if len(slackCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				slackCols,
+				[]handler.Condition{
+					handler.NewCond(SlackIDCol, idpEvent.ID),
+					handler.NewCond(SlackInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateSlackSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
 func (p *idpTemplateProjection) reduceGitLabSelfHostedIDPAdded(event eventstore.Event) (*handler.Statement, error) {
 	var idpEvent idp.GitLabSelfHostedIDPAddedEvent
 	var idpOwnerType domain.IdentityProviderType
@@ -1759,6 +2228,188 @@ func (p *idpTemplateProjection) reduceGitLabSelfHostedIDPChanged(event eventstor
 	), nil
 }
 
+func (p *idpTemplateProjection) reduceKeycloakIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.KeycloakIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.KeycloakIDPAddedEvent:
+		idpEvent = e.KeycloakIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.KeycloakIDPAddedEvent:
+		idpEvent = e.KeycloakIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-r0HXf", "reduce.wrong.event.type %v", []eventstore.EventType{org.KeycloakIDPAddedEventType, instance.KeycloakIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeKeycloak),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(KeycloakIDCol, idpEvent.ID),
+				handler.NewCol(KeycloakInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(KeycloakBaseURLCol, idpEvent.BaseURL),
+				handler.NewCol(KeycloakRealmCol, idpEvent.Realm),
+				handler.NewCol(KeycloakClientIDCol, idpEvent.ClientID),
+				handler.NewCol(KeycloakClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(KeycloakScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateKeycloakSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceKeycloakIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.KeycloakIDPChangedEvent
+	switch e := event.(type) {
+	case *org.KeycloakIDPChangedEvent:
+		idpEvent = e.KeycloakIDPChangedEvent
+	case *instance.KeycloakIDPChangedEvent:
+		idpEvent = e.KeycloakIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-DaNLX", "reduce.wrong.event.type %v", []eventstore.EventType{org.KeycloakIDPChangedEventType, instance.KeycloakIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	keycloakCols := reduceKeycloakIDPChangedColumns(idpEvent)
+	if len(keycloakCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				keycloakCols,
+				[]handler.Condition{
+					handler.NewCond(KeycloakIDCol, idpEvent.ID),
+					handler.NewCond(KeycloakInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateKeycloakSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceOktaIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.OktaIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.OktaIDPAddedEvent:
+		idpEvent = e.OktaIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.OktaIDPAddedEvent:
+		idpEvent = e.OktaIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-B2Sg2", "reduce.wrong.event.type %v", []eventstore.EventType{org.OktaIDPAddedEventType, instance.OktaIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeOkta),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(OktaIDCol, idpEvent.ID),
+				handler.NewCol(OktaInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(OktaIssuerCol, idpEvent.Issuer),
+				handler.NewCol(OktaAuthorizationServerIDCol, idpEvent.AuthorizationServerID),
+				handler.NewCol(OktaClientIDCol, idpEvent.ClientID),
+				handler.NewCol(OktaClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(OktaScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateOktaSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceOktaIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.OktaIDPChangedEvent
+	switch e := event.(type) {
+	case *org.OktaIDPChangedEvent:
+		idpEvent = e.OktaIDPChangedEvent
+	case *instance.OktaIDPChangedEvent:
+		idpEvent = e.OktaIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-qP3lC", "reduce.wrong.event.type %v", []eventstore.EventType{org.OktaIDPChangedEventType, instance.OktaIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	oktaCols := reduceOktaIDPChangedColumns(idpEvent)
+	if len(oktaCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				oktaCols,
+				[]handler.Condition{
+					handler.NewCond(OktaIDCol, idpEvent.ID),
+					handler.NewCond(OktaInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateOktaSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
 func (p *idpTemplateProjection) reduceGoogleIDPAdded(event eventstore.Event) (*handler.Statement, error) {
 	var idpEvent idp.GoogleIDPAddedEvent
 	var idpOwnerType domain.IdentityProviderType
@@ -1862,6 +2513,36 @@ func (p *idpTemplateProjection) reduceLDAPIDPAdded(event eventstore.Event) (*han
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-9s02m1", "reduce.wrong.event.type %v", []eventstore.EventType{org.LDAPIDPAddedEventType, instance.LDAPIDPAddedEventType})
 	}
 
+	ldapCols := []handler.Column{
+		handler.NewCol(LDAPIDCol, idpEvent.ID),
+		handler.NewCol(LDAPInstanceIDCol, idpEvent.Aggregate().InstanceID),
+		handler.NewCol(LDAPServersCol, database.TextArray[string](idpEvent.Servers)),
+		handler.NewCol(LDAPStartTLSCol, idpEvent.StartTLS),
+		handler.NewCol(LDAPBaseDNCol, idpEvent.BaseDN),
+		handler.NewCol(LDAPBindDNCol, idpEvent.BindDN),
+		handler.NewCol(LDAPBindPasswordCol, idpEvent.BindPassword),
+		handler.NewCol(LDAPUserBaseCol, idpEvent.UserBase),
+		handler.NewCol(LDAPUserObjectClassesCol, database.TextArray[string](idpEvent.UserObjectClasses)),
+		handler.NewCol(LDAPUserFiltersCol, database.TextArray[string](idpEvent.UserFilters)),
+		handler.NewCol(LDAPTimeoutCol, idpEvent.Timeout),
+		handler.NewCol(LDAPIDAttributeCol, idpEvent.IDAttribute),
+		handler.NewCol(LDAPFirstNameAttributeCol, idpEvent.FirstNameAttribute),
+		handler.NewCol(LDAPLastNameAttributeCol, idpEvent.LastNameAttribute),
+		handler.NewCol(LDAPDisplayNameAttributeCol, idpEvent.DisplayNameAttribute),
+		handler.NewCol(LDAPNickNameAttributeCol, idpEvent.NickNameAttribute),
+		handler.NewCol(LDAPPreferredUsernameAttributeCol, idpEvent.PreferredUsernameAttribute),
+		handler.NewCol(LDAPEmailAttributeCol, idpEvent.EmailAttribute),
+		handler.NewCol(LDAPEmailVerifiedAttributeCol, idpEvent.EmailVerifiedAttribute),
+		handler.NewCol(LDAPPhoneAttributeCol, idpEvent.PhoneAttribute),
+		handler.NewCol(LDAPPhoneVerifiedAttributeCol, idpEvent.PhoneVerifiedAttribute),
+		handler.NewCol(LDAPPreferredLanguageAttributeCol, idpEvent.PreferredLanguageAttribute),
+		handler.NewCol(LDAPAvatarURLAttributeCol, idpEvent.AvatarURLAttribute),
+		handler.NewCol(LDAPProfileAttributeCol, idpEvent.ProfileAttribute),
+	}
+	if idpEvent.Sync != nil {
+		ldapCols = append(ldapCols, handler.NewCol(LDAPSyncCol, idpEvent.Sync))
+	}
+
 	return handler.NewMultiStatement(
 		&idpEvent,
 		handler.AddCreateStatement(
@@ -1884,32 +2565,7 @@ func (p *idpTemplateProjection) reduceLDAPIDPAdded(event eventstore.Event) (*han
 			},
 		),
 		handler.AddCreateStatement(
-			[]handler.Column{
-				handler.NewCol(LDAPIDCol, idpEvent.ID),
-				handler.NewCol(LDAPInstanceIDCol, idpEvent.Aggregate().InstanceID),
-				handler.NewCol(LDAPServersCol, database.TextArray[string](idpEvent.Servers)),
-				handler.NewCol(LDAPStartTLSCol, idpEvent.StartTLS),
-				handler.NewCol(LDAPBaseDNCol, idpEvent.BaseDN),
-				handler.NewCol(LDAPBindDNCol, idpEvent.BindDN),
-				handler.NewCol(LDAPBindPasswordCol, idpEvent.BindPassword),
-				handler.NewCol(LDAPUserBaseCol, idpEvent.UserBase),
-				handler.NewCol(LDAPUserObjectClassesCol, database.TextArray[string](idpEvent.UserObjectClasses)),
-				handler.NewCol(LDAPUserFiltersCol, database.TextArray[string](idpEvent.UserFilters)),
-				handler.NewCol(LDAPTimeoutCol, idpEvent.Timeout),
-				handler.NewCol(LDAPIDAttributeCol, idpEvent.IDAttribute),
-				handler.NewCol(LDAPFirstNameAttributeCol, idpEvent.FirstNameAttribute),
-				handler.NewCol(LDAPLastNameAttributeCol, idpEvent.LastNameAttribute),
-				handler.NewCol(LDAPDisplayNameAttributeCol, idpEvent.DisplayNameAttribute),
-				handler.NewCol(LDAPNickNameAttributeCol, idpEvent.NickNameAttribute),
-				handler.NewCol(LDAPPreferredUsernameAttributeCol, idpEvent.PreferredUsernameAttribute),
-				handler.NewCol(LDAPEmailAttributeCol, idpEvent.EmailAttribute),
-				handler.NewCol(LDAPEmailVerifiedAttributeCol, idpEvent.EmailVerifiedAttribute),
-				handler.NewCol(LDAPPhoneAttributeCol, idpEvent.PhoneAttribute),
-				handler.NewCol(LDAPPhoneVerifiedAttributeCol, idpEvent.PhoneVerifiedAttribute),
-				handler.NewCol(LDAPPreferredLanguageAttributeCol, idpEvent.PreferredLanguageAttribute),
-				handler.NewCol(LDAPAvatarURLAttributeCol, idpEvent.AvatarURLAttribute),
-				handler.NewCol(LDAPProfileAttributeCol, idpEvent.ProfileAttribute),
-			},
+			ldapCols,
 			handler.WithTableSuffix(IDPTemplateLDAPSuffix),
 		),
 	), nil
@@ -1957,6 +2613,30 @@ func (p *idpTemplateProjection) reduceLDAPIDPChanged(event eventstore.Event) (*h
 	), nil
 }
 
+func (p *idpTemplateProjection) reduceLDAPIDPSynced(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.LDAPIDPSyncedEvent
+	switch e := event.(type) {
+	case *org.LDAPIDPSyncedEvent:
+		idpEvent = e.LDAPIDPSyncedEvent
+	case *instance.LDAPIDPSyncedEvent:
+		idpEvent = e.LDAPIDPSyncedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ieth7", "reduce.wrong.event.type %v", []eventstore.EventType{org.LDAPIDPSyncedEventType, instance.LDAPIDPSyncedEventType})
+	}
+
+	return handler.NewUpdateStatement(
+		&idpEvent,
+		[]handler.Column{
+			handler.NewCol(LDAPSyncReportCol, idpEvent.Report),
+		},
+		[]handler.Condition{
+			handler.NewCond(LDAPIDCol, idpEvent.ID),
+			handler.NewCond(LDAPInstanceIDCol, idpEvent.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(IDPTemplateLDAPSuffix),
+	), nil
+}
+
 func (p *idpTemplateProjection) reduceSAMLIDPAdded(event eventstore.Event) (*handler.Statement, error) {
 	var idpEvent idp.SAMLIDPAddedEvent
 	var idpOwnerType domain.IdentityProviderType
@@ -1988,7 +2668,7 @@ func (p *idpTemplateProjection) reduceSAMLIDPAdded(event eventstore.Event) (*han
 	return handler.NewMultiStatement(
 		&idpEvent,
 		handler.AddCreateStatement(
-			[]handler.Column{
+			appendClaimMappingsCol([]handler.Column{
 				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
 				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
 				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
@@ -2004,7 +2684,7 @@ func (p *idpTemplateProjection) reduceSAMLIDPAdded(event eventstore.Event) (*han
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
-			},
+			}, idpEvent.ClaimMappings),
 		),
 		handler.AddCreateStatement(
 			columns,
@@ -2027,7 +2707,7 @@ func (p *idpTemplateProjection) reduceSAMLIDPChanged(event eventstore.Event) (*h
 	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
 	ops = append(ops,
 		handler.AddUpdateStatement(
-			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			appendClaimMappingsChangedCol(reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges), idpEvent.ClaimMappings),
 			[]handler.Condition{
 				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
 				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
@@ -2227,8 +2907,33 @@ func reduceIDPChangedTemplateColumns(name *string, creationDate time.Time, seque
 	)
 }
 
+// appendClaimMappingsCol adds the claim mappings of SAML, OIDC and OAuth templates if there are any
+func appendClaimMappingsCol(cols []handler.Column, mappings idp.ClaimMappings) []handler.Column {
+	if len(mappings) == 0 {
+		return cols
+	}
+	return append(cols, handler.NewCol(IDPTemplateClaimMappingsCol, mappings))
+}
+
+// appendClaimMappingsChangedCol adds the claim mappings if they were changed,
+// removed mappings are stored as null
+func appendClaimMappingsChangedCol(cols []handler.Column, mappings *idp.ClaimMappings) []handler.Column {
+	if mappings == nil {
+		return cols
+	}
+	return append(cols, handler.NewCol(IDPTemplateClaimMappingsCol, *mappings))
+}
+
+// appendUserMappingCol adds the user mapping of OAuth and OIDC templates if any field is mapped
+func appendUserMappingCol(cols []handler.Column, column string, mapping *providers.UserMapping) []handler.Column {
+	if mapping.IsZero() {
+		return cols
+	}
+	return append(cols, handler.NewCol(column, *mapping))
+}
+
 func reduceOAuthIDPChangedColumns(idpEvent idp.OAuthIDPChangedEvent) []handler.Column {
-	oauthCols := make([]handler.Column, 0, 7)
+	oauthCols := make([]handler.Column, 0, 8)
 	if idpEvent.ClientID != nil {
 		oauthCols = append(oauthCols, handler.NewCol(OAuthClientIDCol, *idpEvent.ClientID))
 	}
@@ -2250,11 +2955,14 @@ func reduceOAuthIDPChangedColumns(idpEvent idp.OAuthIDPChangedEvent) []handler.C
 	if idpEvent.IDAttribute != nil {
 		oauthCols = append(oauthCols, handler.NewCol(OAuthIDAttributeCol, *idpEvent.IDAttribute))
 	}
+	if idpEvent.UserMapping != nil {
+		oauthCols = append(oauthCols, handler.NewCol(OAuthUserMappingCol, *idpEvent.UserMapping))
+	}
 	return oauthCols
 }
 
 func reduceOIDCIDPChangedColumns(idpEvent idp.OIDCIDPChangedEvent) []handler.Column {
-	oidcCols := make([]handler.Column, 0, 5)
+	oidcCols := make([]handler.Column, 0, 6)
 	if idpEvent.ClientID != nil {
 		oidcCols = append(oidcCols, handler.NewCol(OIDCClientIDCol, *idpEvent.ClientID))
 	}
@@ -2270,6 +2978,9 @@ func reduceOIDCIDPChangedColumns(idpEvent idp.OIDCIDPChangedEvent) []handler.Col
 	if idpEvent.IsIDTokenMapping != nil {
 		oidcCols = append(oidcCols, handler.NewCol(OIDCIDTokenMappingCol, *idpEvent.IsIDTokenMapping))
 	}
+	if idpEvent.UserMapping != nil {
+		oidcCols = append(oidcCols, handler.NewCol(OIDCUserMappingCol, *idpEvent.UserMapping))
+	}
 	return oidcCols
 }
 
@@ -2361,6 +3072,48 @@ func reduceGitLabIDPChangedColumns(idpEvent idp.GitLabIDPChangedEvent) []handler
 	return gitlabCols
 }
 
+func reduceBitbucketIDPChangedColumns(idpEvent idp.BitbucketIDPChangedEvent) []handler.Column {
+	bitbucketCols := make([]handler.Column, 0, 3)
+	if idpEvent.ClientID != nil {
+		bitbucketCols = append(bitbucketCols, handler.NewCol(BitbucketClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		bitbucketCols = append(bitbucketCols, handler.NewCol(BitbucketClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		bitbucketCols = append(bitbucketCols, handler.NewCol(BitbucketScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return bitbucketCols
+}
+
+func reduceDiscordIDPChangedColumns(idpEvent idp.DiscordIDPChangedEvent) []handler.Column {
+	discordCols := make([]handler.Column, 0, 3)
+	if idpEvent.ClientID != nil {
+		discordCols = append(discordCols, handler.NewCol(DiscordClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		discordCols = append(discordCols, handler.NewCol(DiscordClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		discordCols = append(discordCols, handler.NewCol(DiscordScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return discordCols
+}
+
+func reduceSlackIDPChangedColumns(idpEvent idp.SlackIDPChangedEvent) []handler.Column {
+	slackCols := make([]handler.Column, 0, 3)
+	if idpEvent.ClientID != nil {
+		slackCols = append(slackCols, handler.NewCol(SlackClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		slackCols = append(slackCols, handler.NewCol(SlackClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		slackCols = append(slackCols, handler.NewCol(SlackScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return slackCols
+}
+
 func reduceGitLabSelfHostedIDPChangedColumns(idpEvent idp.GitLabSelfHostedIDPChangedEvent) []handler.Column {
 	gitlabCols := make([]handler.Column, 0, 4)
 	if idpEvent.Issuer != nil {
@@ -2378,6 +3131,46 @@ func reduceGitLabSelfHostedIDPChangedColumns(idpEvent idp.GitLabSelfHostedIDPCha
 	return gitlabCols
 }
 
+func reduceKeycloakIDPChangedColumns(idpEvent idp.KeycloakIDPChangedEvent) []handler.Column {
+	keycloakCols := make([]handler.Column, 0, 5)
+	if idpEvent.BaseURL != nil {
+		keycloakCols = append(keycloakCols, handler.NewCol(KeycloakBaseURLCol, *idpEvent.BaseURL))
+	}
+	if idpEvent.Realm != nil {
+		keycloakCols = append(keycloakCols, handler.NewCol(KeycloakRealmCol, *idpEvent.Realm))
+	}
+	if idpEvent.ClientID != nil {
+		keycloakCols = append(keycloakCols, handler.NewCol(KeycloakClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		keycloakCols = append(keycloakCols, handler.NewCol(KeycloakClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		keycloakCols = append(keycloakCols, handler.NewCol(KeycloakScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return keycloakCols
+}
+
+func reduceOktaIDPChangedColumns(idpEvent idp.OktaIDPChangedEvent) []handler.Column {
+	oktaCols := make([]handler.Column, 0, 5)
+	if idpEvent.Issuer != nil {
+		oktaCols = append(oktaCols, handler.NewCol(OktaIssuerCol, *idpEvent.Issuer))
+	}
+	if idpEvent.AuthorizationServerID != nil {
+		oktaCols = append(oktaCols, handler.NewCol(OktaAuthorizationServerIDCol, *idpEvent.AuthorizationServerID))
+	}
+	if idpEvent.ClientID != nil {
+		oktaCols = append(oktaCols, handler.NewCol(OktaClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		oktaCols = append(oktaCols, handler.NewCol(OktaClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		oktaCols = append(oktaCols, handler.NewCol(OktaScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return oktaCols
+}
+
 func reduceGoogleIDPChangedColumns(idpEvent idp.GoogleIDPChangedEvent) []handler.Column {
 	googleCols := make([]handler.Column, 0, 3)
 	if idpEvent.ClientID != nil {
@@ -2460,6 +3253,9 @@ func reduceLDAPIDPChangedColumns(idpEvent idp.LDAPIDPChangedEvent) []handler.Col
 	if idpEvent.ProfileAttribute != nil {
 		ldapCols = append(ldapCols, handler.NewCol(LDAPProfileAttributeCol, *idpEvent.ProfileAttribute))
 	}
+	if idpEvent.Sync != nil {
+		ldapCols = append(ldapCols, handler.NewCol(LDAPSyncCol, idpEvent.Sync))
+	}
 	return ldapCols
 }
 
@@ -9,6 +9,8 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	providers "github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -1828,6 +1830,282 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 	}
 }
 
+func TestIDPTemplateProjection_reducesKeycloak(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceKeycloakIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.KeycloakIDPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"base_url": "base_url",
+	"realm": "realm",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.KeycloakIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceKeycloakIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeKeycloak,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_keycloak (idp_id, instance_id, base_url, realm, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"base_url",
+								"realm",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceKeycloakIDPChanged realm and scopes",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.KeycloakIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"realm": "realm",
+	"scopes": ["openid", "profile"]
+}`),
+					), instance.KeycloakIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceKeycloakIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_keycloak SET (realm, scopes) = ($1, $2) WHERE (idp_id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								"realm",
+								database.TextArray[string]{"openid", "profile"},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
+func TestIDPTemplateProjection_reducesOkta(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceOktaIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.OktaIDPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"authorization_server_id": "authorization_server_id",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.OktaIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceOktaIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeOkta,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_okta (idp_id, instance_id, issuer, authorization_server_id, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"issuer",
+								"authorization_server_id",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceOktaIDPChanged authorization server",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.OktaIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"authorization_server_id": "authorization_server_id"
+}`),
+					), instance.OktaIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceOktaIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_okta SET authorization_server_id = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"authorization_server_id",
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
 func TestIDPTemplateProjection_reducesGoogle(t *testing.T) {
 	type args struct {
 		event func(t *testing.T) eventstore.Event
@@ -2342,6 +2620,93 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "instance reduceLDAPIDPChanged sync",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.LDAPIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"sync": {
+		"enabled": true,
+		"groupAttribute": "memberOf"
+	}
+}`),
+					), instance.LDAPIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceLDAPIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idp_templates6 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_ldap2 SET sync = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								&idp.LDAPSync{
+									Enabled:        true,
+									GroupAttribute: "memberOf",
+								},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceLDAPIDPSynced",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.LDAPIDPSyncedEventType,
+						org.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"report": {
+		"dryRun": true,
+		"created": ["ext1"],
+		"failed": [{"externalUserId": "ext2", "error": "failed"}]
+	}
+}`),
+					), org.LDAPIDPSyncedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceLDAPIDPSynced,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_ldap2 SET sync_report = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								&idp.LDAPSyncReport{
+									DryRun:  true,
+									Created: []string{"ext1"},
+									Failed: []idp.LDAPSyncUserFailure{
+										{ExternalUserID: "ext2", Error: "failed"},
+									},
+								},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceLDAPIDPChanged",
 			args: args{
@@ -2942,6 +3307,48 @@ func TestIDPTemplateProjection_reducesSAML(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "instance reduceSAMLIDPChanged claim mappings",
+			args: args{
+				event: getEvent(testEvent(
+					instance.SAMLIDPChangedEventType,
+					instance.AggregateType,
+					[]byte(`{
+	"id": "idp-id",
+	"claimMappings": [
+		{
+			"claim": "department",
+			"metadataKey": "department"
+		}
+	]
+}`),
+				), instance.SAMLIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceSAMLIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idp_templates6 SET (change_date, sequence, claim_mappings) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								idp.ClaimMappings{
+									{
+										Claim:       "department",
+										MetadataKey: "department",
+									},
+								},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceSAMLIDPChanged",
 			args: args{
@@ -3235,6 +3642,52 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "instance reduceOIDCIDPChanged user mapping",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.OIDCIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"userMapping": {
+		"id": "$.attributes.uid",
+		"email": "$.emails[0].value"
+	}
+}`),
+					), instance.OIDCIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceOIDCIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idp_templates6 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_oidc SET user_mapping = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								providers.UserMapping{
+									ID:    "$.attributes.uid",
+									Email: "$.emails[0].value",
+								},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceOIDCIDPChanged",
 			args: args{
@@ -0,0 +1,248 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	NotificationRequestProjectionTable = "projections.notification_requests"
+
+	NotificationRequestColumnID               = "id"
+	NotificationRequestColumnCreationDate     = "creation_date"
+	NotificationRequestColumnChangeDate       = "change_date"
+	NotificationRequestColumnSequence         = "sequence"
+	NotificationRequestColumnState            = "state"
+	NotificationRequestColumnResourceOwner    = "resource_owner"
+	NotificationRequestColumnInstanceID       = "instance_id"
+	NotificationRequestColumnUserID           = "user_id"
+	NotificationRequestColumnNotificationType = "notification_type"
+	NotificationRequestColumnMessageType      = "message_type"
+	NotificationRequestColumnTriggerAggType   = "trigger_aggregate_type"
+	NotificationRequestColumnTriggerAggID     = "trigger_aggregate_id"
+	NotificationRequestColumnTriggerEventType = "trigger_event_type"
+	NotificationRequestColumnTriggerSequence  = "trigger_sequence"
+	NotificationRequestColumnAttempts         = "attempts"
+	NotificationRequestColumnLastError        = "last_error"
+	NotificationRequestColumnNextAttempt      = "next_attempt"
+)
+
+type notificationRequestProjection struct{}
+
+func newNotificationRequestProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(notificationRequestProjection))
+}
+
+func (*notificationRequestProjection) Name() string {
+	return NotificationRequestProjectionTable
+}
+
+func (*notificationRequestProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationRequestColumnID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationRequestColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationRequestColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationRequestColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationRequestColumnState, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationRequestColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(NotificationRequestColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationRequestColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationRequestColumnNotificationType, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationRequestColumnMessageType, handler.ColumnTypeText),
+			handler.NewColumn(NotificationRequestColumnTriggerAggType, handler.ColumnTypeText),
+			handler.NewColumn(NotificationRequestColumnTriggerAggID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationRequestColumnTriggerEventType, handler.ColumnTypeText),
+			handler.NewColumn(NotificationRequestColumnTriggerSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationRequestColumnAttempts, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(NotificationRequestColumnLastError, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(NotificationRequestColumnNextAttempt, handler.ColumnTypeTimestamp, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(NotificationRequestColumnInstanceID, NotificationRequestColumnID),
+			handler.WithIndex(handler.NewIndex("user_id", []string{NotificationRequestColumnUserID})),
+			handler.WithIndex(handler.NewIndex("next_attempt", []string{NotificationRequestColumnNextAttempt})),
+		),
+	)
+}
+
+func (p *notificationRequestProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: notification.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notification.RequestedType,
+					Reduce: p.reduceRequested,
+				},
+				{
+					Event:  notification.SentType,
+					Reduce: p.reduceSent,
+				},
+				{
+					Event:  notification.FailedType,
+					Reduce: p.reduceFailed,
+				},
+				{
+					Event:  notification.RetryRequestedType,
+					Reduce: p.reduceRetryRequested,
+				},
+				{
+					Event:  notification.DeadLetteredType,
+					Reduce: p.reduceDeadLettered,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(NotificationRequestColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *notificationRequestProjection) reduceRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.RequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationRequestColumnID, e.Aggregate().ID),
+			handler.NewCol(NotificationRequestColumnCreationDate, e.CreatedAt()),
+			handler.NewCol(NotificationRequestColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(NotificationRequestColumnSequence, e.Sequence()),
+			handler.NewCol(NotificationRequestColumnState, domain.NotificationStateQueued),
+			handler.NewCol(NotificationRequestColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(NotificationRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(NotificationRequestColumnUserID, e.UserID),
+			handler.NewCol(NotificationRequestColumnNotificationType, e.NotificationType),
+			handler.NewCol(NotificationRequestColumnMessageType, e.MessageType),
+			handler.NewCol(NotificationRequestColumnTriggerAggType, e.TriggerAggregateType),
+			handler.NewCol(NotificationRequestColumnTriggerAggID, e.TriggerAggregateID),
+			handler.NewCol(NotificationRequestColumnTriggerEventType, e.TriggerEventType),
+			handler.NewCol(NotificationRequestColumnTriggerSequence, e.TriggerSequence),
+		},
+	), nil
+}
+
+func (p *notificationRequestProjection) reduceSent(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.SentEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(NotificationRequestColumnState, domain.NotificationStateSent),
+		handler.NewIncrementCol(NotificationRequestColumnAttempts, 1),
+		handler.NewCol(NotificationRequestColumnNextAttempt, nil),
+	), nil
+}
+
+func (p *notificationRequestProjection) reduceFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.FailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(NotificationRequestColumnState, domain.NotificationStateFailed),
+		handler.NewIncrementCol(NotificationRequestColumnAttempts, 1),
+		handler.NewCol(NotificationRequestColumnLastError, e.Error),
+		handler.NewCol(NotificationRequestColumnNextAttempt, e.RetryAt),
+	), nil
+}
+
+func (p *notificationRequestProjection) reduceRetryRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.RetryRequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(NotificationRequestColumnState, domain.NotificationStateFailed),
+		handler.NewCol(NotificationRequestColumnAttempts, 0),
+		handler.NewCol(NotificationRequestColumnNextAttempt, e.CreatedAt()),
+	), nil
+}
+
+func (p *notificationRequestProjection) reduceDeadLettered(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeadLetteredEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(NotificationRequestColumnState, domain.NotificationStateDeadLetter),
+		handler.NewCol(NotificationRequestColumnLastError, e.Error),
+		handler.NewCol(NotificationRequestColumnNextAttempt, nil),
+	), nil
+}
+
+func (p *notificationRequestProjection) updateStatement(event eventstore.Event, columns ...handler.Column) *handler.Statement {
+	return handler.NewUpdateStatement(
+		event,
+		append([]handler.Column{
+			handler.NewCol(NotificationRequestColumnChangeDate, event.CreatedAt()),
+			handler.NewCol(NotificationRequestColumnSequence, event.Sequence()),
+		}, columns...),
+		[]handler.Condition{
+			handler.NewCond(NotificationRequestColumnID, event.Aggregate().ID),
+			handler.NewCond(NotificationRequestColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	)
+}
+
+func (p *notificationRequestProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationRequestColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *notificationRequestProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationRequestColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
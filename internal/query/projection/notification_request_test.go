@@ -0,0 +1,294 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationRequestProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceRequested",
+			args: args{
+				event: getEvent(testEvent(
+					notification.RequestedType,
+					notification.AggregateType,
+					[]byte(`{
+						"userID": "user-id",
+						"notificationType": 0,
+						"messageType": "InitCode",
+						"triggerAggregateType": "user",
+						"triggerAggregateID": "user-id",
+						"triggerEventType": "user.human.initialization.code.added",
+						"triggerSequence": 10
+					}`),
+				), eventstore.GenericEventMapper[notification.RequestedEvent]),
+			},
+			reduce: (&notificationRequestProjection{}).reduceRequested,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_requests (id, creation_date, change_date, sequence, state, resource_owner, instance_id, user_id, notification_type, message_type, trigger_aggregate_type, trigger_aggregate_id, trigger_event_type, trigger_sequence) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateQueued,
+								"ro-id",
+								"instance-id",
+								"user-id",
+								domain.NotificationTypeEmail,
+								"InitCode",
+								eventstore.AggregateType("user"),
+								"user-id",
+								eventstore.EventType("user.human.initialization.code.added"),
+								uint64(10),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSent",
+			args: args{
+				event: getEvent(testEvent(
+					notification.SentType,
+					notification.AggregateType,
+					[]byte(`{}`),
+				), eventstore.GenericEventMapper[notification.SentEvent]),
+			},
+			reduce: (&notificationRequestProjection{}).reduceSent,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_requests SET (change_date, sequence, state, attempts, next_attempt) = ($1, $2, $3, attempts + $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateSent,
+								1,
+								nil,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceFailed",
+			args: args{
+				event: getEvent(testEvent(
+					notification.FailedType,
+					notification.AggregateType,
+					[]byte(`{
+						"error": "connection refused",
+						"retryAt": "2024-01-01T00:00:00Z"
+					}`),
+				), eventstore.GenericEventMapper[notification.FailedEvent]),
+			},
+			reduce: (&notificationRequestProjection{}).reduceFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_requests SET (change_date, sequence, state, attempts, last_error, next_attempt) = ($1, $2, $3, attempts + $4, $5, $6) WHERE (id = $7) AND (instance_id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateFailed,
+								1,
+								"connection refused",
+								time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRetryRequested",
+			args: args{
+				event: getEvent(testEvent(
+					notification.RetryRequestedType,
+					notification.AggregateType,
+					[]byte(`{}`),
+				), eventstore.GenericEventMapper[notification.RetryRequestedEvent]),
+			},
+			reduce: (&notificationRequestProjection{}).reduceRetryRequested,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_requests SET (change_date, sequence, state, attempts, next_attempt) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateFailed,
+								0,
+								anyArg{},
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeadLettered",
+			args: args{
+				event: getEvent(testEvent(
+					notification.DeadLetteredType,
+					notification.AggregateType,
+					[]byte(`{
+						"error": "maximum number of attempts reached"
+					}`),
+				), eventstore.GenericEventMapper[notification.DeadLetteredEvent]),
+			},
+			reduce: (&notificationRequestProjection{}).reduceDeadLettered,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_requests SET (change_date, sequence, state, last_error, next_attempt) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateDeadLetter,
+								"maximum number of attempts reached",
+								nil,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					user.UserRemovedType,
+					user.AggregateType,
+					nil,
+				), user.UserRemovedEventMapper),
+			},
+			reduce: (&notificationRequestProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_requests WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					org.OrgRemovedEventType,
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&notificationRequestProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_requests WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					instance.InstanceRemovedEventType,
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(NotificationRequestColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_requests WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, NotificationRequestProjectionTable, tt.want)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/migration"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 const (
@@ -77,6 +78,8 @@ var (
 	TargetProjection                    *handler.Handler
 	ExecutionProjection                 *handler.Handler
 	UserSchemaProjection                *handler.Handler
+	SchemaUserProjection                *handler.Handler
+	NotificationRequestProjection       *handler.Handler
 )
 
 type projection interface {
@@ -158,6 +161,8 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	TargetProjection = newTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["targets"]))
 	ExecutionProjection = newExecutionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["executions"]))
 	UserSchemaProjection = newUserSchemaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_schemas"]))
+	SchemaUserProjection = newSchemaUserProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["schema_users"]))
+	NotificationRequestProjection = newNotificationRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_requests"]))
 	newProjectionsList()
 	return nil
 }
@@ -191,6 +196,19 @@ func ProjectInstance(ctx context.Context) error {
 	return nil
 }
 
+// Rebuild rebuilds the projection with the given name into shadow tables and swaps them afterwards.
+// The tables of all instances are rebuilt if no instance ids are passed.
+func Rebuild(ctx context.Context, projectionName string, instanceIDs []string, progress func(*handler.RebuildProgress)) error {
+	for _, p := range projections {
+		h, ok := p.(*handler.Handler)
+		if !ok || h.ProjectionName() != projectionName {
+			continue
+		}
+		return h.Rebuild(ctx, instanceIDs, progress)
+	}
+	return zerrors.ThrowNotFound(nil, "HANDL-ahT7a", "Errors.ProjectionName.Invalid")
+}
+
 func ApplyCustomConfig(customConfig CustomConfig) handler.Config {
 	return applyCustomConfig(projectionConfig, customConfig)
 }
@@ -282,5 +300,7 @@ func newProjectionsList() {
 		TargetProjection,
 		ExecutionProjection,
 		UserSchemaProjection,
+		SchemaUserProjection,
+		NotificationRequestProjection,
 	}
 }
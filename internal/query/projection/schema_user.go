@@ -0,0 +1,319 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+)
+
+const (
+	SchemaUserTable = "projections.schema_users"
+
+	SchemaUserIDCol             = "id"
+	SchemaUserCreationDateCol   = "creation_date"
+	SchemaUserChangeDateCol     = "change_date"
+	SchemaUserSequenceCol       = "sequence"
+	SchemaUserStateCol          = "state"
+	SchemaUserResourceOwnerCol  = "resource_owner"
+	SchemaUserInstanceIDCol     = "instance_id"
+	SchemaUserSchemaIDCol       = "schema_id"
+	SchemaUserSchemaRevisionCol = "schema_revision"
+	SchemaUserDataCol           = "data"
+	SchemaUserEmailCol          = "email"
+	SchemaUserEmailVerifiedCol  = "email_verified"
+	SchemaUserPhoneCol          = "phone"
+	SchemaUserPhoneVerifiedCol  = "phone_verified"
+)
+
+type schemaUserProjection struct{}
+
+func newSchemaUserProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(schemaUserProjection))
+}
+
+func (*schemaUserProjection) Name() string {
+	return SchemaUserTable
+}
+
+func (*schemaUserProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(SchemaUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SchemaUserCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SchemaUserChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SchemaUserSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(SchemaUserStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(SchemaUserResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(SchemaUserInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SchemaUserSchemaIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SchemaUserSchemaRevisionCol, handler.ColumnTypeInt64),
+			handler.NewColumn(SchemaUserDataCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SchemaUserEmailCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(SchemaUserEmailVerifiedCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(SchemaUserPhoneCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(SchemaUserPhoneVerifiedCol, handler.ColumnTypeBool, handler.Default(false)),
+		},
+			handler.NewPrimaryKey(SchemaUserInstanceIDCol, SchemaUserIDCol),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{SchemaUserResourceOwnerCol})),
+		),
+	)
+}
+
+func (p *schemaUserProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: schemauser.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  schemauser.CreatedType,
+					Reduce: p.reduceCreated,
+				},
+				{
+					Event:  schemauser.UpdatedType,
+					Reduce: p.reduceUpdated,
+				},
+				{
+					Event:  schemauser.DeletedType,
+					Reduce: p.reduceDeleted,
+				},
+				{
+					Event:  schemauser.LockedType,
+					Reduce: p.reduceLocked,
+				},
+				{
+					Event:  schemauser.UnlockedType,
+					Reduce: p.reduceUnlocked,
+				},
+				{
+					Event:  schemauser.DeactivatedType,
+					Reduce: p.reduceDeactivated,
+				},
+				{
+					Event:  schemauser.ReactivatedType,
+					Reduce: p.reduceReactivated,
+				},
+				{
+					Event:  schemauser.EmailUpdatedType,
+					Reduce: p.reduceEmailUpdated,
+				},
+				{
+					Event:  schemauser.EmailVerifiedType,
+					Reduce: p.reduceEmailVerified,
+				},
+				{
+					Event:  schemauser.PhoneUpdatedType,
+					Reduce: p.reducePhoneUpdated,
+				},
+				{
+					Event:  schemauser.PhoneVerifiedType,
+					Reduce: p.reducePhoneVerified,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(SchemaUserInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *schemaUserProjection) reduceCreated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*schemauser.CreatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewCreateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(SchemaUserIDCol, event.Aggregate().ID),
+			handler.NewCol(SchemaUserCreationDateCol, event.CreatedAt()),
+			handler.NewCol(SchemaUserChangeDateCol, event.CreatedAt()),
+			handler.NewCol(SchemaUserSequenceCol, event.Sequence()),
+			handler.NewCol(SchemaUserStateCol, domain.UserStateActive),
+			handler.NewCol(SchemaUserResourceOwnerCol, event.Aggregate().ResourceOwner),
+			handler.NewCol(SchemaUserInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCol(SchemaUserSchemaIDCol, e.SchemaID),
+			handler.NewCol(SchemaUserSchemaRevisionCol, e.SchemaRevision),
+			handler.NewCol(SchemaUserDataCol, e.Data),
+		},
+	), nil
+}
+
+func (p *schemaUserProjection) reduceUpdated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*schemauser.UpdatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := []handler.Column{
+		handler.NewCol(SchemaUserChangeDateCol, event.CreatedAt()),
+		handler.NewCol(SchemaUserSequenceCol, event.Sequence()),
+	}
+	if e.SchemaID != nil {
+		cols = append(cols, handler.NewCol(SchemaUserSchemaIDCol, *e.SchemaID))
+	}
+	if e.SchemaRevision != nil {
+		cols = append(cols, handler.NewCol(SchemaUserSchemaRevisionCol, *e.SchemaRevision))
+	}
+	if len(e.Data) > 0 {
+		cols = append(cols, handler.NewCol(SchemaUserDataCol, e.Data))
+	}
+
+	return p.updateStatement(event, cols), nil
+}
+
+func (p *schemaUserProjection) reduceDeleted(event eventstore.Event) (*handler.Statement, error) {
+	_, err := assertEvent[*schemauser.DeletedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(SchemaUserIDCol, event.Aggregate().ID),
+			handler.NewCond(SchemaUserInstanceIDCol, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *schemaUserProjection) reduceLocked(event eventstore.Event) (*handler.Statement, error) {
+	_, err := assertEvent[*schemauser.LockedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(event, domain.UserStateLocked), nil
+}
+
+func (p *schemaUserProjection) reduceUnlocked(event eventstore.Event) (*handler.Statement, error) {
+	_, err := assertEvent[*schemauser.UnlockedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(event, domain.UserStateActive), nil
+}
+
+func (p *schemaUserProjection) reduceDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	_, err := assertEvent[*schemauser.DeactivatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(event, domain.UserStateInactive), nil
+}
+
+func (p *schemaUserProjection) reduceReactivated(event eventstore.Event) (*handler.Statement, error) {
+	_, err := assertEvent[*schemauser.ReactivatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(event, domain.UserStateActive), nil
+}
+
+func (p *schemaUserProjection) reduceEmailUpdated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*schemauser.EmailUpdatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.updateStatement(event, []handler.Column{
+		handler.NewCol(SchemaUserChangeDateCol, event.CreatedAt()),
+		handler.NewCol(SchemaUserSequenceCol, event.Sequence()),
+		handler.NewCol(SchemaUserEmailCol, e.Address),
+		handler.NewCol(SchemaUserEmailVerifiedCol, false),
+	}), nil
+}
+
+func (p *schemaUserProjection) reduceEmailVerified(event eventstore.Event) (*handler.Statement, error) {
+	_, err := assertEvent[*schemauser.EmailVerifiedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.updateStatement(event, []handler.Column{
+		handler.NewCol(SchemaUserChangeDateCol, event.CreatedAt()),
+		handler.NewCol(SchemaUserSequenceCol, event.Sequence()),
+		handler.NewCol(SchemaUserEmailVerifiedCol, true),
+	}), nil
+}
+
+func (p *schemaUserProjection) reducePhoneUpdated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*schemauser.PhoneUpdatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.updateStatement(event, []handler.Column{
+		handler.NewCol(SchemaUserChangeDateCol, event.CreatedAt()),
+		handler.NewCol(SchemaUserSequenceCol, event.Sequence()),
+		handler.NewCol(SchemaUserPhoneCol, e.Number),
+		handler.NewCol(SchemaUserPhoneVerifiedCol, false),
+	}), nil
+}
+
+func (p *schemaUserProjection) reducePhoneVerified(event eventstore.Event) (*handler.Statement, error) {
+	_, err := assertEvent[*schemauser.PhoneVerifiedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.updateStatement(event, []handler.Column{
+		handler.NewCol(SchemaUserChangeDateCol, event.CreatedAt()),
+		handler.NewCol(SchemaUserSequenceCol, event.Sequence()),
+		handler.NewCol(SchemaUserPhoneVerifiedCol, true),
+	}), nil
+}
+
+func (p *schemaUserProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	_, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(SchemaUserInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCond(SchemaUserResourceOwnerCol, event.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *schemaUserProjection) updateState(event eventstore.Event, state domain.UserState) *handler.Statement {
+	return p.updateStatement(event, []handler.Column{
+		handler.NewCol(SchemaUserChangeDateCol, event.CreatedAt()),
+		handler.NewCol(SchemaUserSequenceCol, event.Sequence()),
+		handler.NewCol(SchemaUserStateCol, state),
+	})
+}
+
+func (p *schemaUserProjection) updateStatement(event eventstore.Event, cols []handler.Column) *handler.Statement {
+	return handler.NewUpdateStatement(
+		event,
+		cols,
+		[]handler.Condition{
+			handler.NewCond(SchemaUserIDCol, event.Aggregate().ID),
+			handler.NewCond(SchemaUserInstanceIDCol, event.Aggregate().InstanceID),
+		},
+	)
+}
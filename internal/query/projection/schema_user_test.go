@@ -0,0 +1,339 @@
+package projection
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestSchemaUserProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceCreated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						schemauser.CreatedType,
+						schemauser.AggregateType,
+						[]byte(`{"schemaID": "schema", "schemaRevision": 2, "data": {"name":"user"}}`),
+					), eventstore.GenericEventMapper[schemauser.CreatedEvent]),
+			},
+			reduce: (&schemaUserProjection{}).reduceCreated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.schema_users (id, creation_date, change_date, sequence, state, resource_owner, instance_id, schema_id, schema_revision, data) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.UserStateActive,
+								"ro-id",
+								"instance-id",
+								"schema",
+								uint64(2),
+								json.RawMessage(`{"name":"user"}`),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUpdated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						schemauser.UpdatedType,
+						schemauser.AggregateType,
+						[]byte(`{"schemaID": "schema2", "schemaRevision": 3, "data": {"name":"changed"}}`),
+					), eventstore.GenericEventMapper[schemauser.UpdatedEvent]),
+			},
+			reduce: (&schemaUserProjection{}).reduceUpdated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.schema_users SET (change_date, sequence, schema_id, schema_revision, data) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"schema2",
+								uint64(3),
+								json.RawMessage(`{"name":"changed"}`),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeleted",
+			args: args{
+				event: getEvent(
+					testEvent(
+						schemauser.DeletedType,
+						schemauser.AggregateType,
+						[]byte(`{}`),
+					), eventstore.GenericEventMapper[schemauser.DeletedEvent]),
+			},
+			reduce: (&schemaUserProjection{}).reduceDeleted,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.schema_users WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceLocked",
+			args: args{
+				event: getEvent(
+					testEvent(
+						schemauser.LockedType,
+						schemauser.AggregateType,
+						[]byte(`{}`),
+					), eventstore.GenericEventMapper[schemauser.LockedEvent]),
+			},
+			reduce: (&schemaUserProjection{}).reduceLocked,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.schema_users SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserStateLocked,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeactivated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						schemauser.DeactivatedType,
+						schemauser.AggregateType,
+						[]byte(`{}`),
+					), eventstore.GenericEventMapper[schemauser.DeactivatedEvent]),
+			},
+			reduce: (&schemaUserProjection{}).reduceDeactivated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.schema_users SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserStateInactive,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceEmailUpdated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						schemauser.EmailUpdatedType,
+						schemauser.AggregateType,
+						[]byte(`{"address": "test@example.com"}`),
+					), eventstore.GenericEventMapper[schemauser.EmailUpdatedEvent]),
+			},
+			reduce: (&schemaUserProjection{}).reduceEmailUpdated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.schema_users SET (change_date, sequence, email, email_verified) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.EmailAddress("test@example.com"),
+								false,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceEmailVerified",
+			args: args{
+				event: getEvent(
+					testEvent(
+						schemauser.EmailVerifiedType,
+						schemauser.AggregateType,
+						[]byte(`{}`),
+					), eventstore.GenericEventMapper[schemauser.EmailVerifiedEvent]),
+			},
+			reduce: (&schemaUserProjection{}).reduceEmailVerified,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.schema_users SET (change_date, sequence, email_verified) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								true,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducePhoneUpdated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						schemauser.PhoneUpdatedType,
+						schemauser.AggregateType,
+						[]byte(`{"number": "+41791234567"}`),
+					), eventstore.GenericEventMapper[schemauser.PhoneUpdatedEvent]),
+			},
+			reduce: (&schemaUserProjection{}).reducePhoneUpdated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.schema_users SET (change_date, sequence, phone, phone_verified) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.PhoneNumber("+41791234567"),
+								false,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceOwnerRemoved",
+			reduce: (&schemaUserProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.schema_users WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(SchemaUserInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.schema_users WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, SchemaUserTable, tt.want)
+		})
+	}
+}
@@ -12,8 +12,11 @@ import (
 )
 
 const (
-	SMSConfigProjectionTable = "projections.sms_configs2"
+	SMSConfigProjectionTable = "projections.sms_configs3"
 	SMSTwilioTable           = SMSConfigProjectionTable + "_" + smsTwilioTableSuffix
+	SMSHTTPTable             = SMSConfigProjectionTable + "_" + smsHTTPTableSuffix
+	SMSVonageTable           = SMSConfigProjectionTable + "_" + smsVonageTableSuffix
+	SMSMessageBirdTable      = SMSConfigProjectionTable + "_" + smsMessageBirdTableSuffix
 
 	SMSColumnID            = "id"
 	SMSColumnAggregateID   = "aggregate_id"
@@ -23,6 +26,7 @@ const (
 	SMSColumnState         = "state"
 	SMSColumnResourceOwner = "resource_owner"
 	SMSColumnInstanceID    = "instance_id"
+	SMSColumnPriority      = "priority"
 
 	smsTwilioTableSuffix              = "twilio"
 	SMSTwilioConfigColumnSMSID        = "sms_id"
@@ -30,6 +34,29 @@ const (
 	SMSTwilioConfigColumnSID          = "sid"
 	SMSTwilioConfigColumnSenderNumber = "sender_number"
 	SMSTwilioConfigColumnToken        = "token"
+
+	smsHTTPTableSuffix                 = "http"
+	SMSHTTPConfigColumnSMSID           = "sms_id"
+	SMSHTTPColumnInstanceID            = "instance_id"
+	SMSHTTPConfigColumnEndpoint        = "endpoint"
+	SMSHTTPConfigColumnMethod          = "method"
+	SMSHTTPConfigColumnBodyTemplate    = "body_template"
+	SMSHTTPConfigColumnAuthHeaderName  = "auth_header_name"
+	SMSHTTPConfigColumnAuthHeaderValue = "auth_header_value"
+	SMSHTTPConfigColumnSenderNumber    = "sender_number"
+
+	smsVonageTableSuffix              = "vonage"
+	SMSVonageConfigColumnSMSID        = "sms_id"
+	SMSVonageColumnInstanceID         = "instance_id"
+	SMSVonageConfigColumnAPIKey       = "api_key"
+	SMSVonageConfigColumnAPISecret    = "api_secret"
+	SMSVonageConfigColumnSenderNumber = "sender_number"
+
+	smsMessageBirdTableSuffix            = "messagebird"
+	SMSMessageBirdConfigColumnSMSID      = "sms_id"
+	SMSMessageBirdColumnInstanceID       = "instance_id"
+	SMSMessageBirdConfigColumnAccessKey  = "access_key"
+	SMSMessageBirdConfigColumnOriginator = "originator"
 )
 
 type smsConfigProjection struct{}
@@ -53,6 +80,7 @@ func (*smsConfigProjection) Init() *old_handler.Check {
 			handler.NewColumn(SMSColumnState, handler.ColumnTypeEnum),
 			handler.NewColumn(SMSColumnResourceOwner, handler.ColumnTypeText),
 			handler.NewColumn(SMSColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSColumnPriority, handler.ColumnTypeInt64, handler.Default(0)),
 		},
 			handler.NewPrimaryKey(SMSColumnInstanceID, SMSColumnID),
 		),
@@ -67,6 +95,41 @@ func (*smsConfigProjection) Init() *old_handler.Check {
 			smsTwilioTableSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSHTTPConfigColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnEndpoint, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnMethod, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnBodyTemplate, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnAuthHeaderName, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnAuthHeaderValue, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SMSHTTPConfigColumnSenderNumber, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSHTTPColumnInstanceID, SMSHTTPConfigColumnSMSID),
+			smsHTTPTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSVonageConfigColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageConfigColumnAPIKey, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageConfigColumnAPISecret, handler.ColumnTypeJSONB),
+			handler.NewColumn(SMSVonageConfigColumnSenderNumber, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSVonageColumnInstanceID, SMSVonageConfigColumnSMSID),
+			smsVonageTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSMessageBirdConfigColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSMessageBirdColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSMessageBirdConfigColumnAccessKey, handler.ColumnTypeJSONB),
+			handler.NewColumn(SMSMessageBirdConfigColumnOriginator, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSMessageBirdColumnInstanceID, SMSMessageBirdConfigColumnSMSID),
+			smsMessageBirdTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -99,6 +162,34 @@ func (p *smsConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMSConfigRemovedEventType,
 					Reduce: p.reduceSMSConfigRemoved,
 				},
+				{
+					Event:  instance.SMSConfigHTTPAddedEventType,
+					Reduce: p.reduceSMSConfigHTTPAdded,
+				},
+				{
+					Event:  instance.SMSConfigHTTPChangedEventType,
+					Reduce: p.reduceSMSConfigHTTPChanged,
+				},
+				{
+					Event:  instance.SMSConfigVonageAddedEventType,
+					Reduce: p.reduceSMSConfigVonageAdded,
+				},
+				{
+					Event:  instance.SMSConfigVonageChangedEventType,
+					Reduce: p.reduceSMSConfigVonageChanged,
+				},
+				{
+					Event:  instance.SMSConfigMessageBirdAddedEventType,
+					Reduce: p.reduceSMSConfigMessageBirdAdded,
+				},
+				{
+					Event:  instance.SMSConfigMessageBirdChangedEventType,
+					Reduce: p.reduceSMSConfigMessageBirdChanged,
+				},
+				{
+					Event:  instance.SMSConfigPriorityChangedEventType,
+					Reduce: p.reduceSMSConfigPriorityChanged,
+				},
 				{
 					Event:  instance.InstanceRemovedEventType,
 					Reduce: reduceInstanceRemovedHelper(SMSColumnInstanceID),
@@ -120,8 +211,8 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioAdded(event eventstore.Event)
 			[]handler.Column{
 				handler.NewCol(SMSColumnID, e.ID),
 				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
-				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
-				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnCreationDate, e.CreatedAt()),
+				handler.NewCol(SMSColumnChangeDate, e.CreatedAt()),
 				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
 				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
 				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
@@ -166,7 +257,7 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioChanged(event eventstore.Even
 		),
 		handler.AddUpdateStatement(
 			[]handler.Column{
-				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreatedAt()),
 				handler.NewCol(SMSColumnSequence, e.Sequence()),
 			},
 			[]handler.Condition{
@@ -199,7 +290,7 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioTokenChanged(event eventstore
 		),
 		handler.AddUpdateStatement(
 			[]handler.Column{
-				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreatedAt()),
 				handler.NewCol(SMSColumnSequence, e.Sequence()),
 			},
 			[]handler.Condition{
@@ -219,7 +310,7 @@ func (p *smsConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (
 		e,
 		[]handler.Column{
 			handler.NewCol(SMSColumnState, domain.SMSConfigStateActive),
-			handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMSColumnChangeDate, e.CreatedAt()),
 			handler.NewCol(SMSColumnSequence, e.Sequence()),
 		},
 		[]handler.Condition{
@@ -238,7 +329,7 @@ func (p *smsConfigProjection) reduceSMSConfigDeactivated(event eventstore.Event)
 		e,
 		[]handler.Column{
 			handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
-			handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMSColumnChangeDate, e.CreatedAt()),
 			handler.NewCol(SMSColumnSequence, e.Sequence()),
 		},
 		[]handler.Condition{
@@ -261,3 +352,215 @@ func (p *smsConfigProjection) reduceSMSConfigRemoved(event eventstore.Event) (*h
 		},
 	), nil
 }
+
+func (p *smsConfigProjection) reduceSMSConfigHTTPAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigHTTPAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Dq4vYk8nWa", "reduce.wrong.event.type %s", instance.SMSConfigHTTPAddedEventType)
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		addSMSConfigStatement(e, e.ID, e.Priority),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSHTTPConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSHTTPColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSHTTPConfigColumnEndpoint, e.Endpoint),
+				handler.NewCol(SMSHTTPConfigColumnMethod, e.Method),
+				handler.NewCol(SMSHTTPConfigColumnBodyTemplate, e.BodyTemplate),
+				handler.NewCol(SMSHTTPConfigColumnAuthHeaderName, e.AuthHeaderName),
+				handler.NewCol(SMSHTTPConfigColumnAuthHeaderValue, e.AuthHeaderValue),
+				handler.NewCol(SMSHTTPConfigColumnSenderNumber, e.SenderNumber),
+			},
+			handler.WithTableSuffix(smsHTTPTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigHTTPChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigHTTPChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Tg7sMc2rXo", "reduce.wrong.event.type %s", instance.SMSConfigHTTPChangedEventType)
+	}
+	columns := make([]handler.Column, 0, 6)
+	if e.Endpoint != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnEndpoint, *e.Endpoint))
+	}
+	if e.Method != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnMethod, *e.Method))
+	}
+	if e.BodyTemplate != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnBodyTemplate, *e.BodyTemplate))
+	}
+	if e.AuthHeaderName != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnAuthHeaderName, *e.AuthHeaderName))
+	}
+	if e.AuthHeaderValue != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnAuthHeaderValue, e.AuthHeaderValue))
+	}
+	if e.SenderNumber != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnSenderNumber, *e.SenderNumber))
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSHTTPConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSHTTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsHTTPTableSuffix),
+		),
+		updateSMSConfigStatement(e, e.ID),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigVonageAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigVonageAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Wn3bKx6dPe", "reduce.wrong.event.type %s", instance.SMSConfigVonageAddedEventType)
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		addSMSConfigStatement(e, e.ID, e.Priority),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSVonageConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSVonageColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSVonageConfigColumnAPIKey, e.APIKey),
+				handler.NewCol(SMSVonageConfigColumnAPISecret, e.APISecret),
+				handler.NewCol(SMSVonageConfigColumnSenderNumber, e.SenderNumber),
+			},
+			handler.WithTableSuffix(smsVonageTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigVonageChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigVonageChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ha8rLe1mSv", "reduce.wrong.event.type %s", instance.SMSConfigVonageChangedEventType)
+	}
+	columns := make([]handler.Column, 0, 3)
+	if e.APIKey != nil {
+		columns = append(columns, handler.NewCol(SMSVonageConfigColumnAPIKey, *e.APIKey))
+	}
+	if e.APISecret != nil {
+		columns = append(columns, handler.NewCol(SMSVonageConfigColumnAPISecret, e.APISecret))
+	}
+	if e.SenderNumber != nil {
+		columns = append(columns, handler.NewCol(SMSVonageConfigColumnSenderNumber, *e.SenderNumber))
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSVonageConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSVonageColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsVonageTableSuffix),
+		),
+		updateSMSConfigStatement(e, e.ID),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigMessageBirdAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigMessageBirdAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ce5tNw9qGz", "reduce.wrong.event.type %s", instance.SMSConfigMessageBirdAddedEventType)
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		addSMSConfigStatement(e, e.ID, e.Priority),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSMessageBirdConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSMessageBirdColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSMessageBirdConfigColumnAccessKey, e.AccessKey),
+				handler.NewCol(SMSMessageBirdConfigColumnOriginator, e.Originator),
+			},
+			handler.WithTableSuffix(smsMessageBirdTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigMessageBirdChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigMessageBirdChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Iy2kVd4sOb", "reduce.wrong.event.type %s", instance.SMSConfigMessageBirdChangedEventType)
+	}
+	columns := make([]handler.Column, 0, 2)
+	if e.AccessKey != nil {
+		columns = append(columns, handler.NewCol(SMSMessageBirdConfigColumnAccessKey, e.AccessKey))
+	}
+	if e.Originator != nil {
+		columns = append(columns, handler.NewCol(SMSMessageBirdConfigColumnOriginator, *e.Originator))
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSMessageBirdConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSMessageBirdColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsMessageBirdTableSuffix),
+		),
+		updateSMSConfigStatement(e, e.ID),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigPriorityChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigPriorityChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ob6pXa3wEk", "reduce.wrong.event.type %s", instance.SMSConfigPriorityChangedEventType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMSColumnPriority, e.Priority),
+			handler.NewCol(SMSColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(SMSColumnSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMSColumnID, e.ID),
+			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func addSMSConfigStatement(e eventstore.Event, id string, priority uint32) func(eventstore.Event) handler.Exec {
+	return handler.AddCreateStatement(
+		[]handler.Column{
+			handler.NewCol(SMSColumnID, id),
+			handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+			handler.NewCol(SMSColumnCreationDate, e.CreatedAt()),
+			handler.NewCol(SMSColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+			handler.NewCol(SMSColumnSequence, e.Sequence()),
+			handler.NewCol(SMSColumnPriority, priority),
+		},
+	)
+}
+
+func updateSMSConfigStatement(e eventstore.Event, id string) func(eventstore.Event) handler.Exec {
+	return handler.AddUpdateStatement(
+		[]handler.Column{
+			handler.NewCol(SMSColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(SMSColumnSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMSColumnID, id),
+			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	)
+}
@@ -8,6 +8,7 @@ import (
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -99,6 +100,39 @@ func (p *smtpConfigProjection) Reducers() []handler.AggregateReducer {
 				},
 			},
 		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.SMTPConfigAddedEventType,
+					Reduce: p.reduceOrgSMTPConfigAdded,
+				},
+				{
+					Event:  org.SMTPConfigChangedEventType,
+					Reduce: p.reduceOrgSMTPConfigChanged,
+				},
+				{
+					Event:  org.SMTPConfigPasswordChangedEventType,
+					Reduce: p.reduceOrgSMTPConfigPasswordChanged,
+				},
+				{
+					Event:  org.SMTPConfigActivatedEventType,
+					Reduce: p.reduceOrgSMTPConfigActivated,
+				},
+				{
+					Event:  org.SMTPConfigDeactivatedEventType,
+					Reduce: p.reduceOrgSMTPConfigDeactivated,
+				},
+				{
+					Event:  org.SMTPConfigRemovedEventType,
+					Reduce: p.reduceOrgSMTPConfigRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
 	}
 }
 
@@ -293,3 +327,157 @@ func (p *smtpConfigProjection) reduceSMTPConfigRemoved(event eventstore.Event) (
 		},
 	), nil
 }
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnCreationDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			handler.NewCol(SMTPConfigColumnID, e.ID),
+			handler.NewCol(SMTPConfigColumnTLS, e.TLS),
+			handler.NewCol(SMTPConfigColumnSenderAddress, e.SenderAddress),
+			handler.NewCol(SMTPConfigColumnSenderName, e.SenderName),
+			handler.NewCol(SMTPConfigColumnReplyToAddress, e.ReplyToAddress),
+			handler.NewCol(SMTPConfigColumnSMTPHost, e.Host),
+			handler.NewCol(SMTPConfigColumnSMTPUser, e.User),
+			handler.NewCol(SMTPConfigColumnSMTPPassword, e.Password),
+			handler.NewCol(SMTPConfigColumnState, domain.SMTPConfigStateInactive),
+			handler.NewCol(SMTPConfigColumnDescription, e.Description),
+		},
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]handler.Column, 0, 10)
+	columns = append(columns, handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+		handler.NewCol(SMTPConfigColumnSequence, e.Sequence()))
+	if e.TLS != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnTLS, *e.TLS))
+	}
+	if e.FromAddress != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnSenderAddress, *e.FromAddress))
+	}
+	if e.FromName != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnSenderName, *e.FromName))
+	}
+	if e.ReplyToAddress != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnReplyToAddress, *e.ReplyToAddress))
+	}
+	if e.Host != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnSMTPHost, *e.Host))
+	}
+	if e.User != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnSMTPUser, *e.User))
+	}
+	if e.Password != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnSMTPPassword, *e.Password))
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnDescription, *e.Description))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		columns,
+		smtpConfigConditions(e.Aggregate(), e.ID),
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigPasswordChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigPasswordChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			handler.NewCol(SMTPConfigColumnSMTPPassword, e.Password),
+		},
+		smtpConfigConditions(e.Aggregate(), e.ID),
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigActivated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigActivatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			handler.NewCol(SMTPConfigColumnState, domain.SMTPConfigStateActive),
+		},
+		smtpConfigConditions(e.Aggregate(), e.ID),
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigDeactivatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			handler.NewCol(SMTPConfigColumnState, domain.SMTPConfigStateInactive),
+		},
+		smtpConfigConditions(e.Aggregate(), e.ID),
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		smtpConfigConditions(e.Aggregate(), e.ID),
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnResourceOwner, e.Aggregate().ID),
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func smtpConfigConditions(agg *eventstore.Aggregate, id string) []handler.Condition {
+	return []handler.Condition{
+		handler.NewCond(SMTPConfigColumnID, id),
+		handler.NewCond(SMTPConfigColumnResourceOwner, agg.ResourceOwner),
+		handler.NewCond(SMTPConfigColumnInstanceID, agg.InstanceID),
+	}
+}
@@ -54,13 +54,14 @@ const (
 	HumanIsPhoneVerifiedCol = "is_phone_verified"
 
 	// machine
-	UserMachineSuffix         = "machines"
-	MachineUserIDCol          = "user_id"
-	MachineUserInstanceIDCol  = "instance_id"
-	MachineNameCol            = "name"
-	MachineDescriptionCol     = "description"
-	MachineSecretCol          = "secret"
-	MachineAccessTokenTypeCol = "access_token_type"
+	UserMachineSuffix               = "machines"
+	MachineUserIDCol                = "user_id"
+	MachineUserInstanceIDCol        = "instance_id"
+	MachineNameCol                  = "name"
+	MachineDescriptionCol           = "description"
+	MachineSecretCol                = "secret"
+	MachineAccessTokenTypeCol       = "access_token_type"
+	MachineDPoPBoundAccessTokensCol = "dpop_bound_access_tokens"
 
 	// notify
 	UserNotifySuffix            = "notifications"
@@ -128,6 +129,7 @@ func (*userProjection) Init() *old_handler.Check {
 			handler.NewColumn(MachineDescriptionCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(MachineSecretCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(MachineAccessTokenTypeCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(MachineDPoPBoundAccessTokensCol, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(MachineUserInstanceIDCol, MachineUserIDCol),
 			UserMachineSuffix,
@@ -1057,6 +1059,7 @@ func (p *userProjection) reduceMachineAdded(event eventstore.Event) (*handler.St
 				handler.NewCol(MachineNameCol, e.Name),
 				handler.NewCol(MachineDescriptionCol, &sql.NullString{String: e.Description, Valid: e.Description != ""}),
 				handler.NewCol(MachineAccessTokenTypeCol, e.AccessTokenType),
+				handler.NewCol(MachineDPoPBoundAccessTokensCol, e.DPoPBoundAccessTokens),
 			},
 			handler.WithTableSuffix(UserMachineSuffix),
 		),
@@ -1079,6 +1082,9 @@ func (p *userProjection) reduceMachineChanged(event eventstore.Event) (*handler.
 	if e.AccessTokenType != nil {
 		cols = append(cols, handler.NewCol(MachineAccessTokenTypeCol, e.AccessTokenType))
 	}
+	if e.DPoPBoundAccessTokens != nil {
+		cols = append(cols, handler.NewCol(MachineDPoPBoundAccessTokensCol, *e.DPoPBoundAccessTokens))
+	}
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
 	}
@@ -1504,13 +1504,14 @@ func TestUserProjection_reduces(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.users12_machines (user_id, instance_id, name, description, access_token_type) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.users12_machines (user_id, instance_id, name, description, access_token_type, dpop_bound_access_tokens) VALUES ($1, $2, $3, $4, $5, $6)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
 								"machine-name",
 								&sql.NullString{},
 								domain.OIDCTokenTypeBearer,
+								false,
 							},
 						},
 					},
@@ -1527,7 +1528,8 @@ func TestUserProjection_reduces(t *testing.T) {
 						[]byte(`{
 						"username": "username",
 						"name": "machine-name",
-						"description": "description"
+						"description": "description",
+						"dpopBoundAccessTokens": true
 					}`),
 					), user.MachineAddedEventMapper),
 			},
@@ -1552,13 +1554,14 @@ func TestUserProjection_reduces(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.users12_machines (user_id, instance_id, name, description, access_token_type) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.users12_machines (user_id, instance_id, name, description, access_token_type, dpop_bound_access_tokens) VALUES ($1, $2, $3, $4, $5, $6)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
 								"machine-name",
 								&sql.NullString{String: "description", Valid: true},
 								domain.OIDCTokenTypeBearer,
+								true,
 							},
 						},
 					},
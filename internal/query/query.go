@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -60,7 +62,7 @@ func StartQueries(
 	repo = &Queries{
 		eventstore:                          es,
 		eventStoreV4:                        esV4,
-		client:                              querySqlClient,
+		client:                              querySqlClient.WithBeforeRead(awaitProjections),
 		DefaultLanguage:                     language.Und,
 		LoginTranslationFileContents:        make(map[string][]byte),
 		NotificationTranslationFileContents: make(map[string][]byte),
@@ -114,6 +116,16 @@ func init() {
 	)
 }
 
+// triggerOpts returns the options to trigger the projections of a query.
+// Projections which already reached the position required by the request aren't triggered.
+func triggerOpts(ctx context.Context) []handler.TriggerOpt {
+	position, _ := database.RequiredPosition(ctx)
+	return []handler.TriggerOpt{
+		handler.WithAwaitRunning(),
+		handler.WithMinPosition(position),
+	}
+}
+
 // triggerBatch calls Trigger on every handler in a separate Go routine.
 // The returned context is the context returned by the Trigger that finishes last.
 func triggerBatch(ctx context.Context, handlers ...*handler.Handler) {
@@ -124,7 +136,7 @@ func triggerBatch(ctx context.Context, handlers ...*handler.Handler) {
 		go func(ctx context.Context, h *handler.Handler) {
 			name := h.ProjectionName()
 			_, traceSpan := tracing.NewNamedSpan(ctx, fmt.Sprintf("Trigger%s", name))
-			_, err := h.Trigger(ctx, handler.WithAwaitRunning())
+			_, err := h.Trigger(ctx, triggerOpts(ctx)...)
 			logging.OnError(err).WithField("projection", name).Debug("trigger failed")
 			traceSpan.EndWithError(err)
 
@@ -134,3 +146,67 @@ func triggerBatch(ctx context.Context, handlers ...*handler.Handler) {
 
 	wg.Wait()
 }
+
+var projectionTablePattern = regexp.MustCompile(`projections\.([a-z0-9_]+)`)
+
+// statementProjections caches the projections read by a statement
+var statementProjections sync.Map
+
+// awaitProjections is called before every read of the [Queries].
+// If the request requires a minimum position by the min position header,
+// the projections read by the statement are triggered until they reached the position.
+// This applies to all queries, including the searches, which don't trigger their projections otherwise.
+func awaitProjections(ctx context.Context, stmt string) {
+	if position, _ := database.RequiredPosition(ctx); position == 0 {
+		return
+	}
+	triggerBatch(ctx, projectionsOfStatement(stmt)...)
+}
+
+// projectionsOfStatement returns the handlers of the projections read by the statement.
+func projectionsOfStatement(stmt string) []*handler.Handler {
+	if cached, ok := statementProjections.Load(stmt); ok {
+		return cached.([]*handler.Handler)
+	}
+	byName := make(map[string]*handler.Handler, len(projection.Projections()))
+	for _, p := range projection.Projections() {
+		if h, ok := p.(*handler.Handler); ok {
+			byName[h.ProjectionName()] = h
+		}
+	}
+	names := projectionNames(stmt, func(name string) bool {
+		_, ok := byName[name]
+		return ok
+	})
+	handlers := make([]*handler.Handler, len(names))
+	for i, name := range names {
+		handlers[i] = byName[name]
+	}
+	// the projections are created once on start, so the result of a statement never changes afterwards
+	if len(byName) > 0 {
+		statementProjections.Store(stmt, handlers)
+	}
+	return handlers
+}
+
+// projectionNames returns the names of the projections whose tables are referenced by the statement.
+// The tables of a projection are either named like the projection or suffixed, e.g. projections.users14_humans.
+func projectionNames(stmt string, isProjection func(name string) bool) []string {
+	names := make([]string, 0, 2)
+	for _, match := range projectionTablePattern.FindAllStringSubmatch(stmt, -1) {
+		for table := match[1]; table != ""; {
+			if name := "projections." + table; isProjection(name) {
+				if !slices.Contains(names, name) {
+					names = append(names, name)
+				}
+				break
+			}
+			suffix := strings.LastIndex(table, "_")
+			if suffix < 0 {
+				break
+			}
+			table = table[:suffix]
+		}
+	}
+	return names
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"database/sql"
+	"slices"
 	"testing"
 	"time"
 
@@ -68,3 +69,45 @@ from table;`
 	cleanStaticQueries(&query)
 	assert.Equal(t, want, query)
 }
+
+func Test_projectionNames(t *testing.T) {
+	projections := []string{
+		"projections.users14",
+		"projections.login_names3",
+		"projections.idp_templates6",
+	}
+	isProjection := func(name string) bool {
+		return slices.Contains(projections, name)
+	}
+	tests := []struct {
+		name string
+		stmt string
+		want []string
+	}{
+		{
+			name: "no projection",
+			stmt: "SELECT position FROM eventstore.events2",
+			want: []string{},
+		},
+		{
+			name: "unknown table",
+			stmt: "SELECT position FROM projections.current_states",
+			want: []string{},
+		},
+		{
+			name: "suffixed tables of a single projection",
+			stmt: "SELECT projections.users14.id, projections.users14_humans.email FROM projections.users14 LEFT JOIN projections.users14_humans ON projections.users14.id = projections.users14_humans.user_id",
+			want: []string{"projections.users14"},
+		},
+		{
+			name: "multiple projections",
+			stmt: "SELECT projections.login_names3.login_name, projections.idp_templates6_ldap2.servers FROM projections.login_names3_users JOIN projections.idp_templates6_ldap2 ON true",
+			want: []string{"projections.login_names3", "projections.idp_templates6"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, projectionNames(tt.stmt, isProjection))
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SAMLSession represents a SAML service provider which received a SAML response for the user
+type SAMLSession struct {
+	ID                string
+	ResourceOwner     string
+	CreationDate      time.Time
+	UserID            string
+	UserResourceOwner string
+	SessionID         string
+	AppID             string
+	EntityID          string
+	Issuer            string
+	NameID            string
+	UserAgentID       string
+	State             domain.SAMLSessionState
+	LogoutRequestID   string
+	LogoutRelayState  string
+	LogoutBinding     string
+	LogoutResponseURL string
+}
+
+func (s *SAMLSession) reduce(event eventstore.Event) {
+	switch e := event.(type) {
+	case *samlsession.AddedEvent:
+		s.ID = e.Aggregate().ID
+		s.ResourceOwner = e.Aggregate().ResourceOwner
+		s.CreationDate = e.CreatedAt()
+		s.UserID = e.UserID
+		s.UserResourceOwner = e.UserResourceOwner
+		s.SessionID = e.SessionID
+		s.AppID = e.AppID
+		s.EntityID = e.EntityID
+		s.Issuer = e.Issuer
+		s.NameID = e.NameID
+		s.UserAgentID = e.UserAgent.GetFingerprintID()
+		s.State = domain.SAMLSessionStateActive
+	case *samlsession.LogoutRequestedEvent:
+		s.LogoutRequestID = e.RequestID
+		s.LogoutRelayState = e.RelayState
+		s.LogoutBinding = e.Binding
+		s.LogoutResponseURL = e.ResponseURL
+		s.State = domain.SAMLSessionStateLogoutRequested
+	case *samlsession.LogoutSentEvent:
+		s.LogoutRequestID = e.RequestID
+		s.LogoutBinding = e.Binding
+		s.State = domain.SAMLSessionStateLogoutPending
+	case *samlsession.TerminatedEvent:
+		s.State = domain.SAMLSessionStateTerminated
+	}
+}
+
+// ActiveSAMLSessionsByUserAgent returns the SAML sessions of the user agent, which were not logged out yet.
+// If a userID is provided, only the sessions of the user are returned.
+func (q *Queries) ActiveSAMLSessionsByUserAgent(ctx context.Context, userAgentID, userID string) (sessions []*SAMLSession, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	data := map[string]interface{}{
+		"userAgent": map[string]interface{}{
+			"fingerprint_id": userAgentID,
+		},
+	}
+	if userID != "" {
+		data["userID"] = userID
+	}
+	sessions, err = q.samlSessions(ctx, samlsession.AddedType, data)
+	if err != nil {
+		return nil, err
+	}
+	active := make([]*SAMLSession, 0, len(sessions))
+	for _, session := range sessions {
+		if session.State == domain.SAMLSessionStateActive {
+			active = append(active, session)
+		}
+	}
+	return active, nil
+}
+
+// SAMLSessionsByUserAgent returns all SAML sessions of the user agent regardless of their state.
+func (q *Queries) SAMLSessionsByUserAgent(ctx context.Context, userAgentID string) (sessions []*SAMLSession, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return q.samlSessions(ctx, samlsession.AddedType, map[string]interface{}{
+		"userAgent": map[string]interface{}{
+			"fingerprint_id": userAgentID,
+		},
+	})
+}
+
+// SAMLSessionByLogoutRequestID returns the SAML session, to which the logout request with the provided id was sent.
+func (q *Queries) SAMLSessionByLogoutRequestID(ctx context.Context, requestID string) (session *SAMLSession, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	sessions, err := q.samlSessions(ctx, samlsession.LogoutSentType, map[string]interface{}{
+		"requestID": requestID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) != 1 {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Quai3", "Errors.SAMLSession.NotFound")
+	}
+	return sessions[0], nil
+}
+
+// SAMLSessionByID returns the SAML session by its id.
+func (q *Queries) SAMLSessionByID(ctx context.Context, id string) (session *SAMLSession, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	sessions, err := q.samlSessionsByIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) != 1 {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-eeKo4", "Errors.SAMLSession.NotFound")
+	}
+	return sessions[0], nil
+}
+
+// samlSessions returns the SAML sessions having an event of the provided type matching the data
+func (q *Queries) samlSessions(ctx context.Context, eventType eventstore.EventType, data map[string]interface{}) ([]*SAMLSession, error) {
+	events, err := q.eventstore.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(samlsession.AggregateType).
+		EventTypes(eventType).
+		EventData(data).
+		Builder(),
+	)
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.Aggregate().ID)
+	}
+	return q.samlSessionsByIDs(ctx, ids...)
+}
+
+func (q *Queries) samlSessionsByIDs(ctx context.Context, ids ...string) ([]*SAMLSession, error) {
+	events, err := q.eventstore.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(samlsession.AggregateType).
+		AggregateIDs(ids...).
+		EventTypes(
+			samlsession.AddedType,
+			samlsession.LogoutRequestedType,
+			samlsession.LogoutSentType,
+			samlsession.TerminatedType,
+		).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make(map[string]int, len(ids))
+	sessions := make([]*SAMLSession, 0, len(ids))
+	for _, event := range events {
+		i, ok := indexes[event.Aggregate().ID]
+		if !ok {
+			i = len(sessions)
+			indexes[event.Aggregate().ID] = i
+			sessions = append(sessions, new(SAMLSession))
+		}
+		sessions[i].reduce(event)
+	}
+	return sessions, nil
+}
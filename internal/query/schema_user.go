@@ -0,0 +1,361 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type SchemaUsers struct {
+	SearchResponse
+	Users []*SchemaUser
+}
+
+func (u *SchemaUsers) SetState(s *State) {
+	u.State = s
+}
+
+// RemoveNoPermission removes all users the caller is not allowed to read.
+// Users are always allowed to read themselves.
+func (u *SchemaUsers) RemoveNoPermission(ctx context.Context, permissionCheck domain.PermissionCheck) {
+	ctxData := authz.GetCtxData(ctx)
+	users := make([]*SchemaUser, 0, len(u.Users))
+	for _, user := range u.Users {
+		if ctxData.UserID == user.ID || permissionCheck(ctx, domain.PermissionUserRead, user.ResourceOwner, user.ID) == nil {
+			users = append(users, user)
+		}
+	}
+	u.Users = users
+	// reset count as some users could be removed
+	u.SearchResponse.Count = uint64(len(u.Users))
+}
+
+type SchemaUser struct {
+	ID string
+	domain.ObjectDetails
+	CreationDate    time.Time
+	State           domain.UserState
+	SchemaID        string
+	SchemaType      string
+	SchemaRevision  uint32
+	Data            json.RawMessage
+	Email           string
+	IsEmailVerified bool
+	Phone           string
+	IsPhoneVerified bool
+}
+
+type SchemaUserSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	schemaUserTable = table{
+		name:          projection.SchemaUserTable,
+		instanceIDCol: projection.SchemaUserInstanceIDCol,
+	}
+	SchemaUserIDCol = Column{
+		name:  projection.SchemaUserIDCol,
+		table: schemaUserTable,
+	}
+	SchemaUserCreationDateCol = Column{
+		name:  projection.SchemaUserCreationDateCol,
+		table: schemaUserTable,
+	}
+	SchemaUserChangeDateCol = Column{
+		name:  projection.SchemaUserChangeDateCol,
+		table: schemaUserTable,
+	}
+	SchemaUserSequenceCol = Column{
+		name:  projection.SchemaUserSequenceCol,
+		table: schemaUserTable,
+	}
+	SchemaUserStateCol = Column{
+		name:  projection.SchemaUserStateCol,
+		table: schemaUserTable,
+	}
+	SchemaUserResourceOwnerCol = Column{
+		name:  projection.SchemaUserResourceOwnerCol,
+		table: schemaUserTable,
+	}
+	SchemaUserInstanceIDCol = Column{
+		name:  projection.SchemaUserInstanceIDCol,
+		table: schemaUserTable,
+	}
+	SchemaUserSchemaIDCol = Column{
+		name:  projection.SchemaUserSchemaIDCol,
+		table: schemaUserTable,
+	}
+	SchemaUserSchemaRevisionCol = Column{
+		name:  projection.SchemaUserSchemaRevisionCol,
+		table: schemaUserTable,
+	}
+	SchemaUserDataCol = Column{
+		name:  projection.SchemaUserDataCol,
+		table: schemaUserTable,
+	}
+	SchemaUserEmailCol = Column{
+		name:  projection.SchemaUserEmailCol,
+		table: schemaUserTable,
+	}
+	SchemaUserEmailVerifiedCol = Column{
+		name:  projection.SchemaUserEmailVerifiedCol,
+		table: schemaUserTable,
+	}
+	SchemaUserPhoneCol = Column{
+		name:  projection.SchemaUserPhoneCol,
+		table: schemaUserTable,
+	}
+	SchemaUserPhoneVerifiedCol = Column{
+		name:  projection.SchemaUserPhoneVerifiedCol,
+		table: schemaUserTable,
+	}
+)
+
+// GetSchemaUserByID returns the user based on a user schema.
+// Users are always allowed to read themselves, all others need the user.read permission.
+func (q *Queries) GetSchemaUserByID(ctx context.Context, id string) (user *SchemaUser, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		SchemaUserIDCol.identifier():         id,
+		SchemaUserInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+
+	query, scan := prepareSchemaUserQuery()
+	user, err = genericRowQuery[*SchemaUser](ctx, q.client, query.Where(eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	if authz.GetCtxData(ctx).UserID != user.ID {
+		if err := q.checkPermission(ctx, domain.PermissionUserRead, user.ResourceOwner, user.ID); err != nil {
+			return nil, err
+		}
+	}
+	return user, nil
+}
+
+// GetNotifySchemaUserByID returns the contact information of a user based on a user schema,
+// which is used to send notifications to the user. No permission is checked.
+func (q *Queries) GetNotifySchemaUserByID(ctx context.Context, shouldTriggerBulk bool, id string) (_ *NotifyUser, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		triggerBatch(ctx, projection.SchemaUserProjection)
+	}
+
+	eq := sq.Eq{
+		SchemaUserIDCol.identifier():         id,
+		SchemaUserInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareSchemaUserQuery()
+	user, err := genericRowQuery[*SchemaUser](ctx, q.client, query.Where(eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	return schemaUserToNotifyUser(user), nil
+}
+
+func schemaUserToNotifyUser(user *SchemaUser) *NotifyUser {
+	notifyUser := &NotifyUser{
+		ID:            user.ID,
+		CreationDate:  user.CreationDate,
+		ChangeDate:    user.EventDate,
+		ResourceOwner: user.ResourceOwner,
+		Sequence:      user.Sequence,
+		State:         user.State,
+		Type:          domain.UserTypeHuman,
+		LastEmail:     user.Email,
+		LastPhone:     user.Phone,
+	}
+	if user.IsEmailVerified {
+		notifyUser.VerifiedEmail = user.Email
+	}
+	if user.IsPhoneVerified {
+		notifyUser.VerifiedPhone = user.Phone
+	}
+	return notifyUser
+}
+
+// SearchSchemaUsers returns the users based on user schemas the caller is allowed to read.
+func (q *Queries) SearchSchemaUsers(ctx context.Context, queries *SchemaUserSearchQueries) (users *SchemaUsers, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		SchemaUserInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+
+	query, scan := prepareSchemaUsersQuery()
+	users, err = genericRowsQueryWithState[*SchemaUsers](ctx, q.client, schemaUserTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	users.RemoveNoPermission(ctx, q.checkPermission)
+	return users, nil
+}
+
+func (q *SchemaUserSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewSchemaUserIDSearchQuery(value string, comparison TextComparison) (SearchQuery, error) {
+	return NewTextQuery(SchemaUserIDCol, value, comparison)
+}
+
+func NewSchemaUserResourceOwnerSearchQuery(value string, comparison TextComparison) (SearchQuery, error) {
+	return NewTextQuery(SchemaUserResourceOwnerCol, value, comparison)
+}
+
+func NewSchemaUserEmailSearchQuery(value string, comparison TextComparison) (SearchQuery, error) {
+	return NewTextQuery(SchemaUserEmailCol, value, comparison)
+}
+
+func NewSchemaUserPhoneSearchQuery(value string, comparison TextComparison) (SearchQuery, error) {
+	return NewTextQuery(SchemaUserPhoneCol, value, comparison)
+}
+
+func NewSchemaUserStateSearchQuery(value domain.UserState) (SearchQuery, error) {
+	return NewNumberQuery(SchemaUserStateCol, value, NumberEquals)
+}
+
+func NewSchemaUserSchemaIDSearchQuery(value string, comparison TextComparison) (SearchQuery, error) {
+	return NewTextQuery(SchemaUserSchemaIDCol, value, comparison)
+}
+
+func NewSchemaUserSchemaTypeSearchQuery(value string, comparison TextComparison) (SearchQuery, error) {
+	return NewTextQuery(UserSchemaTypeCol, value, comparison)
+}
+
+func NewSchemaUserOrSearchQuery(values []SearchQuery) (SearchQuery, error) {
+	return NewOrQuery(values...)
+}
+
+func NewSchemaUserAndSearchQuery(values []SearchQuery) (SearchQuery, error) {
+	return NewAndQuery(values...)
+}
+
+func NewSchemaUserNotSearchQuery(value SearchQuery) (SearchQuery, error) {
+	return NewNotQuery(value)
+}
+
+func schemaUserColumns() []string {
+	return []string{
+		SchemaUserIDCol.identifier(),
+		SchemaUserCreationDateCol.identifier(),
+		SchemaUserChangeDateCol.identifier(),
+		SchemaUserSequenceCol.identifier(),
+		SchemaUserResourceOwnerCol.identifier(),
+		SchemaUserStateCol.identifier(),
+		SchemaUserSchemaIDCol.identifier(),
+		UserSchemaTypeCol.identifier(),
+		SchemaUserSchemaRevisionCol.identifier(),
+		SchemaUserDataCol.identifier(),
+		SchemaUserEmailCol.identifier(),
+		SchemaUserEmailVerifiedCol.identifier(),
+		SchemaUserPhoneCol.identifier(),
+		SchemaUserPhoneVerifiedCol.identifier(),
+	}
+}
+
+type schemaUserScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSchemaUser(scanner schemaUserScanner, dest ...any) (*SchemaUser, error) {
+	u := new(SchemaUser)
+	var (
+		schemaType sql.NullString
+		data       database.ByteArray[byte]
+		email      sql.NullString
+		phone      sql.NullString
+	)
+	err := scanner.Scan(append([]any{
+		&u.ID,
+		&u.CreationDate,
+		&u.EventDate,
+		&u.Sequence,
+		&u.ResourceOwner,
+		&u.State,
+		&u.SchemaID,
+		&schemaType,
+		&u.SchemaRevision,
+		&data,
+		&email,
+		&u.IsEmailVerified,
+		&phone,
+		&u.IsPhoneVerified,
+	}, dest...)...)
+	if err != nil {
+		return nil, err
+	}
+	u.SchemaType = schemaType.String
+	u.Data = json.RawMessage(data)
+	u.Email = email.String
+	u.Phone = phone.String
+	return u, nil
+}
+
+func prepareSchemaUserQuery() (sq.SelectBuilder, func(*sql.Row) (*SchemaUser, error)) {
+	return sq.Select(schemaUserColumns()...).
+			From(schemaUserTable.identifier()).
+			LeftJoin(join(UserSchemaIDCol, SchemaUserSchemaIDCol)).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*SchemaUser, error) {
+			u, err := scanSchemaUser(row)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-ysoV5mYGvB", "Errors.User.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-kGn3bzT6VR", "Errors.Internal")
+			}
+			return u, nil
+		}
+}
+
+func prepareSchemaUsersQuery() (sq.SelectBuilder, func(*sql.Rows) (*SchemaUsers, error)) {
+	return sq.Select(append(schemaUserColumns(), countColumn.identifier())...).
+			From(schemaUserTable.identifier()).
+			LeftJoin(join(UserSchemaIDCol, SchemaUserSchemaIDCol)).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*SchemaUsers, error) {
+			users := make([]*SchemaUser, 0)
+			var count uint64
+			for rows.Next() {
+				u, err := scanSchemaUser(rows, &count)
+				if err != nil {
+					return nil, err
+				}
+				users = append(users, u)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-4A6vM0J2N9", "Errors.Query.CloseRows")
+			}
+
+			return &SchemaUsers{
+				Users: users,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,275 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareSchemaUserStmt = `SELECT projections.schema_users.id,` +
+		` projections.schema_users.creation_date,` +
+		` projections.schema_users.change_date,` +
+		` projections.schema_users.sequence,` +
+		` projections.schema_users.resource_owner,` +
+		` projections.schema_users.state,` +
+		` projections.schema_users.schema_id,` +
+		` projections.user_schemas.type,` +
+		` projections.schema_users.schema_revision,` +
+		` projections.schema_users.data,` +
+		` projections.schema_users.email,` +
+		` projections.schema_users.email_verified,` +
+		` projections.schema_users.phone,` +
+		` projections.schema_users.phone_verified` +
+		` FROM projections.schema_users` +
+		` LEFT JOIN projections.user_schemas ON projections.schema_users.schema_id = projections.user_schemas.id AND projections.schema_users.instance_id = projections.user_schemas.instance_id`
+	prepareSchemaUserCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"state",
+		"schema_id",
+		"type",
+		"schema_revision",
+		"data",
+		"email",
+		"email_verified",
+		"phone",
+		"phone_verified",
+	}
+
+	prepareSchemaUsersStmt = `SELECT projections.schema_users.id,` +
+		` projections.schema_users.creation_date,` +
+		` projections.schema_users.change_date,` +
+		` projections.schema_users.sequence,` +
+		` projections.schema_users.resource_owner,` +
+		` projections.schema_users.state,` +
+		` projections.schema_users.schema_id,` +
+		` projections.user_schemas.type,` +
+		` projections.schema_users.schema_revision,` +
+		` projections.schema_users.data,` +
+		` projections.schema_users.email,` +
+		` projections.schema_users.email_verified,` +
+		` projections.schema_users.phone,` +
+		` projections.schema_users.phone_verified,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.schema_users` +
+		` LEFT JOIN projections.user_schemas ON projections.schema_users.schema_id = projections.user_schemas.id AND projections.schema_users.instance_id = projections.user_schemas.instance_id`
+	prepareSchemaUsersCols = append(prepareSchemaUserCols, "count")
+)
+
+func Test_SchemaUserPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareSchemaUsersQuery no result",
+			prepare: prepareSchemaUsersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareSchemaUsersStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &SchemaUsers{Users: []*SchemaUser{}},
+		},
+		{
+			name:    "prepareSchemaUsersQuery multiple result",
+			prepare: prepareSchemaUsersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareSchemaUsersStmt),
+					prepareSchemaUsersCols,
+					[][]driver.Value{
+						{
+							"id-1",
+							testNow,
+							testNow,
+							uint64(20211109),
+							"ro",
+							domain.UserStateActive,
+							"schema",
+							"type",
+							1,
+							[]byte(`{"name":"user"}`),
+							"test@example.com",
+							true,
+							nil,
+							false,
+						},
+						{
+							"id-2",
+							testNow,
+							testNow,
+							uint64(20211110),
+							"ro",
+							domain.UserStateLocked,
+							"schema",
+							nil,
+							2,
+							nil,
+							nil,
+							false,
+							"+41791234567",
+							false,
+						},
+					},
+				),
+			},
+			object: &SchemaUsers{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Users: []*SchemaUser{
+					{
+						ID: "id-1",
+						ObjectDetails: domain.ObjectDetails{
+							EventDate:     testNow,
+							Sequence:      20211109,
+							ResourceOwner: "ro",
+						},
+						CreationDate:    testNow,
+						State:           domain.UserStateActive,
+						SchemaID:        "schema",
+						SchemaType:      "type",
+						SchemaRevision:  1,
+						Data:            json.RawMessage(`{"name":"user"}`),
+						Email:           "test@example.com",
+						IsEmailVerified: true,
+					},
+					{
+						ID: "id-2",
+						ObjectDetails: domain.ObjectDetails{
+							EventDate:     testNow,
+							Sequence:      20211110,
+							ResourceOwner: "ro",
+						},
+						CreationDate:   testNow,
+						State:          domain.UserStateLocked,
+						SchemaID:       "schema",
+						SchemaRevision: 2,
+						Data:           json.RawMessage{},
+						Phone:          "+41791234567",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareSchemaUsersQuery sql err",
+			prepare: prepareSchemaUsersQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareSchemaUsersStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*SchemaUsers)(nil),
+		},
+		{
+			name:    "prepareSchemaUserQuery no result",
+			prepare: prepareSchemaUserQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareSchemaUserStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*SchemaUser)(nil),
+		},
+		{
+			name:    "prepareSchemaUserQuery found",
+			prepare: prepareSchemaUserQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareSchemaUserStmt),
+					prepareSchemaUserCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						uint64(20211109),
+						"ro",
+						domain.UserStateActive,
+						"schema",
+						"type",
+						1,
+						[]byte(`{"name":"user"}`),
+						"test@example.com",
+						false,
+						"+41791234567",
+						true,
+					},
+				),
+			},
+			object: &SchemaUser{
+				ID: "id",
+				ObjectDetails: domain.ObjectDetails{
+					EventDate:     testNow,
+					Sequence:      20211109,
+					ResourceOwner: "ro",
+				},
+				CreationDate:    testNow,
+				State:           domain.UserStateActive,
+				SchemaID:        "schema",
+				SchemaType:      "type",
+				SchemaRevision:  1,
+				Data:            json.RawMessage(`{"name":"user"}`),
+				Email:           "test@example.com",
+				Phone:           "+41791234567",
+				IsPhoneVerified: true,
+			},
+		},
+		{
+			name:    "prepareSchemaUserQuery sql err",
+			prepare: prepareSchemaUserQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareSchemaUserStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*SchemaUser)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
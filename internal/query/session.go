@@ -15,7 +15,6 @@ import (
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
-	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -201,7 +200,7 @@ func (q *Queries) SessionByID(ctx context.Context, shouldTriggerBulk bool, id, s
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerSessionProjection")
-		ctx, err = projection.SessionProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.SessionProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("unable to trigger")
 		traceSpan.EndWithError(err)
 	}
@@ -30,8 +30,12 @@ type SMSConfig struct {
 	ResourceOwner string
 	State         domain.SMSConfigState
 	Sequence      uint64
+	Priority      uint32
 
-	TwilioConfig *Twilio
+	TwilioConfig      *Twilio
+	HTTPConfig        *HTTPSMS
+	VonageConfig      *Vonage
+	MessageBirdConfig *MessageBird
 }
 
 type Twilio struct {
@@ -40,6 +44,26 @@ type Twilio struct {
 	SenderNumber string
 }
 
+type HTTPSMS struct {
+	Endpoint        string
+	Method          string
+	BodyTemplate    string
+	AuthHeaderName  string
+	AuthHeaderValue *crypto.CryptoValue
+	SenderNumber    string
+}
+
+type Vonage struct {
+	APIKey       string
+	APISecret    *crypto.CryptoValue
+	SenderNumber string
+}
+
+type MessageBird struct {
+	AccessKey  *crypto.CryptoValue
+	Originator string
+}
+
 type SMSConfigsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -90,6 +114,10 @@ var (
 		name:  projection.SMSColumnSequence,
 		table: smsConfigsTable,
 	}
+	SMSConfigColumnPriority = Column{
+		name:  projection.SMSColumnPriority,
+		table: smsConfigsTable,
+	}
 )
 
 var (
@@ -115,6 +143,83 @@ var (
 	}
 )
 
+var (
+	smsHTTPConfigsTable = table{
+		name:          projection.SMSHTTPTable,
+		instanceIDCol: projection.SMSHTTPColumnInstanceID,
+	}
+	SMSHTTPConfigColumnSMSID = Column{
+		name:  projection.SMSHTTPConfigColumnSMSID,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnEndpoint = Column{
+		name:  projection.SMSHTTPConfigColumnEndpoint,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnMethod = Column{
+		name:  projection.SMSHTTPConfigColumnMethod,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnBodyTemplate = Column{
+		name:  projection.SMSHTTPConfigColumnBodyTemplate,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnAuthHeaderName = Column{
+		name:  projection.SMSHTTPConfigColumnAuthHeaderName,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnAuthHeaderValue = Column{
+		name:  projection.SMSHTTPConfigColumnAuthHeaderValue,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnSenderNumber = Column{
+		name:  projection.SMSHTTPConfigColumnSenderNumber,
+		table: smsHTTPConfigsTable,
+	}
+)
+
+var (
+	smsVonageConfigsTable = table{
+		name:          projection.SMSVonageTable,
+		instanceIDCol: projection.SMSVonageColumnInstanceID,
+	}
+	SMSVonageConfigColumnSMSID = Column{
+		name:  projection.SMSVonageConfigColumnSMSID,
+		table: smsVonageConfigsTable,
+	}
+	SMSVonageConfigColumnAPIKey = Column{
+		name:  projection.SMSVonageConfigColumnAPIKey,
+		table: smsVonageConfigsTable,
+	}
+	SMSVonageConfigColumnAPISecret = Column{
+		name:  projection.SMSVonageConfigColumnAPISecret,
+		table: smsVonageConfigsTable,
+	}
+	SMSVonageConfigColumnSenderNumber = Column{
+		name:  projection.SMSVonageConfigColumnSenderNumber,
+		table: smsVonageConfigsTable,
+	}
+)
+
+var (
+	smsMessageBirdConfigsTable = table{
+		name:          projection.SMSMessageBirdTable,
+		instanceIDCol: projection.SMSMessageBirdColumnInstanceID,
+	}
+	SMSMessageBirdConfigColumnSMSID = Column{
+		name:  projection.SMSMessageBirdConfigColumnSMSID,
+		table: smsMessageBirdConfigsTable,
+	}
+	SMSMessageBirdConfigColumnAccessKey = Column{
+		name:  projection.SMSMessageBirdConfigColumnAccessKey,
+		table: smsMessageBirdConfigsTable,
+	}
+	SMSMessageBirdConfigColumnOriginator = Column{
+		name:  projection.SMSMessageBirdConfigColumnOriginator,
+		table: smsMessageBirdConfigsTable,
+	}
+)
+
 func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (config *SMSConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -198,18 +303,42 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			SMSConfigColumnResourceOwner.identifier(),
 			SMSConfigColumnState.identifier(),
 			SMSConfigColumnSequence.identifier(),
+			SMSConfigColumnPriority.identifier(),
 
 			SMSTwilioConfigColumnSMSID.identifier(),
 			SMSTwilioConfigColumnSID.identifier(),
 			SMSTwilioConfigColumnToken.identifier(),
 			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnMethod.identifier(),
+			SMSHTTPConfigColumnBodyTemplate.identifier(),
+			SMSHTTPConfigColumnAuthHeaderName.identifier(),
+			SMSHTTPConfigColumnAuthHeaderValue.identifier(),
+			SMSHTTPConfigColumnSenderNumber.identifier(),
+
+			SMSVonageConfigColumnSMSID.identifier(),
+			SMSVonageConfigColumnAPIKey.identifier(),
+			SMSVonageConfigColumnAPISecret.identifier(),
+			SMSVonageConfigColumnSenderNumber.identifier(),
+
+			SMSMessageBirdConfigColumnSMSID.identifier(),
+			SMSMessageBirdConfigColumnAccessKey.identifier(),
+			SMSMessageBirdConfigColumnOriginator.identifier(),
 		).From(smsConfigsTable.identifier()).
-			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSVonageConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSMessageBirdConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*SMSConfig, error) {
 			config := new(SMSConfig)
 
 			var (
-				twilioConfig = sqlTwilioConfig{}
+				twilioConfig      = sqlTwilioConfig{}
+				httpConfig        = sqlHTTPSMSConfig{}
+				vonageConfig      = sqlVonageConfig{}
+				messageBirdConfig = sqlMessageBirdConfig{}
 			)
 
 			err := row.Scan(
@@ -220,11 +349,29 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				&config.ResourceOwner,
 				&config.State,
 				&config.Sequence,
+				&config.Priority,
 
 				&twilioConfig.smsID,
 				&twilioConfig.sid,
 				&twilioConfig.token,
 				&twilioConfig.senderNumber,
+
+				&httpConfig.smsID,
+				&httpConfig.endpoint,
+				&httpConfig.method,
+				&httpConfig.bodyTemplate,
+				&httpConfig.authHeaderName,
+				&httpConfig.authHeaderValue,
+				&httpConfig.senderNumber,
+
+				&vonageConfig.smsID,
+				&vonageConfig.apiKey,
+				&vonageConfig.apiSecret,
+				&vonageConfig.senderNumber,
+
+				&messageBirdConfig.smsID,
+				&messageBirdConfig.accessKey,
+				&messageBirdConfig.originator,
 			)
 
 			if err != nil {
@@ -235,6 +382,9 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			}
 
 			twilioConfig.set(config)
+			httpConfig.set(config)
+			vonageConfig.set(config)
+			messageBirdConfig.set(config)
 
 			return config, nil
 		}
@@ -249,21 +399,45 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 			SMSConfigColumnResourceOwner.identifier(),
 			SMSConfigColumnState.identifier(),
 			SMSConfigColumnSequence.identifier(),
+			SMSConfigColumnPriority.identifier(),
 
 			SMSTwilioConfigColumnSMSID.identifier(),
 			SMSTwilioConfigColumnSID.identifier(),
 			SMSTwilioConfigColumnToken.identifier(),
 			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnMethod.identifier(),
+			SMSHTTPConfigColumnBodyTemplate.identifier(),
+			SMSHTTPConfigColumnAuthHeaderName.identifier(),
+			SMSHTTPConfigColumnAuthHeaderValue.identifier(),
+			SMSHTTPConfigColumnSenderNumber.identifier(),
+
+			SMSVonageConfigColumnSMSID.identifier(),
+			SMSVonageConfigColumnAPIKey.identifier(),
+			SMSVonageConfigColumnAPISecret.identifier(),
+			SMSVonageConfigColumnSenderNumber.identifier(),
+
+			SMSMessageBirdConfigColumnSMSID.identifier(),
+			SMSMessageBirdConfigColumnAccessKey.identifier(),
+			SMSMessageBirdConfigColumnOriginator.identifier(),
 			countColumn.identifier(),
 		).From(smsConfigsTable.identifier()).
-			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSVonageConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSMessageBirdConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Rows) (*SMSConfigs, error) {
 			configs := &SMSConfigs{Configs: []*SMSConfig{}}
 
 			for row.Next() {
 				config := new(SMSConfig)
 				var (
-					twilioConfig = sqlTwilioConfig{}
+					twilioConfig      = sqlTwilioConfig{}
+					httpConfig        = sqlHTTPSMSConfig{}
+					vonageConfig      = sqlVonageConfig{}
+					messageBirdConfig = sqlMessageBirdConfig{}
 				)
 
 				err := row.Scan(
@@ -274,11 +448,29 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 					&config.ResourceOwner,
 					&config.State,
 					&config.Sequence,
+					&config.Priority,
 
 					&twilioConfig.smsID,
 					&twilioConfig.sid,
 					&twilioConfig.token,
 					&twilioConfig.senderNumber,
+
+					&httpConfig.smsID,
+					&httpConfig.endpoint,
+					&httpConfig.method,
+					&httpConfig.bodyTemplate,
+					&httpConfig.authHeaderName,
+					&httpConfig.authHeaderValue,
+					&httpConfig.senderNumber,
+
+					&vonageConfig.smsID,
+					&vonageConfig.apiKey,
+					&vonageConfig.apiSecret,
+					&vonageConfig.senderNumber,
+
+					&messageBirdConfig.smsID,
+					&messageBirdConfig.accessKey,
+					&messageBirdConfig.originator,
 					&configs.Count,
 				)
 
@@ -287,6 +479,9 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				}
 
 				twilioConfig.set(config)
+				httpConfig.set(config)
+				vonageConfig.set(config)
+				messageBirdConfig.set(config)
 
 				configs.Configs = append(configs.Configs, config)
 			}
@@ -312,3 +507,61 @@ func (c sqlTwilioConfig) set(smsConfig *SMSConfig) {
 		SenderNumber: c.senderNumber.String,
 	}
 }
+
+type sqlHTTPSMSConfig struct {
+	smsID           sql.NullString
+	endpoint        sql.NullString
+	method          sql.NullString
+	bodyTemplate    sql.NullString
+	authHeaderName  sql.NullString
+	authHeaderValue *crypto.CryptoValue
+	senderNumber    sql.NullString
+}
+
+func (c sqlHTTPSMSConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.HTTPConfig = &HTTPSMS{
+		Endpoint:        c.endpoint.String,
+		Method:          c.method.String,
+		BodyTemplate:    c.bodyTemplate.String,
+		AuthHeaderName:  c.authHeaderName.String,
+		AuthHeaderValue: c.authHeaderValue,
+		SenderNumber:    c.senderNumber.String,
+	}
+}
+
+type sqlVonageConfig struct {
+	smsID        sql.NullString
+	apiKey       sql.NullString
+	apiSecret    *crypto.CryptoValue
+	senderNumber sql.NullString
+}
+
+func (c sqlVonageConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.VonageConfig = &Vonage{
+		APIKey:       c.apiKey.String,
+		APISecret:    c.apiSecret,
+		SenderNumber: c.senderNumber.String,
+	}
+}
+
+type sqlMessageBirdConfig struct {
+	smsID      sql.NullString
+	accessKey  *crypto.CryptoValue
+	originator sql.NullString
+}
+
+func (c sqlMessageBirdConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.MessageBirdConfig = &MessageBird{
+		AccessKey:  c.accessKey,
+		Originator: c.originator.String,
+	}
+}
@@ -14,38 +14,86 @@ import (
 )
 
 var (
-	expectedSMSConfigQuery = regexp.QuoteMeta(`SELECT projections.sms_configs2.id,` +
-		` projections.sms_configs2.aggregate_id,` +
-		` projections.sms_configs2.creation_date,` +
-		` projections.sms_configs2.change_date,` +
-		` projections.sms_configs2.resource_owner,` +
-		` projections.sms_configs2.state,` +
-		` projections.sms_configs2.sequence,` +
+	expectedSMSConfigQuery = regexp.QuoteMeta(`SELECT projections.sms_configs3.id,` +
+		` projections.sms_configs3.aggregate_id,` +
+		` projections.sms_configs3.creation_date,` +
+		` projections.sms_configs3.change_date,` +
+		` projections.sms_configs3.resource_owner,` +
+		` projections.sms_configs3.state,` +
+		` projections.sms_configs3.sequence,` +
+		` projections.sms_configs3.priority,` +
 
 		// twilio config
-		` projections.sms_configs2_twilio.sms_id,` +
-		` projections.sms_configs2_twilio.sid,` +
-		` projections.sms_configs2_twilio.token,` +
-		` projections.sms_configs2_twilio.sender_number` +
-		` FROM projections.sms_configs2` +
-		` LEFT JOIN projections.sms_configs2_twilio ON projections.sms_configs2.id = projections.sms_configs2_twilio.sms_id AND projections.sms_configs2.instance_id = projections.sms_configs2_twilio.instance_id` +
+		` projections.sms_configs3_twilio.sms_id,` +
+		` projections.sms_configs3_twilio.sid,` +
+		` projections.sms_configs3_twilio.token,` +
+		` projections.sms_configs3_twilio.sender_number,` +
+
+		// http config
+		` projections.sms_configs3_http.sms_id,` +
+		` projections.sms_configs3_http.endpoint,` +
+		` projections.sms_configs3_http.method,` +
+		` projections.sms_configs3_http.body_template,` +
+		` projections.sms_configs3_http.auth_header_name,` +
+		` projections.sms_configs3_http.auth_header_value,` +
+		` projections.sms_configs3_http.sender_number,` +
+
+		// vonage config
+		` projections.sms_configs3_vonage.sms_id,` +
+		` projections.sms_configs3_vonage.api_key,` +
+		` projections.sms_configs3_vonage.api_secret,` +
+		` projections.sms_configs3_vonage.sender_number,` +
+
+		// messagebird config
+		` projections.sms_configs3_messagebird.sms_id,` +
+		` projections.sms_configs3_messagebird.access_key,` +
+		` projections.sms_configs3_messagebird.originator` +
+		` FROM projections.sms_configs3` +
+		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
+		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id` +
+		` LEFT JOIN projections.sms_configs3_vonage ON projections.sms_configs3.id = projections.sms_configs3_vonage.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_vonage.instance_id` +
+		` LEFT JOIN projections.sms_configs3_messagebird ON projections.sms_configs3.id = projections.sms_configs3_messagebird.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_messagebird.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs2.id,` +
-		` projections.sms_configs2.aggregate_id,` +
-		` projections.sms_configs2.creation_date,` +
-		` projections.sms_configs2.change_date,` +
-		` projections.sms_configs2.resource_owner,` +
-		` projections.sms_configs2.state,` +
-		` projections.sms_configs2.sequence,` +
+	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs3.id,` +
+		` projections.sms_configs3.aggregate_id,` +
+		` projections.sms_configs3.creation_date,` +
+		` projections.sms_configs3.change_date,` +
+		` projections.sms_configs3.resource_owner,` +
+		` projections.sms_configs3.state,` +
+		` projections.sms_configs3.sequence,` +
+		` projections.sms_configs3.priority,` +
 
 		// twilio config
-		` projections.sms_configs2_twilio.sms_id,` +
-		` projections.sms_configs2_twilio.sid,` +
-		` projections.sms_configs2_twilio.token,` +
-		` projections.sms_configs2_twilio.sender_number,` +
+		` projections.sms_configs3_twilio.sms_id,` +
+		` projections.sms_configs3_twilio.sid,` +
+		` projections.sms_configs3_twilio.token,` +
+		` projections.sms_configs3_twilio.sender_number,` +
+
+		// http config
+		` projections.sms_configs3_http.sms_id,` +
+		` projections.sms_configs3_http.endpoint,` +
+		` projections.sms_configs3_http.method,` +
+		` projections.sms_configs3_http.body_template,` +
+		` projections.sms_configs3_http.auth_header_name,` +
+		` projections.sms_configs3_http.auth_header_value,` +
+		` projections.sms_configs3_http.sender_number,` +
+
+		// vonage config
+		` projections.sms_configs3_vonage.sms_id,` +
+		` projections.sms_configs3_vonage.api_key,` +
+		` projections.sms_configs3_vonage.api_secret,` +
+		` projections.sms_configs3_vonage.sender_number,` +
+
+		// messagebird config
+		` projections.sms_configs3_messagebird.sms_id,` +
+		` projections.sms_configs3_messagebird.access_key,` +
+		` projections.sms_configs3_messagebird.originator,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.sms_configs2` +
-		` LEFT JOIN projections.sms_configs2_twilio ON projections.sms_configs2.id = projections.sms_configs2_twilio.sms_id AND projections.sms_configs2.instance_id = projections.sms_configs2_twilio.instance_id` +
+		` FROM projections.sms_configs3` +
+		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
+		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id` +
+		` LEFT JOIN projections.sms_configs3_vonage ON projections.sms_configs3.id = projections.sms_configs3_vonage.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_vonage.instance_id` +
+		` LEFT JOIN projections.sms_configs3_messagebird ON projections.sms_configs3.id = projections.sms_configs3_messagebird.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_messagebird.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 
 	smsConfigCols = []string{
@@ -56,11 +104,29 @@ var (
 		"resource_owner",
 		"state",
 		"sequence",
+		"priority",
 		// twilio config
 		"sms_id",
 		"sid",
 		"token",
 		"sender-number",
+		// http config
+		"sms_id",
+		"endpoint",
+		"method",
+		"body_template",
+		"auth_header_name",
+		"auth_header_value",
+		"sender_number",
+		// vonage config
+		"sms_id",
+		"api_key",
+		"api_secret",
+		"sender_number",
+		// messagebird config
+		"sms_id",
+		"access_key",
+		"originator",
 	}
 	smsConfigsCols = append(smsConfigCols, "count")
 )
@@ -104,11 +170,29 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"ro",
 							domain.SMSConfigStateInactive,
 							uint64(20211109),
+							uint32(0),
 							// twilio config
 							"sms-id",
 							"sid",
 							&crypto.CryptoValue{},
 							"sender-number",
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -135,6 +219,75 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareSMSQuery http config",
+			prepare: prepareSMSConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSMSConfigsQuery,
+					smsConfigsCols,
+					[][]driver.Value{
+						{
+							"sms-id",
+							"agg-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.SMSConfigStateActive,
+							uint64(20211109),
+							uint32(1),
+							// twilio config
+							nil,
+							nil,
+							nil,
+							nil,
+							// http config
+							"sms-id",
+							"https://sms.example.com",
+							"POST",
+							"{}",
+							"Authorization",
+							&crypto.CryptoValue{},
+							"sender-number",
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
+						},
+					},
+				),
+			},
+			object: &SMSConfigs{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Configs: []*SMSConfig{
+					{
+						ID:            "sms-id",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.SMSConfigStateActive,
+						Sequence:      20211109,
+						Priority:      1,
+						HTTPConfig: &HTTPSMS{
+							Endpoint:        "https://sms.example.com",
+							Method:          "POST",
+							BodyTemplate:    "{}",
+							AuthHeaderName:  "Authorization",
+							AuthHeaderValue: &crypto.CryptoValue{},
+							SenderNumber:    "sender-number",
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "prepareSMSConfigsQuery multiple result",
 			prepare: prepareSMSConfigsQuery,
@@ -151,11 +304,29 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"ro",
 							domain.SMSConfigStateInactive,
 							uint64(20211109),
+							uint32(0),
 							// twilio config
 							"sms-id",
 							"sid",
 							&crypto.CryptoValue{},
 							"sender-number",
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"sms-id2",
@@ -165,11 +336,29 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"ro",
 							domain.SMSConfigStateInactive,
 							uint64(20211109),
+							uint32(0),
 							// twilio config
 							"sms-id2",
 							"sid2",
 							&crypto.CryptoValue{},
 							"sender-number2",
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -279,11 +468,29 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						"ro",
 						domain.SMSConfigStateInactive,
 						uint64(20211109),
+						uint32(0),
 						// twilio config
 						"sms-id",
 						"sid",
 						&crypto.CryptoValue{},
 						"sender-number",
+						// http config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// vonage config
+						nil,
+						nil,
+						nil,
+						nil,
+						// messagebird config
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
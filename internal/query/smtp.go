@@ -27,6 +27,14 @@ type SMTPConfigs struct {
 	Configs []*SMTPConfig
 }
 
+func (q *SMTPConfigsSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
 var (
 	smtpConfigsTable = table{
 		name:          projection.SMTPConfigProjectionTable,
@@ -132,6 +140,28 @@ func (q *Queries) SMTPConfigActive(ctx context.Context, resourceOwner string) (c
 	return config, err
 }
 
+// OrgSMTPConfigActive returns the active SMTP configuration owned by the organization.
+func (q *Queries) OrgSMTPConfigActive(ctx context.Context, orgID string) (config *SMTPConfig, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareSMTPConfigQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		SMTPConfigColumnResourceOwner.identifier(): orgID,
+		SMTPConfigColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+		SMTPConfigColumnState.identifier():         domain.SMTPConfigStateActive,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Pq4vb", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		config, err = scan(row)
+		return err
+	}, query, args...)
+	return config, err
+}
+
 func (q *Queries) SMTPConfigByID(ctx context.Context, instanceID, resourceOwner, id string) (config *SMTPConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -153,6 +183,10 @@ func (q *Queries) SMTPConfigByID(ctx context.Context, instanceID, resourceOwner,
 	return config, err
 }
 
+func NewSMTPConfigResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(SMTPConfigColumnResourceOwner, resourceOwner, TextEquals)
+}
+
 func prepareSMTPConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*SMTPConfig, error)) {
 	password := new(crypto.CryptoValue)
 
@@ -274,6 +308,6 @@ func (q *Queries) SearchSMTPConfigs(ctx context.Context, queries *SMTPConfigsSea
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-tOpKN", "Errors.Internal")
 	}
-	configs.State, err = q.latestState(ctx, smsConfigsTable)
+	configs.State, err = q.latestState(ctx, smtpConfigsTable)
 	return configs, err
 }
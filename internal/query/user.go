@@ -91,10 +91,11 @@ type Phone struct {
 }
 
 type Machine struct {
-	Name            string               `json:"name,omitempty"`
-	Description     string               `json:"description,omitempty"`
-	EncodedSecret   string               `json:"encoded_hash,omitempty"`
-	AccessTokenType domain.OIDCTokenType `json:"access_token_type,omitempty"`
+	Name                  string               `json:"name,omitempty"`
+	Description           string               `json:"description,omitempty"`
+	EncodedSecret         string               `json:"encoded_hash,omitempty"`
+	AccessTokenType       domain.OIDCTokenType `json:"access_token_type,omitempty"`
+	DPoPBoundAccessTokens bool                 `json:"dpop_bound_access_tokens,omitempty"`
 }
 
 type NotifyUser struct {
@@ -308,6 +309,10 @@ var (
 		name:  projection.MachineAccessTokenTypeCol,
 		table: machineTable,
 	}
+	MachineDPoPBoundAccessTokensCol = Column{
+		name:  projection.MachineDPoPBoundAccessTokensCol,
+		table: machineTable,
+	}
 )
 
 var (
@@ -828,6 +833,7 @@ func scanUser(row *sql.Row) (*User, error) {
 	description := sql.NullString{}
 	encodedHash := sql.NullString{}
 	accessTokenType := sql.NullInt32{}
+	dpopBoundAccessTokens := sql.NullBool{}
 
 	err := row.Scan(
 		&u.ID,
@@ -858,6 +864,7 @@ func scanUser(row *sql.Row) (*User, error) {
 		&description,
 		&encodedHash,
 		&accessTokenType,
+		&dpopBoundAccessTokens,
 		&count,
 	)
 
@@ -887,10 +894,11 @@ func scanUser(row *sql.Row) (*User, error) {
 		}
 	} else if machineID.Valid {
 		u.Machine = &Machine{
-			Name:            name.String,
-			Description:     description.String,
-			EncodedSecret:   encodedHash.String,
-			AccessTokenType: domain.OIDCTokenType(accessTokenType.Int32),
+			Name:                  name.String,
+			Description:           description.String,
+			EncodedSecret:         encodedHash.String,
+			AccessTokenType:       domain.OIDCTokenType(accessTokenType.Int32),
+			DPoPBoundAccessTokens: dpopBoundAccessTokens.Bool,
 		}
 	}
 	return u, nil
@@ -934,6 +942,7 @@ func prepareUserQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			MachineDescriptionCol.identifier(),
 			MachineSecretCol.identifier(),
 			MachineAccessTokenTypeCol.identifier(),
+			MachineDPoPBoundAccessTokensCol.identifier(),
 			countColumn.identifier(),
 		).
 			From(userTable.identifier()).
@@ -1321,6 +1330,7 @@ func prepareUsersQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilde
 			MachineDescriptionCol.identifier(),
 			MachineSecretCol.identifier(),
 			MachineAccessTokenTypeCol.identifier(),
+			MachineDPoPBoundAccessTokensCol.identifier(),
 			countColumn.identifier()).
 			From(userTable.identifier()).
 			LeftJoin(join(HumanUserIDCol, UserIDCol)).
@@ -1361,6 +1371,7 @@ func prepareUsersQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilde
 				description := sql.NullString{}
 				encodedHash := sql.NullString{}
 				accessTokenType := sql.NullInt32{}
+				dpopBoundAccessTokens := sql.NullBool{}
 
 				err := rows.Scan(
 					&u.ID,
@@ -1391,6 +1402,7 @@ func prepareUsersQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilde
 					&description,
 					&encodedHash,
 					&accessTokenType,
+					&dpopBoundAccessTokens,
 					&count,
 				)
 				if err != nil {
@@ -1419,10 +1431,11 @@ func prepareUsersQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilde
 					}
 				} else if machineID.Valid {
 					u.Machine = &Machine{
-						Name:            name.String,
-						Description:     description.String,
-						EncodedSecret:   encodedHash.String,
-						AccessTokenType: domain.OIDCTokenType(accessTokenType.Int32),
+						Name:                  name.String,
+						Description:           description.String,
+						EncodedSecret:         encodedHash.String,
+						AccessTokenType:       domain.OIDCTokenType(accessTokenType.Int32),
+						DPoPBoundAccessTokens: dpopBoundAccessTokens.Bool,
 					}
 				}
 
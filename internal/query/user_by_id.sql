@@ -64,6 +64,7 @@ SELECT
   , m.description
   , m.secret
   , m.access_token_type
+  , m.dpop_bound_access_tokens
   , count(*) OVER ()
 FROM projections.users12 u
 LEFT JOIN
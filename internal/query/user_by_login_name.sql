@@ -79,6 +79,7 @@ SELECT
   , m.description
   , m.secret
   , m.access_token_type
+  , m.dpop_bound_access_tokens
   , count(*) OVER ()
 FROM found_users fu
 JOIN
@@ -13,7 +13,6 @@ import (
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
-	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -237,7 +236,7 @@ func (q *Queries) UserGrant(ctx context.Context, shouldTriggerBulk bool, queries
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserGrantProjection")
-		ctx, err = projection.UserGrantProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.UserGrantProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("trigger failed")
 		traceSpan.EndWithError(err)
 	}
@@ -265,7 +264,7 @@ func (q *Queries) UserGrants(ctx context.Context, queries *UserGrantsQueries, sh
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserGrantProjection")
-		ctx, err = projection.UserGrantProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.UserGrantProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("unable to trigger")
 		traceSpan.EndWithError(err)
 	}
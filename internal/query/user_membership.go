@@ -11,7 +11,6 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/database"
-	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -112,25 +111,25 @@ func (q *Queries) Memberships(ctx context.Context, queries *MembershipSearchQuer
 		wg.Add(4)
 		go func() {
 			spanCtx, triggerSpan := tracing.NewNamedSpan(ctx, "TriggerOrgMemberProjection")
-			_, _ = projection.OrgMemberProjection.Trigger(spanCtx, handler.WithAwaitRunning())
+			_, _ = projection.OrgMemberProjection.Trigger(spanCtx, triggerOpts(spanCtx)...)
 			triggerSpan.End()
 			wg.Done()
 		}()
 		go func() {
 			spanCtx, triggerSpan := tracing.NewNamedSpan(ctx, "TriggerInstanceMemberProjection")
-			_, _ = projection.InstanceMemberProjection.Trigger(spanCtx, handler.WithAwaitRunning())
+			_, _ = projection.InstanceMemberProjection.Trigger(spanCtx, triggerOpts(spanCtx)...)
 			triggerSpan.End()
 			wg.Done()
 		}()
 		go func() {
 			spanCtx, triggerSpan := tracing.NewNamedSpan(ctx, "TriggerProjectMemberProjection")
-			_, _ = projection.ProjectMemberProjection.Trigger(spanCtx, handler.WithAwaitRunning())
+			_, _ = projection.ProjectMemberProjection.Trigger(spanCtx, triggerOpts(spanCtx)...)
 			triggerSpan.End()
 			wg.Done()
 		}()
 		go func() {
 			spanCtx, triggerSpan := tracing.NewNamedSpan(ctx, "TriggerProjectGrantMemberProjection")
-			_, _ = projection.ProjectGrantMemberProjection.Trigger(spanCtx, handler.WithAwaitRunning())
+			_, _ = projection.ProjectGrantMemberProjection.Trigger(spanCtx, triggerOpts(spanCtx)...)
 			triggerSpan.End()
 			wg.Done()
 		}()
@@ -11,7 +11,6 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
-	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -81,7 +80,7 @@ func (q *Queries) GetUserMetadataByKey(ctx context.Context, shouldTriggerBulk bo
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserMetadataProjection")
-		ctx, err = projection.UserMetadataProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.UserMetadataProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("trigger failed")
 		traceSpan.EndWithError(err)
 	}
@@ -113,7 +112,7 @@ func (q *Queries) SearchUserMetadata(ctx context.Context, shouldTriggerBulk bool
 
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserMetadataProjection")
-		ctx, err = projection.UserMetadataProjection.Trigger(ctx, handler.WithAwaitRunning())
+		ctx, err = projection.UserMetadataProjection.Trigger(ctx, triggerOpts(ctx)...)
 		logging.OnError(err).Debug("trigger failed")
 		traceSpan.EndWithError(err)
 	}
@@ -139,6 +138,48 @@ func (q *Queries) SearchUserMetadata(ctx context.Context, shouldTriggerBulk bool
 	return metadata, err
 }
 
+// UserMetadataValuesByKey returns the values of the metadata with the key of all provided users mapped by the user id.
+// Users without the metadata are not contained in the result.
+func (q *Queries) UserMetadataValuesByKey(ctx context.Context, key string, userIDs ...string) (values map[string][]byte, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	values = make(map[string][]byte, len(userIDs))
+	if len(userIDs) == 0 {
+		return values, nil
+	}
+	stmt, args, err := sq.Select(
+		UserMetadataUserIDCol.identifier(),
+		UserMetadataValueCol.identifier(),
+	).
+		From(userMetadataTable.identifier() + q.client.Timetravel(call.Took(ctx))).
+		Where(sq.Eq{
+			UserMetadataInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+			UserMetadataKeyCol.identifier():        key,
+			UserMetadataUserIDCol.identifier():     userIDs,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-o2IjL", "Errors.Query.SQLStatment")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var userID string
+			var value []byte
+			if err := rows.Scan(&userID, &value); err != nil {
+				return err
+			}
+			values[userID] = value
+		}
+		return rows.Err()
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-VXlHT", "Errors.Internal")
+	}
+	return values, nil
+}
+
 func (q *UserMetadataSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
 	query = q.SearchRequest.toQuery(query)
 	for _, q := range q.Queries {
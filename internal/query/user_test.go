@@ -175,6 +175,7 @@ var (
 		` projections.users12_machines.description,` +
 		` projections.users12_machines.secret,` +
 		` projections.users12_machines.access_token_type,` +
+		` projections.users12_machines.dpop_bound_access_tokens,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.users12` +
 		` LEFT JOIN projections.users12_humans ON projections.users12.id = projections.users12_humans.user_id AND projections.users12.instance_id = projections.users12_humans.instance_id` +
@@ -217,6 +218,7 @@ var (
 		"description",
 		"secret",
 		"access_token_type",
+		"dpop_bound_access_tokens",
 		"count",
 	}
 	profileQuery = `SELECT projections.users12.id,` +
@@ -401,6 +403,7 @@ var (
 		` projections.users12_machines.description,` +
 		` projections.users12_machines.secret,` +
 		` projections.users12_machines.access_token_type,` +
+		` projections.users12_machines.dpop_bound_access_tokens,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.users12` +
 		` LEFT JOIN projections.users12_humans ON projections.users12.id = projections.users12_humans.user_id AND projections.users12.instance_id = projections.users12_humans.instance_id` +
@@ -443,6 +446,7 @@ var (
 		"description",
 		"secret",
 		"access_token_type",
+		"dpop_bound_access_tokens",
 		"count",
 	}
 )
@@ -514,6 +518,7 @@ func Test_UserPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						1,
 					},
 				),
@@ -583,6 +588,7 @@ func Test_UserPrepares(t *testing.T) {
 						"description",
 						nil,
 						domain.OIDCTokenTypeBearer,
+						false,
 						1,
 					},
 				),
@@ -644,6 +650,7 @@ func Test_UserPrepares(t *testing.T) {
 						"description",
 						"secret",
 						domain.OIDCTokenTypeBearer,
+						true,
 						1,
 					},
 				),
@@ -660,10 +667,11 @@ func Test_UserPrepares(t *testing.T) {
 				LoginNames:         database.TextArray[string]{"login_name1", "login_name2"},
 				PreferredLoginName: "login_name1",
 				Machine: &Machine{
-					Name:            "name",
-					Description:     "description",
-					EncodedSecret:   "secret",
-					AccessTokenType: domain.OIDCTokenTypeBearer,
+					Name:                  "name",
+					Description:           "description",
+					EncodedSecret:         "secret",
+					AccessTokenType:       domain.OIDCTokenTypeBearer,
+					DPoPBoundAccessTokens: true,
 				},
 			},
 		},
@@ -1232,6 +1240,7 @@ func Test_UserPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1309,6 +1318,7 @@ func Test_UserPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 						{
 							"id",
@@ -1341,6 +1351,7 @@ func Test_UserPrepares(t *testing.T) {
 							"description",
 							"secret",
 							domain.OIDCTokenTypeBearer,
+							false,
 						},
 					},
 				),
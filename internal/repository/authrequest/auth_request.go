@@ -2,6 +2,7 @@ package authrequest
 
 import (
 	"context"
+	"net/url"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
@@ -17,6 +18,8 @@ const (
 	SessionLinkedType      = authRequestEventPrefix + "session.linked"
 	CodeExchangedType      = authRequestEventPrefix + "code.exchanged"
 	SucceededType          = authRequestEventPrefix + "succeeded"
+	PushedType             = authRequestEventPrefix + "pushed"
+	PushedUsedType         = authRequestEventPrefix + "pushed.used"
 )
 
 type AddedEvent struct {
@@ -286,3 +289,95 @@ func SucceededEventMapper(event eventstore.Event) (eventstore.Event, error) {
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
+
+// PushedEvent is created when a client pushes the parameters of an authorization request
+// to the pushed authorization request endpoint (RFC 9126).
+type PushedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID   string     `json:"client_id"`
+	Parameters url.Values `json:"parameters"`
+	Expiration time.Time  `json:"expiration"`
+}
+
+func (e *PushedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPushedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+	parameters url.Values,
+	expiration time.Time,
+) *PushedEvent {
+	return &PushedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedType,
+		),
+		ClientID:   clientID,
+		Parameters: parameters,
+		Expiration: expiration,
+	}
+}
+
+func PushedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	added := &PushedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(added)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "AUTHR-Shoo4", "unable to unmarshal auth request pushed")
+	}
+
+	return added, nil
+}
+
+// PushedUsedEvent is created when the request_uri of a pushed authorization request was used,
+// so it cannot be used again.
+type PushedUsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	pushedSequence uint64
+}
+
+func (e *PushedUsedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *PushedUsedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+// GuardedSequence prevents concurrent uses of the same request_uri,
+// which all passed the check of the write model.
+func (e *PushedUsedEvent) GuardedSequence() (uint64, string) {
+	return e.pushedSequence, "Errors.AuthRequest.AlreadyHandled"
+}
+
+// NewPushedUsedEvent creates the event to use the pushed authorization request,
+// which is only pushed if the aggregate is still at the sequence of the write model.
+func NewPushedUsedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	pushedSequence uint64,
+) *PushedUsedEvent {
+	return &PushedUsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedUsedType,
+		),
+		pushedSequence: pushedSequence,
+	}
+}
+
+func PushedUsedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &PushedUsedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,56 @@
+package idp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"slices"
+)
+
+// ClaimMappings map the claims (or attributes) returned by an identity provider
+// to user metadata and project role grants, which are applied on each login
+type ClaimMappings []ClaimMapping
+
+// ClaimMapping maps a single claim, at least a metadata key or a project with roles is set
+type ClaimMapping struct {
+	// Claim is the name of the claim or attribute, e.g. groups or department
+	Claim string `json:"claim"`
+	// MetadataKey stores the values of the claim as user metadata, it's removed if the claim is missing
+	MetadataKey string `json:"metadataKey,omitempty"`
+	// Value restricts the roles to users with the value in the claim,
+	// any value grants the roles if empty
+	Value          string   `json:"value,omitempty"`
+	ProjectID      string   `json:"projectId,omitempty"`
+	ProjectGrantID string   `json:"projectGrantId,omitempty"`
+	Roles          []string `json:"roles,omitempty"`
+}
+
+// Equal returns if both lists contain the same mappings in the same order
+func (m ClaimMappings) Equal(mappings ClaimMappings) bool {
+	return slices.EqualFunc(m, mappings, func(a, b ClaimMapping) bool {
+		return a.Claim == b.Claim &&
+			a.MetadataKey == b.MetadataKey &&
+			a.Value == b.Value &&
+			a.ProjectID == b.ProjectID &&
+			a.ProjectGrantID == b.ProjectGrantID &&
+			slices.Equal(a.Roles, b.Roles)
+	})
+}
+
+// Scan implements the [sql.Scanner] interface for the projection column
+func (m *ClaimMappings) Scan(src any) error {
+	if b, ok := src.([]byte); ok {
+		return json.Unmarshal(b, m)
+	}
+	if str, ok := src.(string); ok {
+		return json.Unmarshal([]byte(str), m)
+	}
+	return nil
+}
+
+// Value implements the [driver.Valuer] interface for the projection column
+func (m ClaimMappings) Value() (driver.Value, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(m)
+}
@@ -1,6 +1,9 @@
 package idp
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"slices"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/crypto"
@@ -22,11 +25,81 @@ type LDAPIDPAddedEvent struct {
 	UserObjectClasses []string            `json:"userObjectClasses"`
 	UserFilters       []string            `json:"userFilters"`
 	Timeout           time.Duration       `json:"timeout"`
+	Sync              *LDAPSync           `json:"sync,omitempty"`
 
 	LDAPAttributes
 	Options
 }
 
+// LDAPSync configures the scheduled synchronization of the users of the directory
+type LDAPSync struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// DryRun only reports the changes of the synchronization without executing them
+	DryRun bool `json:"dryRun,omitempty"`
+	// Filter is an additional LDAP filter for users to be synchronized, e.g. to exclude disabled accounts
+	Filter string `json:"filter,omitempty"`
+	// OrgID is the organization the users of an instance provider are created in,
+	// the default organization is used if empty
+	OrgID string `json:"orgId,omitempty"`
+	// DeactivateMissing deactivates linked users which aren't found in the directory anymore
+	// and reactivates the users it deactivated as soon as they are found again
+	DeactivateMissing bool `json:"deactivateMissing,omitempty"`
+	// GroupAttribute is the attribute listing the groups of a user, e.g. memberOf
+	GroupAttribute string             `json:"groupAttribute,omitempty"`
+	GroupMappings  []LDAPGroupMapping `json:"groupMappings,omitempty"`
+}
+
+// LDAPGroupMapping grants the roles of the project to all members of the group
+type LDAPGroupMapping struct {
+	// Group is the distinguished name of the group as listed in the group attribute
+	Group          string   `json:"group"`
+	ProjectID      string   `json:"projectId"`
+	ProjectGrantID string   `json:"projectGrantId,omitempty"`
+	Roles          []string `json:"roles"`
+}
+
+// Equal returns if both configurations synchronize the same way,
+// a nil configuration equals a disabled one without mappings
+func (s *LDAPSync) Equal(sync *LDAPSync) bool {
+	if s == nil {
+		s = new(LDAPSync)
+	}
+	if sync == nil {
+		sync = new(LDAPSync)
+	}
+	return s.Enabled == sync.Enabled &&
+		s.DryRun == sync.DryRun &&
+		s.Filter == sync.Filter &&
+		s.OrgID == sync.OrgID &&
+		s.DeactivateMissing == sync.DeactivateMissing &&
+		s.GroupAttribute == sync.GroupAttribute &&
+		slices.EqualFunc(s.GroupMappings, sync.GroupMappings, func(a, b LDAPGroupMapping) bool {
+			return a.Group == b.Group &&
+				a.ProjectID == b.ProjectID &&
+				a.ProjectGrantID == b.ProjectGrantID &&
+				slices.Equal(a.Roles, b.Roles)
+		})
+}
+
+// Scan implements the [sql.Scanner] interface for the projection column
+func (s *LDAPSync) Scan(src any) error {
+	if b, ok := src.([]byte); ok {
+		return json.Unmarshal(b, s)
+	}
+	if str, ok := src.(string); ok {
+		return json.Unmarshal([]byte(str), s)
+	}
+	return nil
+}
+
+// Value implements the [driver.Valuer] interface for the projection column
+func (s *LDAPSync) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return json.Marshal(s)
+}
+
 type LDAPAttributes struct {
 	IDAttribute                string `json:"idAttribute,omitempty"`
 	FirstNameAttribute         string `json:"firstNameAttribute,omitempty"`
@@ -143,6 +216,7 @@ func NewLDAPIDPAddedEvent(
 	userFilters []string,
 	timeout time.Duration,
 	attributes LDAPAttributes,
+	sync *LDAPSync,
 	options Options,
 ) *LDAPIDPAddedEvent {
 	return &LDAPIDPAddedEvent{
@@ -158,6 +232,7 @@ func NewLDAPIDPAddedEvent(
 		UserObjectClasses: userObjectClasses,
 		UserFilters:       userFilters,
 		Timeout:           timeout,
+		Sync:              sync,
 		LDAPAttributes:    attributes,
 		Options:           options,
 	}
@@ -198,6 +273,7 @@ type LDAPIDPChangedEvent struct {
 	UserObjectClasses []string            `json:"userObjectClasses,omitempty"`
 	UserFilters       []string            `json:"userFilters,omitempty"`
 	Timeout           *time.Duration      `json:"timeout,omitempty"`
+	Sync              *LDAPSync           `json:"sync,omitempty"`
 
 	LDAPAttributeChanges
 	OptionChanges
@@ -315,6 +391,17 @@ func ChangeLDAPTimeout(timeout time.Duration) func(*LDAPIDPChangedEvent) {
 	}
 }
 
+// ChangeLDAPSync replaces the synchronization configuration,
+// it's disabled by an empty configuration
+func ChangeLDAPSync(sync *LDAPSync) func(*LDAPIDPChangedEvent) {
+	return func(e *LDAPIDPChangedEvent) {
+		if sync == nil {
+			sync = new(LDAPSync)
+		}
+		e.Sync = sync
+	}
+}
+
 func ChangeLDAPAttributes(attributes LDAPAttributeChanges) func(*LDAPIDPChangedEvent) {
 	return func(e *LDAPIDPChangedEvent) {
 		e.LDAPAttributeChanges = attributes
@@ -347,3 +434,98 @@ func LDAPIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error)
 
 	return e, nil
 }
+
+// LDAPSyncReport is the result of a synchronization of the users of the directory,
+// the users are referenced by their id in the directory
+type LDAPSyncReport struct {
+	DryRun      bool     `json:"dryRun,omitempty"`
+	Created     []string `json:"created,omitempty"`
+	Updated     []string `json:"updated,omitempty"`
+	Deactivated []string `json:"deactivated,omitempty"`
+	Reactivated []string `json:"reactivated,omitempty"`
+	// Skipped lists the users of the directory which were removed from ZITADEL or unlinked from the provider
+	Skipped []string `json:"skipped,omitempty"`
+	// Grants lists the users whose authorizations were added, changed or removed
+	Grants []string              `json:"grants,omitempty"`
+	Failed []LDAPSyncUserFailure `json:"failed,omitempty"`
+}
+
+type LDAPSyncUserFailure struct {
+	ExternalUserID string `json:"externalUserId"`
+	Error          string `json:"error"`
+}
+
+// Equal returns if both reports list the same changes
+func (r *LDAPSyncReport) Equal(report *LDAPSyncReport) bool {
+	if r == nil || report == nil {
+		return r == report
+	}
+	return r.DryRun == report.DryRun &&
+		slices.Equal(r.Created, report.Created) &&
+		slices.Equal(r.Updated, report.Updated) &&
+		slices.Equal(r.Deactivated, report.Deactivated) &&
+		slices.Equal(r.Reactivated, report.Reactivated) &&
+		slices.Equal(r.Skipped, report.Skipped) &&
+		slices.Equal(r.Grants, report.Grants) &&
+		slices.Equal(r.Failed, report.Failed)
+}
+
+// Scan implements the [sql.Scanner] interface for the projection column
+func (r *LDAPSyncReport) Scan(src any) error {
+	if b, ok := src.([]byte); ok {
+		return json.Unmarshal(b, r)
+	}
+	if str, ok := src.(string); ok {
+		return json.Unmarshal([]byte(str), r)
+	}
+	return nil
+}
+
+// Value implements the [driver.Valuer] interface for the projection column
+func (r *LDAPSyncReport) Value() (driver.Value, error) {
+	if r == nil {
+		return nil, nil
+	}
+	return json.Marshal(r)
+}
+
+// LDAPIDPSyncedEvent stores the report of the last synchronization of the users of the directory
+type LDAPIDPSyncedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID     string          `json:"id"`
+	Report *LDAPSyncReport `json:"report"`
+}
+
+func NewLDAPIDPSyncedEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	report *LDAPSyncReport,
+) *LDAPIDPSyncedEvent {
+	return &LDAPIDPSyncedEvent{
+		BaseEvent: *base,
+		ID:        id,
+		Report:    report,
+	}
+}
+
+func (e *LDAPIDPSyncedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LDAPIDPSyncedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func LDAPIDPSyncedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &LDAPIDPSyncedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Ohd3a", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -3,21 +3,24 @@ package idp
 import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type OAuthIDPAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	ID                    string              `json:"id"`
-	Name                  string              `json:"name,omitempty"`
-	ClientID              string              `json:"clientId,omitempty"`
-	ClientSecret          *crypto.CryptoValue `json:"clientSecret,omitempty"`
-	AuthorizationEndpoint string              `json:"authorizationEndpoint,omitempty"`
-	TokenEndpoint         string              `json:"tokenEndpoint,omitempty"`
-	UserEndpoint          string              `json:"userEndpoint,omitempty"`
-	Scopes                []string            `json:"scopes,omitempty"`
-	IDAttribute           string              `json:"idAttribute,omitempty"`
+	ID                    string                 `json:"id"`
+	Name                  string                 `json:"name,omitempty"`
+	ClientID              string                 `json:"clientId,omitempty"`
+	ClientSecret          *crypto.CryptoValue    `json:"clientSecret,omitempty"`
+	AuthorizationEndpoint string                 `json:"authorizationEndpoint,omitempty"`
+	TokenEndpoint         string                 `json:"tokenEndpoint,omitempty"`
+	UserEndpoint          string                 `json:"userEndpoint,omitempty"`
+	Scopes                []string               `json:"scopes,omitempty"`
+	IDAttribute           string                 `json:"idAttribute,omitempty"`
+	ClaimMappings         ClaimMappings          `json:"claimMappings,omitempty"`
+	UserMapping           *providers.UserMapping `json:"userMapping,omitempty"`
 	Options
 }
 
@@ -32,6 +35,8 @@ func NewOAuthIDPAddedEvent(
 	userEndpoint,
 	idAttribute string,
 	scopes []string,
+	claimMappings ClaimMappings,
+	userMapping *providers.UserMapping,
 	options Options,
 ) *OAuthIDPAddedEvent {
 	return &OAuthIDPAddedEvent{
@@ -45,6 +50,8 @@ func NewOAuthIDPAddedEvent(
 		UserEndpoint:          userEndpoint,
 		Scopes:                scopes,
 		IDAttribute:           idAttribute,
+		ClaimMappings:         claimMappings,
+		UserMapping:           userMapping,
 		Options:               options,
 	}
 }
@@ -73,15 +80,17 @@ func OAuthIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error)
 type OAuthIDPChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	ID                    string              `json:"id"`
-	Name                  *string             `json:"name,omitempty"`
-	ClientID              *string             `json:"clientId,omitempty"`
-	ClientSecret          *crypto.CryptoValue `json:"clientSecret,omitempty"`
-	AuthorizationEndpoint *string             `json:"authorizationEndpoint,omitempty"`
-	TokenEndpoint         *string             `json:"tokenEndpoint,omitempty"`
-	UserEndpoint          *string             `json:"userEndpoint,omitempty"`
-	Scopes                []string            `json:"scopes,omitempty"`
-	IDAttribute           *string             `json:"idAttribute,omitempty"`
+	ID                    string                 `json:"id"`
+	Name                  *string                `json:"name,omitempty"`
+	ClientID              *string                `json:"clientId,omitempty"`
+	ClientSecret          *crypto.CryptoValue    `json:"clientSecret,omitempty"`
+	AuthorizationEndpoint *string                `json:"authorizationEndpoint,omitempty"`
+	TokenEndpoint         *string                `json:"tokenEndpoint,omitempty"`
+	UserEndpoint          *string                `json:"userEndpoint,omitempty"`
+	Scopes                []string               `json:"scopes,omitempty"`
+	IDAttribute           *string                `json:"idAttribute,omitempty"`
+	ClaimMappings         *ClaimMappings         `json:"claimMappings,omitempty"`
+	UserMapping           *providers.UserMapping `json:"userMapping,omitempty"`
 	OptionChanges
 }
 
@@ -152,6 +161,22 @@ func ChangeOAuthScopes(scopes []string) func(*OAuthIDPChangedEvent) {
 	}
 }
 
+func ChangeOAuthClaimMappings(claimMappings ClaimMappings) func(*OAuthIDPChangedEvent) {
+	return func(e *OAuthIDPChangedEvent) {
+		e.ClaimMappings = &claimMappings
+	}
+}
+
+// ChangeOAuthUserMapping sets the mapping of the user fields, an empty mapping removes it
+func ChangeOAuthUserMapping(userMapping *providers.UserMapping) func(*OAuthIDPChangedEvent) {
+	return func(e *OAuthIDPChangedEvent) {
+		if userMapping == nil {
+			userMapping = new(providers.UserMapping)
+		}
+		e.UserMapping = userMapping
+	}
+}
+
 func ChangeOAuthIDAttribute(idAttribute string) func(*OAuthIDPChangedEvent) {
 	return func(e *OAuthIDPChangedEvent) {
 		e.IDAttribute = &idAttribute
@@ -3,19 +3,22 @@ package idp
 import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type OIDCIDPAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	ID               string              `json:"id"`
-	Name             string              `json:"name"`
-	Issuer           string              `json:"issuer"`
-	ClientID         string              `json:"clientId"`
-	ClientSecret     *crypto.CryptoValue `json:"clientSecret"`
-	Scopes           []string            `json:"scopes,omitempty"`
-	IsIDTokenMapping bool                `json:"idTokenMapping,omitempty"`
+	ID               string                 `json:"id"`
+	Name             string                 `json:"name"`
+	Issuer           string                 `json:"issuer"`
+	ClientID         string                 `json:"clientId"`
+	ClientSecret     *crypto.CryptoValue    `json:"clientSecret"`
+	Scopes           []string               `json:"scopes,omitempty"`
+	IsIDTokenMapping bool                   `json:"idTokenMapping,omitempty"`
+	ClaimMappings    ClaimMappings          `json:"claimMappings,omitempty"`
+	UserMapping      *providers.UserMapping `json:"userMapping,omitempty"`
 	Options
 }
 
@@ -28,6 +31,8 @@ func NewOIDCIDPAddedEvent(
 	clientSecret *crypto.CryptoValue,
 	scopes []string,
 	isIDTokenMapping bool,
+	claimMappings ClaimMappings,
+	userMapping *providers.UserMapping,
 	options Options,
 ) *OIDCIDPAddedEvent {
 	return &OIDCIDPAddedEvent{
@@ -39,6 +44,8 @@ func NewOIDCIDPAddedEvent(
 		ClientSecret:     clientSecret,
 		Scopes:           scopes,
 		IsIDTokenMapping: isIDTokenMapping,
+		ClaimMappings:    claimMappings,
+		UserMapping:      userMapping,
 		Options:          options,
 	}
 }
@@ -67,13 +74,15 @@ func OIDCIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 type OIDCIDPChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	ID               string              `json:"id"`
-	Name             *string             `json:"name,omitempty"`
-	Issuer           *string             `json:"issuer,omitempty"`
-	ClientID         *string             `json:"clientId,omitempty"`
-	ClientSecret     *crypto.CryptoValue `json:"clientSecret,omitempty"`
-	Scopes           []string            `json:"scopes,omitempty"`
-	IsIDTokenMapping *bool               `json:"idTokenMapping,omitempty"`
+	ID               string                 `json:"id"`
+	Name             *string                `json:"name,omitempty"`
+	Issuer           *string                `json:"issuer,omitempty"`
+	ClientID         *string                `json:"clientId,omitempty"`
+	ClientSecret     *crypto.CryptoValue    `json:"clientSecret,omitempty"`
+	Scopes           []string               `json:"scopes,omitempty"`
+	IsIDTokenMapping *bool                  `json:"idTokenMapping,omitempty"`
+	ClaimMappings    *ClaimMappings         `json:"claimMappings,omitempty"`
+	UserMapping      *providers.UserMapping `json:"userMapping,omitempty"`
 	OptionChanges
 }
 
@@ -121,6 +130,22 @@ func ChangeOIDCClientSecret(clientSecret *crypto.CryptoValue) func(*OIDCIDPChang
 	}
 }
 
+func ChangeOIDCClaimMappings(claimMappings ClaimMappings) func(*OIDCIDPChangedEvent) {
+	return func(e *OIDCIDPChangedEvent) {
+		e.ClaimMappings = &claimMappings
+	}
+}
+
+// ChangeOIDCUserMapping sets the mapping of the user fields, an empty mapping removes it
+func ChangeOIDCUserMapping(userMapping *providers.UserMapping) func(*OIDCIDPChangedEvent) {
+	return func(e *OIDCIDPChangedEvent) {
+		if userMapping == nil {
+			userMapping = new(providers.UserMapping)
+		}
+		e.UserMapping = userMapping
+	}
+}
+
 func ChangeOIDCOptions(options OptionChanges) func(*OIDCIDPChangedEvent) {
 	return func(e *OIDCIDPChangedEvent) {
 		e.OptionChanges = options
@@ -19,6 +19,7 @@ type SAMLIDPAddedEvent struct {
 	WithSignedRequest             bool                     `json:"withSignedRequest,omitempty"`
 	NameIDFormat                  *domain.SAMLNameIDFormat `json:"nameIDFormat,omitempty"`
 	TransientMappingAttributeName string                   `json:"transientMappingAttributeName,omitempty"`
+	ClaimMappings                 ClaimMappings            `json:"claimMappings,omitempty"`
 	Options
 }
 
@@ -33,6 +34,7 @@ func NewSAMLIDPAddedEvent(
 	withSignedRequest bool,
 	nameIDFormat *domain.SAMLNameIDFormat,
 	transientMappingAttributeName string,
+	claimMappings ClaimMappings,
 	options Options,
 ) *SAMLIDPAddedEvent {
 	return &SAMLIDPAddedEvent{
@@ -46,6 +48,7 @@ func NewSAMLIDPAddedEvent(
 		WithSignedRequest:             withSignedRequest,
 		NameIDFormat:                  nameIDFormat,
 		TransientMappingAttributeName: transientMappingAttributeName,
+		ClaimMappings:                 claimMappings,
 		Options:                       options,
 	}
 }
@@ -83,6 +86,7 @@ type SAMLIDPChangedEvent struct {
 	WithSignedRequest             *bool                    `json:"withSignedRequest,omitempty"`
 	NameIDFormat                  *domain.SAMLNameIDFormat `json:"nameIDFormat,omitempty"`
 	TransientMappingAttributeName *string                  `json:"transientMappingAttributeName,omitempty"`
+	ClaimMappings                 *ClaimMappings           `json:"claimMappings,omitempty"`
 	OptionChanges
 }
 
@@ -154,6 +158,12 @@ func ChangeSAMLTransientMappingAttributeName(name string) func(*SAMLIDPChangedEv
 	}
 }
 
+func ChangeSAMLClaimMappings(claimMappings ClaimMappings) func(*SAMLIDPChangedEvent) {
+	return func(e *SAMLIDPChangedEvent) {
+		e.ClaimMappings = &claimMappings
+	}
+}
+
 func ChangeSAMLOptions(options OptionChanges) func(*SAMLIDPChangedEvent) {
 	return func(e *SAMLIDPChangedEvent) {
 		e.OptionChanges = options
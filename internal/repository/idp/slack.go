@@ -0,0 +1,141 @@
+package idp
+
+import (
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type SlackIDPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id"`
+	Name         string              `json:"name,omitempty"`
+	ClientID     string              `json:"client_id"`
+	ClientSecret *crypto.CryptoValue `json:"client_secret"`
+	Scopes       []string            `json:"scopes,omitempty"`
+	Options
+}
+
+func NewSlackIDPAddedEvent(
+	base *eventstore.BaseEvent,
+	id,
+	name,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options Options,
+) *SlackIDPAddedEvent {
+	return &SlackIDPAddedEvent{
+		BaseEvent:    *base,
+		ID:           id,
+		Name:         name,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       scopes,
+		Options:      options,
+	}
+}
+
+func (e *SlackIDPAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SlackIDPAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SlackIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &SlackIDPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-enqGR", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+type SlackIDPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id"`
+	Name         *string             `json:"name,omitempty"`
+	ClientID     *string             `json:"client_id,omitempty"`
+	ClientSecret *crypto.CryptoValue `json:"client_secret,omitempty"`
+	Scopes       []string            `json:"scopes,omitempty"`
+	OptionChanges
+}
+
+func NewSlackIDPChangedEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	changes []SlackIDPChanges,
+) (*SlackIDPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IDP-PAnxw", "Errors.NoChangesFound")
+	}
+	changedEvent := &SlackIDPChangedEvent{
+		BaseEvent: *base,
+		ID:        id,
+	}
+	for _, change := range changes {
+		change(changedEvent)
+	}
+	return changedEvent, nil
+}
+
+type SlackIDPChanges func(*SlackIDPChangedEvent)
+
+func ChangeSlackName(name string) func(*SlackIDPChangedEvent) {
+	return func(e *SlackIDPChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeSlackClientID(clientID string) func(*SlackIDPChangedEvent) {
+	return func(e *SlackIDPChangedEvent) {
+		e.ClientID = &clientID
+	}
+}
+
+func ChangeSlackClientSecret(clientSecret *crypto.CryptoValue) func(*SlackIDPChangedEvent) {
+	return func(e *SlackIDPChangedEvent) {
+		e.ClientSecret = clientSecret
+	}
+}
+
+func ChangeSlackScopes(scopes []string) func(*SlackIDPChangedEvent) {
+	return func(e *SlackIDPChangedEvent) {
+		e.Scopes = scopes
+	}
+}
+
+func ChangeSlackOptions(options OptionChanges) func(*SlackIDPChangedEvent) {
+	return func(e *SlackIDPChangedEvent) {
+		e.OptionChanges = options
+	}
+}
+
+func (e *SlackIDPChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SlackIDPChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SlackIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &SlackIDPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-1Ktrc", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
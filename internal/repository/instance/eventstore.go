@@ -24,6 +24,13 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigActivatedEventType, SMSConfigActivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigDeactivatedEventType, SMSConfigDeactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigRemovedEventType, SMSConfigRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigPriorityChangedEventType, SMSConfigPriorityChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPAddedEventType, SMSConfigHTTPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPChangedEventType, SMSConfigHTTPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigVonageAddedEventType, SMSConfigVonageAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigVonageChangedEventType, SMSConfigVonageChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigMessageBirdAddedEventType, SMSConfigMessageBirdAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigMessageBirdChangedEventType, SMSConfigMessageBirdChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, DebugNotificationProviderFileAddedEventType, DebugNotificationProviderFileAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, DebugNotificationProviderFileChangedEventType, DebugNotificationProviderFileChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, DebugNotificationProviderFileRemovedEventType, DebugNotificationProviderFileRemovedEventMapper)
@@ -88,12 +95,23 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, GitHubEnterpriseIDPChangedEventType, GitHubEnterpriseIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GitLabIDPAddedEventType, GitLabIDPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GitLabIDPChangedEventType, GitLabIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, BitbucketIDPAddedEventType, BitbucketIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, BitbucketIDPChangedEventType, BitbucketIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, DiscordIDPAddedEventType, DiscordIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, DiscordIDPChangedEventType, DiscordIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SlackIDPAddedEventType, SlackIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SlackIDPChangedEventType, SlackIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GitLabSelfHostedIDPAddedEventType, GitLabSelfHostedIDPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GitLabSelfHostedIDPChangedEventType, GitLabSelfHostedIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, KeycloakIDPAddedEventType, KeycloakIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, KeycloakIDPChangedEventType, KeycloakIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OktaIDPAddedEventType, OktaIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OktaIDPChangedEventType, OktaIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GoogleIDPAddedEventType, GoogleIDPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GoogleIDPChangedEventType, GoogleIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LDAPIDPAddedEventType, LDAPIDPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LDAPIDPChangedEventType, LDAPIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, LDAPIDPSyncedEventType, LDAPIDPSyncedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, AppleIDPAddedEventType, AppleIDPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, AppleIDPChangedEventType, AppleIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPAddedEventType, SAMLIDPAddedEventMapper)
@@ -7,6 +7,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 )
 
@@ -27,12 +28,23 @@ const (
 	GitHubEnterpriseIDPChangedEventType eventstore.EventType = "instance.idp.github_enterprise.changed"
 	GitLabIDPAddedEventType             eventstore.EventType = "instance.idp.gitlab.added"
 	GitLabIDPChangedEventType           eventstore.EventType = "instance.idp.gitlab.changed"
+	BitbucketIDPAddedEventType          eventstore.EventType = "instance.idp.bitbucket.added"
+	BitbucketIDPChangedEventType        eventstore.EventType = "instance.idp.bitbucket.changed"
+	DiscordIDPAddedEventType            eventstore.EventType = "instance.idp.discord.added"
+	DiscordIDPChangedEventType          eventstore.EventType = "instance.idp.discord.changed"
+	SlackIDPAddedEventType              eventstore.EventType = "instance.idp.slack.added"
+	SlackIDPChangedEventType            eventstore.EventType = "instance.idp.slack.changed"
 	GitLabSelfHostedIDPAddedEventType   eventstore.EventType = "instance.idp.gitlab_self_hosted.added"
 	GitLabSelfHostedIDPChangedEventType eventstore.EventType = "instance.idp.gitlab_self_hosted.changed"
+	KeycloakIDPAddedEventType           eventstore.EventType = "instance.idp.keycloak.added"
+	KeycloakIDPChangedEventType         eventstore.EventType = "instance.idp.keycloak.changed"
+	OktaIDPAddedEventType               eventstore.EventType = "instance.idp.okta.added"
+	OktaIDPChangedEventType             eventstore.EventType = "instance.idp.okta.changed"
 	GoogleIDPAddedEventType             eventstore.EventType = "instance.idp.google.added"
 	GoogleIDPChangedEventType           eventstore.EventType = "instance.idp.google.changed"
 	LDAPIDPAddedEventType               eventstore.EventType = "instance.idp.ldap.v2.added"
 	LDAPIDPChangedEventType             eventstore.EventType = "instance.idp.ldap.v2.changed"
+	LDAPIDPSyncedEventType              eventstore.EventType = "instance.idp.ldap.v2.synced"
 	AppleIDPAddedEventType              eventstore.EventType = "instance.idp.apple.added"
 	AppleIDPChangedEventType            eventstore.EventType = "instance.idp.apple.changed"
 	SAMLIDPAddedEventType               eventstore.EventType = "instance.idp.saml.added"
@@ -56,6 +68,8 @@ func NewOAuthIDPAddedEvent(
 	userEndpoint,
 	idAttribute string,
 	scopes []string,
+	claimMappings idp.ClaimMappings,
+	userMapping *providers.UserMapping,
 	options idp.Options,
 ) *OAuthIDPAddedEvent {
 
@@ -75,6 +89,8 @@ func NewOAuthIDPAddedEvent(
 			userEndpoint,
 			idAttribute,
 			scopes,
+			claimMappings,
+			userMapping,
 			options,
 		),
 	}
@@ -138,6 +154,8 @@ func NewOIDCIDPAddedEvent(
 	clientSecret *crypto.CryptoValue,
 	scopes []string,
 	isIDTokenMapping bool,
+	claimMappings idp.ClaimMappings,
+	userMapping *providers.UserMapping,
 	options idp.Options,
 ) *OIDCIDPAddedEvent {
 
@@ -155,6 +173,8 @@ func NewOIDCIDPAddedEvent(
 			clientSecret,
 			scopes,
 			isIDTokenMapping,
+			claimMappings,
+			userMapping,
 			options,
 		),
 	}
@@ -680,6 +700,234 @@ func GitLabIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, erro
 	return &GitLabIDPChangedEvent{GitLabIDPChangedEvent: *e.(*idp.GitLabIDPChangedEvent)}, nil
 }
 
+type BitbucketIDPAddedEvent struct {
+	idp.BitbucketIDPAddedEvent
+}
+
+func NewBitbucketIDPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options idp.Options,
+) *BitbucketIDPAddedEvent {
+
+	return &BitbucketIDPAddedEvent{
+		BitbucketIDPAddedEvent: *idp.NewBitbucketIDPAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				BitbucketIDPAddedEventType,
+			),
+			id,
+			name,
+			clientID,
+			clientSecret,
+			scopes,
+			options,
+		),
+	}
+}
+
+func BitbucketIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.BitbucketIDPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BitbucketIDPAddedEvent{BitbucketIDPAddedEvent: *e.(*idp.BitbucketIDPAddedEvent)}, nil
+}
+
+type BitbucketIDPChangedEvent struct {
+	idp.BitbucketIDPChangedEvent
+}
+
+func NewBitbucketIDPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []idp.BitbucketIDPChanges,
+) (*BitbucketIDPChangedEvent, error) {
+
+	changedEvent, err := idp.NewBitbucketIDPChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			BitbucketIDPChangedEventType,
+		),
+		id,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &BitbucketIDPChangedEvent{BitbucketIDPChangedEvent: *changedEvent}, nil
+}
+
+func BitbucketIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.BitbucketIDPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BitbucketIDPChangedEvent{BitbucketIDPChangedEvent: *e.(*idp.BitbucketIDPChangedEvent)}, nil
+}
+
+type DiscordIDPAddedEvent struct {
+	idp.DiscordIDPAddedEvent
+}
+
+func NewDiscordIDPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options idp.Options,
+) *DiscordIDPAddedEvent {
+
+	return &DiscordIDPAddedEvent{
+		DiscordIDPAddedEvent: *idp.NewDiscordIDPAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				DiscordIDPAddedEventType,
+			),
+			id,
+			name,
+			clientID,
+			clientSecret,
+			scopes,
+			options,
+		),
+	}
+}
+
+func DiscordIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.DiscordIDPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiscordIDPAddedEvent{DiscordIDPAddedEvent: *e.(*idp.DiscordIDPAddedEvent)}, nil
+}
+
+type DiscordIDPChangedEvent struct {
+	idp.DiscordIDPChangedEvent
+}
+
+func NewDiscordIDPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []idp.DiscordIDPChanges,
+) (*DiscordIDPChangedEvent, error) {
+
+	changedEvent, err := idp.NewDiscordIDPChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DiscordIDPChangedEventType,
+		),
+		id,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &DiscordIDPChangedEvent{DiscordIDPChangedEvent: *changedEvent}, nil
+}
+
+func DiscordIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.DiscordIDPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiscordIDPChangedEvent{DiscordIDPChangedEvent: *e.(*idp.DiscordIDPChangedEvent)}, nil
+}
+
+type SlackIDPAddedEvent struct {
+	idp.SlackIDPAddedEvent
+}
+
+func NewSlackIDPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options idp.Options,
+) *SlackIDPAddedEvent {
+
+	return &SlackIDPAddedEvent{
+		SlackIDPAddedEvent: *idp.NewSlackIDPAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SlackIDPAddedEventType,
+			),
+			id,
+			name,
+			clientID,
+			clientSecret,
+			scopes,
+			options,
+		),
+	}
+}
+
+func SlackIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.SlackIDPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SlackIDPAddedEvent{SlackIDPAddedEvent: *e.(*idp.SlackIDPAddedEvent)}, nil
+}
+
+type SlackIDPChangedEvent struct {
+	idp.SlackIDPChangedEvent
+}
+
+func NewSlackIDPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []idp.SlackIDPChanges,
+) (*SlackIDPChangedEvent, error) {
+
+	changedEvent, err := idp.NewSlackIDPChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SlackIDPChangedEventType,
+		),
+		id,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &SlackIDPChangedEvent{SlackIDPChangedEvent: *changedEvent}, nil
+}
+
+func SlackIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.SlackIDPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SlackIDPChangedEvent{SlackIDPChangedEvent: *e.(*idp.SlackIDPChangedEvent)}, nil
+}
+
 type GitLabSelfHostedIDPAddedEvent struct {
 	idp.GitLabSelfHostedIDPAddedEvent
 }
@@ -758,6 +1006,166 @@ func GitLabSelfHostedIDPChangedEventMapper(event eventstore.Event) (eventstore.E
 	return &GitLabSelfHostedIDPChangedEvent{GitLabSelfHostedIDPChangedEvent: *e.(*idp.GitLabSelfHostedIDPChangedEvent)}, nil
 }
 
+type KeycloakIDPAddedEvent struct {
+	idp.KeycloakIDPAddedEvent
+}
+
+func NewKeycloakIDPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	baseURL,
+	realm,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options idp.Options,
+) *KeycloakIDPAddedEvent {
+
+	return &KeycloakIDPAddedEvent{
+		KeycloakIDPAddedEvent: *idp.NewKeycloakIDPAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				KeycloakIDPAddedEventType,
+			),
+			id,
+			name,
+			baseURL,
+			realm,
+			clientID,
+			clientSecret,
+			scopes,
+			options,
+		),
+	}
+}
+
+func KeycloakIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.KeycloakIDPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeycloakIDPAddedEvent{KeycloakIDPAddedEvent: *e.(*idp.KeycloakIDPAddedEvent)}, nil
+}
+
+type KeycloakIDPChangedEvent struct {
+	idp.KeycloakIDPChangedEvent
+}
+
+func NewKeycloakIDPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []idp.KeycloakIDPChanges,
+) (*KeycloakIDPChangedEvent, error) {
+
+	changedEvent, err := idp.NewKeycloakIDPChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			KeycloakIDPChangedEventType,
+		),
+		id,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &KeycloakIDPChangedEvent{KeycloakIDPChangedEvent: *changedEvent}, nil
+}
+
+func KeycloakIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.KeycloakIDPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeycloakIDPChangedEvent{KeycloakIDPChangedEvent: *e.(*idp.KeycloakIDPChangedEvent)}, nil
+}
+
+type OktaIDPAddedEvent struct {
+	idp.OktaIDPAddedEvent
+}
+
+func NewOktaIDPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	issuer,
+	authorizationServerID,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options idp.Options,
+) *OktaIDPAddedEvent {
+
+	return &OktaIDPAddedEvent{
+		OktaIDPAddedEvent: *idp.NewOktaIDPAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				OktaIDPAddedEventType,
+			),
+			id,
+			name,
+			issuer,
+			authorizationServerID,
+			clientID,
+			clientSecret,
+			scopes,
+			options,
+		),
+	}
+}
+
+func OktaIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.OktaIDPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OktaIDPAddedEvent{OktaIDPAddedEvent: *e.(*idp.OktaIDPAddedEvent)}, nil
+}
+
+type OktaIDPChangedEvent struct {
+	idp.OktaIDPChangedEvent
+}
+
+func NewOktaIDPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []idp.OktaIDPChanges,
+) (*OktaIDPChangedEvent, error) {
+
+	changedEvent, err := idp.NewOktaIDPChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OktaIDPChangedEventType,
+		),
+		id,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &OktaIDPChangedEvent{OktaIDPChangedEvent: *changedEvent}, nil
+}
+
+func OktaIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.OktaIDPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OktaIDPChangedEvent{OktaIDPChangedEvent: *e.(*idp.OktaIDPChangedEvent)}, nil
+}
+
 type GoogleIDPAddedEvent struct {
 	idp.GoogleIDPAddedEvent
 }
@@ -853,6 +1261,7 @@ func NewLDAPIDPAddedEvent(
 	userFilters []string,
 	timeout time.Duration,
 	attributes idp.LDAPAttributes,
+	sync *idp.LDAPSync,
 	options idp.Options,
 ) *LDAPIDPAddedEvent {
 
@@ -875,6 +1284,7 @@ func NewLDAPIDPAddedEvent(
 			userFilters,
 			timeout,
 			attributes,
+			sync,
 			options,
 		),
 	}
@@ -923,6 +1333,38 @@ func LDAPIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error)
 	return &LDAPIDPChangedEvent{LDAPIDPChangedEvent: *e.(*idp.LDAPIDPChangedEvent)}, nil
 }
 
+type LDAPIDPSyncedEvent struct {
+	idp.LDAPIDPSyncedEvent
+}
+
+func NewLDAPIDPSyncedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	report *idp.LDAPSyncReport,
+) *LDAPIDPSyncedEvent {
+	return &LDAPIDPSyncedEvent{
+		LDAPIDPSyncedEvent: *idp.NewLDAPIDPSyncedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				LDAPIDPSyncedEventType,
+			),
+			id,
+			report,
+		),
+	}
+}
+
+func LDAPIDPSyncedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.LDAPIDPSyncedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LDAPIDPSyncedEvent{LDAPIDPSyncedEvent: *e.(*idp.LDAPIDPSyncedEvent)}, nil
+}
+
 type AppleIDPAddedEvent struct {
 	idp.AppleIDPAddedEvent
 }
@@ -1019,6 +1461,7 @@ func NewSAMLIDPAddedEvent(
 	withSignedRequest bool,
 	nameIDFormat *domain.SAMLNameIDFormat,
 	transientMappingAttributeName string,
+	claimMappings idp.ClaimMappings,
 	options idp.Options,
 ) *SAMLIDPAddedEvent {
 	return &SAMLIDPAddedEvent{
@@ -1037,6 +1480,7 @@ func NewSAMLIDPAddedEvent(
 			withSignedRequest,
 			nameIDFormat,
 			transientMappingAttributeName,
+			claimMappings,
 			options,
 		),
 	}
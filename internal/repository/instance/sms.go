@@ -17,6 +17,7 @@ const (
 	SMSConfigActivatedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "activated"
 	SMSConfigDeactivatedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "deactivated"
 	SMSConfigRemovedEventType            = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "removed"
+	SMSConfigPriorityChangedEventType    = instanceEventTypePrefix + smsConfigPrefix + "priority.changed"
 )
 
 type SMSConfigTwilioAddedEvent struct {
@@ -297,3 +298,46 @@ func SMSConfigRemovedEventMapper(event eventstore.Event) (eventstore.Event, erro
 
 	return smsConfigRemoved, nil
 }
+
+type SMSConfigPriorityChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	ID                   string `json:"id,omitempty"`
+	Priority             uint32 `json:"priority,omitempty"`
+}
+
+func NewSMSConfigPriorityChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	priority uint32,
+) *SMSConfigPriorityChangedEvent {
+	return &SMSConfigPriorityChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigPriorityChangedEventType,
+		),
+		ID:       id,
+		Priority: priority,
+	}
+}
+
+func (e *SMSConfigPriorityChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigPriorityChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigPriorityChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smsConfigPriorityChanged := &SMSConfigPriorityChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smsConfigPriorityChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Gd8zTq4wMu", "unable to unmarshal sms config priority changed")
+	}
+
+	return smsConfigPriorityChanged, nil
+}
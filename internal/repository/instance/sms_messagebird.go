@@ -0,0 +1,130 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	smsConfigMessageBirdPrefix           = "messagebird."
+	SMSConfigMessageBirdAddedEventType   = instanceEventTypePrefix + smsConfigPrefix + smsConfigMessageBirdPrefix + "added"
+	SMSConfigMessageBirdChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigMessageBirdPrefix + "changed"
+)
+
+type SMSConfigMessageBirdAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID         string              `json:"id,omitempty"`
+	AccessKey  *crypto.CryptoValue `json:"accessKey,omitempty"`
+	Originator string              `json:"originator,omitempty"`
+	Priority   uint32              `json:"priority,omitempty"`
+}
+
+func NewSMSConfigMessageBirdAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	accessKey *crypto.CryptoValue,
+	originator string,
+	priority uint32,
+) *SMSConfigMessageBirdAddedEvent {
+	return &SMSConfigMessageBirdAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigMessageBirdAddedEventType,
+		),
+		ID:         id,
+		AccessKey:  accessKey,
+		Originator: originator,
+		Priority:   priority,
+	}
+}
+
+func (e *SMSConfigMessageBirdAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigMessageBirdAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigMessageBirdAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smsConfigAdded := &SMSConfigMessageBirdAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smsConfigAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Mq7bXn3cVr", "unable to unmarshal sms config messagebird added")
+	}
+
+	return smsConfigAdded, nil
+}
+
+type SMSConfigMessageBirdChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID         string              `json:"id,omitempty"`
+	AccessKey  *crypto.CryptoValue `json:"accessKey,omitempty"`
+	Originator *string             `json:"originator,omitempty"`
+}
+
+func NewSMSConfigMessageBirdChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigMessageBirdChanges,
+) (*SMSConfigMessageBirdChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-Wc2sLz8hOe", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigMessageBirdChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigMessageBirdChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigMessageBirdChanges func(event *SMSConfigMessageBirdChangedEvent)
+
+func ChangeSMSConfigMessageBirdAccessKey(accessKey *crypto.CryptoValue) func(event *SMSConfigMessageBirdChangedEvent) {
+	return func(e *SMSConfigMessageBirdChangedEvent) {
+		e.AccessKey = accessKey
+	}
+}
+
+func ChangeSMSConfigMessageBirdOriginator(originator string) func(event *SMSConfigMessageBirdChangedEvent) {
+	return func(e *SMSConfigMessageBirdChangedEvent) {
+		e.Originator = &originator
+	}
+}
+
+func (e *SMSConfigMessageBirdChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigMessageBirdChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigMessageBirdChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smsConfigChanged := &SMSConfigMessageBirdChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smsConfigChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Yh5vJt0rQx", "unable to unmarshal sms config messagebird changed")
+	}
+
+	return smsConfigChanged, nil
+}
@@ -213,6 +213,7 @@ func NewSMTPConfigPasswordChangedEvent(
 			aggregate,
 			SMTPConfigPasswordChangedEventType,
 		),
+		ID:       id,
 		Password: password,
 	}
 }
@@ -0,0 +1,209 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix    = AggregateType + "."
+	RequestedType      = eventTypePrefix + "requested"
+	SentType           = eventTypePrefix + "sent"
+	FailedType         = eventTypePrefix + "failed"
+	RetryRequestedType = eventTypePrefix + "retry.requested"
+	DeadLetteredType   = eventTypePrefix + "dead.lettered"
+)
+
+type RequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	UserID               string                   `json:"userID"`
+	NotificationType     domain.NotificationType  `json:"notificationType"`
+	MessageType          string                   `json:"messageType"`
+	TriggerAggregateType eventstore.AggregateType `json:"triggerAggregateType"`
+	TriggerAggregateID   string                   `json:"triggerAggregateID"`
+	TriggerEventType     eventstore.EventType     `json:"triggerEventType"`
+	TriggerSequence      uint64                   `json:"triggerSequence"`
+}
+
+func (e *RequestedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *RequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	notificationType domain.NotificationType,
+	messageType string,
+	triggerAggregateType eventstore.AggregateType,
+	triggerAggregateID string,
+	triggerEventType eventstore.EventType,
+	triggerSequence uint64,
+) *RequestedEvent {
+	return &RequestedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RequestedType,
+		),
+		UserID:               userID,
+		NotificationType:     notificationType,
+		MessageType:          messageType,
+		TriggerAggregateType: triggerAggregateType,
+		TriggerAggregateID:   triggerAggregateID,
+		TriggerEventType:     triggerEventType,
+		TriggerSequence:      triggerSequence,
+	}
+}
+
+type SentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *SentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *SentEvent {
+	return &SentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SentType,
+		),
+	}
+}
+
+// FailedEvent is pushed if the delivery of a notification failed.
+// The delivery is retried after RetryAt.
+type FailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Error   string    `json:"error,omitempty"`
+	RetryAt time.Time `json:"retryAt"`
+}
+
+func (e *FailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *FailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *FailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	err error,
+	retryAt time.Time,
+) *FailedEvent {
+	return &FailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			FailedType,
+		),
+		Error:   errorMessage(err),
+		RetryAt: retryAt,
+	}
+}
+
+// RetryRequestedEvent is pushed if a failed notification is resent manually.
+// It resets the attempts of the notification.
+type RetryRequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RetryRequestedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *RetryRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RetryRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRetryRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *RetryRequestedEvent {
+	return &RetryRequestedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RetryRequestedType,
+		),
+	}
+}
+
+// DeadLetteredEvent is pushed if a notification will not be retried anymore,
+// e.g. because the maximum number of attempts is reached or the code expired.
+type DeadLetteredEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Error string `json:"error,omitempty"`
+}
+
+func (e *DeadLetteredEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *DeadLetteredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DeadLetteredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeadLetteredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	err error,
+) *DeadLetteredEvent {
+	return &DeadLetteredEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeadLetteredType,
+		),
+		Error: errorMessage(err),
+	}
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
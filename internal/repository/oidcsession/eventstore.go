@@ -11,5 +11,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, RefreshTokenAddedType, eventstore.GenericEventMapper[RefreshTokenAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, RefreshTokenRenewedType, eventstore.GenericEventMapper[RefreshTokenRenewedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, RefreshTokenRevokedType, eventstore.GenericEventMapper[RefreshTokenRevokedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, BackChannelLogoutSentType, eventstore.GenericEventMapper[BackChannelLogoutSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, BackChannelLogoutFailedType, eventstore.GenericEventMapper[BackChannelLogoutFailedEvent])
 
 }
@@ -11,13 +11,15 @@ import (
 )
 
 const (
-	oidcSessionEventPrefix  = "oidc_session."
-	AddedType               = oidcSessionEventPrefix + "added"
-	AccessTokenAddedType    = oidcSessionEventPrefix + "access_token.added"
-	AccessTokenRevokedType  = oidcSessionEventPrefix + "access_token.revoked"
-	RefreshTokenAddedType   = oidcSessionEventPrefix + "refresh_token.added"
-	RefreshTokenRenewedType = oidcSessionEventPrefix + "refresh_token.renewed"
-	RefreshTokenRevokedType = oidcSessionEventPrefix + "refresh_token.revoked"
+	oidcSessionEventPrefix      = "oidc_session."
+	AddedType                   = oidcSessionEventPrefix + "added"
+	AccessTokenAddedType        = oidcSessionEventPrefix + "access_token.added"
+	AccessTokenRevokedType      = oidcSessionEventPrefix + "access_token.revoked"
+	RefreshTokenAddedType       = oidcSessionEventPrefix + "refresh_token.added"
+	RefreshTokenRenewedType     = oidcSessionEventPrefix + "refresh_token.renewed"
+	RefreshTokenRevokedType     = oidcSessionEventPrefix + "refresh_token.revoked"
+	BackChannelLogoutSentType   = oidcSessionEventPrefix + "back_channel_logout.sent"
+	BackChannelLogoutFailedType = oidcSessionEventPrefix + "back_channel_logout.failed"
 )
 
 type AddedEvent struct {
@@ -34,6 +36,7 @@ type AddedEvent struct {
 	Nonce             string                      `json:"nonce,omitempty"`
 	PreferredLanguage *language.Tag               `json:"preferredLanguage,omitempty"`
 	UserAgent         *domain.UserAgent           `json:"userAgent,omitempty"`
+	DPoPJKT           string                      `json:"dpopJKT,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -61,6 +64,7 @@ func NewAddedEvent(ctx context.Context,
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	dpopJKT string,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -79,6 +83,7 @@ func NewAddedEvent(ctx context.Context,
 		Nonce:             nonce,
 		PreferredLanguage: preferredLanguage,
 		UserAgent:         userAgent,
+		DPoPJKT:           dpopJKT,
 	}
 }
 
@@ -259,3 +264,75 @@ func NewRefreshTokenRevokedEvent(
 		),
 	}
 }
+
+type BackChannelLogoutSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID string `json:"clientID,omitempty"`
+}
+
+func (e *BackChannelLogoutSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *BackChannelLogoutSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *BackChannelLogoutSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewBackChannelLogoutSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+) *BackChannelLogoutSentEvent {
+	return &BackChannelLogoutSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			BackChannelLogoutSentType,
+		),
+		ClientID: clientID,
+	}
+}
+
+type BackChannelLogoutFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID string `json:"clientID,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (e *BackChannelLogoutFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *BackChannelLogoutFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *BackChannelLogoutFailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewBackChannelLogoutFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+	err error,
+) *BackChannelLogoutFailedEvent {
+	event := &BackChannelLogoutFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			BackChannelLogoutFailedType,
+		),
+		ClientID: clientID,
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	return event
+}
@@ -31,20 +31,24 @@ type OIDCConfigAddedEvent struct {
 	ClientSecret *crypto.CryptoValue `json:"clientSecret,omitempty"`
 	HashedSecret string              `json:"hashedSecret,omitempty"`
 
-	RedirectUris             []string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   []string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  bool                       `json:"devMode,omitempty"`
-	AccessTokenType          domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        []string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	RedirectUris                     []string                   `json:"redirectUris,omitempty"`
+	ResponseTypes                    []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                       []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType                  domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType                   domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris           []string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                          bool                       `json:"devMode,omitempty"`
+	AccessTokenType                  domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion         bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion             bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion         bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                        time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins                []string                   `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage         bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI             string                     `json:"backChannelLogoutURI,omitempty"`
+	BackChannelLogoutSessionRequired bool                       `json:"backChannelLogoutSessionRequired,omitempty"`
+	DPoPBoundAccessTokens            bool                       `json:"dpopBoundAccessTokens,omitempty"`
+	RequirePushedAuthRequests        bool                       `json:"requirePushedAuthRequests,omitempty"`
 }
 
 func (e *OIDCConfigAddedEvent) Payload() interface{} {
@@ -76,6 +80,10 @@ func NewOIDCConfigAddedEvent(
 	clockSkew time.Duration,
 	additionalOrigins []string,
 	skipNativeAppSuccessPage bool,
+	backChannelLogoutURI string,
+	backChannelLogoutSessionRequired bool,
+	dpopBoundAccessTokens bool,
+	requirePushedAuthRequests bool,
 ) *OIDCConfigAddedEvent {
 	return &OIDCConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -83,24 +91,28 @@ func NewOIDCConfigAddedEvent(
 			aggregate,
 			OIDCConfigAddedType,
 		),
-		Version:                  version,
-		AppID:                    appID,
-		ClientID:                 clientID,
-		HashedSecret:             hashedSecret,
-		RedirectUris:             redirectUris,
-		ResponseTypes:            responseTypes,
-		GrantTypes:               grantTypes,
-		ApplicationType:          applicationType,
-		AuthMethodType:           authMethodType,
-		PostLogoutRedirectUris:   postLogoutRedirectUris,
-		DevMode:                  devMode,
-		AccessTokenType:          accessTokenType,
-		AccessTokenRoleAssertion: accessTokenRoleAssertion,
-		IDTokenRoleAssertion:     idTokenRoleAssertion,
-		IDTokenUserinfoAssertion: idTokenUserinfoAssertion,
-		ClockSkew:                clockSkew,
-		AdditionalOrigins:        additionalOrigins,
-		SkipNativeAppSuccessPage: skipNativeAppSuccessPage,
+		Version:                          version,
+		AppID:                            appID,
+		ClientID:                         clientID,
+		HashedSecret:                     hashedSecret,
+		RedirectUris:                     redirectUris,
+		ResponseTypes:                    responseTypes,
+		GrantTypes:                       grantTypes,
+		ApplicationType:                  applicationType,
+		AuthMethodType:                   authMethodType,
+		PostLogoutRedirectUris:           postLogoutRedirectUris,
+		DevMode:                          devMode,
+		AccessTokenType:                  accessTokenType,
+		AccessTokenRoleAssertion:         accessTokenRoleAssertion,
+		IDTokenRoleAssertion:             idTokenRoleAssertion,
+		IDTokenUserinfoAssertion:         idTokenUserinfoAssertion,
+		ClockSkew:                        clockSkew,
+		AdditionalOrigins:                additionalOrigins,
+		SkipNativeAppSuccessPage:         skipNativeAppSuccessPage,
+		BackChannelLogoutURI:             backChannelLogoutURI,
+		BackChannelLogoutSessionRequired: backChannelLogoutSessionRequired,
+		DPoPBoundAccessTokens:            dpopBoundAccessTokens,
+		RequirePushedAuthRequests:        requirePushedAuthRequests,
 	}
 }
 
@@ -186,7 +198,19 @@ func (e *OIDCConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 			return false
 		}
 	}
-	return e.SkipNativeAppSuccessPage == c.SkipNativeAppSuccessPage
+	if e.SkipNativeAppSuccessPage != c.SkipNativeAppSuccessPage {
+		return false
+	}
+	if e.BackChannelLogoutURI != c.BackChannelLogoutURI {
+		return false
+	}
+	if e.BackChannelLogoutSessionRequired != c.BackChannelLogoutSessionRequired {
+		return false
+	}
+	if e.DPoPBoundAccessTokens != c.DPoPBoundAccessTokens {
+		return false
+	}
+	return e.RequirePushedAuthRequests == c.RequirePushedAuthRequests
 }
 
 func OIDCConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
@@ -205,22 +229,26 @@ func OIDCConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error
 type OIDCConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Version                  *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
-	AppID                    string                      `json:"appId"`
-	RedirectUris             *[]string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          *domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   *[]string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  *bool                       `json:"devMode,omitempty"`
-	AccessTokenType          *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion *bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     *bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                *time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        *[]string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	Version                          *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
+	AppID                            string                      `json:"appId"`
+	RedirectUris                     *[]string                   `json:"redirectUris,omitempty"`
+	ResponseTypes                    *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                       *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType                  *domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType                   *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris           *[]string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                          *bool                       `json:"devMode,omitempty"`
+	AccessTokenType                  *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion         *bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion             *bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion         *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                        *time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins                *[]string                   `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage         *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI             *string                     `json:"backChannelLogoutURI,omitempty"`
+	BackChannelLogoutSessionRequired *bool                       `json:"backChannelLogoutSessionRequired,omitempty"`
+	DPoPBoundAccessTokens            *bool                       `json:"dpopBoundAccessTokens,omitempty"`
+	RequirePushedAuthRequests        *bool                       `json:"requirePushedAuthRequests,omitempty"`
 }
 
 func (e *OIDCConfigChangedEvent) Payload() interface{} {
@@ -347,6 +375,30 @@ func ChangeSkipNativeAppSuccessPage(skipNativeAppSuccessPage bool) func(event *O
 	}
 }
 
+func ChangeBackChannelLogoutURI(backChannelLogoutURI string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.BackChannelLogoutURI = &backChannelLogoutURI
+	}
+}
+
+func ChangeBackChannelLogoutSessionRequired(backChannelLogoutSessionRequired bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.BackChannelLogoutSessionRequired = &backChannelLogoutSessionRequired
+	}
+}
+
+func ChangeDPoPBoundAccessTokens(dpopBoundAccessTokens bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.DPoPBoundAccessTokens = &dpopBoundAccessTokens
+	}
+}
+
+func ChangeRequirePushedAuthRequests(requirePushedAuthRequests bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequirePushedAuthRequests = &requirePushedAuthRequests
+	}
+}
+
 func OIDCConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &OIDCConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
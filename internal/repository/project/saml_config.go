@@ -16,10 +16,11 @@ const (
 type SAMLConfigAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AppID       string `json:"appId"`
-	EntityID    string `json:"entityId"`
-	Metadata    []byte `json:"metadata,omitempty"`
-	MetadataURL string `json:"metadata_url,omitempty"`
+	AppID               string `json:"appId"`
+	EntityID            string `json:"entityId"`
+	Metadata            []byte `json:"metadata,omitempty"`
+	MetadataURL         string `json:"metadata_url,omitempty"`
+	AllowUnsignedLogout bool   `json:"allowUnsignedLogout,omitempty"`
 }
 
 func (e *SAMLConfigAddedEvent) Payload() interface{} {
@@ -50,6 +51,7 @@ func NewSAMLConfigAddedEvent(
 	entityID string,
 	metadata []byte,
 	metadataURL string,
+	allowUnsignedLogout bool,
 ) *SAMLConfigAddedEvent {
 	return &SAMLConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -57,10 +59,11 @@ func NewSAMLConfigAddedEvent(
 			aggregate,
 			SAMLConfigAddedType,
 		),
-		AppID:       appID,
-		EntityID:    entityID,
-		Metadata:    metadata,
-		MetadataURL: metadataURL,
+		AppID:               appID,
+		EntityID:            entityID,
+		Metadata:            metadata,
+		MetadataURL:         metadataURL,
+		AllowUnsignedLogout: allowUnsignedLogout,
 	}
 }
 
@@ -80,11 +83,12 @@ func SAMLConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error
 type SAMLConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AppID       string  `json:"appId"`
-	EntityID    string  `json:"entityId"`
-	Metadata    []byte  `json:"metadata,omitempty"`
-	MetadataURL *string `json:"metadata_url,omitempty"`
-	oldEntityID string
+	AppID               string  `json:"appId"`
+	EntityID            string  `json:"entityId"`
+	Metadata            []byte  `json:"metadata,omitempty"`
+	MetadataURL         *string `json:"metadata_url,omitempty"`
+	AllowUnsignedLogout *bool   `json:"allowUnsignedLogout,omitempty"`
+	oldEntityID         string
 }
 
 func (e *SAMLConfigChangedEvent) Payload() interface{} {
@@ -147,6 +151,12 @@ func ChangeEntityID(entityID string) func(event *SAMLConfigChangedEvent) {
 	}
 }
 
+func ChangeAllowUnsignedLogout(allowUnsignedLogout bool) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.AllowUnsignedLogout = &allowUnsignedLogout
+	}
+}
+
 func SAMLConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &SAMLConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
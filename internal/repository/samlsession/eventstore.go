@@ -0,0 +1,12 @@
+package samlsession
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AddedType, eventstore.GenericEventMapper[AddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, LogoutRequestedType, eventstore.GenericEventMapper[LogoutRequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, LogoutSentType, eventstore.GenericEventMapper[LogoutSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, TerminatedType, eventstore.GenericEventMapper[TerminatedEvent])
+}
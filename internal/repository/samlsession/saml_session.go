@@ -0,0 +1,181 @@
+package samlsession
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	samlSessionEventPrefix = "saml_session."
+	AddedType              = samlSessionEventPrefix + "added"
+	LogoutRequestedType    = samlSessionEventPrefix + "logout.requested"
+	LogoutSentType         = samlSessionEventPrefix + "logout.sent"
+	TerminatedType         = samlSessionEventPrefix + "terminated"
+)
+
+// AddedEvent is pushed for every SAML response issued to a service provider,
+// so the service provider can be notified on the logout of the user.
+// The SessionID links the SAML session to the (v2) session, the response was issued for, if there is one.
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID            string            `json:"userID"`
+	UserResourceOwner string            `json:"userResourceOwner"`
+	SessionID         string            `json:"sessionID,omitempty"`
+	AppID             string            `json:"appID"`
+	EntityID          string            `json:"entityID"`
+	Issuer            string            `json:"issuer"`
+	NameID            string            `json:"nameID"`
+	UserAgent         *domain.UserAgent `json:"userAgent,omitempty"`
+}
+
+func (e *AddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *AddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewAddedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	userResourceOwner,
+	sessionID,
+	appID,
+	entityID,
+	issuer,
+	nameID string,
+	userAgent *domain.UserAgent,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AddedType,
+		),
+		UserID:            userID,
+		UserResourceOwner: userResourceOwner,
+		SessionID:         sessionID,
+		AppID:             appID,
+		EntityID:          entityID,
+		Issuer:            issuer,
+		NameID:            nameID,
+		UserAgent:         userAgent,
+	}
+}
+
+// LogoutRequestedEvent is pushed when the service provider initiated the logout.
+// It contains everything needed to send the logout response, after the other service providers were logged out.
+type LogoutRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	RequestID   string `json:"requestID"`
+	RelayState  string `json:"relayState,omitempty"`
+	Binding     string `json:"binding"`
+	ResponseURL string `json:"responseURL"`
+}
+
+func (e *LogoutRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LogoutRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *LogoutRequestedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewLogoutRequestedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	requestID,
+	relayState,
+	binding,
+	responseURL string,
+) *LogoutRequestedEvent {
+	return &LogoutRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LogoutRequestedType,
+		),
+		RequestID:   requestID,
+		RelayState:  relayState,
+		Binding:     binding,
+		ResponseURL: responseURL,
+	}
+}
+
+// LogoutSentEvent is pushed when a logout request was sent to the service provider over the front-channel.
+// The request id is used to correlate the logout response of the service provider.
+type LogoutSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	RequestID string `json:"requestID"`
+	Binding   string `json:"binding"`
+}
+
+func (e *LogoutSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LogoutSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *LogoutSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewLogoutSentEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	requestID,
+	binding string,
+) *LogoutSentEvent {
+	return &LogoutSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LogoutSentType,
+		),
+		RequestID: requestID,
+		Binding:   binding,
+	}
+}
+
+// TerminatedEvent is pushed when the service provider was logged out.
+type TerminatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *TerminatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *TerminatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *TerminatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewTerminatedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *TerminatedEvent {
+	return &TerminatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			TerminatedType,
+		),
+	}
+}
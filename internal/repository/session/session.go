@@ -651,6 +651,8 @@ func NewLifetimeSetEvent(
 
 type TerminateEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	TriggeredAtOrigin string `json:"triggerOrigin,omitempty"`
 }
 
 func (e *TerminateEvent) Payload() interface{} {
@@ -661,6 +663,10 @@ func (e *TerminateEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
 
+func (e *TerminateEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
 func NewTerminateEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
@@ -671,11 +677,18 @@ func NewTerminateEvent(
 			aggregate,
 			TerminateType,
 		),
+		TriggeredAtOrigin: http.ComposedOrigin(ctx),
 	}
 }
 
 func TerminateEventMapper(event eventstore.Event) (eventstore.Event, error) {
-	return &TerminateEvent{
+	terminated := &TerminateEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
-	}, nil
+	}
+	err := event.Unmarshal(terminated)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SESSION-Pe4ai", "unable to unmarshal session terminated")
+	}
+
+	return terminated, nil
 }
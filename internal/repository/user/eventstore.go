@@ -4,6 +4,22 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 )
 
+// humanPersonalDataFields are the fields of human events which are encrypted
+// if personal data encryption is enabled
+var humanPersonalDataFields = []string{
+	"firstName",
+	"lastName",
+	"nickName",
+	"displayName",
+	"email",
+	"phone",
+	"country",
+	"locality",
+	"postalCode",
+	"region",
+	"streetAddress",
+}
+
 func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, UserV1AddedType, HumanAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserV1RegisteredType, HumanRegisteredEventMapper)
@@ -137,4 +153,23 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineSecretCheckSucceededType, MachineSecretCheckSucceededEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineSecretCheckFailedType, MachineSecretCheckFailedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineSecretHashUpdatedType, eventstore.GenericEventMapper[MachineSecretHashUpdatedEvent])
+
+	for _, eventType := range []eventstore.EventType{
+		UserV1AddedType,
+		HumanAddedType,
+		UserV1RegisteredType,
+		HumanRegisteredType,
+		UserV1ProfileChangedType,
+		HumanProfileChangedType,
+		UserV1EmailChangedType,
+		HumanEmailChangedType,
+		UserV1PhoneChangedType,
+		HumanPhoneChangedType,
+		UserV1AddressChangedType,
+		HumanAddressChangedType,
+	} {
+		eventstore.RegisterPersonalData(eventType, humanPersonalDataFields...)
+	}
+	eventstore.RegisterPersonalData(UserIDPLinkAddedType, "displayName")
+	eventstore.RegisterPersonalDataErasure(UserRemovedType)
 }
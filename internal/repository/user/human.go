@@ -382,13 +382,18 @@ func HumanInitializedCheckFailedEventMapper(event eventstore.Event) (eventstore.
 type HumanSignedOutEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	UserAgentID string `json:"userAgentID"`
+	UserAgentID       string `json:"userAgentID"`
+	TriggeredAtOrigin string `json:"triggerOrigin,omitempty"`
 }
 
 func (e *HumanSignedOutEvent) Payload() interface{} {
 	return e
 }
 
+func (e *HumanSignedOutEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
 func (e *HumanSignedOutEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
@@ -404,7 +409,8 @@ func NewHumanSignedOutEvent(
 			aggregate,
 			HumanSignedOutType,
 		),
-		UserAgentID: userAgentID,
+		UserAgentID:       userAgentID,
+		TriggeredAtOrigin: http.ComposedOrigin(ctx),
 	}
 }
 
@@ -20,9 +20,10 @@ type MachineAddedEvent struct {
 	UserName              string `json:"userName"`
 	userLoginMustBeDomain bool
 
-	Name            string               `json:"name,omitempty"`
-	Description     string               `json:"description,omitempty"`
-	AccessTokenType domain.OIDCTokenType `json:"accessTokenType,omitempty"`
+	Name                  string               `json:"name,omitempty"`
+	Description           string               `json:"description,omitempty"`
+	AccessTokenType       domain.OIDCTokenType `json:"accessTokenType,omitempty"`
+	DPoPBoundAccessTokens bool                 `json:"dpopBoundAccessTokens,omitempty"`
 }
 
 func (e *MachineAddedEvent) Payload() interface{} {
@@ -41,6 +42,7 @@ func NewMachineAddedEvent(
 	description string,
 	userLoginMustBeDomain bool,
 	accessTokenType domain.OIDCTokenType,
+	dpopBoundAccessTokens bool,
 ) *MachineAddedEvent {
 	return &MachineAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -53,6 +55,7 @@ func NewMachineAddedEvent(
 		Description:           description,
 		userLoginMustBeDomain: userLoginMustBeDomain,
 		AccessTokenType:       accessTokenType,
+		DPoPBoundAccessTokens: dpopBoundAccessTokens,
 	}
 }
 
@@ -71,9 +74,10 @@ func MachineAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 type MachineChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Name            *string               `json:"name,omitempty"`
-	Description     *string               `json:"description,omitempty"`
-	AccessTokenType *domain.OIDCTokenType `json:"accessTokenType,omitempty"`
+	Name                  *string               `json:"name,omitempty"`
+	Description           *string               `json:"description,omitempty"`
+	AccessTokenType       *domain.OIDCTokenType `json:"accessTokenType,omitempty"`
+	DPoPBoundAccessTokens *bool                 `json:"dpopBoundAccessTokens,omitempty"`
 }
 
 func (e *MachineChangedEvent) Payload() interface{} {
@@ -125,6 +129,12 @@ func ChangeAccessTokenType(accessTokenType domain.OIDCTokenType) func(event *Mac
 	}
 }
 
+func ChangeDPoPBoundAccessTokens(dpopBoundAccessTokens bool) func(event *MachineChangedEvent) {
+	return func(e *MachineChangedEvent) {
+		e.DPoPBoundAccessTokens = &dpopBoundAccessTokens
+	}
+}
+
 func MachineChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	machineChanged := &MachineChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
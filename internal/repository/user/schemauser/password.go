@@ -0,0 +1,133 @@
+package schemauser
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	PasswordUpdatedType   = eventPrefix + "password.updated"
+	PasswordCodeAddedType = eventPrefix + "password.code.added"
+	PasswordCodeSentType  = eventPrefix + "password.code.sent"
+)
+
+type PasswordUpdatedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	EncodedHash    string `json:"encodedHash,omitempty"`
+	ChangeRequired bool   `json:"changeRequired,omitempty"`
+}
+
+func (e *PasswordUpdatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PasswordUpdatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PasswordUpdatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPasswordUpdatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	encodedHash string,
+	changeRequired bool,
+) *PasswordUpdatedEvent {
+	return &PasswordUpdatedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PasswordUpdatedType,
+		),
+		EncodedHash:    encodedHash,
+		ChangeRequired: changeRequired,
+	}
+}
+
+type PasswordCodeAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Code              *crypto.CryptoValue     `json:"code,omitempty"`
+	Expiry            time.Duration           `json:"expiry,omitempty"`
+	NotificationType  domain.NotificationType `json:"notificationType,omitempty"`
+	URLTemplate       string                  `json:"url_template,omitempty"`
+	CodeReturned      bool                    `json:"code_returned,omitempty"`
+	TriggeredAtOrigin string                  `json:"triggerOrigin,omitempty"`
+}
+
+func (e *PasswordCodeAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PasswordCodeAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PasswordCodeAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PasswordCodeAddedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewPasswordCodeAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+	notificationType domain.NotificationType,
+	urlTemplate string,
+	codeReturned bool,
+) *PasswordCodeAddedEvent {
+	return &PasswordCodeAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PasswordCodeAddedType,
+		),
+		Code:              code,
+		Expiry:            expiry,
+		NotificationType:  notificationType,
+		URLTemplate:       urlTemplate,
+		CodeReturned:      codeReturned,
+		TriggeredAtOrigin: http.ComposedOrigin(ctx),
+	}
+}
+
+type PasswordCodeSentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PasswordCodeSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PasswordCodeSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PasswordCodeSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPasswordCodeSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PasswordCodeSentEvent {
+	return &PasswordCodeSentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PasswordCodeSentType,
+		),
+	}
+}
@@ -0,0 +1,184 @@
+package schemauser
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	PhoneUpdatedType            = eventPrefix + "phone.updated"
+	PhoneCodeAddedType          = eventPrefix + "phone.code.added"
+	PhoneVerifiedType           = eventPrefix + "phone.verified"
+	PhoneVerificationFailedType = eventPrefix + "phone.verification.failed"
+	PhoneCodeSentType           = eventPrefix + "phone.code.sent"
+)
+
+type PhoneUpdatedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Number domain.PhoneNumber `json:"number"`
+}
+
+func (e *PhoneUpdatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PhoneUpdatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PhoneUpdatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPhoneUpdatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	number domain.PhoneNumber,
+) *PhoneUpdatedEvent {
+	return &PhoneUpdatedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PhoneUpdatedType,
+		),
+		Number: number,
+	}
+}
+
+type PhoneCodeAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Code              *crypto.CryptoValue `json:"code,omitempty"`
+	Expiry            time.Duration       `json:"expiry,omitempty"`
+	CodeReturned      bool                `json:"code_returned,omitempty"`
+	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+}
+
+func (e *PhoneCodeAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PhoneCodeAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PhoneCodeAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PhoneCodeAddedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewPhoneCodeAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+	codeReturned bool,
+) *PhoneCodeAddedEvent {
+	return &PhoneCodeAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PhoneCodeAddedType,
+		),
+		Code:              code,
+		Expiry:            expiry,
+		CodeReturned:      codeReturned,
+		TriggeredAtOrigin: http.ComposedOrigin(ctx),
+	}
+}
+
+type PhoneVerifiedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PhoneVerifiedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PhoneVerifiedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PhoneVerifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPhoneVerifiedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PhoneVerifiedEvent {
+	return &PhoneVerifiedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PhoneVerifiedType,
+		),
+	}
+}
+
+type PhoneVerificationFailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PhoneVerificationFailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PhoneVerificationFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PhoneVerificationFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPhoneVerificationFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PhoneVerificationFailedEvent {
+	return &PhoneVerificationFailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PhoneVerificationFailedType,
+		),
+	}
+}
+
+type PhoneCodeSentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PhoneCodeSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PhoneCodeSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PhoneCodeSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPhoneCodeSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PhoneCodeSentEvent {
+	return &PhoneCodeSentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PhoneCodeSentType,
+		),
+	}
+}
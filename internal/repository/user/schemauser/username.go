@@ -0,0 +1,97 @@
+package schemauser
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	UsernameAddedType   = eventPrefix + "username.added"
+	UsernameRemovedType = eventPrefix + "username.removed"
+)
+
+type UsernameAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID            string `json:"id"`
+	Username      string `json:"username"`
+	IsOrgSpecific bool   `json:"isOrgSpecific,omitempty"`
+}
+
+func (e *UsernameAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *UsernameAddedEvent) Payload() interface{} {
+	return e
+}
+
+// UniqueConstraints shares the usernames with the users of the v1 aggregate,
+// so a username identifies a single user during the login
+func (e *UsernameAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{
+		user.NewAddUsernameUniqueConstraint(e.Username, e.Aggregate().ResourceOwner, e.IsOrgSpecific),
+	}
+}
+
+func NewUsernameAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	username string,
+	isOrgSpecific bool,
+) *UsernameAddedEvent {
+	return &UsernameAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UsernameAddedType,
+		),
+		ID:            id,
+		Username:      username,
+		IsOrgSpecific: isOrgSpecific,
+	}
+}
+
+type UsernameRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID            string `json:"id"`
+	username      string
+	isOrgSpecific bool
+}
+
+func (e *UsernameRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *UsernameRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UsernameRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{
+		user.NewRemoveUsernameUniqueConstraint(e.username, e.Aggregate().ResourceOwner, e.isOrgSpecific),
+	}
+}
+
+func NewUsernameRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	username string,
+	isOrgSpecific bool,
+) *UsernameRemovedEvent {
+	return &UsernameRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UsernameRemovedType,
+		),
+		ID:            id,
+		username:      username,
+		isOrgSpecific: isOrgSpecific,
+	}
+}
@@ -0,0 +1,186 @@
+package transfer
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// archiveVersion is increased as soon as the format of the archive changes
+const archiveVersion = 1
+
+// Header is the first record of an archive
+type Header struct {
+	Version    uint16    `json:"version"`
+	InstanceID string    `json:"instanceId"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// Event is an event of the exported instance.
+// Personal data are stored in plain text, they are encrypted with the keys of the target on import.
+type Event struct {
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	Type          string          `json:"type"`
+	Sequence      uint64          `json:"sequence"`
+	Revision      uint16          `json:"revision"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+	Creator       string          `json:"creator"`
+	Owner         string          `json:"owner"`
+}
+
+// UniqueConstraint is a unique constraint of the exported instance.
+// Global constraints like the domains of the instance are unique over all instances.
+type UniqueConstraint struct {
+	Global bool   `json:"global,omitempty"`
+	Type   string `json:"type"`
+	Field  string `json:"field"`
+}
+
+// Asset is an asset of the exported instance stored in the database, e.g. a logo or an avatar
+type Asset struct {
+	Type          int32     `json:"type"`
+	ResourceOwner string    `json:"resourceOwner"`
+	Name          string    `json:"name"`
+	ContentType   string    `json:"contentType"`
+	Data          []byte    `json:"data"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// Summary is the last record of an archive,
+// it's used to detect truncated archives
+type Summary struct {
+	Events            uint64 `json:"events"`
+	UniqueConstraints uint64 `json:"uniqueConstraints"`
+	Assets            uint64 `json:"assets"`
+}
+
+// Record is a single line of an archive, exactly one field is set
+type Record struct {
+	Event            *Event            `json:"event,omitempty"`
+	UniqueConstraint *UniqueConstraint `json:"uniqueConstraint,omitempty"`
+	Asset            *Asset            `json:"asset,omitempty"`
+	Summary          *Summary          `json:"summary,omitempty"`
+}
+
+// ArchiveWriter writes gzip compressed json lines.
+// The first line is the [Header], the last line the [Summary].
+type ArchiveWriter struct {
+	gzip    *gzip.Writer
+	encoder *json.Encoder
+	summary Summary
+}
+
+func NewArchiveWriter(w io.Writer, header *Header) (*ArchiveWriter, error) {
+	header.Version = archiveVersion
+	gz := gzip.NewWriter(w)
+	writer := &ArchiveWriter{
+		gzip:    gz,
+		encoder: json.NewEncoder(gz),
+	}
+	if err := writer.encoder.Encode(header); err != nil {
+		return nil, zerrors.ThrowInternal(err, "TRANS-aeK4o", "Errors.Internal")
+	}
+	return writer, nil
+}
+
+func (w *ArchiveWriter) WriteEvent(event *Event) error {
+	w.summary.Events++
+	return w.write(&Record{Event: event})
+}
+
+func (w *ArchiveWriter) WriteUniqueConstraint(constraint *UniqueConstraint) error {
+	w.summary.UniqueConstraints++
+	return w.write(&Record{UniqueConstraint: constraint})
+}
+
+func (w *ArchiveWriter) WriteAsset(asset *Asset) error {
+	w.summary.Assets++
+	return w.write(&Record{Asset: asset})
+}
+
+// Close writes the summary and flushes the archive.
+// The underlying writer is not closed.
+func (w *ArchiveWriter) Close() (*Summary, error) {
+	if err := w.write(&Record{Summary: &w.summary}); err != nil {
+		return nil, err
+	}
+	if err := w.gzip.Close(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "TRANS-Ooz3u", "Errors.Internal")
+	}
+	return &w.summary, nil
+}
+
+func (w *ArchiveWriter) write(record *Record) error {
+	if err := w.encoder.Encode(record); err != nil {
+		return zerrors.ThrowInternal(err, "TRANS-wei5E", "Errors.Internal")
+	}
+	return nil
+}
+
+// ArchiveReader reads the records of an archive written by the [ArchiveWriter]
+type ArchiveReader struct {
+	Header *Header
+
+	decoder *json.Decoder
+	read    Summary
+	done    bool
+}
+
+func NewArchiveReader(r io.Reader) (*ArchiveReader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "TRANS-Jo5ie", "Errors.Transfer.Archive.Invalid")
+	}
+	reader := &ArchiveReader{
+		Header:  new(Header),
+		decoder: json.NewDecoder(gz),
+	}
+	if err = reader.decoder.Decode(reader.Header); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "TRANS-ohX5a", "Errors.Transfer.Archive.Invalid")
+	}
+	if reader.Header.Version != archiveVersion {
+		return nil, zerrors.ThrowInvalidArgument(nil, "TRANS-Ahm0u", "Errors.Transfer.Archive.Version")
+	}
+	if reader.Header.InstanceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "TRANS-ieh3O", "Errors.Transfer.Archive.Invalid")
+	}
+	return reader, nil
+}
+
+// Next returns the next record of the archive.
+// [io.EOF] is returned after the summary was read and matches the read records.
+func (r *ArchiveReader) Next() (*Record, error) {
+	if r.done {
+		return nil, io.EOF
+	}
+	record := new(Record)
+	err := r.decoder.Decode(record)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, zerrors.ThrowInvalidArgument(err, "TRANS-Eeh2a", "Errors.Transfer.Archive.Truncated")
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "TRANS-ua7Ch", "Errors.Transfer.Archive.Invalid")
+	}
+	switch {
+	case record.Event != nil:
+		r.read.Events++
+	case record.UniqueConstraint != nil:
+		r.read.UniqueConstraints++
+	case record.Asset != nil:
+		r.read.Assets++
+	case record.Summary != nil:
+		if *record.Summary != r.read {
+			return nil, zerrors.ThrowInvalidArgument(nil, "TRANS-Yee8k", "Errors.Transfer.Archive.Truncated")
+		}
+		r.done = true
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "TRANS-Ga1ee", "Errors.Transfer.Archive.Invalid")
+	}
+	return record, nil
+}
@@ -0,0 +1,143 @@
+package transfer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func writeArchive(t *testing.T, header *Header, records ...*Record) *bytes.Buffer {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	writer, err := NewArchiveWriter(buf, header)
+	if err != nil {
+		t.Fatalf("unable to create archive: %v", err)
+	}
+	for _, record := range records {
+		switch {
+		case record.Event != nil:
+			err = writer.WriteEvent(record.Event)
+		case record.UniqueConstraint != nil:
+			err = writer.WriteUniqueConstraint(record.UniqueConstraint)
+		case record.Asset != nil:
+			err = writer.WriteAsset(record.Asset)
+		}
+		if err != nil {
+			t.Fatalf("unable to write record: %v", err)
+		}
+	}
+	if _, err = writer.Close(); err != nil {
+		t.Fatalf("unable to close archive: %v", err)
+	}
+	return buf
+}
+
+func TestArchive(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	records := []*Record{
+		{Event: &Event{
+			AggregateType: "instance",
+			AggregateID:   "instance1",
+			Type:          "instance.added",
+			Sequence:      1,
+			Revision:      1,
+			CreatedAt:     createdAt,
+			Payload:       json.RawMessage(`{"name":"ZITADEL"}`),
+			Creator:       "SYSTEM",
+			Owner:         "instance1",
+		}},
+		{UniqueConstraint: &UniqueConstraint{Global: true, Type: "instance_domain", Field: "zitadel.example.com"}},
+		{Asset: &Asset{Type: 1, ResourceOwner: "instance1", Name: "instance1/policy/label/logo", ContentType: "image/png", Data: []byte{1, 2, 3}, UpdatedAt: createdAt}},
+	}
+	buf := writeArchive(t, &Header{InstanceID: "instance1", CreatedAt: createdAt}, records...)
+
+	reader, err := NewArchiveReader(buf)
+	if err != nil {
+		t.Fatalf("unable to read archive: %v", err)
+	}
+	if want := (&Header{Version: archiveVersion, InstanceID: "instance1", CreatedAt: createdAt}); !reflect.DeepEqual(want, reader.Header) {
+		t.Errorf("unexpected header: want %+v, got %+v", want, reader.Header)
+	}
+	for _, want := range records {
+		got, err := reader.Next()
+		if err != nil {
+			t.Fatalf("unable to read record: %v", err)
+		}
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("unexpected record: want %+v, got %+v", want, got)
+		}
+	}
+	summary, err := reader.Next()
+	if err != nil {
+		t.Fatalf("unable to read summary: %v", err)
+	}
+	if want := (&Summary{Events: 1, UniqueConstraints: 1, Assets: 1}); !reflect.DeepEqual(want, summary.Summary) {
+		t.Errorf("unexpected summary: want %+v, got %+v", want, summary.Summary)
+	}
+	if _, err = reader.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("expected EOF, got %v", err)
+	}
+}
+
+func TestArchive_truncated(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gz := gzip.NewWriter(buf)
+	// the summary is missing
+	_, err := gz.Write([]byte(`{"version":1,"instanceId":"instance1"}` + "\n" + `{"event":{"type":"instance.added"}}` + "\n"))
+	if err != nil {
+		t.Fatalf("unable to write archive: %v", err)
+	}
+	if err = gz.Close(); err != nil {
+		t.Fatalf("unable to close archive: %v", err)
+	}
+
+	reader, err := NewArchiveReader(buf)
+	if err != nil {
+		t.Fatalf("unable to read archive: %v", err)
+	}
+	if _, err = reader.Next(); err != nil {
+		t.Fatalf("unable to read event: %v", err)
+	}
+	if _, err = reader.Next(); !zerrors.IsErrorInvalidArgument(err) {
+		t.Errorf("expected invalid argument, got %v", err)
+	}
+}
+
+func TestArchive_summaryMismatch(t *testing.T) {
+	buf := new(bytes.Buffer)
+	writer, err := NewArchiveWriter(buf, &Header{InstanceID: "instance1"})
+	if err != nil {
+		t.Fatalf("unable to create archive: %v", err)
+	}
+	// the event is written without being counted
+	if err = writer.write(&Record{Event: &Event{Type: "instance.added"}}); err != nil {
+		t.Fatalf("unable to write record: %v", err)
+	}
+	if _, err = writer.Close(); err != nil {
+		t.Fatalf("unable to close archive: %v", err)
+	}
+
+	reader, err := NewArchiveReader(buf)
+	if err != nil {
+		t.Fatalf("unable to read archive: %v", err)
+	}
+	if _, err = reader.Next(); err != nil {
+		t.Fatalf("unable to read event: %v", err)
+	}
+	if _, err = reader.Next(); !zerrors.IsErrorInvalidArgument(err) {
+		t.Errorf("expected invalid argument, got %v", err)
+	}
+}
+
+func TestNewArchiveReader_invalid(t *testing.T) {
+	if _, err := NewArchiveReader(bytes.NewBufferString("no archive")); !zerrors.IsErrorInvalidArgument(err) {
+		t.Errorf("expected invalid argument, got %v", err)
+	}
+}
@@ -0,0 +1,206 @@
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	instanceExistsStmt = "SELECT EXISTS (SELECT 1 FROM eventstore.events2 WHERE instance_id = $1)"
+	exportEventsStmt   = `SELECT aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner"` +
+		` FROM eventstore.events2 WHERE instance_id = $1 ORDER BY "position", in_tx_order`
+	exportUniqueConstraintsStmt = "SELECT instance_id, unique_type, unique_field FROM eventstore.unique_constraints" +
+		" WHERE instance_id = $1 OR (instance_id = '' AND unique_type = $2 AND unique_field = ANY($3))"
+	exportAssetsStmt = "SELECT asset_type, resource_owner, name, content_type, data, updated_at FROM system.assets WHERE instance_id = $1"
+)
+
+// Export writes all events, unique constraints and assets of the instance into the archive.
+// All data are read in a single transaction, so the archive contains a consistent state of the instance.
+func (t *Transfer) Export(ctx context.Context, instanceID string, w io.Writer) (_ *Summary, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	tx, err := t.client.BeginTx(ctx, &sql.TxOptions{ReadOnly: true, Isolation: sql.LevelRepeatableRead})
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "TRANS-Ub1ie", "Errors.Internal")
+	}
+	defer func() {
+		rollbackErr := tx.Rollback()
+		logging.OnError(rollbackErr).Debug("unable to close read only transaction")
+	}()
+
+	var exists bool
+	if err = tx.QueryRowContext(ctx, instanceExistsStmt, instanceID).Scan(&exists); err != nil {
+		return nil, zerrors.ThrowInternal(err, "TRANS-aiw7E", "Errors.Internal")
+	}
+	if !exists {
+		return nil, zerrors.ThrowNotFound(nil, "TRANS-Ahd8o", "Errors.Instance.NotFound")
+	}
+
+	archive, err := NewArchiveWriter(w, &Header{InstanceID: instanceID, CreatedAt: time.Now()})
+	if err != nil {
+		return nil, err
+	}
+	domains, err := t.exportEvents(ctx, tx, instanceID, archive)
+	if err != nil {
+		return nil, err
+	}
+	if err = exportUniqueConstraints(ctx, tx, instanceID, domains, archive); err != nil {
+		return nil, err
+	}
+	if err = exportAssets(ctx, tx, instanceID, archive); err != nil {
+		return nil, err
+	}
+	return archive.Close()
+}
+
+// exportEvents writes the events ordered by their position and returns the current domains of the instance
+func (t *Transfer) exportEvents(ctx context.Context, tx *sql.Tx, instanceID string, archive *ArchiveWriter) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, exportEventsStmt, instanceID)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "TRANS-Oow4a", "Errors.Internal")
+	}
+	defer rows.Close()
+
+	domains := make(map[string]bool)
+	for rows.Next() {
+		event := new(Event)
+		var payload []byte
+		err = rows.Scan(
+			&event.AggregateType,
+			&event.AggregateID,
+			&event.Type,
+			&event.Sequence,
+			&event.Revision,
+			&event.CreatedAt,
+			&payload,
+			&event.Creator,
+			&event.Owner,
+		)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "TRANS-oa9Ei", "Errors.Internal")
+		}
+		if payload, err = t.decryptPersonalData(ctx, instanceID, event, payload); err != nil {
+			return nil, err
+		}
+		event.Payload = payload
+		if err = reduceDomain(domains, event); err != nil {
+			return nil, err
+		}
+		if err = archive.WriteEvent(event); err != nil {
+			return nil, err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "TRANS-Ieg4u", "Errors.Internal")
+	}
+
+	instanceDomains := make([]string, 0, len(domains))
+	for domain := range domains {
+		instanceDomains = append(instanceDomains, domain)
+	}
+	return instanceDomains, nil
+}
+
+func (t *Transfer) decryptPersonalData(ctx context.Context, instanceID string, event *Event, payload []byte) ([]byte, error) {
+	eventType := eventstore.EventType(event.Type)
+	if t.personalData == nil || !eventstore.HasPersonalData(eventType) {
+		return payload, nil
+	}
+	aggregate := &eventstore.Aggregate{
+		ID:         event.AggregateID,
+		Type:       eventstore.AggregateType(event.AggregateType),
+		InstanceID: instanceID,
+	}
+	return t.personalData.Decrypt(ctx, aggregate, eventType, payload)
+}
+
+// reduceDomain keeps track of the domains of the instance.
+// Their unique constraints are global and therefore not queryable by the id of the instance.
+func reduceDomain(domains map[string]bool, event *Event) error {
+	eventType := eventstore.EventType(event.Type)
+	if eventType != instance.InstanceDomainAddedEventType && eventType != instance.InstanceDomainRemovedEventType {
+		return nil
+	}
+	payload := new(struct {
+		Domain string `json:"domain"`
+	})
+	if err := json.Unmarshal(event.Payload, payload); err != nil {
+		return zerrors.ThrowInternal(err, "TRANS-Ohw0a", "Errors.Internal")
+	}
+	domain := strings.ToLower(payload.Domain)
+	if eventType == instance.InstanceDomainAddedEventType {
+		domains[domain] = true
+		return nil
+	}
+	delete(domains, domain)
+	return nil
+}
+
+func exportUniqueConstraints(ctx context.Context, tx *sql.Tx, instanceID string, domains []string, archive *ArchiveWriter) error {
+	rows, err := tx.QueryContext(ctx, exportUniqueConstraintsStmt, instanceID, instance.UniqueInstanceDomain, database.TextArray[string](domains))
+	if err != nil {
+		return zerrors.ThrowInternal(err, "TRANS-ieT2o", "Errors.Internal")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var constraintInstanceID string
+		constraint := new(UniqueConstraint)
+		if err = rows.Scan(&constraintInstanceID, &constraint.Type, &constraint.Field); err != nil {
+			return zerrors.ThrowInternal(err, "TRANS-quu3A", "Errors.Internal")
+		}
+		constraint.Global = constraintInstanceID == ""
+		if err = archive.WriteUniqueConstraint(constraint); err != nil {
+			return err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return zerrors.ThrowInternal(err, "TRANS-Xoh8i", "Errors.Internal")
+	}
+	return nil
+}
+
+// exportAssets writes the assets stored in the database,
+// assets of other storages must be copied separately
+func exportAssets(ctx context.Context, tx *sql.Tx, instanceID string, archive *ArchiveWriter) error {
+	rows, err := tx.QueryContext(ctx, exportAssetsStmt, instanceID)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "TRANS-ahR1u", "Errors.Internal")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		asset := new(Asset)
+		err = rows.Scan(
+			&asset.Type,
+			&asset.ResourceOwner,
+			&asset.Name,
+			&asset.ContentType,
+			&asset.Data,
+			&asset.UpdatedAt,
+		)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "TRANS-oh6Ie", "Errors.Internal")
+		}
+		if err = archive.WriteAsset(asset); err != nil {
+			return err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return zerrors.ThrowInternal(err, "TRANS-Thae9", "Errors.Internal")
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// importEventsBatchSize is the amount of events inserted per statement
+	importEventsBatchSize = 100
+	// destroyKeysBatchSize is the amount of personal data keys deleted per statement
+	destroyKeysBatchSize = 1000
+
+	importEventsStmt            = `INSERT INTO eventstore.events2 (instance_id, "owner", aggregate_type, aggregate_id, revision, creator, event_type, payload, "sequence", created_at, "position", in_tx_order) VALUES `
+	importEventsPlaceholderFmt  = "($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, %s, $%d)"
+	importUniqueConstraintsStmt = "INSERT INTO eventstore.unique_constraints (instance_id, unique_type, unique_field) VALUES ($1, $2, $3)"
+	importAssetsStmt            = "INSERT INTO system.assets (instance_id, asset_type, resource_owner, name, content_type, data, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+)
+
+// Import inserts the events, unique constraints and assets of the archive in a single transaction.
+// The instance must not exist in the target.
+// The events get new positions, so projections and other subscribers of the target process the instance like a new one.
+// The keys of encrypted personal data are created outside of the transaction and destroyed if the import fails.
+func (t *Transfer) Import(ctx context.Context, r io.Reader, remapping *Remapping) (instanceID string, _ *Summary, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	archive, err := NewArchiveReader(r)
+	if err != nil {
+		return "", nil, err
+	}
+	instanceID = archive.Header.InstanceID
+	if remapping.InstanceID != "" {
+		instanceID = remapping.InstanceID
+	}
+
+	positionExpr, err := t.positionExpr()
+	if err != nil {
+		return "", nil, err
+	}
+
+	tx, err := t.client.BeginTx(ctx, nil)
+	if err != nil {
+		return "", nil, zerrors.ThrowInternal(err, "TRANS-eeR0i", "Errors.Internal")
+	}
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback()
+			logging.OnError(rollbackErr).Debug("unable to rollback import")
+		}
+	}()
+
+	var exists bool
+	if err = tx.QueryRowContext(ctx, instanceExistsStmt, instanceID).Scan(&exists); err != nil {
+		return "", nil, zerrors.ThrowInternal(err, "TRANS-Oo1ae", "Errors.Internal")
+	}
+	if exists {
+		return "", nil, zerrors.ThrowAlreadyExists(nil, "TRANS-ga4Oh", "Errors.Instance.AlreadyExists")
+	}
+
+	importer := &importer{
+		tx:           tx,
+		instanceID:   instanceID,
+		remapper:     newRemapper(archive.Header.InstanceID, remapping),
+		positionExpr: positionExpr,
+		personalData: t.personalData,
+	}
+	defer func() {
+		if err != nil {
+			importer.destroyPersonalDataKeys(context.WithoutCancel(ctx))
+		}
+	}()
+	var record *Record
+	for {
+		record, err = archive.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		switch {
+		case record.Event != nil:
+			err = importer.event(ctx, record.Event)
+		case record.UniqueConstraint != nil:
+			err = importer.uniqueConstraint(ctx, record.UniqueConstraint)
+		case record.Asset != nil:
+			err = importer.asset(ctx, record.Asset)
+		}
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	if err = importer.flushEvents(ctx); err != nil {
+		return "", nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return "", nil, zerrors.ThrowInternal(err, "TRANS-ahC7u", "Errors.Internal")
+	}
+	return instanceID, &importer.summary, nil
+}
+
+func (t *Transfer) positionExpr() (string, error) {
+	switch t.client.Type() {
+	case "cockroach":
+		return "cluster_logical_timestamp()", nil
+	case "postgres":
+		return "EXTRACT(EPOCH FROM clock_timestamp())", nil
+	default:
+		return "", zerrors.ThrowUnimplementedf(nil, "TRANS-ooy5E", "database type %s not supported", t.client.Type())
+	}
+}
+
+type importer struct {
+	tx           *sql.Tx
+	instanceID   string
+	remapper     *remapper
+	positionExpr string
+	personalData *eventstore.PersonalDataCipher
+	// encrypted are the aggregates whose personal data are encrypted by the import
+	encrypted map[string]*eventstore.Aggregate
+
+	placeholders []string
+	args         []any
+	// inTxOrder keeps the order of the events if multiple events get the same position
+	inTxOrder uint64
+	summary   Summary
+}
+
+func (i *importer) event(ctx context.Context, event *Event) (err error) {
+	payload, err := i.remapper.payload(event.Payload)
+	if err != nil {
+		return err
+	}
+	aggregate := &eventstore.Aggregate{
+		ID:         i.remapper.id(event.AggregateID),
+		Type:       eventstore.AggregateType(event.AggregateType),
+		InstanceID: i.instanceID,
+	}
+	if i.personalData != nil && eventstore.HasPersonalData(eventstore.EventType(event.Type)) {
+		// keys are created outside of the transaction, they are destroyed if the import fails
+		i.trackEncrypted(aggregate)
+		if payload, err = i.personalData.Encrypt(ctx, aggregate, eventstore.EventType(event.Type), payload); err != nil {
+			return err
+		}
+	}
+
+	n := len(i.args)
+	i.placeholders = append(i.placeholders, fmt.Sprintf(importEventsPlaceholderFmt,
+		n+1, n+2, n+3, n+4, n+5, n+6, n+7, n+8, n+9, n+10, i.positionExpr, n+11,
+	))
+	i.args = append(i.args,
+		i.instanceID,
+		i.remapper.id(event.Owner),
+		event.AggregateType,
+		aggregate.ID,
+		event.Revision,
+		i.remapper.id(event.Creator),
+		event.Type,
+		nullPayload(payload),
+		event.Sequence,
+		event.CreatedAt,
+		i.inTxOrder,
+	)
+	i.inTxOrder++
+	i.summary.Events++
+	if len(i.placeholders) < importEventsBatchSize {
+		return nil
+	}
+	return i.flushEvents(ctx)
+}
+
+func (i *importer) trackEncrypted(aggregate *eventstore.Aggregate) {
+	if i.encrypted == nil {
+		i.encrypted = make(map[string]*eventstore.Aggregate)
+	}
+	i.encrypted[string(aggregate.Type)+":"+aggregate.ID] = aggregate
+}
+
+// destroyPersonalDataKeys deletes the keys created for the encrypted aggregates after the import failed,
+// the instance doesn't exist in the target, so the keys aren't used by other aggregates
+func (i *importer) destroyPersonalDataKeys(ctx context.Context) {
+	if len(i.encrypted) == 0 {
+		return
+	}
+	aggregates := make([]*eventstore.Aggregate, 0, min(len(i.encrypted), destroyKeysBatchSize))
+	destroy := func() {
+		err := i.personalData.Destroy(ctx, aggregates...)
+		logging.WithFields("instance", i.instanceID, "count", len(aggregates)).OnError(err).Error("unable to destroy personal data keys of failed import")
+		aggregates = aggregates[:0]
+	}
+	for _, aggregate := range i.encrypted {
+		aggregates = append(aggregates, aggregate)
+		if len(aggregates) == destroyKeysBatchSize {
+			destroy()
+		}
+	}
+	if len(aggregates) > 0 {
+		destroy()
+	}
+}
+
+func (i *importer) flushEvents(ctx context.Context) error {
+	if len(i.placeholders) == 0 {
+		return nil
+	}
+	_, err := i.tx.ExecContext(ctx, importEventsStmt+strings.Join(i.placeholders, ", "), i.args...)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "TRANS-Mah3i", "Errors.Internal")
+	}
+	i.placeholders = i.placeholders[:0]
+	i.args = i.args[:0]
+	return nil
+}
+
+func (i *importer) uniqueConstraint(ctx context.Context, constraint *UniqueConstraint) error {
+	var instanceID string
+	if !constraint.Global {
+		instanceID = i.instanceID
+	}
+	_, err := i.tx.ExecContext(ctx, importUniqueConstraintsStmt,
+		instanceID,
+		constraint.Type,
+		strings.ToLower(i.remapper.value(constraint.Field)),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return zerrors.ThrowAlreadyExists(err, "TRANS-Ut4ee", "Errors.Transfer.UniqueConstraint.AlreadyExists")
+	}
+	if err != nil {
+		return zerrors.ThrowInternal(err, "TRANS-eiT1o", "Errors.Internal")
+	}
+	i.summary.UniqueConstraints++
+	return nil
+}
+
+func (i *importer) asset(ctx context.Context, asset *Asset) error {
+	_, err := i.tx.ExecContext(ctx, importAssetsStmt,
+		i.instanceID,
+		asset.Type,
+		i.remapper.id(asset.ResourceOwner),
+		i.remapper.value(asset.Name),
+		asset.ContentType,
+		asset.Data,
+		asset.UpdatedAt,
+	)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "TRANS-Vie3a", "Errors.Internal")
+	}
+	i.summary.Assets++
+	return nil
+}
+
+// nullPayload stores events without payload as null like the eventstore does
+func nullPayload(payload []byte) any {
+	if len(payload) == 0 {
+		return nil
+	}
+	return payload
+}
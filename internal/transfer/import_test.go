@@ -0,0 +1,197 @@
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	db_mock "github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/database/postgres"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func init() {
+	eventstore.RegisterPersonalData("transfer.personal.added", "name")
+}
+
+func TestTransfer_Import(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	archive := writeArchive(t, &Header{InstanceID: "instance1"},
+		&Record{Event: &Event{
+			AggregateType: "instance",
+			AggregateID:   "instance1",
+			Type:          "instance.domain.added",
+			Sequence:      1,
+			Revision:      1,
+			CreatedAt:     createdAt,
+			Payload:       json.RawMessage(`{"domain":"prod.example.com"}`),
+			Creator:       "SYSTEM",
+			Owner:         "instance1",
+		}},
+		&Record{Event: &Event{
+			AggregateType: "project",
+			AggregateID:   "project1",
+			Type:          "project.removed",
+			Sequence:      2,
+			Revision:      1,
+			CreatedAt:     createdAt,
+			Creator:       "user1",
+			Owner:         "org1",
+		}},
+		&Record{UniqueConstraint: &UniqueConstraint{Global: true, Type: "instance_domain", Field: "prod.example.com"}},
+		&Record{UniqueConstraint: &UniqueConstraint{Type: "usernames", Field: "gigi@acme.prod.example.com"}},
+		&Record{Asset: &Asset{Type: 1, ResourceOwner: "instance1", Name: "instance1/policy/label/logo", ContentType: "image/png", Data: []byte{1}, UpdatedAt: createdAt}},
+	)
+
+	client, mock, err := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+	if err != nil {
+		t.Fatalf("unable to create sql mock: %v", err)
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(instanceExistsStmt)).
+		WithArgs("instance2").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec(regexp.QuoteMeta(importUniqueConstraintsStmt)).
+		WithArgs("", "instance_domain", "staging.example.com").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(importUniqueConstraintsStmt)).
+		WithArgs("instance2", "usernames", "gigi@acme.staging.example.com").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(importAssetsStmt)).
+		WithArgs("instance2", int32(1), "instance2", "instance2/policy/label/logo", "image/png", []byte{1}, createdAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(importEventsStmt+
+		"($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, EXTRACT(EPOCH FROM clock_timestamp()), $11), "+
+		"($12, $13, $14, $15, $16, $17, $18, $19, $20, $21, EXTRACT(EPOCH FROM clock_timestamp()), $22)")).
+		WithArgs(
+			"instance2", "instance2", "instance", "instance2", uint16(1), "SYSTEM", "instance.domain.added", []byte(`{"domain":"staging.example.com"}`), uint64(1), createdAt, uint64(0),
+			"instance2", "org1", "project", "project1", uint16(1), "user1", "project.removed", nil, uint64(2), createdAt, uint64(1),
+		).
+		WillReturnResult(sqlmock.NewResult(2, 2))
+	mock.ExpectCommit()
+
+	transfer := New(&database.DB{DB: client, Database: new(postgres.Config)}, nil)
+	instanceID, summary, err := transfer.Import(context.Background(), archive, &Remapping{
+		InstanceID: "instance2",
+		Domains:    map[string]string{"prod.example.com": "staging.example.com"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if instanceID != "instance2" {
+		t.Errorf("unexpected instance id: %s", instanceID)
+	}
+	if want := (Summary{Events: 2, UniqueConstraints: 2, Assets: 1}); *summary != want {
+		t.Errorf("unexpected summary: want %+v, got %+v", want, *summary)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransfer_Import_instanceExists(t *testing.T) {
+	archive := writeArchive(t, &Header{InstanceID: "instance1"})
+
+	client, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("unable to create sql mock: %v", err)
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(instanceExistsStmt)).
+		WithArgs("instance1").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	transfer := New(&database.DB{DB: client, Database: new(postgres.Config)}, nil)
+	_, _, err = transfer.Import(context.Background(), archive, &Remapping{})
+	if !zerrors.IsErrorAlreadyExists(err) {
+		t.Errorf("expected already exists, got %v", err)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransfer_Import_personalDataKeysDestroyed(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	archive := writeArchive(t, &Header{InstanceID: "instance1"},
+		&Record{Event: &Event{
+			AggregateType: "transfer",
+			AggregateID:   "user1",
+			Type:          "transfer.personal.added",
+			Sequence:      1,
+			Revision:      1,
+			CreatedAt:     createdAt,
+			Payload:       json.RawMessage(`{"name":"Gigi"}`),
+			Creator:       "user1",
+			Owner:         "org1",
+		}},
+	)
+
+	client, mock, err := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+	if err != nil {
+		t.Fatalf("unable to create sql mock: %v", err)
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(instanceExistsStmt)).
+		WithArgs("instance1").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec(regexp.QuoteMeta(importEventsStmt)).
+		WillReturnError(sqlmock.ErrCancelled)
+	mock.ExpectRollback()
+
+	keys := &testKeyStorage{keys: crypto.Keys{}}
+	transfer := New(&database.DB{DB: client, Database: new(postgres.Config)}, eventstore.NewPersonalDataCipher(keys, eventstore.PersonalDataConfig{Enabled: true}))
+	if _, _, err = transfer.Import(context.Background(), archive, &Remapping{}); !zerrors.IsInternal(err) {
+		t.Errorf("expected internal error, got %v", err)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	if keys.created != 1 {
+		t.Errorf("expected key to be created, got %d", keys.created)
+	}
+	if len(keys.keys) != 0 {
+		t.Errorf("expected keys to be destroyed, got %v", keys.keys)
+	}
+}
+
+type testKeyStorage struct {
+	keys    crypto.Keys
+	created int
+}
+
+func (s *testKeyStorage) ReadKeys() (crypto.Keys, error) {
+	return s.keys, nil
+}
+
+func (s *testKeyStorage) ReadKey(id string) (*crypto.Key, error) {
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, zerrors.ThrowInternal(sql.ErrNoRows, "", "unable to read key")
+	}
+	return &crypto.Key{ID: id, Value: key}, nil
+}
+
+func (s *testKeyStorage) CreateKeys(_ context.Context, keys ...*crypto.Key) error {
+	for _, key := range keys {
+		s.keys[key.ID] = key.Value
+		s.created++
+	}
+	return nil
+}
+
+func (s *testKeyStorage) DeleteKeys(_ context.Context, ids ...string) error {
+	for _, id := range ids {
+		delete(s.keys, id)
+	}
+	return nil
+}
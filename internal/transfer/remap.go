@@ -0,0 +1,161 @@
+package transfer
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Remapping defines how the exported instance is changed on import
+type Remapping struct {
+	// InstanceID is the id of the imported instance.
+	// The id of the exported instance is used if empty.
+	InstanceID string
+	// Domains maps domains of the exported instance to domains of the imported instance, e.g. the instance domains.
+	// Subdomains and the hosts of urls are mapped as well, e.g. "prod.example.com" to "staging.example.com"
+	// also maps "acme.prod.example.com" and "https://prod.example.com/ui/console".
+	Domains map[string]string
+}
+
+// remapper replaces ids and domains in the string values of the archive
+type remapper struct {
+	ids map[string]string
+	// domains are sorted by length descending, so the most specific domain matches first
+	domains []string
+	mapping map[string]string
+}
+
+func newRemapper(exportedInstanceID string, remapping *Remapping) *remapper {
+	r := &remapper{
+		ids:     make(map[string]string),
+		mapping: make(map[string]string, len(remapping.Domains)),
+	}
+	if remapping.InstanceID != "" && remapping.InstanceID != exportedInstanceID {
+		r.ids[exportedInstanceID] = remapping.InstanceID
+	}
+	for from, to := range remapping.Domains {
+		from = strings.ToLower(from)
+		if from == "" || from == strings.ToLower(to) {
+			continue
+		}
+		r.mapping[from] = to
+		r.domains = append(r.domains, from)
+	}
+	sort.Slice(r.domains, func(i, j int) bool {
+		return len(r.domains[i]) > len(r.domains[j])
+	})
+	return r
+}
+
+func (r *remapper) isEmpty() bool {
+	return len(r.ids) == 0 && len(r.domains) == 0
+}
+
+// id replaces the value if it's a remapped id
+func (r *remapper) id(value string) string {
+	if id, ok := r.ids[value]; ok {
+		return id
+	}
+	return value
+}
+
+// value replaces remapped ids, domains and hosts of urls.
+// Paths containing remapped ids, like the names of assets, are replaced segment wise.
+func (r *remapper) value(value string) string {
+	if id, ok := r.ids[value]; ok {
+		return id
+	}
+	if domain, ok := r.domain(value); ok {
+		return domain
+	}
+	if strings.Contains(value, "://") {
+		return r.url(value)
+	}
+	if len(r.ids) > 0 && strings.Contains(value, "/") {
+		segments := strings.Split(value, "/")
+		for i, segment := range segments {
+			segments[i] = r.id(segment)
+		}
+		return strings.Join(segments, "/")
+	}
+	return value
+}
+
+func (r *remapper) domain(value string) (string, bool) {
+	lower := strings.ToLower(value)
+	for _, from := range r.domains {
+		if lower == from {
+			return r.mapping[from], true
+		}
+		if strings.HasSuffix(lower, "."+from) {
+			return value[:len(value)-len(from)] + r.mapping[from], true
+		}
+	}
+	return "", false
+}
+
+func (r *remapper) url(value string) string {
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		return value
+	}
+	host, ok := r.domain(u.Hostname())
+	if !ok {
+		return value
+	}
+	if port := u.Port(); port != "" {
+		host = net.JoinHostPort(host, port)
+	}
+	u.Host = host
+	return u.String()
+}
+
+// payload replaces the string values of the json payload,
+// keys of objects are not changed
+func (r *remapper) payload(payload json.RawMessage) (json.RawMessage, error) {
+	if r.isEmpty() || len(payload) == 0 {
+		return payload, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	// numbers must not lose precision
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "TRANS-Iek0o", "Errors.Transfer.Archive.Invalid")
+	}
+	remapped, changed := r.json(data)
+	if !changed {
+		return payload, nil
+	}
+	payload, err := json.Marshal(remapped)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "TRANS-ooG3e", "Errors.Internal")
+	}
+	return payload, nil
+}
+
+func (r *remapper) json(data any) (_ any, changed bool) {
+	switch value := data.(type) {
+	case string:
+		remapped := r.value(value)
+		return remapped, remapped != value
+	case []any:
+		for i, item := range value {
+			remapped, itemChanged := r.json(item)
+			value[i] = remapped
+			changed = changed || itemChanged
+		}
+	case map[string]any:
+		for key, item := range value {
+			remapped, itemChanged := r.json(item)
+			value[key] = remapped
+			changed = changed || itemChanged
+		}
+	}
+	return data, changed
+}
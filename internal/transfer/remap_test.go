@@ -0,0 +1,84 @@
+package transfer
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func Test_remapper_value(t *testing.T) {
+	r := newRemapper("instance1", &Remapping{
+		InstanceID: "instance2",
+		Domains: map[string]string{
+			"prod.example.com":       "staging.example.com",
+			"login.prod.example.com": "login.staging.local",
+			"unchanged.example.com":  "unchanged.example.com",
+		},
+	})
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "instance1", want: "instance2"},
+		{value: "instance10", want: "instance10"},
+		{value: "instance1/policy/label/logo", want: "instance2/policy/label/logo"},
+		{value: "prod.example.com", want: "staging.example.com"},
+		{value: "PROD.example.com", want: "staging.example.com"},
+		{value: "acme.prod.example.com", want: "acme.staging.example.com"},
+		{value: "login.prod.example.com", want: "login.staging.local"},
+		{value: "notprod.example.com", want: "notprod.example.com"},
+		{value: "https://prod.example.com/ui/console?login=true", want: "https://staging.example.com/ui/console?login=true"},
+		{value: "http://acme.prod.example.com:8080/callback", want: "http://acme.staging.example.com:8080/callback"},
+		{value: "https://example.com/prod.example.com", want: "https://example.com/prod.example.com"},
+		{value: "gigi@prod.example.com", want: "gigi@prod.example.com"},
+		{value: "unchanged.example.com", want: "unchanged.example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := r.value(tt.value); got != tt.want {
+				t.Errorf("value() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_remapper_payload(t *testing.T) {
+	tests := []struct {
+		name      string
+		remapping *Remapping
+		payload   string
+		want      string
+	}{
+		{
+			name:      "no remapping",
+			remapping: &Remapping{InstanceID: "instance1"},
+			payload:   `{"instanceId": "instance1"}`,
+			want:      `{"instanceId": "instance1"}`,
+		},
+		{
+			name:      "nothing to remap",
+			remapping: &Remapping{InstanceID: "instance2"},
+			payload:   `{"name": "ZITADEL", "amount": 12345678901234567890}`,
+			want:      `{"name": "ZITADEL", "amount": 12345678901234567890}`,
+		},
+		{
+			name: "nested values",
+			remapping: &Remapping{
+				InstanceID: "instance2",
+				Domains:    map[string]string{"prod.example.com": "staging.example.com"},
+			},
+			payload: `{"instance1":"instance1","amount":12345678901234567890,"uris":["https://prod.example.com/cb"],"domain":{"name":"prod.example.com","verified":true}}`,
+			want:    `{"amount":12345678901234567890,"domain":{"name":"staging.example.com","verified":true},"instance1":"instance2","uris":["https://staging.example.com/cb"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newRemapper("instance1", tt.remapping).payload(json.RawMessage(tt.payload))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("payload() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
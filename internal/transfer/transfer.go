@@ -0,0 +1,29 @@
+// Package transfer exports all events, unique constraints and assets of a single instance into a self-contained archive
+// and imports such an archive into the same or another ZITADEL cluster.
+// It's used to move an instance between clusters or to clone an instance, e.g. from production into staging.
+//
+// Secrets in the events are encrypted with the encryption keys of the source cluster,
+// they are only readable if the target uses the same keys, see `zitadel mirror system`.
+// Personal data are decrypted on export and encrypted with the keys of the target on import,
+// archives must therefore be handled as confidential.
+package transfer
+
+import (
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	// registers the personal data fields of the user events
+	_ "github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type Transfer struct {
+	client *database.DB
+	// personalData is nil if personal data encryption is disabled
+	personalData *eventstore.PersonalDataCipher
+}
+
+func New(client *database.DB, personalData *eventstore.PersonalDataCipher) *Transfer {
+	return &Transfer{
+		client:       client,
+		personalData: personalData,
+	}
+}